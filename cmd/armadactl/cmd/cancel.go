@@ -19,6 +19,7 @@ func cancelCmd() *cobra.Command {
 	}
 	cmd.AddCommand(
 		cancelJobCmd(),
+		cancelJobsCmd(),
 		cancelJobSetCmd(),
 		cancelExecutorCmd(),
 		cancelNodeCmd(),
@@ -47,6 +48,51 @@ func cancelJobCmd() *cobra.Command {
 	return cmd
 }
 
+func cancelJobsCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "jobs <queue>",
+		Short: "Cancels jobs matching a label or annotation selector.",
+		Long:  `Cancels all active jobs in a queue whose labels and annotations match the provided selector, optionally restricted to job sets with a given prefix. At least one label or annotation must be provided, and only labels and annotations configured as selectable on the server can be used. Jobs submitted before a label or annotation was made selectable are never matched by it.`,
+		Args:  cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queue := args[0]
+
+			selector, err := jobSelectorFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			reason, err := cmd.Flags().GetString("reason")
+			if err != nil {
+				return fmt.Errorf("error reading reason flag: %s", err)
+			}
+
+			dryRun, err := cmd.Flags().GetBool("dry-run")
+			if err != nil {
+				return fmt.Errorf("error reading dry-run flag: %s", err)
+			}
+
+			return a.CancelJobsBySelector(queue, selector, reason, dryRun)
+		},
+	}
+	addJobSelectorFlags(cmd)
+	cmd.Flags().String(
+		"reason",
+		"",
+		"Reason for cancelling the jobs.",
+	)
+	cmd.Flags().Bool(
+		"dry-run",
+		false,
+		"Prints out the number of jobs that would be cancelled without cancelling them. Defaults to false.",
+	)
+	return cmd
+}
+
 func cancelJobSetCmd() *cobra.Command {
 	a := armadactl.New()
 	cmd := &cobra.Command{
@@ -27,10 +27,11 @@ func uncordon() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "uncordon",
 		Short: "Resume scheduling by resource",
-		Long:  "Resume scheduling by resource. Supported: queue, queues, executor",
+		Long:  "Resume scheduling by resource. Supported: queue, queues, executor, node",
 	}
 	cmd.AddCommand(uncordonQueues(a))
 	cmd.AddCommand(uncordonExecutor(a))
+	cmd.AddCommand(uncordonNode(a))
 	return cmd
 }
 
@@ -180,3 +181,33 @@ func uncordonExecutor(a *armadactl.App) *cobra.Command {
 	}
 	return cmd
 }
+
+func uncordonNode(a *armadactl.App) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "node <node_name>",
+		Short: "Release a node from quarantine",
+		Long:  "Release a node quarantined by its executor after repeated job failures, before its cool-down expires",
+		Args:  cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.MarkFlagRequired("executor"); err != nil {
+				return fmt.Errorf("error marking executor flag as required: %s", err)
+			}
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			nodeName := args[0]
+			if nodeName == "" {
+				return fmt.Errorf("provided node name is invalid: %s", nodeName)
+			}
+
+			executor, err := cmd.Flags().GetString("executor")
+			if err != nil {
+				return fmt.Errorf("error reading executor: %s", err)
+			}
+
+			return a.UncordonNode(nodeName, executor)
+		},
+	}
+	cmd.Flags().String("executor", "", "Executor the node belongs to.")
+	return cmd
+}
@@ -48,6 +48,7 @@ func initParams(cmd *cobra.Command, params *armadactl.Params) error {
 
 	params.NodeAPI.PreemptOnNode = cn.PreemptOnNode(client.ExtractCommandlineArmadaApiConnectionDetails)
 	params.NodeAPI.CancelOnNode = cn.CancelOnNode(client.ExtractCommandlineArmadaApiConnectionDetails)
+	params.NodeAPI.ReleaseNodeQuarantine = cn.ReleaseNodeQuarantine(client.ExtractCommandlineArmadaApiConnectionDetails)
 
 	return nil
 }
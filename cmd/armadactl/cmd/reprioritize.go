@@ -7,16 +7,18 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/armadaproject/armada/internal/armadactl"
+	"github.com/armadaproject/armada/pkg/api"
 )
 
 func reprioritizeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "reprioritize",
 		Short: "Reprioritize jobs in Armada",
-		Long:  `Change the priority of a single job or entire job-set. Supported: job, job-set`,
+		Long:  `Change the priority of a single job or entire job-set. Supported: job, jobs, job-set`,
 	}
 	cmd.AddCommand(
 		reprioritizeJobCmd(),
+		reprioritizeJobsCmd(),
 		reprioritizeJobSetCmd(),
 	)
 
@@ -49,6 +51,47 @@ func reprioritizeJobCmd() *cobra.Command {
 	return cmd
 }
 
+func reprioritizeJobsCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "jobs <queue> <priority>",
+		Short: `Change the priority of all jobs matching a label or annotation selector.`,
+		Long:  `Change the priority of all active jobs in a queue whose labels and annotations match the provided selector, optionally restricted to job sets with a given prefix. At least one label or annotation must be provided, and only labels and annotations configured as selectable on the server can be used. Jobs submitted before a label or annotation was made selectable are never matched by it.`,
+		Args:  cobra.ExactArgs(2),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queue := args[0]
+
+			priorityString := args[1]
+			priorityFactor, err := strconv.ParseFloat(priorityString, 64)
+			if err != nil {
+				return fmt.Errorf("error converting %s to float64: %s", priorityString, err)
+			}
+
+			selector, err := jobSelectorFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			dryRun, err := cmd.Flags().GetBool("dry-run")
+			if err != nil {
+				return fmt.Errorf("error reading dry-run flag: %s", err)
+			}
+
+			return a.ReprioritizeJobsBySelector(queue, selector, priorityFactor, dryRun)
+		},
+	}
+	addJobSelectorFlags(cmd)
+	cmd.Flags().Bool(
+		"dry-run",
+		false,
+		"Prints out the number of jobs that would be reprioritized without reprioritizing them. Defaults to false.",
+	)
+	return cmd
+}
+
 func reprioritizeJobSetCmd() *cobra.Command {
 	a := armadactl.New()
 	cmd := &cobra.Command{
@@ -73,3 +116,46 @@ func reprioritizeJobSetCmd() *cobra.Command {
 	}
 	return cmd
 }
+
+func addJobSelectorFlags(cmd *cobra.Command) {
+	cmd.Flags().StringToStringP(
+		"labels",
+		"l",
+		map[string]string{},
+		"Select jobs with all of the provided labels, as in the following example: key1=value1,key2=value2.",
+	)
+	cmd.Flags().StringToString(
+		"annotations",
+		map[string]string{},
+		"Select jobs with all of the provided annotations, as in the following example: key1=value1,key2=value2.",
+	)
+	cmd.Flags().String(
+		"job-set-prefix",
+		"",
+		"Only select jobs in job sets whose name starts with the provided prefix.",
+	)
+}
+
+func jobSelectorFromFlags(cmd *cobra.Command) (*api.JobSelector, error) {
+	labels, err := cmd.Flags().GetStringToString("labels")
+	if err != nil {
+		return nil, fmt.Errorf("error reading labels flag: %s", err)
+	}
+	annotations, err := cmd.Flags().GetStringToString("annotations")
+	if err != nil {
+		return nil, fmt.Errorf("error reading annotations flag: %s", err)
+	}
+	jobSetPrefix, err := cmd.Flags().GetString("job-set-prefix")
+	if err != nil {
+		return nil, fmt.Errorf("error reading job-set-prefix flag: %s", err)
+	}
+	if len(labels) == 0 && len(annotations) == 0 {
+		// This check makes accidentally operating on every job in a queue far less likely
+		return nil, fmt.Errorf("jobs must be selected by at least one label or annotation")
+	}
+	return &api.JobSelector{
+		Labels:       labels,
+		Annotations:  annotations,
+		JobSetPrefix: jobSetPrefix,
+	}, nil
+}
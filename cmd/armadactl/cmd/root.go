@@ -29,6 +29,8 @@ func RootCmd() *cobra.Command {
 		watchCmd(),
 		configCmd(armadactl.New()),
 		preemptCmd(),
+		suspendCmd(),
+		resumeCmd(),
 		docsCmd(),
 		cordon(),
 		uncordon(),
@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+)
+
+func suspendCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "suspend",
+		Short: "Suspend jobs in armada.",
+		Args:  cobra.ExactArgs(0),
+	}
+	cmd.AddCommand(
+		suspendJobCmd(),
+		suspendJobSetCmd(),
+	)
+	return cmd
+}
+
+func suspendJobCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "job <queue> <job-set> <job-id>...",
+		Short: "Suspend armada jobs.",
+		Long: `Suspend one or more jobs by providing their queue, job set and job ids.
+Queued jobs are held back from scheduling and running jobs are preempted and requeued without consuming a retry.`,
+		Args: cobra.MinimumNArgs(3),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			reason, err := cmd.Flags().GetString("reason")
+			if err != nil {
+				return fmt.Errorf("error reading reason: %s", err)
+			}
+			return a.SuspendJobs(args[0], args[1], args[2:], reason)
+		},
+	}
+	cmd.Flags().String("reason", "", "Reason for suspending the jobs.")
+	return cmd
+}
+
+func suspendJobSetCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "jobset <queue> <job-set>",
+		Short: "Suspend all active jobs in an armada job set.",
+		Args:  cobra.ExactArgs(2),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			reason, err := cmd.Flags().GetString("reason")
+			if err != nil {
+				return fmt.Errorf("error reading reason: %s", err)
+			}
+			return a.SuspendJobSet(args[0], args[1], reason)
+		},
+	}
+	cmd.Flags().String("reason", "", "Reason for suspending the job set.")
+	return cmd
+}
+
+func resumeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume",
+		Short: "Resume suspended jobs in armada.",
+		Args:  cobra.ExactArgs(0),
+	}
+	cmd.AddCommand(
+		resumeJobCmd(),
+		resumeJobSetCmd(),
+	)
+	return cmd
+}
+
+func resumeJobCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "job <queue> <job-set> <job-id>...",
+		Short: "Resume suspended armada jobs.",
+		Args:  cobra.MinimumNArgs(3),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.ResumeJobs(args[0], args[1], args[2:])
+		},
+	}
+	return cmd
+}
+
+func resumeJobSetCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "jobset <queue> <job-set>",
+		Short: "Resume all suspended jobs in an armada job set.",
+		Args:  cobra.ExactArgs(2),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.ResumeJobSet(args[0], args[1])
+		},
+	}
+	return cmd
+}
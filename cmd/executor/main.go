@@ -12,6 +12,7 @@ import (
 
 	"github.com/armadaproject/armada/internal/common"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	commonconfig "github.com/armadaproject/armada/internal/common/config"
 	"github.com/armadaproject/armada/internal/common/health"
 	log "github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/common/observability"
@@ -37,7 +38,8 @@ func main() {
 
 	var config configuration.ExecutorConfiguration
 	userSpecifiedConfigs := viper.GetStringSlice(CustomConfigLocation)
-	common.LoadConfig(&config, "./config/executor", userSpecifiedConfigs)
+	// Pod templates embed Kubernetes API types, which are decoded using their JSON field tags
+	common.LoadConfigWithHooks(&config, "./config/executor", userSpecifiedConfigs, commonconfig.KubernetesApiTypeHooks...)
 
 	if err := observability.InitOTel(config.Observability); err != nil {
 		log.Fatalf("Failed to initialize OTel: %v", err)
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/blobstore"
+	log "github.com/armadaproject/armada/internal/common/logging"
+	"github.com/armadaproject/armada/internal/lookout/archive"
+	"github.com/armadaproject/armada/internal/lookout/configuration"
+)
+
+const ArchiveCommand = "archive"
+
+func archiveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          ArchiveCommand,
+		Short:        "Work with the archives of jobs deleted by the pruner",
+		SilenceUsage: true,
+	}
+	cmd.PersistentFlags().StringSlice(
+		CustomConfigLocation,
+		[]string{},
+		"Fully qualified path to application configuration file (for multiple config files repeat this arg or separate paths with commas)",
+	)
+	cmd.AddCommand(archiveQueryCmd())
+	return cmd
+}
+
+func archiveQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query",
+		Short: "Search archived jobs by queue, job set and the days they were submitted on",
+		Long: "Search archived jobs by queue, job set and the days they were submitted on, in UTC. " +
+			"Jobs are printed as newline-delimited JSON, along with their runs and errors.",
+		Args: cobra.NoArgs,
+		RunE: queryArchive,
+	}
+	cmd.Flags().String("queue", "", "Queue the jobs were submitted to")
+	cmd.Flags().String("jobSet", "", "Job set the jobs were submitted to. Jobs in all job sets are returned if empty")
+	cmd.Flags().String("from", "", "First day the jobs were submitted on, as yyyy-mm-dd")
+	cmd.Flags().String("to", "", "Last day the jobs were submitted on, as yyyy-mm-dd. Defaults to the first day")
+	for _, flag := range []string{"queue", "from"} {
+		if err := cmd.MarkFlagRequired(flag); err != nil {
+			panic(err)
+		}
+	}
+	return cmd
+}
+
+func queryArchive(cmd *cobra.Command, _ []string) error {
+	// Log to stderr, so that the jobs printed to stdout can be piped to other tools
+	log.ReplaceStdLogger(log.FromZerolog(zerolog.New(zerolog.ConsoleWriter{Out: os.Stderr}).Level(zerolog.InfoLevel).With().Timestamp().Logger()))
+
+	query := archive.Query{}
+	var err error
+	if query.Queue, err = cmd.Flags().GetString("queue"); err != nil {
+		return errors.WithStack(err)
+	}
+	if query.JobSet, err = cmd.Flags().GetString("jobSet"); err != nil {
+		return errors.WithStack(err)
+	}
+	if query.From, err = dayFlag(cmd, "from"); err != nil {
+		return err
+	}
+	query.To = query.From
+	if cmd.Flags().Changed("to") {
+		if query.To, err = dayFlag(cmd, "to"); err != nil {
+			return err
+		}
+	}
+	configPaths, err := cmd.Flags().GetStringSlice(CustomConfigLocation)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	var config configuration.LookoutConfig
+	common.LoadConfig(&config, "./config/lookout", configPaths)
+	if config.PrunerConfig.Archive == nil {
+		return errors.New("prunerConfig.archive is not configured")
+	}
+	store, err := blobstore.New(*config.PrunerConfig.Archive)
+	if err != nil {
+		return err
+	}
+
+	jobs, err := archive.Search(armadacontext.Background(), store, query)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(cmd.OutOrStdout())
+	for _, job := range jobs {
+		if err := encoder.Encode(job); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	return nil
+}
+
+func dayFlag(cmd *cobra.Command, name string) (time.Time, error) {
+	value, err := cmd.Flags().GetString(name)
+	if err != nil {
+		return time.Time{}, errors.WithStack(err)
+	}
+	day, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, errors.Errorf("%s must be a date formatted as yyyy-mm-dd, got %q", name, value)
+	}
+	return day, nil
+}
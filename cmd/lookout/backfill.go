@@ -0,0 +1,57 @@
+package main
+
+import (
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/lookout/backfill"
+)
+
+const BackfillCommand = "backfill"
+
+func backfillCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          BackfillCommand,
+		Short:        "Backfill columns of the job table for jobs ingested before they were added",
+		SilenceUsage: true,
+	}
+	cmd.PersistentFlags().StringSlice(
+		CustomConfigLocation,
+		[]string{},
+		"Fully qualified path to application configuration file (for multiple config files repeat this arg or separate paths with commas)",
+	)
+	cmd.AddCommand(backfillRunCountsCmd())
+	return cmd
+}
+
+func backfillRunCountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run-counts",
+		Short: "Set the run count and first lease time of jobs from their runs",
+		Long: "Set the run count and first lease time of jobs ingested before migration 039 from their runs, " +
+			"in batches, while Lookout and the ingester keep running. Migration 040 only does this itself for small databases.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			batchSize, err := cmd.Flags().GetInt("batchSize")
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if batchSize <= 0 {
+				return errors.New("batchSize must be greater than 0")
+			}
+			after, err := cmd.Flags().GetString("after")
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			return withLookoutDb(cmd, func(ctx *armadacontext.Context, db *pgx.Conn) error {
+				_, err := backfill.RunCounts(ctx, db, batchSize, after)
+				return err
+			})
+		},
+	}
+	cmd.Flags().Int("batchSize", 10000, "Number of jobs to update in each transaction")
+	cmd.Flags().String("after", "", "Only update jobs with ids after this one, to resume an interrupted backfill")
+	return cmd
+}
@@ -6,38 +6,66 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	"k8s.io/utils/clock"
 
 	"github.com/armadaproject/armada/internal/common"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/blobstore"
+	"github.com/armadaproject/armada/internal/common/compress"
 	"github.com/armadaproject/armada/internal/common/database"
 	log "github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/common/observability"
 	"github.com/armadaproject/armada/internal/common/profiling"
 	"github.com/armadaproject/armada/internal/lookout"
+	"github.com/armadaproject/armada/internal/lookout/archive"
 	"github.com/armadaproject/armada/internal/lookout/configuration"
 	"github.com/armadaproject/armada/internal/lookout/gen/restapi"
 	"github.com/armadaproject/armada/internal/lookout/pruner"
 	lookoutschema "github.com/armadaproject/armada/internal/lookout/schema"
-	lookouthcschema "github.com/armadaproject/armada/internal/lookouthc/schema"
 	armada_config "github.com/armadaproject/armada/internal/server/configuration"
 )
 
 const (
 	CustomConfigLocation string = "config"
 	MigrateDatabase             = "migrateDatabase"
+	TargetVersion               = "targetVersion"
 	PruneDatabase               = "pruneDatabase"
 )
 
+// subcommand returns the command lookout was run as, such as "lookout archive ...", which parses its own arguments
+// rather than running the server. Returns nil if lookout wasn't run as a subcommand.
+func subcommand() *cobra.Command {
+	if len(os.Args) < 2 {
+		return nil
+	}
+	switch os.Args[1] {
+	case ArchiveCommand:
+		return archiveCmd()
+	case PartitionCommand:
+		return partitionCmd()
+	case BackfillCommand:
+		return backfillCmd()
+	default:
+		return nil
+	}
+}
+
 func init() {
+	if subcommand() != nil {
+		return
+	}
 	pflag.StringSlice(
 		CustomConfigLocation,
 		[]string{},
 		"Fully qualified path to application configuration file (for multiple config files repeat this arg or separate paths with commas)",
 	)
 	pflag.Bool(MigrateDatabase, false, "Migrate database instead of running server")
+	pflag.Int(TargetVersion, 0, "Only apply the migrations up to and including this version when migrating the database; 0 applies all of them")
 	pflag.Bool(PruneDatabase, false, "Prune database of old jobs instead of running server")
 	pflag.Parse()
 }
@@ -63,7 +91,8 @@ func makeContext() (*armadacontext.Context, func()) {
 	}
 }
 
-func migrate(ctx *armadacontext.Context, config configuration.LookoutConfig) {
+// migrate applies the lookout migrations up to and including targetVersion, or all of them if targetVersion is 0
+func migrate(ctx *armadacontext.Context, config configuration.LookoutConfig, targetVersion int) {
 	db, err := database.OpenPgxConn(config.Postgres)
 	if err != nil {
 		panic(err)
@@ -78,17 +107,36 @@ func migrate(ctx *armadacontext.Context, config configuration.LookoutConfig) {
 	if err != nil {
 		panic(err)
 	}
+	if targetVersion > 0 {
+		migrations = database.MigrationsUpTo(migrations, targetVersion)
+	}
 
 	err = database.UpdateDatabase(ctx, db, migrations)
 	if err != nil {
 		panic(err)
 	}
+}
 
-	if config.ExperimentalHotColdSplit {
-		if err := lookouthcschema.ApplyPartitioner(ctx, db); err != nil {
-			panic(err)
-		}
+// withLookoutDb connects to the lookout database the way migrations do, and runs action against it
+func withLookoutDb(cmd *cobra.Command, action func(ctx *armadacontext.Context, db *pgx.Conn) error) error {
+	configPaths, err := cmd.Flags().GetStringSlice(CustomConfigLocation)
+	if err != nil {
+		return errors.WithStack(err)
 	}
+	var config configuration.LookoutConfig
+	common.LoadConfig(&config, "./config/lookout", configPaths)
+
+	ctx, cleanup := makeContext()
+	defer cleanup()
+	db, err := database.OpenPgxConn(config.Postgres)
+	if err != nil {
+		return err
+	}
+	defer db.Close(ctx)
+	if err := database.PrepareSchema(ctx, db, config.Migration); err != nil {
+		return err
+	}
+	return action(ctx, db)
 }
 
 func prune(ctx *armadacontext.Context, config configuration.LookoutConfig) {
@@ -120,6 +168,16 @@ func prune(ctx *armadacontext.Context, config configuration.LookoutConfig) {
 	log.Infof("expireAfter: %v, batchSize: %v, timeout: %v, zombieRepairThreshold: %v",
 		config.PrunerConfig.ExpireAfter, config.PrunerConfig.BatchSize, config.PrunerConfig.Timeout, zombieRepairThreshold)
 
+	var archiver pruner.Archiver
+	if config.PrunerConfig.Archive != nil {
+		store, err := blobstore.New(*config.PrunerConfig.Archive)
+		if err != nil {
+			panic(err)
+		}
+		archiver = archive.NewArchiver(store, compress.NewZlibDecompressor())
+		log.Info("Archiving jobs before deleting them")
+	}
+
 	ctxTimeout, cancel := armadacontext.WithTimeout(ctx, config.PrunerConfig.Timeout)
 	defer cancel()
 	err = pruner.PruneDb(
@@ -130,7 +188,7 @@ func prune(ctx *armadacontext.Context, config configuration.LookoutConfig) {
 		zombieRepairThreshold,
 		config.PrunerConfig.BatchSize,
 		clock.RealClock{},
-		config.ExperimentalHotColdSplit,
+		archiver,
 	)
 	if err != nil {
 		panic(err)
@@ -150,6 +208,14 @@ func prune(ctx *armadacontext.Context, config configuration.LookoutConfig) {
 }
 
 func main() {
+	if cmd := subcommand(); cmd != nil {
+		cmd.SetArgs(os.Args[2:])
+		if err := cmd.Execute(); err != nil {
+			os.Exit(1)
+		}
+		return
+	}
+
 	log.MustConfigureApplicationLogging()
 	common.BindCommandlineArguments()
 
@@ -185,7 +251,7 @@ func main() {
 
 	if viper.GetBool(MigrateDatabase) {
 		log.Info("Migrating database")
-		migrate(ctx, config)
+		migrate(ctx, config, viper.GetInt(TargetVersion))
 		return
 	}
 
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/lookout/partition"
+)
+
+const PartitionCommand = "partition"
+
+func partitionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   PartitionCommand,
+		Short: "Partition the job table online, before migrating the database",
+		Long: "Partition the job table by state while Lookout and the ingester keep running, by running prepare, " +
+			"backfill, verify and swap in turn. The migration that partitions job then has nothing to do. " +
+			"See docs/lookout_partitioning.md.",
+		SilenceUsage: true,
+	}
+	cmd.PersistentFlags().StringSlice(
+		CustomConfigLocation,
+		[]string{},
+		"Fully qualified path to application configuration file (for multiple config files repeat this arg or separate paths with commas)",
+	)
+	cmd.AddCommand(partitionPrepareCmd(), partitionBackfillCmd(), partitionVerifyCmd(), partitionSwapCmd())
+	return cmd
+}
+
+func partitionPrepareCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prepare",
+		Short: "Create an empty partitioned copy of job, and a trigger mirroring changes to job into it",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			lockTimeout, err := cmd.Flags().GetDuration("lockTimeout")
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			return withLookoutDb(cmd, func(ctx *armadacontext.Context, db *pgx.Conn) error {
+				return partition.Prepare(ctx, db, lockTimeout)
+			})
+		},
+	}
+	cmd.Flags().Duration("lockTimeout", 10*time.Second, "How long to wait to lock job before giving up")
+	return cmd
+}
+
+func partitionBackfillCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backfill",
+		Short: "Copy the rows of job into its partitioned copy",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			batchSize, err := cmd.Flags().GetInt("batchSize")
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if batchSize <= 0 {
+				return errors.New("batchSize must be greater than 0")
+			}
+			after, err := cmd.Flags().GetString("after")
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			return withLookoutDb(cmd, func(ctx *armadacontext.Context, db *pgx.Conn) error {
+				_, err := partition.Backfill(ctx, db, batchSize, after)
+				return err
+			})
+		},
+	}
+	cmd.Flags().Int("batchSize", 10000, "Number of jobs to copy in each transaction")
+	cmd.Flags().String("after", "", "Only copy jobs with ids after this one, to resume an interrupted backfill")
+	return cmd
+}
+
+func partitionVerifyCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify",
+		Short: "Check the shape of the partitioned job table, and that its partitioned copy matches job",
+		Long: "Check the shape of the partitioned job table, and that its partitioned copy matches job. " +
+			"Each problem found is printed, and the command fails if there are any.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return withLookoutDb(cmd, func(ctx *armadacontext.Context, db *pgx.Conn) error {
+				problems, err := partition.Verify(ctx, db)
+				if err != nil {
+					return err
+				}
+				for _, problem := range problems {
+					fmt.Fprintln(cmd.OutOrStdout(), problem)
+				}
+				if len(problems) > 0 {
+					return errors.Errorf("found %d problems", len(problems))
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), "OK")
+				return nil
+			})
+		},
+	}
+}
+
+func partitionSwapCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap",
+		Short: "Replace job with its backfilled partitioned copy",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			lockTimeout, err := cmd.Flags().GetDuration("lockTimeout")
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			return withLookoutDb(cmd, func(ctx *armadacontext.Context, db *pgx.Conn) error {
+				return partition.Swap(ctx, db, lockTimeout)
+			})
+		},
+	}
+	cmd.Flags().Duration("lockTimeout", 10*time.Second, "How long to wait to lock job before giving up")
+	return cmd
+}
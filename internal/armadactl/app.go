@@ -80,8 +80,9 @@ type RetryPolicyAPI struct {
 }
 
 type NodeAPI struct {
-	PreemptOnNode node.PreemptAPI
-	CancelOnNode  node.CancelAPI
+	PreemptOnNode         node.PreemptAPI
+	CancelOnNode          node.CancelAPI
+	ReleaseNodeQuarantine node.ReleaseQuarantineAPI
 }
 
 // New instantiates an App with default parameters, including standard output
@@ -2,6 +2,8 @@ package armadactl
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -56,6 +58,46 @@ func (a *App) CancelJobSet(queue string, jobSetId string) (outerErr error) {
 	})
 }
 
+// CancelJobsBySelector cancels all active jobs in the queue whose labels and annotations match the provided selector.
+// If dryRun is set, only the number of matching jobs is reported.
+func (a *App) CancelJobsBySelector(queue string, selector *api.JobSelector, reason string, dryRun bool) error {
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		result, err := c.CancelJobsBySelector(ctx, &api.JobSelectorCancelRequest{
+			Queue:    queue,
+			Selector: selector,
+			Reason:   reason,
+			DryRun:   dryRun,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "error cancelling jobs matching queue: %s, selector: %s", queue, formatJobSelector(selector))
+		}
+
+		if dryRun {
+			fmt.Fprintf(a.Out, "%d jobs in queue %s match selector %s\n", result.MatchedJobs, queue, formatJobSelector(selector))
+		} else {
+			fmt.Fprintf(a.Out, "Requested cancellation of %d jobs in queue %s matching selector %s\n", result.MatchedJobs, queue, formatJobSelector(selector))
+		}
+		return nil
+	})
+}
+
+func formatJobSelector(selector *api.JobSelector) string {
+	var parts []string
+	for _, k := range slices.Sorted(maps.Keys(selector.Labels)) {
+		parts = append(parts, fmt.Sprintf("label %s=%s", k, selector.Labels[k]))
+	}
+	for _, k := range slices.Sorted(maps.Keys(selector.Annotations)) {
+		parts = append(parts, fmt.Sprintf("annotation %s=%s", k, selector.Annotations[k]))
+	}
+	if selector.JobSetPrefix != "" {
+		parts = append(parts, fmt.Sprintf("job set prefix %s", selector.JobSetPrefix))
+	}
+	return strings.Join(parts, ", ")
+}
+
 func (a *App) CancelOnExecutor(executor string, queues []string, priorityClasses []string, pools []string) error {
 	priorityClassesMsg := strings.Join(priorityClasses, ",")
 	if len(priorityClasses) == 0 {
@@ -78,6 +78,15 @@ func (a *App) UncordonExecutor(executor string) error {
 	return nil
 }
 
+// UncordonNode releases a node from the quarantine placed on it by its executor after repeated job failures.
+func (a *App) UncordonNode(node string, executor string) error {
+	fmt.Fprintf(a.Out, "Requesting release of node %s on executor %s from quarantine\n", node, executor)
+	if err := a.Params.NodeAPI.ReleaseNodeQuarantine(node, executor); err != nil {
+		return fmt.Errorf("error releasing node %s on executor %s from quarantine: %s", node, executor, err)
+	}
+	return nil
+}
+
 func (a *App) CordonExecutor(executor string, cordonReason string) error {
 	fmt.Println("Cordoning the following executors:")
 	if err := a.Params.ExecutorAPI.Cordon(executor, cordonReason); err != nil {
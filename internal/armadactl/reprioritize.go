@@ -66,6 +66,32 @@ func (a *App) ReprioritizeJob(queue string, jobSet string, jobId string, priorit
 	})
 }
 
+// ReprioritizeJobsBySelector sets the priority of all active jobs in the queue whose labels and annotations match the
+// provided selector to priorityFactor. If dryRun is set, only the number of matching jobs is reported.
+func (a *App) ReprioritizeJobsBySelector(queueName string, selector *api.JobSelector, priorityFactor float64, dryRun bool) error {
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		result, err := c.ReprioritizeJobsBySelector(ctx, &api.JobSelectorReprioritizeRequest{
+			Queue:       queueName,
+			Selector:    selector,
+			NewPriority: priorityFactor,
+			DryRun:      dryRun,
+		})
+		if err != nil {
+			return errors.WithMessagef(err, "error reprioritising jobs matching queue: %s, selector: %s\n", queueName, formatJobSelector(selector))
+		}
+
+		if dryRun {
+			fmt.Fprintf(a.Out, "%d jobs in queue %s match selector %s\n", result.MatchedJobs, queueName, formatJobSelector(selector))
+		} else {
+			fmt.Fprintf(a.Out, "Reprioritized %d jobs in queue %s matching selector %s\n", result.MatchedJobs, queueName, formatJobSelector(selector))
+		}
+		return nil
+	})
+}
+
 func (a *App) writeResults(results map[string]string) error {
 	if len(results) == 0 {
 		return errors.Errorf("no jobs were reprioritized")
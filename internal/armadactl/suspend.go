@@ -0,0 +1,104 @@
+package armadactl
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+// SuspendJobs suspends the given jobs in the provided queue and job set.
+func (a *App) SuspendJobs(queue string, jobSetId string, jobIds []string, reason string) error {
+	fmt.Fprintf(a.Out, "Requesting suspension of jobs matching queue: %s, job set: %s, and job IDs: %s\n", queue, jobSetId, strings.Join(jobIds, ", "))
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		result, err := c.SuspendJobs(ctx, &api.JobSuspendRequest{
+			JobIds:   jobIds,
+			JobSetId: jobSetId,
+			Queue:    queue,
+			Reason:   reason,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "error suspending jobs matching queue: %s, job set: %s", queue, jobSetId)
+		}
+		a.printSuspensionResult("suspension", result)
+		return nil
+	})
+}
+
+// ResumeJobs resumes the given suspended jobs in the provided queue and job set.
+func (a *App) ResumeJobs(queue string, jobSetId string, jobIds []string) error {
+	fmt.Fprintf(a.Out, "Requesting resumption of jobs matching queue: %s, job set: %s, and job IDs: %s\n", queue, jobSetId, strings.Join(jobIds, ", "))
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		result, err := c.ResumeJobs(ctx, &api.JobResumeRequest{
+			JobIds:   jobIds,
+			JobSetId: jobSetId,
+			Queue:    queue,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "error resuming jobs matching queue: %s, job set: %s", queue, jobSetId)
+		}
+		a.printSuspensionResult("resumption", result)
+		return nil
+	})
+}
+
+// SuspendJobSet suspends all active jobs in the provided queue and job set.
+func (a *App) SuspendJobSet(queue string, jobSetId string, reason string) error {
+	fmt.Fprintf(a.Out, "Requesting suspension of job set matching queue: %s, job set: %s\n", queue, jobSetId)
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		result, err := c.SuspendJobSet(ctx, &api.JobSetSuspendRequest{
+			JobSetId: jobSetId,
+			Queue:    queue,
+			Reason:   reason,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "error suspending job set matching queue: %s, job set: %s", queue, jobSetId)
+		}
+		a.printSuspensionResult("suspension", result)
+		return nil
+	})
+}
+
+// ResumeJobSet resumes all suspended jobs in the provided queue and job set.
+func (a *App) ResumeJobSet(queue string, jobSetId string) error {
+	fmt.Fprintf(a.Out, "Requesting resumption of job set matching queue: %s, job set: %s\n", queue, jobSetId)
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		result, err := c.ResumeJobSet(ctx, &api.JobSetResumeRequest{
+			JobSetId: jobSetId,
+			Queue:    queue,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "error resuming job set matching queue: %s, job set: %s", queue, jobSetId)
+		}
+		a.printSuspensionResult("resumption", result)
+		return nil
+	})
+}
+
+func (a *App) printSuspensionResult(action string, result *api.JobSuspensionResult) {
+	for _, jobId := range slices.Sorted(maps.Keys(result.Results)) {
+		if msg := result.Results[jobId]; msg != "" {
+			fmt.Fprintf(a.Out, "%s %s failed: %s\n", jobId, action, msg)
+		} else {
+			fmt.Fprintf(a.Out, "%s %s requested\n", jobId, action)
+		}
+	}
+}
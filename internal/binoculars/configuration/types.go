@@ -2,6 +2,7 @@ package configuration
 
 import (
 	"github.com/armadaproject/armada/internal/common/auth/configuration"
+	"github.com/armadaproject/armada/internal/common/blobstore"
 	grpcconfig "github.com/armadaproject/armada/internal/common/grpc/configuration"
 	"github.com/armadaproject/armada/internal/common/observability"
 	profilingconfig "github.com/armadaproject/armada/internal/common/profiling/configuration"
@@ -22,6 +23,14 @@ type BinocularsConfig struct {
 	Grpc             grpcconfig.GrpcConfig
 	ImpersonateUsers bool
 	Kubernetes       KubernetesConfiguration
+	FailedPodLogs    FailedPodLogsConfiguration
+}
+
+// FailedPodLogsConfiguration controls serving the logs captured by the executor from failed pods once they've been deleted.
+// Store must point at the same blob store the executor uploads the logs to.
+type FailedPodLogsConfiguration struct {
+	Enabled bool
+	Store   blobstore.Config
 }
 
 type KubernetesConfiguration struct {
@@ -8,6 +8,7 @@ import (
 	"github.com/armadaproject/armada/internal/binoculars/server"
 	"github.com/armadaproject/armada/internal/binoculars/service"
 	"github.com/armadaproject/armada/internal/common/auth"
+	"github.com/armadaproject/armada/internal/common/blobstore"
 	"github.com/armadaproject/armada/internal/common/cluster"
 	grpcCommon "github.com/armadaproject/armada/internal/common/grpc"
 	log "github.com/armadaproject/armada/internal/common/logging"
@@ -42,7 +43,16 @@ func StartUp(config *configuration.BinocularsConfig) (func(), *sync.WaitGroup) {
 		config.Auth.PermissionClaimMapping,
 	)
 
-	logService := service.NewKubernetesLogService(kubernetesClientProvider)
+	var failedPodLogStore blobstore.BlobStore
+	if config.FailedPodLogs.Enabled {
+		failedPodLogStore, err = blobstore.New(config.FailedPodLogs.Store)
+		if err != nil {
+			log.Errorf("Failed to create failed pod log store %s", err)
+			os.Exit(-1)
+		}
+	}
+
+	logService := service.NewKubernetesLogService(kubernetesClientProvider, failedPodLogStore)
 	cordonService := service.NewKubernetesCordonService(config.Cordon, permissionsChecker, kubernetesClientProvider)
 	binocularsServer := server.NewBinocularsServer(logService, cordonService)
 	binoculars.RegisterBinocularsServer(grpcServer, binocularsServer)
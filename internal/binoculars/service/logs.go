@@ -1,18 +1,22 @@
 package service
 
 import (
+	goerrors "errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/auth"
+	"github.com/armadaproject/armada/internal/common/blobstore"
 	"github.com/armadaproject/armada/internal/common/cluster"
 	log "github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/pkg/api/binoculars"
@@ -32,12 +36,17 @@ type LogParams struct {
 
 type KubernetesLogService struct {
 	clientProvider cluster.KubernetesClientProvider
+	// Store holding the logs the executor captured from failed pods, used once a pod has been deleted.
+	// May be nil, in which case logs are only available while the pod exists.
+	failedPodLogStore blobstore.BlobStore
 }
 
 const MaxLogBytes = 2000000
 
-func NewKubernetesLogService(clientProvider cluster.KubernetesClientProvider) *KubernetesLogService {
-	return &KubernetesLogService{clientProvider: clientProvider}
+const podNotFoundMessage = "The pod with these logs doesn't exist - this is likely because the job has finished and the pod has been cleaned up"
+
+func NewKubernetesLogService(clientProvider cluster.KubernetesClientProvider, failedPodLogStore blobstore.BlobStore) *KubernetesLogService {
+	return &KubernetesLogService{clientProvider: clientProvider, failedPodLogStore: failedPodLogStore}
 }
 
 func (l *KubernetesLogService) GetLogs(ctx *armadacontext.Context, params *LogParams) ([]*binoculars.LogLine, error) {
@@ -71,7 +80,7 @@ func (l *KubernetesLogService) GetLogs(ctx *armadacontext.Context, params *LogPa
 	result := req.Do(ctx)
 	if err := result.Error(); err != nil {
 		if errors.IsNotFound(err) {
-			return nil, status.Error(codes.NotFound, "The pod with these logs doesn't exist - this is likely because the job has finished and the pod has been cleaned up")
+			return l.getCapturedLogs(ctx, client, params)
 		}
 		return nil, err
 	}
@@ -81,6 +90,58 @@ func (l *KubernetesLogService) GetLogs(ctx *armadacontext.Context, params *LogPa
 		return nil, err
 	}
 
+	return convertLogsForPod(rawLog, params), nil
+}
+
+// getCapturedLogs returns the logs the executor captured when the pod failed, for pods that no longer exist.
+// As the pod can't be used to check the user may read its logs, the user must be allowed to read pod logs in its namespace.
+func (l *KubernetesLogService) getCapturedLogs(ctx *armadacontext.Context, client kubernetes.Interface, params *LogParams) ([]*binoculars.LogLine, error) {
+	if l.failedPodLogStore == nil || params.LogOptions.Container == "" {
+		return nil, status.Error(codes.NotFound, podNotFoundMessage)
+	}
+
+	review, err := client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace:   params.Namespace,
+				Verb:        "get",
+				Resource:    "pods",
+				Subresource: "log",
+				Name:        params.PodName,
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !review.Status.Allowed {
+		return nil, status.Errorf(codes.PermissionDenied, "not allowed to read the logs of pods in namespace %s", params.Namespace)
+	}
+
+	rawLog, err := l.failedPodLogStore.Get(ctx, blobstore.PodLogKey(params.Namespace, params.PodName, params.LogOptions.Container))
+	if goerrors.Is(err, blobstore.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, podNotFoundMessage)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	logLines := convertLogsForPod(rawLog, params)
+	if params.LogOptions.SinceTime == nil {
+		return logLines, nil
+	}
+	// Kubernetes applies SinceTime when reading logs; for captured logs we have to filter them ourselves.
+	filtered := make([]*binoculars.LogLine, 0, len(logLines))
+	for _, logLine := range logLines {
+		timestamp, err := time.Parse(time.RFC3339Nano, logLine.Timestamp)
+		if err == nil && !timestamp.Before(params.LogOptions.SinceTime.Time) {
+			filtered = append(filtered, logLine)
+		}
+	}
+	return filtered, nil
+}
+
+func convertLogsForPod(rawLog []byte, params *LogParams) []*binoculars.LogLine {
 	logLines, errs := ConvertLogs(rawLog)
 	for _, err := range errs {
 		log.Errorf(
@@ -89,8 +150,7 @@ func (l *KubernetesLogService) GetLogs(ctx *armadacontext.Context, params *LogPa
 			params.PodName,
 			err)
 	}
-
-	return logLines, nil
+	return logLines
 }
 
 func ConvertLogs(rawLog []byte) ([]*binoculars.LogLine, []error) {
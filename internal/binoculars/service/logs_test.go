@@ -4,8 +4,21 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clientTesting "k8s.io/client-go/testing"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/blobstore"
 )
 
 func TestConvertLogs_ReturnsLogLineWithTime(t *testing.T) {
@@ -106,3 +119,69 @@ func TestConvertLogs_LargerThanMaxBytesTruncatesLogs(t *testing.T) {
 	assert.Len(t, logLines, nLines, fmt.Sprintf("should be %d, is %d", nLines, len(logLines)))
 	assert.Len(t, errs, 0)
 }
+
+func TestGetCapturedLogs(t *testing.T) {
+	logService, client, store := setupCapturedLogsTest(t, true)
+	ctx := armadacontext.Background()
+	_, err := store.Put(ctx, blobstore.PodLogKey("namespace", "pod", "main"), []byte(
+		"2022-02-08T11:32:21.183268868Z first\n"+
+			"2022-02-08T11:32:22.183268868Z second\n"))
+	require.NoError(t, err)
+
+	logLines, err := logService.getCapturedLogs(ctx, client, makeLogParams("main", nil))
+	require.NoError(t, err)
+	require.Len(t, logLines, 2)
+	assert.Equal(t, "first", logLines[0].Line)
+
+	since, err := time.Parse(time.RFC3339Nano, "2022-02-08T11:32:22.183268868Z")
+	require.NoError(t, err)
+	logLines, err = logService.getCapturedLogs(ctx, client, makeLogParams("main", &metav1.Time{Time: since}))
+	require.NoError(t, err)
+	require.Len(t, logLines, 1)
+	assert.Equal(t, "second", logLines[0].Line)
+}
+
+func TestGetCapturedLogs_NotCaptured(t *testing.T) {
+	logService, client, _ := setupCapturedLogsTest(t, true)
+
+	_, err := logService.getCapturedLogs(armadacontext.Background(), client, makeLogParams("main", nil))
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGetCapturedLogs_NoStore(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	logService := NewKubernetesLogService(&FakeClientProvider{FakeClient: client}, nil)
+
+	_, err := logService.getCapturedLogs(armadacontext.Background(), client, makeLogParams("main", nil))
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGetCapturedLogs_PermissionDenied(t *testing.T) {
+	logService, client, store := setupCapturedLogsTest(t, false)
+	ctx := armadacontext.Background()
+	_, err := store.Put(ctx, blobstore.PodLogKey("namespace", "pod", "main"), []byte("2022-02-08T11:32:21.183268868Z first\n"))
+	require.NoError(t, err)
+
+	_, err = logService.getCapturedLogs(ctx, client, makeLogParams("main", nil))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func setupCapturedLogsTest(t *testing.T, allowed bool) (*KubernetesLogService, *fake.Clientset, blobstore.BlobStore) {
+	client := fake.NewSimpleClientset()
+	client.PrependReactor("create", "selfsubjectaccessreviews", func(action clientTesting.Action) (bool, runtime.Object, error) {
+		review := action.(clientTesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		review.Status.Allowed = allowed
+		return true, review, nil
+	})
+	store, err := blobstore.NewFilesystemBlobStore(t.TempDir())
+	require.NoError(t, err)
+	return NewKubernetesLogService(&FakeClientProvider{FakeClient: client}, store), client, store
+}
+
+func makeLogParams(container string, since *metav1.Time) *LogParams {
+	return &LogParams{
+		Namespace:  "namespace",
+		PodName:    "pod",
+		LogOptions: &v1.PodLogOptions{Container: container, SinceTime: since},
+	}
+}
@@ -83,7 +83,8 @@ Three implementations are provided:
     inserts are committed before updates. This avoids FK-constraint lock
     contention between concurrent job run inserts and job row updates. After
     applying schema migrations, InitialiseSchema executes any Postgres tuning SQL
-    statements supplied via configuration. TearDown reverts tuning settings by
+    statements supplied via configuration. The job table is partitioned, so
+    statements altering it are applied to each of its partitions. TearDown reverts tuning settings by
     executing any Postgres tuning revert SQL statements, then truncates all tables.
     When the HotColdSplit feature toggle is enabled, InitialiseSchema additionally
     applies the hot/cold split migration (sql/hotcold_up.sql), which creates the
@@ -19,15 +19,17 @@ var partitionRevertSQL string
 // then creates daily partitions covering all configured jobAgeDays plus
 // a buffer for live ingestion, plus a DEFAULT partition.
 func (p *PostgresDatabase) applyPartitionMigration(ctx context.Context, jobAgeDays []int) error {
-	// Check whether the job table is already partitioned (relkind 'p').
-	// If so, skip the one-time conversion and just ensure partitions exist.
-	var relkind string
+	// Check whether the job table is already range-partitioned (partstrat
+	// 'r'). The Lookout migrations leave it list-partitioned by state, which
+	// still needs converting. If so, skip the one-time conversion and just
+	// ensure partitions exist.
+	var partstrat string
 	if err := p.pool.QueryRow(ctx,
-		"SELECT relkind::text FROM pg_class WHERE relname = 'job'").Scan(&relkind); err != nil {
-		return fmt.Errorf("checking job table type: %w", err)
+		"SELECT partstrat::text FROM pg_partitioned_table WHERE partrelid = 'job'::regclass").Scan(&partstrat); err != nil {
+		return fmt.Errorf("checking job table partitioning: %w", err)
 	}
 
-	if relkind != "p" {
+	if partstrat != "r" {
 		if _, err := p.pool.Exec(ctx, partitionMigrationSQL); err != nil {
 			return fmt.Errorf("applying partition migration: %w", err)
 		}
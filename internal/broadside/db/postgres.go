@@ -104,16 +104,9 @@ func (p *PostgresDatabase) InitialiseSchema(ctx context.Context) error {
 		logging.Info("Partition-by-submitted migration applied")
 	}
 
-	if p.features.PartitionBySubmitted {
-		if err := p.applyTuningSQLToPartitions(ctx); err != nil {
-			pool.Close()
-			return fmt.Errorf("applying tuning SQL to partitions: %w", err)
-		}
-	} else {
-		if err := p.applyTuningSQL(ctx); err != nil {
-			pool.Close()
-			return fmt.Errorf("applying tuning SQL: %w", err)
-		}
+	if err := p.applyTuningSQLToPartitions(ctx); err != nil {
+		pool.Close()
+		return fmt.Errorf("applying tuning SQL to partitions: %w", err)
 	}
 
 	decompressor := &compress.NoOpDecompressor{}
@@ -133,29 +126,11 @@ func (p *PostgresDatabase) InitialiseSchema(ctx context.Context) error {
 	return nil
 }
 
-func (p *PostgresDatabase) applyTuningSQL(ctx context.Context) error {
-	for i, stmt := range p.tuningSQLStatements {
-		if _, err := p.pool.Exec(ctx, stmt); err != nil {
-			return fmt.Errorf("executing tuning SQL statement %d: %w", i+1, err)
-		}
-		logging.Infof("Applied tuning SQL statement %d", i+1)
-	}
-	return nil
-}
-
-func (p *PostgresDatabase) revertTuningSQL(ctx context.Context) error {
-	for i, stmt := range p.tuningRevertSQLStatements {
-		if _, err := p.pool.Exec(ctx, stmt); err != nil {
-			return fmt.Errorf("executing tuning revert SQL statement %d: %w", i+1, err)
-		}
-		logging.Infof("Executed tuning revert SQL statement %d", i+1)
-	}
-	return nil
-}
-
 // applyTuningSQLToPartitions applies tuning SQL to leaf partitions of the
 // job table rather than the partitioned parent (which doesn't support storage
-// parameters). Statements targeting other tables are applied as-is.
+// parameters). The job table is always partitioned, by state after the Lookout
+// migrations and by submitted when PartitionBySubmitted is enabled. Statements
+// targeting other tables are applied as-is.
 func (p *PostgresDatabase) applyTuningSQLToPartitions(ctx context.Context) error {
 	return p.execTuningSQLOnPartitions(ctx, p.tuningSQLStatements, "applying")
 }
@@ -401,14 +376,8 @@ func (p *PostgresDatabase) GetJobGroups(ctx *context.Context, filters []*model.F
 // truncated and the hot/cold migration is reverted so the schema is left
 // in its original state.
 func (p *PostgresDatabase) TearDown(ctx context.Context) error {
-	if p.features.PartitionBySubmitted {
-		if err := p.revertTuningSQLFromPartitions(ctx); err != nil {
-			return fmt.Errorf("reverting tuning SQL from partitions: %w", err)
-		}
-	} else {
-		if err := p.revertTuningSQL(ctx); err != nil {
-			return fmt.Errorf("reverting tuning SQL: %w", err)
-		}
+	if err := p.revertTuningSQLFromPartitions(ctx); err != nil {
+		return fmt.Errorf("reverting tuning SQL from partitions: %w", err)
 	}
 
 	tables := []string{
@@ -606,7 +575,8 @@ INSERT INTO %s (
     cpu, memory, ephemeral_storage, gpu, priority,
     submitted, state, last_transition_time, last_transition_time_seconds,
     priority_class, annotations, latest_run_id,
-    cancelled, cancel_reason, cancel_user
+    cancelled, cancel_reason, cancel_user,
+    run_count, first_leased
 )
 SELECT
     '%s' || lpad(i::text, 10, '0'),
@@ -634,7 +604,9 @@ SELECT
     CASE WHEN i%%1000 >= %d AND i%%1000 < %d
          THEN 'user requested' END,
     CASE WHEN i%%1000 >= %d AND i%%1000 < %d
-         THEN '%s' END
+         THEN '%s' END,
+    1,
+    t.base_time + INTERVAL '1 second'
 FROM generate_series(%d, %d) AS i,
 LATERAL (SELECT NOW() - (%s)[i%%%d+1] * INTERVAL '1 day' AS base_time) AS t`,
 		jobTable,
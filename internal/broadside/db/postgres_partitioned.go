@@ -233,7 +233,9 @@ func (p *PostgresDatabase) updateJobsPartitioned(ctx context.Context, instructio
 			duplicate                    bool,
 			latest_run_id                varchar(36),
 			cancel_reason                varchar(512),
-			cancel_user                  varchar(512)
+			cancel_user                  varchar(512),
+			runs_leased                  int,
+			first_leased                 timestamp
 		) ON COMMIT DROP`, tmpTable)); err != nil {
 		return fmt.Errorf("creating temp table: %w", err)
 	}
@@ -244,6 +246,7 @@ func (p *PostgresDatabase) updateJobsPartitioned(ctx context.Context, instructio
 			"job_id", "submitted", "priority", "state", "cancelled",
 			"last_transition_time", "last_transition_time_seconds",
 			"duplicate", "latest_run_id", "cancel_reason", "cancel_user",
+			"runs_leased", "first_leased",
 		},
 		pgx.CopyFromSlice(len(instructions), func(i int) ([]interface{}, error) {
 			instr := instructions[i]
@@ -252,6 +255,7 @@ func (p *PostgresDatabase) updateJobsPartitioned(ctx context.Context, instructio
 				instr.Priority, instr.State, instr.Cancelled,
 				instr.LastTransitionTime, instr.LastTransitionTimeSeconds,
 				instr.Duplicate, instr.LatestRunId, instr.CancelReason, instr.CancelUser,
+				instr.RunsLeased, instr.FirstLeased,
 			}, nil
 		}),
 	); err != nil {
@@ -269,7 +273,9 @@ func (p *PostgresDatabase) updateJobsPartitioned(ctx context.Context, instructio
 			duplicate                    = coalesce(tmp.duplicate, job.duplicate),
 			latest_run_id                = coalesce(tmp.latest_run_id, job.latest_run_id),
 			cancel_reason                = coalesce(tmp.cancel_reason, job.cancel_reason),
-			cancel_user                  = coalesce(tmp.cancel_user, job.cancel_user)
+			cancel_user                  = coalesce(tmp.cancel_user, job.cancel_user),
+			run_count                    = job.run_count + CASE WHEN tmp.latest_run_id IS DISTINCT FROM job.latest_run_id THEN coalesce(tmp.runs_leased, 0) ELSE 0 END,
+			first_leased                 = coalesce(job.first_leased, tmp.first_leased)
 		FROM %s AS tmp
 		WHERE tmp.job_id = job.job_id AND tmp.submitted = job.submitted`, tmpTable)); err != nil {
 		return fmt.Errorf("updating jobs from temp table: %w", err)
@@ -327,7 +333,8 @@ INSERT INTO %s (
     cpu, memory, ephemeral_storage, gpu, priority,
     submitted, state, last_transition_time, last_transition_time_seconds,
     priority_class, annotations, latest_run_id,
-    cancelled, cancel_reason, cancel_user
+    cancelled, cancel_reason, cancel_user,
+    run_count, first_leased
 )
 SELECT
     '%s' || lpad(i::text, 10, '0'),
@@ -355,7 +362,9 @@ SELECT
     CASE WHEN i%%1000 >= %d AND i%%1000 < %d
          THEN 'user requested' END,
     CASE WHEN i%%1000 >= %d AND i%%1000 < %d
-         THEN '%s' END
+         THEN '%s' END,
+    1,
+    %s + INTERVAL '1 second'
 FROM generate_series(%d, %d) AS i
 %s
 ON CONFLICT DO NOTHING`,
@@ -381,6 +390,7 @@ ON CONFLICT DO NOTHING`,
 		errored, cancelled, baseTimeExpr,
 		errored, cancelled,
 		errored, cancelled, params.QueueName,
+		baseTimeExpr,
 		startIdx, lastIdx,
 		bucketFilter,
 	)
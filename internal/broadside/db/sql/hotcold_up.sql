@@ -29,6 +29,8 @@
 --   005: annotations
 --   014: external_job_uri
 --   015: cancel_user
+--   037: suspended
+--   039: run_count, first_leased
 -- The UNION ALL view uses SELECT *, which matches columns positionally.
 BEGIN;
 
@@ -56,6 +58,9 @@ CREATE TABLE IF NOT EXISTS job_historical (
     annotations                  jsonb         NOT NULL DEFAULT '{}'::jsonb,
     external_job_uri             varchar(1024) NULL,
     cancel_user                  varchar(512)  NULL,
+    suspended                    bool          NOT NULL DEFAULT false,
+    run_count                    int           NOT NULL DEFAULT 0,
+    first_leased                 timestamp     NULL,
     CONSTRAINT chk_job_historical_terminal_state
         CHECK (state IN (4, 5, 6, 7, 9))
 );
@@ -72,7 +77,8 @@ WITH moved AS (
         submitted, cancelled, state,
         last_transition_time, last_transition_time_seconds,
         job_spec, duplicate, priority_class, latest_run_id,
-        cancel_reason, namespace, annotations, external_job_uri, cancel_user
+        cancel_reason, namespace, annotations, external_job_uri, cancel_user,
+        suspended, run_count, first_leased
 )
 INSERT INTO job_historical (
     job_id, queue, owner, jobset,
@@ -80,7 +86,8 @@ INSERT INTO job_historical (
     submitted, cancelled, state,
     last_transition_time, last_transition_time_seconds,
     job_spec, duplicate, priority_class, latest_run_id,
-    cancel_reason, namespace, annotations, external_job_uri, cancel_user
+    cancel_reason, namespace, annotations, external_job_uri, cancel_user,
+    suspended, run_count, first_leased
 )
 SELECT
     job_id, queue, owner, jobset,
@@ -88,7 +95,8 @@ SELECT
     submitted, cancelled, state,
     last_transition_time, last_transition_time_seconds,
     job_spec, duplicate, priority_class, latest_run_id,
-    cancel_reason, namespace, COALESCE(annotations, '{}'::jsonb), external_job_uri, cancel_user
+    cancel_reason, namespace, COALESCE(annotations, '{}'::jsonb), external_job_uri, cancel_user,
+    suspended, run_count, first_leased
 FROM moved;
 
 -- Step 3: add a CHECK constraint to job restricting it to active states.
@@ -131,14 +139,14 @@ CREATE OR REPLACE VIEW job_all AS
            priority, submitted, cancelled, state, last_transition_time,
            last_transition_time_seconds, job_spec, duplicate, priority_class,
            latest_run_id, cancel_reason, namespace, annotations,
-           external_job_uri, cancel_user
+           external_job_uri, cancel_user, suspended, run_count, first_leased
     FROM job
     UNION ALL
     SELECT job_id, queue, owner, jobset, cpu, memory, ephemeral_storage, gpu,
            priority, submitted, cancelled, state, last_transition_time,
            last_transition_time_seconds, job_spec, duplicate, priority_class,
            latest_run_id, cancel_reason, namespace, annotations,
-           external_job_uri, cancel_user
+           external_job_uri, cancel_user, suspended, run_count, first_leased
     FROM job_historical;
 
 COMMIT;
@@ -36,7 +36,10 @@ CREATE TABLE job (
     namespace                    varchar(512)  NULL,
     annotations                  jsonb         NOT NULL DEFAULT '{}'::jsonb,
     external_job_uri             varchar(1024) NULL,
-    cancel_user                  varchar(512)  NULL
+    cancel_user                  varchar(512)  NULL,
+    suspended                    bool          NOT NULL DEFAULT false,
+    run_count                    int           NOT NULL DEFAULT 0,
+    first_leased                 timestamp     NULL
 );
 
 ALTER TABLE job ALTER COLUMN job_spec SET STORAGE EXTERNAL;
@@ -55,6 +55,9 @@ CREATE TABLE job (
     annotations                  jsonb         NOT NULL DEFAULT '{}'::jsonb,
     external_job_uri             varchar(1024) NULL,
     cancel_user                  varchar(512)  NULL,
+    suspended                    bool          NOT NULL DEFAULT false,
+    run_count                    int           NOT NULL DEFAULT 0,
+    first_leased                 timestamp     NULL,
     PRIMARY KEY (job_id, submitted)
 ) PARTITION BY RANGE (submitted);
 
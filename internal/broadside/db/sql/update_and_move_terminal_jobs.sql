@@ -44,7 +44,8 @@ moved AS (
         j.job_spec, j.duplicate, j.priority_class, j.latest_run_id,
         COALESCE(u.new_cancel_reason, j.cancel_reason)         AS cancel_reason,
         j.namespace, COALESCE(j.annotations, '{}'::jsonb) AS annotations, j.external_job_uri,
-        COALESCE(u.new_cancel_user, j.cancel_user)             AS cancel_user
+        COALESCE(u.new_cancel_user, j.cancel_user)             AS cancel_user,
+        j.suspended, j.run_count, j.first_leased
 )
 INSERT INTO job_historical (
     job_id, queue, owner, jobset,
@@ -52,7 +53,8 @@ INSERT INTO job_historical (
     submitted, cancelled, state,
     last_transition_time, last_transition_time_seconds,
     job_spec, duplicate, priority_class, latest_run_id,
-    cancel_reason, namespace, annotations, external_job_uri, cancel_user
+    cancel_reason, namespace, annotations, external_job_uri, cancel_user,
+    suspended, run_count, first_leased
 )
 SELECT
     job_id, queue, owner, jobset,
@@ -60,5 +62,6 @@ SELECT
     submitted, cancelled, state,
     last_transition_time, last_transition_time_seconds,
     job_spec, duplicate, priority_class, latest_run_id,
-    cancel_reason, namespace, annotations, external_job_uri, cancel_user
+    cancel_reason, namespace, annotations, external_job_uri, cancel_user,
+    suspended, run_count, first_leased
 FROM moved;
@@ -0,0 +1,73 @@
+package blobstore
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+const (
+	FilesystemType = "filesystem"
+	S3Type         = "s3"
+)
+
+// ErrNotFound is returned by BlobStore.Get when no blob exists for the given key.
+var ErrNotFound = errors.New("blob not found")
+
+// BlobStore stores opaque blobs by key.
+type BlobStore interface {
+	// Put stores data under the given key, replacing any existing blob, and returns a URI identifying its location.
+	Put(ctx *armadacontext.Context, key string, data []byte) (string, error)
+	// Get returns the blob stored under the given key, or ErrNotFound if there is none.
+	Get(ctx *armadacontext.Context, key string) ([]byte, error)
+	// List returns the keys of the blobs whose keys start with the given prefix, in order.
+	List(ctx *armadacontext.Context, prefix string) ([]string, error)
+}
+
+type Config struct {
+	// Type of the store; either "filesystem" or "s3".
+	Type       string `validate:"omitempty,oneof=filesystem s3"`
+	Filesystem FilesystemConfig
+	S3         S3Config
+}
+
+type FilesystemConfig struct {
+	// Directory under which blobs are written.
+	Directory string
+}
+
+type S3Config struct {
+	// Host, and optionally port, of the S3-compatible service, e.g., s3.eu-west-2.amazonaws.com.
+	Endpoint string
+	Region   string
+	Bucket   string
+	// Prefix is prepended to the keys of blobs to give the names of their objects, so that several stores can share a
+	// bucket.
+	Prefix string
+	// Credentials to access the bucket with. If empty, credentials are read from the standard AWS environment
+	// variables and credentials file, or from the IAM role of the instance.
+	AccessKeyId     string
+	SecretAccessKey string
+	// Insecure connects over plain http rather than https, e.g., for testing against a local service.
+	Insecure bool
+}
+
+func New(config Config) (BlobStore, error) {
+	switch config.Type {
+	case FilesystemType:
+		return NewFilesystemBlobStore(config.Filesystem.Directory)
+	case S3Type:
+		return NewS3BlobStore(config.S3)
+	default:
+		return nil, errors.Errorf("unknown blob store type %q", config.Type)
+	}
+}
+
+// PodLogKey returns the key under which the captured logs of a container are stored.
+// The key doesn't include the run, so the logs of a failed run replace those of any earlier run of the same pod.
+func PodLogKey(namespace string, podName string, containerName string) string {
+	return path.Join("pod-logs", namespace, podName, fmt.Sprintf("%s.log", containerName))
+}
@@ -0,0 +1,203 @@
+package blobstore
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+func TestFilesystemBlobStore_PutGet(t *testing.T) {
+	store, err := NewFilesystemBlobStore(t.TempDir())
+	require.NoError(t, err)
+	ctx := armadacontext.Background()
+
+	location, err := store.Put(ctx, "a/b/c.log", []byte("hello"))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(location, "file://"))
+	assert.True(t, strings.HasSuffix(location, "/a/b/c.log"))
+
+	data, err := store.Get(ctx, "a/b/c.log")
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(data))
+
+	_, err = store.Put(ctx, "a/b/c.log", []byte("replaced"))
+	require.NoError(t, err)
+	data, err = store.Get(ctx, "a/b/c.log")
+	require.NoError(t, err)
+	assert.Equal(t, "replaced", string(data))
+}
+
+func TestFilesystemBlobStore_GetMissing(t *testing.T) {
+	store, err := NewFilesystemBlobStore(t.TempDir())
+	require.NoError(t, err)
+
+	_, err = store.Get(armadacontext.Background(), "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestFilesystemBlobStore_RejectsKeysOutsideDirectory(t *testing.T) {
+	store, err := NewFilesystemBlobStore(t.TempDir())
+	require.NoError(t, err)
+
+	_, err = store.Put(armadacontext.Background(), "../escaped", []byte("data"))
+	assert.Error(t, err)
+	_, err = store.Get(armadacontext.Background(), "../escaped")
+	assert.Error(t, err)
+}
+
+func TestFilesystemBlobStore_List(t *testing.T) {
+	store, err := NewFilesystemBlobStore(t.TempDir())
+	require.NoError(t, err)
+	ctx := armadacontext.Background()
+	for _, key := range []string{"jobs/date=2024-03-02/b", "jobs/date=2024-03-01/b", "jobs/date=2024-03-01/a", "runs/a"} {
+		_, err := store.Put(ctx, key, []byte("data"))
+		require.NoError(t, err)
+	}
+
+	keys, err := store.List(ctx, "jobs/date=2024-03-01/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"jobs/date=2024-03-01/a", "jobs/date=2024-03-01/b"}, keys)
+
+	keys, err = store.List(ctx, "jobs/date=2024-03")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"jobs/date=2024-03-01/a", "jobs/date=2024-03-01/b", "jobs/date=2024-03-02/b"}, keys)
+
+	keys, err = store.List(ctx, "")
+	require.NoError(t, err)
+	assert.Len(t, keys, 4)
+
+	keys, err = store.List(ctx, "missing/")
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	_, err = store.List(ctx, "../")
+	assert.Error(t, err)
+}
+
+func TestS3BlobStore_PrefixAndList(t *testing.T) {
+	var objectNames []string
+	var mu sync.Mutex
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == http.MethodPut:
+			objectNames = append(objectNames, strings.TrimPrefix(r.URL.Path, "/archive/"))
+		case r.Method == http.MethodGet && r.URL.Query().Get("list-type") == "2":
+			prefix := r.URL.Query().Get("prefix")
+			var contents strings.Builder
+			for _, name := range objectNames {
+				if strings.HasPrefix(name, prefix) {
+					contents.WriteString("<Contents><Key>" + name + "</Key><Size>4</Size></Contents>")
+				}
+			}
+			w.Header().Set("Content-Type", "application/xml")
+			_, _ = w.Write([]byte(`<ListBucketResult><Name>archive</Name><IsTruncated>false</IsTruncated>` + contents.String() + `</ListBucketResult>`))
+		default:
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	defer server.Close()
+
+	store, err := newS3BlobStore(S3Config{
+		Endpoint:        strings.TrimPrefix(server.URL, "https://"),
+		Region:          "eu-west-2",
+		Bucket:          "archive",
+		Prefix:          "lookout/",
+		AccessKeyId:     "key-id",
+		SecretAccessKey: "secret",
+	}, server.Client().Transport)
+	require.NoError(t, err)
+	ctx := armadacontext.Background()
+
+	location, err := store.Put(ctx, "jobs/date=2024-03-01/b", []byte("data"))
+	require.NoError(t, err)
+	assert.Equal(t, "s3://archive/lookout/jobs/date=2024-03-01/b", location)
+	_, err = store.Put(ctx, "jobs/date=2024-03-01/a", []byte("data"))
+	require.NoError(t, err)
+	_, err = store.Put(ctx, "runs/date=2024-03-01/a", []byte("data"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"lookout/jobs/date=2024-03-01/b", "lookout/jobs/date=2024-03-01/a", "lookout/runs/date=2024-03-01/a"}, objectNames)
+
+	keys, err := store.List(ctx, "jobs/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"jobs/date=2024-03-01/a", "jobs/date=2024-03-01/b"}, keys)
+}
+
+func TestS3BlobStore_PutGet(t *testing.T) {
+	objects := map[string][]byte{}
+	var mu sync.Mutex
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=key-id/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodPut:
+			data, _ := io.ReadAll(r.Body)
+			objects[r.URL.Path] = data
+		case http.MethodGet:
+			data, ok := objects[r.URL.Path]
+			if !ok {
+				w.Header().Set("Content-Type", "application/xml")
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`<Error><Code>NoSuchKey</Code></Error>`))
+				return
+			}
+			w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+			_, _ = w.Write(data)
+		}
+	}))
+	defer server.Close()
+
+	store, err := newS3BlobStore(S3Config{
+		Endpoint:        strings.TrimPrefix(server.URL, "https://"),
+		Region:          "eu-west-2",
+		Bucket:          "logs",
+		AccessKeyId:     "key-id",
+		SecretAccessKey: "secret",
+	}, server.Client().Transport)
+	require.NoError(t, err)
+	ctx := armadacontext.Background()
+
+	location, err := store.Put(ctx, "pod-logs/ns/pod/main.log", []byte("hello"))
+	require.NoError(t, err)
+	assert.Equal(t, "s3://logs/pod-logs/ns/pod/main.log", location)
+	assert.Equal(t, "hello", string(objects["/logs/pod-logs/ns/pod/main.log"]))
+
+	data, err := store.Get(ctx, "pod-logs/ns/pod/main.log")
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(data))
+
+	_, err = store.Get(ctx, "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestNewS3BlobStore_RequiresBucket(t *testing.T) {
+	_, err := NewS3BlobStore(S3Config{Endpoint: "s3.eu-west-2.amazonaws.com", Region: "eu-west-2"})
+	assert.Error(t, err)
+}
+
+func TestNew(t *testing.T) {
+	store, err := New(Config{Type: FilesystemType, Filesystem: FilesystemConfig{Directory: t.TempDir()}})
+	require.NoError(t, err)
+	assert.IsType(t, &FilesystemBlobStore{}, store)
+
+	_, err = New(Config{Type: "unknown"})
+	assert.Error(t, err)
+}
+
+func TestPodLogKey(t *testing.T) {
+	assert.Equal(t, "pod-logs/namespace/pod/main.log", PodLogKey("namespace", "pod", "main"))
+}
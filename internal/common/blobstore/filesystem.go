@@ -0,0 +1,112 @@
+package blobstore
+
+import (
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+// FilesystemBlobStore stores blobs as files under a directory, e.g., on a shared volume.
+type FilesystemBlobStore struct {
+	directory string
+}
+
+func NewFilesystemBlobStore(directory string) (*FilesystemBlobStore, error) {
+	if directory == "" {
+		return nil, errors.New("filesystem blob store requires a directory")
+	}
+	absDirectory, err := filepath.Abs(directory)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &FilesystemBlobStore{directory: absDirectory}, nil
+}
+
+func (s *FilesystemBlobStore) Put(_ *armadacontext.Context, key string, data []byte) (string, error) {
+	filePath, err := s.filePath(key)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return "", errors.WithStack(err)
+	}
+	// Write to a temporary file and rename it, so readers never see a partially written blob.
+	tmpFile, err := os.CreateTemp(filepath.Dir(filePath), ".tmp-")
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	defer os.Remove(tmpFile.Name())
+	if _, err := tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		return "", errors.WithStack(err)
+	}
+	if err := tmpFile.Close(); err != nil {
+		return "", errors.WithStack(err)
+	}
+	if err := os.Rename(tmpFile.Name(), filePath); err != nil {
+		return "", errors.WithStack(err)
+	}
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(filePath)}).String(), nil
+}
+
+func (s *FilesystemBlobStore) Get(_ *armadacontext.Context, key string) ([]byte, error) {
+	filePath, err := s.filePath(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return data, errors.WithStack(err)
+}
+
+func (s *FilesystemBlobStore) List(_ *armadacontext.Context, prefix string) ([]string, error) {
+	// Walk the deepest directory containing all keys with the prefix
+	dir := filepath.Join(s.directory, filepath.FromSlash(path.Dir(prefix+"x")))
+	if dir != s.directory && !strings.HasPrefix(dir, s.directory+string(filepath.Separator)) {
+		return nil, errors.Errorf("invalid blob key prefix %q", prefix)
+	}
+	var keys []string
+	err := filepath.WalkDir(dir, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".tmp-") {
+			return nil
+		}
+		rel, err := filepath.Rel(s.directory, filePath)
+		if err != nil {
+			return err
+		}
+		if key := filepath.ToSlash(rel); strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// filePath returns the path of the file for the given key, rejecting keys that would escape the store's directory.
+func (s *FilesystemBlobStore) filePath(key string) (string, error) {
+	filePath := filepath.Join(s.directory, filepath.FromSlash(key))
+	if !strings.HasPrefix(filePath, s.directory+string(filepath.Separator)) {
+		return "", errors.Errorf("invalid blob key %q", key)
+	}
+	return filePath, nil
+}
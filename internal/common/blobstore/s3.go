@@ -0,0 +1,103 @@
+package blobstore
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+// S3BlobStore stores blobs as objects in a bucket of an S3-compatible service, such as AWS S3, MinIO or Ceph.
+type S3BlobStore struct {
+	client *minio.Client
+	bucket string
+	prefix string
+}
+
+func NewS3BlobStore(config S3Config) (*S3BlobStore, error) {
+	return newS3BlobStore(config, nil)
+}
+
+// newS3BlobStore creates a store whose client uses the given transport, or the default transport if nil.
+func newS3BlobStore(config S3Config, transport http.RoundTripper) (*S3BlobStore, error) {
+	if config.Endpoint == "" || config.Bucket == "" || config.Region == "" {
+		return nil, errors.New("s3 blob store requires an endpoint, region and bucket")
+	}
+	creds := credentials.NewChainCredentials([]credentials.Provider{
+		&credentials.EnvAWS{},
+		&credentials.FileAWSCredentials{},
+		&credentials.IAM{Client: &http.Client{Transport: http.DefaultTransport}},
+	})
+	if config.AccessKeyId != "" {
+		creds = credentials.NewStaticV4(config.AccessKeyId, config.SecretAccessKey, "")
+	}
+	client, err := minio.New(config.Endpoint, &minio.Options{
+		Creds:     creds,
+		Secure:    !config.Insecure,
+		Region:    config.Region,
+		Transport: transport,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error creating s3 client for endpoint %q", config.Endpoint)
+	}
+	return &S3BlobStore{client: client, bucket: config.Bucket, prefix: config.Prefix}, nil
+}
+
+func (s *S3BlobStore) Put(ctx *armadacontext.Context, key string, data []byte) (string, error) {
+	objectName := s.objectName(key)
+	_, err := s.client.PutObject(ctx, s.bucket, objectName, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{})
+	if err != nil {
+		return "", errors.Wrapf(err, "error putting %s/%s", s.bucket, objectName)
+	}
+	return fmt.Sprintf("s3://%s/%s", s.bucket, objectName), nil
+}
+
+func (s *S3BlobStore) Get(ctx *armadacontext.Context, key string) ([]byte, error) {
+	object, err := s.client.GetObject(ctx, s.bucket, s.objectName(key), minio.GetObjectOptions{})
+	if err != nil {
+		return nil, s.getError(err, key)
+	}
+	defer object.Close()
+	// The object is only requested once it's first read, so a missing object is only reported here.
+	data, err := io.ReadAll(object)
+	if err != nil {
+		return nil, s.getError(err, key)
+	}
+	return data, nil
+}
+
+func (s *S3BlobStore) List(ctx *armadacontext.Context, prefix string) ([]string, error) {
+	var keys []string
+	objects := s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: s.objectName(prefix), Recursive: true})
+	for object := range objects {
+		if object.Err != nil {
+			return nil, errors.Wrapf(object.Err, "error listing %s/%s", s.bucket, s.objectName(prefix))
+		}
+		keys = append(keys, strings.TrimPrefix(object.Key, s.objectName("")))
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (s *S3BlobStore) getError(err error, key string) error {
+	if minio.ToErrorResponse(err).StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+	return errors.Wrapf(err, "error getting %s/%s", s.bucket, s.objectName(key))
+}
+
+// objectName returns the name of the object a blob is stored in
+func (s *S3BlobStore) objectName(key string) string {
+	if s.prefix == "" {
+		return key
+	}
+	return strings.TrimSuffix(s.prefix, "/") + "/" + key
+}
@@ -24,26 +24,54 @@ type ConfigKubernetesClientProvider struct {
 }
 
 func NewKubernetesClientProvider(impersonateUsers bool, qps float32, burst int) (*ConfigKubernetesClientProvider, error) {
+	if err := validateRateLimits(qps, burst); err != nil {
+		return nil, err
+	}
+	restConfig, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+	return newConfigKubernetesClientProvider(restConfig, impersonateUsers, qps, burst)
+}
+
+// NewKubernetesClientProviderForKubeConfig returns a provider for the cluster described by the given kubeconfig file and context.
+// If kubeConfigPath is empty the default kubeconfig loading rules are used, and if kubeContext is empty the current context is used.
+func NewKubernetesClientProviderForKubeConfig(
+	kubeConfigPath string,
+	kubeContext string,
+	impersonateUsers bool,
+	qps float32,
+	burst int,
+) (*ConfigKubernetesClientProvider, error) {
+	if err := validateRateLimits(qps, burst); err != nil {
+		return nil, err
+	}
+	restConfig, err := loadKubeConfig(kubeConfigPath, kubeContext)
+	if err != nil {
+		return nil, err
+	}
+	return newConfigKubernetesClientProvider(restConfig, impersonateUsers, qps, burst)
+}
+
+func validateRateLimits(qps float32, burst int) error {
 	if qps == 0 {
-		return nil, errors.WithStack(&armadaerrors.ErrInvalidArgument{
+		return errors.WithStack(&armadaerrors.ErrInvalidArgument{
 			Name:    "qps",
 			Value:   qps,
 			Message: "qps must be positive",
 		})
 	}
 	if burst == 0 {
-		return nil, errors.WithStack(&armadaerrors.ErrInvalidArgument{
+		return errors.WithStack(&armadaerrors.ErrInvalidArgument{
 			Name:    "burst",
 			Value:   burst,
 			Message: "burst must be positive",
 		})
 	}
+	return nil
+}
 
-	restConfig, err := loadConfig()
-	if err != nil {
-		return nil, err
-	}
-
+func newConfigKubernetesClientProvider(restConfig *rest.Config, impersonateUsers bool, qps float32, burst int) (*ConfigKubernetesClientProvider, error) {
 	// Use a shared rate limiter for all clients created by this provider.
 	// This limits the total number of concurrent calls across all clients to burst
 	// and the total number of calls per second to qps.
@@ -90,3 +118,16 @@ func loadConfig() (*rest.Config, error) {
 	log.Info("Running with in cluster client configuration")
 	return config, err
 }
+
+func loadKubeConfig(kubeConfigPath string, kubeContext string) (*rest.Config, error) {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	if kubeConfigPath != "" {
+		rules.ExplicitPath = kubeConfigPath
+	}
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: kubeContext}
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, overrides).ClientConfig()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load kubeconfig %q with context %q", kubeConfigPath, kubeContext)
+	}
+	return config, nil
+}
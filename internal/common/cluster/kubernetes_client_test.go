@@ -0,0 +1,55 @@
+package cluster
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testKubeConfig = `apiVersion: v1
+kind: Config
+current-context: cluster-1
+clusters:
+- name: cluster-1
+  cluster:
+    server: https://cluster-1.example.com
+- name: cluster-2
+  cluster:
+    server: https://cluster-2.example.com
+contexts:
+- name: cluster-1
+  context:
+    cluster: cluster-1
+    user: user
+- name: cluster-2
+  context:
+    cluster: cluster-2
+    user: user
+users:
+- name: user
+  user:
+    token: token
+`
+
+func TestNewKubernetesClientProviderForKubeConfig(t *testing.T) {
+	kubeConfigPath := filepath.Join(t.TempDir(), "config")
+	require.NoError(t, os.WriteFile(kubeConfigPath, []byte(testKubeConfig), 0o600))
+
+	provider, err := NewKubernetesClientProviderForKubeConfig(kubeConfigPath, "", false, 10, 10)
+	require.NoError(t, err)
+	assert.Equal(t, "https://cluster-1.example.com", provider.ClientConfig().Host)
+
+	provider, err = NewKubernetesClientProviderForKubeConfig(kubeConfigPath, "cluster-2", false, 10, 10)
+	require.NoError(t, err)
+	assert.Equal(t, "https://cluster-2.example.com", provider.ClientConfig().Host)
+	assert.NotNil(t, provider.ClientConfig().RateLimiter)
+
+	_, err = NewKubernetesClientProviderForKubeConfig(kubeConfigPath, "missing", false, 10, 10)
+	assert.Error(t, err)
+
+	_, err = NewKubernetesClientProviderForKubeConfig(kubeConfigPath, "", false, 0, 10)
+	assert.Error(t, err)
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strings"
@@ -21,6 +22,13 @@ var CustomHooks = []viper.DecoderConfigOption{
 	addDecodeHook(StringConfigUnmarshalerHook()),
 }
 
+// KubernetesApiTypeHooks decode Kubernetes API types in config using their JSON field tags.
+// They aren't part of CustomHooks, so that only components whose config embeds these types, e.g., the executor's pod
+// templates, opt in to decoding them differently.
+var KubernetesApiTypeHooks = []viper.DecoderConfigOption{
+	addDecodeHook(KubernetesApiTypeDecodeHook()),
+}
+
 type StringConfigUnmarshaler interface {
 	UnmarshalConfigString(string) error
 }
@@ -116,6 +124,29 @@ func QuantityDecodeHook() mapstructure.DecodeHookFuncType {
 	}
 }
 
+// KubernetesApiTypeDecodeHook decodes Kubernetes API types, e.g., v1.Volume, using their JSON field tags.
+// Without it, fields inlined into these types, such as the volume source of a v1.Volume, can't be set from config.
+func KubernetesApiTypeDecodeHook() mapstructure.DecodeHookFuncType {
+	return func(
+		f reflect.Type,
+		t reflect.Type,
+		data interface{},
+	) (interface{}, error) {
+		if f.Kind() != reflect.Map || t.Kind() != reflect.Struct || !strings.HasPrefix(t.PkgPath(), "k8s.io/api/") {
+			return data, nil
+		}
+		jsonData, err := json.Marshal(data)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		result := reflect.New(t)
+		if err := json.Unmarshal(jsonData, result.Interface()); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode %s", t)
+		}
+		return result.Elem().Interface(), nil
+	}
+}
+
 func addDecodeHook(hook mapstructure.DecodeHookFuncType) viper.DecoderConfigOption {
 	return func(c *mapstructure.DecoderConfig) {
 		c.DecodeHook = mapstructure.ComposeDecodeHookFunc(
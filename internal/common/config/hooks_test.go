@@ -2,11 +2,14 @@ package config
 
 import (
 	"reflect"
+	"slices"
 	"testing"
 
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/go-viper/mapstructure/v2"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 )
 
@@ -165,6 +168,56 @@ func TestStringConfigUnmarshalerHook(t *testing.T) {
 	}
 }
 
+func TestKubernetesApiTypeDecodeHook(t *testing.T) {
+	tests := map[string]HookTest{
+		"inlined fields": {
+			value: map[string]interface{}{
+				"name":     "data",
+				"hostpath": map[string]interface{}{"path": "/data"},
+			},
+			expected: v1.Volume{
+				Name:         "data",
+				VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: "/data"}},
+			},
+		},
+		"invalid field type": {
+			value:       map[string]interface{}{"name": 1},
+			expectError: true,
+		},
+		"not map input": {
+			value:    "data",
+			expected: "data",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			runHookTest(t, tc, reflect.TypeOf(v1.Volume{}), KubernetesApiTypeDecodeHook())
+		})
+	}
+}
+
+func TestKubernetesApiTypeHooks_NotInCustomHooks(t *testing.T) {
+	input := map[string]interface{}{
+		"volumes": []interface{}{
+			map[string]interface{}{"name": "data", "hostpath": map[string]interface{}{"path": "/data"}},
+		},
+	}
+	decode := func(hooks []viper.DecoderConfigOption) []v1.Volume {
+		var config struct{ Volumes []v1.Volume }
+		decoderConfig := &mapstructure.DecoderConfig{Result: &config, DecodeHook: mapstructure.StringToTimeDurationHookFunc()}
+		for _, hook := range hooks {
+			hook(decoderConfig)
+		}
+		decoder, err := mapstructure.NewDecoder(decoderConfig)
+		assert.NoError(t, err)
+		assert.NoError(t, decoder.Decode(input))
+		return config.Volumes
+	}
+
+	assert.Nil(t, decode(CustomHooks)[0].HostPath)
+	assert.Equal(t, &v1.HostPathVolumeSource{Path: "/data"}, decode(append(slices.Clone(CustomHooks), KubernetesApiTypeHooks...))[0].HostPath)
+}
+
 func runHookTest(t *testing.T, tc HookTest, convertTo reflect.Type, hookFunc mapstructure.DecodeHookFuncType) {
 	parsed, err := hookFunc(reflect.TypeOf(tc.value), convertTo, tc.value)
 	if tc.expectError {
@@ -38,6 +38,10 @@ const (
 	// Event stream keys follow the pattern: "Events:{queue}:{jobSetId}"
 	EventStreamPrefix = "Events:"
 
+	// JobSecretQueuesAnnotation lists, comma-separated, the queues whose jobs may copy a secret held in an executor's
+	// job secret namespace. Secrets without it can't be copied by any job.
+	JobSecretQueuesAnnotation = "armadaproject.io/jobSecretQueues"
+
 	// ExternalJobUriAnnotation is the legacy annotation key for setting an external job URI.
 	// Prefer the ExternalJobUri proto field on JobSubmitRequestItem / SubmitJob instead.
 	ExternalJobUriAnnotation = "armadaproject.io/externalJobUri"
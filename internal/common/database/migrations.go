@@ -25,6 +25,17 @@ func NewMigration(id int, name string, sql string) Migration {
 	}
 }
 
+// MigrationsUpTo returns the migrations with ids up to and including the given version
+func MigrationsUpTo(migrations []Migration, version int) []Migration {
+	var upTo []Migration
+	for _, m := range migrations {
+		if m.id <= version {
+			upTo = append(upTo, m)
+		}
+	}
+	return upTo
+}
+
 func UpdateDatabase(ctx *armadacontext.Context, db Querier, migrations []Migration) error {
 	ctx.Info("Preparing to apply postgres migrations.")
 	version, err := readVersion(ctx, db)
@@ -0,0 +1,19 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigrationsUpTo(t *testing.T) {
+	migrations := []Migration{
+		NewMigration(47, "047_a.sql", ""),
+		NewMigration(48, "048_b.sql", ""),
+		NewMigration(49, "049_c.sql", ""),
+	}
+
+	assert.Equal(t, migrations[:2], MigrationsUpTo(migrations, 48))
+	assert.Equal(t, migrations, MigrationsUpTo(migrations, 100))
+	assert.Empty(t, MigrationsUpTo(migrations, 46))
+}
@@ -372,6 +372,47 @@ var JobValidated = &armadaevents.EventSequence_Event{
 	},
 }
 
+var UpdateQueuedJob = &armadaevents.EventSequence_Event{
+	Created: testfixtures.BasetimeProto,
+	Event: &armadaevents.EventSequence_Event_UpdateQueuedJob{
+		UpdateQueuedJob: &armadaevents.UpdateQueuedJob{
+			JobId:             JobId,
+			NodeSelector:      map[string]string{"foo": "bar"},
+			PriorityClassName: PriorityClassName,
+		},
+	},
+}
+
+var MoveJob = &armadaevents.EventSequence_Event{
+	Created: testfixtures.BasetimeProto,
+	Event: &armadaevents.EventSequence_Event_MoveJob{
+		MoveJob: &armadaevents.MoveJob{
+			JobId:               JobId,
+			DestinationQueue:    "destination-queue",
+			DestinationJobSetId: "destination-job-set",
+		},
+	},
+}
+
+var SuspendJob = &armadaevents.EventSequence_Event{
+	Created: testfixtures.BasetimeProto,
+	Event: &armadaevents.EventSequence_Event_SuspendJob{
+		SuspendJob: &armadaevents.SuspendJob{
+			JobId:  JobId,
+			Reason: "suspended for maintenance",
+		},
+	},
+}
+
+var ResumeJob = &armadaevents.EventSequence_Event{
+	Created: testfixtures.BasetimeProto,
+	Event: &armadaevents.EventSequence_Event_ResumeJob{
+		ResumeJob: &armadaevents.ResumeJob{
+			JobId: JobId,
+		},
+	},
+}
+
 var JobRequeued = &armadaevents.EventSequence_Event{
 	Created: testfixtures.BasetimeProto,
 	Event: &armadaevents.EventSequence_Event_JobRequeued{
@@ -706,6 +747,16 @@ var CancelOnNode = &controlplaneevents.Event{
 	},
 }
 
+var ReleaseNodeQuarantine = &controlplaneevents.Event{
+	Created: BaseTimeProto,
+	Event: &controlplaneevents.Event_ReleaseNodeQuarantine{
+		ReleaseNodeQuarantine: &controlplaneevents.ReleaseNodeQuarantine{
+			Name:     NodeName,
+			Executor: ExecutorId,
+		},
+	},
+}
+
 var CancelOnExecutorWithPools = &controlplaneevents.Event{
 	Event: &controlplaneevents.Event_CancelOnExecutor{
 		CancelOnExecutor: &controlplaneevents.CancelOnExecutor{
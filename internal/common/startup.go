@@ -31,6 +31,11 @@ func BindCommandlineArguments() {
 }
 
 func LoadConfig(config commonconfig.Config, defaultPath string, overrideConfigs []string) *viper.Viper {
+	return LoadConfigWithHooks(config, defaultPath, overrideConfigs)
+}
+
+// LoadConfigWithHooks loads config like LoadConfig, decoding it with the given hooks as well as the common ones.
+func LoadConfigWithHooks(config commonconfig.Config, defaultPath string, overrideConfigs []string, hooks ...viper.DecoderConfigOption) *viper.Viper {
 	v := viper.NewWithOptions(viper.KeyDelimiter("::"))
 	v.SetConfigName(baseConfigFileName)
 	v.AddConfigPath(defaultPath)
@@ -58,7 +63,8 @@ func LoadConfig(config commonconfig.Config, defaultPath string, overrideConfigs
 	v.AutomaticEnv()
 
 	var metadata mapstructure.Metadata
-	customHooks := append(slices.Clone(commonconfig.CustomHooks), func(c *mapstructure.DecoderConfig) { c.Metadata = &metadata })
+	customHooks := append(slices.Clone(commonconfig.CustomHooks), hooks...)
+	customHooks = append(customHooks, func(c *mapstructure.DecoderConfig) { c.Metadata = &metadata })
 	if err := v.Unmarshal(config, customHooks...); err != nil {
 		log.Fatal(err)
 	}
@@ -24,6 +24,7 @@ type task struct {
 type BackgroundTaskManager struct {
 	tasks         []*task
 	metricsPrefix string
+	registerer    prometheus.Registerer
 	wg            *sync.WaitGroup
 }
 
@@ -33,10 +34,18 @@ func NewBackgroundTaskManager(metricsPrefix string) *BackgroundTaskManager {
 	return &BackgroundTaskManager{
 		tasks:         []*task{},
 		metricsPrefix: metricsPrefix,
+		registerer:    prometheus.DefaultRegisterer,
 		wg:            &sync.WaitGroup{},
 	}
 }
 
+// WithRegisterer sets the registerer task metrics are registered with, in place of the default Prometheus registerer.
+// Must be called before any tasks are registered.
+func (m *BackgroundTaskManager) WithRegisterer(registerer prometheus.Registerer) *BackgroundTaskManager {
+	m.registerer = registerer
+	return m
+}
+
 // Register the function f to be run periodically.
 // Interval is the time between function returns and the next time it is called,
 // i.e., the time between calls to function is interval + the runtime of the function.
@@ -60,7 +69,7 @@ func (m *BackgroundTaskManager) StopAll(timeout time.Duration) bool {
 }
 
 func (m *BackgroundTaskManager) startBackgroundTask(task *task) {
-	taskDurationHistogram := promauto.NewHistogram(
+	taskDurationHistogram := promauto.With(m.registerer).NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    m.metricsPrefix + task.metricName + "_latency_seconds",
 			Help:    "Background loop " + task.metricName + " latency in seconds",
@@ -4,18 +4,22 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/go-playground/validator/v10"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
+	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/blobstore"
 	"github.com/armadaproject/armada/internal/common/cluster"
 	"github.com/armadaproject/armada/internal/common/etcdhealth"
 	"github.com/armadaproject/armada/internal/common/healthmonitor"
@@ -25,8 +29,10 @@ import (
 	"github.com/armadaproject/armada/internal/executor/categorizer"
 	"github.com/armadaproject/armada/internal/executor/configuration"
 	executor_context "github.com/armadaproject/armada/internal/executor/context"
+	fake_context "github.com/armadaproject/armada/internal/executor/fake/context"
 	"github.com/armadaproject/armada/internal/executor/job"
 	"github.com/armadaproject/armada/internal/executor/job/processors"
+	"github.com/armadaproject/armada/internal/executor/logcapture"
 	"github.com/armadaproject/armada/internal/executor/metrics"
 	"github.com/armadaproject/armada/internal/executor/metrics/pod_metrics"
 	"github.com/armadaproject/armada/internal/executor/metrics/runstate"
@@ -35,6 +41,7 @@ import (
 	"github.com/armadaproject/armada/internal/executor/podchecks/failedpodchecks"
 	"github.com/armadaproject/armada/internal/executor/reporter"
 	"github.com/armadaproject/armada/internal/executor/service"
+	util2 "github.com/armadaproject/armada/internal/executor/util"
 	"github.com/armadaproject/armada/internal/executor/utilisation"
 	"github.com/armadaproject/armada/pkg/client"
 	"github.com/armadaproject/armada/pkg/executorapi"
@@ -47,11 +54,7 @@ func StartUp(ctx *armadacontext.Context, config configuration.ExecutorConfigurat
 		os.Exit(-1)
 	}
 
-	kubernetesClientProvider, err := cluster.NewKubernetesClientProvider(
-		config.Kubernetes.ImpersonateUsers,
-		config.Kubernetes.QPS,
-		config.Kubernetes.Burst,
-	)
+	clusters, err := createClusters(config)
 	if err != nil {
 		ctx.Errorf("Failed to connect to kubernetes because %s", err)
 		os.Exit(-1)
@@ -60,9 +63,30 @@ func StartUp(ctx *armadacontext.Context, config configuration.ExecutorConfigurat
 	// Create an errgroup to run services in.
 	g, ctx := armadacontext.ErrGroup(ctx)
 
-	// Setup etcd health monitoring.
-	etcdClusterHealthMonitoringByName := make(map[string]healthmonitor.HealthMonitor, len(config.Kubernetes.Etcd.EtcdClustersHealthMonitoring))
-	for _, etcdClusterHealthMonitoring := range config.Kubernetes.Etcd.EtcdClustersHealthMonitoring {
+	// Setup etcd health monitoring; each cluster monitors its own etcd.
+	for _, executorCluster := range clusters {
+		executorCluster.healthMonitor = newEtcdHealthMonitor(executorCluster.config.Kubernetes.Etcd)
+		if executorCluster.healthMonitor == nil {
+			ctx.Infof("no etcd URLs provided for cluster %s; etcd health isn't monitored", executorCluster.config.Application.ClusterId)
+			continue
+		}
+		ctx.Infof("etcd URLs provided for cluster %s; monitoring etcd health enabled", executorCluster.config.Application.ClusterId)
+		healthMonitor := executorCluster.healthMonitor
+		g.Go(func() error { return healthMonitor.Run(ctx) })
+		executorCluster.metricsRegisterer.MustRegister(healthMonitor)
+	}
+
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+
+	return startUpClusters(ctx, config, clusters, wg)
+}
+
+// newEtcdHealthMonitor returns a health monitor for the etcd clusters of the given configuration,
+// or nil if no etcd clusters are configured.
+func newEtcdHealthMonitor(config configuration.EtcdConfiguration) healthmonitor.HealthMonitor {
+	etcdClusterHealthMonitoringByName := make(map[string]healthmonitor.HealthMonitor, len(config.EtcdClustersHealthMonitoring))
+	for _, etcdClusterHealthMonitoring := range config.EtcdClustersHealthMonitoring {
 		etcdReplicaHealthMonitorsByUrl := make(map[string]healthmonitor.HealthMonitor, len(etcdClusterHealthMonitoring.MetricUrls))
 		for _, metricsUrl := range etcdClusterHealthMonitoring.MetricUrls {
 			etcdReplicaHealthMonitorsByUrl[metricsUrl] = etcdhealth.NewEtcdReplicaHealthMonitor(
@@ -86,34 +110,112 @@ func StartUp(ctx *armadacontext.Context, config configuration.ExecutorConfigurat
 			etcdClusterHealthMonitoring.MinimumReplicasAvailable,
 		)
 	}
-	var etcdClustersHealthMonitoring healthmonitor.HealthMonitor
-	if len(etcdClusterHealthMonitoringByName) > 0 {
-		ctx.Info("etcd URLs provided; monitoring etcd health enabled")
-		etcdClustersHealthMonitoring = healthmonitor.NewMultiHealthMonitor(
-			"overall",
-			metrics.ArmadaExecutorMetricsPrefix+"overall_etcd",
-			etcdClusterHealthMonitoringByName,
+	if len(etcdClusterHealthMonitoringByName) == 0 {
+		return nil
+	}
+	return healthmonitor.NewMultiHealthMonitor(
+		"overall",
+		metrics.ArmadaExecutorMetricsPrefix+"overall_etcd",
+		etcdClusterHealthMonitoringByName,
+	)
+}
+
+// executorCluster holds the components specific to one of the Kubernetes clusters served by the executor.
+type executorCluster struct {
+	config            configuration.ExecutorConfiguration
+	clusterContext    executor_context.ClusterContext
+	taskManager       *task.BackgroundTaskManager
+	metricsRegisterer prometheus.Registerer
+	// healthMonitor monitors the health of the cluster's etcd, if configured.
+	healthMonitor healthmonitor.HealthMonitor
+}
+
+// createClusters connects to each of the Kubernetes clusters the executor serves.
+// If no clusters are configured, the executor serves the single cluster described by config.Application.
+func createClusters(config configuration.ExecutorConfiguration) ([]*executorCluster, error) {
+	if len(config.Clusters) == 0 {
+		kubernetesClientProvider, err := cluster.NewKubernetesClientProvider(
+			config.Kubernetes.ImpersonateUsers,
+			config.Kubernetes.QPS,
+			config.Kubernetes.Burst,
 		)
-		g.Go(func() error { return etcdClustersHealthMonitoring.Run(ctx) })
-		prometheus.MustRegister(etcdClustersHealthMonitoring)
-	} else {
-		ctx.Info("no etcd URLs provided; etcd health isn't monitored")
+		if err != nil {
+			return nil, err
+		}
+		metrics.RegisterKubernetesCluster(kubernetesClientProvider.ClientConfig().Host, config.Application.ClusterId)
+		return []*executorCluster{newExecutorCluster(config, kubernetesClientProvider, prometheus.DefaultRegisterer)}, nil
 	}
 
+	clusters := make([]*executorCluster, 0, len(config.Clusters))
+	for _, clusterConfig := range config.Clusters {
+		executorConfig := clusterExecutorConfiguration(config, clusterConfig)
+		kubernetesClientProvider, err := cluster.NewKubernetesClientProviderForKubeConfig(
+			clusterConfig.KubeConfig,
+			clusterConfig.KubeContext,
+			executorConfig.Kubernetes.ImpersonateUsers,
+			executorConfig.Kubernetes.QPS,
+			executorConfig.Kubernetes.Burst,
+		)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "cluster %s", clusterConfig.ClusterId)
+		}
+		metrics.RegisterKubernetesCluster(kubernetesClientProvider.ClientConfig().Host, clusterConfig.ClusterId)
+		// Label each cluster's metrics with its id, so the same metrics can be registered once per cluster.
+		metricsRegisterer := prometheus.WrapRegistererWith(prometheus.Labels{"cluster": clusterConfig.ClusterId}, prometheus.DefaultRegisterer)
+		clusters = append(clusters, newExecutorCluster(executorConfig, kubernetesClientProvider, metricsRegisterer))
+	}
+	return clusters, nil
+}
+
+func newExecutorCluster(
+	config configuration.ExecutorConfiguration,
+	kubernetesClientProvider cluster.KubernetesClientProvider,
+	metricsRegisterer prometheus.Registerer,
+) *executorCluster {
 	clusterContext := executor_context.NewClusterContext(
 		config.Application,
 		2*time.Minute,
 		kubernetesClientProvider,
 		config.Kubernetes.PodKillTimeout,
+		metricsRegisterer,
 	)
 
-	wg := &sync.WaitGroup{}
-	wg.Add(1)
-
-	taskManager := task.NewBackgroundTaskManager(metrics.ArmadaExecutorMetricsPrefix)
+	taskManager := task.NewBackgroundTaskManager(metrics.ArmadaExecutorMetricsPrefix).WithRegisterer(metricsRegisterer)
 	taskManager.Register(clusterContext.ProcessPodsToDelete, config.Task.PodDeletionInterval, "pod_deletion")
 
-	return StartUpWithContext(ctx, config, clusterContext, etcdClustersHealthMonitoring, taskManager, wg)
+	var executorClusterContext executor_context.ClusterContext = clusterContext
+	if config.Application.ShadowMode {
+		executorClusterContext = fake_context.NewShadowClusterContext(clusterContext)
+	}
+
+	return &executorCluster{
+		config:            config,
+		clusterContext:    executorClusterContext,
+		taskManager:       taskManager,
+		metricsRegisterer: metricsRegisterer,
+	}
+}
+
+// clusterExecutorConfiguration returns the executor configuration for one of several clusters served by the executor.
+func clusterExecutorConfiguration(config configuration.ExecutorConfiguration, clusterConfig configuration.ClusterConfiguration) configuration.ExecutorConfiguration {
+	config.Application.ClusterId = clusterConfig.ClusterId
+	config.Application.Pool = clusterConfig.Pool
+	config.Clusters = nil
+	if clusterConfig.ImpersonateUsers != nil {
+		config.Kubernetes.ImpersonateUsers = *clusterConfig.ImpersonateUsers
+	}
+	if clusterConfig.QPS != 0 {
+		config.Kubernetes.QPS = clusterConfig.QPS
+	}
+	if clusterConfig.Burst != 0 {
+		config.Kubernetes.Burst = clusterConfig.Burst
+	}
+	config.Kubernetes.Etcd = clusterConfig.Etcd
+	if config.Application.RunStatePersistence.Enabled {
+		// Each cluster stores its runs separately, so a cluster never restores runs leased to another.
+		config.Application.RunStatePersistence.Directory = filepath.Join(config.Application.RunStatePersistence.Directory, clusterConfig.ClusterId)
+	}
+	return config
 }
 
 func StartUpWithContext(
@@ -124,6 +226,64 @@ func StartUpWithContext(
 	taskManager *task.BackgroundTaskManager,
 	wg *sync.WaitGroup,
 ) (func(), *sync.WaitGroup) {
+	clusters := []*executorCluster{
+		{
+			config:            config,
+			clusterContext:    clusterContext,
+			taskManager:       taskManager,
+			metricsRegisterer: prometheus.DefaultRegisterer,
+			healthMonitor:     clusterHealthMonitor,
+		},
+	}
+	return startUpClusters(ctx, config, clusters, wg)
+}
+
+// startUpClusters starts serving each of the clusters. The clusters share a single connection to the Executor API.
+func startUpClusters(
+	ctx *armadacontext.Context,
+	config configuration.ExecutorConfiguration,
+	clusters []*executorCluster,
+	wg *sync.WaitGroup,
+) (func(), *sync.WaitGroup) {
+	conn, err := createConnectionToApi(config.ExecutorApiConnection, config.Client.MaxMessageSizeBytes, config.GRPC)
+	if err != nil {
+		ctx.Fatalf("Failed to connect to Executor API because: %s", err)
+	}
+	executorApiClient := executorapi.NewExecutorApiClient(conn)
+
+	stopClusters := make([]func(), 0, len(clusters))
+	for _, executorCluster := range clusters {
+		clusterCtx := ctx
+		if len(clusters) > 1 {
+			clusterCtx = armadacontext.WithLogField(ctx, "cluster", executorCluster.config.Application.ClusterId)
+		}
+		stopClusters = append(stopClusters, startUpCluster(clusterCtx, executorCluster, executorApiClient))
+	}
+
+	return func() {
+		for _, stopCluster := range stopClusters {
+			stopCluster()
+		}
+		conn.Close()
+		ctx.Infof("Shutdown complete")
+		wg.Done()
+	}, wg
+}
+
+func startUpCluster(
+	ctx *armadacontext.Context,
+	executorCluster *executorCluster,
+	executorApiClient executorapi.ExecutorApiClient,
+) func() {
+	config := executorCluster.config
+	clusterHealthMonitor := executorCluster.healthMonitor
+	clusterContext := executorCluster.clusterContext
+	taskManager := executorCluster.taskManager
+
+	if config.Application.ShadowMode {
+		ctx.Infof("Running in shadow mode; pods won't be created and leases will be returned")
+	}
+
 	nodeInfoService := node.NewKubernetesNodeInfoService(
 		clusterContext,
 		config.Kubernetes.NodeTypeLabel,
@@ -137,6 +297,15 @@ func StartUpWithContext(
 		&http.Client{Timeout: 15 * time.Second},
 	)
 
+	var acceleratorHealthService *utilisation.AcceleratorHealthService
+	if config.Kubernetes.AcceleratorHealth.Enabled {
+		acceleratorHealthService = utilisation.NewAcceleratorHealthService(
+			clusterContext,
+			config.Kubernetes.AcceleratorHealth,
+			&http.Client{Timeout: 15 * time.Second},
+		)
+	}
+
 	if config.Kubernetes.PendingPodChecks == nil {
 		ctx.Fatalf("Config error: Missing pending pod checks")
 	}
@@ -145,7 +314,19 @@ func StartUpWithContext(
 		ctx.Fatalf("Config error in pending pod checks: %s", err)
 	}
 
-	stopExecutorApiComponents := setupExecutorApiComponents(ctx, config, clusterContext, clusterHealthMonitor, taskManager, pendingPodChecker, nodeInfoService, podUtilisationService)
+	stopExecutorApiComponents := setupExecutorApiComponents(
+		ctx,
+		config,
+		executorApiClient,
+		clusterContext,
+		clusterHealthMonitor,
+		taskManager,
+		executorCluster.metricsRegisterer,
+		pendingPodChecker,
+		nodeInfoService,
+		podUtilisationService,
+		acceleratorHealthService,
+	)
 
 	resourceCleanupService, err := service.NewResourceCleanupService(clusterContext, config.Kubernetes)
 	if err != nil {
@@ -153,6 +334,10 @@ func StartUpWithContext(
 	}
 	taskManager.Register(resourceCleanupService.CleanupResources, config.Task.ResourceCleanupInterval, "resource_cleanup")
 
+	if acceleratorHealthService != nil {
+		taskManager.Register(acceleratorHealthService.RefreshMetrics, config.Task.AcceleratorHealthRefreshInterval, "accelerator_health_refresh")
+	}
+
 	if config.Metric.ExposeQueueUsageMetrics {
 		taskManager.Register(podUtilisationService.RefreshUtilisationData, config.Task.QueueUsageDataRefreshInterval, "pod_usage_data_refresh")
 	}
@@ -163,34 +348,39 @@ func StartUpWithContext(
 		if taskManager.StopAll(10 * time.Second) {
 			ctx.Warnf("Graceful shutdown timed out")
 		}
-		ctx.Infof("Shutdown complete")
-		wg.Done()
-	}, wg
+	}
 }
 
 func setupExecutorApiComponents(
 	ctx *armadacontext.Context,
 	config configuration.ExecutorConfiguration,
+	executorApiClient executorapi.ExecutorApiClient,
 	clusterContext executor_context.ClusterContext,
 	clusterHealthMonitor healthmonitor.HealthMonitor,
 	taskManager *task.BackgroundTaskManager,
+	metricsRegisterer prometheus.Registerer,
 	pendingPodChecker *podchecks.PodChecks,
 	nodeInfoService node.NodeInfoService,
 	podUtilisationService utilisation.PodUtilisationService,
+	acceleratorHealthService *utilisation.AcceleratorHealthService,
 ) func() {
-	conn, err := createConnectionToApi(config.ExecutorApiConnection, config.Client.MaxMessageSizeBytes, config.GRPC)
-	if err != nil {
-		ctx.Fatalf("Failed to connect to Executor API because: %s", err)
-	}
-
-	executorApiClient := executorapi.NewExecutorApiClient(conn)
 	eventSender := reporter.NewExecutorApiEventSender(executorApiClient, config.Client.MaxMessageSizeBytes)
-	jobRunState := job.NewJobRunStateStore(clusterContext)
+
+	var runStatePersister job.RunStatePersister
+	var err error
+	if config.Application.RunStatePersistence.Enabled {
+		runStatePersister, err = job.NewFileRunStatePersister(config.Application.RunStatePersistence.Directory)
+		if err != nil {
+			ctx.Fatalf("Failed to create run state persister: %s", err)
+		}
+	}
+	jobRunState := job.NewJobRunStateStore(clusterContext, runStatePersister)
 
 	clusterUtilisationService := utilisation.NewClusterUtilisationService(
 		clusterContext,
 		podUtilisationService,
 		nodeInfoService,
+		acceleratorHealthService,
 		config.Kubernetes.TrackedNodeLabels,
 		config.Kubernetes.NodeIdLabel,
 		config.Kubernetes.MinimumResourcesMarkedAllocatedToNonArmadaPodsPerNode,
@@ -210,6 +400,20 @@ func setupExecutorApiComponents(
 		}
 	}
 
+	var quarantiner *node.Quarantiner
+	if config.Kubernetes.NodeQuarantine.Enabled {
+		quarantiner = node.NewQuarantiner(clusterContext, config.Kubernetes.NodeQuarantine, clock.RealClock{})
+	}
+
+	var logCapturer *logcapture.LogCapturer
+	if config.Kubernetes.FailedPodLogCapture.Enabled {
+		logStore, err := blobstore.New(config.Kubernetes.FailedPodLogCapture.Store)
+		if err != nil {
+			ctx.Fatalf("Config error in failed pod log capture: %s", err)
+		}
+		logCapturer = logcapture.NewLogCapturer(clusterContext, logStore, config.Kubernetes.FailedPodLogCapture)
+	}
+
 	eventReporter, stopReporter := reporter.NewJobEventReporter(eventSender, clock.RealClock{}, 200)
 
 	submitter := job.NewSubmitter(
@@ -217,6 +421,8 @@ func setupExecutorApiComponents(
 		config.Kubernetes.PodDefaults,
 		config.Application.SubmitConcurrencyLimit,
 		config.Kubernetes.FatalPodSubmissionErrors,
+		config.Kubernetes.JobSecretNamespace,
+		config.Kubernetes.JobNetworkPolicy,
 	)
 
 	leaseRequester := service.NewJobLeaseRequester(executorApiClient, clusterContext)
@@ -230,6 +436,7 @@ func setupExecutorApiComponents(
 		jobRunState,
 		clusterUtilisationService,
 		config.Kubernetes.PodDefaults,
+		quarantiner,
 		config.Application.MaxLeasedJobs,
 		config.Application.JobLeaseRequestTimeout,
 	)
@@ -249,6 +456,7 @@ func setupExecutorApiComponents(
 		failedPodChecker,
 		config.Kubernetes.StuckTerminatingPodExpiry,
 		classifier,
+		quarantiner,
 	)
 	if err != nil {
 		ctx.Fatalf("Failed to create pod issue service: %s", err)
@@ -259,6 +467,8 @@ func setupExecutorApiComponents(
 		eventReporter,
 		podIssueService,
 		classifier,
+		quarantiner,
+		logCapturer,
 	)
 	if err != nil {
 		ctx.Fatalf("Failed to create job state reporter: %s", err)
@@ -270,12 +480,15 @@ func setupExecutorApiComponents(
 	taskManager.Register(jobRequester.RequestJobsRuns, config.Task.JobLeaseRenewalInterval, "request_runs")
 	taskManager.Register(clusterAllocationService.AllocateSpareClusterCapacity, config.Task.AllocateSpareClusterCapacityInterval, "submit_runs")
 	taskManager.Register(jobStateReporter.ReportMissingJobEvents, config.Task.MissingJobEventReconciliationInterval, "event_reconciliation")
-	_, err = pod_metrics.ExposeClusterContextMetrics(clusterContext, clusterUtilisationService, podUtilisationService, nodeInfoService)
+	if quarantiner != nil {
+		taskManager.Register(quarantiner.Run, config.Task.NodeQuarantineInterval, "node_quarantine")
+	}
+	_, err = pod_metrics.ExposeClusterContextMetrics(clusterContext, clusterUtilisationService, podUtilisationService, nodeInfoService, metricsRegisterer)
 	if err != nil {
 		ctx.Fatalf("Failed to setup cluster context metrics: %s", err)
 	}
 	runStateMetricsCollector := runstate.NewJobRunStateStoreMetricsCollector(jobRunState)
-	prometheus.MustRegister(runStateMetricsCollector)
+	metricsRegisterer.MustRegister(runStateMetricsCollector)
 
 	if config.Metric.ExposeQueueUsageMetrics && config.Task.UtilisationEventReportingInterval > 0 {
 		podUtilisationReporter, err := utilisation.NewUtilisationEventReporter(
@@ -295,7 +508,6 @@ func setupExecutorApiComponents(
 
 	return func() {
 		stopReporter <- true
-		conn.Close()
 	}
 }
 
@@ -331,5 +543,23 @@ func validateConfig(config configuration.ExecutorConfiguration) error {
 	if config.Application.DeleteConcurrencyLimit <= 0 {
 		return fmt.Errorf("DeleteConcurrencyLimit was %d, must be greater or equal to 1", config.Application.DeleteConcurrencyLimit)
 	}
+	clusterIds := map[string]bool{}
+	for _, clusterConfig := range config.Clusters {
+		if clusterIds[clusterConfig.ClusterId] {
+			return fmt.Errorf("Cluster %s is configured more than once", clusterConfig.ClusterId)
+		}
+		clusterIds[clusterConfig.ClusterId] = true
+	}
+	if len(config.Clusters) > 0 && len(config.Kubernetes.Etcd.EtcdClustersHealthMonitoring) > 0 {
+		return fmt.Errorf("Etcd health monitoring must be configured per cluster when serving several clusters")
+	}
+	if _, err := metav1.LabelSelectorAsSelector(&config.Kubernetes.JobNetworkPolicy.QueueSelector); err != nil {
+		return fmt.Errorf("Invalid job network policy queue selector: %s", err)
+	}
+	if config.Kubernetes.PodDefaults != nil {
+		if err := util2.ValidatePodTemplates(config.Kubernetes.PodDefaults.Templates); err != nil {
+			return err
+		}
+	}
 	return nil
 }
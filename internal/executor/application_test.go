@@ -74,6 +74,75 @@ func Test_ValidateConfig_When_DeleteThreadCount_LessThanOrEqualToZero(t *testing
 	assert.Error(t, validateConfig(config))
 }
 
+func Test_ValidateConfig_Clusters(t *testing.T) {
+	config := createBasicValidExecutorConfiguration()
+
+	config.Clusters = []configuration.ClusterConfiguration{
+		{ClusterId: "cluster-1", Pool: "pool", KubeConfig: "/kube/config", KubeContext: "cluster-1"},
+		{ClusterId: "cluster-2", Pool: "pool"},
+	}
+	assert.NoError(t, validateConfig(config))
+
+	config.Clusters[1].ClusterId = "cluster-1"
+	assert.Error(t, validateConfig(config))
+
+	config.Clusters[1] = configuration.ClusterConfiguration{ClusterId: "cluster-2"}
+	assert.Error(t, validateConfig(config))
+}
+
+func Test_ValidateConfig_Clusters_RequiresPerClusterEtcd(t *testing.T) {
+	config := createBasicValidExecutorConfiguration()
+	config.Clusters = []configuration.ClusterConfiguration{{ClusterId: "cluster-1", Pool: "pool"}}
+	config.Kubernetes.Etcd.EtcdClustersHealthMonitoring = []configuration.EtcdClusterHealthMonitoringConfiguration{{Name: "etcd"}}
+
+	assert.Error(t, validateConfig(config))
+}
+
+func Test_ClusterExecutorConfiguration(t *testing.T) {
+	config := createBasicValidExecutorConfiguration()
+	config.Application.ClusterId = "executor"
+	config.Application.Pool = "default"
+	config.Application.RunStatePersistence = configuration.RunStatePersistenceConfiguration{Enabled: true, Directory: "/state"}
+	config.Clusters = []configuration.ClusterConfiguration{{ClusterId: "cluster-1", Pool: "gpu"}}
+
+	clusterConfig := clusterExecutorConfiguration(config, config.Clusters[0])
+
+	assert.Equal(t, "cluster-1", clusterConfig.Application.ClusterId)
+	assert.Equal(t, "gpu", clusterConfig.Application.Pool)
+	assert.Equal(t, "/state/cluster-1", clusterConfig.Application.RunStatePersistence.Directory)
+	assert.Empty(t, clusterConfig.Clusters)
+	// The executor's own configuration is unchanged.
+	assert.Equal(t, "executor", config.Application.ClusterId)
+	assert.Equal(t, "/state", config.Application.RunStatePersistence.Directory)
+}
+
+func Test_ClusterExecutorConfiguration_KubernetesOverrides(t *testing.T) {
+	config := createBasicValidExecutorConfiguration()
+	config.Kubernetes.ImpersonateUsers = true
+	config.Kubernetes.QPS = 100
+	config.Kubernetes.Burst = 200
+	impersonateUsers := false
+	etcd := configuration.EtcdConfiguration{
+		EtcdClustersHealthMonitoring: []configuration.EtcdClusterHealthMonitoringConfiguration{{Name: "etcd-1"}},
+	}
+	config.Clusters = []configuration.ClusterConfiguration{
+		{ClusterId: "cluster-1", Pool: "pool", ImpersonateUsers: &impersonateUsers, QPS: 10, Burst: 20, Etcd: etcd},
+		{ClusterId: "cluster-2", Pool: "pool"},
+	}
+
+	overridden := clusterExecutorConfiguration(config, config.Clusters[0])
+	assert.False(t, overridden.Kubernetes.ImpersonateUsers)
+	assert.Equal(t, float32(10), overridden.Kubernetes.QPS)
+	assert.Equal(t, 20, overridden.Kubernetes.Burst)
+	assert.Equal(t, etcd, overridden.Kubernetes.Etcd)
+
+	defaulted := clusterExecutorConfiguration(config, config.Clusters[1])
+	assert.True(t, defaulted.Kubernetes.ImpersonateUsers)
+	assert.Equal(t, float32(100), defaulted.Kubernetes.QPS)
+	assert.Equal(t, 200, defaulted.Kubernetes.Burst)
+	assert.Empty(t, defaulted.Kubernetes.Etcd.EtcdClustersHealthMonitoring)
+}
+
 func createBasicValidExecutorConfiguration() configuration.ExecutorConfiguration {
 	return configuration.ExecutorConfiguration{
 		Application: configuration.ApplicationConfiguration{
@@ -11,6 +11,9 @@ type Action string
 const (
 	ActionFail  Action = "Fail"
 	ActionRetry Action = "Retry"
+	// ActionReturnLeaseAvoidNode returns the lease like ActionRetry, and has the scheduler avoid the node the pod was
+	// assigned to when it retries the job. If the pod hasn't been assigned to a node, it acts like ActionRetry.
+	ActionReturnLeaseAvoidNode Action = "ReturnLeaseAvoidNode"
 )
 
 type ContainerState string
@@ -28,6 +31,8 @@ const (
 type Checks struct {
 	Events                    []EventCheck
 	ContainerStatuses         []ContainerStatusCheck
+	NodeConditions            []NodeConditionCheck
+	AutoscalerEvents          []AutoscalerEventCheck
 	DeadlineForUpdates        time.Duration
 	DeadlineForNodeAssignment time.Duration
 	DeadlineForInitContainers time.Duration
@@ -51,6 +56,26 @@ type ContainerStatusCheck struct {
 	Name         string
 }
 
+// NodeConditionCheck matches pods assigned to a node with the given condition, e.g., DiskPressure=True or Ready=Unknown.
+// GracePeriod is how long the node must have had the condition.
+type NodeConditionCheck struct {
+	Type        v1.NodeConditionType
+	Status      v1.ConditionStatus
+	GracePeriod time.Duration
+	Action      Action
+	Name        string
+}
+
+// AutoscalerEventCheck matches unschedulable pods the cluster autoscaler has raised an event with the given reason for,
+// e.g., TriggeredScaleUp or NotTriggerScaleUp.
+// GracePeriod is how long the pod must have remained unschedulable since the autoscaler first raised the event.
+type AutoscalerEventCheck struct {
+	Reason      string
+	GracePeriod time.Duration
+	Action      Action
+	Name        string
+}
+
 type FailedChecks struct {
 	Events            []PodEventCheck
 	PodStatuses       []PodStatusCheck
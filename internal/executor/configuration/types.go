@@ -4,7 +4,11 @@ import (
 	"time"
 
 	"google.golang.org/grpc/keepalive"
+	v1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/armadaproject/armada/internal/common/blobstore"
 	"github.com/armadaproject/armada/internal/common/observability"
 	profilingconfig "github.com/armadaproject/armada/internal/common/profiling/configuration"
 	armadaresource "github.com/armadaproject/armada/internal/common/resource"
@@ -31,11 +35,65 @@ type ApplicationConfiguration struct {
 	// ErrorCategories defines category rules for classifying pod failures.
 	// Set ErrorCategories.Enabled to true to turn classification on.
 	ErrorCategories categorizer.ErrorCategoriesConfig `yaml:"errorCategories"`
+	// RunStatePersistence configures storing runs that don't yet have a pod, so they survive executor restarts.
+	RunStatePersistence RunStatePersistenceConfiguration
+	// ShadowMode runs the executor without changing the cluster, e.g., before cutting a new cluster over.
+	// The executor reports the cluster's nodes and leases runs as normal, but logs the pods it would have created
+	// and returns their leases to the scheduler, rather than creating them. Returned runs count as attempted, so the
+	// scheduler retries their jobs on other nodes, and each job leased to the executor uses up one of its attempts.
+	ShadowMode bool
+}
+
+// RunStatePersistenceConfiguration controls the durable storage of leased and submitted runs.
+// Without it, runs leased but not yet created as pods are lost if the executor restarts.
+type RunStatePersistenceConfiguration struct {
+	Enabled bool
+	// Directory the run state is written to. It should be on a volume that outlives the executor's pod.
+	Directory string `validate:"required_if=Enabled true"`
 }
 
 type PodDefaults struct {
 	SchedulerName string
 	Ingress       *IngressConfiguration
+	// Templates are applied, in order, to the pods of the jobs they match.
+	// They let cluster-specific plumbing, e.g., sidecars or credentials, be added without it appearing in job specs.
+	Templates []PodTemplate `validate:"dive"`
+}
+
+// PodTemplate describes changes made to the pods of matching jobs, applied as a strategic merge patch.
+// Hence, maps are merged key by key, lists of containers, volumes and environment variables are merged by name,
+// and volume mounts by mount path, with the template taking precedence over the job wherever both set a value.
+type PodTemplate struct {
+	Name  string `validate:"required"`
+	Match PodTemplateMatch
+	// Labels and annotations added to the pod.
+	Labels      map[string]string
+	Annotations map[string]string
+	// Environment variables and volume mounts added to each of the job's containers and init containers.
+	Env          []v1.EnvVar
+	VolumeMounts []v1.VolumeMount
+	// Volumes added to the pod.
+	Volumes []v1.Volume
+	// Init containers and sidecar containers added to the pod.
+	// These are added after the job's own containers and aren't modified by Env, VolumeMounts or ContainerSecurityContext.
+	InitContainers []v1.Container
+	Sidecars       []v1.Container
+	// Security context merged into that of the pod.
+	SecurityContext *v1.PodSecurityContext
+	// Security context merged into that of each of the job's containers and init containers.
+	ContainerSecurityContext *v1.SecurityContext
+	// Kubernetes priority class to set on the pod, by the name of the job's priority class.
+	PriorityClassNames map[string]string
+}
+
+// PodTemplateMatch selects the jobs a template is applied to.
+// A job must satisfy every non-empty field; a template with an empty match applies to all jobs.
+type PodTemplateMatch struct {
+	Pools           []string
+	Queues          []string
+	PriorityClasses []string
+	// Labels the job must have, with the given values.
+	Labels map[string]string
 }
 
 type StateChecksConfiguration struct {
@@ -87,6 +145,10 @@ type KubernetesConfiguration struct {
 	FailedPodChecks           podchecks.FailedChecks
 	PendingPodChecks          *podchecks.Checks
 	FatalPodSubmissionErrors  []string
+	// Namespace holding the secrets that jobs may reference. Referenced secrets are copied into the job's namespace,
+	// provided their armadaproject.io/jobSecretQueues annotation lists the job's queue.
+	// If empty, jobs that reference secrets fail to submit.
+	JobSecretNamespace string
 	// Minimum amount of resources marked as allocated to non-Armada pods on each node.
 	// I.e., if the total resources allocated to non-Armada pods on some node drops below this value,
 	// the executor adds a fictional allocation to make up the difference, such that the total is at least this.
@@ -97,6 +159,110 @@ type KubernetesConfiguration struct {
 	MinimumResourcesMarkedAllocatedToNonArmadaPodsPerNodePriority int32
 
 	PodKillTimeout time.Duration
+	// NodeQuarantine configures automatically tainting nodes that repeatedly fail jobs.
+	NodeQuarantine NodeQuarantineConfiguration
+	// FailedPodLogCapture configures uploading the logs of failed pods, so they remain available after the pod is deleted.
+	FailedPodLogCapture FailedPodLogCaptureConfiguration
+	// AcceleratorHealth configures reporting unhealthy accelerators, e.g., GPUs, so the scheduler doesn't schedule onto them.
+	AcceleratorHealth AcceleratorHealthConfiguration
+	// JobNetworkPolicy configures creating a NetworkPolicy for each job, isolating it from the pods of other jobs.
+	JobNetworkPolicy JobNetworkPolicyConfiguration
+}
+
+type JobNetworkPolicyScope string
+
+const (
+	// JobNetworkPolicyScopeGang allows traffic only between the pods of the same gang, or of the same job if it isn't in a gang.
+	JobNetworkPolicyScopeGang JobNetworkPolicyScope = "Gang"
+	// JobNetworkPolicyScopeJobSet allows traffic between the pods of all jobs in the same job set.
+	JobNetworkPolicyScopeJobSet JobNetworkPolicyScope = "JobSet"
+)
+
+// JobNetworkPolicyConfiguration controls the NetworkPolicy created alongside each job's pod.
+// The policy allows ingress only from, and egress only to, pods of the same queue and scope, plus the configured Ingress and Egress.
+// It is owned by the pod, and deleted with its other associated objects once the pod finishes.
+type JobNetworkPolicyConfiguration struct {
+	Enabled bool
+	Scope   JobNetworkPolicyScope `validate:"required_if=Enabled true,omitempty,oneof=Gang JobSet"`
+	// Selects, by their labels, the queues whose jobs are isolated. If empty, jobs of all queues are isolated.
+	QueueSelector metav1.LabelSelector
+	// Ingress allowed in addition to traffic within the scope, e.g., from ingress controllers.
+	Ingress []networking.NetworkPolicyIngressRule
+	// Egress allowed in addition to traffic within the scope, e.g., to cluster DNS.
+	Egress []networking.NetworkPolicyEgressRule
+}
+
+// FailedPodLogCaptureConfiguration controls capturing the tail of each container's logs when a pod fails.
+// Captured logs are uploaded to a blob store and their locations recorded on the run's error event,
+// which lets Binoculars serve them once the pod has been deleted, e.g., due to FailedPodExpiry.
+type FailedPodLogCaptureConfiguration struct {
+	Enabled bool
+	// Failure categories, as assigned by the error categorizer, for which logs are captured.
+	// If empty, logs are captured for every failure.
+	Categories []string
+	// Number of lines to capture from the end of each container's logs.
+	TailLines int64 `validate:"required_if=Enabled true"`
+	// Maximum number of bytes to capture for each container.
+	LimitBytes int64 `validate:"required_if=Enabled true"`
+	// Maximum time spent fetching and uploading the logs of a single pod.
+	Timeout time.Duration `validate:"required_if=Enabled true"`
+	// Blob store the logs are uploaded to.
+	Store blobstore.Config
+}
+
+// NodeQuarantineConfiguration controls the quarantining of nodes that fail many jobs in a short time.
+// A quarantined node is tainted with a NoSchedule taint and so reported to the scheduler as unschedulable.
+// Quarantines are lifted after CoolDown, or earlier if released manually via armadactl.
+type NodeQuarantineConfiguration struct {
+	Enabled bool
+	// Failure categories, as assigned by the error categorizer, counted towards quarantining a node.
+	// Each category is counted separately. If empty, failures of every category are counted.
+	Categories []string
+	// Number of failures of a single category within Window after which a node is quarantined.
+	FailureThreshold int `validate:"required_if=Enabled true"`
+	// Length of the sliding window over which failures are counted.
+	Window time.Duration `validate:"required_if=Enabled true"`
+	// How long a node stays quarantined before it is released automatically.
+	CoolDown time.Duration `validate:"required_if=Enabled true"`
+	// Key of the taint added to quarantined nodes. The taint value is the failure category.
+	// Quarantined nodes are also annotated with the cluster id; taints with this key that lack the annotation, e.g.,
+	// added by an administrator or another executor, are never removed.
+	TaintKey string `validate:"required_if=Enabled true"`
+}
+
+// AcceleratorHealthConfiguration controls detecting unhealthy accelerators on each node.
+// A node's unhealthy accelerators are reported to the scheduler, which excludes them from the node's schedulable resources.
+type AcceleratorHealthConfiguration struct {
+	Enabled bool
+	// Resources are the accelerator resources whose health is tracked, e.g., nvidia.com/gpu.
+	// Devices the device plugin reports as unhealthy, i.e., the difference between a node's capacity and allocatable, are always counted.
+	Resources []string `validate:"required_if=Enabled true"`
+	// While a node has any of these conditions, all its accelerators are considered unhealthy.
+	NodeConditions []AcceleratorNodeCondition `validate:"dive"`
+	// Metrics configures scraping the health of individual devices from a metrics exporter, e.g., the DCGM exporter.
+	Metrics []AcceleratorHealthMetric `validate:"dive"`
+}
+
+type AcceleratorNodeCondition struct {
+	Type   v1.NodeConditionType `validate:"required"`
+	Status v1.ConditionStatus   `validate:"required"`
+}
+
+// AcceleratorHealthMetric describes a per-device metric exposed by the endpoints selected, as for CustomUsageMetrics.
+// A device is unhealthy while its metric is greater than UnhealthyAbove,
+// e.g., DCGM_FI_DEV_XID_ERRORS is the last XID error a GPU reported, so is unhealthy above 0.
+type AcceleratorHealthMetric struct {
+	Namespace                  string `validate:"required"`
+	EndpointSelectorLabelName  string `validate:"required"`
+	EndpointSelectorLabelValue string `validate:"required"`
+	// Resource is the accelerator resource the metric's devices provide, e.g., nvidia.com/gpu.
+	Resource             string `validate:"required"`
+	PrometheusMetricName string `validate:"required"`
+	// PrometheusNodeNameLabel is the label giving the name of the node a device is on, e.g., Hostname.
+	PrometheusNodeNameLabel string `validate:"required"`
+	// PrometheusDeviceLabel is the label identifying a device, e.g., UUID.
+	PrometheusDeviceLabel string `validate:"required"`
+	UnhealthyAbove        float64
 }
 
 type EtcdConfiguration struct {
@@ -145,6 +311,8 @@ type TaskConfiguration struct {
 	UtilisationEventReportingInterval     time.Duration
 	ResourceCleanupInterval               time.Duration
 	StateProcessorInterval                time.Duration
+	NodeQuarantineInterval                time.Duration
+	AcceleratorHealthRefreshInterval      time.Duration
 }
 
 type MetricConfiguration struct {
@@ -188,4 +356,26 @@ type ExecutorConfiguration struct {
 
 	Kubernetes KubernetesConfiguration
 	Task       TaskConfiguration
+	// Clusters lets a single executor serve several Kubernetes clusters.
+	// If empty, the executor serves the cluster it's configured to connect to, using Application.ClusterId and Application.Pool.
+	Clusters []ClusterConfiguration `validate:"dive"`
+}
+
+// ClusterConfiguration describes one of several Kubernetes clusters served by an executor.
+// Each cluster requests and reports its jobs independently, as if it were served by its own executor.
+type ClusterConfiguration struct {
+	ClusterId string `validate:"required"`
+	Pool      string `validate:"required"`
+	// KubeConfig is the path to the kubeconfig file for the cluster.
+	// If empty, the default kubeconfig loading rules are used.
+	KubeConfig string
+	// KubeContext is the kubeconfig context to use. If empty, the current context is used.
+	KubeContext string
+	// ImpersonateUsers, QPS and Burst override those of the Kubernetes configuration for this cluster, if set.
+	ImpersonateUsers *bool
+	QPS              float32
+	Burst            int
+	// Etcd configures health monitoring of this cluster's etcd.
+	// When serving several clusters, the etcd of the Kubernetes configuration must not be set.
+	Etcd EtcdConfiguration
 }
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	v1 "k8s.io/api/core/v1"
 	discovery "k8s.io/api/discovery/v1"
 	networking "k8s.io/api/networking/v1"
@@ -54,16 +55,26 @@ type ClusterContext interface {
 	GetServices(pod *v1.Pod) ([]*v1.Service, error)
 	GetIngresses(pod *v1.Pod) ([]*networking.Ingress, error)
 	GetEndpointSlices(namespace string, labelName string, labelValue string) ([]*discovery.EndpointSlice, error)
+	GetSecret(namespace string, name string) (*v1.Secret, error)
+	GetPodLogs(ctx *armadacontext.Context, pod *v1.Pod, options *v1.PodLogOptions) ([]byte, error)
 
 	SubmitPod(pod *v1.Pod, owner string, ownerGroups []string) (*v1.Pod, error)
 	SubmitService(service *v1.Service) (*v1.Service, error)
 	SubmitIngress(ingress *networking.Ingress) (*networking.Ingress, error)
+	SubmitConfigMap(configMap *v1.ConfigMap) (*v1.ConfigMap, error)
+	SubmitSecret(secret *v1.Secret) (*v1.Secret, error)
+	SubmitPersistentVolumeClaim(claim *v1.PersistentVolumeClaim) (*v1.PersistentVolumeClaim, error)
+	SubmitNetworkPolicy(policy *networking.NetworkPolicy) (*networking.NetworkPolicy, error)
 	DeletePodWithCondition(pod *v1.Pod, condition func(pod *v1.Pod) bool, pessimistic bool) error
 	DeletePods(pods []*v1.Pod)
 	DeleteService(service *v1.Service) error
 	DeleteIngress(ingress *networking.Ingress) error
+	DeleteAssociatedObjects(pod *v1.Pod) error
 
 	AddAnnotation(pod *v1.Pod, annotations map[string]string) error
+	AddNetworkPolicyOwnerReference(policy *networking.NetworkPolicy, owner metav1.OwnerReference) error
+	UpdateNode(node *v1.Node) error
+	AddNodeEvent(node *v1.Node, eventType string, reason string, message string) error
 
 	Stop()
 }
@@ -100,6 +111,7 @@ func NewClusterContext(
 	minTimeBetweenRepeatDeletionCalls time.Duration,
 	kubernetesClientProvider cluster.KubernetesClientProvider,
 	killTimeout time.Duration,
+	metricsRegisterer prometheus.Registerer,
 ) *KubernetesClusterContext {
 	kubernetesClient := kubernetesClientProvider.Client()
 
@@ -109,8 +121,8 @@ func NewClusterContext(
 		clusterId:                configuration.ClusterId,
 		pool:                     configuration.Pool,
 		deleteThreadCount:        configuration.DeleteConcurrencyLimit,
-		submittedPods:            util.NewTimeExpiringPodCache(time.Minute, time.Second, "submitted_job"),
-		podsToDelete:             util.NewTimeExpiringPodCache(minTimeBetweenRepeatDeletionCalls, time.Second, "deleted_job"),
+		submittedPods:            util.NewTimeExpiringPodCache(time.Minute, time.Second, "submitted_job", metricsRegisterer),
+		podsToDelete:             util.NewTimeExpiringPodCache(minTimeBetweenRepeatDeletionCalls, time.Second, "deleted_job", metricsRegisterer),
 		stopper:                  make(chan struct{}),
 		podInformer:              factory.Core().V1().Pods(),
 		nodeInformer:             factory.Core().V1().Nodes(),
@@ -265,6 +277,31 @@ func (c *KubernetesClusterContext) SubmitIngress(ingress *networking.Ingress) (*
 	return c.kubernetesClient.NetworkingV1().Ingresses(ingress.Namespace).Create(armadacontext.Background(), ingress, metav1.CreateOptions{})
 }
 
+func (c *KubernetesClusterContext) SubmitConfigMap(configMap *v1.ConfigMap) (*v1.ConfigMap, error) {
+	return c.kubernetesClient.CoreV1().ConfigMaps(configMap.Namespace).Create(armadacontext.Background(), configMap, metav1.CreateOptions{})
+}
+
+func (c *KubernetesClusterContext) SubmitSecret(secret *v1.Secret) (*v1.Secret, error) {
+	return c.kubernetesClient.CoreV1().Secrets(secret.Namespace).Create(armadacontext.Background(), secret, metav1.CreateOptions{})
+}
+
+func (c *KubernetesClusterContext) SubmitPersistentVolumeClaim(claim *v1.PersistentVolumeClaim) (*v1.PersistentVolumeClaim, error) {
+	return c.kubernetesClient.CoreV1().PersistentVolumeClaims(claim.Namespace).Create(armadacontext.Background(), claim, metav1.CreateOptions{})
+}
+
+func (c *KubernetesClusterContext) SubmitNetworkPolicy(policy *networking.NetworkPolicy) (*networking.NetworkPolicy, error) {
+	return c.kubernetesClient.NetworkingV1().NetworkPolicies(policy.Namespace).Create(armadacontext.Background(), policy, metav1.CreateOptions{})
+}
+
+// GetSecret reads the secret directly from the API server, as secrets are not watched by an informer.
+func (c *KubernetesClusterContext) GetSecret(namespace string, name string) (*v1.Secret, error) {
+	return c.kubernetesClient.CoreV1().Secrets(namespace).Get(armadacontext.Background(), name, metav1.GetOptions{})
+}
+
+func (c *KubernetesClusterContext) GetPodLogs(ctx *armadacontext.Context, pod *v1.Pod, options *v1.PodLogOptions) ([]byte, error) {
+	return c.kubernetesClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, options).DoRaw(ctx)
+}
+
 func (c *KubernetesClusterContext) AddAnnotation(pod *v1.Pod, annotations map[string]string) error {
 	patch := &domain.Patch{
 		MetaData: metav1.ObjectMeta{
@@ -284,6 +321,56 @@ func (c *KubernetesClusterContext) AddAnnotation(pod *v1.Pod, annotations map[st
 	return nil
 }
 
+// AddNetworkPolicyOwnerReference makes the given network policy owned by, and so deleted along with, e.g., a pod.
+func (c *KubernetesClusterContext) AddNetworkPolicyOwnerReference(policy *networking.NetworkPolicy, owner metav1.OwnerReference) error {
+	patch := &domain.Patch{
+		MetaData: metav1.ObjectMeta{
+			OwnerReferences: []metav1.OwnerReference{owner},
+		},
+	}
+	patchBytes, err := json.Marshal(patch)
+	if err != nil {
+		return err
+	}
+	_, err = c.kubernetesClient.NetworkingV1().
+		NetworkPolicies(policy.Namespace).
+		Patch(armadacontext.Background(), policy.Name, types.StrategicMergePatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
+// UpdateNode replaces the given node, e.g., to change its taints and annotations.
+// The update fails if the node has been modified since it was read.
+func (c *KubernetesClusterContext) UpdateNode(node *v1.Node) error {
+	_, err := c.kubernetesClient.CoreV1().Nodes().Update(armadacontext.Background(), node, metav1.UpdateOptions{})
+	return err
+}
+
+// AddNodeEvent records a Kubernetes event against the given node.
+func (c *KubernetesClusterContext) AddNodeEvent(node *v1.Node, eventType string, reason string, message string) error {
+	now := metav1.NewTime(c.clock.Now())
+	event := &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: node.Name + ".",
+			Namespace:    metav1.NamespaceDefault,
+		},
+		InvolvedObject: v1.ObjectReference{
+			APIVersion: "v1",
+			Kind:       "Node",
+			Name:       node.Name,
+			UID:        node.UID,
+		},
+		Reason:         reason,
+		Message:        message,
+		Type:           eventType,
+		Source:         v1.EventSource{Component: "armada-executor"},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}
+	_, err := c.kubernetesClient.CoreV1().Events(metav1.NamespaceDefault).Create(armadacontext.Background(), event, metav1.CreateOptions{})
+	return err
+}
+
 func (c *KubernetesClusterContext) DeletePodWithCondition(pod *v1.Pod, condition func(pod *v1.Pod) bool, pessimistic bool) error {
 	currentPod, err := c.podInformer.Lister().Pods(pod.Namespace).Get(pod.Name)
 	if err != nil {
@@ -459,6 +546,31 @@ func (c *KubernetesClusterContext) GetIngresses(pod *v1.Pod) ([]*networking.Ingr
 	return ingresses, err
 }
 
+// DeleteAssociatedObjects deletes the ConfigMaps, Secrets, PersistentVolumeClaims and NetworkPolicies created alongside the given pod.
+func (c *KubernetesClusterContext) DeleteAssociatedObjects(pod *v1.Pod) error {
+	podAssociationSelector, err := createPodAssociationSelector(pod)
+	if err != nil {
+		return err
+	}
+	ctx := armadacontext.Background()
+	deleteOptions := createDeleteOptions()
+	listOptions := metav1.ListOptions{LabelSelector: (*podAssociationSelector).String()}
+	core := c.kubernetesClient.CoreV1()
+	if err := core.ConfigMaps(pod.Namespace).DeleteCollection(ctx, deleteOptions, listOptions); err != nil {
+		return errors.WithMessage(err, "failed to delete configmaps")
+	}
+	if err := core.Secrets(pod.Namespace).DeleteCollection(ctx, deleteOptions, listOptions); err != nil {
+		return errors.WithMessage(err, "failed to delete secrets")
+	}
+	if err := core.PersistentVolumeClaims(pod.Namespace).DeleteCollection(ctx, deleteOptions, listOptions); err != nil {
+		return errors.WithMessage(err, "failed to delete persistentvolumeclaims")
+	}
+	if err := c.kubernetesClient.NetworkingV1().NetworkPolicies(pod.Namespace).DeleteCollection(ctx, deleteOptions, listOptions); err != nil {
+		return errors.WithMessage(err, "failed to delete networkpolicies")
+	}
+	return nil
+}
+
 func (c *KubernetesClusterContext) GetEndpointSlices(namespace string, labelName string, labelValue string) ([]*discovery.EndpointSlice, error) {
 	req, err := labels.NewRequirement(labelName, selection.Equals, []string{labelValue})
 	if err != nil {
@@ -47,6 +47,7 @@ func setupTestWithMinRepeatedDeletePeriod(minRepeatedDeletePeriod time.Duration)
 		minRepeatedDeletePeriod,
 		clientProvider,
 		5*time.Minute,
+		prometheus.DefaultRegisterer,
 	)
 	return clusterContext, clientProvider
 }
@@ -573,6 +574,21 @@ func TestKubernetesClusterContext_AddAnnotation_ReturnsError_OnClientError(t *te
 	assert.NotNil(t, err)
 }
 
+func TestKubernetesClusterContext_AddNetworkPolicyOwnerReference(t *testing.T) {
+	clusterContext, client := setupTest()
+	policy := &networking.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "policy"}}
+	_, err := clusterContext.SubmitNetworkPolicy(policy)
+	assert.NoError(t, err)
+	owner := metav1.OwnerReference{APIVersion: "v1", Kind: "Pod", Name: "pod", UID: "pod-uid"}
+
+	err = clusterContext.AddNetworkPolicyOwnerReference(policy, owner)
+	assert.NoError(t, err)
+
+	saved, err := client.NetworkingV1().NetworkPolicies("default").Get(armadacontext.Background(), "policy", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []metav1.OwnerReference{owner}, saved.OwnerReferences)
+}
+
 func TestKubernetesClusterContext_GetAllPods(t *testing.T) {
 	clusterContext, _ := setupTest()
 
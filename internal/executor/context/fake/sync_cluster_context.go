@@ -1,13 +1,14 @@
 package fake
 
 import (
-	"errors"
 	"fmt"
 	"sync"
 
 	v1 "k8s.io/api/core/v1"
 	discovery "k8s.io/api/discovery/v1"
 	networking "k8s.io/api/networking/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/kubelet/pkg/apis/stats/v1alpha1"
 
@@ -21,8 +22,18 @@ type SyncFakeClusterContext struct {
 	Pods             map[string]*v1.Pod
 	Events           map[string][]*v1.Event
 	AnnotationsAdded map[string]map[string]string
+	ConfigMaps       map[string]*v1.ConfigMap
+	Secrets          map[string]*v1.Secret
+	VolumeClaims     map[string]*v1.PersistentVolumeClaim
+	NetworkPolicies  map[string]*networking.NetworkPolicy
+	Nodes            map[string]*v1.Node
+	NodeEvents       map[string][]*v1.Event
+	// Container logs by pod name and container name.
+	PodLogs          map[string]map[string]string
 	podEventHandlers []*cache.ResourceEventHandlerFuncs
 	GetPodEventsErr  error
+	UpdateNodeErr    error
+	GetSecretErr     error
 	rwLock           sync.RWMutex
 }
 
@@ -31,6 +42,13 @@ func NewSyncFakeClusterContext() *SyncFakeClusterContext {
 		Pods:             map[string]*v1.Pod{},
 		Events:           map[string][]*v1.Event{},
 		AnnotationsAdded: map[string]map[string]string{},
+		ConfigMaps:       map[string]*v1.ConfigMap{},
+		Secrets:          map[string]*v1.Secret{},
+		VolumeClaims:     map[string]*v1.PersistentVolumeClaim{},
+		NetworkPolicies:  map[string]*networking.NetworkPolicy{},
+		Nodes:            map[string]*v1.Node{},
+		NodeEvents:       map[string][]*v1.Event{},
+		PodLogs:          map[string]map[string]string{},
 	}
 	return c
 }
@@ -59,11 +77,23 @@ func (c *SyncFakeClusterContext) GetActiveBatchPods() ([]*v1.Pod, error) {
 }
 
 func (c *SyncFakeClusterContext) GetNodes() ([]*v1.Node, error) {
-	return make([]*v1.Node, 0), nil
+	c.rwLock.RLock()
+	defer c.rwLock.RUnlock()
+	nodes := make([]*v1.Node, 0, len(c.Nodes))
+	for _, n := range c.Nodes {
+		nodes = append(nodes, n.DeepCopy())
+	}
+	return nodes, nil
 }
 
 func (c *SyncFakeClusterContext) GetNode(nodeName string) (*v1.Node, error) {
-	return nil, errors.New("GetNode Not implemented")
+	c.rwLock.RLock()
+	defer c.rwLock.RUnlock()
+	node, ok := c.Nodes[nodeName]
+	if !ok {
+		return nil, k8s_errors.NewNotFound(v1.Resource("nodes"), nodeName)
+	}
+	return node.DeepCopy(), nil
 }
 
 func (c *SyncFakeClusterContext) GetPodEvents(pod *v1.Pod) ([]*v1.Event, error) {
@@ -102,6 +132,102 @@ func (c *SyncFakeClusterContext) DeleteIngress(ingress *networking.Ingress) erro
 	return fmt.Errorf("Ingresses not implemented in SyncFakeClusterContext")
 }
 
+func (c *SyncFakeClusterContext) SubmitConfigMap(configMap *v1.ConfigMap) (*v1.ConfigMap, error) {
+	c.rwLock.Lock()
+	defer c.rwLock.Unlock()
+	c.ConfigMaps[objectKey(configMap.Namespace, configMap.Name)] = configMap
+	return configMap, nil
+}
+
+func (c *SyncFakeClusterContext) SubmitSecret(secret *v1.Secret) (*v1.Secret, error) {
+	c.rwLock.Lock()
+	defer c.rwLock.Unlock()
+	c.Secrets[objectKey(secret.Namespace, secret.Name)] = secret
+	return secret, nil
+}
+
+func (c *SyncFakeClusterContext) GetSecret(namespace string, name string) (*v1.Secret, error) {
+	c.rwLock.RLock()
+	defer c.rwLock.RUnlock()
+	if c.GetSecretErr != nil {
+		return nil, c.GetSecretErr
+	}
+	secret, ok := c.Secrets[objectKey(namespace, name)]
+	if !ok {
+		return nil, k8s_errors.NewNotFound(v1.Resource("secrets"), name)
+	}
+	return secret, nil
+}
+
+func (c *SyncFakeClusterContext) GetPodLogs(_ *armadacontext.Context, pod *v1.Pod, options *v1.PodLogOptions) ([]byte, error) {
+	c.rwLock.RLock()
+	defer c.rwLock.RUnlock()
+	logs, ok := c.PodLogs[pod.Name][options.Container]
+	if !ok {
+		return nil, k8s_errors.NewNotFound(v1.Resource("pods/log"), pod.Name)
+	}
+	return []byte(logs), nil
+}
+
+func (c *SyncFakeClusterContext) SubmitPersistentVolumeClaim(claim *v1.PersistentVolumeClaim) (*v1.PersistentVolumeClaim, error) {
+	c.rwLock.Lock()
+	defer c.rwLock.Unlock()
+	c.VolumeClaims[objectKey(claim.Namespace, claim.Name)] = claim
+	return claim, nil
+}
+
+func (c *SyncFakeClusterContext) SubmitNetworkPolicy(policy *networking.NetworkPolicy) (*networking.NetworkPolicy, error) {
+	c.rwLock.Lock()
+	defer c.rwLock.Unlock()
+	c.NetworkPolicies[objectKey(policy.Namespace, policy.Name)] = policy
+	return policy, nil
+}
+
+func (c *SyncFakeClusterContext) AddNetworkPolicyOwnerReference(policy *networking.NetworkPolicy, owner metav1.OwnerReference) error {
+	c.rwLock.Lock()
+	defer c.rwLock.Unlock()
+	saved, ok := c.NetworkPolicies[objectKey(policy.Namespace, policy.Name)]
+	if !ok {
+		return k8s_errors.NewNotFound(networking.Resource("networkpolicies"), policy.Name)
+	}
+	saved.OwnerReferences = append(saved.OwnerReferences, owner)
+	return nil
+}
+
+func (c *SyncFakeClusterContext) DeleteAssociatedObjects(pod *v1.Pod) error {
+	c.rwLock.Lock()
+	defer c.rwLock.Unlock()
+	jobId := util2.ExtractJobId(pod)
+	isAssociated := func(labels map[string]string) bool {
+		return jobId != "" && labels[domain.JobId] == jobId
+	}
+	for key, configMap := range c.ConfigMaps {
+		if isAssociated(configMap.Labels) {
+			delete(c.ConfigMaps, key)
+		}
+	}
+	for key, secret := range c.Secrets {
+		if isAssociated(secret.Labels) {
+			delete(c.Secrets, key)
+		}
+	}
+	for key, claim := range c.VolumeClaims {
+		if isAssociated(claim.Labels) {
+			delete(c.VolumeClaims, key)
+		}
+	}
+	for key, policy := range c.NetworkPolicies {
+		if isAssociated(policy.Labels) {
+			delete(c.NetworkPolicies, key)
+		}
+	}
+	return nil
+}
+
+func objectKey(namespace string, name string) string {
+	return namespace + "/" + name
+}
+
 func (c *SyncFakeClusterContext) SubmitPod(pod *v1.Pod, owner string, ownerGroups []string) (*v1.Pod, error) {
 	c.rwLock.Lock()
 	defer c.rwLock.Unlock()
@@ -155,6 +281,26 @@ func (c *SyncFakeClusterContext) GetClusterPool() string {
 	return "pool"
 }
 
+func (c *SyncFakeClusterContext) UpdateNode(node *v1.Node) error {
+	c.rwLock.Lock()
+	defer c.rwLock.Unlock()
+	if c.UpdateNodeErr != nil {
+		return c.UpdateNodeErr
+	}
+	if _, ok := c.Nodes[node.Name]; !ok {
+		return fmt.Errorf("missing node to update: %s", node.Name)
+	}
+	c.Nodes[node.Name] = node.DeepCopy()
+	return nil
+}
+
+func (c *SyncFakeClusterContext) AddNodeEvent(node *v1.Node, eventType string, reason string, message string) error {
+	c.rwLock.Lock()
+	defer c.rwLock.Unlock()
+	c.NodeEvents[node.Name] = append(c.NodeEvents[node.Name], &v1.Event{Type: eventType, Reason: reason, Message: message})
+	return nil
+}
+
 func (c *SyncFakeClusterContext) GetNodeStatsSummary(ctx *armadacontext.Context, node *v1.Node) (*v1alpha1.Summary, error) {
 	return &v1alpha1.Summary{}, nil
 }
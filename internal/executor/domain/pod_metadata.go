@@ -8,10 +8,14 @@ const (
 	JobSetId                 = "armada_jobset_id"
 	Queue                    = "armada_queue_id"
 	Owner                    = "armada_owner"
+	NetworkGroup             = "armada_network_group"
 	HasIngress               = "has_ingress"
+	HasAssociatedObjects     = "has_associated_objects"
 	AssociatedIngressesCount = "associated_ingresses_count"
 	AssociatedServicesCount  = "associated_services_count"
 	IngressReported          = "ingress_reported"
 	MarkedForDeletion        = "deletion_requested"
 	JobPreemptedAnnotation   = "reported_preempted"
+	// Annotation identifying the cluster whose executor quarantined a node.
+	NodeQuarantinedBy = "armada_quarantined_by"
 )
@@ -208,6 +208,38 @@ func (c *FakeClusterContext) DeleteIngress(ingress *networking.Ingress) error {
 	return errors.Errorf("Ingresses not implemented in FakeClusterContext")
 }
 
+func (c *FakeClusterContext) SubmitConfigMap(configMap *v1.ConfigMap) (*v1.ConfigMap, error) {
+	return nil, errors.Errorf("ConfigMaps not implemented in FakeClusterContext")
+}
+
+func (c *FakeClusterContext) SubmitSecret(secret *v1.Secret) (*v1.Secret, error) {
+	return nil, errors.Errorf("Secrets not implemented in FakeClusterContext")
+}
+
+func (c *FakeClusterContext) GetSecret(namespace string, name string) (*v1.Secret, error) {
+	return nil, errors.Errorf("Secrets not implemented in FakeClusterContext")
+}
+
+func (c *FakeClusterContext) GetPodLogs(ctx *armadacontext.Context, pod *v1.Pod, options *v1.PodLogOptions) ([]byte, error) {
+	return nil, errors.Errorf("Pod logs not implemented in FakeClusterContext")
+}
+
+func (c *FakeClusterContext) SubmitPersistentVolumeClaim(claim *v1.PersistentVolumeClaim) (*v1.PersistentVolumeClaim, error) {
+	return nil, errors.Errorf("PersistentVolumeClaims not implemented in FakeClusterContext")
+}
+
+func (c *FakeClusterContext) SubmitNetworkPolicy(policy *networking.NetworkPolicy) (*networking.NetworkPolicy, error) {
+	return nil, errors.Errorf("NetworkPolicies not implemented in FakeClusterContext")
+}
+
+func (c *FakeClusterContext) AddNetworkPolicyOwnerReference(policy *networking.NetworkPolicy, owner metav1.OwnerReference) error {
+	return errors.Errorf("NetworkPolicies not implemented in FakeClusterContext")
+}
+
+func (c *FakeClusterContext) DeleteAssociatedObjects(pod *v1.Pod) error {
+	return errors.Errorf("ConfigMaps, Secrets, PersistentVolumeClaims and NetworkPolicies not implemented in FakeClusterContext")
+}
+
 func (c *FakeClusterContext) updateStatus(saved *v1.Pod, phase v1.PodPhase, state v1.ContainerState) (*v1.Pod, *v1.Pod) {
 	c.rwLock.Lock()
 	oldPod := saved.DeepCopy()
@@ -295,6 +327,23 @@ func (c *FakeClusterContext) GetClusterPool() string {
 	return c.pool
 }
 
+func (c *FakeClusterContext) UpdateNode(node *v1.Node) error {
+	c.rwLock.Lock()
+	defer c.rwLock.Unlock()
+	for _, n := range c.nodes {
+		if n.Name == node.Name {
+			n.Spec.Taints = node.Spec.Taints
+			n.Annotations = node.Annotations
+			return nil
+		}
+	}
+	return errors.Errorf("missing node to update: %s", node.Name)
+}
+
+func (c *FakeClusterContext) AddNodeEvent(node *v1.Node, eventType string, reason string, message string) error {
+	return nil
+}
+
 func (c *FakeClusterContext) GetNodeStatsSummary(ctx *armadacontext.Context, node *v1.Node) (*v1alpha1.Summary, error) {
 	return &v1alpha1.Summary{}, nil
 }
@@ -0,0 +1,126 @@
+package context
+
+import (
+	v1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	log "github.com/armadaproject/armada/internal/common/logging"
+	armadaresource "github.com/armadaproject/armada/internal/common/resource"
+	cluster_context "github.com/armadaproject/armada/internal/executor/context"
+	"github.com/armadaproject/armada/internal/executor/domain"
+)
+
+const shadowModeMessage = "executor is running in shadow mode"
+
+// ShadowClusterContext runs an executor against a real cluster without changing it.
+// Reads, e.g., of nodes and pods, are passed to the wrapped cluster context, so the executor reports the cluster's real nodes.
+// Writes are logged instead of made. In particular, creating a pod fails with a recoverable error, so its lease is returned to the scheduler.
+type ShadowClusterContext struct {
+	cluster_context.ClusterContext
+}
+
+func NewShadowClusterContext(clusterContext cluster_context.ClusterContext) *ShadowClusterContext {
+	return &ShadowClusterContext{ClusterContext: clusterContext}
+}
+
+func (c *ShadowClusterContext) SubmitPod(pod *v1.Pod, owner string, ownerGroups []string) (*v1.Pod, error) {
+	log.Infof(
+		"Shadow mode: would have created pod %s/%s for job %s of queue %s owned by %s, requesting %s",
+		pod.Namespace, pod.Name, pod.Labels[domain.JobId], pod.Labels[domain.Queue], owner,
+		armadaresource.TotalPodResourceRequest(&pod.Spec),
+	)
+	return nil, shadowModeError("pod", pod.Name)
+}
+
+// GetSecret doesn't read job secrets, as they're only read to be copied for pods that won't be created.
+// It fails with a recoverable error, so the job's lease is returned rather than the job failed.
+func (c *ShadowClusterContext) GetSecret(namespace string, name string) (*v1.Secret, error) {
+	log.Infof("Shadow mode: would have read secret %s/%s", namespace, name)
+	return nil, shadowModeError("secret", name)
+}
+
+func (c *ShadowClusterContext) SubmitService(service *v1.Service) (*v1.Service, error) {
+	log.Infof("Shadow mode: would have created service %s/%s", service.Namespace, service.Name)
+	return nil, shadowModeError("service", service.Name)
+}
+
+func (c *ShadowClusterContext) SubmitIngress(ingress *networking.Ingress) (*networking.Ingress, error) {
+	log.Infof("Shadow mode: would have created ingress %s/%s", ingress.Namespace, ingress.Name)
+	return nil, shadowModeError("ingress", ingress.Name)
+}
+
+func (c *ShadowClusterContext) SubmitConfigMap(configMap *v1.ConfigMap) (*v1.ConfigMap, error) {
+	log.Infof("Shadow mode: would have created config map %s/%s", configMap.Namespace, configMap.Name)
+	return nil, shadowModeError("config map", configMap.Name)
+}
+
+func (c *ShadowClusterContext) SubmitSecret(secret *v1.Secret) (*v1.Secret, error) {
+	log.Infof("Shadow mode: would have created secret %s/%s", secret.Namespace, secret.Name)
+	return nil, shadowModeError("secret", secret.Name)
+}
+
+func (c *ShadowClusterContext) SubmitPersistentVolumeClaim(claim *v1.PersistentVolumeClaim) (*v1.PersistentVolumeClaim, error) {
+	log.Infof("Shadow mode: would have created persistent volume claim %s/%s", claim.Namespace, claim.Name)
+	return nil, shadowModeError("persistent volume claim", claim.Name)
+}
+
+func (c *ShadowClusterContext) SubmitNetworkPolicy(policy *networking.NetworkPolicy) (*networking.NetworkPolicy, error) {
+	log.Infof("Shadow mode: would have created network policy %s/%s", policy.Namespace, policy.Name)
+	return nil, shadowModeError("network policy", policy.Name)
+}
+
+func (c *ShadowClusterContext) DeletePodWithCondition(pod *v1.Pod, condition func(pod *v1.Pod) bool, pessimistic bool) error {
+	log.Infof("Shadow mode: would have deleted pod %s/%s", pod.Namespace, pod.Name)
+	return nil
+}
+
+func (c *ShadowClusterContext) DeletePods(pods []*v1.Pod) {
+	for _, pod := range pods {
+		log.Infof("Shadow mode: would have deleted pod %s/%s", pod.Namespace, pod.Name)
+	}
+}
+
+func (c *ShadowClusterContext) DeleteService(service *v1.Service) error {
+	log.Infof("Shadow mode: would have deleted service %s/%s", service.Namespace, service.Name)
+	return nil
+}
+
+func (c *ShadowClusterContext) DeleteIngress(ingress *networking.Ingress) error {
+	log.Infof("Shadow mode: would have deleted ingress %s/%s", ingress.Namespace, ingress.Name)
+	return nil
+}
+
+func (c *ShadowClusterContext) DeleteAssociatedObjects(pod *v1.Pod) error {
+	log.Infof("Shadow mode: would have deleted objects associated with pod %s/%s", pod.Namespace, pod.Name)
+	return nil
+}
+
+func (c *ShadowClusterContext) AddAnnotation(pod *v1.Pod, annotations map[string]string) error {
+	log.Infof("Shadow mode: would have annotated pod %s/%s with %v", pod.Namespace, pod.Name, annotations)
+	return nil
+}
+
+func (c *ShadowClusterContext) AddNetworkPolicyOwnerReference(policy *networking.NetworkPolicy, owner metav1.OwnerReference) error {
+	log.Infof("Shadow mode: would have made network policy %s/%s owned by %s %s", policy.Namespace, policy.Name, owner.Kind, owner.Name)
+	return nil
+}
+
+func (c *ShadowClusterContext) UpdateNode(node *v1.Node) error {
+	log.Infof("Shadow mode: would have set the taints of node %s to %v", node.Name, node.Spec.Taints)
+	return nil
+}
+
+func (c *ShadowClusterContext) AddNodeEvent(node *v1.Node, eventType string, reason string, message string) error {
+	log.Infof("Shadow mode: would have added %s event %s to node %s: %s", eventType, reason, node.Name, message)
+	return nil
+}
+
+func shadowModeError(resourceType string, name string) error {
+	return &armadaerrors.ErrCreateResource{
+		Type:    resourceType,
+		Name:    name,
+		Message: shadowModeMessage,
+	}
+}
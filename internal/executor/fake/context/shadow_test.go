@@ -0,0 +1,71 @@
+package context
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	fakecontext "github.com/armadaproject/armada/internal/executor/context/fake"
+)
+
+func TestShadowClusterContext_SubmitPod_DoesNotCreatePod(t *testing.T) {
+	clusterContext := fakecontext.NewSyncFakeClusterContext()
+	shadowContext := NewShadowClusterContext(clusterContext)
+
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod-1", Namespace: "default"}}
+	result, err := shadowContext.SubmitPod(pod, "user", nil)
+
+	assert.Nil(t, result)
+	var createErr *armadaerrors.ErrCreateResource
+	assert.True(t, errors.As(err, &createErr))
+	assert.Empty(t, clusterContext.Pods)
+}
+
+func TestShadowClusterContext_ReadsFromCluster(t *testing.T) {
+	clusterContext := fakecontext.NewSyncFakeClusterContext()
+	clusterContext.Nodes["node-1"] = &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+	shadowContext := NewShadowClusterContext(clusterContext)
+
+	nodes, err := shadowContext.GetNodes()
+	require.NoError(t, err)
+	assert.Len(t, nodes, 1)
+	assert.Equal(t, "node-1", nodes[0].Name)
+}
+
+func TestShadowClusterContext_DoesNotChangeCluster(t *testing.T) {
+	clusterContext := fakecontext.NewSyncFakeClusterContext()
+	node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+	clusterContext.Nodes[node.Name] = node
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod-1", Namespace: "default"}}
+	_, err := clusterContext.SubmitPod(pod, "user", nil)
+	require.NoError(t, err)
+	shadowContext := NewShadowClusterContext(clusterContext)
+
+	shadowContext.DeletePods([]*v1.Pod{pod})
+	assert.NoError(t, shadowContext.AddAnnotation(pod, map[string]string{"key": "value"}))
+	tainted := node.DeepCopy()
+	tainted.Spec.Taints = []v1.Taint{{Key: "key", Effect: v1.TaintEffectNoSchedule}}
+	assert.NoError(t, shadowContext.UpdateNode(tainted))
+	assert.NoError(t, shadowContext.AddNodeEvent(node, v1.EventTypeWarning, "reason", "message"))
+
+	assert.Len(t, clusterContext.Pods, 1)
+	assert.Empty(t, clusterContext.AnnotationsAdded)
+	assert.Empty(t, clusterContext.Nodes[node.Name].Spec.Taints)
+	assert.Empty(t, clusterContext.NodeEvents)
+}
+
+func TestShadowClusterContext_GetSecret_IsRecoverable(t *testing.T) {
+	clusterContext := fakecontext.NewSyncFakeClusterContext()
+	shadowContext := NewShadowClusterContext(clusterContext)
+
+	result, err := shadowContext.GetSecret("secrets", "secret-1")
+
+	assert.Nil(t, result)
+	var createErr *armadaerrors.ErrCreateResource
+	assert.True(t, errors.As(err, &createErr))
+}
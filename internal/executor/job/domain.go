@@ -5,12 +5,16 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
+
+	"github.com/armadaproject/armada/internal/executor/util"
 )
 
 type SubmitJobMeta struct {
 	RunMeta         *RunMeta
 	Owner           string
 	OwnershipGroups []string
+	// Labels of the job's queue when the run was leased.
+	QueueLabels map[string]string
 }
 
 type SubmitJob struct {
@@ -18,6 +22,10 @@ type SubmitJob struct {
 	Pod       *v1.Pod
 	Ingresses []*networking.Ingress
 	Services  []*v1.Service
+	// ConfigMaps, Secrets and PersistentVolumeClaims created alongside the pod.
+	ConfigMaps             []*v1.ConfigMap
+	Secrets                []*util.JobSecret
+	PersistentVolumeClaims []*v1.PersistentVolumeClaim
 }
 
 type RunMeta struct {
@@ -31,16 +31,35 @@ type JobRunStateStore struct {
 	jobRunState    map[string]*RunState
 	lock           sync.Mutex
 	clusterContext context.ClusterContext
+	// Optional durable storage for runs without a pod. May be nil.
+	persister RunStatePersister
+	// Ids of runs whose durable state is out of date. Guarded by lock.
+	unpersisted map[string]bool
+	// Serialises writes to the persister, which are made without holding lock so that disk latency doesn't block
+	// other reads and writes of run state.
+	persistLock sync.Mutex
 }
 
-func NewJobRunStateStore(clusterContext context.ClusterContext) *JobRunStateStore {
+// NewJobRunStateStore creates a store holding the runs known to the executor.
+// On start up, runs with a pod are rebuilt from Kubernetes. If a persister is given, runs stored by a previous
+// instance of the executor that never got a pod are restored too, so leased runs are still submitted and
+// submitted runs whose pod never appeared are reported as missing, rather than being forgotten.
+func NewJobRunStateStore(clusterContext context.ClusterContext, persister RunStatePersister) *JobRunStateStore {
 	stateStore := &JobRunStateStore{
 		jobRunState:    map[string]*RunState{},
 		lock:           sync.Mutex{},
 		clusterContext: clusterContext,
+		persister:      persister,
+		unpersisted:    map[string]bool{},
 	}
 
-	_, err := clusterContext.AddPodEventHandler(cache.ResourceEventHandlerFuncs{
+	// Restore persisted runs before reconciling with Kubernetes, so runs that did get a pod are then marked active.
+	err := stateStore.restorePersistedState()
+	if err != nil {
+		panic(err)
+	}
+
+	_, err = clusterContext.AddPodEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
 			pod, ok := obj.(*v1.Pod)
 			if !ok {
@@ -70,6 +89,7 @@ func NewJobRunStateStoreWithInitialState(initialJobRuns []*RunState) *JobRunStat
 	stateStore := &JobRunStateStore{
 		jobRunState: map[string]*RunState{},
 		lock:        sync.Mutex{},
+		unpersisted: map[string]bool{},
 	}
 	for _, jobRun := range initialJobRuns {
 		stateStore.jobRunState[jobRun.Meta.RunId] = jobRun
@@ -91,7 +111,74 @@ func (stateStore *JobRunStateStore) initialiseStateFromKubernetes() error {
 	return nil
 }
 
+func (stateStore *JobRunStateStore) restorePersistedState() error {
+	if stateStore.persister == nil {
+		return nil
+	}
+	states, err := stateStore.persister.Load()
+	if err != nil {
+		return err
+	}
+	for _, state := range states {
+		stateStore.jobRunState[state.Meta.RunId] = state
+	}
+	if len(states) > 0 {
+		log.Infof("Restored %d runs from persisted run state", len(states))
+	}
+	return nil
+}
+
+// persist marks the run's durable state as out of date, so that it's stored or removed, depending on whether it still
+// needs recovering after a restart, by the next call to persistChanges. Must be called while holding the lock.
+func (stateStore *JobRunStateStore) persist(state *RunState) {
+	if stateStore.persister == nil {
+		return
+	}
+	stateStore.unpersisted[state.Meta.RunId] = true
+}
+
+// persistChanges brings the durable state of the runs marked by persist up to date. It snapshots them while holding
+// the lock and writes them after releasing it, so must be called without holding the lock. Writes are serialised, and
+// each writes the latest state of its runs, so an older state never overwrites a newer one.
+func (stateStore *JobRunStateStore) persistChanges() {
+	if stateStore.persister == nil {
+		return
+	}
+	stateStore.persistLock.Lock()
+	defer stateStore.persistLock.Unlock()
+
+	stateStore.lock.Lock()
+	snapshots := make(map[string]*RunState, len(stateStore.unpersisted))
+	for runId := range stateStore.unpersisted {
+		if state, present := stateStore.jobRunState[runId]; present && requiresPersistence(state.Phase) {
+			snapshots[runId] = state.DeepCopy()
+		} else {
+			snapshots[runId] = nil
+		}
+	}
+	stateStore.unpersisted = map[string]bool{}
+	stateStore.lock.Unlock()
+
+	for runId, state := range snapshots {
+		var err error
+		if state != nil {
+			err = stateStore.persister.Save(state)
+		} else {
+			err = stateStore.persister.Delete(runId)
+		}
+		if err != nil {
+			log.Errorf("Failed to persist state of run %s: %v", runId, err)
+		}
+	}
+}
+
+// requiresPersistence returns true for phases in which a run has no pod, so can't be rebuilt from Kubernetes.
+func requiresPersistence(phase RunPhase) bool {
+	return phase == Leased || phase == SuccessfulSubmission
+}
+
 func (stateStore *JobRunStateStore) reportRunActive(pod *v1.Pod) {
+	defer stateStore.persistChanges()
 	stateStore.lock.Lock()
 	defer stateStore.lock.Unlock()
 
@@ -112,14 +199,19 @@ func (stateStore *JobRunStateStore) reportRunActive(pod *v1.Pod) {
 		}
 		stateStore.jobRunState[runMeta.RunId] = currentState
 	}
+	wasPersisted := present && requiresPersistence(currentState.Phase)
 
 	currentState.Phase = Active
 	currentState.KubernetesId = string(pod.UID)
 	currentState.Job = nil // Now that the job is active, remove the object to save memory
 	currentState.LastPhaseTransitionTime = time.Now()
+	if wasPersisted {
+		stateStore.persist(currentState)
+	}
 }
 
 func (stateStore *JobRunStateStore) ReportRunLeased(runMeta *RunMeta, job *SubmitJob) {
+	defer stateStore.persistChanges()
 	stateStore.lock.Lock()
 	defer stateStore.lock.Unlock()
 	_, present := stateStore.jobRunState[runMeta.RunId]
@@ -131,6 +223,7 @@ func (stateStore *JobRunStateStore) ReportRunLeased(runMeta *RunMeta, job *Submi
 			LastPhaseTransitionTime: time.Now(),
 		}
 		stateStore.jobRunState[runMeta.RunId] = state
+		stateStore.persist(state)
 	} else {
 		log.Warnf("run unexpectedly reported as leased (runId=%s, jobId=%s), state already exists", runMeta.RunId, runMeta.JobId)
 	}
@@ -153,6 +246,7 @@ func (stateStore *JobRunStateStore) ReportRunInvalid(runMeta *RunMeta) {
 }
 
 func (stateStore *JobRunStateStore) ReportSuccessfulSubmission(runId string) {
+	defer stateStore.persistChanges()
 	stateStore.lock.Lock()
 	defer stateStore.lock.Unlock()
 
@@ -163,9 +257,11 @@ func (stateStore *JobRunStateStore) ReportSuccessfulSubmission(runId string) {
 	}
 	currentState.Phase = SuccessfulSubmission
 	currentState.LastPhaseTransitionTime = time.Now()
+	stateStore.persist(currentState)
 }
 
 func (stateStore *JobRunStateStore) ReportFailedSubmission(runId string) {
+	defer stateStore.persistChanges()
 	stateStore.lock.Lock()
 	defer stateStore.lock.Unlock()
 
@@ -176,30 +272,43 @@ func (stateStore *JobRunStateStore) ReportFailedSubmission(runId string) {
 	}
 	currentState.Phase = FailedSubmission
 	currentState.LastPhaseTransitionTime = time.Now()
+	stateStore.persist(currentState)
 }
 
 func (stateStore *JobRunStateStore) RequestRunCancellation(runId string) {
+	defer stateStore.persistChanges()
 	stateStore.lock.Lock()
 	defer stateStore.lock.Unlock()
 
 	if currentState, present := stateStore.jobRunState[runId]; present {
 		currentState.CancelRequested = true
+		if requiresPersistence(currentState.Phase) {
+			stateStore.persist(currentState)
+		}
 	}
 }
 
 func (stateStore *JobRunStateStore) RequestRunPreemption(runId string) {
+	defer stateStore.persistChanges()
 	stateStore.lock.Lock()
 	defer stateStore.lock.Unlock()
 
 	if currentState, present := stateStore.jobRunState[runId]; present {
 		currentState.PreemptionRequested = true
+		if requiresPersistence(currentState.Phase) {
+			stateStore.persist(currentState)
+		}
 	}
 }
 
 func (stateStore *JobRunStateStore) Delete(runId string) {
+	defer stateStore.persistChanges()
 	stateStore.lock.Lock()
 	defer stateStore.lock.Unlock()
 
+	if currentState, present := stateStore.jobRunState[runId]; present && requiresPersistence(currentState.Phase) {
+		stateStore.persist(currentState)
+	}
 	delete(stateStore.jobRunState, runId)
 }
 
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -232,14 +233,127 @@ func TestGetByKubernetesId(t *testing.T) {
 	assert.Equal(t, jobRun.KubernetesId, string(pod2.UID))
 }
 
+func TestPersistence_RestoresRunsWithoutPods(t *testing.T) {
+	persister, err := NewFileRunStatePersister(t.TempDir())
+	require.NoError(t, err)
+	leasedJob := &SubmitJob{Meta: SubmitJobMeta{RunMeta: defaultRunInfoMeta}, Pod: createPod()}
+	submittedRunMeta := &RunMeta{RunId: "run-2", JobId: "job-2", Queue: "queue-1", JobSet: "job-set-1"}
+
+	jobRunStateManager, _ := setupWithPersister(t, []*v1.Pod{}, persister)
+	jobRunStateManager.ReportRunLeased(defaultRunInfoMeta, leasedJob)
+	jobRunStateManager.ReportRunLeased(submittedRunMeta, &SubmitJob{Meta: SubmitJobMeta{RunMeta: submittedRunMeta}, Pod: createPod()})
+	jobRunStateManager.ReportSuccessfulSubmission(submittedRunMeta.RunId)
+	jobRunStateManager.RequestRunCancellation(submittedRunMeta.RunId)
+
+	restarted, _ := setupWithPersister(t, []*v1.Pod{}, persister)
+
+	leased := restarted.Get(defaultRunInfoMeta.RunId)
+	require.NotNil(t, leased)
+	assert.Equal(t, Leased, leased.Phase)
+	assert.Equal(t, defaultRunInfoMeta, leased.Meta)
+	assert.Equal(t, leasedJob.Pod.Name, leased.Job.Pod.Name)
+
+	submitted := restarted.Get(submittedRunMeta.RunId)
+	require.NotNil(t, submitted)
+	assert.Equal(t, SuccessfulSubmission, submitted.Phase)
+	assert.True(t, submitted.CancelRequested)
+}
+
+func TestPersistence_RunsWithPodsAreMarkedActive(t *testing.T) {
+	persister, err := NewFileRunStatePersister(t.TempDir())
+	require.NoError(t, err)
+	pod := createPod()
+	runMeta, err := ExtractJobRunMeta(pod)
+	require.NoError(t, err)
+
+	jobRunStateManager, _ := setupWithPersister(t, []*v1.Pod{}, persister)
+	jobRunStateManager.ReportRunLeased(runMeta, &SubmitJob{Meta: SubmitJobMeta{RunMeta: runMeta}, Pod: pod})
+
+	// The executor restarts after creating the pod, but before recording the submission.
+	restarted, _ := setupWithPersister(t, []*v1.Pod{pod}, persister)
+
+	run := restarted.Get(runMeta.RunId)
+	require.NotNil(t, run)
+	assert.Equal(t, Active, run.Phase)
+	assert.Nil(t, run.Job)
+	persisted, err := persister.Load()
+	require.NoError(t, err)
+	assert.Empty(t, persisted)
+}
+
+func TestPersistence_RemovesRunsThatNoLongerNeedRecovery(t *testing.T) {
+	persister, err := NewFileRunStatePersister(t.TempDir())
+	require.NoError(t, err)
+	otherRunMeta := &RunMeta{RunId: "run-2", JobId: "job-2", Queue: "queue-1", JobSet: "job-set-1"}
+
+	jobRunStateManager, _ := setupWithPersister(t, []*v1.Pod{}, persister)
+	jobRunStateManager.ReportRunLeased(defaultRunInfoMeta, &SubmitJob{Meta: SubmitJobMeta{RunMeta: defaultRunInfoMeta}, Pod: createPod()})
+	jobRunStateManager.ReportRunLeased(otherRunMeta, &SubmitJob{Meta: SubmitJobMeta{RunMeta: otherRunMeta}, Pod: createPod()})
+	jobRunStateManager.ReportFailedSubmission(defaultRunInfoMeta.RunId)
+	jobRunStateManager.Delete(otherRunMeta.RunId)
+
+	persisted, err := persister.Load()
+	require.NoError(t, err)
+	assert.Empty(t, persisted)
+}
+
+func TestPersistence_DoesNotBlockReadsWhileWriting(t *testing.T) {
+	persister := &blockingRunStatePersister{saving: make(chan struct{}), release: make(chan struct{})}
+	jobRunStateManager, _ := setupWithPersister(t, []*v1.Pod{}, persister)
+
+	leased := make(chan struct{})
+	go func() {
+		jobRunStateManager.ReportRunLeased(defaultRunInfoMeta, &SubmitJob{Meta: SubmitJobMeta{RunMeta: defaultRunInfoMeta}, Pod: createPod()})
+		close(leased)
+	}()
+	<-persister.saving
+
+	read := make(chan *RunState)
+	go func() { read <- jobRunStateManager.Get(defaultRunInfoMeta.RunId) }()
+	select {
+	case state := <-read:
+		require.NotNil(t, state)
+		assert.Equal(t, Leased, state.Phase)
+	case <-time.After(5 * time.Second):
+		t.Fatal("reading run state was blocked by persisting it")
+	}
+
+	close(persister.release)
+	<-leased
+}
+
+// blockingRunStatePersister blocks saving runs until released
+type blockingRunStatePersister struct {
+	saving  chan struct{}
+	release chan struct{}
+}
+
+func (p *blockingRunStatePersister) Save(_ *RunState) error {
+	close(p.saving)
+	<-p.release
+	return nil
+}
+
+func (p *blockingRunStatePersister) Delete(_ string) error {
+	return nil
+}
+
+func (p *blockingRunStatePersister) Load() ([]*RunState, error) {
+	return nil, nil
+}
+
 func setup(t *testing.T, existingPods []*v1.Pod) (*JobRunStateStore, *fakecontext.SyncFakeClusterContext) {
+	return setupWithPersister(t, existingPods, nil)
+}
+
+func setupWithPersister(t *testing.T, existingPods []*v1.Pod, persister RunStatePersister) (*JobRunStateStore, *fakecontext.SyncFakeClusterContext) {
 	executorContext := fakecontext.NewSyncFakeClusterContext()
 	for _, pod := range existingPods {
 		_, err := executorContext.SubmitPod(pod, "test", []string{})
 		assert.NoError(t, err)
 	}
 
-	jobRunStateManager := NewJobRunStateStore(executorContext)
+	jobRunStateManager := NewJobRunStateStore(executorContext, persister)
 	return jobRunStateManager, executorContext
 }
 
@@ -0,0 +1,100 @@
+package job
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	log "github.com/armadaproject/armada/internal/common/logging"
+)
+
+const runStateFileSuffix = ".json"
+
+// RunStatePersister durably stores the state of runs that don't yet have a pod,
+// so the executor can recover them after a restart rather than losing track of them.
+// Runs with a pod don't need to be stored, as their state is rebuilt from Kubernetes.
+type RunStatePersister interface {
+	Save(state *RunState) error
+	Delete(runId string) error
+	// Load returns all stored runs.
+	Load() ([]*RunState, error)
+}
+
+// FileRunStatePersister stores each run as a JSON file in a directory on local disk.
+// The directory should be on a volume that outlives the executor's pod, e.g., a persistent volume.
+type FileRunStatePersister struct {
+	directory string
+}
+
+func NewFileRunStatePersister(directory string) (*FileRunStatePersister, error) {
+	if err := os.MkdirAll(directory, 0o755); err != nil {
+		return nil, errors.Wrapf(err, "failed to create run state directory %s", directory)
+	}
+	return &FileRunStatePersister{directory: directory}, nil
+}
+
+func (p *FileRunStatePersister) Save(state *RunState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	// Write to a temporary file and rename it, so a crash never leaves a partially written run behind.
+	tmpFile, err := os.CreateTemp(p.directory, ".tmp-")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer os.Remove(tmpFile.Name())
+	if _, err := tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		return errors.WithStack(err)
+	}
+	if err := tmpFile.Sync(); err != nil {
+		tmpFile.Close()
+		return errors.WithStack(err)
+	}
+	if err := tmpFile.Close(); err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.Rename(tmpFile.Name(), p.filePath(state.Meta.RunId)))
+}
+
+func (p *FileRunStatePersister) Delete(runId string) error {
+	err := os.Remove(p.filePath(runId))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return errors.WithStack(err)
+}
+
+func (p *FileRunStatePersister) Load() ([]*RunState, error) {
+	entries, err := os.ReadDir(p.directory)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	states := make([]*RunState, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), runStateFileSuffix) {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(p.directory, entry.Name()))
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		state := &RunState{}
+		if err := json.Unmarshal(data, state); err != nil || state.Meta == nil || state.Meta.RunId == "" {
+			// A corrupt file shouldn't stop the executor starting; the run is lost as it would be without persistence.
+			log.Errorf("Ignoring invalid run state file %s: %v", entry.Name(), err)
+			continue
+		}
+		states = append(states, state)
+	}
+	return states, nil
+}
+
+func (p *FileRunStatePersister) filePath(runId string) string {
+	// Run ids are generated by Armada, but guard against them containing path separators regardless.
+	return filepath.Join(p.directory, filepath.Base(runId)+runStateFileSuffix)
+}
@@ -0,0 +1,54 @@
+package job
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileRunStatePersister_SaveLoadDelete(t *testing.T) {
+	persister, err := NewFileRunStatePersister(t.TempDir())
+	require.NoError(t, err)
+	state := &RunState{
+		Meta:                    defaultRunInfoMeta,
+		Job:                     &SubmitJob{Meta: SubmitJobMeta{RunMeta: defaultRunInfoMeta, Owner: "user"}, Pod: createPod()},
+		Phase:                   Leased,
+		LastPhaseTransitionTime: time.Now().UTC().Truncate(time.Second),
+	}
+
+	require.NoError(t, persister.Save(state))
+	loaded, err := persister.Load()
+	require.NoError(t, err)
+	require.Len(t, loaded, 1)
+	assert.Equal(t, state.Meta, loaded[0].Meta)
+	assert.Equal(t, state.Phase, loaded[0].Phase)
+	assert.Equal(t, state.LastPhaseTransitionTime, loaded[0].LastPhaseTransitionTime)
+	assert.Equal(t, state.Job.Meta, loaded[0].Job.Meta)
+	assert.Equal(t, state.Job.Pod, loaded[0].Job.Pod)
+
+	require.NoError(t, persister.Delete(state.Meta.RunId))
+	loaded, err = persister.Load()
+	require.NoError(t, err)
+	assert.Empty(t, loaded)
+
+	// Deleting a run that isn't stored isn't an error.
+	assert.NoError(t, persister.Delete(state.Meta.RunId))
+}
+
+func TestFileRunStatePersister_IgnoresInvalidFiles(t *testing.T) {
+	directory := t.TempDir()
+	persister, err := NewFileRunStatePersister(directory)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "corrupt.json"), []byte("{"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(directory, "other.txt"), []byte("{}"), 0o644))
+	require.NoError(t, persister.Save(&RunState{Meta: defaultRunInfoMeta, Phase: SuccessfulSubmission}))
+
+	loaded, err := persister.Load()
+	require.NoError(t, err)
+	require.Len(t, loaded, 1)
+	assert.Equal(t, defaultRunInfoMeta.RunId, loaded[0].Meta.RunId)
+}
@@ -3,6 +3,7 @@ package job
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -11,6 +12,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/constants"
 	log "github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/common/util"
 	"github.com/armadaproject/armada/internal/executor/configuration"
@@ -28,6 +30,8 @@ type SubmitService struct {
 	podDefaults              *configuration.PodDefaults
 	submissionThreadCount    int
 	fatalPodSubmissionErrors []string
+	jobSecretNamespace       string
+	networkPolicyConfig      configuration.JobNetworkPolicyConfiguration
 }
 
 func NewSubmitter(
@@ -35,12 +39,16 @@ func NewSubmitter(
 	podDefaults *configuration.PodDefaults,
 	submissionThreadCount int,
 	fatalPodSubmissionErrors []string,
+	jobSecretNamespace string,
+	networkPolicyConfig configuration.JobNetworkPolicyConfiguration,
 ) *SubmitService {
 	return &SubmitService{
 		clusterContext:           clusterContext,
 		podDefaults:              podDefaults,
 		submissionThreadCount:    submissionThreadCount,
 		fatalPodSubmissionErrors: fatalPodSubmissionErrors,
+		jobSecretNamespace:       jobSecretNamespace,
+		networkPolicyConfig:      networkPolicyConfig,
 	}
 }
 
@@ -103,13 +111,22 @@ func (submitService *SubmitService) submitWorker(wg *sync.WaitGroup, jobsToSubmi
 
 			// remove just created pods
 			submitService.clusterContext.DeletePods(jobPods)
+
+			// The network policy is created before the pod and has no owner reference until the pod exists,
+			// so it has to be removed explicitly rather than left to the garbage collector.
+			if util2.HasAssociatedObjects(pod) {
+				if err := submitService.clusterContext.DeleteAssociatedObjects(pod); err != nil {
+					log.Errorf("Failed to remove objects associated with job %s because %s", job.Meta.RunMeta.JobId, err)
+				}
+			}
 		}
 	}
 }
 
-// submitPod submits a pod to k8s together with any services and ingresses bundled with the Armada job.
+// submitPod submits a pod to k8s together with any services, ingresses, configmaps, secrets and
+// persistentvolumeclaims bundled with the Armada job.
 // This function may fail partly, i.e., it may successfully create a subset of the requested objects before failing.
-// In case of failure, any already created objects are not cleaned up.
+// In case of failure, any already created objects are not cleaned up here; submitWorker removes them.
 func (submitService *SubmitService) submitPod(job *SubmitJob) (*v1.Pod, error) {
 	pod := job.Pod
 	// Ensure the K8SService and K8SIngress fields are populated
@@ -123,11 +140,44 @@ func (submitService *SubmitService) submitPod(job *SubmitJob) (*v1.Pod, error) {
 		})
 	}
 
+	networkPolicy, err := util2.CreateJobNetworkPolicy(pod, job.Meta.QueueLabels, submitService.networkPolicyConfig)
+	if err != nil {
+		return pod, err
+	}
+
+	if len(job.ConfigMaps) > 0 || len(job.Secrets) > 0 || len(job.PersistentVolumeClaims) > 0 || networkPolicy != nil {
+		pod.Annotations = util.MergeMaps(pod.Annotations, map[string]string{
+			domain.HasAssociatedObjects: "true",
+		})
+	}
+
+	// Resolve secrets before creating anything, so a bad reference doesn't leave a pod behind.
+	secrets, err := submitService.resolveSecrets(job.Meta.RunMeta.Queue, job.Secrets)
+	if err != nil {
+		return pod, err
+	}
+
+	// Created before the pod, so the pod is never running without its network policy.
+	// The owner reference is added once the pod exists, as it needs the pod's uid.
+	if networkPolicy != nil {
+		_, err = submitService.clusterContext.SubmitNetworkPolicy(networkPolicy)
+		if err != nil {
+			return pod, err
+		}
+	}
+
 	submittedPod, err := submitService.clusterContext.SubmitPod(pod, job.Meta.Owner, job.Meta.OwnershipGroups)
 	if err != nil {
 		return pod, err
 	}
 
+	if networkPolicy != nil {
+		err = submitService.clusterContext.AddNetworkPolicyOwnerReference(networkPolicy, util2.CreateOwnerReference(submittedPod))
+		if err != nil {
+			return pod, err
+		}
+	}
+
 	for _, service := range job.Services {
 		service.ObjectMeta.OwnerReferences = []metav1.OwnerReference{util2.CreateOwnerReference(submittedPod)}
 		_, err = submitService.clusterContext.SubmitService(service)
@@ -144,9 +194,82 @@ func (submitService *SubmitService) submitPod(job *SubmitJob) (*v1.Pod, error) {
 		}
 	}
 
+	for _, configMap := range job.ConfigMaps {
+		configMap.ObjectMeta.OwnerReferences = []metav1.OwnerReference{util2.CreateOwnerReference(submittedPod)}
+		_, err = submitService.clusterContext.SubmitConfigMap(configMap)
+		if err != nil {
+			return pod, err
+		}
+	}
+
+	for _, secret := range secrets {
+		secret.ObjectMeta.OwnerReferences = []metav1.OwnerReference{util2.CreateOwnerReference(submittedPod)}
+		_, err = submitService.clusterContext.SubmitSecret(secret)
+		if err != nil {
+			return pod, err
+		}
+	}
+
+	for _, claim := range job.PersistentVolumeClaims {
+		claim.ObjectMeta.OwnerReferences = []metav1.OwnerReference{util2.CreateOwnerReference(submittedPod)}
+		_, err = submitService.clusterContext.SubmitPersistentVolumeClaim(claim)
+		if err != nil {
+			return pod, err
+		}
+	}
+
 	return pod, err
 }
 
+// resolveSecrets creates the secrets to be submitted for a job by copying the referenced secrets
+// from the job secret namespace. Only secrets whose JobSecretQueuesAnnotation lists the job's queue may be copied.
+// Missing, forbidden and disallowed secrets fail the job, whereas other errors, e.g., timeouts, are retried.
+func (submitService *SubmitService) resolveSecrets(queue string, jobSecrets []*util2.JobSecret) ([]*v1.Secret, error) {
+	if len(jobSecrets) == 0 {
+		return nil, nil
+	}
+	if submitService.jobSecretNamespace == "" {
+		return nil, fmt.Errorf("job references secrets but no job secret namespace is configured on this executor")
+	}
+	secrets := make([]*v1.Secret, 0, len(jobSecrets))
+	for _, jobSecret := range jobSecrets {
+		source, err := submitService.clusterContext.GetSecret(submitService.jobSecretNamespace, jobSecret.SourceName)
+		if err != nil {
+			if k8s_errors.IsNotFound(err) {
+				return nil, fmt.Errorf("secret %s not found in job secret namespace %s", jobSecret.SourceName, submitService.jobSecretNamespace)
+			}
+			if k8s_errors.IsForbidden(err) {
+				return nil, err
+			}
+			return nil, &armadaerrors.ErrCreateResource{Type: "secret", Name: jobSecret.ObjectMeta.Name, Message: err.Error()}
+		}
+		if !secretAllowsQueue(source, queue) {
+			return nil, fmt.Errorf(
+				"secret %s in job secret namespace %s may not be used by jobs in queue %s; its %s annotation must list the queue",
+				jobSecret.SourceName, submitService.jobSecretNamespace, queue, constants.JobSecretQueuesAnnotation)
+		}
+		secrets = append(secrets, &v1.Secret{
+			ObjectMeta: jobSecret.ObjectMeta,
+			Immutable:  source.Immutable,
+			Type:       source.Type,
+			Data:       source.Data,
+		})
+	}
+	return secrets, nil
+}
+
+func secretAllowsQueue(secret *v1.Secret, queue string) bool {
+	if queue == "" {
+		return false
+	}
+	for _, allowedQueue := range strings.Split(secret.Annotations[constants.JobSecretQueuesAnnotation], ",") {
+		if strings.TrimSpace(allowedQueue) == queue {
+			return true
+		}
+	}
+	return false
+}
+
 // applyExecutorSpecificIngressDetails populates the executor specific details on ingresses
 // These objects are mostly created server side however there will be details that are not known until submit time
 // So the executor must fill them in before it creates the objects in kubernetes
@@ -5,12 +5,19 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
 	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/constants"
 	"github.com/armadaproject/armada/internal/executor/configuration"
+	"github.com/armadaproject/armada/internal/executor/context/fake"
+	"github.com/armadaproject/armada/internal/executor/domain"
 	"github.com/armadaproject/armada/internal/executor/fake/context"
+	"github.com/armadaproject/armada/internal/executor/util"
 )
 
 const (
@@ -27,7 +34,7 @@ func TestIsRecoverable_ArbitraryErrorIsNotRecoverable(t *testing.T) {
 		AdmissionWebhookRegex,
 		HelloRegex,
 		NamespaceNotFoundRegex,
-	})
+	}, "", configuration.JobNetworkPolicyConfiguration{})
 
 	recoverable := submitter.isRecoverable(newArbitraryError("some error"))
 	assert.False(t, recoverable)
@@ -35,7 +42,7 @@ func TestIsRecoverable_ArbitraryErrorIsNotRecoverable(t *testing.T) {
 
 func TestIsRecoverable_KubernetesStatusInvalidIsUnrecoverable(t *testing.T) {
 	clusterContext := context.NewFakeClusterContext(testAppConfig, "kubernetes.io/hostname", []*context.NodeSpec{})
-	submitter := NewSubmitter(clusterContext, &configuration.PodDefaults{}, 1, []string{})
+	submitter := NewSubmitter(clusterContext, &configuration.PodDefaults{}, 1, []string{}, "", configuration.JobNetworkPolicyConfiguration{})
 
 	recoverable := submitter.isRecoverable(newK8sApiError("", metav1.StatusReasonInvalid))
 	assert.False(t, recoverable)
@@ -43,7 +50,7 @@ func TestIsRecoverable_KubernetesStatusInvalidIsUnrecoverable(t *testing.T) {
 
 func TestIsRecoverable_KubernetesStatusForbiddenIsUnrecoverable(t *testing.T) {
 	clusterContext := context.NewFakeClusterContext(testAppConfig, "kubernetes.io/hostname", []*context.NodeSpec{})
-	submitter := NewSubmitter(clusterContext, &configuration.PodDefaults{}, 1, []string{})
+	submitter := NewSubmitter(clusterContext, &configuration.PodDefaults{}, 1, []string{}, "", configuration.JobNetworkPolicyConfiguration{})
 
 	recoverable := submitter.isRecoverable(newK8sApiError("", metav1.StatusReasonForbidden))
 	assert.False(t, recoverable)
@@ -55,7 +62,7 @@ func TestIsRecoverable_K8sApiMatchingRegexIsUnrecoverable(t *testing.T) {
 		AdmissionWebhookRegex,
 		HelloRegex,
 		NamespaceNotFoundRegex,
-	})
+	}, "", configuration.JobNetworkPolicyConfiguration{})
 
 	recoverable := submitter.isRecoverable(newK8sApiError("admission webhook failure: some webhook failed validation", "other status"))
 	assert.False(t, recoverable)
@@ -72,12 +79,226 @@ func TestIsRecoverable_K8sApiMatchingRegexIsUnrecoverable(t *testing.T) {
 
 func TestIsRecoverable_ArmadaErrCreateResourceIsRecoverable(t *testing.T) {
 	clusterContext := context.NewFakeClusterContext(testAppConfig, "kubernetes.io/hostname", []*context.NodeSpec{})
-	submitter := NewSubmitter(clusterContext, &configuration.PodDefaults{}, 1, []string{})
+	submitter := NewSubmitter(clusterContext, &configuration.PodDefaults{}, 1, []string{}, "", configuration.JobNetworkPolicyConfiguration{})
 
 	recoverable := submitter.isRecoverable(newArmadaErrCreateResource())
 	assert.True(t, recoverable)
 }
 
+func TestSubmitJobs_CreatesAssociatedObjects(t *testing.T) {
+	clusterContext := fake.NewSyncFakeClusterContext()
+	_, err := clusterContext.SubmitSecret(createJobSecretSource("other-queue, queue"))
+	require.NoError(t, err)
+	submitter := NewSubmitter(clusterContext, &configuration.PodDefaults{}, 1, []string{}, "job-secrets", configuration.JobNetworkPolicyConfiguration{})
+
+	job := createSubmitJobWithAssociatedObjects()
+	failed := submitter.SubmitJobs([]*SubmitJob{job})
+	require.Empty(t, failed)
+
+	assert.Equal(t, "true", job.Pod.Annotations[domain.HasAssociatedObjects])
+	ownerReference := util.CreateOwnerReference(job.Pod)
+
+	configMap := clusterContext.ConfigMaps["test-namespace/job-config"]
+	require.NotNil(t, configMap)
+	assert.Equal(t, []metav1.OwnerReference{ownerReference}, configMap.OwnerReferences)
+
+	secret := clusterContext.Secrets["test-namespace/job-creds"]
+	require.NotNil(t, secret)
+	assert.Equal(t, map[string][]byte{"token": []byte("secret")}, secret.Data)
+	assert.Equal(t, v1.SecretTypeOpaque, secret.Type)
+	assert.Equal(t, []metav1.OwnerReference{ownerReference}, secret.OwnerReferences)
+
+	claim := clusterContext.VolumeClaims["test-namespace/job-scratch"]
+	require.NotNil(t, claim)
+	assert.Equal(t, []metav1.OwnerReference{ownerReference}, claim.OwnerReferences)
+}
+
+func TestSubmitJobs_CreatesNetworkPolicy(t *testing.T) {
+	isolatedQueues := metav1.LabelSelector{MatchLabels: map[string]string{"network-isolation": "true"}}
+	tests := map[string]struct {
+		queueSelector         metav1.LabelSelector
+		queueLabels           map[string]string
+		expectedNetworkPolicy bool
+	}{
+		"all queues isolated":      {expectedNetworkPolicy: true},
+		"job's queue isolated":     {queueSelector: isolatedQueues, queueLabels: map[string]string{"network-isolation": "true"}, expectedNetworkPolicy: true},
+		"job's queue not isolated": {queueSelector: isolatedQueues, queueLabels: map[string]string{"team": "a"}, expectedNetworkPolicy: false},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			clusterContext := fake.NewSyncFakeClusterContext()
+			networkPolicyConfig := configuration.JobNetworkPolicyConfiguration{
+				Enabled:       true,
+				Scope:         configuration.JobNetworkPolicyScopeGang,
+				QueueSelector: tc.queueSelector,
+			}
+			submitter := NewSubmitter(clusterContext, &configuration.PodDefaults{}, 1, []string{}, "", networkPolicyConfig)
+
+			job := createSubmitJob()
+			job.Meta.QueueLabels = tc.queueLabels
+			failed := submitter.SubmitJobs([]*SubmitJob{job})
+			require.Empty(t, failed)
+
+			networkPolicy := clusterContext.NetworkPolicies["test-namespace/armada-job-1-0"]
+			if !tc.expectedNetworkPolicy {
+				assert.Nil(t, networkPolicy)
+				assert.NotContains(t, job.Pod.Labels, domain.NetworkGroup)
+				return
+			}
+			require.NotNil(t, networkPolicy)
+			assert.Equal(t, []metav1.OwnerReference{util.CreateOwnerReference(job.Pod)}, networkPolicy.OwnerReferences)
+			assert.Equal(t, "true", job.Pod.Annotations[domain.HasAssociatedObjects])
+			assert.NotEmpty(t, job.Pod.Labels[domain.NetworkGroup])
+		})
+	}
+}
+
+func TestSubmitJobs_CreatesNetworkPolicyBeforePod(t *testing.T) {
+	clusterContext := &podSubmissionRecordingClusterContext{SyncFakeClusterContext: fake.NewSyncFakeClusterContext()}
+	networkPolicyConfig := configuration.JobNetworkPolicyConfiguration{Enabled: true, Scope: configuration.JobNetworkPolicyScopeGang}
+	submitter := NewSubmitter(clusterContext, &configuration.PodDefaults{}, 1, []string{}, "", networkPolicyConfig)
+
+	job := createSubmitJob()
+	failed := submitter.SubmitJobs([]*SubmitJob{job})
+	require.Empty(t, failed)
+
+	require.NotNil(t, clusterContext.networkPolicyOnPodSubmission)
+	assert.Equal(t, "armada-job-1-0", clusterContext.networkPolicyOnPodSubmission.Name)
+	assert.Equal(t, map[string]string{domain.JobId: "job-1", domain.Queue: "queue"}, clusterContext.networkPolicyOnPodSubmission.Spec.PodSelector.MatchLabels)
+}
+
+func TestSubmitJobs_RemovesNetworkPolicyWhenPodSubmissionFails(t *testing.T) {
+	clusterContext := &podSubmissionRecordingClusterContext{
+		SyncFakeClusterContext: fake.NewSyncFakeClusterContext(),
+		submitPodErr:           newArmadaErrCreateResource(),
+	}
+	networkPolicyConfig := configuration.JobNetworkPolicyConfiguration{Enabled: true, Scope: configuration.JobNetworkPolicyScopeGang}
+	submitter := NewSubmitter(clusterContext, &configuration.PodDefaults{}, 1, []string{}, "", networkPolicyConfig)
+
+	failed := submitter.SubmitJobs([]*SubmitJob{createSubmitJob()})
+
+	require.Len(t, failed, 1)
+	assert.NotNil(t, clusterContext.networkPolicyOnPodSubmission)
+	assert.Empty(t, clusterContext.NetworkPolicies)
+}
+
+func TestSubmitJobs_SecretReferenceFailures(t *testing.T) {
+	tests := map[string]struct {
+		jobSecretNamespace  string
+		source              *v1.Secret
+		getSecretErr        error
+		expectedRecoverable bool
+	}{
+		"no job secret namespace configured": {jobSecretNamespace: ""},
+		"secret not found":                   {jobSecretNamespace: "job-secrets"},
+		"secret not annotated":               {jobSecretNamespace: "job-secrets", source: createJobSecretSource("")},
+		"secret for another queue":           {jobSecretNamespace: "job-secrets", source: createJobSecretSource("other-queue")},
+		"secret forbidden": {
+			jobSecretNamespace: "job-secrets",
+			getSecretErr:       k8s_errors.NewForbidden(v1.Resource("secrets"), "team-creds", errors.New("forbidden")),
+		},
+		"transient error": {
+			jobSecretNamespace:  "job-secrets",
+			getSecretErr:        errors.New("connection refused"),
+			expectedRecoverable: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			clusterContext := fake.NewSyncFakeClusterContext()
+			if tc.source != nil {
+				_, err := clusterContext.SubmitSecret(tc.source)
+				require.NoError(t, err)
+			}
+			clusterContext.GetSecretErr = tc.getSecretErr
+			submitter := NewSubmitter(clusterContext, &configuration.PodDefaults{}, 1, []string{}, tc.jobSecretNamespace, configuration.JobNetworkPolicyConfiguration{})
+
+			failed := submitter.SubmitJobs([]*SubmitJob{createSubmitJobWithAssociatedObjects()})
+
+			require.Len(t, failed, 1)
+			assert.Equal(t, tc.expectedRecoverable, failed[0].Recoverable)
+			assert.Empty(t, clusterContext.Pods)
+			assert.Empty(t, clusterContext.ConfigMaps)
+		})
+	}
+}
+
+// podSubmissionRecordingClusterContext records the network policy of the job, if any, at the time its pod is submitted.
+type podSubmissionRecordingClusterContext struct {
+	*fake.SyncFakeClusterContext
+	submitPodErr                 error
+	networkPolicyOnPodSubmission *networking.NetworkPolicy
+}
+
+func (c *podSubmissionRecordingClusterContext) SubmitPod(pod *v1.Pod, owner string, ownerGroups []string) (*v1.Pod, error) {
+	c.networkPolicyOnPodSubmission = c.NetworkPolicies[pod.Namespace+"/"+pod.Name]
+	if c.submitPodErr != nil {
+		return nil, c.submitPodErr
+	}
+	return c.SyncFakeClusterContext.SubmitPod(pod, owner, ownerGroups)
+}
+
+func createJobSecretSource(queues string) *v1.Secret {
+	return &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "job-secrets",
+			Name:        "team-creds",
+			Annotations: map[string]string{constants.JobSecretQueuesAnnotation: queues},
+		},
+		Type: v1.SecretTypeOpaque,
+		Data: map[string][]byte{"token": []byte("secret")},
+	}
+}
+
+func createSubmitJob() *SubmitJob {
+	return &SubmitJob{
+		Meta: SubmitJobMeta{
+			RunMeta: &RunMeta{JobId: "job-1", RunId: "run-1", JobSet: "job-set", Queue: "queue"},
+		},
+		Pod: &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "test-namespace",
+				Name:        "armada-job-1-0",
+				UID:         "pod-uid",
+				Labels:      map[string]string{domain.JobId: "job-1", domain.Queue: "queue"},
+				Annotations: map[string]string{domain.JobSetId: "job-set"},
+			},
+		},
+	}
+}
+
+func createSubmitJobWithAssociatedObjects() *SubmitJob {
+	objectMeta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Namespace: "test-namespace",
+			Name:      name,
+			Labels:    map[string]string{domain.JobId: "job-1"},
+		}
+	}
+	return &SubmitJob{
+		Meta: SubmitJobMeta{
+			RunMeta: &RunMeta{JobId: "job-1", RunId: "run-1", JobSet: "job-set", Queue: "queue"},
+		},
+		Pod: &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test-namespace",
+				Name:      "armada-job-1-0",
+				UID:       "pod-uid",
+				Labels:    map[string]string{domain.JobId: "job-1"},
+			},
+		},
+		ConfigMaps: []*v1.ConfigMap{
+			{ObjectMeta: objectMeta("job-config"), Data: map[string]string{"key": "value"}},
+		},
+		Secrets: []*util.JobSecret{
+			{ObjectMeta: objectMeta("job-creds"), SourceName: "team-creds"},
+		},
+		PersistentVolumeClaims: []*v1.PersistentVolumeClaim{
+			{ObjectMeta: objectMeta("job-scratch")},
+		},
+	}
+}
+
 func newK8sApiError(message string, reason metav1.StatusReason) *k8s_errors.StatusError {
 	return &k8s_errors.StatusError{
 		ErrStatus: metav1.Status{
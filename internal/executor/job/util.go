@@ -35,10 +35,14 @@ func CreateSubmitJobFromExecutorApiJobRunLease(
 			RunMeta:         runMeta,
 			Owner:           jobRunLease.User,
 			OwnershipGroups: jobRunLease.Groups,
+			QueueLabels:     jobRunLease.QueueLabels,
 		},
-		Pod:       pod,
-		Ingresses: util2.ExtractIngresses(jobRunLease, pod, podDefaultIngress),
-		Services:  util2.ExtractServices(jobRunLease, pod),
+		Pod:                    pod,
+		Ingresses:              util2.ExtractIngresses(jobRunLease, pod, podDefaultIngress),
+		Services:               util2.ExtractServices(jobRunLease, pod),
+		ConfigMaps:             util2.ExtractConfigMaps(jobRunLease, pod),
+		Secrets:                util2.ExtractSecrets(jobRunLease, pod),
+		PersistentVolumeClaims: util2.ExtractPersistentVolumeClaims(jobRunLease, pod),
 	}, nil
 }
 
@@ -0,0 +1,96 @@
+package logcapture
+
+import (
+	"slices"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/blobstore"
+	log "github.com/armadaproject/armada/internal/common/logging"
+	"github.com/armadaproject/armada/internal/executor/configuration"
+	"github.com/armadaproject/armada/internal/executor/context"
+	"github.com/armadaproject/armada/internal/executor/metrics"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+// LogCapturer uploads the tail of each container's logs when a pod fails, so the logs remain available
+// after the pod has been deleted. Logs are stored under blobstore.PodLogKey, from which Binoculars reads them.
+//
+// All methods are safe to call on a nil LogCapturer, in which case they do nothing.
+type LogCapturer struct {
+	clusterContext context.ClusterContext
+	store          blobstore.BlobStore
+	config         configuration.FailedPodLogCaptureConfiguration
+}
+
+func NewLogCapturer(
+	clusterContext context.ClusterContext,
+	store blobstore.BlobStore,
+	config configuration.FailedPodLogCaptureConfiguration,
+) *LogCapturer {
+	return &LogCapturer{
+		clusterContext: clusterContext,
+		store:          store,
+		config:         config,
+	}
+}
+
+// Capture uploads the logs of each container of the given failed pod and returns their locations.
+// Pods failing with a category not configured for capture are skipped.
+// Failures to capture the logs of a container are logged and that container omitted from the result,
+// as log capture must never block reporting the failure itself.
+func (c *LogCapturer) Capture(pod *v1.Pod, failureCategory string) []*armadaevents.ContainerLog {
+	if c == nil || pod == nil {
+		return nil
+	}
+	if len(c.config.Categories) > 0 && !slices.Contains(c.config.Categories, failureCategory) {
+		return nil
+	}
+
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), c.config.Timeout)
+	defer cancel()
+
+	var containerLogs []*armadaevents.ContainerLog
+	for _, container := range capturableContainers(pod) {
+		location, err := c.captureContainer(ctx, pod, container)
+		metrics.RecordFailedPodLogCapture(c.clusterContext.GetClusterId(), err == nil)
+		if err != nil {
+			log.Warnf("Failed to capture logs of container %s of pod %s: %v", container, pod.Name, err)
+			continue
+		}
+		containerLogs = append(containerLogs, &armadaevents.ContainerLog{
+			ContainerName: container,
+			Location:      location,
+		})
+	}
+	return containerLogs
+}
+
+func (c *LogCapturer) captureContainer(ctx *armadacontext.Context, pod *v1.Pod, container string) (string, error) {
+	tailLines := c.config.TailLines
+	limitBytes := c.config.LimitBytes
+	logs, err := c.clusterContext.GetPodLogs(ctx, pod, &v1.PodLogOptions{
+		Container:  container,
+		TailLines:  &tailLines,
+		LimitBytes: &limitBytes,
+		// Binoculars parses the timestamp prefixing each line, as it does for logs read from Kubernetes.
+		Timestamps: true,
+	})
+	if err != nil {
+		return "", err
+	}
+	return c.store.Put(ctx, blobstore.PodLogKey(pod.Namespace, pod.Name, container), logs)
+}
+
+// capturableContainers returns the names of the containers of the pod that have run, and so may have logs.
+func capturableContainers(pod *v1.Pod) []string {
+	statuses := append(slices.Clone(pod.Status.InitContainerStatuses), pod.Status.ContainerStatuses...)
+	containers := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		if status.State.Terminated != nil || status.State.Running != nil || status.LastTerminationState.Terminated != nil {
+			containers = append(containers, status.Name)
+		}
+	}
+	return containers
+}
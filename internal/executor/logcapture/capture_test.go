@@ -0,0 +1,92 @@
+package logcapture
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/blobstore"
+	"github.com/armadaproject/armada/internal/executor/configuration"
+	"github.com/armadaproject/armada/internal/executor/context/fake"
+)
+
+var captureConfig = configuration.FailedPodLogCaptureConfiguration{
+	Enabled:    true,
+	TailLines:  100,
+	LimitBytes: 1024,
+	Timeout:    time.Minute,
+}
+
+func TestCapture_UploadsLogsOfEachContainer(t *testing.T) {
+	capturer, clusterContext, store := setupCaptureTest(t, captureConfig)
+	clusterContext.PodLogs["pod-1"] = map[string]string{
+		"init": "init logs",
+		"main": "main logs",
+	}
+
+	containerLogs := capturer.Capture(makeFailedPod(), "")
+
+	require.Len(t, containerLogs, 2)
+	assert.Equal(t, "init", containerLogs[0].ContainerName)
+	assert.Equal(t, "main", containerLogs[1].ContainerName)
+	for _, containerLog := range containerLogs {
+		assert.NotEmpty(t, containerLog.Location)
+		logs, err := store.Get(armadacontext.Background(), blobstore.PodLogKey("namespace", "pod-1", containerLog.ContainerName))
+		require.NoError(t, err)
+		assert.Equal(t, containerLog.ContainerName+" logs", string(logs))
+	}
+}
+
+func TestCapture_SkipsContainersWhoseLogsCannotBeRead(t *testing.T) {
+	capturer, clusterContext, _ := setupCaptureTest(t, captureConfig)
+	clusterContext.PodLogs["pod-1"] = map[string]string{"main": "main logs"}
+
+	containerLogs := capturer.Capture(makeFailedPod(), "")
+
+	require.Len(t, containerLogs, 1)
+	assert.Equal(t, "main", containerLogs[0].ContainerName)
+}
+
+func TestCapture_OnlyCapturesConfiguredCategories(t *testing.T) {
+	config := captureConfig
+	config.Categories = []string{"oom"}
+	capturer, clusterContext, _ := setupCaptureTest(t, config)
+	clusterContext.PodLogs["pod-1"] = map[string]string{"main": "main logs"}
+
+	assert.Empty(t, capturer.Capture(makeFailedPod(), "user_error"))
+	assert.Len(t, capturer.Capture(makeFailedPod(), "oom"), 1)
+}
+
+func TestCapture_NilCapturer(t *testing.T) {
+	var capturer *LogCapturer
+	assert.Nil(t, capturer.Capture(makeFailedPod(), ""))
+}
+
+func setupCaptureTest(t *testing.T, config configuration.FailedPodLogCaptureConfiguration) (*LogCapturer, *fake.SyncFakeClusterContext, blobstore.BlobStore) {
+	clusterContext := fake.NewSyncFakeClusterContext()
+	store, err := blobstore.NewFilesystemBlobStore(t.TempDir())
+	require.NoError(t, err)
+	return NewLogCapturer(clusterContext, store, config), clusterContext, store
+}
+
+func makeFailedPod() *v1.Pod {
+	terminated := v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 1}}
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod-1", Namespace: "namespace"},
+		Status: v1.PodStatus{
+			Phase: v1.PodFailed,
+			InitContainerStatuses: []v1.ContainerStatus{
+				{Name: "init", State: terminated},
+			},
+			ContainerStatuses: []v1.ContainerStatus{
+				{Name: "main", State: terminated},
+				{Name: "sidecar", State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{}}},
+			},
+		},
+	}
+}
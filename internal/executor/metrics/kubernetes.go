@@ -3,6 +3,7 @@ package metrics
 import (
 	"context"
 	"net/url"
+	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -16,29 +17,54 @@ var (
 	requestLatency = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name:    "rest_client_request_duration_seconds",
-			Help:    "Request latency in seconds. Broken down by verb and URL.",
+			Help:    "Request latency in seconds. Broken down by cluster, verb and URL.",
 			Buckets: []float64{.01, .05, .1, .2, .3, .4, .5, .6, .8, 1, 1.25, 1.5, 1.75, 2, 3, 4, 5, 10},
 		},
-		[]string{"verb", "url"},
+		[]string{clusterLabel, "verb", "url"},
 	)
 
 	requestResult = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "rest_client_requests_total",
-			Help: "Number of HTTP requests, partitioned by status code, method, and host.",
+			Help: "Number of HTTP requests, partitioned by cluster, status code, method, and host.",
 		},
-		[]string{"code", "method", "host"},
+		[]string{clusterLabel, "code", "method", "host"},
 	)
 
 	rateLimiterLatency = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name: "rest_client_rate_limiter_duration_seconds",
-			Help: "Client side rate limiter latency in seconds. Broken down by verb and URL.",
+			Help: "Client side rate limiter latency in seconds. Broken down by cluster, verb and URL.",
 		},
-		[]string{"verb", "url"},
+		[]string{clusterLabel, "verb", "url"},
 	)
+
+	// clusterIdsByHost maps the host of each Kubernetes API server the executor talks to onto the id of its cluster.
+	clusterIdsByHost sync.Map
 )
 
+// RegisterKubernetesCluster labels the metrics of requests to the given Kubernetes API server with the given cluster id.
+// host may be a URL or a host[:port], as in a rest.Config.
+func RegisterKubernetesCluster(host string, clusterId string) {
+	clusterIdsByHost.Store(hostOf(host), clusterId)
+}
+
+// clusterIdForHost returns the id of the cluster served by the given host, or "" if it isn't registered.
+func clusterIdForHost(host string) string {
+	clusterId, ok := clusterIdsByHost.Load(host)
+	if !ok {
+		return ""
+	}
+	return clusterId.(string)
+}
+
+func hostOf(host string) string {
+	if u, err := url.Parse(host); err == nil && u.Host != "" {
+		return u.Host
+	}
+	return host
+}
+
 func init() {
 	prometheus.MustRegister(requestLatency)
 	prometheus.MustRegister(requestResult)
@@ -59,7 +85,7 @@ type latencyAdapter struct {
 }
 
 func (l *latencyAdapter) Observe(ctx context.Context, verb string, u url.URL, latency time.Duration) {
-	l.m.WithLabelValues(verb, u.String()).Observe(latency.Seconds())
+	l.m.WithLabelValues(clusterIdForHost(u.Host), verb, u.String()).Observe(latency.Seconds())
 }
 
 type resultAdapter struct {
@@ -67,5 +93,5 @@ type resultAdapter struct {
 }
 
 func (r *resultAdapter) Increment(ctx context.Context, code, method, host string) {
-	r.m.WithLabelValues(code, method, host).Inc()
+	r.m.WithLabelValues(clusterIdForHost(host), code, method, host).Inc()
 }
@@ -10,6 +10,7 @@ import (
 const ArmadaExecutorMetricsPrefix = "armada_executor_"
 
 const (
+	clusterLabel            = "cluster"
 	failureCategoryLabel    = "failure_category"
 	failureSubcategoryLabel = "failure_subcategory"
 )
@@ -21,7 +22,7 @@ var jobFailureCategoryTotal = promauto.NewCounterVec(
 			"Includes retryable failures whose lease is returned for rescheduling, " +
 			"so a single job can contribute multiple increments.",
 	},
-	[]string{failureCategoryLabel, failureSubcategoryLabel},
+	[]string{clusterLabel, failureCategoryLabel, failureSubcategoryLabel},
 )
 
 var jobFailureRuleEvaluationDurationSeconds = promauto.NewHistogramVec(
@@ -48,11 +49,11 @@ var jobFailureRuleEvaluationDurationSeconds = promauto.NewHistogramVec(
 // flag is off or the classifier is nil); in that case no metric is emitted.
 // An empty subcategory is allowed and indicates a matched rule with no
 // subcategory set; it produces an empty-string label value.
-func RecordJobFailure(category, subcategory string) {
+func RecordJobFailure(clusterId, category, subcategory string) {
 	if category == "" {
 		return
 	}
-	jobFailureCategoryTotal.WithLabelValues(category, subcategory).Inc()
+	jobFailureCategoryTotal.WithLabelValues(clusterId, category, subcategory).Inc()
 }
 
 // RecordRuleEvaluationDuration records the time a single classification
@@ -64,3 +65,46 @@ func RecordRuleEvaluationDuration(category, subcategory string, duration time.Du
 	}
 	jobFailureRuleEvaluationDurationSeconds.WithLabelValues(category, subcategory).Observe(duration.Seconds())
 }
+
+var nodeQuarantinesTotal = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: ArmadaExecutorMetricsPrefix + "node_quarantines_total",
+		Help: "Total number of times a node was quarantined, by the failure category that triggered the quarantine.",
+	},
+	[]string{clusterLabel, failureCategoryLabel},
+)
+
+var quarantinedNodes = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: ArmadaExecutorMetricsPrefix + "quarantined_nodes",
+		Help: "Number of nodes currently quarantined due to repeated job failures.",
+	},
+	[]string{clusterLabel},
+)
+
+// RecordNodeQuarantine increments the node quarantine counter of the given cluster for the given failure category.
+func RecordNodeQuarantine(clusterId, category string) {
+	nodeQuarantinesTotal.WithLabelValues(clusterId, category).Inc()
+}
+
+// SetQuarantinedNodes sets the number of currently quarantined nodes of the given cluster.
+func SetQuarantinedNodes(clusterId string, count int) {
+	quarantinedNodes.WithLabelValues(clusterId).Set(float64(count))
+}
+
+var failedPodLogCapturesTotal = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: ArmadaExecutorMetricsPrefix + "failed_pod_log_captures_total",
+		Help: "Total number of container logs captured from failed pods, by whether the capture succeeded.",
+	},
+	[]string{clusterLabel, "result"},
+)
+
+// RecordFailedPodLogCapture increments the failed pod log capture counter of the given cluster.
+func RecordFailedPodLogCapture(clusterId string, success bool) {
+	result := "success"
+	if !success {
+		result = "failure"
+	}
+	failedPodLogCapturesTotal.WithLabelValues(clusterId, result).Inc()
+}
@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -35,9 +36,9 @@ func TestRecordJobFailure(t *testing.T) {
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			before := testutil.ToFloat64(jobFailureCategoryTotal.WithLabelValues(tc.category, tc.subcategory))
-			RecordJobFailure(tc.category, tc.subcategory)
-			after := testutil.ToFloat64(jobFailureCategoryTotal.WithLabelValues(tc.category, tc.subcategory))
+			before := testutil.ToFloat64(jobFailureCategoryTotal.WithLabelValues("cluster-1", tc.category, tc.subcategory))
+			RecordJobFailure("cluster-1", tc.category, tc.subcategory)
+			after := testutil.ToFloat64(jobFailureCategoryTotal.WithLabelValues("cluster-1", tc.category, tc.subcategory))
 			assert.Equal(t, tc.expectedDelta, after-before)
 		})
 	}
@@ -87,3 +88,25 @@ func histogramSampleCount(t *testing.T, h prometheus.Histogram) uint64 {
 	require.NotNil(t, pb.Histogram)
 	return pb.Histogram.GetSampleCount()
 }
+
+func TestKubernetesRequestMetrics_LabelledByCluster(t *testing.T) {
+	RegisterKubernetesCluster("https://cluster-1.example.com:6443", "cluster-1")
+	RegisterKubernetesCluster("cluster-2.example.com:6443", "cluster-2")
+
+	tests := map[string]struct {
+		host            string
+		expectedCluster string
+	}{
+		"host registered as a URL":         {host: "cluster-1.example.com:6443", expectedCluster: "cluster-1"},
+		"host registered without scheme":   {host: "cluster-2.example.com:6443", expectedCluster: "cluster-2"},
+		"unregistered host has no cluster": {host: "unknown.example.com:6443", expectedCluster: ""},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			counter := requestResult.WithLabelValues(tc.expectedCluster, "200", "GET", tc.host)
+			before := testutil.ToFloat64(counter)
+			(&resultAdapter{requestResult}).Increment(context.Background(), "200", "GET", tc.host)
+			assert.Equal(t, 1.0, testutil.ToFloat64(counter)-before)
+		})
+	}
+}
@@ -82,6 +82,7 @@ func ExposeClusterContextMetrics(
 	utilisationService utilisation.UtilisationService,
 	queueUtilisationService utilisation.PodUtilisationService,
 	nodeInfoService node.NodeInfoService,
+	registerer prometheus.Registerer,
 ) (*ClusterContextMetrics, error) {
 	m := &ClusterContextMetrics{
 		context:                 context,
@@ -89,7 +90,7 @@ func ExposeClusterContextMetrics(
 		queueUtilisationService: queueUtilisationService,
 		nodeInfoService:         nodeInfoService,
 		knownQueues:             map[string]map[string]bool{},
-		podCountTotal: promauto.NewCounterVec(
+		podCountTotal: promauto.With(registerer).NewCounterVec(
 			prometheus.CounterOpts{
 				Name: metrics.ArmadaExecutorMetricsPrefix + "job_pod_total",
 				Help: "Counter for pods in different phases by queue",
@@ -117,7 +118,7 @@ func ExposeClusterContextMetrics(
 	if err != nil {
 		return nil, err
 	}
-	prometheus.MustRegister(m)
+	registerer.MustRegister(m)
 	return m, nil
 }
 
@@ -0,0 +1,307 @@
+package node
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/maps"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+
+	log "github.com/armadaproject/armada/internal/common/logging"
+	protoutil "github.com/armadaproject/armada/internal/common/proto"
+	"github.com/armadaproject/armada/internal/executor/configuration"
+	"github.com/armadaproject/armada/internal/executor/context"
+	"github.com/armadaproject/armada/internal/executor/domain"
+	"github.com/armadaproject/armada/internal/executor/metrics"
+	"github.com/armadaproject/armada/pkg/executorapi"
+)
+
+const quarantineEventReason = "ArmadaNodeQuarantined"
+
+// Quarantiner taints nodes that fail too many jobs of the same failure category within a sliding window.
+// A quarantined node carries a NoSchedule taint, so it is reported to the scheduler as unschedulable.
+// The taint is removed once the configured cool-down has passed, or earlier if a manual release is requested.
+//
+// Quarantined nodes are annotated with the id of the cluster, and only quarantines carrying this cluster's annotation are
+// released; a taint with the same key added by anything else is left alone.
+//
+// Failure counts are held in memory only; the quarantine itself is stored on the node and so survives restarts.
+// All methods are safe to call on a nil Quarantiner, in which case they do nothing.
+type Quarantiner struct {
+	clusterContext context.ClusterContext
+	config         configuration.NodeQuarantineConfiguration
+	clock          clock.Clock
+	mu             sync.Mutex
+	// Failure times by node name and failure category.
+	failures map[string]map[string][]time.Time
+	// Most recent manual release request by node name.
+	releaseRequests map[string]time.Time
+	// Release requests that have been acted on but not yet reported to the scheduler, by node name.
+	processedReleases map[string]time.Time
+}
+
+func NewQuarantiner(
+	clusterContext context.ClusterContext,
+	config configuration.NodeQuarantineConfiguration,
+	clock clock.Clock,
+) *Quarantiner {
+	return &Quarantiner{
+		clusterContext:    clusterContext,
+		config:            config,
+		clock:             clock,
+		failures:          map[string]map[string][]time.Time{},
+		releaseRequests:   map[string]time.Time{},
+		processedReleases: map[string]time.Time{},
+	}
+}
+
+// RecordFailure records a job failure of the given category on the given node.
+// Failures without a node or category, or of a category not configured for quarantine, are ignored.
+func (q *Quarantiner) RecordFailure(nodeName string, category string) {
+	if q == nil || nodeName == "" || category == "" {
+		return
+	}
+	if len(q.config.Categories) > 0 && !slices.Contains(q.config.Categories, category) {
+		return
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	failuresByCategory, ok := q.failures[nodeName]
+	if !ok {
+		failuresByCategory = map[string][]time.Time{}
+		q.failures[nodeName] = failuresByCategory
+	}
+	failuresByCategory[category] = append(failuresByCategory[category], q.clock.Now())
+}
+
+// RequestRelease records manual release requests received from the scheduler.
+// A request only releases a node if it was made after the node was quarantined.
+func (q *Quarantiner) RequestRelease(releases []*executorapi.NodeQuarantineRelease) {
+	if q == nil {
+		return
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, release := range releases {
+		if release == nil || release.NodeName == "" || release.RequestedAt == nil {
+			continue
+		}
+		requestedAt := protoutil.ToStdTime(release.RequestedAt)
+		if requestedAt.After(q.releaseRequests[release.NodeName]) {
+			q.releaseRequests[release.NodeName] = requestedAt
+		}
+	}
+}
+
+// TakeProcessedReleases returns the release requests acted on since it was last called, so they can be reported to the
+// scheduler. A request is acted on once the node is released, or once it's found not to apply to the node.
+// Should reporting fail, the scheduler sends the requests again and they're reported again on the next call.
+func (q *Quarantiner) TakeProcessedReleases() []*executorapi.NodeQuarantineRelease {
+	if q == nil {
+		return nil
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	nodeNames := maps.Keys(q.processedReleases)
+	slices.Sort(nodeNames)
+	releases := make([]*executorapi.NodeQuarantineRelease, 0, len(nodeNames))
+	for _, nodeName := range nodeNames {
+		releases = append(releases, &executorapi.NodeQuarantineRelease{
+			NodeName:    nodeName,
+			RequestedAt: protoutil.ToTimestamp(q.processedReleases[nodeName]),
+		})
+	}
+	q.processedReleases = map[string]time.Time{}
+	return releases
+}
+
+// Run quarantines nodes that have exceeded the failure threshold and releases quarantined nodes that are due.
+func (q *Quarantiner) Run() {
+	if q == nil {
+		return
+	}
+	nodes, err := q.clusterContext.GetNodes()
+	if err != nil {
+		log.Errorf("Failed to get nodes for quarantine processing: %v", err)
+		return
+	}
+
+	now := q.clock.Now()
+	quarantined := 0
+	for _, node := range nodes {
+		taint := q.quarantineTaint(node)
+		if taint != nil && q.isQuarantinedByUs(node) {
+			if q.shouldRelease(node.Name, taint, now) {
+				if err := q.release(node); err != nil {
+					log.Errorf("Failed to release node %s from quarantine: %v", node.Name, err)
+					quarantined++
+					continue
+				}
+			} else {
+				quarantined++
+			}
+			q.markReleaseProcessed(node.Name)
+			continue
+		}
+		// Any release request for a node we haven't quarantined doesn't apply to it.
+		q.markReleaseProcessed(node.Name)
+		if taint != nil {
+			// Tainted by something else; leave it alone.
+			continue
+		}
+		if category := q.exceededCategory(node.Name, now); category != "" {
+			if err := q.quarantine(node, category, now); err != nil {
+				log.Errorf("Failed to quarantine node %s: %v", node.Name, err)
+				continue
+			}
+			quarantined++
+		}
+	}
+	q.pruneRemovedNodes(nodes)
+	metrics.SetQuarantinedNodes(q.clusterContext.GetClusterId(), quarantined)
+}
+
+func (q *Quarantiner) quarantineTaint(node *v1.Node) *v1.Taint {
+	for i := range node.Spec.Taints {
+		if node.Spec.Taints[i].Key == q.config.TaintKey {
+			return &node.Spec.Taints[i]
+		}
+	}
+	return nil
+}
+
+func (q *Quarantiner) isQuarantinedByUs(node *v1.Node) bool {
+	return node.Annotations[domain.NodeQuarantinedBy] == q.clusterContext.GetClusterId()
+}
+
+func (q *Quarantiner) shouldRelease(nodeName string, taint *v1.Taint, now time.Time) bool {
+	// Without a TimeAdded we can't tell when the quarantine started, so release it straight away
+	// rather than risk leaving the node quarantined forever.
+	if taint.TimeAdded == nil {
+		return true
+	}
+	quarantinedAt := taint.TimeAdded.Time
+	if !now.Before(quarantinedAt.Add(q.config.CoolDown)) {
+		return true
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	requestedAt, ok := q.releaseRequests[nodeName]
+	return ok && requestedAt.After(quarantinedAt)
+}
+
+// markReleaseProcessed moves any release request for the node to those to report to the scheduler.
+// Requests are only processed once per Run, so a request made before the node was quarantined is processed, and so
+// dropped, without releasing the node.
+func (q *Quarantiner) markReleaseProcessed(nodeName string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if requestedAt, ok := q.releaseRequests[nodeName]; ok {
+		q.processedReleases[nodeName] = requestedAt
+		delete(q.releaseRequests, nodeName)
+	}
+}
+
+// exceededCategory prunes failures outside the window and returns the first category, in sorted order,
+// whose failure count has reached the threshold, or the empty string if there is none.
+func (q *Quarantiner) exceededCategory(nodeName string, now time.Time) string {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	failuresByCategory, ok := q.failures[nodeName]
+	if !ok {
+		return ""
+	}
+	windowStart := now.Add(-q.config.Window)
+	exceeded := []string{}
+	for category, failures := range failuresByCategory {
+		failures = slices.DeleteFunc(failures, func(t time.Time) bool { return !t.After(windowStart) })
+		if len(failures) == 0 {
+			delete(failuresByCategory, category)
+			continue
+		}
+		failuresByCategory[category] = failures
+		if len(failures) >= q.config.FailureThreshold {
+			exceeded = append(exceeded, category)
+		}
+	}
+	if len(failuresByCategory) == 0 {
+		delete(q.failures, nodeName)
+	}
+	if len(exceeded) == 0 {
+		return ""
+	}
+	slices.Sort(exceeded)
+	return exceeded[0]
+}
+
+func (q *Quarantiner) quarantine(node *v1.Node, category string, now time.Time) error {
+	timeAdded := metav1.NewTime(now)
+	updated := node.DeepCopy()
+	updated.Spec.Taints = append(updated.Spec.Taints, v1.Taint{
+		Key:       q.config.TaintKey,
+		Value:     category,
+		Effect:    v1.TaintEffectNoSchedule,
+		TimeAdded: &timeAdded,
+	})
+	if updated.Annotations == nil {
+		updated.Annotations = map[string]string{}
+	}
+	updated.Annotations[domain.NodeQuarantinedBy] = q.clusterContext.GetClusterId()
+	if err := q.clusterContext.UpdateNode(updated); err != nil {
+		return err
+	}
+
+	q.mu.Lock()
+	failures := len(q.failures[node.Name][category])
+	// Start counting afresh so the node isn't quarantined again straight after release.
+	delete(q.failures, node.Name)
+	q.mu.Unlock()
+
+	message := fmt.Sprintf(
+		"Node quarantined after %d job failures of category %s within %s; it will be released after %s",
+		failures, category, q.config.Window, q.config.CoolDown)
+	if err := q.clusterContext.AddNodeEvent(node, v1.EventTypeWarning, quarantineEventReason, message); err != nil {
+		log.Warnf("Failed to add quarantine event to node %s: %v", node.Name, err)
+	}
+	log.Warnf("Quarantined node %s: %s", node.Name, message)
+	metrics.RecordNodeQuarantine(q.clusterContext.GetClusterId(), category)
+	return nil
+}
+
+func (q *Quarantiner) release(node *v1.Node) error {
+	updated := node.DeepCopy()
+	updated.Spec.Taints = slices.DeleteFunc(updated.Spec.Taints, func(t v1.Taint) bool {
+		return t.Key == q.config.TaintKey
+	})
+	delete(updated.Annotations, domain.NodeQuarantinedBy)
+	if err := q.clusterContext.UpdateNode(updated); err != nil {
+		return err
+	}
+	log.Infof("Released node %s from quarantine", node.Name)
+	return nil
+}
+
+func (q *Quarantiner) pruneRemovedNodes(nodes []*v1.Node) {
+	present := make(map[string]bool, len(nodes))
+	for _, node := range nodes {
+		present[node.Name] = true
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for nodeName := range q.failures {
+		if !present[nodeName] {
+			delete(q.failures, nodeName)
+		}
+	}
+	for nodeName, requestedAt := range q.releaseRequests {
+		if !present[nodeName] {
+			// There's nothing left to release.
+			q.processedReleases[nodeName] = requestedAt
+			delete(q.releaseRequests, nodeName)
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package node
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clock "k8s.io/utils/clock/testing"
+
+	protoutil "github.com/armadaproject/armada/internal/common/proto"
+	"github.com/armadaproject/armada/internal/executor/configuration"
+	"github.com/armadaproject/armada/internal/executor/context/fake"
+	"github.com/armadaproject/armada/internal/executor/domain"
+	"github.com/armadaproject/armada/pkg/executorapi"
+)
+
+const quarantineTaintKey = "armadaproject.io/quarantined"
+
+var quarantineConfig = configuration.NodeQuarantineConfiguration{
+	Enabled:          true,
+	Categories:       []string{"gpu", "disk"},
+	FailureThreshold: 3,
+	Window:           10 * time.Minute,
+	CoolDown:         time.Hour,
+	TaintKey:         quarantineTaintKey,
+}
+
+func TestQuarantiner_QuarantinesNodeAtThreshold(t *testing.T) {
+	quarantiner, clusterContext, _ := setupQuarantinerTest("node-1", "node-2")
+
+	quarantiner.RecordFailure("node-1", "gpu")
+	quarantiner.RecordFailure("node-1", "gpu")
+	quarantiner.Run()
+	assert.Nil(t, findQuarantineTaint(clusterContext, "node-1"))
+
+	quarantiner.RecordFailure("node-1", "gpu")
+	quarantiner.Run()
+	taint := findQuarantineTaint(clusterContext, "node-1")
+	require.NotNil(t, taint)
+	assert.Equal(t, "gpu", taint.Value)
+	assert.Equal(t, v1.TaintEffectNoSchedule, taint.Effect)
+	assert.Equal(t, clusterContext.GetClusterId(), clusterContext.Nodes["node-1"].Annotations[domain.NodeQuarantinedBy])
+	assert.Len(t, clusterContext.NodeEvents["node-1"], 1)
+	assert.Nil(t, findQuarantineTaint(clusterContext, "node-2"))
+}
+
+func TestQuarantiner_CountsCategoriesSeparately(t *testing.T) {
+	quarantiner, clusterContext, _ := setupQuarantinerTest("node-1")
+
+	quarantiner.RecordFailure("node-1", "gpu")
+	quarantiner.RecordFailure("node-1", "gpu")
+	quarantiner.RecordFailure("node-1", "disk")
+	quarantiner.Run()
+
+	assert.Nil(t, findQuarantineTaint(clusterContext, "node-1"))
+}
+
+func TestQuarantiner_IgnoresUnconfiguredCategories(t *testing.T) {
+	quarantiner, clusterContext, _ := setupQuarantinerTest("node-1")
+
+	for i := 0; i < 5; i++ {
+		quarantiner.RecordFailure("node-1", "user-error")
+		quarantiner.RecordFailure("node-1", "")
+		quarantiner.RecordFailure("", "gpu")
+	}
+	quarantiner.Run()
+
+	assert.Nil(t, findQuarantineTaint(clusterContext, "node-1"))
+}
+
+func TestQuarantiner_FailuresOutsideWindowAreIgnored(t *testing.T) {
+	quarantiner, clusterContext, fakeClock := setupQuarantinerTest("node-1")
+
+	quarantiner.RecordFailure("node-1", "gpu")
+	quarantiner.RecordFailure("node-1", "gpu")
+	fakeClock.Step(quarantineConfig.Window)
+	quarantiner.RecordFailure("node-1", "gpu")
+	quarantiner.Run()
+
+	assert.Nil(t, findQuarantineTaint(clusterContext, "node-1"))
+}
+
+func TestQuarantiner_ReleasesAfterCoolDown(t *testing.T) {
+	quarantiner, clusterContext, fakeClock := setupQuarantinerTest("node-1")
+	quarantineNode(quarantiner)
+	require.NotNil(t, findQuarantineTaint(clusterContext, "node-1"))
+
+	fakeClock.Step(quarantineConfig.CoolDown - time.Second)
+	quarantiner.Run()
+	assert.NotNil(t, findQuarantineTaint(clusterContext, "node-1"))
+
+	fakeClock.Step(time.Second)
+	quarantiner.Run()
+	assert.Nil(t, findQuarantineTaint(clusterContext, "node-1"))
+}
+
+func TestQuarantiner_ReleasesOnManualRequest(t *testing.T) {
+	quarantiner, clusterContext, fakeClock := setupQuarantinerTest("node-1")
+	quarantinedAt := fakeClock.Now()
+	quarantineNode(quarantiner)
+	require.NotNil(t, findQuarantineTaint(clusterContext, "node-1"))
+
+	// A release requested before the node was quarantined must not release it.
+	quarantiner.RequestRelease([]*executorapi.NodeQuarantineRelease{
+		{NodeName: "node-1", RequestedAt: protoutil.ToTimestamp(quarantinedAt.Add(-time.Minute))},
+	})
+	quarantiner.Run()
+	assert.NotNil(t, findQuarantineTaint(clusterContext, "node-1"))
+
+	quarantiner.RequestRelease([]*executorapi.NodeQuarantineRelease{
+		{NodeName: "node-1", RequestedAt: protoutil.ToTimestamp(quarantinedAt.Add(time.Minute))},
+	})
+	quarantiner.Run()
+	assert.Nil(t, findQuarantineTaint(clusterContext, "node-1"))
+	assert.NotContains(t, clusterContext.Nodes["node-1"].Annotations, domain.NodeQuarantinedBy)
+}
+
+func TestQuarantiner_ReportsProcessedReleases(t *testing.T) {
+	quarantiner, clusterContext, fakeClock := setupQuarantinerTest("node-1", "node-2")
+	quarantinedAt := fakeClock.Now()
+	quarantineNode(quarantiner)
+	require.NotNil(t, findQuarantineTaint(clusterContext, "node-1"))
+
+	releases := []*executorapi.NodeQuarantineRelease{
+		{NodeName: "node-1", RequestedAt: protoutil.ToTimestamp(quarantinedAt.Add(time.Minute))},
+		{NodeName: "node-2", RequestedAt: protoutil.ToTimestamp(quarantinedAt.Add(time.Minute))},
+		{NodeName: "removed-node", RequestedAt: protoutil.ToTimestamp(quarantinedAt.Add(time.Minute))},
+	}
+	quarantiner.RequestRelease(releases)
+	assert.Empty(t, quarantiner.TakeProcessedReleases())
+
+	// Requests are processed whether or not they released a node.
+	quarantiner.Run()
+	assert.Nil(t, findQuarantineTaint(clusterContext, "node-1"))
+	assert.Equal(t, releases, quarantiner.TakeProcessedReleases())
+	assert.Empty(t, quarantiner.TakeProcessedReleases())
+}
+
+func TestQuarantiner_KeepsReleaseRequestIfReleaseFails(t *testing.T) {
+	quarantiner, clusterContext, fakeClock := setupQuarantinerTest("node-1")
+	quarantinedAt := fakeClock.Now()
+	quarantineNode(quarantiner)
+	quarantiner.RequestRelease([]*executorapi.NodeQuarantineRelease{
+		{NodeName: "node-1", RequestedAt: protoutil.ToTimestamp(quarantinedAt.Add(time.Minute))},
+	})
+
+	clusterContext.UpdateNodeErr = errors.New("update failed")
+	quarantiner.Run()
+	assert.NotNil(t, findQuarantineTaint(clusterContext, "node-1"))
+	assert.Empty(t, quarantiner.TakeProcessedReleases())
+
+	clusterContext.UpdateNodeErr = nil
+	quarantiner.Run()
+	assert.Nil(t, findQuarantineTaint(clusterContext, "node-1"))
+	assert.Len(t, quarantiner.TakeProcessedReleases(), 1)
+}
+
+func TestQuarantiner_LeavesTaintsItDidNotAdd(t *testing.T) {
+	quarantiner, clusterContext, fakeClock := setupQuarantinerTest("node-1")
+	timeAdded := metav1.NewTime(fakeClock.Now())
+	foreignTaint := v1.Taint{Key: quarantineTaintKey, Value: "manual", Effect: v1.TaintEffectNoSchedule, TimeAdded: &timeAdded}
+	clusterContext.Nodes["node-1"].Spec.Taints = []v1.Taint{foreignTaint}
+	quarantiner.RequestRelease([]*executorapi.NodeQuarantineRelease{
+		{NodeName: "node-1", RequestedAt: protoutil.ToTimestamp(fakeClock.Now().Add(time.Minute))},
+	})
+
+	fakeClock.Step(quarantineConfig.CoolDown)
+	quarantineNode(quarantiner)
+
+	assert.Equal(t, []v1.Taint{foreignTaint}, clusterContext.Nodes["node-1"].Spec.Taints)
+	assert.Len(t, quarantiner.TakeProcessedReleases(), 1)
+}
+
+func TestQuarantiner_ReleasePreservesOtherTaints(t *testing.T) {
+	quarantiner, clusterContext, fakeClock := setupQuarantinerTest("node-1")
+	clusterContext.Nodes["node-1"].Spec.Taints = []v1.Taint{{Key: "other", Effect: v1.TaintEffectNoSchedule}}
+	quarantineNode(quarantiner)
+	require.Len(t, clusterContext.Nodes["node-1"].Spec.Taints, 2)
+
+	fakeClock.Step(quarantineConfig.CoolDown)
+	quarantiner.Run()
+
+	assert.Equal(t, []v1.Taint{{Key: "other", Effect: v1.TaintEffectNoSchedule}}, clusterContext.Nodes["node-1"].Spec.Taints)
+}
+
+func TestQuarantiner_Nil(t *testing.T) {
+	var quarantiner *Quarantiner
+	assert.NotPanics(t, func() {
+		quarantiner.RecordFailure("node-1", "gpu")
+		quarantiner.RequestRelease([]*executorapi.NodeQuarantineRelease{{NodeName: "node-1"}})
+		quarantiner.Run()
+		quarantiner.TakeProcessedReleases()
+	})
+}
+
+func setupQuarantinerTest(nodeNames ...string) (*Quarantiner, *fake.SyncFakeClusterContext, *clock.FakeClock) {
+	clusterContext := fake.NewSyncFakeClusterContext()
+	for _, name := range nodeNames {
+		clusterContext.Nodes[name] = &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	}
+	fakeClock := clock.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	return NewQuarantiner(clusterContext, quarantineConfig, fakeClock), clusterContext, fakeClock
+}
+
+func quarantineNode(quarantiner *Quarantiner) {
+	for i := 0; i < quarantineConfig.FailureThreshold; i++ {
+		quarantiner.RecordFailure("node-1", "gpu")
+	}
+	quarantiner.Run()
+}
+
+func findQuarantineTaint(clusterContext *fake.SyncFakeClusterContext, nodeName string) *v1.Taint {
+	for _, taint := range clusterContext.Nodes[nodeName].Spec.Taints {
+		if taint.Key == quarantineTaintKey {
+			return &taint
+		}
+	}
+	return nil
+}
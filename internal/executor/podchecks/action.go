@@ -12,6 +12,7 @@ const (
 	// Order matters here, actions with higher numbers trump those with lower.
 	ActionWait Action = iota
 	ActionRetry
+	ActionReturnLeaseAvoidNode
 	ActionFail
 )
 
@@ -21,6 +22,8 @@ func (a Action) String() string {
 		return "Wait"
 	case ActionRetry:
 		return "Retry"
+	case ActionReturnLeaseAvoidNode:
+		return "ReturnLeaseAvoidNode"
 	case ActionFail:
 		return "Fail"
 	default:
@@ -34,6 +37,8 @@ func mapAction(action config.Action) (Action, error) {
 		return ActionFail, nil
 	case config.ActionRetry:
 		return ActionRetry, nil
+	case config.ActionReturnLeaseAvoidNode:
+		return ActionReturnLeaseAvoidNode, nil
 	default:
 		return ActionWait, fmt.Errorf("Invalid action: \"%s\"", action)
 	}
@@ -11,4 +11,6 @@ func Test_maxAction(t *testing.T) {
 	assert.Equal(t, ActionFail, maxAction(ActionFail, ActionRetry))
 	assert.Equal(t, ActionRetry, maxAction(ActionWait, ActionRetry))
 	assert.Equal(t, ActionWait, maxAction(ActionWait, ActionWait))
+	assert.Equal(t, ActionReturnLeaseAvoidNode, maxAction(ActionRetry, ActionReturnLeaseAvoidNode))
+	assert.Equal(t, ActionFail, maxAction(ActionReturnLeaseAvoidNode, ActionFail))
 }
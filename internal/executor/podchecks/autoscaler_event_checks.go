@@ -0,0 +1,162 @@
+package podchecks
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	log "github.com/armadaproject/armada/internal/common/logging"
+	config "github.com/armadaproject/armada/internal/executor/configuration/podchecks"
+)
+
+type autoscalerEventChecker interface {
+	getAction(pod *v1.Pod, podEvents []*v1.Event, currentTime time.Time) (Action, string)
+}
+
+type autoscalerEventCheck struct {
+	reason      string
+	gracePeriod time.Duration
+	action      Action
+	name        string
+}
+
+type autoscalerEventChecks struct {
+	checks []autoscalerEventCheck
+}
+
+func newAutoscalerEventChecks(configs []config.AutoscalerEventCheck) (*autoscalerEventChecks, error) {
+	autoscalerEventChecks := &autoscalerEventChecks{}
+	for i, cfg := range configs {
+		action, err := mapAction(cfg.Action)
+		if err != nil {
+			return nil, err
+		}
+
+		if cfg.Reason == "" {
+			return nil, fmt.Errorf("autoscaler event checks must have a reason")
+		}
+
+		name := cfg.Name
+		if name == "" {
+			name = fmt.Sprintf("autoscaler-event-check-%d", i)
+		}
+
+		check := autoscalerEventCheck{
+			reason:      cfg.Reason,
+			gracePeriod: cfg.GracePeriod,
+			action:      action,
+			name:        name,
+		}
+		autoscalerEventChecks.checks = append(autoscalerEventChecks.checks, check)
+		log.Infof(
+			"   Created autoscaler event check (%s) %s %s %s",
+			check.name,
+			check.reason,
+			check.gracePeriod,
+			check.action,
+		)
+	}
+	return autoscalerEventChecks, nil
+}
+
+// getAction returns the action for a pod the scheduler has found unschedulable, based on the events the cluster autoscaler
+// has raised for it. Each check's grace period runs from when the autoscaler first raised a matching event while the
+// pod was unschedulable, so, e.g., a pod can be retried if it's still unschedulable some time after a scale up was triggered.
+func (aec *autoscalerEventChecks) getAction(pod *v1.Pod, podEvents []*v1.Event, currentTime time.Time) (Action, string) {
+	unschedulableSince, unschedulable := getUnschedulableSince(pod)
+	if !unschedulable {
+		return ActionWait, ""
+	}
+	resultAction := ActionWait
+	resultMessages := []string{}
+	for _, check := range aec.checks {
+		firstSeen, found := firstAutoscalerEventTime(podEvents, check.reason, unschedulableSince)
+		if !found || currentTime.Sub(firstSeen) <= check.gracePeriod {
+			continue
+		}
+		action := check.action
+		if action == ActionReturnLeaseAvoidNode && pod.Spec.NodeName == "" {
+			// There's no node to avoid, so just return the lease.
+			action = ActionRetry
+		}
+		log.Warnf(
+			"Matched check %q: Pod %s needs action %s because it has been unschedulable for more than %v since the cluster autoscaler raised event %s",
+			check.name,
+			pod.Name,
+			action,
+			check.gracePeriod,
+			check.reason,
+		)
+		resultAction = maxAction(resultAction, action)
+		resultMessages = append(resultMessages, fmt.Sprintf(
+			"Matched check: %q\nPod has been unschedulable for more than %v since the cluster autoscaler raised event %s",
+			check.name,
+			check.gracePeriod,
+			check.reason,
+		))
+	}
+	return resultAction, strings.Join(resultMessages, "\n")
+}
+
+// getUnschedulableSince returns when the scheduler last found the pod unschedulable, if the pod is currently unschedulable.
+func getUnschedulableSince(pod *v1.Pod) (time.Time, bool) {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodScheduled && condition.Status == v1.ConditionFalse && condition.Reason == v1.PodReasonUnschedulable {
+			return condition.LastTransitionTime.Time, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// firstAutoscalerEventTime returns the earliest time after notBefore that the cluster autoscaler raised an event with the given reason.
+// Events raised before notBefore relate to an earlier period of the pod being unschedulable, so are ignored.
+func firstAutoscalerEventTime(podEvents []*v1.Event, reason string, notBefore time.Time) (time.Time, bool) {
+	var firstSeen time.Time
+	found := false
+	for _, event := range podEvents {
+		if event.Reason != reason || !isAutoscalerEvent(event) {
+			continue
+		}
+		eventTime := latestEventTime(event)
+		if eventTime.Before(notBefore) {
+			continue
+		}
+		// The event may have been raised several times; count from the earliest time it was raised while unschedulable.
+		if eventFirstTime := earliestEventTime(event); eventFirstTime.After(notBefore) {
+			eventTime = eventFirstTime
+		} else {
+			eventTime = notBefore
+		}
+		if !found || eventTime.Before(firstSeen) {
+			firstSeen = eventTime
+			found = true
+		}
+	}
+	return firstSeen, found
+}
+
+func isAutoscalerEvent(event *v1.Event) bool {
+	return event.Source.Component == ClusterAutoscalerComponent || event.ReportingController == ClusterAutoscalerComponent
+}
+
+func earliestEventTime(event *v1.Event) time.Time {
+	if !event.FirstTimestamp.IsZero() {
+		return event.FirstTimestamp.Time
+	}
+	if !event.EventTime.IsZero() {
+		return event.EventTime.Time
+	}
+	return event.LastTimestamp.Time
+}
+
+func latestEventTime(event *v1.Event) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	if event.Series != nil && !event.Series.LastObservedTime.IsZero() {
+		return event.Series.LastObservedTime.Time
+	}
+	return earliestEventTime(event)
+}
@@ -0,0 +1,134 @@
+package podchecks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	config "github.com/armadaproject/armada/internal/executor/configuration/podchecks"
+)
+
+func Test_autoscalerEventChecks_getAction(t *testing.T) {
+	checks := []config.AutoscalerEventCheck{
+		{Name: "no-scale-up", Reason: "NotTriggerScaleUp", GracePeriod: 5 * time.Minute, Action: config.ActionRetry},
+		{Name: "scale-up-failed", Reason: "TriggeredScaleUp", GracePeriod: 15 * time.Minute, Action: config.ActionFail},
+	}
+	tests := map[string]struct {
+		pod            *v1.Pod
+		events         []*v1.Event
+		expectedAction Action
+	}{
+		"no events": {
+			pod:            makeUnschedulablePod(time.Hour),
+			expectedAction: ActionWait,
+		},
+		"pod not unschedulable": {
+			pod:            createBasicPod(false),
+			events:         []*v1.Event{makeAutoscalerEvent("NotTriggerScaleUp", time.Hour)},
+			expectedAction: ActionWait,
+		},
+		"event within grace period": {
+			pod:            makeUnschedulablePod(time.Hour),
+			events:         []*v1.Event{makeAutoscalerEvent("NotTriggerScaleUp", time.Minute)},
+			expectedAction: ActionWait,
+		},
+		"event beyond grace period": {
+			pod:            makeUnschedulablePod(time.Hour),
+			events:         []*v1.Event{makeAutoscalerEvent("NotTriggerScaleUp", 10*time.Minute)},
+			expectedAction: ActionRetry,
+		},
+		"grace period runs from when the pod became unschedulable": {
+			pod:            makeUnschedulablePod(2 * time.Minute),
+			events:         []*v1.Event{makeAutoscalerEvent("NotTriggerScaleUp", 10*time.Minute)},
+			expectedAction: ActionWait,
+		},
+		"event from another component": {
+			pod: makeUnschedulablePod(time.Hour),
+			events: []*v1.Event{func() *v1.Event {
+				event := makeAutoscalerEvent("NotTriggerScaleUp", 10*time.Minute)
+				event.Source.Component = "default-scheduler"
+				return event
+			}()},
+			expectedAction: ActionWait,
+		},
+		"event before the pod became unschedulable": {
+			pod: makeUnschedulablePod(5 * time.Minute),
+			events: []*v1.Event{func() *v1.Event {
+				event := makeAutoscalerEvent("TriggeredScaleUp", 30*time.Minute)
+				event.LastTimestamp = metav1.NewTime(currentTime.Add(-20 * time.Minute))
+				return event
+			}()},
+			expectedAction: ActionWait,
+		},
+		"most drastic action wins": {
+			pod: makeUnschedulablePod(time.Hour),
+			events: []*v1.Event{
+				makeAutoscalerEvent("NotTriggerScaleUp", 10*time.Minute),
+				makeAutoscalerEvent("TriggeredScaleUp", 20*time.Minute),
+			},
+			expectedAction: ActionFail,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			aec, err := newAutoscalerEventChecks(checks)
+			require.NoError(t, err)
+
+			action, message := aec.getAction(tc.pod, tc.events, currentTime)
+			assert.Equal(t, tc.expectedAction, action)
+			if tc.expectedAction == ActionWait {
+				assert.Empty(t, message)
+			} else {
+				assert.Contains(t, message, "since the cluster autoscaler raised event")
+			}
+		})
+	}
+}
+
+func Test_autoscalerEventChecks_getAction_AvoidNode(t *testing.T) {
+	aec, err := newAutoscalerEventChecks([]config.AutoscalerEventCheck{
+		{Name: "no-scale-up", Reason: "NotTriggerScaleUp", GracePeriod: 5 * time.Minute, Action: config.ActionReturnLeaseAvoidNode},
+	})
+	require.NoError(t, err)
+	events := []*v1.Event{makeAutoscalerEvent("NotTriggerScaleUp", 10*time.Minute)}
+
+	pod := makeUnschedulablePod(time.Hour)
+	action, _ := aec.getAction(pod, events, currentTime)
+	assert.Equal(t, ActionRetry, action, "a pod without a node has no node to avoid")
+
+	pod.Spec.NodeName = "node-1"
+	action, _ = aec.getAction(pod, events, currentTime)
+	assert.Equal(t, ActionReturnLeaseAvoidNode, action)
+}
+
+func Test_newAutoscalerEventChecks_InvalidConfig(t *testing.T) {
+	_, err := newAutoscalerEventChecks([]config.AutoscalerEventCheck{{Action: config.ActionRetry}})
+	assert.Error(t, err)
+	_, err = newAutoscalerEventChecks([]config.AutoscalerEventCheck{{Reason: "NotTriggerScaleUp", Action: "Ignore"}})
+	assert.Error(t, err)
+}
+
+func makeUnschedulablePod(unschedulableFor time.Duration) *v1.Pod {
+	pod := createBasicPodInStateFor(false, unschedulableFor)
+	pod.Status.Conditions = []v1.PodCondition{{
+		Type:               v1.PodScheduled,
+		Status:             v1.ConditionFalse,
+		Reason:             v1.PodReasonUnschedulable,
+		LastTransitionTime: metav1.NewTime(currentTime.Add(-unschedulableFor)),
+	}}
+	return pod
+}
+
+func makeAutoscalerEvent(reason string, firstRaised time.Duration) *v1.Event {
+	return &v1.Event{
+		Reason:         reason,
+		Type:           v1.EventTypeNormal,
+		Source:         v1.EventSource{Component: ClusterAutoscalerComponent},
+		FirstTimestamp: metav1.NewTime(currentTime.Add(-firstRaised)),
+		LastTimestamp:  metav1.NewTime(currentTime.Add(-time.Second)),
+	}
+}
@@ -3,4 +3,6 @@ package podchecks
 const (
 	EventReasonScheduled       = "Scheduled"
 	EvenReasonFailedScheduling = "FailedScheduling"
+	// ClusterAutoscalerComponent is the component events raised by the cluster autoscaler are reported by.
+	ClusterAutoscalerComponent = "cluster-autoscaler"
 )
@@ -0,0 +1,116 @@
+package podchecks
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	log "github.com/armadaproject/armada/internal/common/logging"
+	config "github.com/armadaproject/armada/internal/executor/configuration/podchecks"
+)
+
+type nodeConditionChecker interface {
+	getAction(pod *v1.Pod, node *v1.Node, currentTime time.Time) (Action, string)
+}
+
+type nodeConditionCheck struct {
+	conditionType v1.NodeConditionType
+	status        v1.ConditionStatus
+	gracePeriod   time.Duration
+	action        Action
+	name          string
+}
+
+type nodeConditionChecks struct {
+	checks []nodeConditionCheck
+}
+
+func newNodeConditionChecks(configs []config.NodeConditionCheck) (*nodeConditionChecks, error) {
+	nodeConditionChecks := &nodeConditionChecks{}
+	for i, cfg := range configs {
+		action, err := mapAction(cfg.Action)
+		if err != nil {
+			return nil, err
+		}
+
+		if cfg.Type == "" {
+			return nil, fmt.Errorf("node condition checks must have a condition type")
+		}
+		if cfg.Status != v1.ConditionTrue && cfg.Status != v1.ConditionFalse && cfg.Status != v1.ConditionUnknown {
+			return nil, fmt.Errorf("invalid node condition status: \"%s\"", cfg.Status)
+		}
+
+		name := cfg.Name
+		if name == "" {
+			name = fmt.Sprintf("node-condition-check-%d", i)
+		}
+
+		check := nodeConditionCheck{
+			conditionType: cfg.Type,
+			status:        cfg.Status,
+			gracePeriod:   cfg.GracePeriod,
+			action:        action,
+			name:          name,
+		}
+		nodeConditionChecks.checks = append(nodeConditionChecks.checks, check)
+		log.Infof(
+			"   Created node condition check (%s) %s=%s %s %s",
+			check.name,
+			check.conditionType,
+			check.status,
+			check.gracePeriod,
+			check.action,
+		)
+	}
+	return nodeConditionChecks, nil
+}
+
+func (ncc *nodeConditionChecks) getAction(pod *v1.Pod, node *v1.Node, currentTime time.Time) (Action, string) {
+	if node == nil {
+		return ActionWait, ""
+	}
+	resultAction := ActionWait
+	resultMessages := []string{}
+	for _, condition := range node.Status.Conditions {
+		action, message := ncc.getConditionAction(pod, node, condition, currentTime)
+		resultAction = maxAction(resultAction, action)
+		if message != "" {
+			resultMessages = append(resultMessages, message)
+		}
+	}
+	return resultAction, strings.Join(resultMessages, "\n")
+}
+
+func (ncc *nodeConditionChecks) getConditionAction(pod *v1.Pod, node *v1.Node, condition v1.NodeCondition, currentTime time.Time) (Action, string) {
+	for _, check := range ncc.checks {
+		if condition.Type != check.conditionType || condition.Status != check.status {
+			continue
+		}
+		timeInCondition := currentTime.Sub(condition.LastTransitionTime.Time)
+		if timeInCondition <= check.gracePeriod {
+			return ActionWait, ""
+		}
+		log.Warnf(
+			"Matched check %q: Pod %s needs action %s because node %s has had condition %s=%s for more than %v",
+			check.name,
+			pod.Name,
+			check.action,
+			node.Name,
+			condition.Type,
+			condition.Status,
+			check.gracePeriod,
+		)
+		return check.action, fmt.Sprintf(
+			"Matched check: %q\nNode %s has had condition %s=%s (%s) for more than %v",
+			check.name,
+			node.Name,
+			condition.Type,
+			condition.Status,
+			condition.Message,
+			check.gracePeriod,
+		)
+	}
+	return ActionWait, ""
+}
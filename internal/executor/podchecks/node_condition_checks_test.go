@@ -0,0 +1,85 @@
+package podchecks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	config "github.com/armadaproject/armada/internal/executor/configuration/podchecks"
+)
+
+func Test_nodeConditionChecks_getAction(t *testing.T) {
+	checks := []config.NodeConditionCheck{
+		{Name: "disk-pressure", Type: v1.NodeDiskPressure, Status: v1.ConditionTrue, GracePeriod: time.Minute, Action: config.ActionReturnLeaseAvoidNode},
+		{Name: "not-ready", Type: v1.NodeReady, Status: v1.ConditionUnknown, GracePeriod: time.Minute, Action: config.ActionRetry},
+	}
+	tests := map[string]struct {
+		node            *v1.Node
+		expectedAction  Action
+		expectedMessage string
+	}{
+		"no node": {
+			expectedAction: ActionWait,
+		},
+		"healthy node": {
+			node:           makeNodeWithConditions(v1.NodeCondition{Type: v1.NodeReady, Status: v1.ConditionTrue, LastTransitionTime: metav1.NewTime(currentTime.Add(-time.Hour))}),
+			expectedAction: ActionWait,
+		},
+		"condition within grace period": {
+			node:           makeNodeWithConditions(v1.NodeCondition{Type: v1.NodeDiskPressure, Status: v1.ConditionTrue, LastTransitionTime: metav1.NewTime(currentTime.Add(-time.Second))}),
+			expectedAction: ActionWait,
+		},
+		"condition beyond grace period": {
+			node: makeNodeWithConditions(v1.NodeCondition{
+				Type:               v1.NodeDiskPressure,
+				Status:             v1.ConditionTrue,
+				Message:            "kubelet has disk pressure",
+				LastTransitionTime: metav1.NewTime(currentTime.Add(-2 * time.Minute)),
+			}),
+			expectedAction:  ActionReturnLeaseAvoidNode,
+			expectedMessage: "Matched check: \"disk-pressure\"\nNode node1 has had condition DiskPressure=True (kubelet has disk pressure) for more than 1m0s",
+		},
+		"most drastic action wins": {
+			node: makeNodeWithConditions(
+				v1.NodeCondition{Type: v1.NodeReady, Status: v1.ConditionUnknown, LastTransitionTime: metav1.NewTime(currentTime.Add(-2 * time.Minute))},
+				v1.NodeCondition{Type: v1.NodeDiskPressure, Status: v1.ConditionTrue, LastTransitionTime: metav1.NewTime(currentTime.Add(-2 * time.Minute))},
+			),
+			expectedAction: ActionReturnLeaseAvoidNode,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ncc, err := newNodeConditionChecks(checks)
+			require.NoError(t, err)
+
+			action, message := ncc.getAction(createBasicPod(true), tc.node, currentTime)
+			assert.Equal(t, tc.expectedAction, action)
+			if tc.expectedMessage != "" {
+				assert.Equal(t, tc.expectedMessage, message)
+			}
+			if tc.expectedAction == ActionWait {
+				assert.Empty(t, message)
+			}
+		})
+	}
+}
+
+func Test_newNodeConditionChecks_InvalidConfig(t *testing.T) {
+	_, err := newNodeConditionChecks([]config.NodeConditionCheck{{Status: v1.ConditionTrue, Action: config.ActionRetry}})
+	assert.Error(t, err)
+	_, err = newNodeConditionChecks([]config.NodeConditionCheck{{Type: v1.NodeReady, Status: "Maybe", Action: config.ActionRetry}})
+	assert.Error(t, err)
+	_, err = newNodeConditionChecks([]config.NodeConditionCheck{{Type: v1.NodeReady, Status: v1.ConditionFalse, Action: "Ignore"}})
+	assert.Error(t, err)
+}
+
+func makeNodeWithConditions(conditions ...v1.NodeCondition) *v1.Node {
+	return &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node1"},
+		Status:     v1.NodeStatus{Conditions: conditions},
+	}
+}
@@ -16,13 +16,16 @@ import (
 )
 
 type PodChecker interface {
-	GetAction(pod *v1.Pod, podEvents []*v1.Event) (Action, Cause, string)
+	// GetAction returns the action to take for a pending pod. node is the node the pod is assigned to, or nil if it isn't assigned to one.
+	GetAction(pod *v1.Pod, node *v1.Node, podEvents []*v1.Event) (Action, Cause, string)
 }
 
 type PodChecks struct {
 	clock                     clock.Clock
 	eventChecks               eventChecker
 	containerStateChecks      containerStateChecker
+	nodeConditionChecks       nodeConditionChecker
+	autoscalerEventChecks     autoscalerEventChecker
 	deadlineForUpdates        time.Duration
 	deadlineForNodeAssignment time.Duration
 	deadlineForInitContainers time.Duration
@@ -40,17 +43,29 @@ func NewPodChecks(cfg config.Checks, clk clock.Clock) (*PodChecks, error) {
 		return nil, err
 	}
 
+	ncc, err := newNodeConditionChecks(cfg.NodeConditions)
+	if err != nil {
+		return nil, err
+	}
+
+	aec, err := newAutoscalerEventChecks(cfg.AutoscalerEvents)
+	if err != nil {
+		return nil, err
+	}
+
 	return &PodChecks{
 		clock:                     clk,
 		eventChecks:               ec,
 		containerStateChecks:      csc,
+		nodeConditionChecks:       ncc,
+		autoscalerEventChecks:     aec,
 		deadlineForUpdates:        cfg.DeadlineForUpdates,
 		deadlineForNodeAssignment: cfg.DeadlineForNodeAssignment,
 		deadlineForInitContainers: cfg.DeadlineForInitContainers,
 	}, nil
 }
 
-func (pc *PodChecks) GetAction(pod *v1.Pod, podEvents []*v1.Event) (Action, Cause, string) {
+func (pc *PodChecks) GetAction(pod *v1.Pod, node *v1.Node, podEvents []*v1.Event) (Action, Cause, string) {
 	lastStateChange, err := util.LastStatusChange(pod)
 	if err != nil {
 		log.Errorf("Unable to get lastStateChange for pod %s: %v", pod.Name, err)
@@ -78,10 +93,25 @@ func (pc *PodChecks) GetAction(pod *v1.Pod, podEvents []*v1.Event) (Action, Caus
 	timeInState := currentTime.Sub(lastStateChange)
 
 	isAssignedToNode := pod.Spec.NodeName != ""
+	if !isAssignedToNode {
+		autoscalerAction, message := pc.autoscalerEventChecks.getAction(pod, podEvents, currentTime)
+		if autoscalerAction != ActionWait {
+			log.Infof("Pod checks for pod %s returned %s %s\n", pod.Name, autoscalerAction, message)
+			return autoscalerAction, NoNodeAssigned, message
+		}
+	}
 	if timeInState > pc.deadlineForNodeAssignment && !isAssignedToNode {
 		return ActionRetry, NoNodeAssigned, fmt.Sprintf("Pod could not been scheduled in within %s deadline. Retrying", pc.deadlineForNodeAssignment)
 	}
 
+	if isAssignedToNode {
+		nodeConditionAction, message := pc.nodeConditionChecks.getAction(pod, node, currentTime)
+		if nodeConditionAction != ActionWait {
+			log.Infof("Pod checks for pod %s returned %s %s\n", pod.Name, nodeConditionAction, message)
+			return nodeConditionAction, PodStartupIssue, message
+		}
+	}
+
 	isNodeBad := pc.isBadNode(pod, podEvents)
 	if timeInState > pc.deadlineForUpdates && isNodeBad {
 		return ActionRetry, NoStatusUpdates, fmt.Sprintf("Pod has received no updates within %s deadline - likely the node is bad. Retrying", pc.deadlineForUpdates)
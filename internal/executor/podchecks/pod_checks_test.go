@@ -35,7 +35,7 @@ func Test_GetAction(t *testing.T) {
 
 	for _, test := range tests {
 		podChecks := podChecksWithMocks(test.eventAction, test.containerStateAction)
-		result, cause, _ := podChecks.GetAction(createBasicPod(true), []*v1.Event{{Message: "MockEvent", Type: "None"}})
+		result, cause, _ := podChecks.GetAction(createBasicPod(true), nil, []*v1.Event{{Message: "MockEvent", Type: "None"}})
 		assert.Equal(t, test.expectedAction, result)
 		assert.Equal(t, test.expectedCause, cause)
 	}
@@ -46,6 +46,8 @@ func podChecksWithMocks(eventResult Action, containerStateResult Action) *PodChe
 		clock:                     clock.NewFakeClock(currentTime),
 		eventChecks:               &mockEventChecks{result: eventResult, message: mockMessage(eventResult)},
 		containerStateChecks:      &mockContainerStateChecks{result: containerStateResult, message: mockMessage(containerStateResult)},
+		nodeConditionChecks:       &nodeConditionChecks{},
+		autoscalerEventChecks:     &autoscalerEventChecks{},
 		deadlineForUpdates:        time.Minute,
 		deadlineForNodeAssignment: time.Minute,
 	}
@@ -55,19 +57,19 @@ func Test_GetAction_PodNotScheduled(t *testing.T) {
 	podChecks := podChecksWithMocks(ActionWait, ActionWait)
 
 	// No issue if pod isn't scheduled in less than deadlineForNodeAssignment
-	result, cause, _ := podChecks.GetAction(createBasicPodInStateFor(false, 10*time.Second), []*v1.Event{{Message: "MockEvent", Type: "None"}})
+	result, cause, _ := podChecks.GetAction(createBasicPodInStateFor(false, 10*time.Second), nil, []*v1.Event{{Message: "MockEvent", Type: "None"}})
 	assert.Equal(t, result, ActionWait)
 	assert.Equal(t, cause, None)
 
 	// Issue if pod isn't scheduled for longer than deadlineForNodeAssignment
-	result, cause, _ = podChecks.GetAction(createBasicPodInStateFor(false, 2*time.Minute), []*v1.Event{{Message: "MockEvent", Type: "None"}})
+	result, cause, _ = podChecks.GetAction(createBasicPodInStateFor(false, 2*time.Minute), nil, []*v1.Event{{Message: "MockEvent", Type: "None"}})
 	assert.Equal(t, result, ActionRetry)
 	assert.Equal(t, cause, NoNodeAssigned)
 }
 
 func Test_GetAction_BadNode(t *testing.T) {
 	podChecks := podChecksWithMocks(ActionWait, ActionWait)
-	result, cause, message := podChecks.GetAction(createBasicPodInStateFor(true, 10*time.Minute), []*v1.Event{})
+	result, cause, message := podChecks.GetAction(createBasicPodInStateFor(true, 10*time.Minute), nil, []*v1.Event{})
 	assert.Equal(t, result, ActionRetry)
 	assert.Equal(t, cause, NoStatusUpdates)
 	assert.Equal(t, message, "Pod has received no updates within 1m0s deadline - likely the node is bad. Retrying")
@@ -75,7 +77,7 @@ func Test_GetAction_BadNode(t *testing.T) {
 
 func Test_GetAction_BadNode_ShouldIgnoreScheduledEvents(t *testing.T) {
 	podChecks := podChecksWithMocks(ActionWait, ActionWait)
-	result, cause, message := podChecks.GetAction(createBasicPodInStateFor(true, 10*time.Minute), []*v1.Event{{Message: "Scheduled pod onto node", Reason: EventReasonScheduled}})
+	result, cause, message := podChecks.GetAction(createBasicPodInStateFor(true, 10*time.Minute), nil, []*v1.Event{{Message: "Scheduled pod onto node", Reason: EventReasonScheduled}})
 	assert.Equal(t, result, ActionRetry)
 	assert.Equal(t, cause, NoStatusUpdates)
 	assert.Equal(t, message, "Pod has received no updates within 1m0s deadline - likely the node is bad. Retrying")
@@ -83,7 +85,7 @@ func Test_GetAction_BadNode_ShouldIgnoreScheduledEvents(t *testing.T) {
 
 func Test_GetAction_BadNode_ShouldIgnoreFailedSchedulingEvents(t *testing.T) {
 	podChecks := podChecksWithMocks(ActionWait, ActionWait)
-	result, cause, message := podChecks.GetAction(createBasicPodInStateFor(true, 10*time.Minute), []*v1.Event{{Message: "Failed to schedule onto node", Reason: EvenReasonFailedScheduling}})
+	result, cause, message := podChecks.GetAction(createBasicPodInStateFor(true, 10*time.Minute), nil, []*v1.Event{{Message: "Failed to schedule onto node", Reason: EvenReasonFailedScheduling}})
 	assert.Equal(t, result, ActionRetry)
 	assert.Equal(t, cause, NoStatusUpdates)
 	assert.Equal(t, message, "Pod has received no updates within 1m0s deadline - likely the node is bad. Retrying")
@@ -91,7 +93,7 @@ func Test_GetAction_BadNode_ShouldIgnoreFailedSchedulingEvents(t *testing.T) {
 
 func Test_GetAction_BadNodeButUnderTimeLimit(t *testing.T) {
 	podChecks := podChecksWithMocks(ActionWait, ActionWait)
-	result, cause, message := podChecks.GetAction(createBasicPodInStateFor(true, 10*time.Second), []*v1.Event{})
+	result, cause, message := podChecks.GetAction(createBasicPodInStateFor(true, 10*time.Second), nil, []*v1.Event{})
 	assert.Equal(t, result, ActionWait)
 	assert.Equal(t, cause, NoStatusUpdates)
 	assert.Equal(t, message, "Pod status and pod events are both empty but we are under time limit. Waiting")
@@ -100,7 +102,7 @@ func Test_GetAction_BadNodeButUnderTimeLimit(t *testing.T) {
 func Test_GetAction_ReturnsWait_WhenLastStateChangeIsNotReady(t *testing.T) {
 	podChecks := podChecksWithMocks(ActionFail, ActionFail)
 
-	result, cause, message := podChecks.GetAction(&v1.Pod{}, []*v1.Event{{Message: "MockEvent", Type: "None"}})
+	result, cause, message := podChecks.GetAction(&v1.Pod{}, nil, []*v1.Event{{Message: "MockEvent", Type: "None"}})
 
 	assert.Equal(t, ActionWait, result)
 	assert.Equal(t, None, cause)
@@ -112,7 +114,7 @@ func Test_GetAction_InitContainerDeadlineUsesFirstInitStart(t *testing.T) {
 	podChecks.deadlineForInitContainers = time.Minute
 	pod := createPodWithRunningInitContainer("init", currentTime.Add(-2*time.Minute))
 
-	result, cause, message := podChecks.GetAction(pod, []*v1.Event{{Message: "MockEvent", Type: "None"}})
+	result, cause, message := podChecks.GetAction(pod, nil, []*v1.Event{{Message: "MockEvent", Type: "None"}})
 
 	assert.Equal(t, ActionFail, result)
 	assert.Equal(t, PodStartupIssue, cause)
@@ -126,7 +128,7 @@ func Test_GetAction_InitContainerDeadlineWaitsUntilFirstInitStarts(t *testing.T)
 	podChecks.deadlineForInitContainers = time.Minute
 	pod := createPodWithWaitingInitContainer("init")
 
-	result, cause, _ := podChecks.GetAction(pod, []*v1.Event{{Message: "MockEvent", Type: "None"}})
+	result, cause, _ := podChecks.GetAction(pod, nil, []*v1.Event{{Message: "MockEvent", Type: "None"}})
 
 	assert.Equal(t, ActionWait, result)
 	assert.Equal(t, None, cause)
@@ -137,7 +139,7 @@ func Test_GetAction_InitContainerDeadlineDoesNotFailAfterSuccessfulCompletion(t
 	podChecks.deadlineForInitContainers = time.Minute
 	pod := createPodWithTerminatedInitContainer("init", currentTime.Add(-5*time.Minute), currentTime.Add(-3*time.Minute), 0)
 
-	result, cause, _ := podChecks.GetAction(pod, []*v1.Event{{Message: "MockEvent", Type: "None"}})
+	result, cause, _ := podChecks.GetAction(pod, nil, []*v1.Event{{Message: "MockEvent", Type: "None"}})
 
 	assert.Equal(t, ActionWait, result)
 	assert.Equal(t, None, cause)
@@ -155,7 +157,7 @@ func Test_GetAction_InitContainerDeadlineRequiresAllRegularInitContainersToCompl
 		}},
 	})
 
-	result, cause, message := podChecks.GetAction(pod, []*v1.Event{{Message: "MockEvent", Type: "None"}})
+	result, cause, message := podChecks.GetAction(pod, nil, []*v1.Event{{Message: "MockEvent", Type: "None"}})
 
 	assert.Equal(t, ActionFail, result)
 	assert.Equal(t, PodStartupIssue, cause)
@@ -164,6 +166,36 @@ func Test_GetAction_InitContainerDeadlineRequiresAllRegularInitContainersToCompl
 	assert.Contains(t, message, "Init container second-init is still running and has been running for 30s")
 }
 
+func Test_GetAction_NodeConditionMatched(t *testing.T) {
+	podChecks := podChecksWithMocks(ActionWait, ActionWait)
+	podChecks.nodeConditionChecks = &mockNodeConditionChecks{result: ActionReturnLeaseAvoidNode, message: "node has disk pressure"}
+
+	node := makeNodeWithConditions()
+	result, cause, message := podChecks.GetAction(createBasicPod(true), node, []*v1.Event{{Message: "MockEvent", Type: "None"}})
+	assert.Equal(t, ActionReturnLeaseAvoidNode, result)
+	assert.Equal(t, PodStartupIssue, cause)
+	assert.Equal(t, "node has disk pressure", message)
+
+	// Node conditions are only checked for pods assigned to a node.
+	result, _, _ = podChecks.GetAction(createBasicPodInStateFor(false, 10*time.Second), nil, []*v1.Event{{Message: "MockEvent", Type: "None"}})
+	assert.Equal(t, ActionWait, result)
+}
+
+func Test_GetAction_AutoscalerEventMatched(t *testing.T) {
+	podChecks := podChecksWithMocks(ActionWait, ActionWait)
+	podChecks.autoscalerEventChecks = &mockAutoscalerEventChecks{result: ActionRetry, message: "scale up failed"}
+
+	// The autoscaler can act on an unscheduled pod before deadlineForNodeAssignment.
+	result, cause, message := podChecks.GetAction(createBasicPodInStateFor(false, 10*time.Second), nil, []*v1.Event{})
+	assert.Equal(t, ActionRetry, result)
+	assert.Equal(t, NoNodeAssigned, cause)
+	assert.Equal(t, "scale up failed", message)
+
+	// Autoscaler events are only checked for pods not yet assigned to a node.
+	result, _, _ = podChecks.GetAction(createBasicPod(true), nil, []*v1.Event{{Message: "MockEvent", Type: "None"}})
+	assert.Equal(t, ActionWait, result)
+}
+
 func createBasicPod(scheduled bool) *v1.Pod {
 	return createBasicPodInStateFor(scheduled, time.Minute)
 }
@@ -246,3 +278,21 @@ func (ec *mockEventChecks) getAction(podName string, podEvents []*v1.Event, time
 func (csc *mockContainerStateChecks) getAction(pod *v1.Pod, timeInState time.Duration) (Action, string) {
 	return csc.result, csc.message
 }
+
+type mockNodeConditionChecks struct {
+	result  Action
+	message string
+}
+
+func (ncc *mockNodeConditionChecks) getAction(pod *v1.Pod, node *v1.Node, currentTime time.Time) (Action, string) {
+	return ncc.result, ncc.message
+}
+
+type mockAutoscalerEventChecks struct {
+	result  Action
+	message string
+}
+
+func (aec *mockAutoscalerEventChecks) getAction(pod *v1.Pod, podEvents []*v1.Event, currentTime time.Time) (Action, string) {
+	return aec.result, aec.message
+}
@@ -261,6 +261,24 @@ func CreateJobFailedEvent(pod *v1.Pod, reason string, cause armadaevents.Kuberne
 	return sequence, nil
 }
 
+// AttachContainerLogs records the locations of captured container logs on the pod errors of the given sequence.
+func AttachContainerLogs(sequence *armadaevents.EventSequence, containerLogs []*armadaevents.ContainerLog) {
+	if sequence == nil || len(containerLogs) == 0 {
+		return
+	}
+	for _, event := range sequence.Events {
+		jobRunErrors := event.GetJobRunErrors()
+		if jobRunErrors == nil {
+			continue
+		}
+		for _, runError := range jobRunErrors.Errors {
+			if podError := runError.GetPodError(); podError != nil {
+				podError.ContainerLogs = containerLogs
+			}
+		}
+	}
+}
+
 // CreateJobRunCancelledDebugEvent creates a JobCancelledDebugInfo event carrying the rendered
 // k8s pod events for a run that is being cancelled before its main container ever started. It is
 // purely diagnostic: it is NOT an error and does NOT change the run's state, so the run remains
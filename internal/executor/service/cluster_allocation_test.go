@@ -12,8 +12,10 @@ import (
 
 	"github.com/armadaproject/armada/internal/common/healthmonitor"
 	util2 "github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/internal/executor/configuration"
 	fakecontext "github.com/armadaproject/armada/internal/executor/context/fake"
 	"github.com/armadaproject/armada/internal/executor/domain"
+	shadowcontext "github.com/armadaproject/armada/internal/executor/fake/context"
 	"github.com/armadaproject/armada/internal/executor/job"
 	"github.com/armadaproject/armada/internal/executor/job/mocks"
 	mocks2 "github.com/armadaproject/armada/internal/executor/reporter/mocks"
@@ -145,6 +147,41 @@ func TestAllocateSpareClusterCapacity_HandlesFailedPodCreations(t *testing.T) {
 	}
 }
 
+func TestAllocateSpareClusterCapacity_InShadowMode_ReturnsLeasesWithoutCreatingPods(t *testing.T) {
+	leaseRun := createRun(uuid.New().String(), job.Leased)
+	clusterContext := fakecontext.NewSyncFakeClusterContext()
+	submitter := job.NewSubmitter(
+		shadowcontext.NewShadowClusterContext(clusterContext),
+		&configuration.PodDefaults{},
+		1,
+		[]string{},
+		"",
+		configuration.JobNetworkPolicyConfiguration{},
+	)
+	clusterId := fakecontext.NewFakeClusterIdentity("cluster-1", "pool-1")
+	eventReporter := mocks2.NewFakeEventReporter()
+	runStore := job.NewJobRunStateStoreWithInitialState([]*job.RunState{leaseRun})
+	healthMonitor := &healthmonitor.ManualHealthMonitor{}
+	healthMonitor.SetHealthStatus(true)
+	clusterAllocationService := NewClusterAllocationService(clusterId, eventReporter, runStore, submitter, healthMonitor)
+
+	clusterAllocationService.AllocateSpareClusterCapacity()
+
+	pods, err := clusterContext.GetAllPods()
+	assert.NoError(t, err)
+	assert.Empty(t, pods)
+	assert.Equal(t, job.FailedSubmission, runStore.Get(leaseRun.Meta.RunId).Phase)
+	assert.Len(t, eventReporter.ReceivedEvents, 1)
+	assert.Len(t, eventReporter.ReceivedEvents[0].Event.Events, 1)
+	event, ok := eventReporter.ReceivedEvents[0].Event.Events[0].Event.(*armadaevents.EventSequence_Event_JobRunErrors)
+	assert.True(t, ok)
+	assert.Len(t, event.JobRunErrors.Errors, 1)
+	leaseReturned := event.JobRunErrors.Errors[0].GetPodLeaseReturned()
+	assert.NotNil(t, leaseReturned)
+	assert.True(t, leaseReturned.RunAttempted)
+	assert.Contains(t, leaseReturned.Message, "shadow mode")
+}
+
 func setupClusterAllocationServiceTest(initialJobRuns []*job.RunState) (
 	*ClusterAllocationService,
 	*healthmonitor.ManualHealthMonitor,
@@ -13,6 +13,7 @@ import (
 	"github.com/armadaproject/armada/internal/executor/configuration"
 	executorContext "github.com/armadaproject/armada/internal/executor/context"
 	"github.com/armadaproject/armada/internal/executor/job"
+	"github.com/armadaproject/armada/internal/executor/node"
 	"github.com/armadaproject/armada/internal/executor/reporter"
 	"github.com/armadaproject/armada/internal/executor/utilisation"
 	"github.com/armadaproject/armada/pkg/executorapi"
@@ -25,6 +26,7 @@ type JobRequester struct {
 	clusterId          executorContext.ClusterIdentity
 	podDefaults        *configuration.PodDefaults
 	jobRunStateStore   job.RunStateStore
+	quarantiner        *node.Quarantiner
 	maxLeasedJobs      int
 	maxRequestDuration time.Duration
 }
@@ -36,6 +38,7 @@ func NewJobRequester(
 	jobRunStateStore job.RunStateStore,
 	utilisationService utilisation.UtilisationService,
 	podDefaults *configuration.PodDefaults,
+	quarantiner *node.Quarantiner,
 	maxLeasedJobs int,
 	maxRequestDuration time.Duration,
 ) *JobRequester {
@@ -46,6 +49,7 @@ func NewJobRequester(
 		jobRunStateStore:   jobRunStateStore,
 		clusterId:          clusterId,
 		podDefaults:        podDefaults,
+		quarantiner:        quarantiner,
 		maxLeasedJobs:      maxLeasedJobs,
 		maxRequestDuration: maxRequestDuration,
 	}
@@ -71,6 +75,7 @@ func (r *JobRequester) RequestJobsRuns() {
 	r.markJobRunsAsLeased(jobs)
 	r.markJobRunsAsCancelled(leaseResponse.RunIdsToCancel)
 	r.markJobRunsToPreempt(leaseResponse.RunIdsToPreempt)
+	r.quarantiner.RequestRelease(leaseResponse.NodeQuarantineReleases)
 	r.handleFailedJobCreation(failedJobCreations)
 }
 
@@ -93,10 +98,11 @@ func (r *JobRequester) createLeaseRequest() (*LeaseRequest, error) {
 	}
 
 	return &LeaseRequest{
-		AvailableResource:   *capacityReport.AvailableCapacity,
-		Nodes:               nodes,
-		UnassignedJobRunIds: unassignedRunIds,
-		MaxJobsToLease:      uint32(maxJobsToLease),
+		AvailableResource:               *capacityReport.AvailableCapacity,
+		Nodes:                           nodes,
+		UnassignedJobRunIds:             unassignedRunIds,
+		MaxJobsToLease:                  uint32(maxJobsToLease),
+		ProcessedNodeQuarantineReleases: r.quarantiner.TakeProcessedReleases(),
 	}, nil
 }
 
@@ -271,6 +271,7 @@ func setupJobRequesterTest(initialJobRuns []*job.RunState) (*JobRequester, *mock
 		stateStore,
 		utilisationService,
 		podDefaults,
+		nil,
 		defaultMaxLeasedJobs,
 		defaultMaxRequestDuration)
 	return jobRequester, eventReporter, leaseRequester, stateStore, utilisationService
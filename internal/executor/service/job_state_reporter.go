@@ -10,7 +10,9 @@ import (
 	"github.com/armadaproject/armada/internal/executor/categorizer"
 	clusterContext "github.com/armadaproject/armada/internal/executor/context"
 	domain2 "github.com/armadaproject/armada/internal/executor/domain"
+	"github.com/armadaproject/armada/internal/executor/logcapture"
 	"github.com/armadaproject/armada/internal/executor/metrics"
+	"github.com/armadaproject/armada/internal/executor/node"
 	"github.com/armadaproject/armada/internal/executor/reporter"
 	"github.com/armadaproject/armada/internal/executor/util"
 )
@@ -20,6 +22,8 @@ type JobStateReporter struct {
 	clusterContext  clusterContext.ClusterContext
 	podIssueHandler IssueHandler
 	classifier      *categorizer.Classifier
+	quarantiner     *node.Quarantiner
+	logCapturer     *logcapture.LogCapturer
 }
 
 func NewJobStateReporter(
@@ -27,12 +31,16 @@ func NewJobStateReporter(
 	eventReporter reporter.EventReporter,
 	podIssueHandler IssueHandler,
 	classifier *categorizer.Classifier,
+	quarantiner *node.Quarantiner,
+	logCapturer *logcapture.LogCapturer,
 ) (*JobStateReporter, error) {
 	stateReporter := &JobStateReporter{
 		eventReporter:   eventReporter,
 		clusterContext:  clusterContext,
 		podIssueHandler: podIssueHandler,
 		classifier:      classifier,
+		quarantiner:     quarantiner,
+		logCapturer:     logCapturer,
 	}
 
 	_, err := clusterContext.AddPodEventHandler(stateReporter.podEventHandler())
@@ -129,6 +137,9 @@ func (stateReporter *JobStateReporter) reportCurrentStatus(pod *v1.Pod) {
 		log.Errorf("Failed to report event: %v", err)
 		return
 	}
+	if pod.Status.Phase == v1.PodFailed {
+		reporter.AttachContainerLogs(event, stateReporter.logCapturer.Capture(pod, classifyResult.Category))
+	}
 
 	stateReporter.eventReporter.QueueEvent(reporter.EventMessage{Event: event, JobRunId: util.ExtractJobRunId(pod)}, func(err error) {
 		if err != nil {
@@ -137,7 +148,8 @@ func (stateReporter *JobStateReporter) reportCurrentStatus(pod *v1.Pod) {
 		}
 		// Increment only after successful emission so failed sends do not inflate the counter.
 		// RecordJobFailure is a no-op for non-failure phases and for nil classifiers (empty category).
-		metrics.RecordJobFailure(classifyResult.Category, classifyResult.Subcategory)
+		metrics.RecordJobFailure(stateReporter.clusterContext.GetClusterId(), classifyResult.Category, classifyResult.Subcategory)
+		stateReporter.quarantiner.RecordFailure(pod.Spec.NodeName, classifyResult.Category)
 
 		if util.IsReportingPhaseRequired(pod.Status.Phase) {
 			err = stateReporter.addAnnotationToMarkStateReported(pod)
@@ -17,16 +17,18 @@ import (
 )
 
 type LeaseRequest struct {
-	AvailableResource   armadaresource.ComputeResources
-	Nodes               []*executorapi.NodeInfo
-	UnassignedJobRunIds []string
-	MaxJobsToLease      uint32
+	AvailableResource               armadaresource.ComputeResources
+	Nodes                           []*executorapi.NodeInfo
+	UnassignedJobRunIds             []string
+	MaxJobsToLease                  uint32
+	ProcessedNodeQuarantineReleases []*executorapi.NodeQuarantineRelease
 }
 
 type LeaseResponse struct {
-	LeasedRuns      []*executorapi.JobRunLease
-	RunIdsToCancel  []string
-	RunIdsToPreempt []string
+	LeasedRuns             []*executorapi.JobRunLease
+	RunIdsToCancel         []string
+	RunIdsToPreempt        []string
+	NodeQuarantineReleases []*executorapi.NodeQuarantineRelease
 }
 
 type LeaseRequester interface {
@@ -54,12 +56,13 @@ func (requester *JobLeaseRequester) LeaseJobRuns(ctx *armadacontext.Context, req
 		return nil, err
 	}
 	leaseRequest := &executorapi.LeaseRequest{
-		ExecutorId:          requester.clusterIdentity.GetClusterId(),
-		Pool:                requester.clusterIdentity.GetClusterPool(),
-		Resources:           request.AvailableResource.ToProtoMap(),
-		Nodes:               request.Nodes,
-		UnassignedJobRunIds: request.UnassignedJobRunIds,
-		MaxJobsToLease:      request.MaxJobsToLease,
+		ExecutorId:                      requester.clusterIdentity.GetClusterId(),
+		Pool:                            requester.clusterIdentity.GetClusterPool(),
+		Resources:                       request.AvailableResource.ToProtoMap(),
+		Nodes:                           request.Nodes,
+		UnassignedJobRunIds:             request.UnassignedJobRunIds,
+		MaxJobsToLease:                  request.MaxJobsToLease,
+		ProcessedNodeQuarantineReleases: request.ProcessedNodeQuarantineReleases,
 	}
 	if err := stream.Send(leaseRequest); err != nil {
 		return nil, errors.WithStack(err)
@@ -68,6 +71,7 @@ func (requester *JobLeaseRequester) LeaseJobRuns(ctx *armadacontext.Context, req
 	leaseRuns := []*executorapi.JobRunLease{}
 	runIdsToCancel := []string{}
 	runIdsToPreempt := []string{}
+	nodeQuarantineReleases := []*executorapi.NodeQuarantineRelease{}
 	shouldEndStream := false
 	for !shouldEndStream {
 		res, err := stream.Recv()
@@ -82,6 +86,8 @@ func (requester *JobLeaseRequester) LeaseJobRuns(ctx *armadacontext.Context, req
 			runIdsToPreempt = append(runIdsToPreempt, typed.PreemptRuns.JobRunIdsToPreempt...)
 		case *executorapi.LeaseStreamMessage_CancelRuns:
 			runIdsToCancel = append(runIdsToCancel, typed.CancelRuns.JobRunIdsToCancel...)
+		case *executorapi.LeaseStreamMessage_ReleaseNodeQuarantines:
+			nodeQuarantineReleases = append(nodeQuarantineReleases, typed.ReleaseNodeQuarantines.Releases...)
 		case *executorapi.LeaseStreamMessage_End:
 			shouldEndStream = true
 		default:
@@ -95,9 +101,10 @@ func (requester *JobLeaseRequester) LeaseJobRuns(ctx *armadacontext.Context, req
 	}
 
 	return &LeaseResponse{
-		LeasedRuns:      leaseRuns,
-		RunIdsToCancel:  runIdsToCancel,
-		RunIdsToPreempt: runIdsToPreempt,
+		LeasedRuns:             leaseRuns,
+		RunIdsToCancel:         runIdsToCancel,
+		RunIdsToPreempt:        runIdsToPreempt,
+		NodeQuarantineReleases: nodeQuarantineReleases,
 	}, nil
 }
 
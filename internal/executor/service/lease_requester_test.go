@@ -13,6 +13,7 @@ import (
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/mocks"
+	protoutil "github.com/armadaproject/armada/internal/common/proto"
 	armadaresource "github.com/armadaproject/armada/internal/common/resource"
 	"github.com/armadaproject/armada/internal/executor/context/fake"
 	"github.com/armadaproject/armada/pkg/api"
@@ -82,6 +83,30 @@ func TestLeaseJobRuns(t *testing.T) {
 	}
 }
 
+func TestLeaseJobRuns_NodeQuarantineReleases(t *testing.T) {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 30*time.Second)
+	defer cancel()
+	releases := []*executorapi.NodeQuarantineRelease{
+		{NodeName: "node-1", RequestedAt: protoutil.ToTimestamp(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))},
+		{NodeName: "node-2", RequestedAt: protoutil.ToTimestamp(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))},
+	}
+
+	jobRequester, mockExecutorApiClient, mockStream := setup(t)
+	mockExecutorApiClient.EXPECT().LeaseJobRuns(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockStream, nil)
+	mockStream.EXPECT().Send(gomock.Any()).Return(nil)
+	mockStream.EXPECT().Recv().Return(&executorapi.LeaseStreamMessage{
+		Event: &executorapi.LeaseStreamMessage_ReleaseNodeQuarantines{
+			ReleaseNodeQuarantines: &executorapi.ReleaseNodeQuarantines{Releases: releases},
+		},
+	}, nil)
+	mockStream.EXPECT().Recv().Return(endMarker, nil)
+	mockStream.EXPECT().Recv().Return(nil, io.EOF)
+
+	response, err := jobRequester.LeaseJobRuns(ctx, &LeaseRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, releases, response.NodeQuarantineReleases)
+}
+
 func TestLeaseJobRuns_Send(t *testing.T) {
 	shortCtx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 30*time.Second)
 	defer cancel()
@@ -99,15 +124,19 @@ func TestLeaseJobRuns_Send(t *testing.T) {
 		},
 		UnassignedJobRunIds: []string{id1},
 		MaxJobsToLease:      uint32(5),
+		ProcessedNodeQuarantineReleases: []*executorapi.NodeQuarantineRelease{
+			{NodeName: "node-1", RequestedAt: protoutil.ToTimestamp(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))},
+		},
 	}
 
 	expectedRequest := &executorapi.LeaseRequest{
-		ExecutorId:          defaultClusterIdentity.GetClusterId(),
-		Pool:                defaultClusterIdentity.GetClusterPool(),
-		Resources:           leaseRequest.AvailableResource.ToProtoMap(),
-		Nodes:               leaseRequest.Nodes,
-		UnassignedJobRunIds: leaseRequest.UnassignedJobRunIds,
-		MaxJobsToLease:      leaseRequest.MaxJobsToLease,
+		ExecutorId:                      defaultClusterIdentity.GetClusterId(),
+		Pool:                            defaultClusterIdentity.GetClusterPool(),
+		Resources:                       leaseRequest.AvailableResource.ToProtoMap(),
+		Nodes:                           leaseRequest.Nodes,
+		UnassignedJobRunIds:             leaseRequest.UnassignedJobRunIds,
+		MaxJobsToLease:                  leaseRequest.MaxJobsToLease,
+		ProcessedNodeQuarantineReleases: leaseRequest.ProcessedNodeQuarantineReleases,
 	}
 
 	jobRequester, mockExecutorApiClient, mockStream := setup(t)
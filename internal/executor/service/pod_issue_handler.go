@@ -17,6 +17,7 @@ import (
 	executorContext "github.com/armadaproject/armada/internal/executor/context"
 	"github.com/armadaproject/armada/internal/executor/job"
 	"github.com/armadaproject/armada/internal/executor/metrics"
+	"github.com/armadaproject/armada/internal/executor/node"
 	"github.com/armadaproject/armada/internal/executor/podchecks"
 	"github.com/armadaproject/armada/internal/executor/podchecks/failedpodchecks"
 	"github.com/armadaproject/armada/internal/executor/reporter"
@@ -42,10 +43,12 @@ type podIssue struct {
 	Classification categorizer.ClassifyResult
 	DetectionTime  time.Time
 	// A copy of the pod when an issue was detected
-	OriginalPodState  *v1.Pod
-	Message           string
-	DebugMessage      string
-	Retryable         bool
+	OriginalPodState *v1.Pod
+	Message          string
+	DebugMessage     string
+	Retryable        bool
+	// AvoidNode is set for retryable issues where the job should be retried on a different node.
+	AvoidNode         bool
 	DeletionRequested bool
 	Type              podIssueType
 	Cause             armadaevents.KubernetesReason
@@ -81,6 +84,7 @@ type PodIssueHandler struct {
 	failedPodChecker  failedpodchecks.RetryChecker
 	stateChecksConfig configuration.StateChecksConfiguration
 	classifier        *categorizer.Classifier
+	quarantiner       *node.Quarantiner
 
 	stuckTerminatingPodExpiry time.Duration
 
@@ -100,6 +104,7 @@ func NewPodIssuerHandler(
 	failedPodChecker failedpodchecks.RetryChecker,
 	stuckTerminatingPodExpiry time.Duration,
 	classifier *categorizer.Classifier,
+	quarantiner *node.Quarantiner,
 ) (*PodIssueHandler, error) {
 	issueHandler := &PodIssueHandler{
 		jobRunState:               jobRunState,
@@ -109,6 +114,7 @@ func NewPodIssuerHandler(
 		failedPodChecker:          failedPodChecker,
 		stateChecksConfig:         stateChecksConfig,
 		classifier:                classifier,
+		quarantiner:               quarantiner,
 		stuckTerminatingPodExpiry: stuckTerminatingPodExpiry,
 		knownPodIssues:            map[string]*runIssue{},
 		podIssueMutex:             sync.Mutex{},
@@ -341,10 +347,18 @@ func (p *PodIssueHandler) detectPodIssues(allManagedPods []*v1.Pod) {
 				log.Errorf("Unable to get pod events for pod %s: %v", pod.Name, err)
 			}
 
-			action, cause, podCheckMessage := p.pendingPodChecker.GetAction(pod, podEvents)
+			var node *v1.Node
+			if pod.Spec.NodeName != "" {
+				node, err = p.clusterContext.GetNode(pod.Spec.NodeName)
+				if err != nil {
+					log.Warnf("Unable to get node %s for pod %s, so node condition checks are skipped: %v", pod.Spec.NodeName, pod.Name, err)
+				}
+			}
+
+			action, cause, podCheckMessage := p.pendingPodChecker.GetAction(pod, node, podEvents)
 
 			if action != podchecks.ActionWait {
-				retryable := action == podchecks.ActionRetry
+				retryable := action == podchecks.ActionRetry || action == podchecks.ActionReturnLeaseAvoidNode
 				message := createStuckPodMessage(retryable, podCheckMessage)
 				debugMessage := reporter.CreateDebugMessage(podEvents)
 				podIssueType := StuckStartingUp
@@ -359,6 +373,7 @@ func (p *PodIssueHandler) detectPodIssues(allManagedPods []*v1.Pod) {
 					Message:          message,
 					DebugMessage:     debugMessage,
 					Retryable:        retryable,
+					AvoidNode:        action == podchecks.ActionReturnLeaseAvoidNode,
 					Type:             podIssueType,
 				}
 				p.attemptToRegisterIssue(&runIssue{
@@ -497,7 +512,8 @@ func (p *PodIssueHandler) handleNonRetryableJobIssue(issue *issue) {
 		}
 		// Increment only after successful Report so failed sends do not inflate the counter.
 		// RecordJobFailure is a no-op when classification didn't run (empty category).
-		metrics.RecordJobFailure(failureCategory, failureSubcategory)
+		metrics.RecordJobFailure(p.clusterContext.GetClusterId(), failureCategory, failureSubcategory)
+		p.quarantiner.RecordFailure(podIssue.OriginalPodState.Spec.NodeName, failureCategory)
 		p.markIssueReported(issue.RunIssue)
 	}
 
@@ -594,7 +610,8 @@ func (p *PodIssueHandler) reportDeleteActionFailure(issue *issue, category strin
 		log.Errorf("Failed to report failed event for job %s because %s", issue.RunIssue.JobId, err)
 		return
 	}
-	metrics.RecordJobFailure(category, subcategory)
+	metrics.RecordJobFailure(p.clusterContext.GetClusterId(), category, subcategory)
+	p.quarantiner.RecordFailure(podIssue.OriginalPodState.Spec.NodeName, category)
 	p.markIssueReported(issue.RunIssue)
 }
 
@@ -639,6 +656,11 @@ func (p *PodIssueHandler) handleRetryableJobIssue(issue *issue) {
 		// When we have our own internal state - we don't need to wait for the pod deletion to complete
 		// We can just mark is to delete in our state and return the lease
 		jobRunAttempted := issue.RunIssue.PodIssue.Type != UnableToSchedule
+		if issue.RunIssue.PodIssue.AvoidNode {
+			// The scheduler avoids the nodes of a job's attempted runs when retrying it,
+			// so report the run as attempted whenever the pod was assigned a node.
+			jobRunAttempted = issue.RunIssue.PodIssue.OriginalPodState.Spec.NodeName != ""
+		}
 		result := p.classifier.ClassifyPodError(issue.RunIssue.PodIssue.OriginalPodState, issue.RunIssue.PodIssue.Message, nil)
 
 		returnLeaseEvent, err := reporter.CreateReturnLeaseEvent(
@@ -661,7 +683,8 @@ func (p *PodIssueHandler) handleRetryableJobIssue(issue *issue) {
 			return
 		}
 		// Record only after a successful Report so failed sends do not inflate the counter.
-		metrics.RecordJobFailure(result.Category, result.Subcategory)
+		metrics.RecordJobFailure(p.clusterContext.GetClusterId(), result.Category, result.Subcategory)
+		p.quarantiner.RecordFailure(issue.RunIssue.PodIssue.OriginalPodState.Spec.NodeName, result.Category)
 		p.markIssuesResolved(issue.RunIssue)
 	}
 }
@@ -540,6 +540,7 @@ func setupTestComponentsWithClassifier(initialRunState []*job.RunState, classifi
 		failedPodChecker,
 		time.Minute*3,
 		classifier,
+		nil,
 	)
 
 	return podIssueHandler, runStateStore, fakeClusterContext, eventReporter, err
@@ -692,6 +693,35 @@ func TestPodIssueService_RetryableIssue_LeaseReturnClassification(t *testing.T)
 	}
 }
 
+func TestPodIssueService_ReturnsLeaseAndAvoidsNode_IfNodeConditionMatches(t *testing.T) {
+	podIssueService, _, fakeClusterContext, eventReporter, err := setupTestComponents([]*job.RunState{})
+	require.NoError(t, err)
+	fakeClusterContext.Nodes["node1"] = &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node1"},
+		Status: v1.NodeStatus{Conditions: []v1.NodeCondition{{
+			Type:               v1.NodeDiskPressure,
+			Status:             v1.ConditionTrue,
+			LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Minute)),
+		}}},
+	}
+	pod := makeTestPod(v1.PodStatus{Phase: v1.PodPending})
+	addPod(t, fakeClusterContext, pod)
+
+	// First pass deletes the pod, second pass emits the lease return.
+	podIssueService.HandlePodIssues()
+	assert.Equal(t, []*v1.Pod{}, getActivePods(t, fakeClusterContext))
+	podIssueService.HandlePodIssues()
+
+	require.Len(t, eventReporter.ReceivedEvents, 1)
+	returnedEvent, ok := eventReporter.ReceivedEvents[0].Event.Events[0].Event.(*armadaevents.EventSequence_Event_JobRunErrors)
+	require.True(t, ok)
+	leaseReturned := returnedEvent.JobRunErrors.Errors[0].GetPodLeaseReturned()
+	require.NotNil(t, leaseReturned)
+	// Reporting the run as attempted has the scheduler avoid the node when retrying the job.
+	assert.True(t, leaseReturned.RunAttempted)
+	assert.Contains(t, leaseReturned.Message, "DiskPressure=True")
+}
+
 func TestPodIssueService_RetryableIssue_NoRecordWhenReportFails(t *testing.T) {
 	category, subcategory := "pih-retry-report-fail-cat", "pih-retry-report-fail-sub"
 	classifier := podErrorClassifier(t, category, subcategory, "Unable to start pod", "")
@@ -867,6 +897,9 @@ func makePendingPodChecker() podchecks.PodChecker {
 		{State: podchecksConfig.ContainerStateWaiting, ReasonRegexp: "ImagePullBackOff", GracePeriod: time.Nanosecond, Action: podchecksConfig.ActionFail},
 		{State: podchecksConfig.ContainerStateWaiting, ReasonRegexp: "Some reason", GracePeriod: time.Nanosecond, Action: podchecksConfig.ActionRetry},
 	}
+	cfg.NodeConditions = []podchecksConfig.NodeConditionCheck{
+		{Type: v1.NodeDiskPressure, Status: v1.ConditionTrue, GracePeriod: time.Nanosecond, Action: podchecksConfig.ActionReturnLeaseAvoidNode},
+	}
 
 	checker, err := podchecks.NewPodChecks(cfg, realclock.RealClock{})
 	if err != nil {
@@ -1183,6 +1216,7 @@ func TestPodIssueService_DeleteActionLifecycle(t *testing.T) {
 				makeFailedPodChecker(),
 				time.Minute*3,
 				classifier,
+				nil,
 			)
 			require.NoError(t, err)
 			baseTime := time.Now()
@@ -35,6 +35,9 @@ func NewResourceCleanupService(
 
 	 We do set ownerreference on the services to point to the pod.
 	 So in the case the cleanup below fails, the ownerreference will ensure it is cleaned up when the pod is
+
+	 Configmaps, secrets and persistentvolumeclaims created for the job are removed at the same point, so that
+	 storage and secret copies aren't held for the lifetime of the terminated pod
 	*/
 
 	_, err := clusterContext.AddPodEventHandler(cache.ResourceEventHandlerFuncs{
@@ -47,6 +50,9 @@ func NewResourceCleanupService(
 			if util.IsManagedPod(pod) && util.IsInTerminalState(pod) && util.HasIngress(pod) {
 				go service.removeAnyAssociatedIngress(pod)
 			}
+			if util.IsManagedPod(pod) && util.IsInTerminalState(pod) && util.HasAssociatedObjects(pod) {
+				go service.removeAssociatedObjects(pod)
+			}
 		},
 	})
 	if err != nil {
@@ -85,6 +91,14 @@ func (i *ResourceCleanupService) removeAnyAssociatedIngress(pod *v1.Pod) {
 	}
 }
 
+func (i *ResourceCleanupService) removeAssociatedObjects(pod *v1.Pod) {
+	log.Infof("Removing any configmaps, secrets and persistentvolumeclaims associated with pod %s (%s)", pod.Name, pod.Namespace)
+	err := i.clusterContext.DeleteAssociatedObjects(pod)
+	if err != nil {
+		log.Errorf("Failed to remove objects associated with pod %s (%s) because %s", pod.Name, pod.Namespace, err)
+	}
+}
+
 // CleanupResources
 /*
  * This function finds and delete old resources. It does this in two ways:
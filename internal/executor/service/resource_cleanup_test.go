@@ -93,6 +93,30 @@ func TestCleanUpResources_RemovesNonExpiredPodsOverMaxTerminatedPodLimit(t *test
 	assert.Equal(t, remainingPods[0].Name, succeededNonExpiredPod.Name)
 }
 
+func TestRemoveAssociatedObjects(t *testing.T) {
+	s, err := createResourceCleanupService(time.Second, time.Second, 10)
+	require.NoError(t, err)
+	fakeClusterContext := s.clusterContext.(*fake.SyncFakeClusterContext)
+	objectMeta := func(name string, jobId string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Namespace: "default", Name: name, Labels: map[string]string{domain.JobId: jobId}}
+	}
+	_, err = fakeClusterContext.SubmitConfigMap(&v1.ConfigMap{ObjectMeta: objectMeta("config-1", "job-1")})
+	require.NoError(t, err)
+	_, err = fakeClusterContext.SubmitSecret(&v1.Secret{ObjectMeta: objectMeta("secret-1", "job-1")})
+	require.NoError(t, err)
+	_, err = fakeClusterContext.SubmitPersistentVolumeClaim(&v1.PersistentVolumeClaim{ObjectMeta: objectMeta("claim-1", "job-1")})
+	require.NoError(t, err)
+	_, err = fakeClusterContext.SubmitConfigMap(&v1.ConfigMap{ObjectMeta: objectMeta("config-2", "job-2")})
+	require.NoError(t, err)
+
+	s.removeAssociatedObjects(&v1.Pod{ObjectMeta: objectMeta("armada-job-1-0", "job-1")})
+
+	assert.Len(t, fakeClusterContext.ConfigMaps, 1)
+	assert.Contains(t, fakeClusterContext.ConfigMaps, "default/config-2")
+	assert.Empty(t, fakeClusterContext.Secrets)
+	assert.Empty(t, fakeClusterContext.VolumeClaims)
+}
+
 func TestCanBeRemovedConditions(t *testing.T) {
 	s, err := createResourceCleanupService(time.Second, time.Second, 1)
 	require.NoError(t, err)
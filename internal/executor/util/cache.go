@@ -33,12 +33,12 @@ type mapPodCache struct {
 	sizeGauge     prometheus.Gauge
 }
 
-func NewTimeExpiringPodCache(expiry time.Duration, cleanUpInterval time.Duration, metricName string) *mapPodCache {
+func NewTimeExpiringPodCache(expiry time.Duration, cleanUpInterval time.Duration, metricName string, registerer prometheus.Registerer) *mapPodCache {
 	cache := &mapPodCache{
 		records:       map[string]cacheRecord{},
 		rwLock:        sync.RWMutex{},
 		defaultExpiry: expiry,
-		sizeGauge: promauto.NewGauge(
+		sizeGauge: promauto.With(registerer).NewGauge(
 			prometheus.GaugeOpts{
 				Name: metrics.ArmadaExecutorMetricsPrefix + metricName + "_cache_size",
 				Help: "Number of pods in the pod cache",
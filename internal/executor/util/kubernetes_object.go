@@ -105,6 +105,78 @@ func ExtractServices(job *executorapi.JobRunLease, pod *v1.Pod) []*v1.Service {
 	return result
 }
 
+// JobSecret is a secret to be created in a job's namespace by copying the data of
+// the named secret in the executor's job secret namespace.
+type JobSecret struct {
+	ObjectMeta metav1.ObjectMeta
+	SourceName string
+}
+
+func ExtractConfigMaps(job *executorapi.JobRunLease, pod *v1.Pod) []*v1.ConfigMap {
+	result := make([]*v1.ConfigMap, 0)
+	for _, additionalObject := range job.Job.Objects {
+		switch typed := additionalObject.Object.(type) {
+		case *armadaevents.KubernetesObject_ConfigMap:
+			result = append(result, &v1.ConfigMap{
+				ObjectMeta: createAssociatedObjectMeta(job, pod, additionalObject.ObjectMeta),
+				Immutable:  typed.ConfigMap.Immutable,
+				Data:       typed.ConfigMap.Data,
+				BinaryData: typed.ConfigMap.BinaryData,
+			})
+		}
+	}
+	return result
+}
+
+func ExtractSecrets(job *executorapi.JobRunLease, pod *v1.Pod) []*JobSecret {
+	result := make([]*JobSecret, 0)
+	for _, additionalObject := range job.Job.Objects {
+		switch typed := additionalObject.Object.(type) {
+		case *armadaevents.KubernetesObject_Secret:
+			result = append(result, &JobSecret{
+				ObjectMeta: createAssociatedObjectMeta(job, pod, additionalObject.ObjectMeta),
+				SourceName: typed.Secret.SourceName,
+			})
+		}
+	}
+	return result
+}
+
+func ExtractPersistentVolumeClaims(job *executorapi.JobRunLease, pod *v1.Pod) []*v1.PersistentVolumeClaim {
+	result := make([]*v1.PersistentVolumeClaim, 0)
+	for _, additionalObject := range job.Job.Objects {
+		switch typed := additionalObject.Object.(type) {
+		case *armadaevents.KubernetesObject_PersistentVolumeClaim:
+			result = append(result, &v1.PersistentVolumeClaim{
+				ObjectMeta: createAssociatedObjectMeta(job, pod, additionalObject.ObjectMeta),
+				Spec:       *typed.PersistentVolumeClaim,
+			})
+		}
+	}
+	return result
+}
+
+// createAssociatedObjectMeta returns the metadata of an object created alongside the given pod.
+// The pod's identifying labels are added so that the object can be found, and cleaned up, from the pod.
+func createAssociatedObjectMeta(job *executorapi.JobRunLease, pod *v1.Pod, objectMeta *armadaevents.ObjectMeta) metav1.ObjectMeta {
+	labels := util.MergeMaps(objectMeta.Labels, map[string]string{
+		domain.JobId:     pod.Labels[domain.JobId],
+		domain.JobRunId:  pod.Labels[domain.JobRunId],
+		domain.Queue:     pod.Labels[domain.Queue],
+		domain.PodNumber: pod.Labels[domain.PodNumber],
+	})
+	annotations := util.MergeMaps(objectMeta.Annotations, map[string]string{
+		domain.JobSetId: job.Jobset,
+		domain.Owner:    job.User,
+	})
+	return metav1.ObjectMeta{
+		Name:        objectMeta.Name,
+		Labels:      labels,
+		Annotations: annotations,
+		Namespace:   objectMeta.Namespace,
+	}
+}
+
 func CreatePodFromExecutorApiJob(job *executorapi.JobRunLease, defaults *configuration.PodDefaults) (*v1.Pod, error) {
 	podSpec, err := getPodSpec(job)
 	if err != nil {
@@ -147,6 +219,12 @@ func CreatePodFromExecutorApiJob(job *executorapi.JobRunLease, defaults *configu
 		Spec: *podSpec,
 	}
 
+	if defaults != nil {
+		if err := applyPodTemplates(pod, defaults.Templates); err != nil {
+			return nil, err
+		}
+	}
+
 	return pod, nil
 }
 
@@ -135,6 +135,81 @@ func TestCreatePodFromExecutorApiJob(t *testing.T) {
 	assert.Equal(t, expectedPod, result)
 }
 
+func TestExtractConfigMapsSecretsAndPersistentVolumeClaims(t *testing.T) {
+	objectMeta := func(name string) *armadaevents.ObjectMeta {
+		return &armadaevents.ObjectMeta{
+			Namespace:   "test-namespace",
+			Name:        name,
+			Labels:      map[string]string{"app": "test"},
+			Annotations: map[string]string{},
+		}
+	}
+	claimSpec := v1.PersistentVolumeClaimSpec{AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce}}
+	jobLease := &executorapi.JobRunLease{
+		Jobset: "job-set",
+		User:   "user",
+		Job: &armadaevents.SubmitJob{
+			Objects: []*armadaevents.KubernetesObject{
+				{
+					ObjectMeta: objectMeta("job-config"),
+					Object: &armadaevents.KubernetesObject_ConfigMap{
+						ConfigMap: &v1.ConfigMap{Data: map[string]string{"key": "value"}},
+					},
+				},
+				{
+					ObjectMeta: objectMeta("job-creds"),
+					Object: &armadaevents.KubernetesObject_Secret{
+						Secret: &armadaevents.SecretReference{SourceName: "team-creds"},
+					},
+				},
+				{
+					ObjectMeta: objectMeta("job-scratch"),
+					Object: &armadaevents.KubernetesObject_PersistentVolumeClaim{
+						PersistentVolumeClaim: &claimSpec,
+					},
+				},
+			},
+		},
+	}
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				domain.JobId:     "job-id",
+				domain.JobRunId:  "run-id",
+				domain.Queue:     "queue",
+				domain.PodNumber: "0",
+			},
+		},
+	}
+	expectedObjectMeta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Namespace: "test-namespace",
+			Name:      name,
+			Labels: map[string]string{
+				"app":            "test",
+				domain.JobId:     "job-id",
+				domain.JobRunId:  "run-id",
+				domain.Queue:     "queue",
+				domain.PodNumber: "0",
+			},
+			Annotations: map[string]string{
+				domain.JobSetId: "job-set",
+				domain.Owner:    "user",
+			},
+		}
+	}
+
+	assert.Equal(t, []*v1.ConfigMap{
+		{ObjectMeta: expectedObjectMeta("job-config"), Data: map[string]string{"key": "value"}},
+	}, ExtractConfigMaps(jobLease, pod))
+	assert.Equal(t, []*JobSecret{
+		{ObjectMeta: expectedObjectMeta("job-creds"), SourceName: "team-creds"},
+	}, ExtractSecrets(jobLease, pod))
+	assert.Equal(t, []*v1.PersistentVolumeClaim{
+		{ObjectMeta: expectedObjectMeta("job-scratch"), Spec: claimSpec},
+	}, ExtractPersistentVolumeClaims(jobLease, pod))
+}
+
 func TestCreatePodFromExecutorApiJob_Invalid(t *testing.T) {
 	lease := createBasicJobRunLease()
 	_, err := CreatePodFromExecutorApiJob(lease, &configuration.PodDefaults{})
@@ -0,0 +1,91 @@
+package util
+
+import (
+	"crypto/sha1"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/armadaproject/armada/internal/common/constants"
+	"github.com/armadaproject/armada/internal/executor/configuration"
+	"github.com/armadaproject/armada/internal/executor/domain"
+)
+
+// CreateJobNetworkPolicy returns the NetworkPolicy isolating the given pod, or nil if its queue, which has the given labels, isn't isolated.
+// Pods that may talk to each other are labelled with the same network group, so the pod is labelled with its group here;
+// hence this must be called before the pod is submitted.
+func CreateJobNetworkPolicy(pod *v1.Pod, queueLabels map[string]string, config configuration.JobNetworkPolicyConfiguration) (*networking.NetworkPolicy, error) {
+	if !config.Enabled {
+		return nil, nil
+	}
+	queueSelector, err := metav1.LabelSelectorAsSelector(&config.QueueSelector)
+	if err != nil {
+		return nil, err
+	}
+	if !queueSelector.Matches(labels.Set(queueLabels)) {
+		return nil, nil
+	}
+	queue := ExtractQueue(pod)
+
+	networkGroup := getNetworkGroup(pod, config.Scope)
+	if pod.Labels == nil {
+		pod.Labels = map[string]string{}
+	}
+	pod.Labels[domain.NetworkGroup] = networkGroup
+
+	peers := []networking.NetworkPolicyPeer{{
+		PodSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{
+				domain.Queue:        queue,
+				domain.NetworkGroup: networkGroup,
+			},
+		},
+	}}
+	ingress := append([]networking.NetworkPolicyIngressRule{{From: peers}}, config.Ingress...)
+	egress := append([]networking.NetworkPolicyEgressRule{{To: peers}}, config.Egress...)
+
+	return &networking.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pod.Name,
+			Namespace: pod.Namespace,
+			Labels: map[string]string{
+				domain.JobId:     pod.Labels[domain.JobId],
+				domain.JobRunId:  pod.Labels[domain.JobRunId],
+				domain.Queue:     queue,
+				domain.PodNumber: pod.Labels[domain.PodNumber],
+			},
+			Annotations: map[string]string{
+				domain.JobSetId: ExtractJobSet(pod),
+				domain.Owner:    pod.Annotations[domain.Owner],
+			},
+		},
+		Spec: networking.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					domain.JobId: pod.Labels[domain.JobId],
+					domain.Queue: queue,
+				},
+			},
+			PolicyTypes: []networking.PolicyType{networking.PolicyTypeIngress, networking.PolicyTypeEgress},
+			Ingress:     ingress,
+			Egress:      egress,
+		},
+	}, nil
+}
+
+// getNetworkGroup returns the label value shared by the pods the given pod may talk to.
+// Job set and gang ids aren't necessarily valid label values, so the value is a hash.
+func getNetworkGroup(pod *v1.Pod, scope configuration.JobNetworkPolicyScope) string {
+	group := []string{ExtractQueue(pod), ExtractJobSet(pod)}
+	if scope != configuration.JobNetworkPolicyScopeJobSet {
+		if gangId := pod.Annotations[constants.GangIdAnnotation]; gangId != "" {
+			group = append(group, "gang", gangId)
+		} else {
+			group = append(group, "job", ExtractJobId(pod))
+		}
+	}
+	return fmt.Sprintf("%x", sha1.Sum([]byte(fmt.Sprintf("%q", group))))
+}
@@ -0,0 +1,158 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/armadaproject/armada/internal/common/constants"
+	"github.com/armadaproject/armada/internal/executor/configuration"
+	"github.com/armadaproject/armada/internal/executor/domain"
+)
+
+func TestCreateJobNetworkPolicy(t *testing.T) {
+	dnsEgress := networking.NetworkPolicyEgressRule{
+		To: []networking.NetworkPolicyPeer{{IPBlock: &networking.IPBlock{CIDR: "10.0.0.10/32"}}},
+	}
+	ingressControllerIngress := networking.NetworkPolicyIngressRule{
+		From: []networking.NetworkPolicyPeer{{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"kubernetes.io/metadata.name": "ingress-nginx"}},
+		}},
+	}
+	config := configuration.JobNetworkPolicyConfiguration{
+		Enabled: true,
+		Scope:   configuration.JobNetworkPolicyScopeGang,
+		Ingress: []networking.NetworkPolicyIngressRule{ingressControllerIngress},
+		Egress:  []networking.NetworkPolicyEgressRule{dnsEgress},
+	}
+	pod := makeNetworkPolicyTestPod("job-1", "queue", "job-set", "")
+
+	policy, err := CreateJobNetworkPolicy(pod, nil, config)
+
+	require.NoError(t, err)
+	require.NotNil(t, policy)
+	networkGroup := pod.Labels[domain.NetworkGroup]
+	assert.NotEmpty(t, networkGroup)
+	assert.Equal(t, "armada-job-1-0", policy.Name)
+	assert.Equal(t, "namespace", policy.Namespace)
+	assert.Equal(t, "job-1", policy.Labels[domain.JobId])
+	assert.Equal(t, map[string]string{domain.JobId: "job-1", domain.Queue: "queue"}, policy.Spec.PodSelector.MatchLabels)
+	assert.Equal(t, []networking.PolicyType{networking.PolicyTypeIngress, networking.PolicyTypeEgress}, policy.Spec.PolicyTypes)
+
+	expectedPeers := []networking.NetworkPolicyPeer{{
+		PodSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{domain.Queue: "queue", domain.NetworkGroup: networkGroup},
+		},
+	}}
+	assert.Equal(t, []networking.NetworkPolicyIngressRule{{From: expectedPeers}, ingressControllerIngress}, policy.Spec.Ingress)
+	assert.Equal(t, []networking.NetworkPolicyEgressRule{{To: expectedPeers}, dnsEgress}, policy.Spec.Egress)
+}
+
+func TestCreateJobNetworkPolicy_QueueSelector(t *testing.T) {
+	isolatedQueues := metav1.LabelSelector{MatchLabels: map[string]string{"network-isolation": "true"}}
+	tests := map[string]struct {
+		config           configuration.JobNetworkPolicyConfiguration
+		queueLabels      map[string]string
+		expectedIsolated bool
+	}{
+		"disabled": {
+			config: configuration.JobNetworkPolicyConfiguration{Enabled: false, Scope: configuration.JobNetworkPolicyScopeGang},
+		},
+		"all queues isolated": {
+			config:           configuration.JobNetworkPolicyConfiguration{Enabled: true, Scope: configuration.JobNetworkPolicyScopeGang},
+			expectedIsolated: true,
+		},
+		"queue labels match": {
+			config:           configuration.JobNetworkPolicyConfiguration{Enabled: true, Scope: configuration.JobNetworkPolicyScopeGang, QueueSelector: isolatedQueues},
+			queueLabels:      map[string]string{"network-isolation": "true", "team": "a"},
+			expectedIsolated: true,
+		},
+		"queue labels don't match": {
+			config:      configuration.JobNetworkPolicyConfiguration{Enabled: true, Scope: configuration.JobNetworkPolicyScopeGang, QueueSelector: isolatedQueues},
+			queueLabels: map[string]string{"network-isolation": "false"},
+		},
+		"queue without labels": {
+			config: configuration.JobNetworkPolicyConfiguration{Enabled: true, Scope: configuration.JobNetworkPolicyScopeGang, QueueSelector: isolatedQueues},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pod := makeNetworkPolicyTestPod("job-1", "queue", "job-set", "")
+			policy, err := CreateJobNetworkPolicy(pod, tc.queueLabels, tc.config)
+			require.NoError(t, err)
+			if tc.expectedIsolated {
+				assert.NotNil(t, policy)
+				assert.Contains(t, pod.Labels, domain.NetworkGroup)
+			} else {
+				assert.Nil(t, policy)
+				assert.NotContains(t, pod.Labels, domain.NetworkGroup)
+			}
+		})
+	}
+}
+
+func TestCreateJobNetworkPolicy_InvalidQueueSelector(t *testing.T) {
+	config := configuration.JobNetworkPolicyConfiguration{
+		Enabled: true,
+		Scope:   configuration.JobNetworkPolicyScopeGang,
+		QueueSelector: metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "network-isolation", Operator: "Unknown"}},
+		},
+	}
+	_, err := CreateJobNetworkPolicy(makeNetworkPolicyTestPod("job-1", "queue", "job-set", ""), nil, config)
+	assert.Error(t, err)
+}
+
+func TestGetNetworkGroup(t *testing.T) {
+	gang := configuration.JobNetworkPolicyScopeGang
+	jobSet := configuration.JobNetworkPolicyScopeJobSet
+
+	// Jobs not in a gang are isolated from each other.
+	assert.NotEqual(t,
+		getNetworkGroup(makeNetworkPolicyTestPod("job-1", "queue", "job-set", ""), gang),
+		getNetworkGroup(makeNetworkPolicyTestPod("job-2", "queue", "job-set", ""), gang))
+	// Jobs of the same gang share a group.
+	assert.Equal(t,
+		getNetworkGroup(makeNetworkPolicyTestPod("job-1", "queue", "job-set", "gang-1"), gang),
+		getNetworkGroup(makeNetworkPolicyTestPod("job-2", "queue", "job-set", "gang-1"), gang))
+	// Gangs with the same id in different queues are isolated from each other.
+	assert.NotEqual(t,
+		getNetworkGroup(makeNetworkPolicyTestPod("job-1", "queue", "job-set", "gang-1"), gang),
+		getNetworkGroup(makeNetworkPolicyTestPod("job-2", "other-queue", "job-set", "gang-1"), gang))
+	// Jobs of the same job set share a group when scoped by job set.
+	assert.Equal(t,
+		getNetworkGroup(makeNetworkPolicyTestPod("job-1", "queue", "job-set", ""), jobSet),
+		getNetworkGroup(makeNetworkPolicyTestPod("job-2", "queue", "job-set", "gang-1"), jobSet))
+	assert.NotEqual(t,
+		getNetworkGroup(makeNetworkPolicyTestPod("job-1", "queue", "job-set", ""), jobSet),
+		getNetworkGroup(makeNetworkPolicyTestPod("job-2", "queue", "other-job-set", ""), jobSet))
+	// Groups are valid label values.
+	assert.Len(t, getNetworkGroup(makeNetworkPolicyTestPod("job-1", "queue", "job set with spaces", ""), jobSet), 40)
+}
+
+func makeNetworkPolicyTestPod(jobId string, queue string, jobSet string, gangId string) *v1.Pod {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "armada-" + jobId + "-0",
+			Namespace: "namespace",
+			Labels: map[string]string{
+				domain.JobId:     jobId,
+				domain.JobRunId:  "run-" + jobId,
+				domain.Queue:     queue,
+				domain.PodNumber: "0",
+			},
+			Annotations: map[string]string{
+				domain.JobSetId: jobSet,
+				domain.Owner:    "user",
+			},
+		},
+	}
+	if gangId != "" {
+		pod.Annotations[constants.GangIdAnnotation] = gangId
+	}
+	return pod
+}
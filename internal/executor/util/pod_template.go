@@ -0,0 +1,190 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+
+	"github.com/armadaproject/armada/internal/executor/configuration"
+	"github.com/armadaproject/armada/internal/executor/domain"
+)
+
+// ValidatePodTemplates checks the given templates are well-formed and can be applied to a pod.
+func ValidatePodTemplates(templates []configuration.PodTemplate) error {
+	names := map[string]bool{}
+	for _, template := range templates {
+		if names[template.Name] {
+			return fmt.Errorf("pod template %s is defined more than once", template.Name)
+		}
+		names[template.Name] = true
+		if err := validatePodTemplate(template); err != nil {
+			return errors.WithMessagef(err, "invalid pod template %s", template.Name)
+		}
+		// Apply the template to a minimal pod, to catch anything the checks above miss.
+		pod := &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "validation"},
+			Spec: v1.PodSpec{
+				InitContainers: []v1.Container{{Name: "init", Image: "image"}},
+				Containers:     []v1.Container{{Name: "main", Image: "image"}},
+			},
+		}
+		if err := applyPodTemplate(pod, template); err != nil {
+			return errors.WithMessagef(err, "invalid pod template %s", template.Name)
+		}
+	}
+	return nil
+}
+
+func validatePodTemplate(template configuration.PodTemplate) error {
+	for _, env := range template.Env {
+		if env.Name == "" {
+			return fmt.Errorf("environment variables must have a name")
+		}
+	}
+	for _, mount := range template.VolumeMounts {
+		if mount.Name == "" || mount.MountPath == "" {
+			return fmt.Errorf("volume mounts must have a name and mount path")
+		}
+	}
+	for _, volume := range template.Volumes {
+		if volume.Name == "" {
+			return fmt.Errorf("volumes must have a name")
+		}
+	}
+	for _, container := range slices.Concat(template.InitContainers, template.Sidecars) {
+		if container.Name == "" || container.Image == "" {
+			return fmt.Errorf("init containers and sidecars must have a name and image")
+		}
+	}
+	for jobPriorityClass, priorityClass := range template.PriorityClassNames {
+		if jobPriorityClass == "" || priorityClass == "" {
+			return fmt.Errorf("priority class mappings must not be empty")
+		}
+	}
+	return nil
+}
+
+// applyPodTemplates applies, in order, each of the templates that match the pod.
+func applyPodTemplates(pod *v1.Pod, templates []configuration.PodTemplate) error {
+	for _, template := range templates {
+		if !podTemplateMatches(pod, template.Match) {
+			continue
+		}
+		if err := applyPodTemplate(pod, template); err != nil {
+			return errors.WithMessagef(err, "failed to apply pod template %s", template.Name)
+		}
+	}
+	return nil
+}
+
+func podTemplateMatches(pod *v1.Pod, match configuration.PodTemplateMatch) bool {
+	if len(match.Pools) > 0 && !slices.Contains(match.Pools, ExtractPool(pod)) {
+		return false
+	}
+	if len(match.Queues) > 0 && !slices.Contains(match.Queues, pod.Labels[domain.Queue]) {
+		return false
+	}
+	if len(match.PriorityClasses) > 0 && !slices.Contains(match.PriorityClasses, pod.Spec.PriorityClassName) {
+		return false
+	}
+	for key, value := range match.Labels {
+		if podValue, ok := pod.Labels[key]; !ok || podValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+func applyPodTemplate(pod *v1.Pod, template configuration.PodTemplate) error {
+	podJson, err := json.Marshal(pod)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	patchJson, err := json.Marshal(createPodTemplatePatch(pod, template))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	patchedJson, err := strategicpatch.StrategicMergePatch(podJson, patchJson, v1.Pod{})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	patched := &v1.Pod{}
+	if err := json.Unmarshal(patchedJson, patched); err != nil {
+		return errors.WithStack(err)
+	}
+	*pod = *patched
+	return nil
+}
+
+// createPodTemplatePatch builds the strategic merge patch for the template.
+// The patch is built from maps rather than a v1.Pod, as fields of a v1.Pod without omitempty would be
+// serialised as null, which a strategic merge patch interprets as deleting the field.
+func createPodTemplatePatch(pod *v1.Pod, template configuration.PodTemplate) map[string]any {
+	metadata := map[string]any{}
+	if len(template.Labels) > 0 {
+		metadata["labels"] = template.Labels
+	}
+	if len(template.Annotations) > 0 {
+		metadata["annotations"] = template.Annotations
+	}
+
+	spec := map[string]any{}
+	if len(template.Volumes) > 0 {
+		spec["volumes"] = template.Volumes
+	}
+	if template.SecurityContext != nil {
+		spec["securityContext"] = template.SecurityContext
+	}
+	if priorityClass, ok := template.PriorityClassNames[pod.Spec.PriorityClassName]; ok {
+		spec["priorityClassName"] = priorityClass
+	}
+	addContainerPatches(spec, "initContainers", pod.Spec.InitContainers, template.InitContainers, template)
+	addContainerPatches(spec, "containers", pod.Spec.Containers, template.Sidecars, template)
+
+	return map[string]any{
+		"metadata": metadata,
+		"spec":     spec,
+	}
+}
+
+// addContainerPatches adds to the spec patch the changes to the job's containers and the containers added by the template.
+// As a strategic merge would put added containers first, the patch also sets the order of the merged list,
+// keeping the job's containers first; much of Armada assumes the first container is the job's main container.
+func addContainerPatches(spec map[string]any, field string, jobContainers []v1.Container, addedContainers []v1.Container, template configuration.PodTemplate) {
+	patches := make([]any, 0, len(jobContainers)+len(addedContainers))
+	if len(template.Env) > 0 || len(template.VolumeMounts) > 0 || template.ContainerSecurityContext != nil {
+		for _, container := range jobContainers {
+			patch := map[string]any{"name": container.Name}
+			if len(template.Env) > 0 {
+				patch["env"] = template.Env
+			}
+			if len(template.VolumeMounts) > 0 {
+				patch["volumeMounts"] = template.VolumeMounts
+			}
+			if template.ContainerSecurityContext != nil {
+				patch["securityContext"] = template.ContainerSecurityContext
+			}
+			patches = append(patches, patch)
+		}
+	}
+	for _, container := range addedContainers {
+		patches = append(patches, container)
+	}
+	if len(patches) == 0 {
+		return
+	}
+	spec[field] = patches
+
+	if len(addedContainers) > 0 {
+		order := make([]map[string]string, 0, len(jobContainers)+len(addedContainers))
+		for _, container := range slices.Concat(jobContainers, addedContainers) {
+			order = append(order, map[string]string{"name": container.Name})
+		}
+		spec["$setElementOrder/"+field] = order
+	}
+}
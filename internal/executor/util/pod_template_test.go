@@ -0,0 +1,193 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/armadaproject/armada/internal/common/constants"
+	"github.com/armadaproject/armada/internal/executor/configuration"
+	"github.com/armadaproject/armada/internal/executor/domain"
+)
+
+func TestApplyPodTemplates_MergesTemplateIntoPod(t *testing.T) {
+	pod := makeTemplateTestPod()
+	template := configuration.PodTemplate{
+		Name:        "plumbing",
+		Labels:      map[string]string{"team": "platform", "app": "overridden"},
+		Annotations: map[string]string{"cluster": "a"},
+		Env: []v1.EnvVar{
+			{Name: "PROXY", Value: "http://proxy"},
+			{Name: "EXISTING", Value: "overridden"},
+		},
+		VolumeMounts: []v1.VolumeMount{{Name: "certs", MountPath: "/certs"}},
+		Volumes: []v1.Volume{
+			{Name: "certs", VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: "/etc/certs"}}},
+		},
+		InitContainers:           []v1.Container{{Name: "setup", Image: "setup"}},
+		Sidecars:                 []v1.Container{{Name: "proxy", Image: "proxy"}},
+		SecurityContext:          &v1.PodSecurityContext{RunAsNonRoot: pointer.Bool(true)},
+		ContainerSecurityContext: &v1.SecurityContext{AllowPrivilegeEscalation: pointer.Bool(false)},
+		PriorityClassNames:       map[string]string{"armada-default": "cluster-default"},
+	}
+
+	err := applyPodTemplates(pod, []configuration.PodTemplate{template})
+	require.NoError(t, err)
+
+	assert.Equal(t, "platform", pod.Labels["team"])
+	assert.Equal(t, "overridden", pod.Labels["app"])
+	assert.Equal(t, "queue", pod.Labels[domain.Queue])
+	assert.Equal(t, "a", pod.Annotations["cluster"])
+	assert.Equal(t, "cluster-default", pod.Spec.PriorityClassName)
+	assert.Equal(t, pointer.Bool(true), pod.Spec.SecurityContext.RunAsNonRoot)
+	assert.Equal(t, int64(1000), *pod.Spec.SecurityContext.RunAsUser)
+	assert.ElementsMatch(t, []string{"job-volume", "certs"}, volumeNames(pod.Spec.Volumes))
+	assert.Equal(t, []string{"init", "setup"}, containerNames(pod.Spec.InitContainers))
+	assert.Equal(t, []string{"main", "proxy"}, containerNames(pod.Spec.Containers))
+
+	main := pod.Spec.Containers[0]
+	assert.Equal(t, "main", main.Image)
+	assert.ElementsMatch(t, []v1.EnvVar{
+		{Name: "EXISTING", Value: "overridden"},
+		{Name: "PROXY", Value: "http://proxy"},
+	}, main.Env)
+	assert.ElementsMatch(t, []v1.VolumeMount{
+		{Name: "job-volume", MountPath: "/data"},
+		{Name: "certs", MountPath: "/certs"},
+	}, main.VolumeMounts)
+	assert.Equal(t, pointer.Bool(false), main.SecurityContext.AllowPrivilegeEscalation)
+	assert.Len(t, pod.Spec.InitContainers[0].Env, 2)
+
+	sidecar := pod.Spec.Containers[1]
+	assert.Empty(t, sidecar.Env)
+	assert.Nil(t, sidecar.SecurityContext)
+}
+
+func TestApplyPodTemplates_AppliesMatchingTemplatesInOrder(t *testing.T) {
+	templates := []configuration.PodTemplate{
+		{Name: "all", Labels: map[string]string{"a": "all", "b": "all"}},
+		{Name: "queue", Match: configuration.PodTemplateMatch{Queues: []string{"queue"}}, Labels: map[string]string{"b": "queue"}},
+		{Name: "other-queue", Match: configuration.PodTemplateMatch{Queues: []string{"other"}}, Labels: map[string]string{"c": "other"}},
+	}
+	pod := makeTemplateTestPod()
+
+	err := applyPodTemplates(pod, templates)
+	require.NoError(t, err)
+
+	assert.Equal(t, "all", pod.Labels["a"])
+	assert.Equal(t, "queue", pod.Labels["b"])
+	assert.NotContains(t, pod.Labels, "c")
+}
+
+func TestPodTemplateMatches(t *testing.T) {
+	tests := map[string]struct {
+		match    configuration.PodTemplateMatch
+		expected bool
+	}{
+		"empty":                   {match: configuration.PodTemplateMatch{}, expected: true},
+		"pool":                    {match: configuration.PodTemplateMatch{Pools: []string{"gpu", "cpu"}}, expected: true},
+		"other pool":              {match: configuration.PodTemplateMatch{Pools: []string{"gpu"}}, expected: false},
+		"queue":                   {match: configuration.PodTemplateMatch{Queues: []string{"queue"}}, expected: true},
+		"other queue":             {match: configuration.PodTemplateMatch{Queues: []string{"other"}}, expected: false},
+		"priority class":          {match: configuration.PodTemplateMatch{PriorityClasses: []string{"armada-default"}}, expected: true},
+		"other priority class":    {match: configuration.PodTemplateMatch{PriorityClasses: []string{"armada-preemptible"}}, expected: false},
+		"labels":                  {match: configuration.PodTemplateMatch{Labels: map[string]string{"app": "job"}}, expected: true},
+		"other label value":       {match: configuration.PodTemplateMatch{Labels: map[string]string{"app": "other"}}, expected: false},
+		"missing label":           {match: configuration.PodTemplateMatch{Labels: map[string]string{"missing": ""}}, expected: false},
+		"all fields must match":   {match: configuration.PodTemplateMatch{Pools: []string{"cpu"}, Queues: []string{"other"}}, expected: false},
+		"all fields match a pool": {match: configuration.PodTemplateMatch{Pools: []string{"cpu"}, Queues: []string{"queue"}}, expected: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, podTemplateMatches(makeTemplateTestPod(), tc.match))
+		})
+	}
+}
+
+func TestValidatePodTemplates(t *testing.T) {
+	tests := map[string]struct {
+		templates []configuration.PodTemplate
+		valid     bool
+	}{
+		"valid": {
+			templates: []configuration.PodTemplate{
+				{Name: "a", Env: []v1.EnvVar{{Name: "A", Value: "a"}}},
+				{Name: "b", Sidecars: []v1.Container{{Name: "proxy", Image: "proxy"}}},
+			},
+			valid: true,
+		},
+		"duplicate name": {
+			templates: []configuration.PodTemplate{{Name: "a"}, {Name: "a"}},
+		},
+		"env without name": {
+			templates: []configuration.PodTemplate{{Name: "a", Env: []v1.EnvVar{{Value: "a"}}}},
+		},
+		"volume mount without path": {
+			templates: []configuration.PodTemplate{{Name: "a", VolumeMounts: []v1.VolumeMount{{Name: "a"}}}},
+		},
+		"volume without name": {
+			templates: []configuration.PodTemplate{{Name: "a", Volumes: []v1.Volume{{}}}},
+		},
+		"sidecar without image": {
+			templates: []configuration.PodTemplate{{Name: "a", Sidecars: []v1.Container{{Name: "proxy"}}}},
+		},
+		"empty priority class mapping": {
+			templates: []configuration.PodTemplate{{Name: "a", PriorityClassNames: map[string]string{"armada-default": ""}}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidatePodTemplates(tc.templates)
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func makeTemplateTestPod() *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "pod",
+			Namespace:   "namespace",
+			Labels:      map[string]string{domain.Queue: "queue", "app": "job"},
+			Annotations: map[string]string{constants.PoolAnnotation: "cpu"},
+		},
+		Spec: v1.PodSpec{
+			PriorityClassName: "armada-default",
+			SecurityContext:   &v1.PodSecurityContext{RunAsUser: pointer.Int64(1000)},
+			Volumes:           []v1.Volume{{Name: "job-volume"}},
+			InitContainers:    []v1.Container{{Name: "init", Image: "init"}},
+			Containers: []v1.Container{
+				{
+					Name:         "main",
+					Image:        "main",
+					Env:          []v1.EnvVar{{Name: "EXISTING", Value: "job"}},
+					VolumeMounts: []v1.VolumeMount{{Name: "job-volume", MountPath: "/data"}},
+				},
+			},
+		},
+	}
+}
+
+func containerNames(containers []v1.Container) []string {
+	names := make([]string, 0, len(containers))
+	for _, container := range containers {
+		names = append(names, container.Name)
+	}
+	return names
+}
+
+func volumeNames(volumes []v1.Volume) []string {
+	names := make([]string, 0, len(volumes))
+	for _, volume := range volumes {
+		names = append(names, volume.Name)
+	}
+	return names
+}
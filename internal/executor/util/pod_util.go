@@ -27,6 +27,11 @@ func HasIngress(pod *v1.Pod) bool {
 	return exists && value == "true"
 }
 
+func HasAssociatedObjects(pod *v1.Pod) bool {
+	value, exists := pod.Annotations[domain.HasAssociatedObjects]
+	return exists && value == "true"
+}
+
 func GetExpectedNumberOfAssociatedServices(pod *v1.Pod) int {
 	value, exists := pod.Annotations[domain.AssociatedServicesCount]
 	if !exists {
@@ -0,0 +1,150 @@
+package utilisation
+
+import (
+	"sync"
+
+	"github.com/prometheus/common/model"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	log "github.com/armadaproject/armada/internal/common/logging"
+	armadaresource "github.com/armadaproject/armada/internal/common/resource"
+	"github.com/armadaproject/armada/internal/executor/configuration"
+	clusterContext "github.com/armadaproject/armada/internal/executor/context"
+	"github.com/armadaproject/armada/internal/executor/util"
+)
+
+// AcceleratorHealthService determines how many of each node's accelerators, e.g., GPUs, are unhealthy.
+// A device is unhealthy if:
+//   - the device plugin has removed it from the node's allocatable resources
+//   - the node has one of the configured unhealthy conditions, in which case all the node's devices are unhealthy
+//   - one of the configured metrics reports it as unhealthy
+//
+// Metrics are scraped by RefreshMetrics, which should be called periodically.
+// A nil *AcceleratorHealthService considers all accelerators healthy.
+type AcceleratorHealthService struct {
+	clusterContext clusterContext.ClusterContext
+	config         configuration.AcceleratorHealthConfiguration
+	httpClient     httpGetter
+
+	// Unhealthy devices by node name, then resource name, as last scraped.
+	unhealthyDevices map[string]map[string]map[string]bool
+	mutex            sync.Mutex
+}
+
+func NewAcceleratorHealthService(
+	clusterContext clusterContext.ClusterContext,
+	config configuration.AcceleratorHealthConfiguration,
+	httpClient httpGetter,
+) *AcceleratorHealthService {
+	return &AcceleratorHealthService{
+		clusterContext:   clusterContext,
+		config:           config,
+		httpClient:       httpClient,
+		unhealthyDevices: map[string]map[string]map[string]bool{},
+	}
+}
+
+// RefreshMetrics scrapes the configured device health metrics.
+// Devices whose metrics can't be scraped are considered healthy.
+func (s *AcceleratorHealthService) RefreshMetrics() {
+	if len(s.config.Metrics) == 0 {
+		return
+	}
+
+	nodes, err := s.clusterContext.GetNodes()
+	if err != nil {
+		log.Warnf("could not get nodes, abandoning accelerator health scrape: %v", err)
+		return
+	}
+	nodeNames := util.ExtractNodeNames(nodes)
+
+	unhealthyDevices := map[string]map[string]map[string]bool{}
+	for _, metric := range s.config.Metrics {
+		endpointSlices, err := s.clusterContext.GetEndpointSlices(metric.Namespace, metric.EndpointSelectorLabelName, metric.EndpointSelectorLabelValue)
+		if err != nil {
+			log.Warnf("could not get accelerator health endpoint slices for %s, skipping metric: %v", metric.PrometheusMetricName, err)
+			continue
+		}
+		urls := getUrlsToScrape(endpointSlices, nodeNames)
+		samples := scrapeUrls(urls, []string{metric.PrometheusMetricName}, s.httpClient)
+		addUnhealthyDevices(samples, metric, unhealthyDevices)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.unhealthyDevices = unhealthyDevices
+}
+
+func addUnhealthyDevices(samples model.Vector, metric configuration.AcceleratorHealthMetric, unhealthyDevices map[string]map[string]map[string]bool) {
+	for _, sample := range samples {
+		if float64(sample.Value) <= metric.UnhealthyAbove {
+			continue
+		}
+		nodeName := string(sample.Metric[model.LabelName(metric.PrometheusNodeNameLabel)])
+		device := string(sample.Metric[model.LabelName(metric.PrometheusDeviceLabel)])
+		if nodeName == "" || device == "" {
+			continue
+		}
+		if _, ok := unhealthyDevices[nodeName]; !ok {
+			unhealthyDevices[nodeName] = map[string]map[string]bool{}
+		}
+		if _, ok := unhealthyDevices[nodeName][metric.Resource]; !ok {
+			unhealthyDevices[nodeName][metric.Resource] = map[string]bool{}
+		}
+		unhealthyDevices[nodeName][metric.Resource][device] = true
+	}
+}
+
+// GetUnhealthyResources returns the node's unhealthy accelerators that are still included in its allocatable resources,
+// i.e., those the scheduler would otherwise consider schedulable.
+func (s *AcceleratorHealthService) GetUnhealthyResources(node *v1.Node) armadaresource.ComputeResources {
+	unhealthy := armadaresource.ComputeResources{}
+	if s == nil {
+		return unhealthy
+	}
+
+	allUnhealthy := s.hasUnhealthyCondition(node)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, resourceName := range s.config.Resources {
+		allocatable, ok := node.Status.Allocatable[v1.ResourceName(resourceName)]
+		if !ok || allocatable.IsZero() {
+			continue
+		}
+		capacity, ok := node.Status.Capacity[v1.ResourceName(resourceName)]
+		if !ok || capacity.Cmp(allocatable) < 0 {
+			capacity = allocatable
+		}
+
+		var unhealthyDeviceCount int64
+		if allUnhealthy {
+			unhealthyDeviceCount = capacity.Value()
+		} else {
+			// Devices the device plugin has marked unhealthy are already excluded from allocatable,
+			// and may be the same devices the metrics report as unhealthy.
+			unhealthyDeviceCount = max(
+				capacity.Value()-allocatable.Value(),
+				int64(len(s.unhealthyDevices[node.Name][resourceName])))
+		}
+
+		// Only report those unhealthy devices not already excluded from allocatable.
+		unhealthyAllocatable := min(unhealthyDeviceCount-(capacity.Value()-allocatable.Value()), allocatable.Value())
+		if unhealthyAllocatable > 0 {
+			unhealthy[resourceName] = *resource.NewQuantity(unhealthyAllocatable, resource.DecimalSI)
+		}
+	}
+	return unhealthy
+}
+
+func (s *AcceleratorHealthService) hasUnhealthyCondition(node *v1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		for _, unhealthyCondition := range s.config.NodeConditions {
+			if condition.Type == unhealthyCondition.Type && condition.Status == unhealthyCondition.Status {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,137 @@
+package utilisation
+
+import (
+	"testing"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	armadaresource "github.com/armadaproject/armada/internal/common/resource"
+	"github.com/armadaproject/armada/internal/executor/configuration"
+)
+
+const gpu = "nvidia.com/gpu"
+
+func TestAddUnhealthyDevices(t *testing.T) {
+	metric := configuration.AcceleratorHealthMetric{
+		Resource:                gpu,
+		PrometheusMetricName:    "DCGM_FI_DEV_XID_ERRORS",
+		PrometheusNodeNameLabel: "Hostname",
+		PrometheusDeviceLabel:   "UUID",
+		UnhealthyAbove:          0,
+	}
+	samples := model.Vector{
+		makeDeviceSample("node1", "gpu-0", 0),
+		makeDeviceSample("node1", "gpu-1", 48),
+		makeDeviceSample("node2", "gpu-0", 79),
+		makeDeviceSample("", "gpu-0", 79),
+	}
+
+	unhealthyDevices := map[string]map[string]map[string]bool{}
+	addUnhealthyDevices(samples, metric, unhealthyDevices)
+
+	assert.Equal(t, map[string]map[string]map[string]bool{
+		"node1": {gpu: {"gpu-1": true}},
+		"node2": {gpu: {"gpu-0": true}},
+	}, unhealthyDevices)
+}
+
+func TestGetUnhealthyResources(t *testing.T) {
+	tests := map[string]struct {
+		capacity         int64
+		allocatable      int64
+		conditions       []v1.NodeCondition
+		unhealthyDevices map[string]bool
+		expected         armadaresource.ComputeResources
+	}{
+		"AllHealthy": {
+			capacity:    8,
+			allocatable: 8,
+			expected:    armadaresource.ComputeResources{},
+		},
+		"DevicePluginUnhealthyAlreadyExcluded": {
+			capacity:    8,
+			allocatable: 6,
+			expected:    armadaresource.ComputeResources{},
+		},
+		"MetricUnhealthy": {
+			capacity:         8,
+			allocatable:      8,
+			unhealthyDevices: map[string]bool{"gpu-0": true, "gpu-1": true},
+			expected:         armadaresource.ComputeResources{gpu: resource.MustParse("2")},
+		},
+		"MetricUnhealthyOverlapsDevicePlugin": {
+			capacity:         8,
+			allocatable:      7,
+			unhealthyDevices: map[string]bool{"gpu-0": true, "gpu-1": true},
+			expected:         armadaresource.ComputeResources{gpu: resource.MustParse("1")},
+		},
+		"UnhealthyCondition": {
+			capacity:    8,
+			allocatable: 6,
+			conditions:  []v1.NodeCondition{{Type: "GpuUnhealthy", Status: v1.ConditionTrue}},
+			expected:    armadaresource.ComputeResources{gpu: resource.MustParse("6")},
+		},
+		"HealthyCondition": {
+			capacity:    8,
+			allocatable: 8,
+			conditions:  []v1.NodeCondition{{Type: "GpuUnhealthy", Status: v1.ConditionFalse}},
+			expected:    armadaresource.ComputeResources{},
+		},
+		"NoAccelerators": {
+			conditions: []v1.NodeCondition{{Type: "GpuUnhealthy", Status: v1.ConditionTrue}},
+			expected:   armadaresource.ComputeResources{},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			service := NewAcceleratorHealthService(nil, configuration.AcceleratorHealthConfiguration{
+				Enabled:        true,
+				Resources:      []string{gpu},
+				NodeConditions: []configuration.AcceleratorNodeCondition{{Type: "GpuUnhealthy", Status: v1.ConditionTrue}},
+			}, nil)
+			if tc.unhealthyDevices != nil {
+				service.unhealthyDevices["node1"] = map[string]map[string]bool{gpu: tc.unhealthyDevices}
+			}
+
+			result := service.GetUnhealthyResources(makeAcceleratorNode("node1", tc.capacity, tc.allocatable, tc.conditions))
+			assert.True(t, tc.expected.Equal(result), "expected %v, got %v", tc.expected, result)
+		})
+	}
+}
+
+func TestGetUnhealthyResources_WhenServiceNil(t *testing.T) {
+	var service *AcceleratorHealthService
+	result := service.GetUnhealthyResources(makeAcceleratorNode("node1", 8, 8, nil))
+	assert.Equal(t, armadaresource.ComputeResources{}, result)
+}
+
+func makeDeviceSample(nodeName string, device string, value float64) *model.Sample {
+	return &model.Sample{
+		Metric: model.Metric{
+			model.MetricNameLabel: "DCGM_FI_DEV_XID_ERRORS",
+			"Hostname":            model.LabelValue(nodeName),
+			"UUID":                model.LabelValue(device),
+		},
+		Value: model.SampleValue(value),
+	}
+}
+
+func makeAcceleratorNode(name string, capacity int64, allocatable int64, conditions []v1.NodeCondition) *v1.Node {
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: v1.NodeStatus{
+			Capacity:    v1.ResourceList{"cpu": resource.MustParse("32")},
+			Allocatable: v1.ResourceList{"cpu": resource.MustParse("31")},
+			Conditions:  conditions,
+		},
+	}
+	if capacity > 0 {
+		node.Status.Capacity[gpu] = *resource.NewQuantity(capacity, resource.DecimalSI)
+		node.Status.Allocatable[gpu] = *resource.NewQuantity(allocatable, resource.DecimalSI)
+	}
+	return node
+}
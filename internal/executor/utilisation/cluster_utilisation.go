@@ -27,6 +27,7 @@ type ClusterUtilisationService struct {
 	clusterContext                                                context.ClusterContext
 	queueUtilisationService                                       PodUtilisationService
 	nodeInfoService                                               node.NodeInfoService
+	acceleratorHealthService                                      *AcceleratorHealthService
 	trackedNodeLabels                                             []string
 	nodeIdLabel                                                   string
 	minimumResourcesMarkedAllocatedToNonArmadaPodsPerNode         armadaresource.ComputeResources
@@ -37,17 +38,19 @@ func NewClusterUtilisationService(
 	clusterContext context.ClusterContext,
 	queueUtilisationService PodUtilisationService,
 	nodeInfoService node.NodeInfoService,
+	acceleratorHealthService *AcceleratorHealthService,
 	trackedNodeLabels []string,
 	nodeIdLabel string,
 	minimumResourcesMarkedAllocatedToNonArmadaPodsPerNode armadaresource.ComputeResources,
 	minimumResourcesMarkedAllocatedToNonArmadaPodsPerNodePriority int32,
 ) *ClusterUtilisationService {
 	return &ClusterUtilisationService{
-		clusterContext:          clusterContext,
-		queueUtilisationService: queueUtilisationService,
-		nodeInfoService:         nodeInfoService,
-		trackedNodeLabels:       trackedNodeLabels,
-		nodeIdLabel:             nodeIdLabel,
+		clusterContext:           clusterContext,
+		queueUtilisationService:  queueUtilisationService,
+		nodeInfoService:          nodeInfoService,
+		acceleratorHealthService: acceleratorHealthService,
+		trackedNodeLabels:        trackedNodeLabels,
+		nodeIdLabel:              nodeIdLabel,
 		minimumResourcesMarkedAllocatedToNonArmadaPodsPerNode:         minimumResourcesMarkedAllocatedToNonArmadaPodsPerNode,
 		minimumResourcesMarkedAllocatedToNonArmadaPodsPerNodePriority: minimumResourcesMarkedAllocatedToNonArmadaPodsPerNodePriority,
 	}
@@ -88,8 +91,10 @@ func (cls *ClusterUtilisationService) GetAvailableClusterCapacity() (*ClusterAva
 	for _, node := range allNodes {
 		isSchedulable := cls.nodeInfoService.IsAvailableProcessingNode(node)
 		allocatable := armadaresource.FromResourceList(node.Status.Allocatable)
+		unhealthy := cls.acceleratorHealthService.GetUnhealthyResources(node)
 		available := allocatable.DeepCopy()
 		available.Sub(nodesUsage[node.Name])
+		available.Sub(unhealthy)
 
 		if isSchedulable {
 			totalAvailable.Add(available)
@@ -124,6 +129,7 @@ func (cls *ClusterUtilisationService) GetAvailableClusterCapacity() (*ClusterAva
 			NodeType:                    cls.nodeInfoService.GetType(node),
 			Pool:                        nodePool,
 			ResourceUsageByQueueAndPool: cls.getPoolQueueResources(runningNodePodsArmada, nodePool),
+			UnhealthyResources:          unhealthy.ToProtoMap(),
 		})
 	}
 
@@ -4,6 +4,7 @@ package lookout
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/IBM/pgxpoolprometheus"
 	"github.com/go-openapi/loads"
@@ -27,6 +28,10 @@ import (
 )
 
 func Serve(configuration configuration.LookoutConfig) error {
+	if configuration.Export.MaxRows <= 0 || configuration.Export.PageSize <= 0 {
+		return fmt.Errorf("export maxRows and pageSize must be greater than 0")
+	}
+
 	// load embedded swagger file
 	swaggerSpec, err := loads.Analyzed(restapi.SwaggerJSON, "")
 	if err != nil {
@@ -49,6 +54,9 @@ func Serve(configuration configuration.LookoutConfig) error {
 	getJobRunDebugMessageRepo := repository.NewSqlGetJobRunDebugMessageRepository(db, decompressor)
 	getJobSpecRepo := repository.NewSqlGetJobSpecRepository(db, decompressor)
 	getJobRunSchedulerTerminationReasonRepo := repository.NewSqlGetJobRunSchedulerTerminationReasonRepository(db)
+	jobStatisticsRepo := repository.NewSqlJobStatisticsRepository(db)
+	savedViewRepo := repository.NewSqlSavedViewRepository(db)
+	jobRunErrorSearchRepo := repository.NewSqlJobRunErrorSearchRepository(db)
 
 	// create new service API
 	api := operations.NewLookoutAPI(swaggerSpec)
@@ -67,21 +75,28 @@ func Serve(configuration configuration.LookoutConfig) error {
 
 	api.GetJobsHandler = operations.GetJobsHandlerFunc(
 		func(params operations.GetJobsParams) middleware.Responder {
-			filters := slices.Map(params.GetJobsRequest.Filters, conversions.FromSwaggerFilter)
-			order := conversions.FromSwaggerOrder(params.GetJobsRequest.Order)
-			result, err := getJobsRepo.GetJobs(
-				armadacontext.New(params.HTTPRequest.Context(), logger),
-				filters,
-				params.GetJobsRequest.ActiveJobSets,
-				order,
-				int(params.GetJobsRequest.Skip),
-				int(params.GetJobsRequest.Take),
-			)
+			request := params.GetJobsRequest
+			if request.ContinuationToken != "" && request.Skip != 0 {
+				return operations.NewGetJobsBadRequest().WithPayload(conversions.ToSwaggerError("continuationToken cannot be combined with skip"))
+			}
+			filters := slices.Map(request.Filters, conversions.FromSwaggerFilter)
+			order := conversions.FromSwaggerOrder(request.Order)
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			var result *repository.GetJobsResult
+			var err error
+			if request.Skip == 0 {
+				// Pages are fetched with keyset pagination unless the client skips jobs, so that the first page
+				// returns a continuation token to fetch the next page with
+				result, err = getJobsRepo.GetJobsAfter(ctx, filters, request.ActiveJobSets, order, request.ContinuationToken, int(request.Take))
+			} else {
+				result, err = getJobsRepo.GetJobs(ctx, filters, request.ActiveJobSets, order, int(request.Skip), int(request.Take))
+			}
 			if err != nil {
 				return operations.NewGetJobsBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
 			}
 			return operations.NewGetJobsOK().WithPayload(&operations.GetJobsOKBody{
-				Jobs: slices.Map(result.Jobs, conversions.ToSwaggerJob),
+				Jobs:              slices.Map(result.Jobs, conversions.ToSwaggerJob),
+				ContinuationToken: result.ContinuationToken,
 			})
 		},
 	)
@@ -109,6 +124,8 @@ func Serve(configuration configuration.LookoutConfig) error {
 		},
 	)
 
+	api.ExportJobsHandler = exportJobsHandler(getJobsRepo, configuration.Export, logger)
+
 	api.GetJobRunErrorHandler = operations.GetJobRunErrorHandlerFunc(
 		func(params operations.GetJobRunErrorParams) middleware.Responder {
 			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
@@ -174,6 +191,117 @@ func Serve(configuration configuration.LookoutConfig) error {
 		},
 	)
 
+	api.GetJobStatisticsHandler = operations.GetJobStatisticsHandlerFunc(
+		func(params operations.GetJobStatisticsParams) middleware.Responder {
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			request := params.GetJobStatisticsRequest
+			result, err := jobStatisticsRepo.GetJobStatistics(ctx, repository.JobStatisticsQuery{
+				Queues:          request.Queues,
+				Pools:           request.Pools,
+				PriorityClasses: request.PriorityClasses,
+				GroupBy:         request.GroupBy,
+				From:            time.Time(request.From),
+				To:              time.Time(request.To),
+				BucketSize:      time.Duration(request.BucketSize) * time.Second,
+			})
+			if err != nil {
+				return operations.NewGetJobStatisticsBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			return operations.NewGetJobStatisticsOK().WithPayload(&operations.GetJobStatisticsOKBody{
+				Series: slices.Map(result, conversions.ToSwaggerJobStatisticsSeries),
+			})
+		},
+	)
+
+	api.SearchJobRunErrorsHandler = operations.SearchJobRunErrorsHandlerFunc(
+		func(params operations.SearchJobRunErrorsParams) middleware.Responder {
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			request := params.SearchJobRunErrorsRequest
+			query := repository.JobRunErrorSearchQuery{
+				Query:  request.Query,
+				Queues: request.Queues,
+				Take:   int(request.Take),
+			}
+			if !request.From.IsZero() {
+				from := time.Time(request.From)
+				query.From = &from
+			}
+			if !request.To.IsZero() {
+				to := time.Time(request.To)
+				query.To = &to
+			}
+			result, err := jobRunErrorSearchRepo.SearchJobRunErrors(ctx, query)
+			if err != nil {
+				return operations.NewSearchJobRunErrorsBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			return operations.NewSearchJobRunErrorsOK().WithPayload(&operations.SearchJobRunErrorsOKBody{
+				Results: slices.Map(result, conversions.ToSwaggerJobRunErrorMatch),
+			})
+		},
+	)
+
+	// Saved views are owned by the principal that created them
+	api.CreateSavedViewHandler = operations.CreateSavedViewHandlerFunc(
+		func(params operations.CreateSavedViewParams) middleware.Responder {
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			user := auth.GetPrincipal(ctx).GetName()
+			result, err := savedViewRepo.CreateSavedView(ctx, user, conversions.FromSwaggerSavedView(params.SavedView))
+			if err != nil {
+				return operations.NewCreateSavedViewBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			return operations.NewCreateSavedViewOK().WithPayload(conversions.ToSwaggerSavedView(result))
+		},
+	)
+
+	api.GetSavedViewHandler = operations.GetSavedViewHandlerFunc(
+		func(params operations.GetSavedViewParams) middleware.Responder {
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			user := auth.GetPrincipal(ctx).GetName()
+			result, err := savedViewRepo.GetSavedView(ctx, user, params.GetSavedViewRequest.ID)
+			if err != nil {
+				return operations.NewGetSavedViewBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			return operations.NewGetSavedViewOK().WithPayload(conversions.ToSwaggerSavedView(result))
+		},
+	)
+
+	api.ListSavedViewsHandler = operations.ListSavedViewsHandlerFunc(
+		func(params operations.ListSavedViewsParams) middleware.Responder {
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			user := auth.GetPrincipal(ctx).GetName()
+			result, err := savedViewRepo.ListSavedViews(ctx, user)
+			if err != nil {
+				return operations.NewListSavedViewsBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			return operations.NewListSavedViewsOK().WithPayload(&operations.ListSavedViewsOKBody{
+				SavedViews: slices.Map(result, conversions.ToSwaggerSavedView),
+			})
+		},
+	)
+
+	api.UpdateSavedViewHandler = operations.UpdateSavedViewHandlerFunc(
+		func(params operations.UpdateSavedViewParams) middleware.Responder {
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			user := auth.GetPrincipal(ctx).GetName()
+			result, err := savedViewRepo.UpdateSavedView(ctx, user, conversions.FromSwaggerSavedView(params.SavedView))
+			if err != nil {
+				return operations.NewUpdateSavedViewBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			return operations.NewUpdateSavedViewOK().WithPayload(conversions.ToSwaggerSavedView(result))
+		},
+	)
+
+	api.DeleteSavedViewHandler = operations.DeleteSavedViewHandlerFunc(
+		func(params operations.DeleteSavedViewParams) middleware.Responder {
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			user := auth.GetPrincipal(ctx).GetName()
+			if err := savedViewRepo.DeleteSavedView(ctx, user, params.DeleteSavedViewRequest.ID); err != nil {
+				return operations.NewDeleteSavedViewBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
+			}
+			return operations.NewDeleteSavedViewOK()
+		},
+	)
+
 	shutdownMetricServer := common.ServeMetrics(uint16(configuration.MetricsPort))
 	defer shutdownMetricServer()
 
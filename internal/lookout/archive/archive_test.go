@@ -0,0 +1,157 @@
+package archive
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/pointer"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/blobstore"
+	"github.com/armadaproject/armada/internal/common/compress"
+)
+
+var (
+	day1 = time.Date(2024, 3, 1, 23, 0, 0, 0, time.UTC)
+	day2 = time.Date(2024, 3, 2, 1, 0, 0, 0, time.UTC)
+)
+
+func job(jobId, queue, jobSet string, submitted time.Time) JobRow {
+	return JobRow{
+		JobId:              jobId,
+		Queue:              queue,
+		JobSet:             jobSet,
+		Owner:              "owner",
+		State:              "SUCCEEDED",
+		Cpu:                1000,
+		Submitted:          submitted.UnixMicro(),
+		LastTransitionTime: submitted.Add(time.Hour).UnixMicro(),
+		Annotations:        `{"team":"ml"}`,
+	}
+}
+
+func run(runId, jobId string, leased time.Time) RunRow {
+	return RunRow{
+		RunId:    runId,
+		JobId:    jobId,
+		Cluster:  "cluster",
+		Node:     pointer.String("node"),
+		State:    "RUN_SUCCEEDED",
+		Leased:   pointer.Int64(leased.UnixMicro()),
+		ExitCode: pointer.Int32(0),
+	}
+}
+
+func TestArchiveAndSearch(t *testing.T) {
+	ctx := armadacontext.Background()
+	store := newTestStore(t)
+	archiver := NewArchiver(store, &compress.NoOpDecompressor{})
+
+	err := archiver.writeBatch(ctx, &batch{
+		jobs: []JobRow{
+			job("job-1", "queue-a", "set-1", day1),
+			job("job-2", "queue-a", "set-2", day2),
+			job("job-3", "queue-b", "set-1", day2),
+		},
+		runs: []RunRow{
+			run("run-2", "job-1", day1.Add(2*time.Minute)),
+			run("run-1", "job-1", day1.Add(time.Minute)),
+			run("run-3", "job-2", day2.Add(time.Minute)),
+		},
+		errors: []ErrorRow{{JobId: "job-1", Error: "out of memory"}},
+	}, "01HQ0000000000000000000001")
+	require.NoError(t, err)
+
+	keys, err := store.List(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"errors/date=2024-03-01/01HQ0000000000000000000001.parquet",
+		"jobs/date=2024-03-01/01HQ0000000000000000000001.parquet",
+		"jobs/date=2024-03-02/01HQ0000000000000000000001.parquet",
+		"runs/date=2024-03-01/01HQ0000000000000000000001.parquet",
+		"runs/date=2024-03-02/01HQ0000000000000000000001.parquet",
+	}, keys)
+
+	jobs, err := Search(ctx, store, Query{Queue: "queue-a", From: day1, To: day2})
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	assert.Equal(t, job("job-1", "queue-a", "set-1", day1), jobs[0].JobRow)
+	assert.Equal(t, []RunRow{run("run-1", "job-1", day1.Add(time.Minute)), run("run-2", "job-1", day1.Add(2*time.Minute))}, jobs[0].Runs)
+	assert.Equal(t, pointer.String("out of memory"), jobs[0].Error)
+	assert.Equal(t, "job-2", jobs[1].JobId)
+	assert.Equal(t, []RunRow{run("run-3", "job-2", day2.Add(time.Minute))}, jobs[1].Runs)
+	assert.Nil(t, jobs[1].Error)
+
+	jobs, err = Search(ctx, store, Query{Queue: "queue-a", JobSet: "set-2", From: day1, To: day2})
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, "job-2", jobs[0].JobId)
+
+	jobs, err = Search(ctx, store, Query{Queue: "queue-b", From: day1, To: day1})
+	require.NoError(t, err)
+	assert.Empty(t, jobs)
+}
+
+func TestSearch_UsesRowsOfLastFile(t *testing.T) {
+	ctx := armadacontext.Background()
+	store := newTestStore(t)
+	archiver := NewArchiver(store, &compress.NoOpDecompressor{})
+
+	archived := job("job-1", "queue-a", "set-1", day1)
+	require.NoError(t, archiver.writeBatch(ctx, &batch{jobs: []JobRow{archived}}, "01HQ0000000000000000000001"))
+	archived.State = "CANCELLED"
+	require.NoError(t, archiver.writeBatch(ctx, &batch{jobs: []JobRow{archived}}, "01HQ0000000000000000000002"))
+
+	jobs, err := Search(ctx, store, Query{Queue: "queue-a", From: day1, To: day1})
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, "CANCELLED", jobs[0].State)
+}
+
+func TestSearch_Invalid(t *testing.T) {
+	store := newTestStore(t)
+	tests := map[string]Query{
+		"no queue":       {From: day1, To: day1},
+		"to before from": {Queue: "queue-a", From: day2, To: day1},
+		"too many days":  {Queue: "queue-a", From: day1, To: day1.AddDate(2, 0, 0)},
+	}
+	for name, query := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Search(armadacontext.Background(), store, query)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestBatchName(t *testing.T) {
+	assert.Equal(t, "job-1", batchName(&batch{jobs: []JobRow{
+		job("job-2", "queue-a", "set-1", day1),
+		job("job-1", "queue-a", "set-1", day2),
+		job("job-3", "queue-a", "set-1", day1),
+	}}))
+}
+
+func TestArchive_SameBatchReplacesFiles(t *testing.T) {
+	ctx := armadacontext.Background()
+	store := newTestStore(t)
+	archiver := NewArchiver(store, &compress.NoOpDecompressor{})
+
+	b := &batch{jobs: []JobRow{job("job-1", "queue-a", "set-1", day1), job("job-2", "queue-a", "set-1", day1)}}
+	require.NoError(t, archiver.writeBatch(ctx, b, batchName(b)))
+	require.NoError(t, archiver.writeBatch(ctx, b, batchName(b)))
+
+	keys, err := store.List(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"jobs/date=2024-03-01/job-1.parquet"}, keys)
+	jobs, err := Search(ctx, store, Query{Queue: "queue-a", From: day1, To: day1})
+	require.NoError(t, err)
+	assert.Len(t, jobs, 2)
+}
+
+func newTestStore(t *testing.T) blobstore.BlobStore {
+	store, err := blobstore.NewFilesystemBlobStore(t.TempDir())
+	require.NoError(t, err)
+	return store
+}
@@ -0,0 +1,273 @@
+package archive
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/writer"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/blobstore"
+	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/common/database/lookout"
+)
+
+// Archiver archives jobs, along with their runs and errors, before the pruner deletes them
+type Archiver struct {
+	store        blobstore.BlobStore
+	decompressor compress.Decompressor
+}
+
+func NewArchiver(store blobstore.BlobStore, decompressor compress.Decompressor) *Archiver {
+	return &Archiver{
+		store:        store,
+		decompressor: decompressor,
+	}
+}
+
+// batch is the rows of a batch of jobs to archive
+type batch struct {
+	jobs   []JobRow
+	runs   []RunRow
+	errors []ErrorRow
+}
+
+// Archive archives the jobs whose ids are in the jobIdsTable, along with their runs and errors.
+// The table name is interpolated into the queries, so must not come from user input.
+// The batch's files are named after its first job id, so that if the batch isn't deleted after it's archived, e.g.
+// because the pruner's transaction fails, archiving the same batch again replaces its files rather than adding more.
+func (a *Archiver) Archive(ctx *armadacontext.Context, tx pgx.Tx, jobIdsTable string) error {
+	b, err := a.readBatch(ctx, tx, jobIdsTable)
+	if err != nil {
+		return errors.Wrap(err, "error reading jobs to archive")
+	}
+	if len(b.jobs) == 0 {
+		return nil
+	}
+	return a.writeBatch(ctx, b, batchName(b))
+}
+
+// batchName returns the smallest job id of the batch
+func batchName(b *batch) string {
+	name := b.jobs[0].JobId
+	for _, job := range b.jobs[1:] {
+		if job.JobId < name {
+			name = job.JobId
+		}
+	}
+	return name
+}
+
+func (a *Archiver) readBatch(ctx *armadacontext.Context, tx pgx.Tx, jobIdsTable string) (*batch, error) {
+	b := &batch{}
+
+	rows, err := tx.Query(ctx, fmt.Sprintf(`
+		SELECT
+			j.job_id, j.queue, j.jobset, j.owner, j.namespace, j.state, j.cpu, j.memory, j.ephemeral_storage, j.gpu,
+			j.priority, j.priority_class, j.submitted, j.last_transition_time, j.cancelled, j.cancel_reason,
+			j.cancel_user, j.duplicate, j.run_count, j.latest_run_id, j.annotations::text
+		FROM job j
+		JOIN %s b ON b.job_id = j.job_id`, jobIdsTable))
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var row JobRow
+		var state int16
+		var submitted, lastTransitionTime time.Time
+		var cancelled sql.NullTime
+		var annotations sql.NullString
+		if err := rows.Scan(
+			&row.JobId, &row.Queue, &row.JobSet, &row.Owner, &row.Namespace, &state, &row.Cpu, &row.Memory,
+			&row.EphemeralStorage, &row.Gpu, &row.Priority, &row.PriorityClass, &submitted, &lastTransitionTime,
+			&cancelled, &row.CancelReason, &row.CancelUser, &row.Duplicate, &row.RunCount, &row.LatestRunId,
+			&annotations,
+		); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		row.State = string(lookout.JobStateMap[int(state)])
+		row.Submitted = submitted.UnixMicro()
+		row.LastTransitionTime = lastTransitionTime.UnixMicro()
+		row.Cancelled = optionalMicros(cancelled)
+		row.Annotations = "{}"
+		if annotations.Valid {
+			row.Annotations = annotations.String
+		}
+		b.jobs = append(b.jobs, row)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = tx.Query(ctx, fmt.Sprintf(`
+		SELECT
+			r.run_id, r.job_id, r.cluster, r.node, r.pool, r.job_run_state, r.leased, r.pending, r.started, r.finished,
+			r.exit_code, r.failure_category, r.failure_subcategory, r.error
+		FROM job_run r
+		JOIN %s b ON b.job_id = r.job_id`, jobIdsTable))
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var row RunRow
+		var state int16
+		var leased, pending, started, finished sql.NullTime
+		var runError []byte
+		if err := rows.Scan(
+			&row.RunId, &row.JobId, &row.Cluster, &row.Node, &row.Pool, &state, &leased, &pending, &started, &finished,
+			&row.ExitCode, &row.FailureCategory, &row.FailureSubcategory, &runError,
+		); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		row.State = string(lookout.JobRunStateMap[int(state)])
+		row.Leased = optionalMicros(leased)
+		row.Pending = optionalMicros(pending)
+		row.Started = optionalMicros(started)
+		row.Finished = optionalMicros(finished)
+		if runError != nil {
+			decompressed, err := a.decompressor.Decompress(runError)
+			if err != nil {
+				rows.Close()
+				return nil, errors.Wrapf(err, "error decompressing error of run %s", row.RunId)
+			}
+			s := string(decompressed)
+			row.Error = &s
+		}
+		b.runs = append(b.runs, row)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = tx.Query(ctx, fmt.Sprintf(`
+		SELECT e.job_id, e.error
+		FROM job_error e
+		JOIN %s b ON b.job_id = e.job_id`, jobIdsTable))
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var row ErrorRow
+		var jobError []byte
+		if err := rows.Scan(&row.JobId, &jobError); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		decompressed, err := a.decompressor.Decompress(jobError)
+		if err != nil {
+			rows.Close()
+			return nil, errors.Wrapf(err, "error decompressing error of job %s", row.JobId)
+		}
+		row.Error = string(decompressed)
+		b.errors = append(b.errors, row)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// writeBatch writes one file per table and day the batch's jobs were submitted on, named after the batch
+func (a *Archiver) writeBatch(ctx *armadacontext.Context, b *batch, name string) error {
+	jobDays := make(map[string]string, len(b.jobs))
+	jobsByDay := map[string][]JobRow{}
+	for _, job := range b.jobs {
+		day := time.UnixMicro(job.Submitted).UTC().Format(time.DateOnly)
+		jobDays[job.JobId] = day
+		jobsByDay[day] = append(jobsByDay[day], job)
+	}
+	runsByDay := map[string][]RunRow{}
+	for _, run := range b.runs {
+		day := jobDays[run.JobId]
+		runsByDay[day] = append(runsByDay[day], run)
+	}
+	errorsByDay := map[string][]ErrorRow{}
+	for _, jobError := range b.errors {
+		day := jobDays[jobError.JobId]
+		errorsByDay[day] = append(errorsByDay[day], jobError)
+	}
+
+	if err := writePartitions(ctx, a.store, jobsTable, name, jobsByDay); err != nil {
+		return err
+	}
+	if err := writePartitions(ctx, a.store, runsTable, name, runsByDay); err != nil {
+		return err
+	}
+	return writePartitions(ctx, a.store, errorsTable, name, errorsByDay)
+}
+
+func writePartitions[T any](ctx *armadacontext.Context, store blobstore.BlobStore, table string, name string, rowsByDay map[string][]T) error {
+	days := make([]string, 0, len(rowsByDay))
+	for day := range rowsByDay {
+		days = append(days, day)
+	}
+	sort.Strings(days)
+	for _, day := range days {
+		data, err := writeParquet(rowsByDay[day])
+		if err != nil {
+			return errors.Wrapf(err, "error writing %s archive", table)
+		}
+		key := fmt.Sprintf("%s/%s", partitionPrefix(table, day), name+".parquet")
+		if _, err := store.Put(ctx, key, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func partitionPrefix(table string, day string) string {
+	return fmt.Sprintf("%s/date=%s", table, day)
+}
+
+func writeParquet[T any](rows []T) ([]byte, error) {
+	var buf bytes.Buffer
+	pw, err := writer.NewParquetWriterFromWriter(&buf, new(T), 1)
+	if err != nil {
+		return nil, err
+	}
+	pw.CompressionType = parquet.CompressionCodec_SNAPPY
+	for _, row := range rows {
+		if err := pw.Write(row); err != nil {
+			return nil, err
+		}
+	}
+	if err := pw.WriteStop(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func readParquet[T any](data []byte) ([]T, error) {
+	pr, err := reader.NewParquetReader(buffer.NewBufferFileFromBytes(data), new(T), 1)
+	if err != nil {
+		return nil, err
+	}
+	defer pr.ReadStop()
+	rows := make([]T, pr.GetNumRows())
+	if err := pr.Read(&rows); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func optionalMicros(t sql.NullTime) *int64 {
+	if !t.Valid {
+		return nil
+	}
+	micros := t.Time.UnixMicro()
+	return &micros
+}
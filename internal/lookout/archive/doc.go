@@ -0,0 +1,12 @@
+// Package archive archives jobs deleted by the Lookout pruner, along with
+// their runs and errors, as Parquet files in a blob store, on the local
+// filesystem or in an S3-compatible bucket, and searches the archived jobs.
+//
+// Archived rows are written to one file per table, day and batch of pruned
+// jobs, keyed as <table>/date=<yyyy-mm-dd>/<batch>.parquet, where the date is
+// the day the job was submitted, in UTC. Runs and errors are archived under
+// the day their job was submitted, so that all of a job's rows are found in
+// the same partition. Batches are named after their first job id, so that
+// archiving a batch again, after the pruner failed to delete it, replaces its
+// files.
+package archive
@@ -0,0 +1,151 @@
+package archive
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/blobstore"
+)
+
+// Query selects archived jobs by the queue and, optionally, job set they were submitted to, and the days they were
+// submitted on
+type Query struct {
+	Queue  string
+	JobSet string
+	// From and To are the first and last days, in UTC, to search the jobs submitted on
+	From time.Time
+	To   time.Time
+}
+
+// ArchivedJob is an archived job, along with its runs and error
+type ArchivedJob struct {
+	JobRow
+	Runs  []RunRow `json:"runs"`
+	Error *string  `json:"error"`
+}
+
+// maxQueryDays limits how many days a query can search, since every file of every day is read
+const maxQueryDays = 366
+
+// Search returns the archived jobs matching the query, ordered by when they were submitted. If a job was archived more
+// than once, e.g. because the pruner failed to delete it after archiving it and it was then archived in a different
+// batch, its rows are only returned once, from the last of its files.
+func Search(ctx *armadacontext.Context, store blobstore.BlobStore, query Query) ([]*ArchivedJob, error) {
+	if query.Queue == "" {
+		return nil, errors.New("queue must be set")
+	}
+	from := query.From.UTC().Truncate(24 * time.Hour)
+	to := query.To.UTC().Truncate(24 * time.Hour)
+	if to.Before(from) {
+		return nil, errors.New("to must not be before from")
+	}
+	if days := int(to.Sub(from)/(24*time.Hour)) + 1; days > maxQueryDays {
+		return nil, errors.Errorf("cannot search more than %d days, got %d", maxQueryDays, days)
+	}
+
+	var jobs []*ArchivedJob
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		dayJobs, err := searchDay(ctx, store, query, day.Format(time.DateOnly))
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, dayJobs...)
+	}
+	sort.SliceStable(jobs, func(i, j int) bool {
+		if jobs[i].Submitted != jobs[j].Submitted {
+			return jobs[i].Submitted < jobs[j].Submitted
+		}
+		return jobs[i].JobId < jobs[j].JobId
+	})
+	return jobs, nil
+}
+
+func searchDay(ctx *armadacontext.Context, store blobstore.BlobStore, query Query, day string) ([]*ArchivedJob, error) {
+	jobRows, err := readPartition[JobRow](ctx, store, jobsTable, day)
+	if err != nil {
+		return nil, err
+	}
+	jobsById := map[string]*ArchivedJob{}
+	for _, row := range jobRows {
+		if row.Queue != query.Queue || (query.JobSet != "" && row.JobSet != query.JobSet) {
+			continue
+		}
+		jobsById[row.JobId] = &ArchivedJob{JobRow: row}
+	}
+	if len(jobsById) == 0 {
+		return nil, nil
+	}
+
+	runRows, err := readPartition[RunRow](ctx, store, runsTable, day)
+	if err != nil {
+		return nil, err
+	}
+	runsById := map[string]RunRow{}
+	var runIds []string
+	for _, row := range runRows {
+		if _, ok := jobsById[row.JobId]; !ok {
+			continue
+		}
+		if _, ok := runsById[row.RunId]; !ok {
+			runIds = append(runIds, row.RunId)
+		}
+		runsById[row.RunId] = row
+	}
+	for _, runId := range runIds {
+		run := runsById[runId]
+		job := jobsById[run.JobId]
+		job.Runs = append(job.Runs, run)
+	}
+
+	errorRows, err := readPartition[ErrorRow](ctx, store, errorsTable, day)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range errorRows {
+		if job, ok := jobsById[row.JobId]; ok {
+			jobError := row.Error
+			job.Error = &jobError
+		}
+	}
+
+	jobs := make([]*ArchivedJob, 0, len(jobsById))
+	for _, job := range jobsById {
+		sort.SliceStable(job.Runs, func(i, j int) bool {
+			return optionalLess(job.Runs[i].Leased, job.Runs[j].Leased)
+		})
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+// readPartition reads the rows of all the files of a table for a day, in the order of their names
+func readPartition[T any](ctx *armadacontext.Context, store blobstore.BlobStore, table string, day string) ([]T, error) {
+	keys, err := store.List(ctx, partitionPrefix(table, day)+"/")
+	if err != nil {
+		return nil, err
+	}
+	var rows []T
+	for _, key := range keys {
+		data, err := store.Get(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		fileRows, err := readParquet[T](data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading %s", key)
+		}
+		rows = append(rows, fileRows...)
+	}
+	return rows, nil
+}
+
+// optionalLess orders nil values last
+func optionalLess(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a != nil
+	}
+	return *a < *b
+}
@@ -0,0 +1,57 @@
+package archive
+
+const (
+	jobsTable   = "jobs"
+	runsTable   = "runs"
+	errorsTable = "errors"
+)
+
+// JobRow is an archived row of the job table. Timestamps are microseconds since the Unix epoch, in UTC.
+type JobRow struct {
+	JobId              string  `parquet:"name=job_id, type=BYTE_ARRAY, convertedtype=UTF8" json:"jobId"`
+	Queue              string  `parquet:"name=queue, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY" json:"queue"`
+	JobSet             string  `parquet:"name=job_set, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY" json:"jobSet"`
+	Owner              string  `parquet:"name=owner, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY" json:"owner"`
+	Namespace          *string `parquet:"name=namespace, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY, repetitiontype=OPTIONAL" json:"namespace"`
+	State              string  `parquet:"name=state, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY" json:"state"`
+	Cpu                int64   `parquet:"name=cpu, type=INT64" json:"cpu"`
+	Memory             int64   `parquet:"name=memory, type=INT64" json:"memory"`
+	EphemeralStorage   int64   `parquet:"name=ephemeral_storage, type=INT64" json:"ephemeralStorage"`
+	Gpu                int64   `parquet:"name=gpu, type=INT64" json:"gpu"`
+	Priority           int64   `parquet:"name=priority, type=INT64" json:"priority"`
+	PriorityClass      *string `parquet:"name=priority_class, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY, repetitiontype=OPTIONAL" json:"priorityClass"`
+	Submitted          int64   `parquet:"name=submitted, type=INT64, convertedtype=TIMESTAMP_MICROS" json:"submitted"`
+	LastTransitionTime int64   `parquet:"name=last_transition_time, type=INT64, convertedtype=TIMESTAMP_MICROS" json:"lastTransitionTime"`
+	Cancelled          *int64  `parquet:"name=cancelled, type=INT64, convertedtype=TIMESTAMP_MICROS, repetitiontype=OPTIONAL" json:"cancelled"`
+	CancelReason       *string `parquet:"name=cancel_reason, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL" json:"cancelReason"`
+	CancelUser         *string `parquet:"name=cancel_user, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL" json:"cancelUser"`
+	Duplicate          bool    `parquet:"name=duplicate, type=BOOLEAN" json:"duplicate"`
+	RunCount           int32   `parquet:"name=run_count, type=INT32" json:"runCount"`
+	LatestRunId        *string `parquet:"name=latest_run_id, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL" json:"latestRunId"`
+	// Annotations are encoded as a JSON object
+	Annotations string `parquet:"name=annotations, type=BYTE_ARRAY, convertedtype=UTF8" json:"annotations"`
+}
+
+// RunRow is an archived row of the job_run table, with its error decompressed
+type RunRow struct {
+	RunId              string  `parquet:"name=run_id, type=BYTE_ARRAY, convertedtype=UTF8" json:"runId"`
+	JobId              string  `parquet:"name=job_id, type=BYTE_ARRAY, convertedtype=UTF8" json:"jobId"`
+	Cluster            string  `parquet:"name=cluster, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY" json:"cluster"`
+	Node               *string `parquet:"name=node, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY, repetitiontype=OPTIONAL" json:"node"`
+	Pool               *string `parquet:"name=pool, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY, repetitiontype=OPTIONAL" json:"pool"`
+	State              string  `parquet:"name=state, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY" json:"state"`
+	Leased             *int64  `parquet:"name=leased, type=INT64, convertedtype=TIMESTAMP_MICROS, repetitiontype=OPTIONAL" json:"leased"`
+	Pending            *int64  `parquet:"name=pending, type=INT64, convertedtype=TIMESTAMP_MICROS, repetitiontype=OPTIONAL" json:"pending"`
+	Started            *int64  `parquet:"name=started, type=INT64, convertedtype=TIMESTAMP_MICROS, repetitiontype=OPTIONAL" json:"started"`
+	Finished           *int64  `parquet:"name=finished, type=INT64, convertedtype=TIMESTAMP_MICROS, repetitiontype=OPTIONAL" json:"finished"`
+	ExitCode           *int32  `parquet:"name=exit_code, type=INT32, repetitiontype=OPTIONAL" json:"exitCode"`
+	FailureCategory    *string `parquet:"name=failure_category, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY, repetitiontype=OPTIONAL" json:"failureCategory"`
+	FailureSubcategory *string `parquet:"name=failure_subcategory, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY, repetitiontype=OPTIONAL" json:"failureSubcategory"`
+	Error              *string `parquet:"name=error, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL" json:"error"`
+}
+
+// ErrorRow is an archived row of the job_error table, with its error decompressed
+type ErrorRow struct {
+	JobId string `parquet:"name=job_id, type=BYTE_ARRAY, convertedtype=UTF8" json:"jobId"`
+	Error string `parquet:"name=error, type=BYTE_ARRAY, convertedtype=UTF8" json:"error"`
+}
@@ -0,0 +1,67 @@
+// Package backfill derives the columns of Lookout's job table that were added after jobs were ingested, in batches,
+// while Lookout and the ingester keep running.
+package backfill
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	log "github.com/armadaproject/armada/internal/common/logging"
+)
+
+// RunCounts sets the run count and first lease time of every job from its runs, in batches of batchSize jobs ordered
+// by job id, starting after the job id given. Jobs ingested before these columns were added have no run count or first
+// lease time; other jobs already have them, and are left as they are. Each batch is updated in its own transaction,
+// which locks the jobs of the batch before counting their runs, so the ingester can't count a run that's counted here
+// too. Returns the number of jobs updated. RunCounts can be run again, or resumed from the last job id it logged, if
+// it's interrupted.
+func RunCounts(ctx *armadacontext.Context, db *pgx.Conn, batchSize int, after string) (int, error) {
+	updated := 0
+	for {
+		start := time.Now()
+		var jobIds []string
+		var batchUpdated int64
+		err := pgx.BeginTxFunc(ctx, db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+			rows, err := tx.Query(ctx, `SELECT job_id FROM job WHERE job_id > $1 ORDER BY job_id LIMIT $2 FOR UPDATE`, after, batchSize)
+			if err != nil {
+				return err
+			}
+			jobIds, err = pgx.CollectRows(rows, pgx.RowTo[string])
+			if err != nil || len(jobIds) == 0 {
+				return err
+			}
+			tag, err := tx.Exec(ctx, `
+				UPDATE job
+				SET
+					run_count    = runs.run_count,
+					first_leased = LEAST(job.first_leased, runs.first_leased)
+				FROM (
+					SELECT job_id, COUNT(*) AS run_count, MIN(COALESCE(leased, pending)) AS first_leased
+					FROM job_run
+					WHERE job_id = any($1)
+					GROUP BY job_id
+				) AS runs
+				WHERE runs.job_id = job.job_id AND job.run_count < runs.run_count`,
+				jobIds)
+			batchUpdated = tag.RowsAffected()
+			return err
+		})
+		if err != nil {
+			return updated, errors.Wrapf(err, "error backfilling run counts of jobs after %s", after)
+		}
+		if len(jobIds) == 0 {
+			break
+		}
+		updated += int(batchUpdated)
+		after = jobIds[len(jobIds)-1]
+		log.Infof("Backfilled run counts of %d jobs in %s, up to job %s. Backfilled %d jobs in total", batchUpdated, time.Since(start), after, updated)
+		if len(jobIds) < batchSize {
+			break
+		}
+	}
+	log.Infof("Finished backfilling run counts of %d jobs", updated)
+	return updated, nil
+}
@@ -0,0 +1,99 @@
+package backfill
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/database"
+	lookoutschema "github.com/armadaproject/armada/internal/lookout/schema"
+)
+
+func withLookoutDb(t *testing.T, action func(ctx *armadacontext.Context, conn *pgx.Conn)) {
+	migrations, err := lookoutschema.LookoutMigrations()
+	require.NoError(t, err)
+	err = database.WithTestDb(migrations, func(db *pgxpool.Pool) error {
+		ctx := armadacontext.Background()
+		conn, err := db.Acquire(ctx)
+		require.NoError(t, err)
+		defer conn.Release()
+		action(ctx, conn.Conn())
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func insertJob(t *testing.T, ctx *armadacontext.Context, conn *pgx.Conn, jobId string, runCount int, firstLeased *time.Time) {
+	_, err := conn.Exec(ctx, `
+		INSERT INTO job (
+			job_id, queue, owner, jobset, cpu, memory, ephemeral_storage, gpu, priority, submitted, state,
+			last_transition_time, last_transition_time_seconds, annotations, run_count, first_leased
+		) VALUES ($1, 'queue', 'owner', 'job-set', 1, 1, 1, 0, 0, $2, 4, $2, 0, '{}'::jsonb, $3, $4)`,
+		jobId, time.Now().UTC(), runCount, firstLeased)
+	require.NoError(t, err)
+}
+
+func insertRun(t *testing.T, ctx *armadacontext.Context, conn *pgx.Conn, runId string, jobId string, leased time.Time) {
+	_, err := conn.Exec(ctx, `
+		INSERT INTO job_run (run_id, job_id, cluster, pending, leased, job_run_state)
+		VALUES ($1, $2, 'cluster', $3, $3, 3)`,
+		runId, jobId, leased)
+	require.NoError(t, err)
+}
+
+func getRunCount(t *testing.T, ctx *armadacontext.Context, conn *pgx.Conn, jobId string) (int, *time.Time) {
+	var runCount int
+	var firstLeased *time.Time
+	require.NoError(t, conn.QueryRow(ctx, `SELECT run_count, first_leased FROM job WHERE job_id = $1`, jobId).Scan(&runCount, &firstLeased))
+	return runCount, firstLeased
+}
+
+func TestRunCounts(t *testing.T) {
+	withLookoutDb(t, func(ctx *armadacontext.Context, conn *pgx.Conn) {
+		first := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		second := first.Add(time.Hour)
+
+		// Ingested before run counts were added
+		insertJob(t, ctx, conn, "job-1", 0, nil)
+		insertRun(t, ctx, conn, "run-1a", "job-1", second)
+		insertRun(t, ctx, conn, "run-1b", "job-1", first)
+		insertJob(t, ctx, conn, "job-2", 0, nil)
+		insertRun(t, ctx, conn, "run-2", "job-2", first)
+		// Never leased
+		insertJob(t, ctx, conn, "job-3", 0, nil)
+		// Already counted by the ingester
+		insertJob(t, ctx, conn, "job-4", 1, &second)
+		insertRun(t, ctx, conn, "run-4", "job-4", second)
+
+		updated, err := RunCounts(ctx, conn, 1, "")
+		require.NoError(t, err)
+		assert.Equal(t, 2, updated)
+
+		runCount, firstLeased := getRunCount(t, ctx, conn, "job-1")
+		assert.Equal(t, 2, runCount)
+		require.NotNil(t, firstLeased)
+		assert.Equal(t, first, firstLeased.UTC())
+
+		runCount, _ = getRunCount(t, ctx, conn, "job-2")
+		assert.Equal(t, 1, runCount)
+
+		runCount, firstLeased = getRunCount(t, ctx, conn, "job-3")
+		assert.Equal(t, 0, runCount)
+		assert.Nil(t, firstLeased)
+
+		runCount, firstLeased = getRunCount(t, ctx, conn, "job-4")
+		assert.Equal(t, 1, runCount)
+		require.NotNil(t, firstLeased)
+		assert.Equal(t, second, firstLeased.UTC())
+
+		// Running again finds nothing to do
+		updated, err = RunCounts(ctx, conn, 10, "")
+		require.NoError(t, err)
+		assert.Equal(t, 0, updated)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	authconfig "github.com/armadaproject/armada/internal/common/auth/configuration"
+	"github.com/armadaproject/armada/internal/common/blobstore"
 	"github.com/armadaproject/armada/internal/common/database"
 	"github.com/armadaproject/armada/internal/common/observability"
 	profilingconfig "github.com/armadaproject/armada/internal/common/profiling/configuration"
@@ -27,7 +28,7 @@ type LookoutConfig struct {
 
 	PrunerConfig PrunerConfig
 
-	ExperimentalHotColdSplit bool
+	Export ExportConfig
 
 	UIConfig
 }
@@ -61,6 +62,19 @@ type PrunerConfig struct {
 	// PushgatewayJobName is the job label attached to pushed metrics.
 	// Defaults to "lookout-pruner" if empty.
 	PushgatewayJobName string
+	// Archive configures the blob store jobs are archived to, as Parquet
+	// files, before they are deleted. If nil, jobs are deleted without being
+	// archived.
+	Archive *blobstore.Config
+}
+
+type ExportConfig struct {
+	// MaxRows is the maximum number of jobs a single export request can
+	// return. Requests asking for more jobs are rejected.
+	MaxRows int
+	// PageSize is the number of jobs fetched from the database at a time
+	// while streaming an export.
+	PageSize int
 }
 
 // Alert level enum values correspond to the severity levels of the MUI Alert
@@ -7,6 +7,7 @@ import (
 	"github.com/go-openapi/strfmt"
 
 	"github.com/armadaproject/armada/internal/common/database/lookout"
+	"github.com/armadaproject/armada/internal/common/slices"
 	"github.com/armadaproject/armada/internal/lookout/gen/models"
 	"github.com/armadaproject/armada/internal/lookout/gen/restapi/operations"
 	"github.com/armadaproject/armada/internal/lookout/model"
@@ -44,6 +45,7 @@ func ToSwaggerJob(job *model.Job) *models.Job {
 		Pool:               job.Pool,
 		ExitCode:           job.ExitCode,
 		RuntimeSeconds:     job.RuntimeSeconds,
+		Suspended:          job.Suspended,
 	}
 }
 
@@ -73,6 +75,63 @@ func ToSwaggerGroup(group *model.JobGroup) *models.Group {
 	}
 }
 
+func ToSwaggerJobStatisticsSeries(series *model.JobStatisticsSeries) *models.JobStatisticsSeries {
+	points := make([]*models.JobStatisticsPoint, len(series.Points))
+	for i, point := range series.Points {
+		points[i] = &models.JobStatisticsPoint{
+			Leased:        point.Leased,
+			Pending:       point.Pending,
+			Queued:        point.Queued,
+			Running:       point.Running,
+			RunningCPU:    point.RunningCpu,
+			RunningGpu:    point.RunningGpu,
+			RunningMemory: point.RunningMemory,
+			Time:          strfmt.DateTime(point.Time),
+		}
+	}
+	return &models.JobStatisticsSeries{
+		Points:        points,
+		Pool:          series.Pool,
+		PriorityClass: series.PriorityClass,
+		Queue:         series.Queue,
+	}
+}
+
+func ToSwaggerSavedView(view *model.SavedView) *models.SavedView {
+	filters := make([]*models.Filter, len(view.Filters))
+	for i, filter := range view.Filters {
+		filters[i] = &models.Filter{
+			Field:        filter.Field,
+			IsAnnotation: filter.IsAnnotation,
+			Match:        filter.Match,
+			Value:        filter.Value,
+		}
+	}
+	return &models.SavedView{
+		Columns:    view.Columns,
+		Created:    strfmt.DateTime(view.Created),
+		Filters:    filters,
+		Grouping:   view.Grouping,
+		ID:         view.Id,
+		Name:       view.Name,
+		Owner:      view.Owner,
+		Updated:    strfmt.DateTime(view.Updated),
+		Visibility: view.Visibility,
+	}
+}
+
+func ToSwaggerJobRunErrorMatch(match *model.JobRunErrorMatch) *models.JobRunErrorMatch {
+	return &models.JobRunErrorMatch{
+		DebugSnippet: match.DebugSnippet,
+		ErrorSnippet: match.ErrorSnippet,
+		Finished:     ToSwaggerTime(match.Finished),
+		JobID:        match.JobId,
+		JobSet:       match.JobSet,
+		Queue:        match.Queue,
+		RunID:        match.RunId,
+	}
+}
+
 func ToSwaggerError(err string) *models.Error {
 	return &models.Error{
 		Error: err,
@@ -88,6 +147,19 @@ func FromSwaggerFilter(filter *models.Filter) *model.Filter {
 	}
 }
 
+// FromSwaggerSavedView converts the view given in a request. The owner and times of the view are set by the server,
+// so are ignored.
+func FromSwaggerSavedView(view *models.SavedView) *model.SavedView {
+	return &model.SavedView{
+		Id:         view.ID,
+		Name:       view.Name,
+		Filters:    slices.Map(view.Filters, FromSwaggerFilter),
+		Grouping:   view.Grouping,
+		Columns:    view.Columns,
+		Visibility: view.Visibility,
+	}
+}
+
 func FromSwaggerOrder(order *models.Order) *model.Order {
 	return &model.Order{
 		Direction: order.Direction,
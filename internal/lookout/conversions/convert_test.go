@@ -170,3 +170,65 @@ func TestFromSwaggerOrder(t *testing.T) {
 	actual := FromSwaggerOrder(swaggerOrder)
 	assert.Equal(t, order, actual)
 }
+
+func TestToSwaggerSavedView(t *testing.T) {
+	actual := ToSwaggerSavedView(&model.SavedView{
+		Id:         "AbCd1234",
+		Name:       "my view",
+		Owner:      "user-id",
+		Filters:    []*model.Filter{filter},
+		Grouping:   []string{"queue"},
+		Columns:    []string{"jobId", "state"},
+		Visibility: model.VisibilityPublic,
+		Created:    baseTime,
+		Updated:    baseTime,
+	})
+	assert.Equal(t, &models.SavedView{
+		Columns:    []string{"jobId", "state"},
+		Created:    baseTimeSwagger,
+		Filters:    []*models.Filter{swaggerFilter},
+		Grouping:   []string{"queue"},
+		ID:         "AbCd1234",
+		Name:       "my view",
+		Owner:      "user-id",
+		Updated:    baseTimeSwagger,
+		Visibility: models.SavedViewVisibilityPublic,
+	}, actual)
+}
+
+func TestFromSwaggerSavedView(t *testing.T) {
+	actual := FromSwaggerSavedView(&models.SavedView{
+		Filters:    []*models.Filter{swaggerFilter},
+		Grouping:   []string{"queue"},
+		ID:         "AbCd1234",
+		Name:       "my view",
+		Owner:      "ignored",
+		Visibility: models.SavedViewVisibilityPrivate,
+	})
+	assert.Equal(t, &model.SavedView{
+		Id:         "AbCd1234",
+		Name:       "my view",
+		Filters:    []*model.Filter{filter},
+		Grouping:   []string{"queue"},
+		Visibility: model.VisibilityPrivate,
+	}, actual)
+}
+
+func TestToSwaggerJobRunErrorMatch(t *testing.T) {
+	actual := ToSwaggerJobRunErrorMatch(&model.JobRunErrorMatch{
+		JobId:        "job-id",
+		RunId:        "run-id",
+		Queue:        "queue",
+		JobSet:       "job-set",
+		Finished:     &baseTime,
+		ErrorSnippet: "container exited with **OOMKilled**",
+	})
+	assert.Equal(t, &models.JobRunErrorMatch{
+		ErrorSnippet: "container exited with **OOMKilled**",
+		Finished:     &baseTimeSwagger,
+		JobID:        "job-id",
+		JobSet:       "job-set",
+		Queue:        "queue",
+		RunID:        "run-id",
+	}, actual)
+}
@@ -0,0 +1,5 @@
+// Package export writes Lookout jobs as CSV or newline-delimited JSON
+// (NDJSON), for the Lookout API's job export endpoint. The caller chooses which
+// job fields are written, and in which order, and which annotations are
+// included alongside them.
+package export
@@ -0,0 +1,277 @@
+package export
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/lookout/model"
+)
+
+const (
+	FormatCsv    = "csv"
+	FormatNdjson = "ndjson"
+
+	annotationsKey = "annotations"
+)
+
+var contentTypes = map[string]string{
+	FormatCsv:    "text/csv",
+	FormatNdjson: "application/x-ndjson",
+}
+
+type column struct {
+	name  string
+	value func(job *model.Job) interface{}
+}
+
+// columns are all the job fields that can be exported, in the order they are exported by default
+var columns = []column{
+	{"jobId", func(job *model.Job) interface{} { return job.JobId }},
+	{"queue", func(job *model.Job) interface{} { return job.Queue }},
+	{"jobSet", func(job *model.Job) interface{} { return job.JobSet }},
+	{"owner", func(job *model.Job) interface{} { return job.Owner }},
+	{"namespace", func(job *model.Job) interface{} { return optional(job.Namespace) }},
+	{"state", func(job *model.Job) interface{} { return job.State }},
+	{"cpu", func(job *model.Job) interface{} { return job.Cpu }},
+	{"memory", func(job *model.Job) interface{} { return job.Memory }},
+	{"ephemeralStorage", func(job *model.Job) interface{} { return job.EphemeralStorage }},
+	{"gpu", func(job *model.Job) interface{} { return job.Gpu }},
+	{"priority", func(job *model.Job) interface{} { return job.Priority }},
+	{"priorityClass", func(job *model.Job) interface{} { return optional(job.PriorityClass) }},
+	{"submitted", func(job *model.Job) interface{} { return dateTime(&job.Submitted) }},
+	{"lastTransitionTime", func(job *model.Job) interface{} { return dateTime(&job.LastTransitionTime) }},
+	{"cancelled", func(job *model.Job) interface{} { return dateTime(job.Cancelled) }},
+	{"cancelReason", func(job *model.Job) interface{} { return optional(job.CancelReason) }},
+	{"cancelUser", func(job *model.Job) interface{} { return optional(job.CancelUser) }},
+	{"duplicate", func(job *model.Job) interface{} { return job.Duplicate }},
+	{"suspended", func(job *model.Job) interface{} { return job.Suspended }},
+	{"lastActiveRunId", func(job *model.Job) interface{} { return optional(job.LastActiveRunId) }},
+	{"cluster", func(job *model.Job) interface{} { return job.Cluster }},
+	{"pool", func(job *model.Job) interface{} { return optional(job.Pool) }},
+	{"node", func(job *model.Job) interface{} { return optional(job.Node) }},
+	{"exitCode", func(job *model.Job) interface{} { return optional(job.ExitCode) }},
+	{"runtimeSeconds", func(job *model.Job) interface{} { return job.RuntimeSeconds }},
+}
+
+// JobWriter writes jobs in an export format
+type JobWriter interface {
+	// WriteJobs writes the jobs, possibly buffering them
+	WriteJobs(jobs []*model.Job) error
+	// Flush writes any buffered jobs to the underlying writer
+	Flush() error
+}
+
+// ContentType returns the content type of jobs exported in the given format
+func ContentType(format string) (string, error) {
+	contentType, ok := contentTypes[format]
+	if !ok {
+		return "", errors.Errorf("unsupported export format %s", format)
+	}
+	return contentType, nil
+}
+
+// NewJobWriter returns a JobWriter writing the given job fields, followed by the values of the given annotation keys,
+// to w in the given format. All job fields are written if none are given.
+func NewJobWriter(w io.Writer, format string, fields []string, annotationKeys []string) (JobWriter, error) {
+	selected, err := selectColumns(fields)
+	if err != nil {
+		return nil, err
+	}
+	switch format {
+	case FormatCsv:
+		return newCsvJobWriter(w, selected, annotationKeys), nil
+	case FormatNdjson:
+		return &ndjsonJobWriter{writer: bufio.NewWriter(w), columns: selected, annotationKeys: annotationKeys}, nil
+	default:
+		return nil, errors.Errorf("unsupported export format %s", format)
+	}
+}
+
+func selectColumns(fields []string) ([]column, error) {
+	if len(fields) == 0 {
+		return columns, nil
+	}
+	selected := make([]column, 0, len(fields))
+	for _, field := range fields {
+		found := false
+		for _, c := range columns {
+			if c.name == field {
+				selected = append(selected, c)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.Errorf("field %s cannot be exported", field)
+		}
+	}
+	return selected, nil
+}
+
+type csvJobWriter struct {
+	writer         *csv.Writer
+	columns        []column
+	annotationKeys []string
+	// header is written along with the first jobs, or on the first flush, rather than when the writer is created,
+	// as the underlying writer may not be ready to be written to until then
+	header []string
+}
+
+func newCsvJobWriter(w io.Writer, columns []column, annotationKeys []string) *csvJobWriter {
+	header := make([]string, 0, len(columns)+len(annotationKeys))
+	for _, c := range columns {
+		header = append(header, c.name)
+	}
+	for _, key := range annotationKeys {
+		header = append(header, fmt.Sprintf("%s.%s", annotationsKey, key))
+	}
+	return &csvJobWriter{writer: csv.NewWriter(w), columns: columns, annotationKeys: annotationKeys, header: header}
+}
+
+func (w *csvJobWriter) writeHeader() error {
+	if w.header == nil {
+		return nil
+	}
+	if err := w.writer.Write(w.header); err != nil {
+		return err
+	}
+	w.header = nil
+	return nil
+}
+
+func (w *csvJobWriter) WriteJobs(jobs []*model.Job) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	record := make([]string, len(w.columns)+len(w.annotationKeys))
+	for _, job := range jobs {
+		for i, c := range w.columns {
+			value := c.value(job)
+			if value == nil {
+				record[i] = ""
+			} else {
+				record[i] = fmt.Sprint(value)
+			}
+		}
+		for i, key := range w.annotationKeys {
+			record[len(w.columns)+i] = job.Annotations[key]
+		}
+		if err := w.writer.Write(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *csvJobWriter) Flush() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+type ndjsonJobWriter struct {
+	writer         *bufio.Writer
+	columns        []column
+	annotationKeys []string
+}
+
+// WriteJobs writes each job as a JSON object on its own line. Fields are written in the order they were selected,
+// rather than sorted as encoding/json would sort a map, and annotations are nested in an annotations object.
+func (w *ndjsonJobWriter) WriteJobs(jobs []*model.Job) error {
+	for _, job := range jobs {
+		if err := w.writer.WriteByte('{'); err != nil {
+			return err
+		}
+		for i, c := range w.columns {
+			if err := w.writeMember(i > 0, c.name, c.value(job)); err != nil {
+				return err
+			}
+		}
+		if len(w.annotationKeys) > 0 {
+			if err := w.writeName(len(w.columns) > 0, annotationsKey); err != nil {
+				return err
+			}
+			if err := w.writeAnnotations(job); err != nil {
+				return err
+			}
+		}
+		if _, err := w.writer.WriteString("}\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *ndjsonJobWriter) writeAnnotations(job *model.Job) error {
+	if err := w.writer.WriteByte('{'); err != nil {
+		return err
+	}
+	for i, key := range w.annotationKeys {
+		var value interface{}
+		if annotation, ok := job.Annotations[key]; ok {
+			value = annotation
+		}
+		if err := w.writeMember(i > 0, key, value); err != nil {
+			return err
+		}
+	}
+	return w.writer.WriteByte('}')
+}
+
+func (w *ndjsonJobWriter) writeMember(separate bool, name string, value interface{}) error {
+	if err := w.writeName(separate, name); err != nil {
+		return err
+	}
+	return w.writeJson(value)
+}
+
+// writeName writes the name of a member of a JSON object, preceded by a separator if it isn't the first member
+func (w *ndjsonJobWriter) writeName(separate bool, name string) error {
+	if separate {
+		if err := w.writer.WriteByte(','); err != nil {
+			return err
+		}
+	}
+	if err := w.writeJson(name); err != nil {
+		return err
+	}
+	return w.writer.WriteByte(':')
+}
+
+func (w *ndjsonJobWriter) writeJson(value interface{}) error {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	_, err = w.writer.Write(b)
+	return err
+}
+
+func (w *ndjsonJobWriter) Flush() error {
+	return w.writer.Flush()
+}
+
+// optional returns the value pointed to, or an untyped nil if the pointer is nil, so that it is written as empty
+func optional[T any](v *T) interface{} {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
+
+// dateTime returns the time in the same format as the Lookout API's other endpoints
+func dateTime(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return strfmt.DateTime(*t)
+}
@@ -0,0 +1,117 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/pointer"
+
+	"github.com/armadaproject/armada/internal/lookout/model"
+)
+
+var (
+	submitted = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	runningJob = &model.Job{
+		JobId:     "job-1",
+		Queue:     "queue-a",
+		JobSet:    "job-set",
+		State:     "RUNNING",
+		Cpu:       2000,
+		Namespace: pointer.String("ns"),
+		Submitted: submitted,
+		Node:      pointer.String("node-1"),
+		Annotations: map[string]string{
+			"team":  "ml",
+			"notes": "contains, a comma",
+		},
+	}
+	queuedJob = &model.Job{
+		JobId:       "job-2",
+		Queue:       "queue-a",
+		JobSet:      "job-set",
+		State:       "QUEUED",
+		Cpu:         500,
+		Submitted:   submitted.Add(time.Minute),
+		Annotations: map[string]string{},
+	}
+)
+
+func TestCsvJobWriter(t *testing.T) {
+	var buf bytes.Buffer
+	writer, err := NewJobWriter(&buf, FormatCsv, []string{"jobId", "state", "cpu", "namespace", "node", "submitted"}, []string{"team", "notes"})
+	require.NoError(t, err)
+
+	require.NoError(t, writer.WriteJobs([]*model.Job{runningJob, queuedJob}))
+	require.NoError(t, writer.Flush())
+
+	expected := "jobId,state,cpu,namespace,node,submitted,annotations.team,annotations.notes\n" +
+		"job-1,RUNNING,2000,ns,node-1,2024-03-01T12:00:00.000Z,ml,\"contains, a comma\"\n" +
+		"job-2,QUEUED,500,,,2024-03-01T12:01:00.000Z,,\n"
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestCsvJobWriter_WritesHeaderWithoutJobs(t *testing.T) {
+	var buf bytes.Buffer
+	writer, err := NewJobWriter(&buf, FormatCsv, []string{"jobId", "queue"}, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, writer.Flush())
+
+	assert.Equal(t, "jobId,queue\n", buf.String())
+}
+
+func TestNdjsonJobWriter(t *testing.T) {
+	var buf bytes.Buffer
+	writer, err := NewJobWriter(&buf, FormatNdjson, []string{"jobId", "cpu", "namespace", "submitted"}, []string{"team"})
+	require.NoError(t, err)
+
+	require.NoError(t, writer.WriteJobs([]*model.Job{runningJob}))
+	require.NoError(t, writer.WriteJobs([]*model.Job{queuedJob}))
+	require.NoError(t, writer.Flush())
+
+	expected := `{"jobId":"job-1","cpu":2000,"namespace":"ns","submitted":"2024-03-01T12:00:00.000Z","annotations":{"team":"ml"}}` + "\n" +
+		`{"jobId":"job-2","cpu":500,"namespace":null,"submitted":"2024-03-01T12:01:00.000Z","annotations":{"team":null}}` + "\n"
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestNdjsonJobWriter_OnlyAnnotations(t *testing.T) {
+	var buf bytes.Buffer
+	writer, err := NewJobWriter(&buf, FormatNdjson, []string{"jobId"}, []string{"annotations"})
+	require.NoError(t, err)
+
+	require.NoError(t, writer.WriteJobs([]*model.Job{queuedJob}))
+	require.NoError(t, writer.Flush())
+
+	assert.Equal(t, `{"jobId":"job-2","annotations":{"annotations":null}}`+"\n", buf.String())
+}
+
+func TestNewJobWriter_AllFieldsByDefault(t *testing.T) {
+	var buf bytes.Buffer
+	writer, err := NewJobWriter(&buf, FormatCsv, nil, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, writer.Flush())
+
+	assert.Equal(
+		t,
+		"jobId,queue,jobSet,owner,namespace,state,cpu,memory,ephemeralStorage,gpu,priority,priorityClass,submitted,"+
+			"lastTransitionTime,cancelled,cancelReason,cancelUser,duplicate,suspended,lastActiveRunId,cluster,pool,node,"+
+			"exitCode,runtimeSeconds\n",
+		buf.String(),
+	)
+}
+
+func TestNewJobWriter_Invalid(t *testing.T) {
+	_, err := NewJobWriter(&bytes.Buffer{}, FormatCsv, []string{"jobId", "runs"}, nil)
+	assert.Error(t, err)
+
+	_, err = NewJobWriter(&bytes.Buffer{}, "xlsx", nil, nil)
+	assert.Error(t, err)
+
+	_, err = ContentType("xlsx")
+	assert.Error(t, err)
+}
@@ -0,0 +1,113 @@
+package lookout
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/logging"
+	"github.com/armadaproject/armada/internal/common/slices"
+	"github.com/armadaproject/armada/internal/lookout/configuration"
+	"github.com/armadaproject/armada/internal/lookout/conversions"
+	"github.com/armadaproject/armada/internal/lookout/export"
+	"github.com/armadaproject/armada/internal/lookout/gen/restapi/operations"
+	"github.com/armadaproject/armada/internal/lookout/model"
+)
+
+type jobExporter interface {
+	ExportJobs(
+		ctx *armadacontext.Context,
+		filters []*model.Filter,
+		activeJobSets bool,
+		order *model.Order,
+		maxRows int,
+		pageSize int,
+		fn func(jobs []*model.Job) error,
+	) error
+}
+
+// exportJobsHandler streams the jobs matching a request as CSV or NDJSON, flushing each page of jobs as it is fetched.
+// Nothing is sent until the first page has been fetched, so that errors fetching it, e.g. due to invalid filters, are
+// returned as bad requests. Errors after that abort the response, so that a partial export can't be mistaken for a
+// complete one.
+func exportJobsHandler(exporter jobExporter, config configuration.ExportConfig, logger *logging.Logger) operations.ExportJobsHandlerFunc {
+	return func(params operations.ExportJobsParams) middleware.Responder {
+		request := params.ExportJobsRequest
+		if request.MaxRows < 0 || request.MaxRows > int64(config.MaxRows) {
+			return exportJobsBadRequest(fmt.Sprintf("maxRows must be between 0 and %d", config.MaxRows))
+		}
+		maxRows := config.MaxRows
+		if request.MaxRows > 0 {
+			maxRows = int(request.MaxRows)
+		}
+
+		contentType, err := export.ContentType(request.Format)
+		if err != nil {
+			return exportJobsBadRequest(err.Error())
+		}
+		out := &exportWriter{}
+		jobWriter, err := export.NewJobWriter(out, request.Format, request.Columns, request.Annotations)
+		if err != nil {
+			return exportJobsBadRequest(err.Error())
+		}
+
+		filters := slices.Map(request.Filters, conversions.FromSwaggerFilter)
+		order := conversions.FromSwaggerOrder(request.Order)
+		return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+			out.rw = rw
+			rw.Header().Set(runtime.HeaderContentType, contentType)
+			rw.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="jobs.%s"`, request.Format))
+
+			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
+			err := exporter.ExportJobs(ctx, filters, request.ActiveJobSets, order, maxRows, config.PageSize, func(jobs []*model.Job) error {
+				if err := jobWriter.WriteJobs(jobs); err != nil {
+					return err
+				}
+				if err := jobWriter.Flush(); err != nil {
+					return err
+				}
+				if err := http.NewResponseController(rw).Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+					return err
+				}
+				return nil
+			})
+			if err == nil {
+				err = jobWriter.Flush()
+			}
+			if err == nil {
+				return
+			}
+			if !out.written {
+				exportJobsBadRequest(err.Error()).WriteResponse(rw, nil)
+				return
+			}
+			ctx.Logger().WithError(err).Error("failed to export jobs")
+			panic(http.ErrAbortHandler)
+		})
+	}
+}
+
+// exportJobsBadRequest returns the error as JSON, rather than in the export format negotiated for the request
+func exportJobsBadRequest(message string) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		rw.Header().Set(runtime.HeaderContentType, runtime.JSONMime)
+		operations.NewExportJobsBadRequest().
+			WithPayload(conversions.ToSwaggerError(message)).
+			WriteResponse(rw, runtime.JSONProducer())
+	})
+}
+
+// exportWriter writes to the response once it is available, recording whether anything has been written to it
+type exportWriter struct {
+	rw      http.ResponseWriter
+	written bool
+}
+
+func (w *exportWriter) Write(p []byte) (int, error) {
+	w.written = true
+	return w.rw.Write(p)
+}
@@ -0,0 +1,201 @@
+package lookout
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/logging"
+	"github.com/armadaproject/armada/internal/lookout/configuration"
+	"github.com/armadaproject/armada/internal/lookout/gen/models"
+	"github.com/armadaproject/armada/internal/lookout/gen/restapi/operations"
+	"github.com/armadaproject/armada/internal/lookout/model"
+)
+
+type fakeJobExporter struct {
+	pages   [][]*model.Job
+	err     error
+	maxRows int
+}
+
+func (e *fakeJobExporter) ExportJobs(
+	_ *armadacontext.Context,
+	_ []*model.Filter,
+	_ bool,
+	_ *model.Order,
+	maxRows int,
+	_ int,
+	fn func(jobs []*model.Job) error,
+) error {
+	e.maxRows = maxRows
+	for _, page := range e.pages {
+		if err := fn(page); err != nil {
+			return err
+		}
+	}
+	return e.err
+}
+
+var exportConfig = configuration.ExportConfig{MaxRows: 100, PageSize: 10}
+
+func exportJobsParams(body operations.ExportJobsBody) operations.ExportJobsParams {
+	body.Order = &models.Order{Field: "jobId", Direction: "ASC"}
+	return operations.ExportJobsParams{
+		HTTPRequest:       httptest.NewRequest(http.MethodPost, "/api/v1/jobs/export", nil),
+		ExportJobsRequest: body,
+	}
+}
+
+func TestExportJobsHandler_StreamsCsv(t *testing.T) {
+	exporter := &fakeJobExporter{
+		pages: [][]*model.Job{
+			{{JobId: "job-1", Queue: "queue-a"}, {JobId: "job-2", Queue: "queue-a"}},
+			{{JobId: "job-3", Queue: "queue-b", Annotations: map[string]string{"team": "ml"}}},
+		},
+	}
+	responder := exportJobsHandler(exporter, exportConfig, logging.StdLogger())(exportJobsParams(operations.ExportJobsBody{
+		Format:      "csv",
+		Columns:     []string{"jobId", "queue"},
+		Annotations: []string{"team"},
+		MaxRows:     50,
+	}))
+
+	rec := httptest.NewRecorder()
+	responder.WriteResponse(rec, runtime.JSONProducer())
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("got status %d, want %d", rec.Code, http.StatusOK)
+	}
+	if contentType := rec.Header().Get("Content-Type"); contentType != "text/csv" {
+		t.Errorf("content type = %q, want %q", contentType, "text/csv")
+	}
+	want := "jobId,queue,annotations.team\njob-1,queue-a,\njob-2,queue-a,\njob-3,queue-b,ml\n"
+	if rec.Body.String() != want {
+		t.Errorf("body = %q, want %q", rec.Body.String(), want)
+	}
+	if exporter.maxRows != 50 {
+		t.Errorf("maxRows = %d, want %d", exporter.maxRows, 50)
+	}
+}
+
+func TestExportJobsHandler_StreamsCsvWithHeaderLongerThanWriteBuffer(t *testing.T) {
+	exporter := &fakeJobExporter{pages: [][]*model.Job{{{JobId: "job-1"}}}}
+	annotations := make([]string, 100)
+	for i := range annotations {
+		annotations[i] = fmt.Sprintf("example.com/annotation-%03d-%s", i, strings.Repeat("x", 40))
+	}
+	responder := exportJobsHandler(exporter, exportConfig, logging.StdLogger())(exportJobsParams(operations.ExportJobsBody{
+		Format:      "csv",
+		Columns:     []string{"jobId"},
+		Annotations: annotations,
+	}))
+
+	rec := httptest.NewRecorder()
+	responder.WriteResponse(rec, runtime.JSONProducer())
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("got status %d, want %d", rec.Code, http.StatusOK)
+	}
+	header := "jobId,annotations." + strings.Join(annotations, ",annotations.") + "\n"
+	if len(header) <= 4096 {
+		t.Fatalf("header is %d bytes, want more than the csv writer's 4096 byte buffer", len(header))
+	}
+	want := header + "job-1" + strings.Repeat(",", len(annotations)) + "\n"
+	if rec.Body.String() != want {
+		t.Errorf("body = %q, want %q", rec.Body.String(), want)
+	}
+}
+
+func TestExportJobsHandler_DefaultsToMaxRows(t *testing.T) {
+	exporter := &fakeJobExporter{}
+	responder := exportJobsHandler(exporter, exportConfig, logging.StdLogger())(exportJobsParams(operations.ExportJobsBody{
+		Format: "ndjson",
+	}))
+
+	rec := httptest.NewRecorder()
+	responder.WriteResponse(rec, runtime.JSONProducer())
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("got status %d, want %d", rec.Code, http.StatusOK)
+	}
+	if rec.Body.Len() != 0 {
+		t.Errorf("body = %q, want it to be empty", rec.Body.String())
+	}
+	if exporter.maxRows != exportConfig.MaxRows {
+		t.Errorf("maxRows = %d, want %d", exporter.maxRows, exportConfig.MaxRows)
+	}
+}
+
+func TestExportJobsHandler_RejectsInvalidRequests(t *testing.T) {
+	tests := map[string]operations.ExportJobsBody{
+		"too many rows":  {Format: "csv", MaxRows: 101},
+		"unknown column": {Format: "csv", Columns: []string{"jobId", "runs"}},
+		"negative rows":  {Format: "ndjson", MaxRows: -1},
+		"unknown format": {Format: "xlsx"},
+	}
+	for name, body := range tests {
+		t.Run(name, func(t *testing.T) {
+			responder := exportJobsHandler(&fakeJobExporter{}, exportConfig, logging.StdLogger())(exportJobsParams(body))
+
+			rec := httptest.NewRecorder()
+			responder.WriteResponse(rec, nil)
+
+			assertExportBadRequest(t, rec)
+		})
+	}
+}
+
+func TestExportJobsHandler_ReturnsErrorsBeforeStreamingAsBadRequest(t *testing.T) {
+	exporter := &fakeJobExporter{err: errors.New("filters are invalid")}
+	responder := exportJobsHandler(exporter, exportConfig, logging.StdLogger())(exportJobsParams(operations.ExportJobsBody{
+		Format: "csv",
+	}))
+
+	rec := httptest.NewRecorder()
+	responder.WriteResponse(rec, runtime.JSONProducer())
+
+	assertExportBadRequest(t, rec)
+}
+
+func TestExportJobsHandler_AbortsOnErrorsWhileStreaming(t *testing.T) {
+	exporter := &fakeJobExporter{
+		pages: [][]*model.Job{{{JobId: "job-1"}}},
+		err:   errors.New("connection reset"),
+	}
+	responder := exportJobsHandler(exporter, exportConfig, logging.StdLogger())(exportJobsParams(operations.ExportJobsBody{
+		Format: "csv",
+	}))
+
+	defer func() {
+		if r := recover(); r != http.ErrAbortHandler {
+			t.Errorf("recovered %v, want %v", r, http.ErrAbortHandler)
+		}
+	}()
+	responder.WriteResponse(httptest.NewRecorder(), runtime.JSONProducer())
+}
+
+func assertExportBadRequest(t *testing.T, rec *httptest.ResponseRecorder) {
+	t.Helper()
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("got status %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+	if contentType := rec.Header().Get("Content-Type"); contentType != "application/json" {
+		t.Errorf("content type = %q, want %q", contentType, "application/json")
+	}
+	var payload struct {
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &payload); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if payload.Error == "" {
+		t.Error("got empty error message")
+	}
+}
@@ -30,7 +30,7 @@ type Filter struct {
 
 	// match
 	// Required: true
-	// Enum: ["exact","anyOf","startsWith","contains","greaterThan","lessThan","greaterThanOrEqualTo","lessThanOrEqualTo","exists"]
+	// Enum: ["exact","anyOf","startsWith","contains","greaterThan","lessThan","greaterThanOrEqualTo","lessThanOrEqualTo","exists","regex","notEqual","notAnyOf","notContains"]
 	Match string `json:"match"`
 
 	// value
@@ -77,7 +77,7 @@ var filterTypeMatchPropEnum []any
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["exact","anyOf","startsWith","contains","greaterThan","lessThan","greaterThanOrEqualTo","lessThanOrEqualTo","exists"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["exact","anyOf","startsWith","contains","greaterThan","lessThan","greaterThanOrEqualTo","lessThanOrEqualTo","exists","regex","notEqual","notAnyOf","notContains"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -113,6 +113,18 @@ const (
 
 	// FilterMatchExists captures enum value "exists"
 	FilterMatchExists string = "exists"
+
+	// FilterMatchRegex captures enum value "regex"
+	FilterMatchRegex string = "regex"
+
+	// FilterMatchNotEqual captures enum value "notEqual"
+	FilterMatchNotEqual string = "notEqual"
+
+	// FilterMatchNotAnyOf captures enum value "notAnyOf"
+	FilterMatchNotAnyOf string = "notAnyOf"
+
+	// FilterMatchNotContains captures enum value "notContains"
+	FilterMatchNotContains string = "notContains"
 )
 
 // prop value enum
@@ -126,6 +126,9 @@ type Job struct {
 	// Min Length: 1
 	// Format: date-time
 	Submitted strfmt.DateTime `json:"submitted"`
+
+	// suspended
+	Suspended bool `json:"suspended,omitempty"`
 }
 
 // Validate validates this job
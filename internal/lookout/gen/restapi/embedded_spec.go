@@ -112,7 +112,7 @@ func init() {
                   "type": "boolean"
                 },
                 "aggregates": {
-                  "description": "Additional fields to compute aggregates on",
+                  "description": "Additional fields to compute aggregates on. The cpu, memory and gpu aggregates are objects with the total and average requested, and the seconds of the resource used by terminal jobs (e.g. GPU seconds). The runtime aggregate is an object with the total, p50, p90 and p99 runtime of terminal jobs, in seconds.",
                   "type": "array",
                   "items": {
                     "type": "string",
@@ -323,6 +323,93 @@ func init() {
         }
       }
     },
+    "/api/v1/jobRunErrors/search": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "searchJobRunErrors",
+        "parameters": [
+          {
+            "name": "searchJobRunErrorsRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "query"
+              ],
+              "properties": {
+                "from": {
+                  "description": "Only include runs that finished at or after this time",
+                  "type": "string",
+                  "format": "date-time"
+                },
+                "query": {
+                  "description": "Words to search for in the errors and debug messages of job runs. Supports \"quoted phrases\", or, and -word to exclude a word.",
+                  "type": "string",
+                  "minLength": 1,
+                  "x-nullable": false
+                },
+                "queues": {
+                  "description": "Only include runs of jobs in these queues. Runs of jobs in all queues are included if empty.",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "take": {
+                  "description": "Maximum number of runs to return. Defaults to 100.",
+                  "type": "integer",
+                  "maximum": 1000,
+                  "minimum": 1
+                },
+                "to": {
+                  "description": "Only include runs that finished before this time",
+                  "type": "string",
+                  "format": "date-time"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns the job runs with errors or debug messages matching the query, most recently finished first",
+            "schema": {
+              "type": "object",
+              "required": [
+                "results"
+              ],
+              "properties": {
+                "results": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/jobRunErrorMatch"
+                  },
+                  "x-nullable": false
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/api/v1/jobRunSchedulerTerminationReason": {
       "post": {
         "consumes": [
@@ -437,6 +524,113 @@ func init() {
         }
       }
     },
+    "/api/v1/jobStatistics": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getJobStatistics",
+        "parameters": [
+          {
+            "name": "getJobStatisticsRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "from",
+                "to",
+                "bucketSize"
+              ],
+              "properties": {
+                "bucketSize": {
+                  "description": "Size of the buckets to average statistics over, in seconds. Must be a multiple of 60.",
+                  "type": "integer",
+                  "minimum": 60,
+                  "x-nullable": false
+                },
+                "from": {
+                  "description": "Start of the time range. Rounded down to a multiple of the bucket size.",
+                  "type": "string",
+                  "format": "date-time",
+                  "x-nullable": false
+                },
+                "groupBy": {
+                  "description": "Fields to return a series for each value of, out of queue, pool and priorityClass. A single series is returned if empty.",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "pools": {
+                  "description": "Only include jobs in these pools. Jobs in all pools are included if empty.",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "priorityClasses": {
+                  "description": "Only include jobs with these priority classes. Jobs with any priority class are included if empty.",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "queues": {
+                  "description": "Only include jobs in these queues. Jobs in all queues are included if empty.",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "to": {
+                  "description": "End of the time range. Rounded up to a multiple of the bucket size.",
+                  "type": "string",
+                  "format": "date-time",
+                  "x-nullable": false
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns the statistics of jobs over time, averaged over each bucket",
+            "schema": {
+              "type": "object",
+              "required": [
+                "series"
+              ],
+              "properties": {
+                "series": {
+                  "description": "A series for each group of jobs",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/jobStatisticsSeries"
+                  },
+                  "x-nullable": false
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/api/v1/jobs": {
       "post": {
         "consumes": [
@@ -462,6 +656,10 @@ func init() {
                   "description": "Only include jobs in active job sets",
                   "type": "boolean"
                 },
+                "continuationToken": {
+                  "description": "Token returned with the previous page of jobs, to fetch the jobs after it. Cannot be combined with skip.",
+                  "type": "string"
+                },
                 "filters": {
                   "description": "Filters to apply to jobs.",
                   "type": "array",
@@ -496,6 +694,10 @@ func init() {
             "schema": {
               "type": "object",
               "properties": {
+                "continuationToken": {
+                  "description": "Token to fetch the next page of jobs with, if there may be more jobs. Only returned if skip is not used.",
+                  "type": "string"
+                },
                 "jobs": {
                   "description": "List of jobs found",
                   "type": "array",
@@ -521,17 +723,89 @@ func init() {
         }
       }
     },
-    "/api/v1/version": {
-      "get": {
+    "/api/v1/jobs/export": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
+          "text/csv",
+          "application/x-ndjson",
           "application/json"
         ],
-        "operationId": "getVersion",
+        "operationId": "exportJobs",
+        "parameters": [
+          {
+            "name": "exportJobsRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "filters",
+                "order",
+                "format"
+              ],
+              "properties": {
+                "activeJobSets": {
+                  "description": "Only include jobs in active job sets",
+                  "type": "boolean"
+                },
+                "annotations": {
+                  "description": "Annotation keys whose values are exported after the job fields.",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "columns": {
+                  "description": "Job fields to export, in order. All fields are exported if none are given.",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "filters": {
+                  "description": "Filters to apply to jobs.",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/filter"
+                  },
+                  "x-nullable": true
+                },
+                "format": {
+                  "description": "Format to export jobs in, either CSV or newline-delimited JSON.",
+                  "type": "string",
+                  "enum": [
+                    "csv",
+                    "ndjson"
+                  ],
+                  "x-nullable": false
+                },
+                "maxRows": {
+                  "description": "Maximum number of jobs to export. Defaults to, and must not exceed, the server's limit.",
+                  "type": "integer"
+                },
+                "order": {
+                  "description": "Ordering to apply to jobs.",
+                  "x-nullable": true,
+                  "$ref": "#/definitions/order"
+                }
+              }
+            }
+          },
+          {
+            "$ref": "#/parameters/backend"
+          }
+        ],
         "responses": {
           "200": {
-            "description": "Returns the Lookout backend's release version, commit, and build time.",
+            "description": "Streams the jobs as CSV or newline-delimited JSON"
+          },
+          "400": {
+            "description": "Error response",
             "schema": {
-              "$ref": "#/definitions/versionInfo"
+              "$ref": "#/definitions/error"
             }
           },
           "default": {
@@ -543,59 +817,300 @@ func init() {
         }
       }
     },
-    "/health": {
+    "/api/v1/savedViews": {
       "get": {
         "produces": [
-          "text/plain"
+          "application/json"
         ],
+        "operationId": "listSavedViews",
         "responses": {
           "200": {
-            "description": "OK",
+            "description": "Returns the saved views owned by the user, and the public saved views of other users",
             "schema": {
-              "type": "string"
+              "type": "object",
+              "required": [
+                "savedViews"
+              ],
+              "properties": {
+                "savedViews": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/savedView"
+                  },
+                  "x-nullable": false
+                }
+              }
             }
           },
           "400": {
             "description": "Error response",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "error": {
-      "type": "object",
-      "required": [
-        "error"
-      ],
-      "properties": {
-        "error": {
-          "type": "string",
-          "minLength": 1,
-          "x-nullable": false
-        }
-      }
     },
-    "filter": {
-      "type": "object",
-      "required": [
-        "field",
-        "value",
-        "match"
-      ],
-      "properties": {
-        "field": {
-          "type": "string",
-          "minLength": 1,
-          "x-nullable": false
-        },
-        "isAnnotation": {
-          "type": "boolean",
-          "x-nullable": false
-        },
+    "/api/v1/savedViews/create": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "createSavedView",
+        "parameters": [
+          {
+            "name": "savedView",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/savedView"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns the saved view created, owned by the user",
+            "schema": {
+              "$ref": "#/definitions/savedView"
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/savedViews/delete": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "deleteSavedView",
+        "parameters": [
+          {
+            "name": "deleteSavedViewRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "id"
+              ],
+              "properties": {
+                "id": {
+                  "type": "string",
+                  "x-nullable": false
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The saved view was deleted. Only the owner of a view can delete it."
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/savedViews/get": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getSavedView",
+        "parameters": [
+          {
+            "name": "getSavedViewRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "id"
+              ],
+              "properties": {
+                "id": {
+                  "type": "string",
+                  "x-nullable": false
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns the saved view with the short id given, if it is public or owned by the user",
+            "schema": {
+              "$ref": "#/definitions/savedView"
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/savedViews/update": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "updateSavedView",
+        "parameters": [
+          {
+            "name": "savedView",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/savedView"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns the saved view updated. Only the owner of a view can update it.",
+            "schema": {
+              "$ref": "#/definitions/savedView"
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/version": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getVersion",
+        "responses": {
+          "200": {
+            "description": "Returns the Lookout backend's release version, commit, and build time.",
+            "schema": {
+              "$ref": "#/definitions/versionInfo"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/health": {
+      "get": {
+        "produces": [
+          "text/plain"
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "type": "string"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "error": {
+      "type": "object",
+      "required": [
+        "error"
+      ],
+      "properties": {
+        "error": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        }
+      }
+    },
+    "filter": {
+      "type": "object",
+      "required": [
+        "field",
+        "value",
+        "match"
+      ],
+      "properties": {
+        "field": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "isAnnotation": {
+          "type": "boolean",
+          "x-nullable": false
+        },
         "match": {
           "type": "string",
           "enum": [
@@ -607,7 +1122,11 @@ func init() {
             "lessThan",
             "greaterThanOrEqualTo",
             "lessThanOrEqualTo",
-            "exists"
+            "exists",
+            "regex",
+            "notEqual",
+            "notAnyOf",
+            "notContains"
           ],
           "x-nullable": false
         },
@@ -800,38 +1319,171 @@ func init() {
           "format": "date-time",
           "minLength": 1,
           "x-nullable": false
+        },
+        "suspended": {
+          "type": "boolean",
+          "x-nullable": false
         }
       }
     },
-    "order": {
+    "jobRunErrorMatch": {
       "type": "object",
       "required": [
-        "field",
-        "direction"
+        "jobId",
+        "runId",
+        "queue",
+        "jobSet",
+        "errorSnippet",
+        "debugSnippet"
       ],
       "properties": {
-        "direction": {
+        "debugSnippet": {
+          "description": "Extracts of the debug message of the run with the matching words between **, or empty if the debug message didn't match",
           "type": "string",
-          "enum": [
-            "ASC",
-            "DESC"
-          ],
           "x-nullable": false
         },
-        "field": {
+        "errorSnippet": {
+          "description": "Extracts of the error of the run with the matching words between **, or empty if the error didn't match",
           "type": "string",
-          "minLength": 1,
           "x-nullable": false
-        }
-      }
-    },
-    "run": {
-      "type": "object",
-      "required": [
-        "runId",
-        "cluster",
-        "jobRunState"
-      ],
+        },
+        "finished": {
+          "description": "Time the run finished, if it has",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "jobId": {
+          "type": "string",
+          "x-nullable": false
+        },
+        "jobSet": {
+          "type": "string",
+          "x-nullable": false
+        },
+        "queue": {
+          "type": "string",
+          "x-nullable": false
+        },
+        "runId": {
+          "type": "string",
+          "x-nullable": false
+        }
+      }
+    },
+    "jobStatisticsPoint": {
+      "type": "object",
+      "required": [
+        "time",
+        "queued",
+        "leased",
+        "pending",
+        "running",
+        "runningCpu",
+        "runningMemory",
+        "runningGpu"
+      ],
+      "properties": {
+        "leased": {
+          "description": "Average number of leased jobs",
+          "type": "number",
+          "x-nullable": false
+        },
+        "pending": {
+          "description": "Average number of pending jobs",
+          "type": "number",
+          "x-nullable": false
+        },
+        "queued": {
+          "description": "Average number of queued jobs",
+          "type": "number",
+          "x-nullable": false
+        },
+        "running": {
+          "description": "Average number of running jobs",
+          "type": "number",
+          "x-nullable": false
+        },
+        "runningCpu": {
+          "description": "Average CPU requested by running jobs, in millicores",
+          "type": "number",
+          "x-nullable": false
+        },
+        "runningGpu": {
+          "description": "Average number of GPUs requested by running jobs",
+          "type": "number",
+          "x-nullable": false
+        },
+        "runningMemory": {
+          "description": "Average memory requested by running jobs, in bytes",
+          "type": "number",
+          "x-nullable": false
+        },
+        "time": {
+          "description": "Start of the bucket",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": false
+        }
+      }
+    },
+    "jobStatisticsSeries": {
+      "type": "object",
+      "required": [
+        "points"
+      ],
+      "properties": {
+        "points": {
+          "description": "A point for every bucket, in order",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/jobStatisticsPoint"
+          },
+          "x-nullable": false
+        },
+        "pool": {
+          "description": "Pool of the jobs, if grouped by pool. Empty for jobs that haven't been leased",
+          "type": "string"
+        },
+        "priorityClass": {
+          "description": "Priority class of the jobs, if grouped by priority class",
+          "type": "string"
+        },
+        "queue": {
+          "description": "Queue of the jobs, if grouped by queue",
+          "type": "string"
+        }
+      }
+    },
+    "order": {
+      "type": "object",
+      "required": [
+        "field",
+        "direction"
+      ],
+      "properties": {
+        "direction": {
+          "type": "string",
+          "enum": [
+            "ASC",
+            "DESC"
+          ],
+          "x-nullable": false
+        },
+        "field": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        }
+      }
+    },
+    "run": {
+      "type": "object",
+      "required": [
+        "runId",
+        "cluster",
+        "jobRunState"
+      ],
       "properties": {
         "cluster": {
           "type": "string",
@@ -912,6 +1564,69 @@ func init() {
         }
       }
     },
+    "savedView": {
+      "type": "object",
+      "required": [
+        "name",
+        "visibility"
+      ],
+      "properties": {
+        "columns": {
+          "description": "Ids of the columns shown, in order",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "created": {
+          "description": "Time the view was created, set by the server",
+          "type": "string",
+          "format": "date-time"
+        },
+        "filters": {
+          "description": "Filters applied to the jobs shown",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/filter"
+          }
+        },
+        "grouping": {
+          "description": "Ids of the columns the jobs are grouped by, outermost first",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "description": "Short id of the view, set by the server when the view is created",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the view",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "owner": {
+          "description": "Name of the user who created the view, set by the server",
+          "type": "string"
+        },
+        "updated": {
+          "description": "Time the view was last updated, set by the server",
+          "type": "string",
+          "format": "date-time"
+        },
+        "visibility": {
+          "description": "Private views can only be seen by their owner. Public views are listed to everyone.",
+          "type": "string",
+          "enum": [
+            "private",
+            "public"
+          ],
+          "x-nullable": false
+        }
+      }
+    },
     "versionInfo": {
       "type": "object",
       "required": [
@@ -1045,7 +1760,7 @@ func init() {
                   "type": "boolean"
                 },
                 "aggregates": {
-                  "description": "Additional fields to compute aggregates on",
+                  "description": "Additional fields to compute aggregates on. The cpu, memory and gpu aggregates are objects with the total and average requested, and the seconds of the resource used by terminal jobs (e.g. GPU seconds). The runtime aggregate is an object with the total, p50, p90 and p99 runtime of terminal jobs, in seconds.",
                   "type": "array",
                   "items": {
                     "type": "string",
@@ -1163,28 +1878,631 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "type": "object",
-              "required": [
-                "runId"
-              ],
-              "properties": {
-                "runId": {
-                  "type": "string",
-                  "x-nullable": false
-                }
-              }
+              "type": "object",
+              "required": [
+                "runId"
+              ],
+              "properties": {
+                "runId": {
+                  "type": "string",
+                  "x-nullable": false
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns debug message for specific job run (if present)",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "errorString": {
+                  "description": "Debug message for individual job run",
+                  "type": "string",
+                  "x-nullable": false
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/jobRunError": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getJobRunError",
+        "parameters": [
+          {
+            "name": "getJobRunErrorRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "runId"
+              ],
+              "properties": {
+                "runId": {
+                  "type": "string",
+                  "x-nullable": false
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns error for specific job run (if present)",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "errorString": {
+                  "description": "Error for individual job run",
+                  "type": "string",
+                  "x-nullable": false
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/jobRunErrors/search": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "searchJobRunErrors",
+        "parameters": [
+          {
+            "name": "searchJobRunErrorsRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "query"
+              ],
+              "properties": {
+                "from": {
+                  "description": "Only include runs that finished at or after this time",
+                  "type": "string",
+                  "format": "date-time"
+                },
+                "query": {
+                  "description": "Words to search for in the errors and debug messages of job runs. Supports \"quoted phrases\", or, and -word to exclude a word.",
+                  "type": "string",
+                  "minLength": 1,
+                  "x-nullable": false
+                },
+                "queues": {
+                  "description": "Only include runs of jobs in these queues. Runs of jobs in all queues are included if empty.",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "take": {
+                  "description": "Maximum number of runs to return. Defaults to 100.",
+                  "type": "integer",
+                  "maximum": 1000,
+                  "minimum": 1
+                },
+                "to": {
+                  "description": "Only include runs that finished before this time",
+                  "type": "string",
+                  "format": "date-time"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns the job runs with errors or debug messages matching the query, most recently finished first",
+            "schema": {
+              "type": "object",
+              "required": [
+                "results"
+              ],
+              "properties": {
+                "results": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/jobRunErrorMatch"
+                  },
+                  "x-nullable": false
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/jobRunSchedulerTerminationReason": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getJobRunSchedulerTerminationReason",
+        "parameters": [
+          {
+            "name": "getJobRunSchedulerTerminationReasonRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "runId"
+              ],
+              "properties": {
+                "runId": {
+                  "type": "string",
+                  "x-nullable": false
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns scheduler termination reason for specific job run (if present)",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "schedulerTerminationReason": {
+                  "description": "Scheduler termination reason for individual job run",
+                  "type": "string",
+                  "x-nullable": false
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/jobSpec": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getJobSpec",
+        "parameters": [
+          {
+            "name": "getJobSpecRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "jobId"
+              ],
+              "properties": {
+                "jobId": {
+                  "type": "string",
+                  "x-nullable": false
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns raw Job spec",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "job": {
+                  "description": "Job Spec object",
+                  "type": "object",
+                  "x-nullable": false
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/jobStatistics": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getJobStatistics",
+        "parameters": [
+          {
+            "name": "getJobStatisticsRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "from",
+                "to",
+                "bucketSize"
+              ],
+              "properties": {
+                "bucketSize": {
+                  "description": "Size of the buckets to average statistics over, in seconds. Must be a multiple of 60.",
+                  "type": "integer",
+                  "minimum": 60,
+                  "x-nullable": false
+                },
+                "from": {
+                  "description": "Start of the time range. Rounded down to a multiple of the bucket size.",
+                  "type": "string",
+                  "format": "date-time",
+                  "x-nullable": false
+                },
+                "groupBy": {
+                  "description": "Fields to return a series for each value of, out of queue, pool and priorityClass. A single series is returned if empty.",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "pools": {
+                  "description": "Only include jobs in these pools. Jobs in all pools are included if empty.",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "priorityClasses": {
+                  "description": "Only include jobs with these priority classes. Jobs with any priority class are included if empty.",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "queues": {
+                  "description": "Only include jobs in these queues. Jobs in all queues are included if empty.",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "to": {
+                  "description": "End of the time range. Rounded up to a multiple of the bucket size.",
+                  "type": "string",
+                  "format": "date-time",
+                  "x-nullable": false
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns the statistics of jobs over time, averaged over each bucket",
+            "schema": {
+              "type": "object",
+              "required": [
+                "series"
+              ],
+              "properties": {
+                "series": {
+                  "description": "A series for each group of jobs",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/jobStatisticsSeries"
+                  },
+                  "x-nullable": false
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/jobs": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "operationId": "getJobs",
+        "parameters": [
+          {
+            "name": "getJobsRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "filters",
+                "order"
+              ],
+              "properties": {
+                "activeJobSets": {
+                  "description": "Only include jobs in active job sets",
+                  "type": "boolean"
+                },
+                "continuationToken": {
+                  "description": "Token returned with the previous page of jobs, to fetch the jobs after it. Cannot be combined with skip.",
+                  "type": "string"
+                },
+                "filters": {
+                  "description": "Filters to apply to jobs.",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/filter"
+                  },
+                  "x-nullable": true
+                },
+                "order": {
+                  "description": "Ordering to apply to jobs.",
+                  "x-nullable": true,
+                  "$ref": "#/definitions/order"
+                },
+                "skip": {
+                  "description": "First elements to ignore from the full set of results. Used for pagination.",
+                  "type": "integer"
+                },
+                "take": {
+                  "description": "Number of jobs to fetch.",
+                  "type": "integer"
+                }
+              }
+            }
+          },
+          {
+            "enum": [
+              "jsonb"
+            ],
+            "type": "string",
+            "description": "The backend to use for this request.",
+            "name": "backend",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Returns jobs from API",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "continuationToken": {
+                  "description": "Token to fetch the next page of jobs with, if there may be more jobs. Only returned if skip is not used.",
+                  "type": "string"
+                },
+                "jobs": {
+                  "description": "List of jobs found",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/job"
+                  }
+                }
+              }
+            }
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/jobs/export": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "text/csv",
+          "application/x-ndjson",
+          "application/json"
+        ],
+        "operationId": "exportJobs",
+        "parameters": [
+          {
+            "name": "exportJobsRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "filters",
+                "order",
+                "format"
+              ],
+              "properties": {
+                "activeJobSets": {
+                  "description": "Only include jobs in active job sets",
+                  "type": "boolean"
+                },
+                "annotations": {
+                  "description": "Annotation keys whose values are exported after the job fields.",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "columns": {
+                  "description": "Job fields to export, in order. All fields are exported if none are given.",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "filters": {
+                  "description": "Filters to apply to jobs.",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/filter"
+                  },
+                  "x-nullable": true
+                },
+                "format": {
+                  "description": "Format to export jobs in, either CSV or newline-delimited JSON.",
+                  "type": "string",
+                  "enum": [
+                    "csv",
+                    "ndjson"
+                  ],
+                  "x-nullable": false
+                },
+                "maxRows": {
+                  "description": "Maximum number of jobs to export. Defaults to, and must not exceed, the server's limit.",
+                  "type": "integer"
+                },
+                "order": {
+                  "description": "Ordering to apply to jobs.",
+                  "x-nullable": true,
+                  "$ref": "#/definitions/order"
+                }
+              }
+            }
+          },
+          {
+            "enum": [
+              "jsonb"
+            ],
+            "type": "string",
+            "description": "The backend to use for this request.",
+            "name": "backend",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Streams the jobs as CSV or newline-delimited JSON"
+          },
+          "400": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "Error response",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
+        }
+      }
+    },
+    "/api/v1/savedViews": {
+      "get": {
+        "produces": [
+          "application/json"
         ],
+        "operationId": "listSavedViews",
         "responses": {
           "200": {
-            "description": "Returns debug message for specific job run (if present)",
+            "description": "Returns the saved views owned by the user, and the public saved views of other users",
             "schema": {
               "type": "object",
+              "required": [
+                "savedViews"
+              ],
               "properties": {
-                "errorString": {
-                  "description": "Debug message for individual job run",
-                  "type": "string",
+                "savedViews": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/savedView"
+                  },
                   "x-nullable": false
                 }
               }
@@ -1205,7 +2523,7 @@ func init() {
         }
       }
     },
-    "/api/v1/jobRunError": {
+    "/api/v1/savedViews/create": {
       "post": {
         "consumes": [
           "application/json"
@@ -1213,38 +2531,22 @@ func init() {
         "produces": [
           "application/json"
         ],
-        "operationId": "getJobRunError",
+        "operationId": "createSavedView",
         "parameters": [
           {
-            "name": "getJobRunErrorRequest",
+            "name": "savedView",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "object",
-              "required": [
-                "runId"
-              ],
-              "properties": {
-                "runId": {
-                  "type": "string",
-                  "x-nullable": false
-                }
-              }
+              "$ref": "#/definitions/savedView"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Returns error for specific job run (if present)",
+            "description": "Returns the saved view created, owned by the user",
             "schema": {
-              "type": "object",
-              "properties": {
-                "errorString": {
-                  "description": "Error for individual job run",
-                  "type": "string",
-                  "x-nullable": false
-                }
-              }
+              "$ref": "#/definitions/savedView"
             }
           },
           "400": {
@@ -1262,7 +2564,7 @@ func init() {
         }
       }
     },
-    "/api/v1/jobRunSchedulerTerminationReason": {
+    "/api/v1/savedViews/delete": {
       "post": {
         "consumes": [
           "application/json"
@@ -1270,19 +2572,19 @@ func init() {
         "produces": [
           "application/json"
         ],
-        "operationId": "getJobRunSchedulerTerminationReason",
+        "operationId": "deleteSavedView",
         "parameters": [
           {
-            "name": "getJobRunSchedulerTerminationReasonRequest",
+            "name": "deleteSavedViewRequest",
             "in": "body",
             "required": true,
             "schema": {
               "type": "object",
               "required": [
-                "runId"
+                "id"
               ],
               "properties": {
-                "runId": {
+                "id": {
                   "type": "string",
                   "x-nullable": false
                 }
@@ -1292,17 +2594,7 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Returns scheduler termination reason for specific job run (if present)",
-            "schema": {
-              "type": "object",
-              "properties": {
-                "schedulerTerminationReason": {
-                  "description": "Scheduler termination reason for individual job run",
-                  "type": "string",
-                  "x-nullable": false
-                }
-              }
-            }
+            "description": "The saved view was deleted. Only the owner of a view can delete it."
           },
           "400": {
             "description": "Error response",
@@ -1319,7 +2611,7 @@ func init() {
         }
       }
     },
-    "/api/v1/jobSpec": {
+    "/api/v1/savedViews/get": {
       "post": {
         "consumes": [
           "application/json"
@@ -1327,19 +2619,19 @@ func init() {
         "produces": [
           "application/json"
         ],
-        "operationId": "getJobSpec",
+        "operationId": "getSavedView",
         "parameters": [
           {
-            "name": "getJobSpecRequest",
+            "name": "getSavedViewRequest",
             "in": "body",
             "required": true,
             "schema": {
               "type": "object",
               "required": [
-                "jobId"
+                "id"
               ],
               "properties": {
-                "jobId": {
+                "id": {
                   "type": "string",
                   "x-nullable": false
                 }
@@ -1349,16 +2641,9 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Returns raw Job spec",
+            "description": "Returns the saved view with the short id given, if it is public or owned by the user",
             "schema": {
-              "type": "object",
-              "properties": {
-                "job": {
-                  "description": "Job Spec object",
-                  "type": "object",
-                  "x-nullable": false
-                }
-              }
+              "$ref": "#/definitions/savedView"
             }
           },
           "400": {
@@ -1376,7 +2661,7 @@ func init() {
         }
       }
     },
-    "/api/v1/jobs": {
+    "/api/v1/savedViews/update": {
       "post": {
         "consumes": [
           "application/json"
@@ -1384,71 +2669,22 @@ func init() {
         "produces": [
           "application/json"
         ],
-        "operationId": "getJobs",
+        "operationId": "updateSavedView",
         "parameters": [
           {
-            "name": "getJobsRequest",
+            "name": "savedView",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "object",
-              "required": [
-                "filters",
-                "order"
-              ],
-              "properties": {
-                "activeJobSets": {
-                  "description": "Only include jobs in active job sets",
-                  "type": "boolean"
-                },
-                "filters": {
-                  "description": "Filters to apply to jobs.",
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/filter"
-                  },
-                  "x-nullable": true
-                },
-                "order": {
-                  "description": "Ordering to apply to jobs.",
-                  "x-nullable": true,
-                  "$ref": "#/definitions/order"
-                },
-                "skip": {
-                  "description": "First elements to ignore from the full set of results. Used for pagination.",
-                  "type": "integer"
-                },
-                "take": {
-                  "description": "Number of jobs to fetch.",
-                  "type": "integer"
-                }
-              }
+              "$ref": "#/definitions/savedView"
             }
-          },
-          {
-            "enum": [
-              "jsonb"
-            ],
-            "type": "string",
-            "description": "The backend to use for this request.",
-            "name": "backend",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Returns jobs from API",
+            "description": "Returns the saved view updated. Only the owner of a view can update it.",
             "schema": {
-              "type": "object",
-              "properties": {
-                "jobs": {
-                  "description": "List of jobs found",
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/job"
-                  }
-                }
-              }
+              "$ref": "#/definitions/savedView"
             }
           },
           "400": {
@@ -1579,7 +2815,11 @@ func init() {
             "lessThan",
             "greaterThanOrEqualTo",
             "lessThanOrEqualTo",
-            "exists"
+            "exists",
+            "regex",
+            "notEqual",
+            "notAnyOf",
+            "notContains"
           ],
           "x-nullable": false
         },
@@ -1772,6 +3012,139 @@ func init() {
           "format": "date-time",
           "minLength": 1,
           "x-nullable": false
+        },
+        "suspended": {
+          "type": "boolean",
+          "x-nullable": false
+        }
+      }
+    },
+    "jobRunErrorMatch": {
+      "type": "object",
+      "required": [
+        "jobId",
+        "runId",
+        "queue",
+        "jobSet",
+        "errorSnippet",
+        "debugSnippet"
+      ],
+      "properties": {
+        "debugSnippet": {
+          "description": "Extracts of the debug message of the run with the matching words between **, or empty if the debug message didn't match",
+          "type": "string",
+          "x-nullable": false
+        },
+        "errorSnippet": {
+          "description": "Extracts of the error of the run with the matching words between **, or empty if the error didn't match",
+          "type": "string",
+          "x-nullable": false
+        },
+        "finished": {
+          "description": "Time the run finished, if it has",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "jobId": {
+          "type": "string",
+          "x-nullable": false
+        },
+        "jobSet": {
+          "type": "string",
+          "x-nullable": false
+        },
+        "queue": {
+          "type": "string",
+          "x-nullable": false
+        },
+        "runId": {
+          "type": "string",
+          "x-nullable": false
+        }
+      }
+    },
+    "jobStatisticsPoint": {
+      "type": "object",
+      "required": [
+        "time",
+        "queued",
+        "leased",
+        "pending",
+        "running",
+        "runningCpu",
+        "runningMemory",
+        "runningGpu"
+      ],
+      "properties": {
+        "leased": {
+          "description": "Average number of leased jobs",
+          "type": "number",
+          "x-nullable": false
+        },
+        "pending": {
+          "description": "Average number of pending jobs",
+          "type": "number",
+          "x-nullable": false
+        },
+        "queued": {
+          "description": "Average number of queued jobs",
+          "type": "number",
+          "x-nullable": false
+        },
+        "running": {
+          "description": "Average number of running jobs",
+          "type": "number",
+          "x-nullable": false
+        },
+        "runningCpu": {
+          "description": "Average CPU requested by running jobs, in millicores",
+          "type": "number",
+          "x-nullable": false
+        },
+        "runningGpu": {
+          "description": "Average number of GPUs requested by running jobs",
+          "type": "number",
+          "x-nullable": false
+        },
+        "runningMemory": {
+          "description": "Average memory requested by running jobs, in bytes",
+          "type": "number",
+          "x-nullable": false
+        },
+        "time": {
+          "description": "Start of the bucket",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": false
+        }
+      }
+    },
+    "jobStatisticsSeries": {
+      "type": "object",
+      "required": [
+        "points"
+      ],
+      "properties": {
+        "points": {
+          "description": "A point for every bucket, in order",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/jobStatisticsPoint"
+          },
+          "x-nullable": false
+        },
+        "pool": {
+          "description": "Pool of the jobs, if grouped by pool. Empty for jobs that haven't been leased",
+          "type": "string"
+        },
+        "priorityClass": {
+          "description": "Priority class of the jobs, if grouped by priority class",
+          "type": "string"
+        },
+        "queue": {
+          "description": "Queue of the jobs, if grouped by queue",
+          "type": "string"
         }
       }
     },
@@ -1884,6 +3257,69 @@ func init() {
         }
       }
     },
+    "savedView": {
+      "type": "object",
+      "required": [
+        "name",
+        "visibility"
+      ],
+      "properties": {
+        "columns": {
+          "description": "Ids of the columns shown, in order",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "created": {
+          "description": "Time the view was created, set by the server",
+          "type": "string",
+          "format": "date-time"
+        },
+        "filters": {
+          "description": "Filters applied to the jobs shown",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/filter"
+          }
+        },
+        "grouping": {
+          "description": "Ids of the columns the jobs are grouped by, outermost first",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "description": "Short id of the view, set by the server when the view is created",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the view",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": false
+        },
+        "owner": {
+          "description": "Name of the user who created the view, set by the server",
+          "type": "string"
+        },
+        "updated": {
+          "description": "Time the view was last updated, set by the server",
+          "type": "string",
+          "format": "date-time"
+        },
+        "visibility": {
+          "description": "Private views can only be seen by their owner. Public views are listed to everyone.",
+          "type": "string",
+          "enum": [
+            "private",
+            "public"
+          ],
+          "x-nullable": false
+        }
+      }
+    },
     "versionInfo": {
       "type": "object",
       "required": [
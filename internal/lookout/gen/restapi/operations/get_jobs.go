@@ -73,6 +73,9 @@ type GetJobsBody struct {
 	// Only include jobs in active job sets
 	ActiveJobSets bool `json:"activeJobSets,omitempty"`
 
+	// Token returned with the previous page of jobs, to fetch the jobs after it. Cannot be combined with skip.
+	ContinuationToken string `json:"continuationToken,omitempty"`
+
 	// Filters to apply to jobs.
 	// Required: true
 	Filters []*models.Filter `json:"filters"`
@@ -252,6 +255,9 @@ func (o *GetJobsBody) UnmarshalBinary(b []byte) error {
 // swagger:model GetJobsOKBody
 type GetJobsOKBody struct {
 
+	// Token to fetch the next page of jobs with, if there may be more jobs. Only returned if skip is not used.
+	ContinuationToken string `json:"continuationToken,omitempty"`
+
 	// List of jobs found
 	Jobs []*models.Job `json:"jobs"`
 }
@@ -74,7 +74,7 @@ type GroupJobsBody struct {
 	// Only include jobs in active job sets
 	ActiveJobSets bool `json:"activeJobSets,omitempty"`
 
-	// Additional fields to compute aggregates on
+	// Additional fields to compute aggregates on. The cpu, memory and gpu aggregates are objects with the total and average requested, and the seconds of the resource used by terminal jobs (e.g. GPU seconds). The runtime aggregate is an object with the total, p50, p90 and p99 runtime of terminal jobs, in seconds.
 	// Required: true
 	Aggregates []string `json:"aggregates"`
 
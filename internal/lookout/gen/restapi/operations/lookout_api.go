@@ -43,6 +43,24 @@ func NewLookoutAPI(spec *loads.Document) *LookoutAPI {
 		JSONProducer: runtime.JSONProducer(),
 		TxtProducer:  runtime.TextProducer(),
 
+		CreateSavedViewHandler: CreateSavedViewHandlerFunc(func(params CreateSavedViewParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation CreateSavedView has not yet been implemented")
+		}),
+
+		DeleteSavedViewHandler: DeleteSavedViewHandlerFunc(func(params DeleteSavedViewParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation DeleteSavedView has not yet been implemented")
+		}),
+
+		ExportJobsHandler: ExportJobsHandlerFunc(func(params ExportJobsParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation ExportJobs has not yet been implemented")
+		}),
+
 		GetHealthHandler: GetHealthHandlerFunc(func(params GetHealthParams) middleware.Responder {
 			_ = params
 
@@ -79,12 +97,24 @@ func NewLookoutAPI(spec *loads.Document) *LookoutAPI {
 			return middleware.NotImplemented("operation GetJobSpec has not yet been implemented")
 		}),
 
+		GetJobStatisticsHandler: GetJobStatisticsHandlerFunc(func(params GetJobStatisticsParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation GetJobStatistics has not yet been implemented")
+		}),
+
 		GetJobsHandler: GetJobsHandlerFunc(func(params GetJobsParams) middleware.Responder {
 			_ = params
 
 			return middleware.NotImplemented("operation GetJobs has not yet been implemented")
 		}),
 
+		GetSavedViewHandler: GetSavedViewHandlerFunc(func(params GetSavedViewParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation GetSavedView has not yet been implemented")
+		}),
+
 		GetVersionHandler: GetVersionHandlerFunc(func(params GetVersionParams) middleware.Responder {
 			_ = params
 
@@ -96,6 +126,24 @@ func NewLookoutAPI(spec *loads.Document) *LookoutAPI {
 
 			return middleware.NotImplemented("operation GroupJobs has not yet been implemented")
 		}),
+
+		ListSavedViewsHandler: ListSavedViewsHandlerFunc(func(params ListSavedViewsParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation ListSavedViews has not yet been implemented")
+		}),
+
+		SearchJobRunErrorsHandler: SearchJobRunErrorsHandlerFunc(func(params SearchJobRunErrorsParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation SearchJobRunErrors has not yet been implemented")
+		}),
+
+		UpdateSavedViewHandler: UpdateSavedViewHandlerFunc(func(params UpdateSavedViewParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation UpdateSavedView has not yet been implemented")
+		}),
 	}
 }
 
@@ -135,6 +183,12 @@ type LookoutAPI struct {
 	//   - text/plain
 	TxtProducer runtime.Producer
 
+	// CreateSavedViewHandler sets the operation handler for the create saved view operation
+	CreateSavedViewHandler CreateSavedViewHandler
+	// DeleteSavedViewHandler sets the operation handler for the delete saved view operation
+	DeleteSavedViewHandler DeleteSavedViewHandler
+	// ExportJobsHandler sets the operation handler for the export jobs operation
+	ExportJobsHandler ExportJobsHandler
 	// GetHealthHandler sets the operation handler for the get health operation
 	GetHealthHandler GetHealthHandler
 	// GetJobErrorHandler sets the operation handler for the get job error operation
@@ -147,12 +201,22 @@ type LookoutAPI struct {
 	GetJobRunSchedulerTerminationReasonHandler GetJobRunSchedulerTerminationReasonHandler
 	// GetJobSpecHandler sets the operation handler for the get job spec operation
 	GetJobSpecHandler GetJobSpecHandler
+	// GetJobStatisticsHandler sets the operation handler for the get job statistics operation
+	GetJobStatisticsHandler GetJobStatisticsHandler
 	// GetJobsHandler sets the operation handler for the get jobs operation
 	GetJobsHandler GetJobsHandler
+	// GetSavedViewHandler sets the operation handler for the get saved view operation
+	GetSavedViewHandler GetSavedViewHandler
 	// GetVersionHandler sets the operation handler for the get version operation
 	GetVersionHandler GetVersionHandler
 	// GroupJobsHandler sets the operation handler for the group jobs operation
 	GroupJobsHandler GroupJobsHandler
+	// ListSavedViewsHandler sets the operation handler for the list saved views operation
+	ListSavedViewsHandler ListSavedViewsHandler
+	// SearchJobRunErrorsHandler sets the operation handler for the search job run errors operation
+	SearchJobRunErrorsHandler SearchJobRunErrorsHandler
+	// UpdateSavedViewHandler sets the operation handler for the update saved view operation
+	UpdateSavedViewHandler UpdateSavedViewHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -233,6 +297,15 @@ func (o *LookoutAPI) Validate() error {
 		unregistered = append(unregistered, "TxtProducer")
 	}
 
+	if o.CreateSavedViewHandler == nil {
+		unregistered = append(unregistered, "CreateSavedViewHandler")
+	}
+	if o.DeleteSavedViewHandler == nil {
+		unregistered = append(unregistered, "DeleteSavedViewHandler")
+	}
+	if o.ExportJobsHandler == nil {
+		unregistered = append(unregistered, "ExportJobsHandler")
+	}
 	if o.GetHealthHandler == nil {
 		unregistered = append(unregistered, "GetHealthHandler")
 	}
@@ -251,15 +324,30 @@ func (o *LookoutAPI) Validate() error {
 	if o.GetJobSpecHandler == nil {
 		unregistered = append(unregistered, "GetJobSpecHandler")
 	}
+	if o.GetJobStatisticsHandler == nil {
+		unregistered = append(unregistered, "GetJobStatisticsHandler")
+	}
 	if o.GetJobsHandler == nil {
 		unregistered = append(unregistered, "GetJobsHandler")
 	}
+	if o.GetSavedViewHandler == nil {
+		unregistered = append(unregistered, "GetSavedViewHandler")
+	}
 	if o.GetVersionHandler == nil {
 		unregistered = append(unregistered, "GetVersionHandler")
 	}
 	if o.GroupJobsHandler == nil {
 		unregistered = append(unregistered, "GroupJobsHandler")
 	}
+	if o.ListSavedViewsHandler == nil {
+		unregistered = append(unregistered, "ListSavedViewsHandler")
+	}
+	if o.SearchJobRunErrorsHandler == nil {
+		unregistered = append(unregistered, "SearchJobRunErrorsHandler")
+	}
+	if o.UpdateSavedViewHandler == nil {
+		unregistered = append(unregistered, "UpdateSavedViewHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -353,6 +441,18 @@ func (o *LookoutAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/api/v1/savedViews/create"] = NewCreateSavedView(o.context, o.CreateSavedViewHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/api/v1/savedViews/delete"] = NewDeleteSavedView(o.context, o.DeleteSavedViewHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/api/v1/jobs/export"] = NewExportJobs(o.context, o.ExportJobsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -380,7 +480,15 @@ func (o *LookoutAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/api/v1/jobStatistics"] = NewGetJobStatistics(o.context, o.GetJobStatisticsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/api/v1/jobs"] = NewGetJobs(o.context, o.GetJobsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/api/v1/savedViews/get"] = NewGetSavedView(o.context, o.GetSavedViewHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -389,6 +497,18 @@ func (o *LookoutAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/api/v1/jobGroups"] = NewGroupJobs(o.context, o.GroupJobsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/api/v1/savedViews"] = NewListSavedViews(o.context, o.ListSavedViewsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/api/v1/jobRunErrors/search"] = NewSearchJobRunErrors(o.context, o.SearchJobRunErrorsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/api/v1/savedViews/update"] = NewUpdateSavedView(o.context, o.UpdateSavedViewHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -14,6 +14,10 @@ const (
 	MatchGreaterThanOrEqualTo = "greaterThanOrEqualTo"
 	MatchLessThanOrEqualTo    = "lessThanOrEqualTo"
 	MatchExists               = "exists"
+	MatchRegex                = "regex"
+	MatchNotEqual             = "notEqual"
+	MatchNotAnyOf             = "notAnyOf"
+	MatchNotContains          = "notContains"
 
 	DirectionAsc  = "ASC"
 	DirectionDesc = "DESC"
@@ -22,6 +26,9 @@ const (
 	AggregateLatest   = "latest"
 	AggregateEarliest = "earliest"
 	AggregateAverage  = "average"
+
+	VisibilityPrivate = "private"
+	VisibilityPublic  = "public"
 )
 
 type Job struct {
@@ -51,6 +58,7 @@ type Job struct {
 	Pool               *string
 	ExitCode           *int32
 	RuntimeSeconds     int32
+	Suspended          bool
 }
 
 // PostgreSQLTime is a wrapper around time.Time that converts to UTC when
@@ -128,3 +136,50 @@ type GroupedField struct {
 	IsAnnotation                bool
 	LastTransitionTimeAggregate string
 }
+
+// JobStatisticsSeries is the statistics of the jobs of a group of queues, pools and priority classes over time. The
+// fields that the statistics weren't grouped by are empty.
+type JobStatisticsSeries struct {
+	Queue         string
+	Pool          string
+	PriorityClass string
+	Points        []*JobStatisticsPoint
+}
+
+// JobStatisticsPoint is the average number of jobs in each state, and the average resources requested by running
+// jobs, over a bucket of time starting at Time
+type JobStatisticsPoint struct {
+	Time          time.Time
+	Queued        float64
+	Leased        float64
+	Pending       float64
+	Running       float64
+	RunningCpu    float64
+	RunningMemory float64
+	RunningGpu    float64
+}
+
+// SavedView is a view of Lookout saved by a user, so that it can be shared by its short id
+type SavedView struct {
+	Id         string
+	Name       string
+	Owner      string
+	Filters    []*Filter
+	Grouping   []string
+	Columns    []string
+	Visibility string
+	Created    time.Time
+	Updated    time.Time
+}
+
+// JobRunErrorMatch is a job run whose error or debug message matched a search. The snippets are extracts of the
+// messages with the matching words marked, and are empty if the message didn't match.
+type JobRunErrorMatch struct {
+	JobId        string
+	RunId        string
+	Queue        string
+	JobSet       string
+	Finished     *time.Time
+	ErrorSnippet string
+	DebugSnippet string
+}
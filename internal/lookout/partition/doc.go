@@ -0,0 +1,19 @@
+// Package partition converts the Lookout job table into its partitioned shape
+// online, and verifies that shape.
+//
+// Migration 049 partitions job by state into job_active and job_terminated,
+// rewriting it while holding an exclusive lock. To avoid that downtime on
+// large databases, job can be converted beforehand, while Lookout and the
+// ingester keep running:
+//
+//  1. Prepare creates an empty partitioned copy of job, job_partitioned, and
+//     a trigger on job that mirrors every insert, update and delete into it.
+//  2. Backfill copies the existing rows of job into job_partitioned, in
+//     batches, locking only the rows of the batch being copied.
+//  3. Verify compares the contents of the two tables.
+//  4. Swap drops job and renames job_partitioned to job, holding an
+//     exclusive lock only for as long as the drop and renames take.
+//
+// Migration 049 then finds job already partitioned and does nothing. Verify
+// also checks the shape of job once it's partitioned, by either path.
+package partition
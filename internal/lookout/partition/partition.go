@@ -0,0 +1,260 @@
+package partition
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/database"
+	log "github.com/armadaproject/armada/internal/common/logging"
+)
+
+const (
+	// partitionMigrationId is the id of the lookout migration that partitions job. The online conversion must be run
+	// against a database with every earlier migration applied, so that job_partitioned has the columns of job.
+	partitionMigrationId = 49
+	// onlineTable is the partitioned copy of job made by the online conversion, which replaces job when swapped
+	onlineTable = "job_partitioned"
+	// syncTrigger is the name of the trigger, and of its function, that mirrors changes to job into onlineTable
+	syncTrigger = "job_partitioned_sync"
+	// backfilledComment is set as the comment of onlineTable once every row of job has been copied to it
+	backfilledComment = "backfilled"
+)
+
+// jobColumns are the columns of the partitioned job table, in order
+var jobColumns = []string{
+	"job_id", "queue", "owner", "jobset", "cpu", "memory", "ephemeral_storage", "gpu", "priority", "submitted",
+	"cancelled", "state", "last_transition_time", "last_transition_time_seconds", "job_spec", "duplicate",
+	"priority_class", "latest_run_id", "cancel_reason", "namespace", "annotations", "external_job_uri", "cancel_user",
+	"suspended", "run_count", "first_leased",
+}
+
+// partitionIndex is an index of the partitioned job table
+type partitionIndex struct {
+	// suffix is the name of the index after idx_<table>_
+	suffix string
+	// definition is the part of the CREATE INDEX statement after the table name
+	definition string
+}
+
+// parentIndexes are the indexes of the partitioned job table, other than its primary key
+var parentIndexes = []partitionIndex{
+	{"queue_last_transition_time_seconds", "(queue, last_transition_time_seconds) WITH (fillfactor = 80)"},
+	{"queue_jobset_state", "(queue, jobset, state) WITH (fillfactor = 80)"},
+	{"state", "(state) WITH (fillfactor = 80)"},
+	{"submitted", "(submitted DESC)"},
+	{"jobset_pattern", "(jobset varchar_pattern_ops) WITH (fillfactor = 80)"},
+	{"annotations_path", "USING gin (annotations jsonb_ops) WITH (fastupdate = true, gin_pending_list_limit = 33554432)"},
+	{"latest_run_id", "(latest_run_id) WITH (fillfactor = 80)"},
+	{"queue_namespace", "(queue, namespace) WITH (fillfactor = 80)"},
+	{"ltt_jobid", "(last_transition_time, job_id) WITH (fillfactor = 80)"},
+	{"run_count", "(run_count) WITH (fillfactor = 80)"},
+	{"queue_trgm", "USING gin (queue gin_trgm_ops)"},
+	{"jobset_trgm", "USING gin (jobset gin_trgm_ops)"},
+	{"owner_trgm", "USING gin (owner gin_trgm_ops)"},
+}
+
+// activeIndex is the index of the active partition of the partitioned job table
+var activeIndex = partitionIndex{"active_queue_jobset", "(queue, jobset) WITH (fillfactor = 80)"}
+
+// Prepare creates job_partitioned, an empty partitioned copy of job, along with a trigger on job that mirrors every
+// change to job into it. Changes made to job from then on are slowed down by the trigger, until Swap drops job.
+// Creating the trigger briefly blocks writes to job, and fails if it can't lock job within lockTimeout.
+func Prepare(ctx *armadacontext.Context, db *pgx.Conn, lockTimeout time.Duration) error {
+	return pgx.BeginTxFunc(ctx, db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		if err := setLockTimeout(ctx, tx, lockTimeout); err != nil {
+			return err
+		}
+		if err := checkVersion(ctx, tx); err != nil {
+			return err
+		}
+		partitioned, err := isPartitioned(ctx, tx, "job")
+		if err != nil {
+			return err
+		}
+		if partitioned {
+			return errors.New("job is already partitioned")
+		}
+		exists, err := tableExists(ctx, tx, onlineTable)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return errors.Errorf("%s already exists, so the conversion has already been prepared", onlineTable)
+		}
+
+		statements := []string{
+			// Copying the columns from job keeps their order, so that rows of job can be inserted into the copy as
+			// they are. The annotations of job are only constrained to be non-null by a check constraint, which
+			// isn't copied.
+			fmt.Sprintf(`CREATE TABLE %s (LIKE job INCLUDING DEFAULTS INCLUDING STORAGE) PARTITION BY LIST (state)`, onlineTable),
+			fmt.Sprintf(`ALTER TABLE %s ALTER COLUMN annotations SET NOT NULL`, onlineTable),
+			fmt.Sprintf(`CREATE TABLE %s_active PARTITION OF %s FOR VALUES IN (1, 2, 3, 8) WITH (fillfactor = 70)`, onlineTable, onlineTable),
+			fmt.Sprintf(`CREATE TABLE %s_terminated PARTITION OF %s FOR VALUES IN (4, 5, 6, 7, 9) WITH (fillfactor = 70)`, onlineTable, onlineTable),
+			fmt.Sprintf(`ALTER TABLE %s ADD CONSTRAINT %s_pkey PRIMARY KEY (job_id, state)`, onlineTable, onlineTable),
+		}
+		for _, index := range parentIndexes {
+			statements = append(statements, fmt.Sprintf(`CREATE INDEX idx_%s_%s ON %s %s`, onlineTable, index.suffix, onlineTable, index.definition))
+		}
+		statements = append(statements,
+			fmt.Sprintf(`CREATE INDEX idx_%s_%s ON %s_active %s`, onlineTable, activeIndex.suffix, onlineTable, activeIndex.definition),
+			// A changed row is deleted and reinserted, so that it lands in the partition of its new state
+			fmt.Sprintf(`
+				CREATE FUNCTION %s() RETURNS trigger LANGUAGE plpgsql AS $$
+				BEGIN
+					IF TG_OP <> 'INSERT' THEN
+						DELETE FROM %s WHERE job_id = OLD.job_id;
+					END IF;
+					IF TG_OP <> 'DELETE' THEN
+						INSERT INTO %s SELECT NEW.*;
+					END IF;
+					RETURN NULL;
+				END
+				$$`, syncTrigger, onlineTable, onlineTable),
+			fmt.Sprintf(`CREATE TRIGGER %s AFTER INSERT OR UPDATE OR DELETE ON job FOR EACH ROW EXECUTE FUNCTION %s()`, syncTrigger, syncTrigger),
+		)
+		for _, statement := range statements {
+			if _, err := tx.Exec(ctx, statement); err != nil {
+				return errors.Wrapf(err, "error executing %s", strings.TrimSpace(statement))
+			}
+		}
+		log.Infof("Created %s and the trigger mirroring job into it", onlineTable)
+		return nil
+	})
+}
+
+// Backfill copies every row of job into job_partitioned, in batches of batchSize rows ordered by job id, starting
+// after the job id given. Each batch is copied in its own transaction, which locks the rows of job being copied so
+// that the trigger made by Prepare can't mirror a change to one of them at the same time. Returns the number of rows
+// copied. Backfill can be run again, or resumed from the last job id it logged, if it's interrupted.
+func Backfill(ctx *armadacontext.Context, db *pgx.Conn, batchSize int, after string) (int, error) {
+	exists, err := tableExists(ctx, db, onlineTable)
+	if err != nil {
+		return 0, err
+	}
+	if !exists {
+		return 0, errors.Errorf("%s doesn't exist, so the conversion hasn't been prepared", onlineTable)
+	}
+
+	columns := strings.Join(jobColumns, ", ")
+	copied := 0
+	for {
+		start := time.Now()
+		var jobIds []string
+		err := pgx.BeginTxFunc(ctx, db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+			rows, err := tx.Query(ctx, `SELECT job_id FROM job WHERE job_id > $1 ORDER BY job_id LIMIT $2 FOR SHARE`, after, batchSize)
+			if err != nil {
+				return err
+			}
+			jobIds, err = pgx.CollectRows(rows, pgx.RowTo[string])
+			if err != nil || len(jobIds) == 0 {
+				return err
+			}
+			// The trigger may already have mirrored some of these jobs, so they're replaced
+			if _, err := tx.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE job_id = any($1)`, onlineTable), jobIds); err != nil {
+				return err
+			}
+			_, err = tx.Exec(ctx,
+				fmt.Sprintf(`INSERT INTO %s (%s) SELECT %s FROM job WHERE job_id = any($1)`, onlineTable, columns, columns),
+				jobIds)
+			return err
+		})
+		if err != nil {
+			return copied, errors.Wrapf(err, "error copying jobs after %s", after)
+		}
+		if len(jobIds) == 0 {
+			break
+		}
+		copied += len(jobIds)
+		after = jobIds[len(jobIds)-1]
+		log.Infof("Copied %d jobs in %s, up to job %s. Copied %d jobs in total", len(jobIds), time.Since(start), after, copied)
+		if len(jobIds) < batchSize {
+			break
+		}
+	}
+
+	if _, err := db.Exec(ctx, fmt.Sprintf(`COMMENT ON TABLE %s IS '%s'`, onlineTable, backfilledComment)); err != nil {
+		return copied, errors.WithStack(err)
+	}
+	log.Infof("Finished copying %d jobs to %s", copied, onlineTable)
+	return copied, nil
+}
+
+// Swap replaces job with job_partitioned, dropping job along with its trigger, then renames job_partitioned and its
+// partitions, primary key and indexes to their final names. Swap refuses to run unless job_partitioned has been
+// backfilled, and fails if it can't lock job within lockTimeout. Writes to job are blocked until it completes, which
+// doesn't depend on the size of job.
+func Swap(ctx *armadacontext.Context, db *pgx.Conn, lockTimeout time.Duration) error {
+	return pgx.BeginTxFunc(ctx, db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		if err := setLockTimeout(ctx, tx, lockTimeout); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, `LOCK TABLE job IN ACCESS EXCLUSIVE MODE`); err != nil {
+			return errors.Wrap(err, "error locking job")
+		}
+		if err := checkVersion(ctx, tx); err != nil {
+			return err
+		}
+		backfilled, err := isBackfilled(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if !backfilled {
+			return errors.Errorf("%s hasn't been backfilled", onlineTable)
+		}
+
+		statements := []string{
+			// Dropping job drops the trigger on it
+			`DROP TABLE job`,
+			fmt.Sprintf(`DROP FUNCTION %s()`, syncTrigger),
+			fmt.Sprintf(`COMMENT ON TABLE %s IS NULL`, onlineTable),
+			fmt.Sprintf(`ALTER TABLE %s RENAME TO job`, onlineTable),
+			fmt.Sprintf(`ALTER TABLE %s_active RENAME TO job_active`, onlineTable),
+			fmt.Sprintf(`ALTER TABLE %s_terminated RENAME TO job_terminated`, onlineTable),
+			fmt.Sprintf(`ALTER TABLE job RENAME CONSTRAINT %s_pkey TO job_pkey`, onlineTable),
+		}
+		for _, index := range append(parentIndexes, activeIndex) {
+			statements = append(statements, fmt.Sprintf(`ALTER INDEX idx_%s_%s RENAME TO idx_job_%s`, onlineTable, index.suffix, index.suffix))
+		}
+		for _, statement := range statements {
+			if _, err := tx.Exec(ctx, statement); err != nil {
+				return errors.Wrapf(err, "error executing %s", statement)
+			}
+		}
+
+		problems, err := shapeProblems(ctx, tx, "job")
+		if err != nil {
+			return err
+		}
+		if len(problems) > 0 {
+			return errors.Errorf("job doesn't have the expected shape after swapping: %s", strings.Join(problems, "; "))
+		}
+		log.Infof("Replaced job with %s", onlineTable)
+		return nil
+	})
+}
+
+// checkVersion returns an error unless every lookout migration before the one that partitions job has been applied,
+// and that one hasn't
+func checkVersion(ctx *armadacontext.Context, db database.Querier) error {
+	var version int
+	if err := db.QueryRow(ctx, `SELECT last_value FROM database_version`).Scan(&version); err != nil {
+		return errors.Wrap(err, "error reading database version")
+	}
+	if version != partitionMigrationId-1 {
+		return errors.Errorf(
+			"the database is at version %d, but must be at version %d to be partitioned online; "+
+				"migrate it with lookout --migrateDatabase --targetVersion %d",
+			version, partitionMigrationId-1, partitionMigrationId-1)
+	}
+	return nil
+}
+
+func setLockTimeout(ctx *armadacontext.Context, tx pgx.Tx, lockTimeout time.Duration) error {
+	_, err := tx.Exec(ctx, `SELECT set_config('lock_timeout', $1, true)`, fmt.Sprintf("%dms", lockTimeout.Milliseconds()))
+	return errors.WithStack(err)
+}
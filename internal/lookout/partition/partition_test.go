@@ -0,0 +1,167 @@
+package partition
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/database"
+	lookoutschema "github.com/armadaproject/armada/internal/lookout/schema"
+)
+
+// withUnpartitionedDb runs action against a database with every lookout migration applied other than the one
+// partitioning job, and those after it
+func withUnpartitionedDb(t *testing.T, action func(ctx *armadacontext.Context, conn *pgx.Conn)) {
+	migrations, err := lookoutschema.LookoutMigrations()
+	require.NoError(t, err)
+	// Migrations are numbered from 1, so the migration partitioning job is at index partitionMigrationId - 1
+	err = database.WithTestDb(migrations[:partitionMigrationId-1], func(db *pgxpool.Pool) error {
+		ctx := armadacontext.Background()
+		conn, err := db.Acquire(ctx)
+		require.NoError(t, err)
+		defer conn.Release()
+		action(ctx, conn.Conn())
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func insertJob(t *testing.T, ctx *armadacontext.Context, conn *pgx.Conn, jobId string, state int16) {
+	_, err := conn.Exec(ctx, `
+		INSERT INTO job (
+			job_id, queue, owner, jobset, cpu, memory, ephemeral_storage, gpu, priority, submitted, state,
+			last_transition_time, last_transition_time_seconds, annotations
+		) VALUES ($1, 'queue', 'owner', 'job-set', 1, 1, 1, 0, 0, $2, $3, $2, 0, '{}'::jsonb)`,
+		jobId, time.Now().UTC(), state)
+	require.NoError(t, err)
+}
+
+func countJobs(t *testing.T, ctx *armadacontext.Context, conn *pgx.Conn, table string) int {
+	var count int
+	require.NoError(t, conn.QueryRow(ctx, "SELECT count(*) FROM "+table).Scan(&count))
+	return count
+}
+
+func TestMigrationPartitionsJob(t *testing.T) {
+	withUnpartitionedDb(t, func(ctx *armadacontext.Context, conn *pgx.Conn) {
+		insertJob(t, ctx, conn, "job-queued", 1)
+		insertJob(t, ctx, conn, "job-running", 3)
+		insertJob(t, ctx, conn, "job-succeeded", 4)
+
+		migrations, err := lookoutschema.LookoutMigrations()
+		require.NoError(t, err)
+		require.NoError(t, database.UpdateDatabase(ctx, conn, migrations))
+
+		problems, err := Verify(ctx, conn)
+		require.NoError(t, err)
+		assert.Empty(t, problems)
+		assert.Equal(t, 2, countJobs(t, ctx, conn, "job_active"))
+		assert.Equal(t, 1, countJobs(t, ctx, conn, "job_terminated"))
+
+		// Updating the state of a job moves it to the partition of its new state
+		_, err = conn.Exec(ctx, "UPDATE job SET state = 4 WHERE job_id = 'job-running'")
+		require.NoError(t, err)
+		assert.Equal(t, 1, countJobs(t, ctx, conn, "job_active"))
+		assert.Equal(t, 2, countJobs(t, ctx, conn, "job_terminated"))
+	})
+}
+
+func TestOnlineConversion(t *testing.T) {
+	withUnpartitionedDb(t, func(ctx *armadacontext.Context, conn *pgx.Conn) {
+		insertJob(t, ctx, conn, "job-1", 1)
+		insertJob(t, ctx, conn, "job-2", 3)
+		insertJob(t, ctx, conn, "job-3", 4)
+		insertJob(t, ctx, conn, "job-4", 5)
+		insertJob(t, ctx, conn, "job-5", 8)
+
+		require.NoError(t, Prepare(ctx, conn, time.Second))
+
+		// Changes made before the backfill are mirrored by the trigger
+		insertJob(t, ctx, conn, "job-6", 2)
+		_, err := conn.Exec(ctx, "UPDATE job SET state = 5 WHERE job_id = 'job-1'")
+		require.NoError(t, err)
+		_, err = conn.Exec(ctx, "DELETE FROM job WHERE job_id = 'job-3'")
+		require.NoError(t, err)
+
+		problems, err := Verify(ctx, conn)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"job_partitioned hasn't been backfilled"}, problems)
+
+		// Batches smaller than the table check that the backfill continues from the last batch
+		copied, err := Backfill(ctx, conn, 2, "")
+		require.NoError(t, err)
+		assert.Equal(t, 5, copied)
+
+		// Changes made after the backfill are mirrored too
+		_, err = conn.Exec(ctx, "UPDATE job SET state = 3 WHERE job_id = 'job-6'")
+		require.NoError(t, err)
+
+		problems, err = Verify(ctx, conn)
+		require.NoError(t, err)
+		assert.Empty(t, problems)
+
+		require.NoError(t, Swap(ctx, conn, time.Second))
+
+		problems, err = Verify(ctx, conn)
+		require.NoError(t, err)
+		assert.Empty(t, problems)
+		assert.Equal(t, 3, countJobs(t, ctx, conn, "job_active"))
+		assert.Equal(t, 2, countJobs(t, ctx, conn, "job_terminated"))
+
+		// The migration partitioning job then has nothing to do
+		migrations, err := lookoutschema.LookoutMigrations()
+		require.NoError(t, err)
+		require.NoError(t, database.UpdateDatabase(ctx, conn, migrations))
+		problems, err = Verify(ctx, conn)
+		require.NoError(t, err)
+		assert.Empty(t, problems)
+		assert.Equal(t, 5, countJobs(t, ctx, conn, "job"))
+	})
+}
+
+func TestVerify_DetectsDifferences(t *testing.T) {
+	withUnpartitionedDb(t, func(ctx *armadacontext.Context, conn *pgx.Conn) {
+		insertJob(t, ctx, conn, "job-1", 1)
+		insertJob(t, ctx, conn, "job-2", 4)
+		require.NoError(t, Prepare(ctx, conn, time.Second))
+		_, err := Backfill(ctx, conn, 10, "")
+		require.NoError(t, err)
+
+		_, err = conn.Exec(ctx, "UPDATE job_partitioned SET queue = 'other' WHERE job_id = 'job-1'")
+		require.NoError(t, err)
+		problems, err := Verify(ctx, conn)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"the rows of job and job_partitioned differ"}, problems)
+
+		_, err = conn.Exec(ctx, "DELETE FROM job_partitioned WHERE job_id = 'job-1'")
+		require.NoError(t, err)
+		problems, err = Verify(ctx, conn)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"job has 2 rows, but job_partitioned has 1"}, problems)
+	})
+}
+
+func TestPrepare_RefusesPartitionedJob(t *testing.T) {
+	withUnpartitionedDb(t, func(ctx *armadacontext.Context, conn *pgx.Conn) {
+		migrations, err := lookoutschema.LookoutMigrations()
+		require.NoError(t, err)
+		require.NoError(t, database.UpdateDatabase(ctx, conn, migrations))
+
+		assert.Error(t, Prepare(ctx, conn, time.Second))
+	})
+}
+
+func TestSwap_RefusesWithoutBackfill(t *testing.T) {
+	withUnpartitionedDb(t, func(ctx *armadacontext.Context, conn *pgx.Conn) {
+		insertJob(t, ctx, conn, "job-1", 1)
+		require.NoError(t, Prepare(ctx, conn, time.Second))
+
+		assert.Error(t, Swap(ctx, conn, time.Second))
+		assert.Equal(t, 1, countJobs(t, ctx, conn, "job"))
+	})
+}
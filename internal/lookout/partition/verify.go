@@ -0,0 +1,294 @@
+package partition
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/database"
+	armadaslices "github.com/armadaproject/armada/internal/common/slices"
+)
+
+type column struct {
+	name    string
+	typ     string
+	notNull bool
+}
+
+// expectedColumns are the columns of the partitioned job table, as reported by information_schema
+var expectedColumns = []column{
+	{"job_id", "character varying", true},
+	{"queue", "character varying", true},
+	{"owner", "character varying", true},
+	{"jobset", "character varying", true},
+	{"cpu", "bigint", true},
+	{"memory", "bigint", true},
+	{"ephemeral_storage", "bigint", true},
+	{"gpu", "bigint", true},
+	{"priority", "bigint", true},
+	{"submitted", "timestamp without time zone", true},
+	{"cancelled", "timestamp without time zone", false},
+	{"state", "smallint", true},
+	{"last_transition_time", "timestamp without time zone", true},
+	{"last_transition_time_seconds", "bigint", true},
+	{"job_spec", "bytea", false},
+	{"duplicate", "boolean", true},
+	{"priority_class", "character varying", false},
+	{"latest_run_id", "character varying", false},
+	{"cancel_reason", "character varying", false},
+	{"namespace", "character varying", false},
+	{"annotations", "jsonb", true},
+	{"external_job_uri", "character varying", false},
+	{"cancel_user", "character varying", false},
+	{"suspended", "boolean", true},
+	{"run_count", "integer", true},
+	{"first_leased", "timestamp without time zone", false},
+}
+
+// expectedBounds are the states in each partition of the partitioned job table, keyed by the suffix of the
+// partition's name after <table>_
+var expectedBounds = map[string][]int{
+	"active":     {1, 2, 3, 8},
+	"terminated": {4, 5, 6, 7, 9},
+}
+
+// Verify checks the partitioning of job, returning a description of each problem found. Once job is partitioned,
+// Verify checks its shape and that no job is in it more than once. While it's being converted online, Verify checks
+// the shape of job_partitioned, and that it has exactly the same rows as job. Comparing the rows reads both tables
+// in full, but doesn't block writes to them.
+func Verify(ctx *armadacontext.Context, db *pgx.Conn) ([]string, error) {
+	partitioned, err := isPartitioned(ctx, db, "job")
+	if err != nil {
+		return nil, err
+	}
+	if partitioned {
+		problems, err := shapeProblems(ctx, db, "job")
+		if err != nil {
+			return nil, err
+		}
+		duplicates, err := duplicateProblems(ctx, db, "job")
+		if err != nil {
+			return nil, err
+		}
+		return append(problems, duplicates...), nil
+	}
+
+	exists, err := tableExists(ctx, db, onlineTable)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return []string{fmt.Sprintf("job isn't partitioned, and %s doesn't exist", onlineTable)}, nil
+	}
+	problems, err := shapeProblems(ctx, db, onlineTable)
+	if err != nil {
+		return nil, err
+	}
+	var triggerExists bool
+	if err := db.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM pg_trigger WHERE tgrelid = 'job'::regclass AND tgname = $1)`,
+		syncTrigger).Scan(&triggerExists); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if !triggerExists {
+		problems = append(problems, fmt.Sprintf("trigger %s on job is missing, so changes to job aren't mirrored into %s", syncTrigger, onlineTable))
+	}
+	backfilled, err := isBackfilled(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	if !backfilled {
+		return append(problems, fmt.Sprintf("%s hasn't been backfilled", onlineTable)), nil
+	}
+
+	// Both tables are read from the same snapshot, so writes made while they're read, and mirrored by the trigger,
+	// are either seen in both or in neither
+	err = pgx.BeginTxFunc(ctx, db, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly}, func(tx pgx.Tx) error {
+		jobCount, jobChecksum, err := checksum(ctx, tx, "job")
+		if err != nil {
+			return err
+		}
+		onlineCount, onlineChecksum, err := checksum(ctx, tx, onlineTable)
+		if err != nil {
+			return err
+		}
+		if jobCount != onlineCount {
+			problems = append(problems, fmt.Sprintf("job has %d rows, but %s has %d", jobCount, onlineTable, onlineCount))
+		} else if jobChecksum != onlineChecksum {
+			problems = append(problems, fmt.Sprintf("the rows of job and %s differ", onlineTable))
+		}
+		duplicates, err := duplicateProblems(ctx, tx, onlineTable)
+		problems = append(problems, duplicates...)
+		return err
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return problems, nil
+}
+
+// shapeProblems describes each way in which the given table differs from the partitioned shape of job. The
+// partitions and indexes of the table are expected to be named after it.
+func shapeProblems(ctx *armadacontext.Context, db database.Querier, table string) ([]string, error) {
+	var problems []string
+
+	var strategy string
+	if err := db.QueryRow(ctx, `
+		SELECT coalesce((SELECT partstrat::text FROM pg_partitioned_table WHERE partrelid = to_regclass($1)), '')`,
+		table).Scan(&strategy); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if strategy != "l" {
+		return []string{fmt.Sprintf("%s isn't partitioned by list", table)}, nil
+	}
+
+	rows, err := db.Query(ctx, `
+		SELECT c.relname, pg_get_expr(c.relpartbound, c.oid)
+		FROM pg_inherits i JOIN pg_class c ON c.oid = i.inhrelid
+		WHERE i.inhparent = to_regclass($1)`, table)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	bounds := map[string]string{}
+	var name, bound string
+	if _, err := pgx.ForEachRow(rows, []any{&name, &bound}, func() error {
+		bounds[name] = bound
+		return nil
+	}); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(bounds) != len(expectedBounds) {
+		problems = append(problems, fmt.Sprintf("%s has %d partitions, expected %d", table, len(bounds), len(expectedBounds)))
+	}
+	for suffix, want := range expectedBounds {
+		partition := table + "_" + suffix
+		bound, ok := bounds[partition]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("partition %s is missing", partition))
+			continue
+		}
+		got := extractInts(bound)
+		slices.Sort(got)
+		if !slices.Equal(got, want) {
+			problems = append(problems, fmt.Sprintf("partition %s has states %v, expected %v", partition, got, want))
+		}
+	}
+
+	rows, err = db.Query(ctx, `
+		SELECT column_name, data_type, is_nullable = 'NO'
+		FROM information_schema.columns
+		WHERE table_name = $1 AND table_schema = current_schema()
+		ORDER BY ordinal_position`, table)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	var c column
+	var columns []column
+	if _, err := pgx.ForEachRow(rows, []any{&c.name, &c.typ, &c.notNull}, func() error {
+		columns = append(columns, c)
+		return nil
+	}); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if !slices.Equal(columns, expectedColumns) {
+		problems = append(problems, fmt.Sprintf("%s has columns %v, expected %v", table, columns, expectedColumns))
+	}
+
+	var primaryKey string
+	if err := db.QueryRow(ctx, `
+		SELECT coalesce((
+			SELECT string_agg(a.attname, ', ' ORDER BY k.ord)
+			FROM pg_constraint c
+			CROSS JOIN LATERAL unnest(c.conkey) WITH ORDINALITY AS k(attnum, ord)
+			JOIN pg_attribute a ON a.attrelid = c.conrelid AND a.attnum = k.attnum
+			WHERE c.conrelid = to_regclass($1) AND c.contype = 'p'
+		), '')`, table).Scan(&primaryKey); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if primaryKey != "job_id, state" {
+		problems = append(problems, fmt.Sprintf("%s has primary key (%s), expected (job_id, state)", table, primaryKey))
+	}
+
+	indexTables := map[string]string{fmt.Sprintf("idx_%s_%s", table, activeIndex.suffix): table + "_active"}
+	for _, index := range parentIndexes {
+		indexTables[fmt.Sprintf("idx_%s_%s", table, index.suffix)] = table
+	}
+	for index, indexTable := range indexTables {
+		var exists bool
+		if err := db.QueryRow(ctx,
+			`SELECT EXISTS (SELECT 1 FROM pg_indexes WHERE schemaname = current_schema() AND tablename = $1 AND indexname = $2)`,
+			indexTable, index).Scan(&exists); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if !exists {
+			problems = append(problems, fmt.Sprintf("index %s on %s is missing", index, indexTable))
+		}
+	}
+	slices.Sort(problems)
+	return problems, nil
+}
+
+// duplicateProblems describes each job that's in the given table more than once, which the primary key doesn't
+// prevent if the rows have different states
+func duplicateProblems(ctx *armadacontext.Context, db database.Querier, table string) ([]string, error) {
+	rows, err := db.Query(ctx, fmt.Sprintf(`SELECT job_id FROM %s GROUP BY job_id HAVING count(*) > 1 ORDER BY job_id LIMIT 100`, table))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	jobIds, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return armadaslices.Map(jobIds, func(jobId string) string {
+		return fmt.Sprintf("job %s is in %s more than once", jobId, table)
+	}), nil
+}
+
+// checksum returns the number of rows in the given table, and the sum of their hashes
+func checksum(ctx *armadacontext.Context, db database.Querier, table string) (int64, string, error) {
+	var count int64
+	var sum string
+	err := db.QueryRow(ctx, fmt.Sprintf(
+		`SELECT count(*), coalesce(sum(hashtextextended(ROW(%s)::text, 0)), 0)::text FROM %s`,
+		strings.Join(jobColumns, ", "), table),
+	).Scan(&count, &sum)
+	return count, sum, errors.WithStack(err)
+}
+
+func isPartitioned(ctx *armadacontext.Context, db database.Querier, table string) (bool, error) {
+	var partitioned bool
+	err := db.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM pg_partitioned_table WHERE partrelid = to_regclass($1))`,
+		table).Scan(&partitioned)
+	return partitioned, errors.WithStack(err)
+}
+
+func tableExists(ctx *armadacontext.Context, db database.Querier, table string) (bool, error) {
+	var exists bool
+	err := db.QueryRow(ctx, `SELECT to_regclass($1) IS NOT NULL`, table).Scan(&exists)
+	return exists, errors.WithStack(err)
+}
+
+func isBackfilled(ctx *armadacontext.Context, db database.Querier) (bool, error) {
+	var comment string
+	err := db.QueryRow(ctx, `SELECT coalesce(obj_description(to_regclass($1), 'pg_class'), '')`, onlineTable).Scan(&comment)
+	return comment == backfilledComment, errors.WithStack(err)
+}
+
+// partitionIntRegex matches the states in a partition bound such as FOR VALUES IN ('1', '2', '3', '8')
+var partitionIntRegex = regexp.MustCompile(`\d+`)
+
+func extractInts(s string) []int {
+	var result []int
+	for _, match := range partitionIntRegex.FindAllString(s, -1) {
+		n, _ := strconv.Atoi(match)
+		result = append(result, n)
+	}
+	return result
+}
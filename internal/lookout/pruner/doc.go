@@ -1,6 +1,6 @@
 // Package pruner contains the lookout database pruner.
 //
-// The pruner runs periodically and performs three tasks:
+// The pruner runs periodically and performs four tasks:
 //
 //  1. Reconciles "zombie" jobs whose state column is non-terminal but whose
 //     latest run is in a terminal state. This addresses the residue of a now-
@@ -8,9 +8,14 @@
 //     period to avoid racing in-flight state transitions and ingester lag.
 //
 //  2. Deletes terminal jobs (and their associated run, spec, and error rows)
-//     that are older than a configurable lifetime, in batches.
+//     that are older than a configurable lifetime, in batches, from the
+//     job_terminated partition of job. If an archiver is configured, each
+//     batch is archived before it is deleted.
 //
-//  3. Deletes job_deduplication rows older than a configurable lifetime.
+//  3. Deletes job_deduplication and request_deduplication rows older than a
+//     configurable lifetime.
+//
+//  4. Deletes job_statistics rows older than the job lifetime.
 //
 // Step 1 runs first so that step 2's deletion sees correct terminal states.
 package pruner
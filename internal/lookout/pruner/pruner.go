@@ -1,7 +1,6 @@
 package pruner
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
@@ -13,6 +12,14 @@ import (
 	log "github.com/armadaproject/armada/internal/common/logging"
 )
 
+// Archiver archives jobs before they are deleted
+type Archiver interface {
+	// Archive archives the jobs whose ids are in the given table, along with their runs and errors
+	Archive(ctx *armadacontext.Context, tx pgx.Tx, jobIdsTable string) error
+}
+
+// PruneDb prunes the database. If archiver isn't nil, each batch of jobs is archived before it is deleted, and the
+// batch isn't deleted if archiving it fails.
 func PruneDb(
 	ctx *armadacontext.Context,
 	db *pgx.Conn,
@@ -21,7 +28,7 @@ func PruneDb(
 	zombieRepairThreshold time.Duration,
 	batchLimit int,
 	clock clock.Clock,
-	hotColdSplit bool,
+	archiver Archiver,
 ) error {
 	var result *multierror.Error
 
@@ -31,7 +38,7 @@ func PruneDb(
 		}
 	}
 
-	if err := deleteJobs(ctx, db, jobLifetime, batchLimit, clock, hotColdSplit); err != nil {
+	if err := deleteJobs(ctx, db, jobLifetime, batchLimit, clock, archiver); err != nil {
 		result = multierror.Append(result, err)
 	}
 
@@ -39,6 +46,10 @@ func PruneDb(
 		result = multierror.Append(result, err)
 	}
 
+	if err := deleteJobStatistics(ctx, db, jobLifetime, clock); err != nil {
+		result = multierror.Append(result, err)
+	}
+
 	return result.ErrorOrNil()
 }
 
@@ -50,13 +61,34 @@ func deleteDeduplications(ctx *armadacontext.Context, db *pgx.Conn, deduplicatio
 		return errors.Wrap(err, "error deleting deduplications from postgres")
 	}
 	log.Infof("Deleted %d rows", cmdTag.RowsAffected())
+
+	log.Infof("Deleting all rows from request_deduplication older than %s", cutOffTime)
+	cmdTag, err = db.Exec(ctx, "DELETE FROM request_deduplication WHERE inserted <= $1", cutOffTime)
+	if err != nil {
+		return errors.Wrap(err, "error deleting request deduplications from postgres")
+	}
+	log.Infof("Deleted %d rows", cmdTag.RowsAffected())
+	return nil
+}
+
+// deleteJobStatistics deletes job statistics older than the job lifetime, so that statistics aren't kept for longer
+// than the jobs they count
+func deleteJobStatistics(ctx *armadacontext.Context, db *pgx.Conn, jobLifetime time.Duration, clock clock.Clock) error {
+	// Statistics are recorded in UTC
+	cutOffTime := clock.Now().UTC().Add(-jobLifetime)
+	log.Infof("Deleting all rows from job_statistics older than %s", cutOffTime)
+	cmdTag, err := db.Exec(ctx, "DELETE FROM job_statistics WHERE bucket <= $1", cutOffTime)
+	if err != nil {
+		return errors.Wrap(err, "error deleting job statistics from postgres")
+	}
+	log.Infof("Deleted %d rows", cmdTag.RowsAffected())
 	return nil
 }
 
-func deleteJobs(ctx *armadacontext.Context, db *pgx.Conn, jobLifetime time.Duration, batchLimit int, clock clock.Clock, hotColdSplit bool) error {
+func deleteJobs(ctx *armadacontext.Context, db *pgx.Conn, jobLifetime time.Duration, batchLimit int, clock clock.Clock, archiver Archiver) error {
 	now := clock.Now()
 	cutOffTime := now.Add(-jobLifetime)
-	totalJobsToDelete, err := createJobIdsToDeleteTempTable(ctx, db, cutOffTime, hotColdSplit)
+	totalJobsToDelete, err := createJobIdsToDeleteTempTable(ctx, db, cutOffTime)
 	if err != nil {
 		return errors.WithStack(err)
 	}
@@ -80,7 +112,7 @@ func deleteJobs(ctx *armadacontext.Context, db *pgx.Conn, jobLifetime time.Durat
 			AccessMode:     pgx.ReadWrite,
 			DeferrableMode: pgx.Deferrable,
 		}, func(tx pgx.Tx) error {
-			batchSize, err = deleteBatch(ctx, tx, batchLimit)
+			batchSize, err = deleteBatch(ctx, tx, batchLimit, archiver)
 			if err != nil {
 				return err
 			}
@@ -102,28 +134,18 @@ func deleteJobs(ctx *armadacontext.Context, db *pgx.Conn, jobLifetime time.Durat
 	return nil
 }
 
-// Returns total number of jobs to delete
-func createJobIdsToDeleteTempTable(ctx *armadacontext.Context, db *pgx.Conn, cutOffTime time.Time, hotColdSplit bool) (int, error) {
-	table := "job"
-	if hotColdSplit {
-		table = "job_terminated"
-	}
-
-	// Using interpolation for table name as parameterized queries do not allow it. The table name is controlled by us and not user input, so this is safe.
-	query := fmt.Sprintf(`
+// Returns total number of jobs to delete. Only terminated jobs are deleted, so they're found in the job_terminated
+// partition without reading the active jobs.
+func createJobIdsToDeleteTempTable(ctx *armadacontext.Context, db *pgx.Conn, cutOffTime time.Time) (int, error) {
+	_, err := db.Exec(ctx, `
 		CREATE TEMP TABLE job_ids_to_delete AS (
-			SELECT job_id FROM %s
+			SELECT job_id FROM job_terminated
 			WHERE last_transition_time < $1
-			AND state in (
-				4, -- Succeeded
-		   		5, -- Failed
-		   		6, -- Cancelled
-		   		7, -- Preempted
-		   		9  -- Rejected
-		    )
-		)`, table)
-
-	_, err := db.Exec(ctx, query, cutOffTime)
+		)`, cutOffTime)
+	if err != nil {
+		return -1, errors.WithStack(err)
+	}
+	_, err = db.Exec(ctx, "CREATE INDEX ON job_ids_to_delete (job_id)")
 	if err != nil {
 		return -1, errors.WithStack(err)
 	}
@@ -135,8 +157,9 @@ func createJobIdsToDeleteTempTable(ctx *armadacontext.Context, db *pgx.Conn, cut
 	return totalJobsToDelete, nil
 }
 
-func deleteBatch(ctx *armadacontext.Context, tx pgx.Tx, batchLimit int) (int, error) {
-	_, err := tx.Exec(ctx, "INSERT INTO batch (job_id) SELECT job_id FROM job_ids_to_delete LIMIT $1;", batchLimit)
+func deleteBatch(ctx *armadacontext.Context, tx pgx.Tx, batchLimit int, archiver Archiver) (int, error) {
+	// Batches are taken in job id order, so that if deleting a batch fails, the next attempt archives the same batch
+	_, err := tx.Exec(ctx, "INSERT INTO batch (job_id) SELECT job_id FROM job_ids_to_delete ORDER BY job_id LIMIT $1;", batchLimit)
 	if err != nil {
 		return -1, err
 	}
@@ -148,11 +171,17 @@ func deleteBatch(ctx *armadacontext.Context, tx pgx.Tx, batchLimit int) (int, er
 	if batchSize == 0 {
 		return 0, nil
 	}
+	if archiver != nil {
+		if err := archiver.Archive(ctx, tx, "batch"); err != nil {
+			return -1, errors.Wrap(err, "error archiving batch")
+		}
+	}
 	_, err = tx.Exec(ctx, `
-		DELETE FROM job WHERE job_id in (SELECT job_id from batch);
+		DELETE FROM job_terminated WHERE job_id in (SELECT job_id from batch);
 		DELETE FROM job_spec WHERE job_id in (SELECT job_id from batch);
 		DELETE FROM job_run WHERE job_id in (SELECT job_id from batch);
 		DELETE FROM job_error WHERE job_id in (SELECT job_id from batch);
+		DELETE FROM job_run_error_search WHERE job_id in (SELECT job_id from batch);
 		DELETE FROM job_ids_to_delete WHERE job_id in (SELECT job_id from batch);
 		TRUNCATE TABLE batch;`)
 	if err != nil {
@@ -6,15 +6,18 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/assert"
 	clock "k8s.io/utils/clock/testing"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/blobstore"
 	"github.com/armadaproject/armada/internal/common/compress"
 	"github.com/armadaproject/armada/internal/common/database/lookout"
 	"github.com/armadaproject/armada/internal/common/slices"
 	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/internal/lookout/archive"
 	"github.com/armadaproject/armada/internal/lookout/repository"
 	"github.com/armadaproject/armada/internal/lookoutingester/instructions"
 	"github.com/armadaproject/armada/internal/lookoutingester/lookoutdb"
@@ -35,8 +38,8 @@ func TestPruneDb(t *testing.T) {
 		expireAfter          time.Duration
 		jobs                 []testJob
 		jobIdsLeft           []string
-		activeJobIdsLeft     []string // HC only: when non-nil, assert job_active contains exactly these
-		terminatedJobIdsLeft []string // HC only: when non-nil, assert job_terminated contains exactly these
+		activeJobIdsLeft     []string // when non-nil, assert job_active contains exactly these
+		terminatedJobIdsLeft []string // when non-nil, assert job_terminated contains exactly these
 		jobErrorIdsLeft      []string // when non-nil, assert job_error contains exactly these
 	}
 
@@ -133,7 +136,7 @@ func TestPruneDb(t *testing.T) {
 			jobIdsLeft: sampleJobIds[50:],
 		},
 		{
-			testName:    "delete from job_terminated when hot cold split enabled",
+			testName:    "delete from job_terminated",
 			expireAfter: 10 * time.Hour,
 			jobs: []testJob{
 				{
@@ -221,8 +224,7 @@ func TestPruneDb(t *testing.T) {
 
 				dbConn, err := db.Acquire(ctx)
 				assert.NoError(t, err)
-				isHC := isHotColdSchema(ctx, db)
-				err = PruneDb(ctx, dbConn.Conn(), tc.expireAfter, 0, 0, 10, clock.NewFakeClock(baseTime), isHC)
+				err = PruneDb(ctx, dbConn.Conn(), tc.expireAfter, 0, 0, 10, clock.NewFakeClock(baseTime), nil)
 				assert.NoError(t, err)
 
 				queriedJobIdsPerTable := []map[string]bool{
@@ -238,13 +240,11 @@ func TestPruneDb(t *testing.T) {
 					}
 				}
 
-				if isHC {
-					if tc.activeJobIdsLeft != nil {
-						assertJobIds(t, db, "SELECT job_id FROM job_active", tc.activeJobIdsLeft)
-					}
-					if tc.terminatedJobIdsLeft != nil {
-						assertJobIds(t, db, "SELECT job_id FROM job_terminated", tc.terminatedJobIdsLeft)
-					}
+				if tc.activeJobIdsLeft != nil {
+					assertJobIds(t, db, "SELECT job_id FROM job_active", tc.activeJobIdsLeft)
+				}
+				if tc.terminatedJobIdsLeft != nil {
+					assertJobIds(t, db, "SELECT job_id FROM job_terminated", tc.terminatedJobIdsLeft)
 				}
 				if tc.jobErrorIdsLeft != nil {
 					assertJobIds(t, db, "SELECT job_id FROM job_error", tc.jobErrorIdsLeft)
@@ -257,6 +257,64 @@ func TestPruneDb(t *testing.T) {
 	}
 }
 
+func TestPruneDb_Deduplications(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Minute)
+		defer cancel()
+
+		_, err := db.Exec(ctx, `
+			INSERT INTO job_deduplication (deduplication_id, job_id, inserted) VALUES
+				('queue:expired', 'job-1', $1),
+				('queue:live', 'job-2', $2)`,
+			baseTime.Add(-2*time.Hour), baseTime.Add(-30*time.Minute))
+		assert.NoError(t, err)
+		_, err = db.Exec(ctx, `
+			INSERT INTO request_deduplication (deduplication_id, request_hash, result, inserted) VALUES
+				('queue:cancel-jobs:expired', 'hash', '', $1),
+				('queue:cancel-jobs:live', 'hash', '', $2)`,
+			baseTime.Add(-2*time.Hour), baseTime.Add(-30*time.Minute))
+		assert.NoError(t, err)
+
+		dbConn, err := db.Acquire(ctx)
+		assert.NoError(t, err)
+		defer dbConn.Release()
+		err = PruneDb(ctx, dbConn.Conn(), 24*time.Hour, time.Hour, 0, 10, clock.NewFakeClock(baseTime), nil)
+		assert.NoError(t, err)
+
+		assertJobIds(t, db, "SELECT deduplication_id FROM job_deduplication", []string{"queue:live"})
+		assertJobIds(t, db, "SELECT deduplication_id FROM request_deduplication", []string{"queue:cancel-jobs:live"})
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestPruneDb_JobStatistics(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Minute)
+		defer cancel()
+
+		_, err := db.Exec(ctx, `
+			INSERT INTO job_statistics (
+				resolution, bucket, queue, pool, priority_class, queued, leased, pending, running, running_cpu,
+				running_memory, running_gpu
+			) VALUES
+				(60, $1, 'expired', '', '', 1, 0, 0, 0, 0, 0, 0),
+				(60, $2, 'live', '', '', 1, 0, 0, 0, 0, 0, 0)`,
+			baseTime.Add(-25*time.Hour), baseTime.Add(-23*time.Hour))
+		assert.NoError(t, err)
+
+		dbConn, err := db.Acquire(ctx)
+		assert.NoError(t, err)
+		defer dbConn.Release()
+		err = PruneDb(ctx, dbConn.Conn(), 24*time.Hour, time.Hour, 0, 10, clock.NewFakeClock(baseTime), nil)
+		assert.NoError(t, err)
+
+		assertJobIds(t, db, "SELECT queue FROM job_statistics", []string{"live"})
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
 func storeJob(job testJob, db *lookoutdb.LookoutDb, converter *instructions.InstructionConverter) {
 	runId := uuid.NewString()
 	simulator := repository.NewJobSimulator(converter, db).
@@ -302,16 +360,6 @@ func storeJob(job testJob, db *lookoutdb.LookoutDb, converter *instructions.Inst
 	}
 }
 
-func isHotColdSchema(ctx *armadacontext.Context, db *pgxpool.Pool) bool {
-	var exists bool
-	err := db.QueryRow(ctx, `
-		SELECT EXISTS (
-			SELECT 1 FROM information_schema.tables
-			WHERE table_name = 'job_active'
-		)`).Scan(&exists)
-	return err == nil && exists
-}
-
 func assertJobIds(t *testing.T, db *pgxpool.Pool, query string, expected []string) {
 	t.Helper()
 	got := selectStringSet(t, db, query)
@@ -335,3 +383,66 @@ func selectStringSet(t *testing.T, db *pgxpool.Pool, query string) map[string]bo
 	}
 	return util.StringListToSet(ss)
 }
+
+func TestPruneDb_ArchivesJobsBeforeDeleting(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		converter := instructions.NewInstructionConverter(metrics.Get().Metrics, "armadaproject.io/", []string{}, &compress.NoOpCompressor{})
+		store := lookoutdb.NewLookoutDb(db, nil, metrics.Get(), 10, 10)
+
+		ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Minute)
+		defer cancel()
+		expired := baseTime.Add(-11 * time.Hour)
+		jobIds := []string{util.NewULID(), util.NewULID(), util.NewULID()}
+		storeJob(testJob{jobId: jobIds[0], ts: expired, state: lookout.JobSucceeded}, store, converter)
+		storeJob(testJob{jobId: jobIds[1], ts: expired, state: lookout.JobFailed}, store, converter)
+		storeJob(testJob{jobId: jobIds[2], ts: baseTime, state: lookout.JobSucceeded}, store, converter)
+
+		dbConn, err := db.Acquire(ctx)
+		assert.NoError(t, err)
+		defer dbConn.Release()
+		archiveStore, err := blobstore.NewFilesystemBlobStore(t.TempDir())
+		assert.NoError(t, err)
+		archiver := archive.NewArchiver(archiveStore, &compress.NoOpDecompressor{})
+		err = PruneDb(ctx, dbConn.Conn(), 10*time.Hour, 0, 0, 10, clock.NewFakeClock(baseTime), archiver)
+		assert.NoError(t, err)
+
+		assertJobIds(t, db, "SELECT job_id FROM job", []string{jobIds[2]})
+		archived, err := archive.Search(ctx, archiveStore, archive.Query{Queue: "queue", From: expired, To: baseTime})
+		assert.NoError(t, err)
+		archivedJobIds := slices.Map(archived, func(job *archive.ArchivedJob) string { return job.JobId })
+		assert.ElementsMatch(t, []string{jobIds[0], jobIds[1]}, archivedJobIds)
+		for _, job := range archived {
+			assert.Len(t, job.Runs, 1)
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+type failingArchiver struct{}
+
+func (failingArchiver) Archive(_ *armadacontext.Context, _ pgx.Tx, _ string) error {
+	return fmt.Errorf("store is unavailable")
+}
+
+func TestPruneDb_KeepsJobsThatFailToArchive(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		converter := instructions.NewInstructionConverter(metrics.Get().Metrics, "armadaproject.io/", []string{}, &compress.NoOpCompressor{})
+		store := lookoutdb.NewLookoutDb(db, nil, metrics.Get(), 10, 10)
+
+		ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Minute)
+		defer cancel()
+		jobId := util.NewULID()
+		storeJob(testJob{jobId: jobId, ts: baseTime.Add(-11 * time.Hour), state: lookout.JobSucceeded}, store, converter)
+
+		dbConn, err := db.Acquire(ctx)
+		assert.NoError(t, err)
+		defer dbConn.Release()
+		err = PruneDb(ctx, dbConn.Conn(), 10*time.Hour, 0, 0, 10, clock.NewFakeClock(baseTime), failingArchiver{})
+		assert.Error(t, err)
+
+		assertJobIds(t, db, "SELECT job_id FROM job", []string{jobId})
+		return nil
+	})
+	assert.NoError(t, err)
+}
@@ -11,6 +11,32 @@ import (
 	"github.com/armadaproject/armada/internal/lookout/model"
 )
 
+// Keys of aggregates made of several values, e.g., the total and average of a resource
+const (
+	totalAggregateKey   = "total"
+	averageAggregateKey = "average"
+	secondsAggregateKey = "seconds"
+
+	// nestedAggregateSeparator separates the field from the key in the fields of parsers of nested aggregates
+	nestedAggregateSeparator = "."
+)
+
+var runtimePercentiles = []int{50, 90, 99}
+
+func percentileAggregateKey(percentile int) string {
+	return fmt.Sprintf("p%d", percentile)
+}
+
+// nestedAggregateName returns the SQL alias of one value of an aggregate made of several values
+func nestedAggregateName(col string, key string) string {
+	return fmt.Sprintf("%s_%s", col, key)
+}
+
+// nestedAggregateField returns the parser field of one value of an aggregate made of several values
+func nestedAggregateField(field string, key string) string {
+	return field + nestedAggregateSeparator + key
+}
+
 type QueryAggregator interface {
 	AggregateSql() (string, error)
 }
@@ -35,6 +61,23 @@ func (qa *SqlFunctionAggregator) AggregateSql() (string, error) {
 	return fmt.Sprintf("%s(%s.%s) AS %s", qa.sqlFunction, qa.queryCol.abbrev, qa.queryCol.name, qa.aggregateColName()), nil
 }
 
+// ExpressionAggregator aggregates an arbitrary SQL expression, so that several aggregates can be computed on the same column
+type ExpressionAggregator struct {
+	expression string
+	name       string
+}
+
+func NewExpressionAggregator(expression string, name string) *ExpressionAggregator {
+	return &ExpressionAggregator{
+		expression: expression,
+		name:       name,
+	}
+}
+
+func (qa *ExpressionAggregator) AggregateSql() (string, error) {
+	return fmt.Sprintf("%s AS %s", qa.expression, qa.name), nil
+}
+
 type StateCountAggregator struct {
 	queryCol    *queryColumn
 	stateString string
@@ -77,11 +120,46 @@ func GetAggregatorsForColumn(queryCol *queryColumn, aggregateType AggregateType,
 		return aggregators, nil
 	case Min:
 		return []QueryAggregator{NewSqlFunctionAggregator(queryCol, "MIN")}, nil
+	case FailureCount:
+		return []QueryAggregator{NewSqlFunctionAggregator(queryCol, "COUNT")}, nil
+	case ResourceTotals:
+		col := fmt.Sprintf("%s.%s", queryCol.abbrev, queryCol.name)
+		runtime := fmt.Sprintf("%s.%s", jobRunTableAbbrev, runtimeCol)
+		return []QueryAggregator{
+			NewExpressionAggregator(fmt.Sprintf("SUM(%s)::bigint", col), nestedAggregateName(queryCol.name, totalAggregateKey)),
+			NewExpressionAggregator(fmt.Sprintf("AVG(%s)::double precision", col), nestedAggregateName(queryCol.name, averageAggregateKey)),
+			NewExpressionAggregator(
+				fmt.Sprintf("COALESCE(SUM(%s), 0)::double precision", terminalJobsOnly(fmt.Sprintf("%s * %s", col, runtime))),
+				nestedAggregateName(queryCol.name, secondsAggregateKey),
+			),
+		}, nil
+	case RuntimePercentiles:
+		runtime := terminalJobsOnly(fmt.Sprintf("%s.%s", queryCol.abbrev, queryCol.name))
+		aggregators := []QueryAggregator{
+			NewExpressionAggregator(fmt.Sprintf("COALESCE(SUM(%s), 0)::double precision", runtime), nestedAggregateName(queryCol.name, totalAggregateKey)),
+		}
+		for _, percentile := range runtimePercentiles {
+			aggregators = append(aggregators, NewExpressionAggregator(
+				fmt.Sprintf("percentile_cont(%.2f) WITHIN GROUP (ORDER BY %s)", float64(percentile)/100, runtime),
+				nestedAggregateName(queryCol.name, percentileAggregateKey(percentile)),
+			))
+		}
+		return aggregators, nil
 	default:
 		return nil, errors.Errorf("cannot determine aggregate type: %v", aggregateType)
 	}
 }
 
+// terminalJobsOnly returns SQL evaluating to the given expression for terminal jobs, and to null otherwise
+func terminalJobsOnly(expression string) string {
+	return fmt.Sprintf(
+		"CASE WHEN %s.%s IN (%d, %d, %d, %d) THEN %s END",
+		jobTableAbbrev, stateCol,
+		lookout.JobSucceededOrdinal, lookout.JobFailedOrdinal, lookout.JobCancelledOrdinal, lookout.JobPreemptedOrdinal,
+		expression,
+	)
+}
+
 // GetStatesForFilter returns a list of states as string if filter for state exists
 // Will always return the states in the same order, irrespective of the ordering of the states in the filter
 func GetStatesForFilter(filters []*model.Filter) []string {
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/lookout/model"
+)
+
+// Types of order values in continuation tokens. The type is recorded so that the value can be decoded back into the
+// Go type it was scanned as, rather than whatever type JSON decodes it to.
+const (
+	orderValueString = "string"
+	orderValueInt    = "int"
+	orderValueFloat  = "float"
+	orderValueTime   = "time"
+)
+
+// continuationToken is encoded as JSON in the opaque tokens returned with pages of jobs. As well as the cursor of the
+// last job of the page, it records the order the page was fetched with, so that it can't be used with another order.
+type continuationToken struct {
+	Field          string          `json:"field"`
+	Direction      string          `json:"direction"`
+	OrderValueType string          `json:"orderValueType,omitempty"`
+	OrderValue     json.RawMessage `json:"orderValue,omitempty"`
+	JobId          string          `json:"jobId"`
+}
+
+func encodeContinuationToken(order *model.Order, cursor *jobsCursor) (string, error) {
+	token := continuationToken{
+		Field:     order.Field,
+		Direction: order.Direction,
+		JobId:     cursor.JobId,
+	}
+	var value interface{}
+	switch v := cursor.OrderValue.(type) {
+	case nil:
+	case string:
+		token.OrderValueType, value = orderValueString, v
+	case int16:
+		token.OrderValueType, value = orderValueInt, int64(v)
+	case int32:
+		token.OrderValueType, value = orderValueInt, int64(v)
+	case int64:
+		token.OrderValueType, value = orderValueInt, v
+	case float64:
+		token.OrderValueType, value = orderValueFloat, v
+	case time.Time:
+		token.OrderValueType, value = orderValueTime, v.Format(time.RFC3339Nano)
+	default:
+		return "", errors.Errorf("cannot continue after order value of type %T", cursor.OrderValue)
+	}
+	if value != nil {
+		b, err := json.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+		token.OrderValue = b
+	}
+	b, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodeContinuationToken(encoded string, order *model.Order) (*jobsCursor, error) {
+	invalid := errors.New("continuation token is invalid")
+	b, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, invalid
+	}
+	var token continuationToken
+	if err := json.Unmarshal(b, &token); err != nil || token.JobId == "" {
+		return nil, invalid
+	}
+	if token.Field != order.Field || token.Direction != order.Direction {
+		return nil, errors.Errorf(
+			"continuation token was returned for jobs ordered by %s %s, not %s %s",
+			token.Field, token.Direction, order.Field, order.Direction,
+		)
+	}
+
+	cursor := &jobsCursor{JobId: token.JobId}
+	switch token.OrderValueType {
+	case "":
+		return cursor, nil
+	case orderValueString:
+		var v string
+		err = json.Unmarshal(token.OrderValue, &v)
+		cursor.OrderValue = v
+	case orderValueInt:
+		var v int64
+		err = json.Unmarshal(token.OrderValue, &v)
+		cursor.OrderValue = v
+	case orderValueFloat:
+		var v float64
+		err = json.Unmarshal(token.OrderValue, &v)
+		cursor.OrderValue = v
+	case orderValueTime:
+		var s string
+		if err = json.Unmarshal(token.OrderValue, &s); err == nil {
+			cursor.OrderValue, err = time.Parse(time.RFC3339Nano, s)
+		}
+	default:
+		return nil, invalid
+	}
+	if err != nil {
+		return nil, invalid
+	}
+	return cursor, nil
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/lookout/model"
+)
+
+func TestContinuationToken_RoundTrip(t *testing.T) {
+	order := &model.Order{Field: "submitted", Direction: model.DirectionDesc}
+	tests := map[string]struct {
+		orderValue interface{}
+		expected   interface{}
+	}{
+		"null":     {orderValue: nil, expected: nil},
+		"string":   {orderValue: "queue-a", expected: "queue-a"},
+		"smallint": {orderValue: int16(3), expected: int64(3)},
+		"int":      {orderValue: int32(-137), expected: int64(-137)},
+		"bigint":   {orderValue: int64(1) << 40, expected: int64(1) << 40},
+		"float":    {orderValue: 12.345678901234567, expected: 12.345678901234567},
+		"time": {
+			orderValue: time.Date(2024, 3, 1, 12, 0, 0, 123456000, time.UTC),
+			expected:   time.Date(2024, 3, 1, 12, 0, 0, 123456000, time.UTC),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			token, err := encodeContinuationToken(order, &jobsCursor{OrderValue: tc.orderValue, JobId: "job-1"})
+			require.NoError(t, err)
+
+			cursor, err := decodeContinuationToken(token, order)
+			require.NoError(t, err)
+			assert.Equal(t, &jobsCursor{OrderValue: tc.expected, JobId: "job-1"}, cursor)
+		})
+	}
+}
+
+func TestContinuationToken_RejectsOtherOrders(t *testing.T) {
+	token, err := encodeContinuationToken(
+		&model.Order{Field: "submitted", Direction: model.DirectionDesc},
+		&jobsCursor{OrderValue: time.Now(), JobId: "job-1"},
+	)
+	require.NoError(t, err)
+
+	_, err = decodeContinuationToken(token, &model.Order{Field: "submitted", Direction: model.DirectionAsc})
+	assert.Error(t, err)
+
+	_, err = decodeContinuationToken(token, &model.Order{Field: "lastTransitionTime", Direction: model.DirectionDesc})
+	assert.Error(t, err)
+}
+
+func TestContinuationToken_RejectsInvalidTokens(t *testing.T) {
+	order := &model.Order{Field: "jobId", Direction: model.DirectionAsc}
+	for _, token := range []string{"not a token", "e30", "eyJqb2JJZCI6ImpvYi0xIn0"} {
+		_, err := decodeContinuationToken(token, order)
+		assert.Error(t, err, token)
+	}
+}
@@ -2,8 +2,33 @@ package repository
 
 import (
 	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
+// invalidRegularExpression is the SQLSTATE Postgres returns when a regex filter is not a valid ARE
+const invalidRegularExpression = "2201B"
+
 // ErrNotFound is returned by repository methods when the requested entity
 // does not exist in the database.
 var ErrNotFound = errors.New("not found")
+
+// ErrPermissionDenied is returned by repository methods when the requested
+// entity exists but the user is not allowed to change it.
+var ErrPermissionDenied = errors.New("permission denied")
+
+// ErrInvalidRegex is returned by repository methods when Postgres rejects a
+// regex filter that passed validation, e.g. because it uses RE2 syntax that
+// Postgres' regex flavour does not support.
+var ErrInvalidRegex = errors.New("invalid regex")
+
+// queryError translates errors Postgres returns for invalid user input into
+// repository errors, and returns any other error unchanged.
+func queryError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == invalidRegularExpression {
+		return fmt.Errorf("%w: %s", ErrInvalidRegex, pgErr.Message)
+	}
+	return err
+}
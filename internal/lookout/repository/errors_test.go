@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryError(t *testing.T) {
+	invalidRegex := &pgconn.PgError{Code: "2201B", Message: "invalid regular expression: quantifier operand invalid"}
+	err := queryError(fmt.Errorf("query failed: %w", invalidRegex))
+	assert.ErrorIs(t, err, ErrInvalidRegex)
+	assert.Equal(t, "invalid regex: invalid regular expression: quantifier operand invalid", err.Error())
+
+	otherErr := &pgconn.PgError{Code: "57014", Message: "canceling statement due to statement timeout"}
+	assert.Equal(t, otherErr, queryError(otherErr))
+}
@@ -110,12 +110,54 @@ func (fp *NullStringParser) ParseValue() (interface{}, error) {
 	return nil, nil
 }
 
+type NullInt32Parser struct {
+	field    string
+	variable sql.NullInt32
+}
+
+func (fp *NullInt32Parser) GetField() string {
+	return fp.field
+}
+
+func (fp *NullInt32Parser) GetVariableRef() interface{} {
+	return &fp.variable
+}
+
+func (fp *NullInt32Parser) ParseValue() (interface{}, error) {
+	if fp.variable.Valid {
+		return fp.variable.Int32, nil
+	}
+	return nil, nil
+}
+
+type NullFloat64Parser struct {
+	field    string
+	variable sql.NullFloat64
+}
+
+func (fp *NullFloat64Parser) GetField() string {
+	return fp.field
+}
+
+func (fp *NullFloat64Parser) GetVariableRef() interface{} {
+	return &fp.variable
+}
+
+func (fp *NullFloat64Parser) ParseValue() (interface{}, error) {
+	if fp.variable.Valid {
+		return fp.variable.Float64, nil
+	}
+	return nil, nil
+}
+
 func ParserForGroup(field string) FieldParser {
 	switch field {
 	case stateField:
 		return &StateParser{}
-	case clusterField, nodeField, poolField:
+	case clusterField, nodeField, poolField, failureCategoryField, failureSubcategoryField:
 		return &NullStringParser{field: field}
+	case exitCodeField:
+		return &NullInt32Parser{field: field}
 	default:
 		return &BasicParser[string]{field: field}
 	}
@@ -133,6 +175,19 @@ func ParsersForAggregate(field string, filters []*model.Filter) ([]FieldParser,
 		for _, state := range states {
 			parsers = append(parsers, &BasicParser[int]{field: fmt.Sprintf("%s%s", stateAggregatePrefix, state)})
 		}
+	case failureCategoryField:
+		parsers = append(parsers, &BasicParser[int64]{field: failureCategoryField})
+	case cpuField, memoryField, gpuField:
+		parsers = append(parsers,
+			&BasicParser[int64]{field: nestedAggregateField(field, totalAggregateKey)},
+			&BasicParser[float64]{field: nestedAggregateField(field, averageAggregateKey)},
+			&BasicParser[float64]{field: nestedAggregateField(field, secondsAggregateKey)},
+		)
+	case runtimeField:
+		parsers = append(parsers, &BasicParser[float64]{field: nestedAggregateField(field, totalAggregateKey)})
+		for _, percentile := range runtimePercentiles {
+			parsers = append(parsers, &NullFloat64Parser{field: nestedAggregateField(field, percentileAggregateKey(percentile))})
+		}
 	default:
 		return nil, errors.Errorf("no aggregate found for field %s", field)
 	}
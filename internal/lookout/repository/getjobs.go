@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"k8s.io/utils/clock"
 
@@ -27,6 +28,14 @@ type SqlGetJobsRepository struct {
 
 type GetJobsResult struct {
 	Jobs []*model.Job
+	// ContinuationToken is set by GetJobsAfter if the page of jobs is full, to fetch the next page with
+	ContinuationToken string
+}
+
+// jobsCursor identifies the last job of a page of jobs, so that the next page can start after it without an OFFSET
+type jobsCursor struct {
+	OrderValue interface{}
+	JobId      string
 }
 
 type jobRow struct {
@@ -49,6 +58,7 @@ type jobRow struct {
 	latestRunId        sql.NullString
 	cancelReason       sql.NullString
 	cancelUser         sql.NullString
+	suspended          bool
 }
 
 func NewSqlGetJobsRepository(db *pgxpool.Pool) *SqlGetJobsRepository {
@@ -81,63 +91,187 @@ func (r *SqlGetJobsRepository) getJobs(ctx *armadacontext.Context, filters []*mo
 	queryDuration := time.Since(queryStart)
 	if err != nil {
 		logQueryError(user, query, "GetJobs", queryDuration, err)
-		return nil, err
+		return nil, queryError(err)
 	}
 	logSlowQuery(ctx, user, query, "GetJobs", queryDuration)
 
 	defer rows.Close()
 	for rows.Next() {
-		var row jobRow
-		var annotations sql.NullString
-		var runs sql.NullString
-		if err := rows.Scan(
-			&row.jobId,
-			&row.queue,
-			&row.owner,
-			&row.namespace,
-			&row.jobSet,
-			&row.cpu,
-			&row.memory,
-			&row.ephemeralStorage,
-			&row.gpu,
-			&row.priority,
-			&row.submitted,
-			&row.cancelled,
-			&row.state,
-			&row.lastTransitionTime,
-			&row.duplicate,
-			&row.priorityClass,
-			&row.latestRunId,
-			&row.cancelReason,
-			&row.cancelUser,
-			&annotations,
-			&runs,
-		); err != nil {
+		job, err := r.scanJob(rows)
+		if err != nil {
 			return nil, err
 		}
+		jobs = append(jobs, job)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, queryError(err)
+	}
+	return &GetJobsResult{Jobs: jobs}, nil
+}
 
-		job := jobRowToModel(&row)
-		if annotations.Valid {
-			if err := json.Unmarshal([]byte(annotations.String), &job.Annotations); err != nil {
-				return nil, err
-			}
+// GetJobsAfter returns the page of jobs after the one the continuation token was returned with, or the first page if
+// the token is empty. Unlike GetJobs, pages are fetched with keyset pagination on the order column and job id, so that
+// fetching a page deep into the results is as cheap as fetching the first, and each page starts after the last job of
+// the previous one even if jobs before it have since changed.
+func (r *SqlGetJobsRepository) GetJobsAfter(
+	ctx *armadacontext.Context,
+	filters []*model.Filter,
+	activeJobSets bool,
+	order *model.Order,
+	continuationToken string,
+	take int,
+) (*GetJobsResult, error) {
+	order = keysetOrder(order)
+	var after *jobsCursor
+	if continuationToken != "" {
+		cursor, err := decodeContinuationToken(continuationToken, order)
+		if err != nil {
+			return nil, err
+		}
+		after = cursor
+	}
+	jobs, cursor, err := r.getJobsPage(ctx, filters, activeJobSets, order, after, take)
+	if err != nil {
+		return nil, err
+	}
+	result := &GetJobsResult{Jobs: jobs}
+	if take > 0 && len(jobs) == take {
+		result.ContinuationToken, err = encodeContinuationToken(order, cursor)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// ExportJobs passes the jobs matching the filters to fn in order, one page of at most pageSize jobs at a time, until
+// there are no more jobs or maxRows jobs have been exported. Pages are fetched with keyset pagination on the order
+// column and job id, so that fetching a page deep into the results is as cheap as fetching the first.
+func (r *SqlGetJobsRepository) ExportJobs(
+	ctx *armadacontext.Context,
+	filters []*model.Filter,
+	activeJobSets bool,
+	order *model.Order,
+	maxRows int,
+	pageSize int,
+	fn func(jobs []*model.Job) error,
+) error {
+	var after *jobsCursor
+	for exported := 0; exported < maxRows; {
+		take := min(pageSize, maxRows-exported)
+		jobs, cursor, err := r.getJobsPage(ctx, filters, activeJobSets, order, after, take)
+		if err != nil {
+			return err
 		}
-		if runs.Valid {
-			if err := json.Unmarshal([]byte(runs.String), &job.Runs); err != nil {
-				return nil, err
+		if len(jobs) > 0 {
+			if err := fn(jobs); err != nil {
+				return err
 			}
 		}
-		if len(job.Runs) > 0 {
-			lastRun := job.Runs[len(job.Runs)-1] // Get the last run
-			job.Node = lastRun.Node
-			job.Cluster = lastRun.Cluster
-			job.Pool = lastRun.Pool
-			job.ExitCode = lastRun.ExitCode
-			job.RuntimeSeconds = calculateJobRuntime(lastRun.Started, lastRun.Finished, r.clock)
+		if len(jobs) < take {
+			return nil
+		}
+		exported += len(jobs)
+		after = cursor
+	}
+	return nil
+}
+
+// getJobsPage returns the page of jobs after the given cursor, along with the cursor of the last job in the page
+func (r *SqlGetJobsRepository) getJobsPage(
+	ctx *armadacontext.Context,
+	filters []*model.Filter,
+	activeJobSets bool,
+	order *model.Order,
+	after *jobsCursor,
+	take int,
+) ([]*model.Job, *jobsCursor, error) {
+	user := auth.GetPrincipal(ctx).GetName()
+	query, err := NewQueryBuilder(r.lookoutTables).GetJobsPage(filters, activeJobSets, order, after, take)
+	if err != nil {
+		return nil, nil, err
+	}
+	logQueryDebug(user, query, "GetJobsPage")
+
+	queryStart := time.Now()
+	rows, err := r.db.Query(ctx, query.Sql, query.Args...)
+	queryDuration := time.Since(queryStart)
+	if err != nil {
+		logQueryError(user, query, "GetJobsPage", queryDuration, err)
+		return nil, nil, queryError(err)
+	}
+	logSlowQuery(ctx, user, query, "GetJobsPage", queryDuration)
+
+	defer rows.Close()
+	var jobs []*model.Job
+	var cursor *jobsCursor
+	for rows.Next() {
+		var orderValue interface{}
+		job, err := r.scanJob(rows, &orderValue)
+		if err != nil {
+			return nil, nil, err
 		}
 		jobs = append(jobs, job)
+		cursor = &jobsCursor{OrderValue: orderValue, JobId: job.JobId}
 	}
-	return &GetJobsResult{Jobs: jobs}, nil
+	if err := rows.Err(); err != nil {
+		return nil, nil, queryError(err)
+	}
+	return jobs, cursor, nil
+}
+
+// scanJob scans a job selected by a GetJobs query, followed by any extra columns selected after the job's runs
+func (r *SqlGetJobsRepository) scanJob(rows pgx.Rows, extra ...interface{}) (*model.Job, error) {
+	var row jobRow
+	var annotations sql.NullString
+	var runs sql.NullString
+	if err := rows.Scan(append([]interface{}{
+		&row.jobId,
+		&row.queue,
+		&row.owner,
+		&row.namespace,
+		&row.jobSet,
+		&row.cpu,
+		&row.memory,
+		&row.ephemeralStorage,
+		&row.gpu,
+		&row.priority,
+		&row.submitted,
+		&row.cancelled,
+		&row.state,
+		&row.lastTransitionTime,
+		&row.duplicate,
+		&row.priorityClass,
+		&row.latestRunId,
+		&row.cancelReason,
+		&row.cancelUser,
+		&row.suspended,
+		&annotations,
+		&runs,
+	}, extra...)...); err != nil {
+		return nil, err
+	}
+
+	job := jobRowToModel(&row)
+	if annotations.Valid {
+		if err := json.Unmarshal([]byte(annotations.String), &job.Annotations); err != nil {
+			return nil, err
+		}
+	}
+	if runs.Valid {
+		if err := json.Unmarshal([]byte(runs.String), &job.Runs); err != nil {
+			return nil, err
+		}
+	}
+	if len(job.Runs) > 0 {
+		lastRun := job.Runs[len(job.Runs)-1] // Get the last run
+		job.Node = lastRun.Node
+		job.Cluster = lastRun.Cluster
+		job.Pool = lastRun.Pool
+		job.ExitCode = lastRun.ExitCode
+		job.RuntimeSeconds = calculateJobRuntime(lastRun.Started, lastRun.Finished, r.clock)
+	}
+	return job, nil
 }
 
 func calculateJobRuntime(started, finished *model.PostgreSQLTime, clock clock.Clock) int32 {
@@ -181,5 +315,6 @@ func jobRowToModel(row *jobRow) *model.Job {
 		Submitted:          row.submitted,
 		CancelReason:       database.ParseNullString(row.cancelReason),
 		CancelUser:         database.ParseNullString(row.cancelUser),
+		Suspended:          row.suspended,
 	}
 }
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"fmt"
+	"slices"
 	"testing"
 	"time"
 
@@ -129,6 +130,40 @@ func TestGetJobsMultipleRuns(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGetJobsSuspended(t *testing.T) {
+	err := withGetJobsSetup(func(converter *instructions.InstructionConverter, store *lookoutdb.LookoutDb, repo *SqlGetJobsRepository, testClock *clock.FakeClock) error {
+		suspended := NewJobSimulatorWithClock(converter, store, testClock).
+			Submit(queue, jobSet, owner, namespace, baseTime, basicJobOpts).
+			Suspended(baseTime).
+			Build().
+			Job()
+
+		resumed := NewJobSimulatorWithClock(converter, store, testClock).
+			Submit(queue, jobSet, owner, namespace, baseTime.Add(time.Second), basicJobOpts).
+			Suspended(baseTime.Add(time.Second)).
+			Resumed(baseTime.Add(2 * time.Second)).
+			Build().
+			Job()
+
+		result, err := repo.GetJobs(
+			armadacontext.TODO(),
+			[]*model.Filter{},
+			false,
+			&model.Order{Field: "submitted", Direction: model.DirectionAsc},
+			0,
+			10,
+		)
+		require.NoError(t, err)
+		require.Len(t, result.Jobs, 2)
+		assert.Equal(t, suspended, result.Jobs[0])
+		assert.True(t, result.Jobs[0].Suspended)
+		assert.Equal(t, resumed, result.Jobs[1])
+		assert.False(t, result.Jobs[1].Suspended)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
 func TestOrderByUnsupportedField(t *testing.T) {
 	err := withGetJobsSetup(func(converter *instructions.InstructionConverter, store *lookoutdb.LookoutDb, repo *SqlGetJobsRepository, testClock *clock.FakeClock) error {
 		_, err := repo.GetJobs(
@@ -2711,3 +2746,388 @@ func TestGetJobsIncludesIngressAddresses(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestGetJobsByFailureCategoryOfLatestRun(t *testing.T) {
+	err := withGetJobsSetup(func(converter *instructions.InstructionConverter, store *lookoutdb.LookoutDb, repo *SqlGetJobsRepository, testClock *clock.FakeClock) error {
+		// Create job whose first run was evicted and latest run ran out of memory
+		firstRunId := uuid.NewString()
+		latestRunId := uuid.NewString()
+		oomJob := NewJobSimulatorWithClock(converter, store, testClock).
+			Submit(queue, jobSet, owner, namespace, baseTime, basicJobOpts).
+			Lease(firstRunId, cluster, "node-1", pool, baseTime).
+			Running(firstRunId, "node-1", baseTime.Add(time.Minute)).
+			RunFailedWithCategory(firstRunId, "node-1", 1, "infrastructure", "evicted", baseTime.Add(2*time.Minute)).
+			Lease(latestRunId, cluster, "node-2", pool, baseTime.Add(3*time.Minute)).
+			Running(latestRunId, "node-2", baseTime.Add(4*time.Minute)).
+			RunFailedWithCategory(latestRunId, "node-2", 137, "user", "oom", baseTime.Add(5*time.Minute)).
+			Failed("node-2", 137, "", baseTime.Add(5*time.Minute)).
+			Build().
+			Job()
+
+		// Create job that failed for a user error other than running out of memory
+		userErrorRunId := uuid.NewString()
+		userErrorJob := NewJobSimulatorWithClock(converter, store, testClock).
+			Submit(queue, "job-set-2", owner, namespace, baseTime, basicJobOpts).
+			Lease(userErrorRunId, cluster, node, pool, baseTime).
+			Running(userErrorRunId, node, baseTime.Add(time.Minute)).
+			RunFailedWithCategory(userErrorRunId, node, 2, "user", "application", baseTime.Add(2*time.Minute)).
+			Failed(node, 2, "", baseTime.Add(2*time.Minute)).
+			Build().
+			Job()
+
+		// Create job with no runs
+		NewJobSimulatorWithClock(converter, store, testClock).
+			Submit(queue, "job-set-3", owner, namespace, baseTime, basicJobOpts).
+			Build()
+
+		// Filtering only matches the latest run
+		result, err := repo.GetJobs(
+			armadacontext.TODO(),
+			[]*model.Filter{
+				{
+					Field: "failureCategory",
+					Match: model.MatchExact,
+					Value: "infrastructure",
+				},
+			},
+			false,
+			&model.Order{},
+			0,
+			10,
+		)
+		require.NoError(t, err)
+		require.Len(t, result.Jobs, 0)
+
+		result, err = repo.GetJobs(
+			armadacontext.TODO(),
+			[]*model.Filter{
+				{
+					Field: "failureCategory",
+					Match: model.MatchExact,
+					Value: "user",
+				},
+			},
+			false,
+			&model.Order{
+				Field:     "exitCode",
+				Direction: model.DirectionDesc,
+			},
+			0,
+			10,
+		)
+		require.NoError(t, err)
+		require.Len(t, result.Jobs, 2)
+		assert.Equal(t, oomJob, result.Jobs[0])
+		assert.Equal(t, userErrorJob, result.Jobs[1])
+
+		result, err = repo.GetJobs(
+			armadacontext.TODO(),
+			[]*model.Filter{
+				{
+					Field: "failureSubcategory",
+					Match: model.MatchStartsWith,
+					Value: "app",
+				},
+			},
+			false,
+			&model.Order{},
+			0,
+			10,
+		)
+		require.NoError(t, err)
+		require.Len(t, result.Jobs, 1)
+		assert.Equal(t, userErrorJob, result.Jobs[0])
+
+		result, err = repo.GetJobs(
+			armadacontext.TODO(),
+			[]*model.Filter{
+				{
+					Field: "exitCode",
+					Match: model.MatchGreaterThan,
+					Value: 100,
+				},
+			},
+			false,
+			&model.Order{},
+			0,
+			10,
+		)
+		require.NoError(t, err)
+		require.Len(t, result.Jobs, 1)
+		assert.Equal(t, oomJob, result.Jobs[0])
+
+		// Ordering by a run column keeps jobs without runs
+		result, err = repo.GetJobs(
+			armadacontext.TODO(),
+			[]*model.Filter{},
+			false,
+			&model.Order{
+				Field:     "exitCode",
+				Direction: model.DirectionAsc,
+			},
+			0,
+			10,
+		)
+		require.NoError(t, err)
+		require.Len(t, result.Jobs, 3)
+		assert.Equal(t, userErrorJob, result.Jobs[0])
+		assert.Equal(t, oomJob, result.Jobs[1])
+
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestGetJobsByRegexAndNegatedMatches(t *testing.T) {
+	err := withGetJobsSetup(func(converter *instructions.InstructionConverter, store *lookoutdb.LookoutDb, repo *SqlGetJobsRepository, testClock *clock.FakeClock) error {
+		trainingJob := NewJobSimulatorWithClock(converter, store, testClock).
+			Submit("queue-1", "training-1", owner, namespace, baseTime, &JobOptions{Annotations: map[string]string{"team": "ml"}}).
+			Build().
+			Job()
+		evaluationJob := NewJobSimulatorWithClock(converter, store, testClock).
+			Submit("queue-2", "evaluation-1", owner, namespace, baseTime, &JobOptions{Annotations: map[string]string{"team": "infra"}}).
+			Build().
+			Job()
+		jobWithoutAnnotation := NewJobSimulatorWithClock(converter, store, testClock).
+			Submit("queue-3", "training-2", owner, namespace, baseTime, &JobOptions{}).
+			Build().
+			Job()
+
+		getJobIds := func(filters []*model.Filter) []string {
+			result, err := repo.GetJobs(armadacontext.TODO(), filters, false, &model.Order{}, 0, 10)
+			require.NoError(t, err)
+			jobIds := make([]string, len(result.Jobs))
+			for i, job := range result.Jobs {
+				jobIds[i] = job.JobId
+			}
+			return jobIds
+		}
+
+		assert.ElementsMatch(t,
+			[]string{trainingJob.JobId, jobWithoutAnnotation.JobId},
+			getJobIds([]*model.Filter{{Field: "jobSet", Match: model.MatchRegex, Value: "^train.*-[0-9]+$"}}),
+		)
+		assert.ElementsMatch(t,
+			[]string{evaluationJob.JobId, jobWithoutAnnotation.JobId},
+			getJobIds([]*model.Filter{{Field: "queue", Match: model.MatchNotEqual, Value: "queue-1"}}),
+		)
+		assert.ElementsMatch(t,
+			[]string{jobWithoutAnnotation.JobId},
+			getJobIds([]*model.Filter{{Field: "queue", Match: model.MatchNotAnyOf, Value: []string{"queue-1", "queue-2"}}}),
+		)
+		assert.ElementsMatch(t,
+			[]string{evaluationJob.JobId},
+			getJobIds([]*model.Filter{{Field: "jobSet", Match: model.MatchNotContains, Value: "training"}}),
+		)
+		// Jobs without the annotation match negated annotation filters
+		assert.ElementsMatch(t,
+			[]string{evaluationJob.JobId, jobWithoutAnnotation.JobId},
+			getJobIds([]*model.Filter{{Field: "team", Match: model.MatchNotEqual, Value: "ml", IsAnnotation: true}}),
+		)
+		assert.ElementsMatch(t,
+			[]string{trainingJob.JobId},
+			getJobIds([]*model.Filter{{Field: "team", Match: model.MatchRegex, Value: "^m", IsAnnotation: true}}),
+		)
+
+		_, err := repo.GetJobs(
+			armadacontext.TODO(),
+			[]*model.Filter{{Field: "queue", Match: model.MatchRegex, Value: "(a)\\1"}},
+			false,
+			&model.Order{},
+			0,
+			10,
+		)
+		assert.Error(t, err)
+
+		// Named groups are valid RE2 but not valid in Postgres
+		_, err = repo.GetJobs(
+			armadacontext.TODO(),
+			[]*model.Filter{{Field: "queue", Match: model.MatchRegex, Value: "(?P<name>queue)-1"}},
+			false,
+			&model.Order{},
+			0,
+			10,
+		)
+		assert.ErrorIs(t, err, ErrInvalidRegex)
+
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestGetJobsByRunsAndDurations(t *testing.T) {
+	err := withGetJobsSetup(func(converter *instructions.InstructionConverter, store *lookoutdb.LookoutDb, repo *SqlGetJobsRepository, testClock *clock.FakeClock) error {
+		// Create job that was retried once, queued for a minute and then ran for an hour
+		firstRunId := uuid.NewString()
+		secondRunId := uuid.NewString()
+		retriedJob := NewJobSimulatorWithClock(converter, store, testClock).
+			Submit(queue, jobSet, owner, namespace, baseTime, basicJobOpts).
+			Lease(firstRunId, cluster, node, pool, baseTime.Add(time.Minute)).
+			Running(firstRunId, node, baseTime.Add(time.Minute)).
+			LeaseReturned(firstRunId, "lease returned", baseTime.Add(2*time.Minute)).
+			Lease(secondRunId, cluster, node, pool, baseTime.Add(3*time.Minute)).
+			Running(secondRunId, node, baseTime.Add(3*time.Minute)).
+			RunSucceeded(secondRunId, baseTime.Add(63*time.Minute)).
+			Succeeded(baseTime.Add(63 * time.Minute)).
+			Build().
+			Job()
+
+		// Create job that was queued for an hour and then ran for a minute
+		runId := uuid.NewString()
+		quickJob := NewJobSimulatorWithClock(converter, store, testClock).
+			Submit(queue, "job-set-2", owner, namespace, baseTime, basicJobOpts).
+			Lease(runId, cluster, node, pool, baseTime.Add(time.Hour)).
+			Running(runId, node, baseTime.Add(time.Hour)).
+			RunSucceeded(runId, baseTime.Add(61*time.Minute)).
+			Succeeded(baseTime.Add(61 * time.Minute)).
+			Build().
+			Job()
+
+		getJobIds := func(filters []*model.Filter, order *model.Order) []string {
+			result, err := repo.GetJobs(armadacontext.TODO(), filters, false, order, 0, 10)
+			require.NoError(t, err)
+			jobIds := make([]string, len(result.Jobs))
+			for i, job := range result.Jobs {
+				jobIds[i] = job.JobId
+			}
+			return jobIds
+		}
+
+		assert.Equal(t,
+			[]string{retriedJob.JobId},
+			getJobIds([]*model.Filter{{Field: "retries", Match: model.MatchGreaterThan, Value: 0}}, &model.Order{}),
+		)
+		assert.Equal(t,
+			[]string{quickJob.JobId},
+			getJobIds([]*model.Filter{{Field: "runs", Match: model.MatchExact, Value: 1}}, &model.Order{}),
+		)
+		assert.Equal(t,
+			[]string{retriedJob.JobId},
+			getJobIds([]*model.Filter{{Field: "runtime", Match: model.MatchGreaterThan, Value: 30 * 60}}, &model.Order{}),
+		)
+		assert.Equal(t,
+			[]string{quickJob.JobId},
+			getJobIds([]*model.Filter{{Field: "queuedDuration", Match: model.MatchGreaterThanOrEqualTo, Value: 60 * 60}}, &model.Order{}),
+		)
+		assert.Equal(t,
+			[]string{quickJob.JobId, retriedJob.JobId},
+			getJobIds([]*model.Filter{}, &model.Order{Field: "queuedDuration", Direction: model.DirectionDesc}),
+		)
+		assert.Equal(t,
+			[]string{retriedJob.JobId, quickJob.JobId},
+			getJobIds([]*model.Filter{}, &model.Order{Field: "runtime", Direction: model.DirectionDesc}),
+		)
+		assert.Equal(t,
+			[]string{retriedJob.JobId, quickJob.JobId},
+			getJobIds([]*model.Filter{}, &model.Order{Field: "runs", Direction: model.DirectionDesc}),
+		)
+
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestExportJobsPagesThroughJobsInOrder(t *testing.T) {
+	err := withGetJobsSetup(func(converter *instructions.InstructionConverter, store *lookoutdb.LookoutDb, repo *SqlGetJobsRepository, testClock *clock.FakeClock) error {
+		// Create jobs sharing the same node, so pages have to be split between jobs with equal order values,
+		// and queued jobs with no node at all
+		leaseOnNode := func(node string) string {
+			runId := uuid.NewString()
+			return NewJobSimulatorWithClock(converter, store, testClock).
+				Submit(queue, jobSet, owner, namespace, baseTime, basicJobOpts).
+				Lease(runId, cluster, node, pool, baseTime).
+				Build().
+				Job().
+				JobId
+		}
+		submit := func() string {
+			return NewJobSimulatorWithClock(converter, store, testClock).
+				Submit(queue, jobSet, owner, namespace, baseTime, basicJobOpts).
+				Build().
+				Job().
+				JobId
+		}
+		nodeOneJobs := []string{leaseOnNode("node-1"), leaseOnNode("node-1"), leaseOnNode("node-1")}
+		nodeTwoJobs := []string{leaseOnNode("node-2")}
+		queuedJobs := []string{submit(), submit()}
+		slices.Sort(nodeOneJobs)
+		slices.Sort(queuedJobs)
+
+		exportJobIds := func(order *model.Order, maxRows int) ([]string, []int) {
+			var jobIds []string
+			var pageSizes []int
+			err := repo.ExportJobs(armadacontext.TODO(), []*model.Filter{}, false, order, maxRows, 2, func(jobs []*model.Job) error {
+				pageSizes = append(pageSizes, len(jobs))
+				for _, job := range jobs {
+					jobIds = append(jobIds, job.JobId)
+				}
+				return nil
+			})
+			require.NoError(t, err)
+			return jobIds, pageSizes
+		}
+
+		// Nulls sort last in ascending order
+		jobIds, pageSizes := exportJobIds(&model.Order{Field: "node", Direction: model.DirectionAsc}, 100)
+		assert.Equal(t, slices.Concat(nodeOneJobs, nodeTwoJobs, queuedJobs), jobIds)
+		assert.Equal(t, []int{2, 2, 2}, pageSizes)
+
+		// Nulls sort first in descending order
+		jobIds, _ = exportJobIds(&model.Order{Field: "node", Direction: model.DirectionDesc}, 100)
+		expected := slices.Concat(queuedJobs, nodeTwoJobs, nodeOneJobs)
+		slices.Reverse(expected[:len(queuedJobs)])
+		slices.Reverse(expected[len(queuedJobs)+len(nodeTwoJobs):])
+		assert.Equal(t, expected, jobIds)
+
+		// Jobs are ordered by id if no order is given
+		jobIds, pageSizes = exportJobIds(&model.Order{}, 3)
+		allJobIds := slices.Concat(nodeOneJobs, nodeTwoJobs, queuedJobs)
+		slices.Sort(allJobIds)
+		assert.Equal(t, allJobIds[:3], jobIds)
+		assert.Equal(t, []int{2, 1}, pageSizes)
+
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestGetJobsAfterContinuationToken(t *testing.T) {
+	err := withGetJobsSetup(func(converter *instructions.InstructionConverter, store *lookoutdb.LookoutDb, repo *SqlGetJobsRepository, testClock *clock.FakeClock) error {
+		// Jobs submitted at the same time are ordered by job id, so that pages split between them don't repeat jobs
+		var jobIds []string
+		for i := 0; i < 5; i++ {
+			job := NewJobSimulatorWithClock(converter, store, testClock).
+				Submit(queue, jobSet, owner, namespace, baseTime.Add(time.Duration(i/2)*time.Minute), basicJobOpts).
+				Build().
+				Job()
+			jobIds = append(jobIds, job.JobId)
+		}
+		expected := []string{jobIds[4], max(jobIds[2], jobIds[3]), min(jobIds[2], jobIds[3]), max(jobIds[0], jobIds[1]), min(jobIds[0], jobIds[1])}
+
+		order := &model.Order{Field: "submitted", Direction: model.DirectionDesc}
+		var fetched []string
+		continuationToken := ""
+		for page := 0; page < 3; page++ {
+			result, err := repo.GetJobsAfter(armadacontext.TODO(), []*model.Filter{}, false, order, continuationToken, 2)
+			require.NoError(t, err)
+			for _, job := range result.Jobs {
+				fetched = append(fetched, job.JobId)
+			}
+			continuationToken = result.ContinuationToken
+			if page < 2 {
+				assert.NotEmpty(t, continuationToken)
+			}
+		}
+		assert.Equal(t, expected, fetched)
+		assert.Empty(t, continuationToken)
+
+		// A token can't be used with another order
+		result, err := repo.GetJobsAfter(armadacontext.TODO(), []*model.Filter{}, false, order, "", 2)
+		require.NoError(t, err)
+		_, err = repo.GetJobsAfter(armadacontext.TODO(), []*model.Filter{}, false, &model.Order{Field: "jobId", Direction: model.DirectionAsc}, result.ContinuationToken, 2)
+		assert.Error(t, err)
+
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -74,7 +74,7 @@ func (r *SqlGroupJobsRepository) GroupBy(
 	queryDuration := time.Since(queryStart)
 	if err != nil {
 		logQueryError(user, query, "GroupBy", queryDuration, err)
-		return nil, err
+		return nil, queryError(err)
 	}
 	logSlowQuery(ctx, user, query, "GroupBy", queryDuration)
 
@@ -97,6 +97,9 @@ func rowsToGroups(rows pgx.Rows, groupedField *model.GroupedField, aggregates []
 		}
 		groups = append(groups, jobGroup)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, queryError(err)
+	}
 	return groups, nil
 }
 
@@ -146,6 +149,17 @@ func scanGroup(rows pgx.Rows, field string, aggregates []string, filters []*mode
 			}
 			state := parser.GetField()[len(stateAggregatePrefix):]
 			stateCounts[state] = singleStateCount
+		} else if field, key, ok := strings.Cut(parser.GetField(), nestedAggregateSeparator); ok {
+			nestedVal, ok := aggregatesMap[field]
+			if !ok {
+				nestedVal = map[string]interface{}{}
+				aggregatesMap[field] = nestedVal
+			}
+			nested, ok := nestedVal.(map[string]interface{})
+			if !ok {
+				return nil, errors.Errorf("failed to parse value for %s aggregate: cannot cast aggregate to map", field)
+			}
+			nested[key] = val
 		} else {
 			aggregatesMap[parser.GetField()] = val
 		}
@@ -155,7 +169,7 @@ func scanGroup(rows pgx.Rows, field string, aggregates []string, filters []*mode
 	if parsedGroup == nil {
 		groupName = ""
 	} else {
-		groupName = fmt.Sprintf("%s", parsedGroup)
+		groupName = fmt.Sprintf("%v", parsedGroup)
 	}
 	return &model.JobGroup{
 		Name:       groupName,
@@ -9,6 +9,7 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/compress"
@@ -1930,3 +1931,198 @@ func makePreempted(opts *createJobsOpts, converter *instructions.InstructionConv
 		Preempted(lastTransitionTime).
 		Build()
 }
+
+func TestGroupByFailureCategory(t *testing.T) {
+	err := withGroupJobsSetup(func(converter *instructions.InstructionConverter, store *lookoutdb.LookoutDb, repo *SqlGroupJobsRepository) error {
+		failJob := func(exitCode int32, category string, subcategory string) {
+			runId := uuid.NewString()
+			NewJobSimulator(converter, store).
+				Submit(queue, jobSet, owner, namespace, baseTime, &JobOptions{}).
+				Lease(runId, cluster, node, pool, baseTime).
+				Running(runId, node, baseTime.Add(time.Minute)).
+				RunFailedWithCategory(runId, node, exitCode, category, subcategory, baseTime.Add(2*time.Minute)).
+				Failed(node, exitCode, "", baseTime.Add(2*time.Minute)).
+				Build()
+		}
+		for i := 0; i < 3; i++ {
+			failJob(137, "user", "oom")
+		}
+		for i := 0; i < 2; i++ {
+			failJob(1, "user", "application")
+		}
+		failJob(1, "infrastructure", "evicted")
+		// Create running jobs without failures
+		for i := 0; i < 2; i++ {
+			runId := uuid.NewString()
+			NewJobSimulator(converter, store).
+				Submit(queue, jobSet, owner, namespace, baseTime, &JobOptions{}).
+				Lease(runId, cluster, node, pool, baseTime).
+				Running(runId, node, baseTime.Add(time.Minute)).
+				Build()
+		}
+
+		result, err := repo.GroupBy(
+			armadacontext.TODO(),
+			[]*model.Filter{},
+			false,
+			&model.Order{
+				Field:     "count",
+				Direction: model.DirectionDesc,
+			},
+			&model.GroupedField{
+				Field: "failureCategory",
+			},
+			[]string{},
+			0,
+			10,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, []*model.JobGroup{
+			{
+				Name:       "user",
+				Count:      5,
+				Aggregates: map[string]interface{}{},
+			},
+			{
+				Name:       "",
+				Count:      2,
+				Aggregates: map[string]interface{}{},
+			},
+			{
+				Name:       "infrastructure",
+				Count:      1,
+				Aggregates: map[string]interface{}{},
+			},
+		}, result.Groups)
+
+		result, err = repo.GroupBy(
+			armadacontext.TODO(),
+			[]*model.Filter{
+				{
+					Field: "failureCategory",
+					Match: model.MatchExact,
+					Value: "user",
+				},
+			},
+			false,
+			&model.Order{
+				Field:     "exitCode",
+				Direction: model.DirectionAsc,
+			},
+			&model.GroupedField{
+				Field: "exitCode",
+			},
+			[]string{"failureCategory"},
+			0,
+			10,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, []*model.JobGroup{
+			{
+				Name:       "1",
+				Count:      2,
+				Aggregates: map[string]interface{}{"failureCategory": int64(2)},
+			},
+			{
+				Name:       "137",
+				Count:      3,
+				Aggregates: map[string]interface{}{"failureCategory": int64(3)},
+			},
+		}, result.Groups)
+
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestGroupJobsWithResourceAndRuntimeAggregates(t *testing.T) {
+	err := withGroupJobsSetup(func(converter *instructions.InstructionConverter, store *lookoutdb.LookoutDb, repo *SqlGroupJobsRepository) error {
+		succeededJob := func(jobSet string, gpu string, runtime time.Duration) {
+			runId := uuid.NewString()
+			NewJobSimulator(converter, store).
+				Submit(queue, jobSet, owner, namespace, baseTime, &JobOptions{Gpu: resource.MustParse(gpu)}).
+				Lease(runId, cluster, node, pool, baseTime).
+				Running(runId, node, baseTime).
+				RunSucceeded(runId, baseTime.Add(runtime)).
+				Succeeded(baseTime.Add(runtime)).
+				Build()
+		}
+		succeededJob("job-set-1", "2", time.Minute)
+		succeededJob("job-set-1", "2", 3*time.Minute)
+		succeededJob("job-set-2", "1", 10*time.Minute)
+		// Running jobs don't count towards runtime
+		runId := uuid.NewString()
+		NewJobSimulator(converter, store).
+			Submit(queue, "job-set-1", owner, namespace, baseTime, &JobOptions{Gpu: resource.MustParse("4")}).
+			Lease(runId, cluster, node, pool, baseTime).
+			Running(runId, node, baseTime).
+			Build()
+
+		result, err := repo.GroupBy(
+			armadacontext.TODO(),
+			[]*model.Filter{},
+			false,
+			&model.Order{
+				Field:     "gpu",
+				Direction: model.DirectionDesc,
+			},
+			&model.GroupedField{
+				Field: "jobSet",
+			},
+			[]string{"gpu", "runtime"},
+			0,
+			10,
+		)
+		require.NoError(t, err)
+		require.Len(t, result.Groups, 2)
+
+		assert.Equal(t, "job-set-1", result.Groups[0].Name)
+		assert.Equal(t, int64(3), result.Groups[0].Count)
+		gpu := result.Groups[0].Aggregates["gpu"].(map[string]interface{})
+		assert.Equal(t, int64(8), gpu["total"])
+		assert.InDelta(t, 8.0/3, gpu["average"], 1e-9)
+		assert.InDelta(t, 480, gpu["seconds"], 1e-9)
+		runtime := result.Groups[0].Aggregates["runtime"].(map[string]interface{})
+		assert.InDelta(t, 240, runtime["total"], 1e-9)
+		assert.InDelta(t, 120, runtime["p50"], 1e-9)
+		assert.InDelta(t, 168, runtime["p90"], 1e-9)
+		assert.InDelta(t, 178.8, runtime["p99"], 1e-9)
+
+		assert.Equal(t, "job-set-2", result.Groups[1].Name)
+		assert.Equal(t, int64(1), result.Groups[1].Count)
+		gpu = result.Groups[1].Aggregates["gpu"].(map[string]interface{})
+		assert.Equal(t, int64(1), gpu["total"])
+		assert.InDelta(t, 1, gpu["average"], 1e-9)
+		assert.InDelta(t, 600, gpu["seconds"], 1e-9)
+		runtime = result.Groups[1].Aggregates["runtime"].(map[string]interface{})
+		assert.InDelta(t, 600, runtime["total"], 1e-9)
+		assert.InDelta(t, 600, runtime["p50"], 1e-9)
+		assert.InDelta(t, 600, runtime["p99"], 1e-9)
+
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestGroupJobsOrderByResourceRequiresAggregate(t *testing.T) {
+	err := withGroupJobsSetup(func(converter *instructions.InstructionConverter, store *lookoutdb.LookoutDb, repo *SqlGroupJobsRepository) error {
+		_, err := repo.GroupBy(
+			armadacontext.TODO(),
+			[]*model.Filter{},
+			false,
+			&model.Order{
+				Field:     "gpu",
+				Direction: model.DirectionDesc,
+			},
+			&model.GroupedField{
+				Field: "jobSet",
+			},
+			[]string{},
+			0,
+			10,
+		)
+		assert.Error(t, err)
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/lookout/model"
+	"github.com/armadaproject/armada/internal/lookoutingester/statistics"
+)
+
+const (
+	JobStatisticsGroupByQueue         = "queue"
+	JobStatisticsGroupByPool          = "pool"
+	JobStatisticsGroupByPriorityClass = "priorityClass"
+
+	// maxJobStatisticsPoints limits the number of points in each series returned
+	maxJobStatisticsPoints = 10000
+)
+
+var jobStatisticsGroupByColumns = map[string]string{
+	JobStatisticsGroupByQueue:         "queue",
+	JobStatisticsGroupByPool:          "pool",
+	JobStatisticsGroupByPriorityClass: "priority_class",
+}
+
+// JobStatisticsQuery selects the job statistics to return. Statistics are filtered to the given queues, pools and
+// priority classes, if any are given, and summed across the fields not in GroupBy.
+type JobStatisticsQuery struct {
+	Queues          []string
+	Pools           []string
+	PriorityClasses []string
+	GroupBy         []string
+	// From is rounded down, and To is rounded up, to a multiple of BucketSize
+	From       time.Time
+	To         time.Time
+	BucketSize time.Duration
+}
+
+type JobStatisticsRepository interface {
+	GetJobStatistics(ctx *armadacontext.Context, query JobStatisticsQuery) ([]*model.JobStatisticsSeries, error)
+}
+
+type SqlJobStatisticsRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewSqlJobStatisticsRepository(db *pgxpool.Pool) *SqlJobStatisticsRepository {
+	return &SqlJobStatisticsRepository{
+		db: db,
+	}
+}
+
+// GetJobStatistics returns a series of points per group, with a point per bucket from From to To. Each point is the
+// average of the statistics recorded once a minute over the minutes of its bucket in which they were recorded, where
+// a group without statistics in a recorded minute counts as zero. Buckets without any statistics recorded are zero.
+func (r *SqlJobStatisticsRepository) GetJobStatistics(ctx *armadacontext.Context, query JobStatisticsQuery) ([]*model.JobStatisticsSeries, error) {
+	if query.BucketSize <= 0 || query.BucketSize%time.Minute != 0 {
+		return nil, errors.Errorf("bucket size must be a positive multiple of 60 seconds, got %s", query.BucketSize)
+	}
+	from := query.From.UTC().Truncate(query.BucketSize)
+	to := query.To.UTC().Truncate(query.BucketSize)
+	if to.Before(query.To) {
+		to = to.Add(query.BucketSize)
+	}
+	if !from.Before(to) {
+		return nil, errors.New("to must be after from")
+	}
+	numBuckets := int(to.Sub(from) / query.BucketSize)
+	if numBuckets > maxJobStatisticsPoints {
+		return nil, errors.Errorf("cannot return more than %d points, got %d; use a larger bucket size", maxJobStatisticsPoints, numBuckets)
+	}
+
+	var selectColumns []string
+	groupByColumns := []string{"i"}
+	grouped := map[string]bool{}
+	for _, field := range query.GroupBy {
+		if _, ok := jobStatisticsGroupByColumns[field]; !ok {
+			return nil, errors.Errorf("cannot group job statistics by %q", field)
+		}
+		grouped[field] = true
+	}
+	for _, field := range []string{JobStatisticsGroupByQueue, JobStatisticsGroupByPool, JobStatisticsGroupByPriorityClass} {
+		if column := jobStatisticsGroupByColumns[field]; grouped[field] {
+			selectColumns = append(selectColumns, column)
+			groupByColumns = append(groupByColumns, column)
+		} else {
+			selectColumns = append(selectColumns, fmt.Sprintf("'' AS %s", column))
+		}
+	}
+
+	// The minute rows of an hour are summed into a row for the hour, which can be read instead when whole hours are
+	// summed anyway
+	resolution := statistics.MinuteResolution
+	if query.BucketSize%time.Hour == 0 {
+		resolution = statistics.HourResolution
+	}
+	args := []interface{}{from, int64(query.BucketSize.Seconds()), resolution, to}
+	var conditions []string
+	for _, filter := range []struct {
+		column string
+		values []string
+	}{
+		{column: "queue", values: query.Queues},
+		{column: "pool", values: query.Pools},
+		{column: "priority_class", values: query.PriorityClasses},
+	} {
+		if len(filter.values) > 0 {
+			args = append(args, filter.values)
+			conditions = append(conditions, fmt.Sprintf("AND %s = ANY($%d)", filter.column, len(args)))
+		}
+	}
+
+	// The number of minutes recorded in each bucket is counted over every group, not just those selected, as a group
+	// without a row in a recorded minute had no jobs in it
+	sql := fmt.Sprintf(`
+		WITH bucket_samples AS (
+			SELECT floor(extract(epoch FROM bucket - $1::timestamp) / $2::bigint)::bigint AS i, sum(samples)::float8 AS samples
+			FROM (
+				SELECT bucket, max(samples) AS samples
+				FROM job_statistics
+				WHERE resolution = $3 AND bucket >= $1 AND bucket < $4
+				GROUP BY bucket
+			) AS buckets
+			GROUP BY 1
+		), bucket_statistics AS (
+			SELECT
+				floor(extract(epoch FROM bucket - $1::timestamp) / $2::bigint)::bigint AS i,
+				%s,
+				sum(queued)::float8 AS queued, sum(leased)::float8 AS leased, sum(pending)::float8 AS pending,
+				sum(running)::float8 AS running, sum(running_cpu)::float8 AS running_cpu,
+				sum(running_memory)::float8 AS running_memory, sum(running_gpu)::float8 AS running_gpu
+			FROM job_statistics
+			WHERE resolution = $3 AND bucket >= $1 AND bucket < $4 %s
+			GROUP BY %s
+		)
+		SELECT
+			i, queue, pool, priority_class, queued, leased, pending, running, running_cpu, running_memory, running_gpu,
+			bucket_samples.samples
+		FROM bucket_statistics
+		JOIN bucket_samples USING (i)
+		ORDER BY 2, 3, 4, 1`,
+		strings.Join(selectColumns, ", "), strings.Join(conditions, " "), strings.Join(groupByColumns, ", "))
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "error querying job statistics")
+	}
+	defer rows.Close()
+
+	var series []*model.JobStatisticsSeries
+	var current *model.JobStatisticsSeries
+	for rows.Next() {
+		var i int
+		var queue, pool, priorityClass string
+		var point model.JobStatisticsPoint
+		var samples float64
+		if err := rows.Scan(
+			&i, &queue, &pool, &priorityClass, &point.Queued, &point.Leased, &point.Pending, &point.Running,
+			&point.RunningCpu, &point.RunningMemory, &point.RunningGpu, &samples,
+		); err != nil {
+			return nil, errors.Wrap(err, "error reading job statistics")
+		}
+		if current == nil || current.Queue != queue || current.Pool != pool || current.PriorityClass != priorityClass {
+			current = newJobStatisticsSeries(queue, pool, priorityClass, from, query.BucketSize, numBuckets)
+			series = append(series, current)
+		}
+		current.Points[i] = &model.JobStatisticsPoint{
+			Time:          current.Points[i].Time,
+			Queued:        point.Queued / samples,
+			Leased:        point.Leased / samples,
+			Pending:       point.Pending / samples,
+			Running:       point.Running / samples,
+			RunningCpu:    point.RunningCpu / samples,
+			RunningMemory: point.RunningMemory / samples,
+			RunningGpu:    point.RunningGpu / samples,
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "error reading job statistics")
+	}
+	return series, nil
+}
+
+// newJobStatisticsSeries returns a series with a zero point for every bucket
+func newJobStatisticsSeries(queue, pool, priorityClass string, from time.Time, bucketSize time.Duration, numBuckets int) *model.JobStatisticsSeries {
+	points := make([]*model.JobStatisticsPoint, numBuckets)
+	for i := range points {
+		points[i] = &model.JobStatisticsPoint{Time: from.Add(time.Duration(i) * bucketSize)}
+	}
+	return &model.JobStatisticsSeries{
+		Queue:         queue,
+		Pool:          pool,
+		PriorityClass: priorityClass,
+		Points:        points,
+	}
+}
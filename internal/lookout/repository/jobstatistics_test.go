@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clock "k8s.io/utils/clock/testing"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/common/database/lookout"
+	"github.com/armadaproject/armada/internal/lookout/model"
+	"github.com/armadaproject/armada/internal/lookoutingester/instructions"
+	"github.com/armadaproject/armada/internal/lookoutingester/lookoutdb"
+	"github.com/armadaproject/armada/internal/lookoutingester/metrics"
+	"github.com/armadaproject/armada/internal/lookoutingester/statistics"
+)
+
+func TestGetJobStatistics(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		converter := instructions.NewInstructionConverter(metrics.Get().Metrics, userAnnotationPrefix, []string{}, &compress.NoOpCompressor{})
+		store := lookoutdb.NewLookoutDb(db, nil, metrics.Get(), 10, 10)
+
+		NewJobSimulator(converter, store).
+			Submit(queue, jobSet, owner, namespace, baseTime, basicJobOpts).
+			Build()
+		NewJobSimulator(converter, store).
+			Submit(queue, jobSet, owner, namespace, baseTime, basicJobOpts).
+			Lease(runId, cluster, node, pool, baseTime).
+			Pending(runId, cluster, baseTime).
+			Running(runId, node, baseTime).
+			Build()
+
+		// Record the statistics of two minutes
+		minute := baseTime.Truncate(time.Minute)
+		testClock := clock.NewFakeClock(minute)
+		recorder := statistics.NewRecorder(db, testClock)
+		require.NoError(t, recorder.Record(armadacontext.TODO()))
+		testClock.Step(time.Minute)
+		require.NoError(t, recorder.Record(armadacontext.TODO()))
+
+		repo := NewSqlJobStatisticsRepository(db)
+
+		result, err := repo.GetJobStatistics(armadacontext.TODO(), JobStatisticsQuery{
+			GroupBy:    []string{JobStatisticsGroupByPool},
+			From:       minute,
+			To:         minute.Add(3 * time.Minute),
+			BucketSize: 2 * time.Minute,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []*model.JobStatisticsSeries{
+			{
+				Pool: "",
+				Points: []*model.JobStatisticsPoint{
+					{Time: minute, Queued: 1},
+					{Time: minute.Add(2 * time.Minute)},
+				},
+			},
+			{
+				Pool: pool,
+				Points: []*model.JobStatisticsPoint{
+					{
+						Time:          minute,
+						Running:       1,
+						RunningCpu:    float64(cpu.MilliValue()),
+						RunningMemory: float64(memory.Value()),
+						RunningGpu:    float64(gpu.Value()),
+					},
+					{Time: minute.Add(2 * time.Minute)},
+				},
+			},
+		}, result)
+
+		// Whole hours are read from the hour rows, and averaged over the minutes of the hour recorded
+		hour := minute.Truncate(time.Hour)
+		result, err = repo.GetJobStatistics(armadacontext.TODO(), JobStatisticsQuery{
+			Queues:     []string{queue},
+			From:       hour,
+			To:         hour.Add(time.Hour),
+			BucketSize: time.Hour,
+		})
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		require.Len(t, result[0].Points, 1)
+		assert.InDelta(t, 1, result[0].Points[0].Queued, 1e-9)
+		assert.InDelta(t, 1, result[0].Points[0].Running, 1e-9)
+
+		// Queues without jobs have no series
+		result, err = repo.GetJobStatistics(armadacontext.TODO(), JobStatisticsQuery{
+			Queues:     []string{"other-queue"},
+			From:       minute,
+			To:         minute.Add(time.Minute),
+			BucketSize: time.Minute,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, result)
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestGetJobStatistics_AveragesPartialBucketsOverRecordedMinutes(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		converter := instructions.NewInstructionConverter(metrics.Get().Metrics, userAnnotationPrefix, []string{}, &compress.NoOpCompressor{})
+		store := lookoutdb.NewLookoutDb(db, nil, metrics.Get(), 10, 10)
+
+		NewJobSimulator(converter, store).
+			Submit(queue, jobSet, owner, namespace, baseTime, basicJobOpts).
+			Build()
+
+		// Record the statistics of three minutes, so the last two-minute bucket, like the current one, is only partly
+		// recorded
+		minute := baseTime.Truncate(time.Hour)
+		testClock := clock.NewFakeClock(minute)
+		recorder := statistics.NewRecorder(db, testClock)
+		for i := 0; i < 3; i++ {
+			require.NoError(t, recorder.Record(armadacontext.TODO()))
+			testClock.Step(time.Minute)
+		}
+
+		repo := NewSqlJobStatisticsRepository(db)
+
+		result, err := repo.GetJobStatistics(armadacontext.TODO(), JobStatisticsQuery{
+			From:       minute,
+			To:         minute.Add(4 * time.Minute),
+			BucketSize: 2 * time.Minute,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []*model.JobStatisticsSeries{
+			{
+				Points: []*model.JobStatisticsPoint{
+					{Time: minute, Queued: 1},
+					{Time: minute.Add(2 * time.Minute), Queued: 1},
+				},
+			},
+		}, result)
+
+		// The hour row records that only three of its minutes were recorded
+		result, err = repo.GetJobStatistics(armadacontext.TODO(), JobStatisticsQuery{
+			From:       minute,
+			To:         minute.Add(time.Hour),
+			BucketSize: time.Hour,
+		})
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, []*model.JobStatisticsPoint{{Time: minute, Queued: 1}}, result[0].Points)
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestGetJobStatisticsInvalidQuery(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		repo := NewSqlJobStatisticsRepository(db)
+		for name, query := range map[string]JobStatisticsQuery{
+			"bucket size not a multiple of a minute": {From: baseTime, To: baseTime.Add(time.Hour), BucketSize: 90 * time.Second},
+			"to before from":                         {From: baseTime, To: baseTime.Add(-time.Hour), BucketSize: time.Minute},
+			"too many points":                        {From: baseTime, To: baseTime.Add(365 * 24 * time.Hour), BucketSize: time.Minute},
+			"unknown group by field":                 {GroupBy: []string{"jobSet"}, From: baseTime, To: baseTime.Add(time.Hour), BucketSize: time.Minute},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := repo.GetJobStatistics(armadacontext.TODO(), query)
+				assert.Error(t, err)
+			})
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+}
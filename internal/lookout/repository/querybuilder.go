@@ -2,6 +2,7 @@ package repository
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -9,24 +10,25 @@ import (
 
 	"github.com/armadaproject/armada/internal/common/database/lookout"
 	log "github.com/armadaproject/armada/internal/common/logging"
+	armadaslices "github.com/armadaproject/armada/internal/common/slices"
 	"github.com/armadaproject/armada/internal/lookout/model"
 )
 
 const (
 	countCol                 = "count"
+	orderKeyCol              = "order_key"
 	activeJobSetsTableAbbrev = "active_job_sets"
 )
 
 var (
+	// activeJobSetsTable selects the job sets with active jobs, which are all in the active partition of the job table
 	activeJobSetsTable = fmt.Sprintf(
 		`(
 	SELECT DISTINCT %s, %s
 	FROM %s
-	WHERE state IN (%d, %d, %d, %d)
 )`,
 		queueCol, jobSetCol,
-		jobTable,
-		lookout.JobQueuedOrdinal, lookout.JobPendingOrdinal, lookout.JobRunningOrdinal, lookout.JobLeasedOrdinal,
+		jobActiveTable,
 	)
 	joinWithActiveJobSetsTable = fmt.Sprintf("INNER JOIN %s AS %s USING (%s, %s)", activeJobSetsTable, activeJobSetsTableAbbrev, queueCol, jobSetCol)
 )
@@ -65,6 +67,39 @@ func (qb *QueryBuilder) GetJobs(
 	order *model.Order,
 	skip int,
 	take int,
+) (*Query, error) {
+	return qb.getJobs(filters, activeJobSets, order, false, nil, skip, take)
+}
+
+// GetJobsPage returns a query for the page of jobs after the given cursor, or the first page if the cursor is nil.
+// Jobs are ordered by the order column and then job id, and the order column's value is selected last as order_key,
+// so that the cursor for the next page can be built from the last job of this page.
+func (qb *QueryBuilder) GetJobsPage(
+	filters []*model.Filter,
+	activeJobSets bool,
+	order *model.Order,
+	after *jobsCursor,
+	take int,
+) (*Query, error) {
+	return qb.getJobs(filters, activeJobSets, keysetOrder(order), true, after, 0, take)
+}
+
+// keysetOrder returns the order of pages of jobs fetched with keyset pagination, which are ordered by job id by default
+func keysetOrder(order *model.Order) *model.Order {
+	if orderIsNull(order) {
+		return &model.Order{Field: jobIdField, Direction: model.DirectionAsc}
+	}
+	return order
+}
+
+func (qb *QueryBuilder) getJobs(
+	filters []*model.Filter,
+	activeJobSets bool,
+	order *model.Order,
+	keyset bool,
+	after *jobsCursor,
+	skip int,
+	take int,
 ) (*Query, error) {
 	if err := qb.validateFilters(filters); err != nil {
 		return nil, errors.Wrap(err, "filters are invalid")
@@ -88,16 +123,39 @@ func (qb *QueryBuilder) GetJobs(
 		return nil, err
 	}
 
-	innerJoinLatestJobRunsFilters, err := qb.getJobsInnerJoinWithLatestActiveJobRunFilters(filtersByTable.jobRunTableFilters)
+	fromTable, err := jobTableForFilters(filtersByTable.jobTableFilters)
 	if err != nil {
 		return nil, err
 	}
 
-	orderBy, err := qb.makeOrderBy(order)
+	joinLatestJobRuns, err := qb.getJobsJoinWithLatestJobRun(filtersByTable.jobRunTableFilters, order)
+	if err != nil {
+		return nil, err
+	}
+
+	orderBy, err := qb.makeJobsOrderBy(order)
 	if err != nil {
 		return nil, err
 	}
 
+	selectOrderKey, outerSelectOrderKey := "", ""
+	if keyset {
+		orderSql, err := qb.orderColumnSql(order)
+		if err != nil {
+			return nil, err
+		}
+		if after != nil {
+			clause := qb.keysetWhereClause(orderSql, order.Direction, after)
+			if jobWhere == "" {
+				jobWhere = fmt.Sprintf("WHERE %s", clause)
+			} else {
+				jobWhere = fmt.Sprintf("%s AND %s", jobWhere, clause)
+			}
+		}
+		selectOrderKey = fmt.Sprintf(",\n\t\t%s AS %s", orderSql, orderKeyCol)
+		outerSelectOrderKey = fmt.Sprintf(",\n\tselected_jobs.%s", orderKeyCol)
+	}
+
 	query := fmt.Sprintf(
 		`SELECT
 	selected_jobs.job_id,
@@ -119,8 +177,9 @@ func (qb *QueryBuilder) GetJobs(
 	selected_jobs.latest_run_id,
 	selected_jobs.cancel_reason,
 	selected_jobs.cancel_user,
+	selected_jobs.suspended,
 	selected_jobs.annotations,
-	selected_runs.runs
+	selected_runs.runs%s
 FROM (
 	SELECT
 		j.job_id,
@@ -143,7 +202,8 @@ FROM (
 		j.latest_run_id,
 		j.cancel_reason,
 		j.cancel_user,
-		j.annotations
+		j.suspended,
+		j.annotations%s
 	FROM %s AS %s
 	%s
 	%s
@@ -180,9 +240,11 @@ CROSS JOIN LATERAL (
 	FROM %s
 	WHERE job_id = selected_jobs.job_id
 ) AS selected_runs`,
-		jobTable, jobTableAbbrev,
+		outerSelectOrderKey,
+		selectOrderKey,
+		fromTable, jobTableAbbrev,
 		activeJobSetsFilter,
-		innerJoinLatestJobRunsFilters,
+		joinLatestJobRuns,
 		jobWhere,
 		orderBy,
 		limitOffsetSql(skip, take),
@@ -192,9 +254,37 @@ CROSS JOIN LATERAL (
 	return &Query{Sql: query, Args: qb.args}, nil
 }
 
-func (qb *QueryBuilder) getJobsInnerJoinWithLatestActiveJobRunFilters(jobRunTableFilters []*model.Filter) (string, error) {
+// getJobsJoinWithLatestJobRun joins each job with its latest run, if the jobs are filtered or ordered by a job run column.
+// When filtered, jobs whose latest run doesn't match are excluded. When only ordered, jobs without runs are kept.
+func (qb *QueryBuilder) getJobsJoinWithLatestJobRun(jobRunTableFilters []*model.Filter, order *model.Order) (string, error) {
+	var orderColumn string
+	if !orderIsNull(order) {
+		column, err := qb.lookoutTables.ColumnFromField(order.Field)
+		if err != nil {
+			return "", err
+		}
+		table, err := qb.lookoutTables.TableForCol(column)
+		if err != nil {
+			return "", err
+		}
+		if table == jobRunTable {
+			orderColumn = column
+		}
+	}
+
 	if len(jobRunTableFilters) == 0 {
-		return "", nil
+		if orderColumn == "" {
+			return "", nil
+		}
+		return fmt.Sprintf(
+			"LEFT JOIN (SELECT run_id, %s FROM %s AS %s) AS %s ON %s.run_id = %s.latest_run_id",
+			qb.lookoutTables.ColumnSelectSql(orderColumn, jobRunTableAbbrev),
+			jobRunTable,
+			jobRunTableAbbrev,
+			jobRunTableAbbrev,
+			jobRunTableAbbrev,
+			jobTableAbbrev,
+		), nil
 	}
 
 	jobRunWhere, err := qb.makeWhere(jobRunTableFilters, jobRunTableAbbrev)
@@ -208,15 +298,22 @@ func (qb *QueryBuilder) getJobsInnerJoinWithLatestActiveJobRunFilters(jobRunTabl
 		if err != nil {
 			return "", err
 		}
-		columnsToSelect = append(columnsToSelect, column)
+		if !slices.Contains(columnsToSelect, column) {
+			columnsToSelect = append(columnsToSelect, column)
+		}
+	}
+	if orderColumn != "" && !slices.Contains(columnsToSelect, orderColumn) {
+		columnsToSelect = append(columnsToSelect, orderColumn)
 	}
 
 	return fmt.Sprintf(
-		"INNER JOIN (SELECT %s FROM %s AS %s %s) AS latest_runs ON latest_runs.run_id = %s.latest_run_id",
-		strings.Join(columnsToSelect, ", "),
+		"INNER JOIN (SELECT %s FROM %s AS %s %s) AS %s ON %s.run_id = %s.latest_run_id",
+		strings.Join(qb.jobRunColumnsSelectSql(columnsToSelect), ", "),
 		jobRunTable,
 		jobRunTableAbbrev,
 		jobRunWhere,
+		jobRunTableAbbrev,
+		jobRunTableAbbrev,
 		jobTableAbbrev,
 	), nil
 }
@@ -234,7 +331,7 @@ func (qb *QueryBuilder) GroupBy(
 	if err != nil {
 		return nil, errors.Wrap(err, "filters are invalid")
 	}
-	err = qb.validateGroupOrder(order, groupedField)
+	err = qb.validateGroupOrder(order, groupedField, aggregates)
 	if err != nil {
 		return nil, errors.Wrap(err, "group order is invalid")
 	}
@@ -252,6 +349,10 @@ func (qb *QueryBuilder) GroupBy(
 		activeJobSetsFilter = joinWithActiveJobSetsTable
 	}
 
+	aggregateColumns, err := qb.getAggregateColumns(aggregates)
+	if err != nil {
+		return nil, err
+	}
 	queryAggregators, err := qb.getQueryAggregators(aggregates, filters, groupedField)
 	if err != nil {
 		return nil, err
@@ -283,12 +384,17 @@ func (qb *QueryBuilder) GroupBy(
 		return nil, err
 	}
 
+	fromTable, err := jobTableForFilters(filtersByTable.jobTableFilters)
+	if err != nil {
+		return nil, err
+	}
+
 	groupByColumn, err := qb.getGroupByColumn(groupedField)
 	if err != nil {
 		return nil, err
 	}
 
-	joinLatestJobRuns, err := qb.groupByJobsJoinWithLatestActiveJobRun(filtersByTable.jobRunTableFilters, groupByColumn)
+	joinLatestJobRuns, err := qb.groupByJobsJoinWithLatestActiveJobRun(filtersByTable.jobRunTableFilters, groupByColumn, aggregateColumns)
 	if err != nil {
 		return nil, err
 	}
@@ -313,7 +419,7 @@ FROM %s as %s
 %s
 %s`,
 		groupByColumn.abbrev, groupByColumn.name, selectList,
-		jobTable, jobTableAbbrev,
+		fromTable, jobTableAbbrev,
 		activeJobSetsFilter,
 		joinLatestJobRuns,
 		jobWhere,
@@ -348,7 +454,7 @@ func (qb *QueryBuilder) getGroupByColumn(groupedField *model.GroupedField) (quer
 	}
 
 	return queryColumn{
-		name:   groupedField.Field,
+		name:   column,
 		table:  table,
 		abbrev: tableAbbrev,
 	}, nil
@@ -357,23 +463,31 @@ func (qb *QueryBuilder) getGroupByColumn(groupedField *model.GroupedField) (quer
 func (qb *QueryBuilder) groupByJobsJoinWithLatestActiveJobRun(
 	jobRunTableFilters []*model.Filter,
 	groupByQueryColumn queryColumn,
+	aggregateColumns []*queryColumn,
 ) (string, error) {
-	if len(jobRunTableFilters) == 0 {
-		if groupByQueryColumn.table != jobRunTable {
-			return "", nil
+	// Job run columns selected other than those filtered on
+	var jobRunColumns []string
+	if groupByQueryColumn.table == jobRunTable {
+		jobRunColumns = append(jobRunColumns, groupByQueryColumn.name)
+	}
+	for _, aggregateColumn := range aggregateColumns {
+		if aggregateColumn.table == jobRunTable && !slices.Contains(jobRunColumns, aggregateColumn.name) {
+			jobRunColumns = append(jobRunColumns, aggregateColumn.name)
 		}
+	}
 
-		groupByColumn, err := qb.lookoutTables.ColumnFromField(groupByQueryColumn.name)
-		if err != nil {
-			return "", err
+	if len(jobRunTableFilters) == 0 {
+		if len(jobRunColumns) == 0 {
+			return "", nil
 		}
 
 		return fmt.Sprintf(
-			"LEFT JOIN (SELECT run_id, %s FROM %s) AS %s ON %s.run_id = %s.latest_run_id",
-			groupByColumn,
+			"LEFT JOIN (SELECT run_id, %s FROM %s AS %s) AS %s ON %s.run_id = %s.latest_run_id",
+			strings.Join(qb.jobRunColumnsSelectSql(jobRunColumns), ", "),
 			jobRunTable,
 			jobRunTableAbbrev,
 			jobRunTableAbbrev,
+			jobRunTableAbbrev,
 			jobTableAbbrev,
 		), nil
 	}
@@ -392,15 +506,14 @@ func (qb *QueryBuilder) groupByJobsJoinWithLatestActiveJobRun(
 		columnsToSelect = append(columnsToSelect, column)
 	}
 
+	for _, column := range jobRunColumns {
+		if !slices.Contains(columnsToSelect, column) {
+			columnsToSelect = append(columnsToSelect, column)
+		}
+	}
+
 	var joinType string
 	if groupByQueryColumn.table == jobRunTable {
-		groupByColumn, err := qb.lookoutTables.ColumnFromField(groupByQueryColumn.name)
-		if err != nil {
-			return "", err
-		}
-		if !slices.Contains(columnsToSelect, groupByColumn) {
-			columnsToSelect = append(columnsToSelect, groupByColumn)
-		}
 		joinType = "LEFT JOIN"
 	} else {
 		joinType = "INNER JOIN"
@@ -409,7 +522,7 @@ func (qb *QueryBuilder) groupByJobsJoinWithLatestActiveJobRun(
 	return fmt.Sprintf(
 		"%s (SELECT %s FROM %s AS %s %s) AS %s ON %s.run_id = %s.latest_run_id",
 		joinType,
-		strings.Join(columnsToSelect, ", "),
+		strings.Join(qb.jobRunColumnsSelectSql(columnsToSelect), ", "),
 		jobRunTable,
 		jobRunTableAbbrev,
 		jobRunWhere,
@@ -419,6 +532,13 @@ func (qb *QueryBuilder) groupByJobsJoinWithLatestActiveJobRun(
 	), nil
 }
 
+// jobRunColumnsSelectSql returns the SQL selecting the given columns from the job run table, computing derived columns
+func (qb *QueryBuilder) jobRunColumnsSelectSql(columns []string) []string {
+	return armadaslices.Map(columns, func(column string) string {
+		return qb.lookoutTables.ColumnSelectSql(column, jobRunTableAbbrev)
+	})
+}
+
 func (qb *QueryBuilder) createGroupBySQL(order *model.Order, groupCol *queryColumn, aggregates []string) (string, error) {
 	expr := fmt.Sprintf("GROUP BY %s.%s", groupCol.abbrev, groupCol.name)
 	isInAggregators := len(aggregates) > 0 && func(sl []string, t string) bool {
@@ -531,7 +651,18 @@ func (qb *QueryBuilder) groupFiltersByTable(filters []*model.Filter) (groupFilte
 }
 
 func (qb *QueryBuilder) makeWhereClause(filter *model.Filter, tableAbbrev string) (string, error) {
-	var column string
+	if positiveMatch, ok := negatedMatches[filter.Match]; ok {
+		positiveFilter := *filter
+		positiveFilter.Match = positiveMatch
+		clause, err := qb.makeWhereClause(&positiveFilter, tableAbbrev)
+		if err != nil {
+			return "", err
+		}
+		// Jobs without a value, e.g., without the annotation, don't match the filter, so match the negated filter
+		return fmt.Sprintf("NOT COALESCE(%s, false)", clause), nil
+	}
+
+	var column, columnSql string
 	if filter.IsAnnotation {
 		switch filter.Match {
 		case model.MatchExact:
@@ -554,7 +685,7 @@ func (qb *QueryBuilder) makeWhereClause(filter *model.Filter, tableAbbrev string
 			placeholder := qb.recordValue(filter.Field)
 			return fmt.Sprintf("%s.annotations ? %s", tableAbbrev, placeholder), nil
 		default:
-			column = qb.annotationColumn(filter.Field)
+			columnSql = fmt.Sprintf("%s.%s", tableAbbrev, qb.annotationColumn(filter.Field))
 		}
 	} else {
 		var err error
@@ -562,6 +693,10 @@ func (qb *QueryBuilder) makeWhereClause(filter *model.Filter, tableAbbrev string
 		if err != nil {
 			return "", err
 		}
+		if duration, ok := qb.lookoutTables.durationColumnFor(column); ok {
+			return qb.makeDurationWhereClause(duration, filter, tableAbbrev)
+		}
+		columnSql = qb.lookoutTables.ColumnSql(column, tableAbbrev)
 	}
 
 	operator, err := operatorForMatch(filter.Match)
@@ -582,7 +717,36 @@ func (qb *QueryBuilder) makeWhereClause(filter *model.Filter, tableAbbrev string
 		return "", err
 	}
 
-	return fmt.Sprintf("%s.%s %s %s", tableAbbrev, column, operator, placeholder), nil
+	return fmt.Sprintf("%s %s %s", columnSql, operator, placeholder), nil
+}
+
+// makeDurationWhereClause compares a duration with the filter's value, in seconds. Where the duration has ended,
+// end - start is compared, which can use the expression index on it. Otherwise the duration runs until now, so start is
+// compared with now less the value instead, which doesn't depend on now for every row.
+func (qb *QueryBuilder) makeDurationWhereClause(duration durationColumn, filter *model.Filter, tableAbbrev string) (string, error) {
+	operator, err := operatorForMatch(filter.Match)
+	if err != nil {
+		return "", err
+	}
+	reversedOperator, ok := reversedComparisonOperators[operator]
+	if !ok {
+		return "", errors.Errorf("unsupported match type for duration: %s", filter.Match)
+	}
+	interval := fmt.Sprintf("make_interval(secs => %s)", qb.recordValue(filter.Value))
+	start := fmt.Sprintf("%s.%s", tableAbbrev, duration.start)
+	end := fmt.Sprintf("%s.%s", tableAbbrev, duration.end)
+	return fmt.Sprintf(
+		"((%[2]s IS NOT NULL AND %[2]s - %[1]s %[3]s %[5]s) OR (%[2]s IS NULL AND %[1]s %[4]s NOW() AT TIME ZONE 'UTC' - %[5]s))",
+		start, end, operator, reversedOperator, interval,
+	), nil
+}
+
+// reversedComparisonOperators maps each comparison operator to the one for the operands swapped, e.g., a > b is b < a
+var reversedComparisonOperators = map[string]string{
+	">":  "<",
+	"<":  ">",
+	">=": "<=",
+	"<=": ">=",
 }
 
 func (qb *QueryBuilder) annotationColumn(key string) string {
@@ -594,6 +758,33 @@ func (qb *QueryBuilder) makeOrderBy(order *model.Order) (string, error) {
 	if orderIsNull(order) {
 		return "", nil
 	}
+	orderSql, err := qb.orderColumnSql(order)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("ORDER BY %s %s", orderSql, order.Direction), nil
+}
+
+// makeJobsOrderBy orders jobs by the order column and then job id, so that jobs with the same value in the order column
+// are always returned in the same order, and pages fetched with skip neither repeat nor miss jobs
+func (qb *QueryBuilder) makeJobsOrderBy(order *model.Order) (string, error) {
+	orderBy, err := qb.makeOrderBy(order)
+	if err != nil || orderBy == "" {
+		return orderBy, err
+	}
+	orderSql, err := qb.orderColumnSql(order)
+	if err != nil {
+		return "", err
+	}
+	jobIdSql := fmt.Sprintf("%s.%s", jobTableAbbrev, jobIdCol)
+	if orderSql == jobIdSql {
+		return orderBy, nil
+	}
+	return fmt.Sprintf("%s, %s %s", orderBy, jobIdSql, order.Direction), nil
+}
+
+// orderColumnSql returns the expression jobs are ordered by
+func (qb *QueryBuilder) orderColumnSql(order *model.Order) (string, error) {
 	column, err := qb.lookoutTables.ColumnFromField(order.Field)
 	if err != nil {
 		return "", err
@@ -607,8 +798,52 @@ func (qb *QueryBuilder) makeOrderBy(order *model.Order) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if table == jobRunTable {
+		// Job run columns, even derived ones, are selected by the join with the latest run
+		return fmt.Sprintf("%s.%s", tableAbbrev, column), nil
+	}
+
+	return qb.lookoutTables.ColumnSql(column, tableAbbrev), nil
+}
+
+// keysetWhereClause returns the condition matching jobs ordered after the cursor, ordering by orderSql and then job id.
+// Null values of orderSql sort after all other values in ascending order, and before them in descending order, as
+// they do by default in Postgres.
+func (qb *QueryBuilder) keysetWhereClause(orderSql string, direction string, after *jobsCursor) string {
+	comparison := ">"
+	if direction == model.DirectionDesc {
+		comparison = "<"
+	}
+	jobIdSql := fmt.Sprintf("%s.%s", jobTableAbbrev, jobIdCol)
+	jobId := qb.recordValue(after.JobId)
+	if orderSql == jobIdSql {
+		return fmt.Sprintf("%s %s %s", jobIdSql, comparison, jobId)
+	}
 
-	return fmt.Sprintf("ORDER BY %s.%s %s", tableAbbrev, column, order.Direction), nil
+	if after.OrderValue == nil {
+		if direction == model.DirectionDesc {
+			return fmt.Sprintf("(%s IS NOT NULL OR %s %s %s)", orderSql, jobIdSql, comparison, jobId)
+		}
+		return fmt.Sprintf("(%s IS NULL AND %s %s %s)", orderSql, jobIdSql, comparison, jobId)
+	}
+
+	orderValue := qb.recordValue(after.OrderValue)
+	clause := fmt.Sprintf(
+		"%s %s %s OR (%s = %s AND %s %s %s)",
+		orderSql, comparison, orderValue,
+		orderSql, orderValue, jobIdSql, comparison, jobId,
+	)
+	if direction == model.DirectionDesc {
+		return fmt.Sprintf("(%s)", clause)
+	}
+	return fmt.Sprintf("(%s OR %s IS NULL)", clause, orderSql)
+}
+
+// negatedMatches maps each negated match to the match it negates
+var negatedMatches = map[string]string{
+	model.MatchNotEqual:    model.MatchExact,
+	model.MatchNotAnyOf:    model.MatchAnyOf,
+	model.MatchNotContains: model.MatchContains,
 }
 
 func operatorForMatch(match string) (string, error) {
@@ -629,6 +864,8 @@ func operatorForMatch(match string) (string, error) {
 		return ">=", nil
 	case model.MatchLessThanOrEqualTo:
 		return "<=", nil
+	case model.MatchRegex:
+		return "~", nil
 	default:
 		err := errors.Errorf("unsupported match type: %s", match)
 		log.Error(err.Error())
@@ -693,15 +930,11 @@ func (qb *QueryBuilder) getQueryAggregators(
 ) ([]QueryAggregator, error) {
 	var queryAggregators []QueryAggregator
 	for _, aggregate := range aggregates {
-		col, err := qb.lookoutTables.ColumnFromField(aggregate)
+		aggregateColumn, err := qb.getAggregateColumn(aggregate)
 		if err != nil {
 			return nil, err
 		}
-		aggregateColumn := &queryColumn{
-			name:   col,
-			table:  jobTable,
-			abbrev: jobTableAbbrev,
-		}
+		col := aggregateColumn.name
 
 		// For lastTransitionTime, use the selected aggregate type if specified
 		var aggregateType AggregateType
@@ -722,6 +955,52 @@ func (qb *QueryBuilder) getQueryAggregators(
 	return queryAggregators, nil
 }
 
+// getAggregateColumns returns the columns the given aggregates are computed from
+func (qb *QueryBuilder) getAggregateColumns(aggregates []string) ([]*queryColumn, error) {
+	aggregateColumns := make([]*queryColumn, 0, len(aggregates))
+	for _, aggregate := range aggregates {
+		aggregateColumn, err := qb.getAggregateColumn(aggregate)
+		if err != nil {
+			return nil, err
+		}
+		aggregateColumns = append(aggregateColumns, aggregateColumn)
+
+		aggregateType, err := qb.lookoutTables.GroupAggregateForCol(aggregateColumn.name)
+		if err != nil {
+			return nil, err
+		}
+		if aggregateType == ResourceTotals {
+			// Resource seconds need the runtime of the latest run
+			aggregateColumns = append(aggregateColumns, &queryColumn{
+				name:   runtimeCol,
+				table:  jobRunTable,
+				abbrev: jobRunTableAbbrev,
+			})
+		}
+	}
+	return aggregateColumns, nil
+}
+
+func (qb *QueryBuilder) getAggregateColumn(aggregate string) (*queryColumn, error) {
+	col, err := qb.lookoutTables.ColumnFromField(aggregate)
+	if err != nil {
+		return nil, err
+	}
+	table, err := qb.lookoutTables.TableForCol(col)
+	if err != nil {
+		return nil, err
+	}
+	tableAbbrev, err := qb.lookoutTables.TableAbbrev(table)
+	if err != nil {
+		return nil, err
+	}
+	return &queryColumn{
+		name:   col,
+		table:  table,
+		abbrev: tableAbbrev,
+	}, nil
+}
+
 func (qb *QueryBuilder) getAggregatesSql(aggregators []QueryAggregator) (string, error) {
 	selectList := []string{fmt.Sprintf("COUNT(*) AS %s", countCol)}
 	for _, agg := range aggregators {
@@ -745,6 +1024,10 @@ func (qb *QueryBuilder) groupByOrderSql(order *model.Order) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	aggregateType, err := qb.lookoutTables.GroupAggregateForCol(col)
+	if err == nil && (aggregateType == ResourceTotals || aggregateType == RuntimePercentiles) {
+		return fmt.Sprintf("ORDER BY %s %s", nestedAggregateName(col, totalAggregateKey), order.Direction), nil
+	}
 	return fmt.Sprintf("ORDER BY %s %s", col, order.Direction), nil
 }
 
@@ -784,6 +1067,22 @@ func (qb *QueryBuilder) validateFilter(filter *model.Filter) error {
 	if !qb.lookoutTables.SupportsMatch(col, filter.Match) {
 		return errors.Errorf("match %s is not supported for field %s", filter.Match, filter.Field)
 	}
+	return validateRegexFilter(filter)
+}
+
+// validateRegexFilter checks regexes are valid RE2 expressions, which excludes back references, as they can make Postgres regex matches very slow.
+// RE2 accepts some syntax Postgres does not, so queries can still fail with ErrInvalidRegex when Postgres compiles the regex
+func validateRegexFilter(filter *model.Filter) error {
+	if filter.Match != model.MatchRegex {
+		return nil
+	}
+	pattern, ok := filter.Value.(string)
+	if !ok {
+		return errors.Errorf("regex for field %s must be a string, got %T", filter.Field, filter.Value)
+	}
+	if _, err := regexp.Compile(pattern); err != nil {
+		return errors.Wrapf(err, "invalid regex for field %s", filter.Field)
+	}
 	return nil
 }
 
@@ -793,10 +1092,13 @@ func validateAnnotationFilter(filter *model.Filter) error {
 		model.MatchStartsWith,
 		model.MatchContains,
 		model.MatchExists,
+		model.MatchRegex,
+		model.MatchNotEqual,
+		model.MatchNotContains,
 	}, filter.Match) {
 		return errors.Errorf("match %s is not supported for annotation", filter.Match)
 	}
-	return nil
+	return validateRegexFilter(filter)
 }
 
 func (qb *QueryBuilder) validateOrder(order *model.Order) error {
@@ -824,7 +1126,7 @@ func orderIsNull(order *model.Order) bool {
 	return order == nil || (order.Direction == "" && order.Field == "")
 }
 
-func (qb *QueryBuilder) validateGroupOrder(order *model.Order, groupedField *model.GroupedField) error {
+func (qb *QueryBuilder) validateGroupOrder(order *model.Order, groupedField *model.GroupedField, aggregates []string) error {
 	if order == nil {
 		return nil
 	}
@@ -848,6 +1150,10 @@ func (qb *QueryBuilder) validateGroupOrder(order *model.Order, groupedField *mod
 			return errors.Errorf("unsupported field for order: %s", order.Field)
 		}
 	}
+	// Aggregates made of several values are ordered by their total, which is only selected if it is aggregated.
+	if aggErr == nil && (aggregateType == ResourceTotals || aggregateType == RuntimePercentiles) && !slices.Contains(aggregates, order.Field) {
+		return errors.Errorf("unsupported field for order: %s, it must also be aggregated", order.Field)
+	}
 
 	// If it is not an aggregate and not groupable, it can't be ordered by
 	if aggErr != nil && !qb.lookoutTables.IsGroupable(col) {
@@ -871,6 +1177,63 @@ func (qb *QueryBuilder) validateGroupedField(groupedField *model.GroupedField) e
 	return nil
 }
 
+// jobTableForFilters returns the table to select the jobs matching the given job table filters from. The job table is
+// partitioned by state, so when the filters only match active states, or only terminated states, the jobs are
+// selected from that partition directly. This doesn't rely on Postgres excluding the other partition itself, which it
+// can't do when planning a query whose states are bound parameters.
+func jobTableForFilters(filters []*model.Filter) (string, error) {
+	states := map[int]bool{}
+	for _, ordinal := range lookout.JobStateOrdinalMap {
+		states[ordinal] = true
+	}
+	for _, filter := range filters {
+		if filter.IsAnnotation || filter.Field != stateField {
+			continue
+		}
+		value, err := parseValueForState(filter.Value)
+		if err != nil {
+			return "", err
+		}
+		var filterStates []int
+		switch v := value.(type) {
+		case int:
+			filterStates = []int{v}
+		case []int:
+			filterStates = v
+		}
+		matched := map[int]bool{}
+		for _, state := range filterStates {
+			matched[state] = true
+		}
+		_, negated := negatedMatches[filter.Match]
+		for state := range states {
+			if matched[state] == negated {
+				delete(states, state)
+			}
+		}
+	}
+
+	if len(states) == 0 {
+		return jobTable, nil
+	}
+	if allStatesIn(states, activeJobStates) {
+		return jobActiveTable, nil
+	}
+	if allStatesIn(states, terminatedJobStates) {
+		return jobTerminatedTable, nil
+	}
+	return jobTable, nil
+}
+
+func allStatesIn(states map[int]bool, partitionStates []int) bool {
+	for state := range states {
+		if !slices.Contains(partitionStates, state) {
+			return false
+		}
+	}
+	return true
+}
+
 func stateToOrdinal(state string) (int, error) {
 	ordinal, ok := lookout.JobStateOrdinalMap[lookout.JobState(state)]
 	if !ok {
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/database/lookout"
+	"github.com/armadaproject/armada/internal/lookout/model"
+)
+
+func TestJobTableForFilters(t *testing.T) {
+	stateFilter := func(match string, value interface{}) *model.Filter {
+		return &model.Filter{Field: stateField, Match: match, Value: value}
+	}
+	queueFilter := &model.Filter{Field: "queue", Match: model.MatchExact, Value: "queue-a"}
+	tests := map[string]struct {
+		filters  []*model.Filter
+		expected string
+	}{
+		"no filters": {
+			expected: jobTable,
+		},
+		"no state filter": {
+			filters:  []*model.Filter{queueFilter},
+			expected: jobTable,
+		},
+		"active state": {
+			filters:  []*model.Filter{queueFilter, stateFilter(model.MatchExact, string(lookout.JobRunning))},
+			expected: jobActiveTable,
+		},
+		"active states": {
+			filters:  []*model.Filter{stateFilter(model.MatchAnyOf, []string{string(lookout.JobQueued), string(lookout.JobLeased)})},
+			expected: jobActiveTable,
+		},
+		"active states as interfaces": {
+			filters:  []*model.Filter{stateFilter(model.MatchAnyOf, []interface{}{string(lookout.JobPending), string(lookout.JobRunning)})},
+			expected: jobActiveTable,
+		},
+		"terminated state": {
+			filters:  []*model.Filter{stateFilter(model.MatchExact, string(lookout.JobRejected))},
+			expected: jobTerminatedTable,
+		},
+		"active and terminated states": {
+			filters:  []*model.Filter{stateFilter(model.MatchAnyOf, []string{string(lookout.JobRunning), string(lookout.JobFailed)})},
+			expected: jobTable,
+		},
+		"not terminated states": {
+			filters: []*model.Filter{stateFilter(model.MatchNotAnyOf, []string{
+				string(lookout.JobSucceeded),
+				string(lookout.JobFailed),
+				string(lookout.JobCancelled),
+				string(lookout.JobPreempted),
+				string(lookout.JobRejected),
+			})},
+			expected: jobActiveTable,
+		},
+		"not one terminated state": {
+			filters:  []*model.Filter{stateFilter(model.MatchNotEqual, string(lookout.JobSucceeded))},
+			expected: jobTable,
+		},
+		"intersecting state filters": {
+			filters: []*model.Filter{
+				stateFilter(model.MatchAnyOf, []string{string(lookout.JobRunning), string(lookout.JobFailed)}),
+				stateFilter(model.MatchNotEqual, string(lookout.JobRunning)),
+			},
+			expected: jobTerminatedTable,
+		},
+		"state annotation": {
+			filters:  []*model.Filter{{Field: stateField, Match: model.MatchExact, Value: string(lookout.JobRunning), IsAnnotation: true}},
+			expected: jobTable,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			table, err := jobTableForFilters(tc.filters)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, table)
+		})
+	}
+}
+
+func TestJobTableForFilters_UnknownState(t *testing.T) {
+	_, err := jobTableForFilters([]*model.Filter{{Field: stateField, Match: model.MatchExact, Value: "NOT_A_STATE"}})
+	assert.Error(t, err)
+}
+
+func TestGetJobs_ActiveStateFilterSelectsFromActivePartition(t *testing.T) {
+	query, err := NewQueryBuilder(NewTables()).GetJobs(
+		[]*model.Filter{{Field: stateField, Match: model.MatchAnyOf, Value: []string{string(lookout.JobQueued), string(lookout.JobRunning)}}},
+		true,
+		&model.Order{},
+		0,
+		10,
+	)
+	require.NoError(t, err)
+	assert.Contains(t, query.Sql, "FROM job_active AS j")
+	assert.Contains(t, query.Sql, "FROM job_active\n)")
+	assert.NotContains(t, query.Sql, "FROM job AS j")
+}
+
+func TestGetJobs_OrderIsTiebrokenByJobId(t *testing.T) {
+	query, err := NewQueryBuilder(NewTables()).GetJobs(
+		[]*model.Filter{},
+		false,
+		&model.Order{Field: "submitted", Direction: model.DirectionDesc},
+		20,
+		10,
+	)
+	require.NoError(t, err)
+	assert.Contains(t, query.Sql, "ORDER BY j.submitted DESC, j.job_id DESC")
+
+	query, err = NewQueryBuilder(NewTables()).GetJobs(
+		[]*model.Filter{},
+		false,
+		&model.Order{Field: jobIdField, Direction: model.DirectionAsc},
+		20,
+		10,
+	)
+	require.NoError(t, err)
+	assert.Contains(t, query.Sql, "ORDER BY j.job_id ASC\n")
+}
+
+func TestGetJobs_DurationFiltersCompareEndedDurationsWithoutNow(t *testing.T) {
+	query, err := NewQueryBuilder(NewTables()).GetJobs(
+		[]*model.Filter{
+			{Field: "queuedDuration", Match: model.MatchGreaterThan, Value: 60},
+			{Field: "runtime", Match: model.MatchLessThanOrEqualTo, Value: 3600},
+		},
+		false,
+		&model.Order{},
+		0,
+		10,
+	)
+	require.NoError(t, err)
+	assert.Contains(t, query.Sql,
+		"((j.first_leased IS NOT NULL AND j.first_leased - j.submitted > make_interval(secs => $1)) "+
+			"OR (j.first_leased IS NULL AND j.submitted < NOW() AT TIME ZONE 'UTC' - make_interval(secs => $1)))")
+	assert.Contains(t, query.Sql,
+		"((jr.finished IS NOT NULL AND jr.finished - jr.started <= make_interval(secs => $2)) "+
+			"OR (jr.finished IS NULL AND jr.started >= NOW() AT TIME ZONE 'UTC' - make_interval(secs => $2)))")
+	assert.Equal(t, []interface{}{60, 3600}, query.Args)
+}
@@ -0,0 +1,262 @@
+package repository
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+	"k8s.io/utils/clock"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/lookout/model"
+)
+
+const (
+	savedViewIdAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	savedViewIdLength   = 8
+
+	// maxSavedViewIdAttempts limits the number of ids tried when a generated id is already taken
+	maxSavedViewIdAttempts = 5
+)
+
+// SavedViewRepository stores views of Lookout saved by users. Every method takes the name of the user making the
+// request: views can only be changed by their owner, and private views can only be seen by their owner.
+type SavedViewRepository interface {
+	CreateSavedView(ctx *armadacontext.Context, user string, view *model.SavedView) (*model.SavedView, error)
+	GetSavedView(ctx *armadacontext.Context, user string, id string) (*model.SavedView, error)
+	ListSavedViews(ctx *armadacontext.Context, user string) ([]*model.SavedView, error)
+	UpdateSavedView(ctx *armadacontext.Context, user string, view *model.SavedView) (*model.SavedView, error)
+	DeleteSavedView(ctx *armadacontext.Context, user string, id string) error
+}
+
+type SqlSavedViewRepository struct {
+	db    *pgxpool.Pool
+	clock clock.Clock
+}
+
+func NewSqlSavedViewRepository(db *pgxpool.Pool) *SqlSavedViewRepository {
+	return &SqlSavedViewRepository{
+		db:    db,
+		clock: clock.RealClock{},
+	}
+}
+
+// CreateSavedView stores the view under a new short id, owned by the user
+func (r *SqlSavedViewRepository) CreateSavedView(ctx *armadacontext.Context, user string, view *model.SavedView) (*model.SavedView, error) {
+	if err := validateSavedView(view); err != nil {
+		return nil, err
+	}
+	filters, grouping, columns, err := marshalSavedView(view)
+	if err != nil {
+		return nil, err
+	}
+	now := r.clock.Now().UTC()
+	for attempt := 0; attempt < maxSavedViewIdAttempts; attempt++ {
+		id, err := newSavedViewId()
+		if err != nil {
+			return nil, err
+		}
+		cmdTag, err := r.db.Exec(ctx, `
+			INSERT INTO saved_view (id, name, owner, filters, grouping, columns, visibility, created, updated)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $8)
+			ON CONFLICT (id) DO NOTHING`,
+			id, view.Name, user, filters, grouping, columns, view.Visibility, now)
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating saved view")
+		}
+		if cmdTag.RowsAffected() == 1 {
+			return &model.SavedView{
+				Id:         id,
+				Name:       view.Name,
+				Owner:      user,
+				Filters:    nonNil(view.Filters),
+				Grouping:   nonNil(view.Grouping),
+				Columns:    nonNil(view.Columns),
+				Visibility: view.Visibility,
+				Created:    now,
+				Updated:    now,
+			}, nil
+		}
+	}
+	return nil, errors.Errorf("could not generate an unused id for saved view after %d attempts", maxSavedViewIdAttempts)
+}
+
+// GetSavedView returns the view with the given short id, if it's public or owned by the user
+func (r *SqlSavedViewRepository) GetSavedView(ctx *armadacontext.Context, user string, id string) (*model.SavedView, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT id, name, owner, filters, grouping, columns, visibility, created, updated
+		FROM saved_view
+		WHERE id = $1 AND (owner = $2 OR visibility = $3)`,
+		id, user, model.VisibilityPublic)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting saved view")
+	}
+	views, err := scanSavedViews(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(views) == 0 {
+		return nil, fmt.Errorf("saved view with id %s not found: %w", id, ErrNotFound)
+	}
+	return views[0], nil
+}
+
+// ListSavedViews returns the views owned by the user, and the public views of other users, ordered by name
+func (r *SqlSavedViewRepository) ListSavedViews(ctx *armadacontext.Context, user string) ([]*model.SavedView, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT id, name, owner, filters, grouping, columns, visibility, created, updated
+		FROM saved_view
+		WHERE owner = $1 OR visibility = $2
+		ORDER BY name, id`,
+		user, model.VisibilityPublic)
+	if err != nil {
+		return nil, errors.Wrap(err, "error listing saved views")
+	}
+	return scanSavedViews(rows)
+}
+
+// UpdateSavedView replaces the name, filters, grouping, columns and visibility of the view with the id of the view
+// given. Only the owner of a view can update it.
+func (r *SqlSavedViewRepository) UpdateSavedView(ctx *armadacontext.Context, user string, view *model.SavedView) (*model.SavedView, error) {
+	if view.Id == "" {
+		return nil, errors.New("id of saved view to update must be given")
+	}
+	if err := validateSavedView(view); err != nil {
+		return nil, err
+	}
+	filters, grouping, columns, err := marshalSavedView(view)
+	if err != nil {
+		return nil, err
+	}
+	now := r.clock.Now().UTC()
+	var created time.Time
+	err = r.db.QueryRow(ctx, `
+		UPDATE saved_view
+		SET name = $3, filters = $4, grouping = $5, columns = $6, visibility = $7, updated = $8
+		WHERE id = $1 AND owner = $2
+		RETURNING created`,
+		view.Id, user, filters, grouping, columns, view.Visibility, now).Scan(&created)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, r.ownerError(ctx, user, view.Id)
+		}
+		return nil, errors.Wrap(err, "error updating saved view")
+	}
+	return &model.SavedView{
+		Id:         view.Id,
+		Name:       view.Name,
+		Owner:      user,
+		Filters:    nonNil(view.Filters),
+		Grouping:   nonNil(view.Grouping),
+		Columns:    nonNil(view.Columns),
+		Visibility: view.Visibility,
+		Created:    created,
+		Updated:    now,
+	}, nil
+}
+
+// DeleteSavedView deletes the view with the given short id. Only the owner of a view can delete it.
+func (r *SqlSavedViewRepository) DeleteSavedView(ctx *armadacontext.Context, user string, id string) error {
+	cmdTag, err := r.db.Exec(ctx, "DELETE FROM saved_view WHERE id = $1 AND owner = $2", id, user)
+	if err != nil {
+		return errors.Wrap(err, "error deleting saved view")
+	}
+	if cmdTag.RowsAffected() == 0 {
+		return r.ownerError(ctx, user, id)
+	}
+	return nil
+}
+
+// ownerError returns the error for a view that couldn't be changed by the user: ErrNotFound if the view doesn't
+// exist or the user can't see it, and ErrPermissionDenied if it's a public view owned by another user
+func (r *SqlSavedViewRepository) ownerError(ctx *armadacontext.Context, user string, id string) error {
+	var visibility string
+	err := r.db.QueryRow(ctx, "SELECT visibility FROM saved_view WHERE id = $1", id).Scan(&visibility)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return errors.Wrap(err, "error getting saved view")
+	}
+	if errors.Is(err, pgx.ErrNoRows) || visibility != model.VisibilityPublic {
+		return fmt.Errorf("saved view with id %s not found: %w", id, ErrNotFound)
+	}
+	return fmt.Errorf("saved view with id %s is owned by another user: %w", id, ErrPermissionDenied)
+}
+
+func validateSavedView(view *model.SavedView) error {
+	if view.Name == "" {
+		return errors.New("name of saved view must not be empty")
+	}
+	if view.Visibility != model.VisibilityPrivate && view.Visibility != model.VisibilityPublic {
+		return errors.Errorf("visibility of saved view must be %s or %s, got %q", model.VisibilityPrivate, model.VisibilityPublic, view.Visibility)
+	}
+	return nil
+}
+
+func marshalSavedView(view *model.SavedView) ([]byte, []byte, []byte, error) {
+	filters, err := json.Marshal(nonNil(view.Filters))
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "error marshalling filters of saved view")
+	}
+	grouping, err := json.Marshal(nonNil(view.Grouping))
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "error marshalling grouping of saved view")
+	}
+	columns, err := json.Marshal(nonNil(view.Columns))
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "error marshalling columns of saved view")
+	}
+	return filters, grouping, columns, nil
+}
+
+func scanSavedViews(rows pgx.Rows) ([]*model.SavedView, error) {
+	defer rows.Close()
+	views := []*model.SavedView{}
+	for rows.Next() {
+		var view model.SavedView
+		var filters, grouping, columns []byte
+		if err := rows.Scan(
+			&view.Id, &view.Name, &view.Owner, &filters, &grouping, &columns, &view.Visibility, &view.Created, &view.Updated,
+		); err != nil {
+			return nil, errors.Wrap(err, "error reading saved view")
+		}
+		if err := json.Unmarshal(filters, &view.Filters); err != nil {
+			return nil, errors.Wrap(err, "error unmarshalling filters of saved view")
+		}
+		if err := json.Unmarshal(grouping, &view.Grouping); err != nil {
+			return nil, errors.Wrap(err, "error unmarshalling grouping of saved view")
+		}
+		if err := json.Unmarshal(columns, &view.Columns); err != nil {
+			return nil, errors.Wrap(err, "error unmarshalling columns of saved view")
+		}
+		views = append(views, &view)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "error reading saved views")
+	}
+	return views, nil
+}
+
+// newSavedViewId returns a random id, short enough to share
+func newSavedViewId() (string, error) {
+	id := make([]byte, savedViewIdLength)
+	alphabetSize := big.NewInt(int64(len(savedViewIdAlphabet)))
+	for i := range id {
+		n, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", errors.Wrap(err, "error generating saved view id")
+		}
+		id[i] = savedViewIdAlphabet[n.Int64()]
+	}
+	return string(id), nil
+}
+
+func nonNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
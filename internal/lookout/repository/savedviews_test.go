@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clock "k8s.io/utils/clock/testing"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/database/lookout"
+	"github.com/armadaproject/armada/internal/lookout/model"
+)
+
+const otherOwner = "user-2"
+
+func withSavedViewSetup(f func(*SqlSavedViewRepository, *clock.FakeClock) error) error {
+	return lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		testClock := clock.NewFakeClock(baseTime)
+		repo := NewSqlSavedViewRepository(db)
+		repo.clock = testClock
+		return f(repo, testClock)
+	})
+}
+
+func TestSavedViews(t *testing.T) {
+	err := withSavedViewSetup(func(repo *SqlSavedViewRepository, testClock *clock.FakeClock) error {
+		ctx := armadacontext.TODO()
+		created, err := repo.CreateSavedView(ctx, owner, &model.SavedView{
+			Name: "failed jobs",
+			Filters: []*model.Filter{
+				{Field: "queue", Match: model.MatchExact, Value: queue},
+				{Field: "state", Match: model.MatchAnyOf, Value: []interface{}{"FAILED"}},
+			},
+			Grouping:   []string{"jobSet"},
+			Columns:    []string{"jobId", "state"},
+			Visibility: model.VisibilityPrivate,
+		})
+		require.NoError(t, err)
+		assert.Len(t, created.Id, savedViewIdLength)
+		assert.Equal(t, owner, created.Owner)
+		assert.Equal(t, baseTime, created.Created)
+
+		fetched, err := repo.GetSavedView(ctx, owner, created.Id)
+		require.NoError(t, err)
+		assert.Equal(t, created, fetched)
+
+		// Private views can't be seen by other users
+		_, err = repo.GetSavedView(ctx, otherOwner, created.Id)
+		assert.ErrorIs(t, err, ErrNotFound)
+		views, err := repo.ListSavedViews(ctx, otherOwner)
+		require.NoError(t, err)
+		assert.Empty(t, views)
+
+		testClock.Step(time.Minute)
+		created.Visibility = model.VisibilityPublic
+		updated, err := repo.UpdateSavedView(ctx, owner, created)
+		require.NoError(t, err)
+		assert.Equal(t, baseTime, updated.Created)
+		assert.Equal(t, baseTime.Add(time.Minute), updated.Updated)
+
+		// Public views can be seen, but not changed, by other users
+		fetched, err = repo.GetSavedView(ctx, otherOwner, created.Id)
+		require.NoError(t, err)
+		assert.Equal(t, updated, fetched)
+		views, err = repo.ListSavedViews(ctx, otherOwner)
+		require.NoError(t, err)
+		assert.Equal(t, []*model.SavedView{updated}, views)
+		_, err = repo.UpdateSavedView(ctx, otherOwner, created)
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+		assert.ErrorIs(t, repo.DeleteSavedView(ctx, otherOwner, created.Id), ErrPermissionDenied)
+
+		require.NoError(t, repo.DeleteSavedView(ctx, owner, created.Id))
+		_, err = repo.GetSavedView(ctx, owner, created.Id)
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.ErrorIs(t, repo.DeleteSavedView(ctx, owner, created.Id), ErrNotFound)
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestListSavedViews(t *testing.T) {
+	err := withSavedViewSetup(func(repo *SqlSavedViewRepository, _ *clock.FakeClock) error {
+		ctx := armadacontext.TODO()
+		for _, view := range []struct {
+			owner      string
+			name       string
+			visibility string
+		}{
+			{owner: owner, name: "b", visibility: model.VisibilityPrivate},
+			{owner: otherOwner, name: "a", visibility: model.VisibilityPublic},
+			{owner: otherOwner, name: "c", visibility: model.VisibilityPrivate},
+		} {
+			_, err := repo.CreateSavedView(ctx, view.owner, &model.SavedView{Name: view.name, Visibility: view.visibility})
+			require.NoError(t, err)
+		}
+
+		views, err := repo.ListSavedViews(ctx, owner)
+		require.NoError(t, err)
+		require.Len(t, views, 2)
+		assert.Equal(t, "a", views[0].Name)
+		assert.Equal(t, "b", views[1].Name)
+		assert.Equal(t, []*model.Filter{}, views[1].Filters)
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestCreateSavedViewInvalid(t *testing.T) {
+	err := withSavedViewSetup(func(repo *SqlSavedViewRepository, _ *clock.FakeClock) error {
+		ctx := armadacontext.TODO()
+		_, err := repo.CreateSavedView(ctx, owner, &model.SavedView{Visibility: model.VisibilityPrivate})
+		assert.Error(t, err)
+		_, err = repo.CreateSavedView(ctx, owner, &model.SavedView{Name: "view", Visibility: "unlisted"})
+		assert.Error(t, err)
+		return nil
+	})
+	assert.NoError(t, err)
+}
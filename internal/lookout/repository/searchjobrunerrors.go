@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/lookout/model"
+)
+
+const (
+	defaultJobRunErrorSearchTake = 100
+	maxJobRunErrorSearchTake     = 1000
+
+	// searchSnippetStart and searchSnippetStop mark the matching words in snippets. They're not HTML, as the messages
+	// searched aren't escaped.
+	searchSnippetStart = "**"
+	searchSnippetStop  = "**"
+)
+
+// JobRunErrorSearchQuery selects the job runs with errors or debug messages matching Query, which uses web search
+// syntax: words are all matched, "quoted phrases" are matched in order, "or" matches either side and -word excludes
+// a word. Results are filtered to the given queues, if any, and to runs that finished within From and To, if given.
+type JobRunErrorSearchQuery struct {
+	Query  string
+	Queues []string
+	From   *time.Time
+	To     *time.Time
+	Take   int
+}
+
+type JobRunErrorSearchRepository interface {
+	SearchJobRunErrors(ctx *armadacontext.Context, query JobRunErrorSearchQuery) ([]*model.JobRunErrorMatch, error)
+}
+
+type SqlJobRunErrorSearchRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewSqlJobRunErrorSearchRepository(db *pgxpool.Pool) *SqlJobRunErrorSearchRepository {
+	return &SqlJobRunErrorSearchRepository{
+		db: db,
+	}
+}
+
+// SearchJobRunErrors returns the job runs matching the query, most recently finished first. Only the errors of runs
+// indexed by the ingester since indexing was enabled can be found.
+func (r *SqlJobRunErrorSearchRepository) SearchJobRunErrors(ctx *armadacontext.Context, query JobRunErrorSearchQuery) ([]*model.JobRunErrorMatch, error) {
+	if strings.TrimSpace(query.Query) == "" {
+		return nil, errors.New("search query must not be empty")
+	}
+	take := query.Take
+	if take == 0 {
+		take = defaultJobRunErrorSearchTake
+	}
+	if take < 0 || take > maxJobRunErrorSearchTake {
+		return nil, errors.Errorf("take must be between 1 and %d, got %d", maxJobRunErrorSearchTake, take)
+	}
+	if query.From != nil && query.To != nil && !query.From.Before(*query.To) {
+		return nil, errors.New("to must be after from")
+	}
+
+	headlineOptions := fmt.Sprintf("StartSel=%s, StopSel=%s, MaxFragments=3, FragmentDelimiter=\" ... \"", searchSnippetStart, searchSnippetStop)
+	args := []interface{}{query.Query, take, headlineOptions}
+	var conditions []string
+	if len(query.Queues) > 0 {
+		args = append(args, query.Queues)
+		conditions = append(conditions, fmt.Sprintf("AND j.queue = ANY($%d)", len(args)))
+	}
+	if query.From != nil {
+		args = append(args, query.From.UTC())
+		conditions = append(conditions, fmt.Sprintf("AND jr.finished >= $%d", len(args)))
+	}
+	if query.To != nil {
+		args = append(args, query.To.UTC())
+		conditions = append(conditions, fmt.Sprintf("AND jr.finished < $%d", len(args)))
+	}
+
+	// Snippets are only made for the rows returned, as making them is much slower than matching
+	sql := fmt.Sprintf(`
+		WITH matches AS (
+			SELECT s.job_id, s.run_id, j.queue, j.jobset, jr.finished, s.error, s.debug
+			FROM job_run_error_search s
+			JOIN job_run jr ON jr.run_id = s.run_id
+			JOIN job j ON j.job_id = s.job_id
+			WHERE s.search @@ websearch_to_tsquery('simple', $1) %s
+			ORDER BY jr.finished DESC NULLS LAST, s.run_id
+			LIMIT $2
+		)
+		SELECT
+			job_id, run_id, queue, jobset, finished,
+			CASE WHEN to_tsvector('simple', coalesce(error, '')) @@ websearch_to_tsquery('simple', $1)
+				THEN ts_headline('simple', error, websearch_to_tsquery('simple', $1), $3) ELSE '' END,
+			CASE WHEN to_tsvector('simple', coalesce(debug, '')) @@ websearch_to_tsquery('simple', $1)
+				THEN ts_headline('simple', debug, websearch_to_tsquery('simple', $1), $3) ELSE '' END
+		FROM matches
+		ORDER BY finished DESC NULLS LAST, run_id`,
+		strings.Join(conditions, " "))
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "error searching job run errors")
+	}
+	defer rows.Close()
+
+	matches := []*model.JobRunErrorMatch{}
+	for rows.Next() {
+		var match model.JobRunErrorMatch
+		if err := rows.Scan(
+			&match.JobId, &match.RunId, &match.Queue, &match.JobSet, &match.Finished, &match.ErrorSnippet, &match.DebugSnippet,
+		); err != nil {
+			return nil, errors.Wrap(err, "error reading job run error matches")
+		}
+		matches = append(matches, &match)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "error reading job run error matches")
+	}
+	return matches, nil
+}
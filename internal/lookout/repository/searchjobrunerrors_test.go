@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/common/database/lookout"
+	"github.com/armadaproject/armada/internal/lookout/model"
+	"github.com/armadaproject/armada/internal/lookoutingester/instructions"
+	"github.com/armadaproject/armada/internal/lookoutingester/lookoutdb"
+	"github.com/armadaproject/armada/internal/lookoutingester/metrics"
+)
+
+func TestSearchJobRunErrors(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		converter := instructions.NewInstructionConverter(metrics.Get().Metrics, userAnnotationPrefix, []string{}, &compress.NoOpCompressor{})
+		store := lookoutdb.NewLookoutDb(db, nil, metrics.Get(), 10, 10).WithErrorSearch(&compress.NoOpDecompressor{})
+
+		oomJob := NewJobSimulator(converter, store).
+			Submit(queue, jobSet, owner, namespace, baseTime, basicJobOpts).
+			Lease(runId, cluster, node, pool, baseTime).
+			Pending(runId, cluster, baseTime).
+			Running(runId, node, baseTime).
+			RunFailed(runId, node, 137, "container was OOMKilled", "memory usage exceeded the limit", baseTime).
+			Build().
+			Job()
+
+		otherRunId := "123e4567-e89b-12d3-a456-426614174002"
+		otherJob := NewJobSimulator(converter, store).
+			Submit("other-queue", jobSet, owner, namespace, baseTime, basicJobOpts).
+			Lease(otherRunId, cluster, node, pool, baseTime).
+			Pending(otherRunId, cluster, baseTime).
+			Running(otherRunId, node, baseTime).
+			RunFailed(otherRunId, node, 1, "image pull failed", "", baseTime.Add(time.Minute)).
+			Build().
+			Job()
+
+		repo := NewSqlJobRunErrorSearchRepository(db)
+
+		result, err := repo.SearchJobRunErrors(armadacontext.TODO(), JobRunErrorSearchQuery{Query: "oomkilled"})
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, &model.JobRunErrorMatch{
+			JobId:        oomJob.JobId,
+			RunId:        runId,
+			Queue:        queue,
+			JobSet:       jobSet,
+			Finished:     result[0].Finished,
+			ErrorSnippet: "container was **OOMKilled**",
+		}, result[0])
+
+		// Debug messages are searched too
+		result, err = repo.SearchJobRunErrors(armadacontext.TODO(), JobRunErrorSearchQuery{Query: "limit"})
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, "", result[0].ErrorSnippet)
+		assert.Equal(t, "memory usage exceeded the **limit**", result[0].DebugSnippet)
+
+		// Most recently finished first
+		result, err = repo.SearchJobRunErrors(armadacontext.TODO(), JobRunErrorSearchQuery{Query: "oomkilled or pull"})
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, otherJob.JobId, result[0].JobId)
+		assert.Equal(t, oomJob.JobId, result[1].JobId)
+
+		result, err = repo.SearchJobRunErrors(armadacontext.TODO(), JobRunErrorSearchQuery{
+			Query:  "oomkilled or pull",
+			Queues: []string{"other-queue"},
+		})
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, otherRunId, result[0].RunId)
+
+		from := baseTime.Add(time.Hour)
+		result, err = repo.SearchJobRunErrors(armadacontext.TODO(), JobRunErrorSearchQuery{Query: "oomkilled", From: &from})
+		require.NoError(t, err)
+		assert.Empty(t, result)
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestSearchJobRunErrorsInvalidQuery(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		repo := NewSqlJobRunErrorSearchRepository(db)
+		from := baseTime
+		to := baseTime.Add(-time.Hour)
+		for name, query := range map[string]JobRunErrorSearchQuery{
+			"empty query":    {Query: " "},
+			"take too large": {Query: "error", Take: maxJobRunErrorSearchTake + 1},
+			"to before from": {Query: "error", From: &from, To: &to},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := repo.SearchJobRunErrors(armadacontext.TODO(), query)
+				assert.Error(t, err)
+			})
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+}
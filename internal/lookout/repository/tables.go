@@ -5,21 +5,34 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/armadaproject/armada/internal/common/database/lookout"
 	"github.com/armadaproject/armada/internal/common/util"
 	"github.com/armadaproject/armada/internal/lookout/model"
 )
 
 const (
+	jobIdField              = "jobId"
 	stateField              = "state"
 	submittedField          = "submitted"
 	lastTransitionTimeField = "lastTransitionTime"
 	clusterField            = "cluster"
 	nodeField               = "node"
 	poolField               = "pool"
+	exitCodeField           = "exitCode"
+	failureCategoryField    = "failureCategory"
+	failureSubcategoryField = "failureSubcategory"
+	cpuField                = "cpu"
+	memoryField             = "memory"
+	gpuField                = "gpu"
+	runtimeField            = "runtime"
 
 	jobTable    = "job"
 	jobRunTable = "job_run"
 
+	// Partitions of the job table, by state
+	jobActiveTable     = "job_active"
+	jobTerminatedTable = "job_terminated"
+
 	jobTableAbbrev    = "j"
 	jobRunTableAbbrev = "jr"
 
@@ -38,11 +51,39 @@ const (
 	submittedCol          = "submitted"
 	lastTransitionTimeCol = "last_transition_time_seconds"
 	priorityClassCol      = "priority_class"
+	runCountCol           = "run_count"
+	// Derived from run_count, see derivedColumns
+	retriesCol = "retries"
+	// Derived from submitted and first_leased, see derivedColumns
+	queuedDurationCol = "queued_seconds"
 
 	// Job Run table columns
-	clusterCol = "cluster"
-	nodeCol    = "node"
-	poolCol    = "pool"
+	clusterCol            = "cluster"
+	nodeCol               = "node"
+	poolCol               = "pool"
+	exitCodeCol           = "exit_code"
+	failureCategoryCol    = "failure_category"
+	failureSubcategoryCol = "failure_subcategory"
+	// Derived from started and finished, see derivedColumns
+	runtimeCol = "runtime_seconds"
+)
+
+var (
+	// activeJobStates are the states of the jobs in the active partition of the job table
+	activeJobStates = []int{
+		lookout.JobQueuedOrdinal,
+		lookout.JobPendingOrdinal,
+		lookout.JobRunningOrdinal,
+		lookout.JobLeasedOrdinal,
+	}
+	// terminatedJobStates are the states of the jobs in the terminated partition of the job table
+	terminatedJobStates = []int{
+		lookout.JobSucceededOrdinal,
+		lookout.JobFailedOrdinal,
+		lookout.JobCancelledOrdinal,
+		lookout.JobPreemptedOrdinal,
+		lookout.JobRejectedOrdinal,
+	}
 )
 
 type AggregateType int
@@ -53,6 +94,13 @@ const (
 	Average                   = 1
 	StateCounts               = 2
 	Min                       = 3
+	// FailureCount counts the jobs whose latest run failed with a failure category.
+	FailureCount = 4
+	// ResourceTotals sums and averages the resource requested by jobs, and sums the resource multiplied by the runtime
+	// of terminal jobs, e.g., GPU seconds.
+	ResourceTotals = 5
+	// RuntimePercentiles sums the runtime of terminal jobs and computes its percentiles.
+	RuntimePercentiles = 6
 )
 
 type LookoutTables struct {
@@ -68,12 +116,24 @@ type LookoutTables struct {
 	tableAbbrevs map[string]string
 	// columns that can be grouped by
 	groupableColumns map[string]bool
+	// column name -> SQL expression computing it, for columns that aren't stored.
+	// The expression is a format string whose argument is the abbreviation of the column's table.
+	derivedColumns map[string]string
+	// derived column -> the columns it's the time between, for derived columns that are durations.
+	// Filters on these are made by makeDurationWhereClause, so they can use the index on end - start.
+	durationColumns map[string]durationColumn
 	// map from column to aggregate that can be performed on it
 	groupAggregates map[string]AggregateType
 	// map from string name to aggregate type for lastTransitionTime
 	lastTransitionTimeAggregateMap map[string]AggregateType
 }
 
+// durationColumn is a derived column holding the time between two timestamp columns, up to now if the end is null.
+type durationColumn struct {
+	start string
+	end   string
+}
+
 func NewTables() *LookoutTables {
 	return newTablesWithJobTable(jobTable)
 }
@@ -101,10 +161,17 @@ func newTablesWithJobTable(table string) *LookoutTables {
 			"submitted":          submittedCol,
 			"lastTransitionTime": lastTransitionTimeCol,
 			"priorityClass":      priorityClassCol,
+			"runs":               runCountCol,
+			"retries":            retriesCol,
+			"queuedDuration":     queuedDurationCol,
 
-			"cluster": clusterCol,
-			"node":    nodeCol,
-			"pool":    poolCol,
+			"cluster":            clusterCol,
+			"node":               nodeCol,
+			"pool":               poolCol,
+			"exitCode":           exitCodeCol,
+			"failureCategory":    failureCategoryCol,
+			"failureSubcategory": failureSubcategoryCol,
+			"runtime":            runtimeCol,
 		},
 		columnTableMap: map[string]string{
 			jobIdCol:              table,
@@ -121,10 +188,17 @@ func newTablesWithJobTable(table string) *LookoutTables {
 			submittedCol:          table,
 			lastTransitionTimeCol: table,
 			priorityClassCol:      table,
+			runCountCol:           table,
+			retriesCol:            table,
+			queuedDurationCol:     table,
 
-			clusterCol: jobRunTable,
-			nodeCol:    jobRunTable,
-			poolCol:    jobRunTable,
+			clusterCol:            jobRunTable,
+			nodeCol:               jobRunTable,
+			poolCol:               jobRunTable,
+			exitCodeCol:           jobRunTable,
+			failureCategoryCol:    jobRunTable,
+			failureSubcategoryCol: jobRunTable,
+			runtimeCol:            jobRunTable,
 		},
 		orderableColumns: util.StringListToSet([]string{
 			jobIdCol,
@@ -133,25 +207,43 @@ func newTablesWithJobTable(table string) *LookoutTables {
 			lastTransitionTimeCol,
 			queueCol,
 			stateCol,
+
+			runCountCol,
+			retriesCol,
+			queuedDurationCol,
+
+			exitCodeCol,
+			failureCategoryCol,
+			failureSubcategoryCol,
+			runtimeCol,
 		}),
 		filterableColumns: map[string]map[string]bool{
 			jobIdCol:            util.StringListToSet([]string{model.MatchExact}),
-			queueCol:            util.StringListToSet([]string{model.MatchExact, model.MatchStartsWith, model.MatchContains, model.MatchAnyOf}),
-			jobSetCol:           util.StringListToSet([]string{model.MatchExact, model.MatchStartsWith, model.MatchContains}),
-			ownerCol:            util.StringListToSet([]string{model.MatchExact, model.MatchStartsWith, model.MatchContains}),
-			namespaceCol:        util.StringListToSet([]string{model.MatchExact, model.MatchStartsWith, model.MatchContains}),
-			stateCol:            util.StringListToSet([]string{model.MatchExact, model.MatchAnyOf}),
+			queueCol:            util.StringListToSet([]string{model.MatchExact, model.MatchStartsWith, model.MatchContains, model.MatchAnyOf, model.MatchNotEqual, model.MatchNotContains, model.MatchNotAnyOf, model.MatchRegex}),
+			jobSetCol:           util.StringListToSet([]string{model.MatchExact, model.MatchStartsWith, model.MatchContains, model.MatchNotEqual, model.MatchNotContains, model.MatchRegex}),
+			ownerCol:            util.StringListToSet([]string{model.MatchExact, model.MatchStartsWith, model.MatchContains, model.MatchNotEqual, model.MatchNotContains, model.MatchRegex}),
+			namespaceCol:        util.StringListToSet([]string{model.MatchExact, model.MatchStartsWith, model.MatchContains, model.MatchNotEqual, model.MatchNotContains, model.MatchRegex}),
+			stateCol:            util.StringListToSet([]string{model.MatchExact, model.MatchAnyOf, model.MatchNotEqual, model.MatchNotAnyOf}),
 			cpuCol:              util.StringListToSet([]string{model.MatchExact, model.MatchGreaterThan, model.MatchLessThan, model.MatchGreaterThanOrEqualTo, model.MatchLessThanOrEqualTo}),
 			memoryCol:           util.StringListToSet([]string{model.MatchExact, model.MatchGreaterThan, model.MatchLessThan, model.MatchGreaterThanOrEqualTo, model.MatchLessThanOrEqualTo}),
 			ephemeralStorageCol: util.StringListToSet([]string{model.MatchExact, model.MatchGreaterThan, model.MatchLessThan, model.MatchGreaterThanOrEqualTo, model.MatchLessThanOrEqualTo}),
 			gpuCol:              util.StringListToSet([]string{model.MatchExact, model.MatchGreaterThan, model.MatchLessThan, model.MatchGreaterThanOrEqualTo, model.MatchLessThanOrEqualTo}),
 			priorityCol:         util.StringListToSet([]string{model.MatchExact, model.MatchGreaterThan, model.MatchLessThan, model.MatchGreaterThanOrEqualTo, model.MatchLessThanOrEqualTo}),
 			submittedCol:        util.StringListToSet([]string{model.MatchGreaterThan, model.MatchLessThan, model.MatchGreaterThanOrEqualTo, model.MatchLessThanOrEqualTo}),
-			priorityClassCol:    util.StringListToSet([]string{model.MatchExact, model.MatchStartsWith, model.MatchContains}),
+			priorityClassCol:    util.StringListToSet([]string{model.MatchExact, model.MatchStartsWith, model.MatchContains, model.MatchNotEqual, model.MatchNotContains, model.MatchRegex}),
+
+			clusterCol: util.StringListToSet([]string{model.MatchExact, model.MatchNotEqual, model.MatchRegex}),
+			nodeCol:    util.StringListToSet([]string{model.MatchExact, model.MatchNotEqual, model.MatchRegex}),
+			poolCol:    util.StringListToSet([]string{model.MatchExact, model.MatchAnyOf, model.MatchNotEqual, model.MatchNotAnyOf}),
 
-			clusterCol: util.StringListToSet([]string{model.MatchExact}),
-			nodeCol:    util.StringListToSet([]string{model.MatchExact}),
-			poolCol:    util.StringListToSet([]string{model.MatchExact, model.MatchAnyOf}),
+			exitCodeCol:           util.StringListToSet([]string{model.MatchExact, model.MatchAnyOf, model.MatchGreaterThan, model.MatchLessThan, model.MatchGreaterThanOrEqualTo, model.MatchLessThanOrEqualTo}),
+			failureCategoryCol:    util.StringListToSet([]string{model.MatchExact, model.MatchAnyOf, model.MatchStartsWith, model.MatchContains, model.MatchNotEqual, model.MatchNotAnyOf, model.MatchNotContains, model.MatchRegex}),
+			failureSubcategoryCol: util.StringListToSet([]string{model.MatchExact, model.MatchAnyOf, model.MatchStartsWith, model.MatchContains, model.MatchNotEqual, model.MatchNotAnyOf, model.MatchNotContains, model.MatchRegex}),
+			runtimeCol:            util.StringListToSet([]string{model.MatchGreaterThan, model.MatchLessThan, model.MatchGreaterThanOrEqualTo, model.MatchLessThanOrEqualTo}),
+
+			runCountCol:       util.StringListToSet([]string{model.MatchExact, model.MatchGreaterThan, model.MatchLessThan, model.MatchGreaterThanOrEqualTo, model.MatchLessThanOrEqualTo}),
+			retriesCol:        util.StringListToSet([]string{model.MatchExact, model.MatchGreaterThan, model.MatchLessThan, model.MatchGreaterThanOrEqualTo, model.MatchLessThanOrEqualTo}),
+			queuedDurationCol: util.StringListToSet([]string{model.MatchGreaterThan, model.MatchLessThan, model.MatchGreaterThanOrEqualTo, model.MatchLessThanOrEqualTo}),
 		},
 		tableAbbrevs: map[string]string{
 			table:       jobTableAbbrev,
@@ -166,11 +258,29 @@ func newTablesWithJobTable(table string) *LookoutTables {
 			clusterCol,
 			nodeCol,
 			poolCol,
+			exitCodeCol,
+			failureCategoryCol,
+			failureSubcategoryCol,
 		}),
+		derivedColumns: map[string]string{
+			// Runtime and time in queue include the time until now if the job is still running or queued, as in the UI
+			runtimeCol:        "EXTRACT(EPOCH FROM (COALESCE(%[1]s.finished, NOW() AT TIME ZONE 'UTC') - %[1]s.started))::double precision",
+			queuedDurationCol: "EXTRACT(EPOCH FROM (COALESCE(%[1]s.first_leased, NOW() AT TIME ZONE 'UTC') - %[1]s.submitted))::double precision",
+			retriesCol:        "GREATEST(%[1]s.run_count - 1, 0)",
+		},
+		durationColumns: map[string]durationColumn{
+			runtimeCol:        {start: "started", end: "finished"},
+			queuedDurationCol: {start: "submitted", end: "first_leased"},
+		},
 		groupAggregates: map[string]AggregateType{
 			submittedCol:          Min,
 			lastTransitionTimeCol: Average,
 			stateCol:              StateCounts,
+			failureCategoryCol:    FailureCount,
+			cpuCol:                ResourceTotals,
+			memoryCol:             ResourceTotals,
+			gpuCol:                ResourceTotals,
+			runtimeCol:            RuntimePercentiles,
 		},
 		lastTransitionTimeAggregateMap: map[string]AggregateType{
 			model.AggregateLatest:   Max,
@@ -228,6 +338,34 @@ func (c *LookoutTables) IsGroupable(col string) bool {
 	return ok
 }
 
+// ColumnSelectSql returns the SQL selecting the given column from its table, computing it if it's derived.
+func (c *LookoutTables) ColumnSelectSql(col string, tableAbbrev string) string {
+	if !c.IsDerived(col) {
+		return col
+	}
+	return fmt.Sprintf("%s AS %s", c.ColumnSql(col, tableAbbrev), col)
+}
+
+// ColumnSql returns the SQL evaluating to the given column of the table with the given abbreviation.
+func (c *LookoutTables) ColumnSql(col string, tableAbbrev string) string {
+	expression, ok := c.derivedColumns[col]
+	if !ok {
+		return fmt.Sprintf("%s.%s", tableAbbrev, col)
+	}
+	return fmt.Sprintf(expression, tableAbbrev)
+}
+
+func (c *LookoutTables) IsDerived(col string) bool {
+	_, ok := c.derivedColumns[col]
+	return ok
+}
+
+// durationColumnFor returns the columns the given derived column is the time between, if it's a duration.
+func (c *LookoutTables) durationColumnFor(col string) (durationColumn, bool) {
+	duration, ok := c.durationColumns[col]
+	return duration, ok
+}
+
 func (c *LookoutTables) GroupAggregateForCol(col string) (AggregateType, error) {
 	aggregate, ok := c.groupAggregates[col]
 	if !ok {
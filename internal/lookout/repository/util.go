@@ -420,6 +420,36 @@ func (js *JobSimulator) Cancelled(timestamp time.Time, cancelUser string) *JobSi
 	return js
 }
 
+func (js *JobSimulator) Suspended(timestamp time.Time) *JobSimulator {
+	ts := timestampOrNow(timestamp)
+	suspended := &armadaevents.EventSequence_Event{
+		Created: ts,
+		Event: &armadaevents.EventSequence_Event_SuspendJob{
+			SuspendJob: &armadaevents.SuspendJob{
+				JobId: js.jobId,
+			},
+		},
+	}
+	js.events = append(js.events, suspended)
+	js.job.Suspended = true
+	return js
+}
+
+func (js *JobSimulator) Resumed(timestamp time.Time) *JobSimulator {
+	ts := timestampOrNow(timestamp)
+	resumed := &armadaevents.EventSequence_Event{
+		Created: ts,
+		Event: &armadaevents.EventSequence_Event_ResumeJob{
+			ResumeJob: &armadaevents.ResumeJob{
+				JobId: js.jobId,
+			},
+		},
+	}
+	js.events = append(js.events, resumed)
+	js.job.Suspended = false
+	return js
+}
+
 func (js *JobSimulator) Reprioritized(newPriority uint32, timestamp time.Time) *JobSimulator {
 	ts := timestampOrNow(timestamp)
 	reprioritized := &armadaevents.EventSequence_Event{
@@ -479,6 +509,20 @@ func (js *JobSimulator) RunFailed(runId string, node string, exitCode int32, mes
 	return js
 }
 
+func (js *JobSimulator) RunFailedWithCategory(runId string, node string, exitCode int32, category string, subcategory string, timestamp time.Time) *JobSimulator {
+	js.RunFailed(runId, node, exitCode, "", "", timestamp)
+	runError := js.events[len(js.events)-1].GetJobRunErrors().Errors[0]
+	runError.FailureCategory = category
+	runError.FailureSubcategory = subcategory
+	for _, run := range js.job.Runs {
+		if run.RunId == runId {
+			run.FailureCategory = category
+			run.FailureSubcategory = subcategory
+		}
+	}
+	return js
+}
+
 func (js *JobSimulator) Rejected(message string, timestamp time.Time) *JobSimulator {
 	ts := timestampOrNow(timestamp)
 	rejectedTime := protoutil.ToStdTime(ts)
@@ -0,0 +1,11 @@
+-- Results of cancel, preempt and reprioritize requests submitted with an idempotency key,
+-- so that retried requests return the original result rather than publishing new events.
+CREATE TABLE IF NOT EXISTS request_deduplication
+(
+  deduplication_id text NOT NULL PRIMARY KEY,
+  request_hash text NOT NULL,
+  result bytea NOT NULL,
+  inserted TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_request_deduplication_inserted ON request_deduplication (inserted);
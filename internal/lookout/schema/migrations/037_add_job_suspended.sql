@@ -0,0 +1 @@
+ALTER TABLE job ADD COLUMN IF NOT EXISTS suspended boolean NOT NULL DEFAULT false;
@@ -0,0 +1,5 @@
+-- Supports filtering and grouping jobs by the failure category of their latest run.
+-- Only failed runs have a category, so the index is partial.
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_job_run_failure_category_run_id ON job_run (failure_category, failure_subcategory, run_id)
+WITH (fillfactor = 80)
+WHERE failure_category IS NOT NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE job ADD COLUMN IF NOT EXISTS run_count int NOT NULL DEFAULT 0;
+ALTER TABLE job ADD COLUMN IF NOT EXISTS first_leased timestamp NULL;
@@ -0,0 +1,22 @@
+-- Jobs ingested before 039 have no run count or first lease time, so derive them from their runs.
+-- Updating every job in one statement locks and rewrites the whole of job, so it's only done here for small
+-- databases. Larger ones are backfilled in batches, while Lookout keeps running, by `lookout backfill run-counts`.
+DO $$
+BEGIN
+    IF (SELECT count(*) FROM (SELECT 1 FROM job LIMIT 100001) AS jobs) > 100000 THEN
+        RAISE NOTICE 'job has more than 100000 rows, so run counts are not backfilled; run lookout backfill run-counts';
+        RETURN;
+    END IF;
+
+    UPDATE job
+    SET
+        run_count    = runs.run_count,
+        first_leased = runs.first_leased
+    FROM (
+        SELECT job_id, COUNT(*) AS run_count, MIN(COALESCE(leased, pending)) AS first_leased
+        FROM job_run
+        GROUP BY job_id
+    ) AS runs
+    WHERE runs.job_id = job.job_id;
+END
+$$;
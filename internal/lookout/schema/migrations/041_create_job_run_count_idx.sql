@@ -0,0 +1,2 @@
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_job_run_count ON job (run_count)
+WITH (fillfactor = 80);
@@ -0,0 +1,15 @@
+-- pg_trgm backs the trigram indexes created by the following migrations. Creating an extension needs superuser, or,
+-- since pg_trgm is a trusted extension, the CREATE privilege on the database from Postgres 13. If the user Lookout
+-- migrates the database with has neither, a superuser must run `CREATE EXTENSION pg_trgm` in the Lookout database
+-- before migrating.
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'pg_trgm') THEN
+        CREATE EXTENSION pg_trgm;
+    END IF;
+EXCEPTION
+    WHEN insufficient_privilege THEN
+        RAISE EXCEPTION 'permission denied to create extension pg_trgm'
+            USING HINT = 'Run CREATE EXTENSION pg_trgm in the Lookout database as a superuser, then migrate the database again.';
+END
+$$;
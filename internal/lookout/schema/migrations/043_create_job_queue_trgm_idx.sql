@@ -0,0 +1,2 @@
+-- Supports regex and contains matches on queue
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_job_queue_trgm ON job USING gin (queue gin_trgm_ops);
@@ -0,0 +1,2 @@
+-- Supports regex and contains matches on jobset
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_job_jobset_trgm ON job USING gin (jobset gin_trgm_ops);
@@ -0,0 +1,2 @@
+-- Supports regex and contains matches on owner
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_job_owner_trgm ON job USING gin (owner gin_trgm_ops);
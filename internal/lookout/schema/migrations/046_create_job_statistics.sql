@@ -0,0 +1,22 @@
+-- Snapshots of the number of queued, leased, pending and running jobs, and the resources requested by running jobs,
+-- per queue, pool and priority class. resolution is the length of the bucket in seconds: rows with a resolution of
+-- 60 are snapshots taken once a minute, and rows with a resolution of 3600 are the sums of the minute snapshots of
+-- the hour. Jobs without a pool or priority class are counted under the empty string.
+CREATE TABLE IF NOT EXISTS job_statistics
+(
+  resolution int NOT NULL,
+  bucket timestamp NOT NULL,
+  queue varchar(512) NOT NULL,
+  pool text NOT NULL,
+  priority_class varchar(63) NOT NULL,
+  queued bigint NOT NULL,
+  leased bigint NOT NULL,
+  pending bigint NOT NULL,
+  running bigint NOT NULL,
+  running_cpu bigint NOT NULL,
+  running_memory bigint NOT NULL,
+  running_gpu bigint NOT NULL,
+  PRIMARY KEY (resolution, queue, bucket, pool, priority_class)
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_statistics_resolution_bucket ON job_statistics (resolution, bucket);
@@ -0,0 +1,16 @@
+-- Views of Lookout saved by users, so that they can be shared by a short id instead of a long URL. filters holds the
+-- JSON of the filters of the view, and grouping and columns hold JSON arrays of column ids.
+CREATE TABLE IF NOT EXISTS saved_view
+(
+  id varchar(16) NOT NULL PRIMARY KEY,
+  name varchar(512) NOT NULL,
+  owner varchar(512) NOT NULL,
+  filters jsonb NOT NULL,
+  grouping jsonb NOT NULL,
+  columns jsonb NOT NULL,
+  visibility varchar(16) NOT NULL,
+  created timestamp NOT NULL,
+  updated timestamp NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_saved_view_owner ON saved_view (owner);
@@ -0,0 +1,18 @@
+-- The decompressed errors and debug messages of job runs, indexed for full-text search. Rows are only written by
+-- lookoutingester when error search is enabled, and are deleted by the pruner along with the job. The 'simple'
+-- configuration is used so that error messages are indexed word for word, without stemming or dropping stop words.
+CREATE TABLE IF NOT EXISTS job_run_error_search
+(
+  run_id varchar(36) NOT NULL PRIMARY KEY,
+  job_id varchar(32) NOT NULL,
+  error text NULL,
+  debug text NULL,
+  search tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', coalesce(error, '')), 'A') ||
+    setweight(to_tsvector('simple', coalesce(debug, '')), 'B')
+  ) STORED
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_run_error_search_search ON job_run_error_search USING gin (search);
+
+CREATE INDEX IF NOT EXISTS idx_job_run_error_search_job_id ON job_run_error_search (job_id);
@@ -0,0 +1,91 @@
+-- Partitions job by state, into job_active (queued, pending, running and leased jobs) and job_terminated (succeeded,
+-- failed, cancelled, preempted and rejected jobs). Queries over active jobs then only read the small, frequently
+-- updated job_active partition, rather than a table dominated by terminated jobs. Postgres moves a row between the
+-- partitions when its state is updated.
+--
+-- The primary key is (job_id, state), as Postgres requires unique constraints to include the partition key, so
+-- Postgres doesn't enforce that job_id alone is unique. The ingester only inserts a job if there's no job with its id.
+--
+-- This rewrites job while holding an exclusive lock on it, which blocks the ingester and Lookout for as long as the
+-- copy takes, so it refuses to run if job has more than 1000000 rows. Large databases must instead be converted online
+-- beforehand with "lookout partition", as described in docs/lookout_partitioning.md, in which case job is already
+-- partitioned and this migration does nothing.
+DO $$
+BEGIN
+    IF (SELECT relkind FROM pg_class WHERE oid = 'job'::regclass) = 'p' THEN
+        RETURN;
+    END IF;
+
+    IF (SELECT count(*) FROM (SELECT 1 FROM job LIMIT 1000001) AS jobs) > 1000000 THEN
+        RAISE EXCEPTION 'job has more than 1000000 rows, so partitioning it here would block Lookout for too long'
+            USING HINT = 'Partition job online with lookout partition, as described in docs/lookout_partitioning.md, then migrate the database again.';
+    END IF;
+
+    LOCK TABLE job IN ACCESS EXCLUSIVE MODE;
+    ALTER TABLE job RENAME TO job_unpartitioned;
+
+    CREATE TABLE job (
+        job_id                       varchar(32)   NOT NULL,
+        queue                        varchar(512)  NOT NULL,
+        owner                        varchar(512)  NOT NULL,
+        jobset                       varchar(1024) NOT NULL,
+        cpu                          bigint        NOT NULL,
+        memory                       bigint        NOT NULL,
+        ephemeral_storage            bigint        NOT NULL,
+        gpu                          bigint        NOT NULL,
+        priority                     bigint        NOT NULL,
+        submitted                    timestamp     NOT NULL,
+        cancelled                    timestamp     NULL,
+        state                        smallint      NOT NULL,
+        last_transition_time         timestamp     NOT NULL,
+        last_transition_time_seconds bigint        NOT NULL,
+        job_spec                     bytea         NULL,
+        duplicate                    bool          NOT NULL DEFAULT false,
+        priority_class               varchar(63)   NULL,
+        latest_run_id                varchar(36)   NULL,
+        cancel_reason                varchar(512)  NULL,
+        namespace                    varchar(512)  NULL,
+        annotations                  jsonb         NOT NULL,
+        external_job_uri             varchar(1024) NULL,
+        cancel_user                  varchar(512)  NULL,
+        suspended                    boolean       NOT NULL DEFAULT false,
+        run_count                    int           NOT NULL DEFAULT 0,
+        first_leased                 timestamp     NULL
+    ) PARTITION BY LIST (state);
+    CREATE TABLE job_active PARTITION OF job FOR VALUES IN (1, 2, 3, 8) WITH (fillfactor = 70);
+    CREATE TABLE job_terminated PARTITION OF job FOR VALUES IN (4, 5, 6, 7, 9) WITH (fillfactor = 70);
+    ALTER TABLE job ALTER COLUMN job_spec SET STORAGE EXTERNAL;
+
+    INSERT INTO job (
+        job_id, queue, owner, jobset, cpu, memory, ephemeral_storage, gpu, priority, submitted, cancelled, state,
+        last_transition_time, last_transition_time_seconds, job_spec, duplicate, priority_class, latest_run_id,
+        cancel_reason, namespace, annotations, external_job_uri, cancel_user, suspended, run_count, first_leased
+    )
+    SELECT
+        job_id, queue, owner, jobset, cpu, memory, ephemeral_storage, gpu, priority, submitted, cancelled, state,
+        last_transition_time, last_transition_time_seconds, job_spec, duplicate, priority_class, latest_run_id,
+        cancel_reason, namespace, annotations, external_job_uri, cancel_user, suspended, run_count, first_leased
+    FROM job_unpartitioned;
+
+    -- Dropping the old table frees the names of its primary key and indexes, which are recreated after the copy as
+    -- that's faster than maintaining them during it
+    DROP TABLE job_unpartitioned;
+
+    ALTER TABLE job ADD CONSTRAINT job_pkey PRIMARY KEY (job_id, state);
+    CREATE INDEX idx_job_queue_last_transition_time_seconds ON job (queue, last_transition_time_seconds) WITH (fillfactor = 80);
+    CREATE INDEX idx_job_queue_jobset_state ON job (queue, jobset, state) WITH (fillfactor = 80);
+    CREATE INDEX idx_job_state ON job (state) WITH (fillfactor = 80);
+    CREATE INDEX idx_job_submitted ON job (submitted DESC);
+    CREATE INDEX idx_job_jobset_pattern ON job (jobset varchar_pattern_ops) WITH (fillfactor = 80);
+    CREATE INDEX idx_job_annotations_path ON job USING gin (annotations jsonb_ops) WITH (fastupdate = true, gin_pending_list_limit = 33554432);
+    CREATE INDEX idx_job_latest_run_id ON job (latest_run_id) WITH (fillfactor = 80);
+    CREATE INDEX idx_job_queue_namespace ON job (queue, namespace) WITH (fillfactor = 80);
+    CREATE INDEX idx_job_ltt_jobid ON job (last_transition_time, job_id) WITH (fillfactor = 80);
+    CREATE INDEX idx_job_run_count ON job (run_count) WITH (fillfactor = 80);
+    CREATE INDEX idx_job_queue_trgm ON job USING gin (queue gin_trgm_ops);
+    CREATE INDEX idx_job_jobset_trgm ON job USING gin (jobset gin_trgm_ops);
+    CREATE INDEX idx_job_owner_trgm ON job USING gin (owner gin_trgm_ops);
+    -- Replaces the partial index over active jobs, as job_active only contains active jobs
+    CREATE INDEX idx_job_active_queue_jobset ON job_active (queue, jobset) WITH (fillfactor = 80);
+END
+$$;
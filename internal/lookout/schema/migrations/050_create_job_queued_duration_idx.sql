@@ -0,0 +1,6 @@
+-- Supports filtering jobs by how long they were queued, once they've been leased. Jobs still queued are filtered on
+-- submitted instead, as their time in queue runs until now.
+-- job is partitioned, and indexes can't be created concurrently on a partitioned table, so the index is created on job
+-- alone here and the partitions' indexes are created concurrently and attached by the following migrations.
+CREATE INDEX IF NOT EXISTS idx_job_queued_duration ON ONLY job ((first_leased - submitted))
+WHERE first_leased IS NOT NULL;
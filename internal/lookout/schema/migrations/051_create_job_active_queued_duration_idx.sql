@@ -0,0 +1,2 @@
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_job_active_queued_duration ON job_active ((first_leased - submitted))
+WHERE first_leased IS NOT NULL;
@@ -0,0 +1,3 @@
+-- The index on job is only valid once the index of every partition is attached to it.
+ALTER INDEX idx_job_queued_duration ATTACH PARTITION idx_job_active_queued_duration;
+ALTER INDEX idx_job_queued_duration ATTACH PARTITION idx_job_terminated_queued_duration;
@@ -0,0 +1,4 @@
+-- Supports filtering jobs by the runtime of their latest run, once it's finished. Runs still running are filtered on
+-- started instead, as their runtime runs until now.
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_job_run_runtime ON job_run ((finished - started))
+WHERE finished IS NOT NULL;
@@ -0,0 +1,18 @@
+-- The number of minute snapshots summed into a row: 1 for rows with a resolution of 60, and the number of minutes of
+-- the hour with snapshots for rows with a resolution of 3600. Statistics are averaged over the snapshots actually
+-- taken, so that buckets that haven't ended yet, or in which snapshots were missed, aren't understated. Hour rows
+-- recorded before this column was added are assumed to be complete if their minute rows have already been pruned.
+ALTER TABLE job_statistics ADD COLUMN IF NOT EXISTS samples int NOT NULL DEFAULT 1;
+
+UPDATE job_statistics AS hour_statistics
+SET samples = COALESCE(
+    (
+        SELECT NULLIF(count(DISTINCT minute_statistics.bucket), 0)
+        FROM job_statistics AS minute_statistics
+        WHERE minute_statistics.resolution = 60
+          AND minute_statistics.bucket >= hour_statistics.bucket
+          AND minute_statistics.bucket < hour_statistics.bucket + interval '1 hour'
+    ),
+    60
+)
+WHERE hour_statistics.resolution = 3600;
@@ -52,6 +52,11 @@ type LookoutIngesterConfiguration struct {
 	Profiling *profilingconfig.ProfilingConfig
 	// List of Regexes which will identify fatal errors when inserting into postgres
 	FatalInsertionErrors []string
+	// If true, the number of jobs in each state, and the resources requested by running jobs, are recorded once a
+	// minute per queue, pool and priority class, for Lookout's job statistics endpoint
+	RecordJobStatistics bool
+	// If true, the errors and debug messages of job runs are indexed for Lookout's full-text error search
+	IndexJobRunErrorsForSearch bool
 }
 
 func (c *LookoutIngesterConfiguration) GetUserAnnotationPrefix() string {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/pkg/errors"
+	"k8s.io/utils/clock"
 
 	"github.com/armadaproject/armada/internal/common"
 	"github.com/armadaproject/armada/internal/common/app"
@@ -20,6 +21,7 @@ import (
 	"github.com/armadaproject/armada/internal/lookoutingester/lookoutdb"
 	"github.com/armadaproject/armada/internal/lookoutingester/metrics"
 	"github.com/armadaproject/armada/internal/lookoutingester/model"
+	"github.com/armadaproject/armada/internal/lookoutingester/statistics"
 	"github.com/armadaproject/armada/pkg/armadaevents"
 )
 
@@ -44,6 +46,9 @@ func Run(config *configuration.LookoutIngesterConfiguration) {
 	}
 
 	lookoutDb := lookoutdb.NewLookoutDb(db, fatalRegexes, m, config.MaxBackoff, 0)
+	if config.IndexJobRunErrorsForSearch {
+		lookoutDb = lookoutDb.WithErrorSearch(compress.NewThreadSafeZlibDecompressor())
+	}
 
 	compressor, err := compress.NewZlibCompressor(config.MinJobSpecCompressionSize)
 	if err != nil {
@@ -83,7 +88,17 @@ func Run(config *configuration.LookoutIngesterConfiguration) {
 		m.Metrics,
 	)
 
-	if err := ingester.Run(app.CreateContextWithShutdown()); err != nil {
+	ctx := app.CreateContextWithShutdown()
+	if config.RecordJobStatistics {
+		recorder := statistics.NewRecorder(db, clock.RealClock{})
+		go func() {
+			if err := recorder.Run(ctx); err != nil {
+				log.WithError(err).Error("Error recording job statistics")
+			}
+		}()
+	}
+
+	if err := ingester.Run(ctx); err != nil {
 		panic(errors.WithMessage(err, "Error running ingestion pipeline"))
 	}
 }
@@ -46,7 +46,8 @@ type InstructionConverter struct {
 	compressor           compress.Compressor
 }
 
-type jobResources struct {
+// JobResources are the total resources requested by the containers of a job's main pod.
+type JobResources struct {
 	Cpu              int64
 	Memory           int64
 	EphemeralStorage int64
@@ -122,6 +123,14 @@ func (c *InstructionConverter) convertSequence(
 			err = c.handleJobRequeued(ts, event.GetJobRequeued(), update)
 		case *armadaevents.EventSequence_Event_JobRunLeased:
 			err = c.handleJobRunLeased(ts, event.GetJobRunLeased(), update)
+		case *armadaevents.EventSequence_Event_MoveJob:
+			err = c.handleMoveJob(event.GetMoveJob(), update)
+		case *armadaevents.EventSequence_Event_UpdateQueuedJob:
+			err = c.handleUpdateQueuedJob(event.GetUpdateQueuedJob(), update)
+		case *armadaevents.EventSequence_Event_SuspendJob:
+			err = c.handleSuspendJob(event.GetSuspendJob().JobId, true, update)
+		case *armadaevents.EventSequence_Event_ResumeJob:
+			err = c.handleSuspendJob(event.GetResumeJob().JobId, false, update)
 		case *armadaevents.EventSequence_Event_StandaloneIngressInfo:
 			err = c.handleStandaloneIngressInfo(event.GetStandaloneIngressInfo(), update)
 		case *armadaevents.EventSequence_Event_ReprioritiseJobSet,
@@ -170,12 +179,8 @@ func (c *InstructionConverter) handleSubmitJob(
 		log.WithError(err).Warnf("Couldn't convert job event for job %s in jobset %s to api job.", event.JobId, jobSet)
 	}
 
-	resources := getJobResources(apiJob)
-	priorityClass := getJobPriorityClass(apiJob)
-	if priorityClass != nil {
-		truncatedPriorityClass := util.Truncate(*priorityClass, maxPriorityClassLen)
-		priorityClass = &truncatedPriorityClass
-	}
+	resources := GetJobResources(apiJob)
+	priorityClass := GetJobPriorityClass(apiJob)
 
 	annotations := event.GetObjectMeta().GetAnnotations()
 	userAnnotations := extractUserAnnotations(event.JobId, c.userAnnotationPrefix, c.blocklistAnnotations, annotations)
@@ -254,6 +259,40 @@ func (c *InstructionConverter) handleReprioritiseJob(_ time.Time, event *armadae
 	return nil
 }
 
+// The update is applied to the stored job spec when it's inserted, since only then are the containers it leaves
+// unchanged known.
+func (c *InstructionConverter) handleUpdateQueuedJob(event *armadaevents.UpdateQueuedJob, update *model.InstructionSet) error {
+	jobUpdate := model.UpdateQueuedJobInstruction{
+		JobId:              event.JobId,
+		ContainerResources: event.ContainerResources,
+		NodeSelector:       event.NodeSelector,
+		Tolerations:        event.Tolerations,
+		PriorityClassName:  event.PriorityClassName,
+	}
+	update.QueuedJobsToUpdate = append(update.QueuedJobsToUpdate, &jobUpdate)
+	return nil
+}
+
+// The job keeps its id, so its runs and errors stay linked to it after the move.
+func (c *InstructionConverter) handleMoveJob(event *armadaevents.MoveJob, update *model.InstructionSet) error {
+	jobUpdate := model.UpdateJobInstruction{
+		JobId:  event.JobId,
+		Queue:  pointer.String(event.DestinationQueue),
+		JobSet: pointer.String(event.DestinationJobSetId),
+	}
+	update.JobsToUpdate = append(update.JobsToUpdate, &jobUpdate)
+	return nil
+}
+
+func (c *InstructionConverter) handleSuspendJob(jobId string, suspended bool, update *model.InstructionSet) error {
+	jobUpdate := model.UpdateJobInstruction{
+		JobId:     jobId,
+		Suspended: pointer.Bool(suspended),
+	}
+	update.JobsToUpdate = append(update.JobsToUpdate, &jobUpdate)
+	return nil
+}
+
 func (c *InstructionConverter) handleCancelledJob(ts time.Time, event *armadaevents.CancelledJob, update *model.InstructionSet) error {
 	var reason *string
 	if event.Reason != "" {
@@ -363,6 +402,8 @@ func (c *InstructionConverter) handleJobRunLeased(ts time.Time, event *armadaeve
 		LastTransitionTime:        &ts,
 		LastTransitionTimeSeconds: pointer.Int64(ts.Unix()),
 		LatestRunId:               &event.RunId,
+		RunsLeased:                pointer.Int32(1),
+		FirstLeased:               &ts,
 	}
 
 	update.JobsToUpdate = append(update.JobsToUpdate, &job)
@@ -557,8 +598,8 @@ func extractNodeName(x HasNodeName) *string {
 	return nil
 }
 
-func getJobResources(job *api.Job) jobResources {
-	resources := jobResources{}
+func GetJobResources(job *api.Job) JobResources {
+	resources := JobResources{}
 
 	podSpec := job.GetMainPodSpec()
 
@@ -583,10 +624,12 @@ func getResource(container v1.Container, resourceName v1.ResourceName, useMillis
 	return resource.Value()
 }
 
-func getJobPriorityClass(job *api.Job) *string {
+// GetJobPriorityClass returns the priority class of a job's main pod, truncated to fit the jobs table,
+// or nil if it has none.
+func GetJobPriorityClass(job *api.Job) *string {
 	podSpec := job.GetMainPodSpec()
 	if podSpec.PriorityClassName != "" {
-		return pointer.String(podSpec.PriorityClassName)
+		return pointer.String(util.Truncate(podSpec.PriorityClassName, maxPriorityClassLen))
 	}
 	return nil
 }
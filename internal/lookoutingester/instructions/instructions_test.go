@@ -43,6 +43,8 @@ var expectedLeased = model.UpdateJobInstruction{
 	LastTransitionTime:        &testfixtures.BaseTime,
 	LastTransitionTimeSeconds: pointer.Int64(testfixtures.BaseTime.Unix()),
 	LatestRunId:               pointer.String(testfixtures.RunId),
+	RunsLeased:                pointer.Int32(1),
+	FirstLeased:               &testfixtures.BaseTime,
 }
 
 var expectedPending = model.UpdateJobInstruction{
@@ -119,6 +121,28 @@ var expectedJobReprioritised = model.UpdateJobInstruction{
 	Priority: pointer.Int64(testfixtures.NewPriority),
 }
 
+var expectedJobMoved = model.UpdateJobInstruction{
+	JobId:  testfixtures.JobId,
+	Queue:  pointer.String("destination-queue"),
+	JobSet: pointer.String("destination-job-set"),
+}
+
+var expectedQueuedJobUpdate = model.UpdateQueuedJobInstruction{
+	JobId:             testfixtures.JobId,
+	NodeSelector:      map[string]string{"foo": "bar"},
+	PriorityClassName: testfixtures.PriorityClassName,
+}
+
+var expectedJobSuspended = model.UpdateJobInstruction{
+	JobId:     testfixtures.JobId,
+	Suspended: pointer.Bool(true),
+}
+
+var expectedJobResumed = model.UpdateJobInstruction{
+	JobId:     testfixtures.JobId,
+	Suspended: pointer.Bool(false),
+}
+
 var expectedFailed = model.UpdateJobInstruction{
 	JobId:                     testfixtures.JobId,
 	State:                     pointer.Int32(lookout.JobFailedOrdinal),
@@ -429,6 +453,46 @@ func TestConvert(t *testing.T) {
 				MessageIds:   []pulsar.MessageID{pulsarutils.NewMessageId(1)},
 			},
 		},
+		"moved": {
+			events: &utils.EventsWithIds[*armadaevents.EventSequence]{
+				Events:     []*armadaevents.EventSequence{testfixtures.NewEventSequence(testfixtures.MoveJob)},
+				MessageIds: []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+			},
+			expected: &model.InstructionSet{
+				JobsToUpdate: []*model.UpdateJobInstruction{&expectedJobMoved},
+				MessageIds:   []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+			},
+		},
+		"queued job updated": {
+			events: &utils.EventsWithIds[*armadaevents.EventSequence]{
+				Events:     []*armadaevents.EventSequence{testfixtures.NewEventSequence(testfixtures.UpdateQueuedJob)},
+				MessageIds: []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+			},
+			expected: &model.InstructionSet{
+				QueuedJobsToUpdate: []*model.UpdateQueuedJobInstruction{&expectedQueuedJobUpdate},
+				MessageIds:         []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+			},
+		},
+		"suspended": {
+			events: &utils.EventsWithIds[*armadaevents.EventSequence]{
+				Events:     []*armadaevents.EventSequence{testfixtures.NewEventSequence(testfixtures.SuspendJob)},
+				MessageIds: []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+			},
+			expected: &model.InstructionSet{
+				JobsToUpdate: []*model.UpdateJobInstruction{&expectedJobSuspended},
+				MessageIds:   []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+			},
+		},
+		"resumed": {
+			events: &utils.EventsWithIds[*armadaevents.EventSequence]{
+				Events:     []*armadaevents.EventSequence{testfixtures.NewEventSequence(testfixtures.ResumeJob)},
+				MessageIds: []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+			},
+			expected: &model.InstructionSet{
+				JobsToUpdate: []*model.UpdateJobInstruction{&expectedJobResumed},
+				MessageIds:   []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+			},
+		},
 		"job run failed": {
 			events: &utils.EventsWithIds[*armadaevents.EventSequence]{
 				Events:     []*armadaevents.EventSequence{testfixtures.NewEventSequence(testfixtures.JobRunFailed)},
@@ -0,0 +1,179 @@
+package lookoutdb
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/compress"
+	commonmetrics "github.com/armadaproject/armada/internal/common/ingest/metrics"
+	log "github.com/armadaproject/armada/internal/common/logging"
+	"github.com/armadaproject/armada/internal/lookoutingester/model"
+)
+
+// maxSearchTextBytes limits the length of each error and debug message indexed for search. Messages are truncated to
+// this length, as tsvectors are limited to 1MB and the start of a message is the part worth searching.
+const maxSearchTextBytes = 64 * 1024
+
+// jobRunErrorText is the decompressed error and debug message of a job run
+type jobRunErrorText struct {
+	runId string
+	error *string
+	debug *string
+}
+
+// WithErrorSearch makes the LookoutDb index the errors and debug messages of job runs for full-text search, in the
+// job_run_error_search table. The decompressor must be able to decompress the errors of the instructions stored.
+func (l *LookoutDb) WithErrorSearch(decompressor compress.Decompressor) *LookoutDb {
+	l.errorSearchDecompressor = decompressor
+	return l
+}
+
+// IndexJobRunErrors decompresses the errors and debug messages of the job run updates given, and stores them in the
+// job_run_error_search table. Messages that can't be decompressed aren't indexed.
+func (l *LookoutDb) IndexJobRunErrors(ctx *armadacontext.Context, instructions []*model.UpdateJobRunInstruction) error {
+	texts := l.decompressJobRunErrors(instructions)
+	if len(texts) == 0 {
+		return nil
+	}
+	start := time.Now()
+	err := l.IndexJobRunErrorsBatch(ctx, texts)
+	if err != nil {
+		log.WithError(err).Warn("Indexing job run errors via batch failed, will attempt to insert serially (this might be slow).")
+		if scalarErr := l.IndexJobRunErrorsScalar(ctx, texts); scalarErr != nil {
+			return scalarErr
+		}
+	}
+	taken := time.Since(start)
+	l.metrics.RecordAvRowChangeTimeByOperation("job_run_error_search", commonmetrics.DBOperationInsert, len(texts), taken)
+	l.metrics.RecordRowsChange("job_run_error_search", commonmetrics.DBOperationInsert, len(texts))
+	log.Infof("Indexed %d job run errors in %s", len(texts), taken)
+	return nil
+}
+
+func (l *LookoutDb) IndexJobRunErrorsBatch(ctx *armadacontext.Context, texts []*jobRunErrorText) error {
+	tmpTable := "job_run_error_search_tmp"
+	return l.withDatabaseRetryInsert(ctx, func() error {
+		createTmp := func(tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, fmt.Sprintf(`
+				CREATE TEMPORARY TABLE %s (
+					run_id varchar(36),
+					error  text,
+					debug  text
+				) ON COMMIT DROP;`, tmpTable))
+			if err != nil {
+				l.metrics.RecordDBError(commonmetrics.DBOperationCreateTempTable)
+			}
+			return err
+		}
+
+		insertTmp := func(tx pgx.Tx) error {
+			_, err := tx.CopyFrom(ctx,
+				pgx.Identifier{tmpTable},
+				[]string{
+					"run_id",
+					"error",
+					"debug",
+				},
+				pgx.CopyFromSlice(len(texts), func(i int) ([]interface{}, error) {
+					return []interface{}{
+						texts[i].runId,
+						texts[i].error,
+						texts[i].debug,
+					}, nil
+				}),
+			)
+			return err
+		}
+
+		copyToDest := func(tx pgx.Tx) error {
+			_, err := tx.Exec(
+				ctx,
+				fmt.Sprintf(`
+					INSERT INTO job_run_error_search (run_id, job_id, error, debug)
+					SELECT tmp.run_id, job_run.job_id, tmp.error, tmp.debug
+					FROM %s AS tmp JOIN job_run ON job_run.run_id = tmp.run_id
+					ON CONFLICT (run_id) DO UPDATE SET
+						error = coalesce(EXCLUDED.error, job_run_error_search.error),
+						debug = coalesce(EXCLUDED.debug, job_run_error_search.debug)`, tmpTable))
+			if err != nil {
+				l.metrics.RecordDBError(commonmetrics.DBOperationInsert)
+			}
+			return err
+		}
+		return batchInsert(ctx, l.db, createTmp, insertTmp, copyToDest)
+	})
+}
+
+func (l *LookoutDb) IndexJobRunErrorsScalar(ctx *armadacontext.Context, texts []*jobRunErrorText) error {
+	sqlStatement := `INSERT INTO job_run_error_search (run_id, job_id, error, debug)
+		SELECT run_id, job_id, $2, $3 FROM job_run WHERE run_id = $1
+		ON CONFLICT (run_id) DO UPDATE SET
+			error = coalesce(EXCLUDED.error, job_run_error_search.error),
+			debug = coalesce(EXCLUDED.debug, job_run_error_search.debug)`
+	for _, text := range texts {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		err := l.withDatabaseRetryInsert(ctx, func() error {
+			_, err := l.db.Exec(ctx, sqlStatement, text.runId, text.error, text.debug)
+			if err != nil {
+				l.metrics.RecordDBError(commonmetrics.DBOperationInsert)
+			}
+			return err
+		})
+		if err != nil {
+			log.WithError(err).Warnf("Indexing error of job run %s failed", text.runId)
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+		}
+	}
+	return nil
+}
+
+// decompressJobRunErrors returns the decompressed error and debug message of the updates that have either set
+func (l *LookoutDb) decompressJobRunErrors(instructions []*model.UpdateJobRunInstruction) []*jobRunErrorText {
+	var texts []*jobRunErrorText
+	for _, instruction := range instructions {
+		text := &jobRunErrorText{
+			runId: instruction.RunId,
+			error: l.decompressSearchText(instruction.RunId, instruction.Error),
+			debug: l.decompressSearchText(instruction.RunId, instruction.Debug),
+		}
+		if text.error != nil || text.debug != nil {
+			texts = append(texts, text)
+		}
+	}
+	return texts
+}
+
+// decompressSearchText returns the text to index of a compressed message, or nil if it's empty or can't be
+// decompressed. Postgres text can't contain null characters or invalid UTF-8, so these are replaced.
+func (l *LookoutDb) decompressSearchText(runId string, compressed []byte) *string {
+	if len(compressed) == 0 {
+		return nil
+	}
+	decompressed, err := l.errorSearchDecompressor.Decompress(compressed)
+	if err != nil {
+		log.WithError(err).Warnf("Couldn't decompress error of job run %s to index it", runId)
+		return nil
+	}
+	if len(decompressed) > maxSearchTextBytes {
+		// Cut at the start of a character, so a partial character isn't left at the end
+		end := maxSearchTextBytes
+		for end > 0 && !utf8.RuneStart(decompressed[end]) {
+			end--
+		}
+		decompressed = decompressed[:end]
+	}
+	text := strings.ToValidUTF8(strings.ReplaceAll(string(decompressed), "\x00", ""), "\uFFFD")
+	if text == "" {
+		return nil
+	}
+	return &text
+}
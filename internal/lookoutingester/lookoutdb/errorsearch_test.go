@@ -0,0 +1,35 @@
+package lookoutdb
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/lookoutingester/metrics"
+	"github.com/armadaproject/armada/internal/lookoutingester/model"
+)
+
+func TestDecompressJobRunErrors(t *testing.T) {
+	ldb := NewLookoutDb(nil, nil, metrics.Get(), 2, 10).WithErrorSearch(&compress.NoOpDecompressor{})
+	// A multi-byte character straddling the limit is dropped, rather than cut in half
+	long := strings.Repeat("a", maxSearchTextBytes-1) + "é"
+
+	texts := ldb.decompressJobRunErrors([]*model.UpdateJobRunInstruction{
+		{RunId: "run-1", Error: []byte("exit code\x00 137")},
+		{RunId: "run-2", Debug: []byte(long)},
+		{RunId: "run-3", Error: []byte{}},
+		{RunId: "run-4", Error: []byte("invalid \xff utf-8")},
+	})
+
+	assert.Len(t, texts, 3)
+	assert.Equal(t, "run-1", texts[0].runId)
+	assert.Equal(t, "exit code 137", *texts[0].error)
+	assert.Nil(t, texts[0].debug)
+	assert.Equal(t, "run-2", texts[1].runId)
+	assert.Nil(t, texts[1].error)
+	assert.Equal(t, strings.Repeat("a", maxSearchTextBytes-1), *texts[1].debug)
+	assert.Equal(t, "run-4", texts[2].runId)
+	assert.Equal(t, "invalid � utf-8", *texts[2].error)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/compress"
 	"github.com/armadaproject/armada/internal/common/database/lookout"
 	commonmetrics "github.com/armadaproject/armada/internal/common/ingest/metrics"
 	log "github.com/armadaproject/armada/internal/common/logging"
@@ -23,6 +24,11 @@ type LookoutDb struct {
 	maxBackoff  int
 	maxRetries  int
 	fatalErrors []*regexp.Regexp
+	// If non-nil, errors of job runs are decompressed with this and indexed for search
+	errorSearchDecompressor compress.Decompressor
+	// Used to read and rewrite the specs of queued jobs that are updated
+	jobSpecCompressor   compress.Compressor
+	jobSpecDecompressor compress.Decompressor
 }
 
 func NewLookoutDb(db *pgxpool.Pool, fatalErrors []*regexp.Regexp, metrics *metrics.Metrics, maxBackoff int, maxRetries int) *LookoutDb {
@@ -32,14 +38,19 @@ func NewLookoutDb(db *pgxpool.Pool, fatalErrors []*regexp.Regexp, metrics *metri
 		maxBackoff:  maxBackoff,
 		maxRetries:  maxRetries,
 		fatalErrors: fatalErrors,
+		// Specs are stored zlib encoded, as the instruction converter stores them
+		jobSpecCompressor:   compress.NewThreadSafeZlibCompressor(0),
+		jobSpecDecompressor: compress.NewThreadSafeZlibDecompressor(),
 	}
 }
 
 // Store updates the lookout database according to the supplied InstructionSet.
 // The updates are applied in the following order:
 // * New Job Creations
+// * Queued Job Updates
 // * Job Updates, New Job Creations, New User Annotations
 // * Job Run Updates
+// * Job Run Error Search Index, if enabled with WithErrorSearch
 // In each case we first try to batch insert the rows using the postgres copy protocol. If this
 // fails then we try a slower, serial insert and discard any rows that cannot be inserted.
 //
@@ -53,8 +64,8 @@ func (l *LookoutDb) Store(ctx *armadacontext.Context, instructions *model.Instru
 	jobsToUpdate := conflateJobUpdates(instructions.JobsToUpdate)
 	jobRunsToUpdate := conflateJobRunUpdates(instructions.JobRunsToUpdate)
 
-	numRowsToChange := len(instructions.JobsToCreate) + len(jobsToUpdate) + len(instructions.JobRunsToCreate) +
-		len(jobRunsToUpdate) + len(instructions.JobErrorsToCreate)
+	numRowsToChange := len(instructions.JobsToCreate) + len(jobsToUpdate) + len(instructions.QueuedJobsToUpdate) +
+		len(instructions.JobRunsToCreate) + len(jobRunsToUpdate) + len(instructions.JobErrorsToCreate)
 
 	start := time.Now()
 	// Jobs need to be ingested first as other updates may reference these
@@ -69,6 +80,11 @@ func (l *LookoutDb) Store(ctx *armadacontext.Context, instructions *model.Instru
 		return err
 	}
 
+	// Queued job updates only apply to jobs that are still queued, so are applied before any state changes
+	if err := l.UpdateQueuedJobs(ctx, instructions.QueuedJobsToUpdate); err != nil {
+		return err
+	}
+
 	// Now we can do job updates, annotations and new job runs
 	jobUpdateGroup, jobUpdateCtx := armadacontext.ErrGroup(ctx)
 	jobUpdateGroup.Go(func() error {
@@ -89,6 +105,13 @@ func (l *LookoutDb) Store(ctx *armadacontext.Context, instructions *model.Instru
 		return err
 	}
 
+	// Errors are indexed after the job runs they belong to have been created
+	if l.errorSearchDecompressor != nil {
+		if err := l.IndexJobRunErrors(ctx, jobRunsToUpdate); err != nil {
+			return err
+		}
+	}
+
 	taken := time.Since(start)
 	if numRowsToChange != 0 && taken != 0 {
 		l.metrics.RecordAvRowChangeTime(numRowsToChange, taken)
@@ -471,7 +494,12 @@ func (l *LookoutDb) UpdateJobsBatch(ctx *armadacontext.Context, instructions []*
 					duplicate                    bool,
 					latest_run_id                varchar(36),
 					cancel_reason                varchar(512),
-					cancel_user                  varchar(512)
+					cancel_user                  varchar(512),
+					queue                        varchar(512),
+					jobset                       varchar(1024),
+					suspended                    bool,
+					runs_leased                  int,
+					first_leased                 timestamp
 				) ON COMMIT DROP;`, tmpTable))
 			if err != nil {
 				l.metrics.RecordDBError(commonmetrics.DBOperationCreateTempTable)
@@ -493,6 +521,11 @@ func (l *LookoutDb) UpdateJobsBatch(ctx *armadacontext.Context, instructions []*
 					"latest_run_id",
 					"cancel_reason",
 					"cancel_user",
+					"queue",
+					"jobset",
+					"suspended",
+					"runs_leased",
+					"first_leased",
 				},
 				pgx.CopyFromSlice(len(instructions), func(i int) ([]interface{}, error) {
 					return []interface{}{
@@ -506,6 +539,11 @@ func (l *LookoutDb) UpdateJobsBatch(ctx *armadacontext.Context, instructions []*
 						instructions[i].LatestRunId,
 						instructions[i].CancelReason,
 						instructions[i].CancelUser,
+						instructions[i].Queue,
+						instructions[i].JobSet,
+						instructions[i].Suspended,
+						instructions[i].RunsLeased,
+						instructions[i].FirstLeased,
 					}, nil
 				}),
 			)
@@ -525,8 +563,13 @@ func (l *LookoutDb) UpdateJobsBatch(ctx *armadacontext.Context, instructions []*
 						duplicate                    = coalesce(tmp.duplicate, job.duplicate),
 						latest_run_id                = coalesce(tmp.latest_run_id, job.latest_run_id),
 						cancel_reason                = coalesce(tmp.cancel_reason, job.cancel_reason),
-						cancel_user                  = coalesce(tmp.cancel_user, job.cancel_user)
-					FROM %s as tmp WHERE tmp.job_id = job.job_id`, tmpTable),
+						cancel_user                  = coalesce(tmp.cancel_user, job.cancel_user),
+						queue                        = CASE WHEN job.state = %[2]d THEN coalesce(tmp.queue, job.queue) ELSE job.queue END,
+						jobset                       = CASE WHEN job.state = %[2]d THEN coalesce(tmp.jobset, job.jobset) ELSE job.jobset END,
+						suspended                    = coalesce(tmp.suspended, job.suspended),
+						run_count                    = job.run_count + CASE WHEN tmp.latest_run_id IS DISTINCT FROM job.latest_run_id THEN coalesce(tmp.runs_leased, 0) ELSE 0 END,
+						first_leased                 = coalesce(job.first_leased, tmp.first_leased)
+					FROM %[1]s as tmp WHERE tmp.job_id = job.job_id`, tmpTable, lookout.JobQueuedOrdinal),
 			)
 			if err != nil {
 				l.metrics.RecordDBError(commonmetrics.DBOperationUpdate)
@@ -539,7 +582,7 @@ func (l *LookoutDb) UpdateJobsBatch(ctx *armadacontext.Context, instructions []*
 }
 
 func (l *LookoutDb) UpdateJobsScalar(ctx *armadacontext.Context, instructions []*model.UpdateJobInstruction) error {
-	sqlStatement := `UPDATE job
+	sqlStatement := fmt.Sprintf(`UPDATE job
 		SET
 			priority                     = coalesce($2, priority),
 			state                        = coalesce($3, state),
@@ -549,8 +592,13 @@ func (l *LookoutDb) UpdateJobsScalar(ctx *armadacontext.Context, instructions []
 			duplicate                    = coalesce($7, duplicate),
 			latest_run_id                = coalesce($8, job.latest_run_id),
 			cancel_reason                = coalesce($9, job.cancel_reason),
-			cancel_user                  = coalesce($10, job.cancel_user)
-		WHERE job_id = $1`
+			cancel_user                  = coalesce($10, job.cancel_user),
+			queue                        = CASE WHEN job.state = %[1]d THEN coalesce($11, job.queue) ELSE job.queue END,
+			jobset                       = CASE WHEN job.state = %[1]d THEN coalesce($12, job.jobset) ELSE job.jobset END,
+			suspended                    = coalesce($13, job.suspended),
+			run_count                    = job.run_count + CASE WHEN $8 IS DISTINCT FROM job.latest_run_id THEN coalesce($14, 0) ELSE 0 END,
+			first_leased                 = coalesce(job.first_leased, $15)
+		WHERE job_id = $1`, lookout.JobQueuedOrdinal)
 	for _, i := range instructions {
 		if ctx.Err() != nil {
 			return ctx.Err()
@@ -566,7 +614,12 @@ func (l *LookoutDb) UpdateJobsScalar(ctx *armadacontext.Context, instructions []
 				i.Duplicate,
 				i.LatestRunId,
 				i.CancelReason,
-				i.CancelUser)
+				i.CancelUser,
+				i.Queue,
+				i.JobSet,
+				i.Suspended,
+				i.RunsLeased,
+				i.FirstLeased)
 			if err != nil {
 				l.metrics.RecordDBError(commonmetrics.DBOperationUpdate)
 			}
@@ -1088,6 +1141,28 @@ func conflateJobUpdates(updates []*model.UpdateJobInstruction) []*model.UpdateJo
 			if update.LatestRunId != nil {
 				existing.LatestRunId = update.LatestRunId
 			}
+			// As in the scheduler, a move is ignored if the job has already left the queued state.
+			if existing.State == nil || *existing.State == lookout.JobQueuedOrdinal {
+				if update.Queue != nil {
+					existing.Queue = update.Queue
+				}
+				if update.JobSet != nil {
+					existing.JobSet = update.JobSet
+				}
+			}
+			if update.Suspended != nil {
+				existing.Suspended = update.Suspended
+			}
+			if update.RunsLeased != nil {
+				runsLeased := *update.RunsLeased
+				if existing.RunsLeased != nil {
+					runsLeased += *existing.RunsLeased
+				}
+				existing.RunsLeased = &runsLeased
+			}
+			if existing.FirstLeased == nil {
+				existing.FirstLeased = update.FirstLeased
+			}
 		}
 	}
 
@@ -1177,14 +1252,8 @@ func (l *LookoutDb) filterEventsForTerminalJobs(
 	}
 	queryStart := time.Now()
 	rowsRaw, err := l.withDatabaseRetryQuery(ctx, func() (interface{}, error) {
-		terminalStates := []int{
-			lookout.JobSucceededOrdinal,
-			lookout.JobFailedOrdinal,
-			lookout.JobCancelledOrdinal,
-			lookout.JobPreemptedOrdinal,
-			lookout.JobRejectedOrdinal,
-		}
-		return db.Query(ctx, "SELECT DISTINCT job_id, state FROM JOB where state = any($1) AND job_id = any($2)", terminalStates, jobIds)
+		// Terminal jobs are all in the job_terminated partition, so the active jobs don't need to be read
+		return db.Query(ctx, "SELECT DISTINCT job_id, state FROM job_terminated WHERE job_id = any($1)", jobIds)
 	})
 	if err != nil {
 		m.RecordDBError(commonmetrics.DBOperationRead)
@@ -11,28 +11,12 @@ import (
 	"k8s.io/utils/ptr"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
-	"github.com/armadaproject/armada/internal/common/database"
 	"github.com/armadaproject/armada/internal/common/database/lookout"
 	"github.com/armadaproject/armada/internal/common/ingest/testfixtures"
 	"github.com/armadaproject/armada/internal/common/pulsarutils"
-	lookoutschema "github.com/armadaproject/armada/internal/lookout/schema"
-	lookouthcschema "github.com/armadaproject/armada/internal/lookouthc/schema"
 	"github.com/armadaproject/armada/internal/lookoutingester/model"
 )
 
-func withLookoutHCDb(action func(db *pgxpool.Pool) error) error {
-	migrations, err := lookoutschema.LookoutMigrations()
-	if err != nil {
-		return err
-	}
-	return database.WithTestDb(migrations, func(db *pgxpool.Pool) error {
-		if err := lookouthcschema.ApplyPartitioner(armadacontext.Background(), db); err != nil {
-			return err
-		}
-		return action(db)
-	})
-}
-
 func countInPartition(t *testing.T, db *pgxpool.Pool, partition, jobId string) int {
 	t.Helper()
 	var count int
@@ -46,7 +30,7 @@ func countInPartition(t *testing.T, db *pgxpool.Pool, partition, jobId string) i
 }
 
 func TestHotCold_StoreRoutesTerminalJobToTerminatedPartition(t *testing.T) {
-	err := withLookoutHCDb(func(db *pgxpool.Pool) error {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
 		ldb := NewLookoutDb(db, fatalErrors, m, 10, 10)
 
 		createInstructions := &model.InstructionSet{
@@ -102,7 +86,7 @@ func TestHotCold_StoreRoutesTerminalJobToTerminatedPartition(t *testing.T) {
 }
 
 func TestHotCold_StoreKeepsRunningJobInActivePartition(t *testing.T) {
-	err := withLookoutHCDb(func(db *pgxpool.Pool) error {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
 		ldb := NewLookoutDb(db, fatalErrors, m, 10, 10)
 
 		instructions := &model.InstructionSet{
@@ -128,7 +112,7 @@ func TestHotCold_StoreKeepsRunningJobInActivePartition(t *testing.T) {
 }
 
 func TestHotCold_MultipleJobsDistributedAcrossPartitions(t *testing.T) {
-	err := withLookoutHCDb(func(db *pgxpool.Pool) error {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
 		ldb := NewLookoutDb(db, fatalErrors, m, 10, 10)
 
 		activeIds := []string{"job-active-1", "job-active-2"}
@@ -198,7 +182,7 @@ func TestHotCold_MultipleJobsDistributedAcrossPartitions(t *testing.T) {
 }
 
 func TestHotCold_FailedJobStoresErrorAndRoutesToTerminatedPartition(t *testing.T) {
-	err := withLookoutHCDb(func(db *pgxpool.Pool) error {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
 		ldb := NewLookoutDb(db, fatalErrors, m, 10, 10)
 
 		instructions := &model.InstructionSet{
@@ -228,7 +212,7 @@ func TestHotCold_FailedJobStoresErrorAndRoutesToTerminatedPartition(t *testing.T
 }
 
 func TestHotCold_ParentJobTableReturnsAllJobs(t *testing.T) {
-	err := withLookoutHCDb(func(db *pgxpool.Pool) error {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
 		ldb := NewLookoutDb(db, fatalErrors, m, 10, 10)
 
 		createInstructions := []*model.CreateJobInstruction{
@@ -286,7 +270,7 @@ func TestHotCold_ParentJobTableReturnsAllJobs(t *testing.T) {
 }
 
 func TestHotCold_TerminalStateQueryPrunesActivePartition(t *testing.T) {
-	err := withLookoutHCDb(func(db *pgxpool.Pool) error {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
 		ldb := NewLookoutDb(db, fatalErrors, m, 10, 10)
 
 		require.NoError(t, ldb.Store(armadacontext.Background(), &model.InstructionSet{
@@ -327,7 +311,7 @@ func TestHotCold_TerminalStateQueryPrunesActivePartition(t *testing.T) {
 }
 
 func TestHotCold_ConflatedTerminalUpdatesProduceSingleTerminatedRow(t *testing.T) {
-	err := withLookoutHCDb(func(db *pgxpool.Pool) error {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
 		ldb := NewLookoutDb(db, fatalErrors, m, 10, 10)
 
 		require.NoError(t, ldb.Store(armadacontext.Background(), &model.InstructionSet{
@@ -388,7 +372,7 @@ func TestHotCold_ConflatedTerminalUpdatesProduceSingleTerminatedRow(t *testing.T
 // CreateJobs, the untargeted ON CONFLICT DO NOTHING only checks the destination
 // (active) partition and would insert a duplicate routed there.
 func TestHotCold_CreateSuppressedWhenJobExistsInOtherPartition(t *testing.T) {
-	err := withLookoutHCDb(func(db *pgxpool.Pool) error {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
 		ldb := NewLookoutDb(db, fatalErrors, m, 10, 10)
 
 		// A row for the job already exists in job_terminated (e.g. from an
@@ -422,7 +406,7 @@ func TestHotCold_CreateSuppressedWhenJobExistsInOtherPartition(t *testing.T) {
 // must be suppressed when another active-state row for the same job_id already
 // exists there (e.g. a Queued create arriving after a Leased row is present).
 func TestHotCold_CreateSuppressedWhenJobExistsInSamePartition(t *testing.T) {
-	err := withLookoutHCDb(func(db *pgxpool.Pool) error {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
 		ldb := NewLookoutDb(db, fatalErrors, m, 10, 10)
 
 		// An active-state row (Leased) already exists in job_active.
@@ -452,3 +436,42 @@ func TestHotCold_CreateSuppressedWhenJobExistsInSamePartition(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+// A late update to an active state, such as a running event replayed after the
+// job succeeded, must not move a terminated job back into job_active.
+func TestHotCold_LateUpdateDoesNotMoveTerminatedJobBackToActivePartition(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		ldb := NewLookoutDb(db, fatalErrors, m, 10, 10)
+
+		succeeded := &model.InstructionSet{
+			JobsToCreate: []*model.CreateJobInstruction{makeCreateJobInstruction(JobId)},
+			JobsToUpdate: []*model.UpdateJobInstruction{{
+				JobId:                     JobId,
+				State:                     ptr.To[int32](lookout.JobSucceededOrdinal),
+				LastTransitionTime:        &finishedTime,
+				LastTransitionTimeSeconds: ptr.To(finishedTime.Unix()),
+			}},
+			MessageIds: []pulsar.MessageID{pulsarutils.NewMessageId(1)},
+		}
+		require.NoError(t, ldb.Store(armadacontext.Background(), succeeded))
+		assert.Equal(t, 1, countInPartition(t, db, "job_terminated", JobId))
+
+		lateRunning := &model.InstructionSet{
+			JobsToUpdate: []*model.UpdateJobInstruction{{
+				JobId:                     JobId,
+				State:                     ptr.To[int32](lookout.JobRunningOrdinal),
+				LastTransitionTime:        &updateTime,
+				LastTransitionTimeSeconds: ptr.To(updateTime.Unix()),
+			}},
+			MessageIds: []pulsar.MessageID{pulsarutils.NewMessageId(2)},
+		}
+		require.NoError(t, ldb.Store(armadacontext.Background(), lateRunning))
+
+		assert.Equal(t, 0, countInPartition(t, db, "job_active", JobId))
+		assert.Equal(t, 1, countInPartition(t, db, "job_terminated", JobId))
+		job := getJob(t, db, JobId)
+		assert.Equal(t, int32(lookout.JobSucceededOrdinal), job.State)
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -94,6 +94,7 @@ type JobRow struct {
 	CancelUser                *string
 	Annotations               map[string]string
 	ExternalJobUri            string
+	Suspended                 bool
 }
 
 type JobSpecRow struct {
@@ -337,6 +338,68 @@ func TestUpdateJobsScalar(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestUpdateJobs_Moved(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		ldb := NewLookoutDb(db, fatalErrors, m, 10, 10)
+		err := ldb.CreateJobsBatch(armadacontext.Background(), defaultInstructionSet().JobsToCreate)
+		assert.Nil(t, err)
+
+		moved := &model.UpdateJobInstruction{JobId: JobId, Queue: pointer.String("other-queue"), JobSet: pointer.String("other-job-set")}
+		err = ldb.UpdateJobsBatch(armadacontext.Background(), []*model.UpdateJobInstruction{moved})
+		assert.Nil(t, err)
+		job := getJob(t, db, JobId)
+		assert.Equal(t, "other-queue", job.Queue)
+		assert.Equal(t, "other-job-set", job.JobSet)
+
+		// Other updates leave the queue and job set alone.
+		err = ldb.UpdateJobsScalar(armadacontext.Background(), defaultInstructionSet().JobsToUpdate)
+		assert.NoError(t, err)
+		job = getJob(t, db, JobId)
+		assert.Equal(t, "other-queue", job.Queue)
+		assert.Equal(t, "other-job-set", job.JobSet)
+
+		// Jobs that are no longer queued aren't moved.
+		err = ldb.UpdateJobsBatch(armadacontext.Background(), []*model.UpdateJobInstruction{makeUpdateJobInstruction(JobId, lookout.JobLeasedOrdinal)})
+		assert.NoError(t, err)
+		err = ldb.UpdateJobsBatch(armadacontext.Background(), []*model.UpdateJobInstruction{moved})
+		assert.NoError(t, err)
+		err = ldb.UpdateJobsScalar(armadacontext.Background(), []*model.UpdateJobInstruction{
+			{JobId: JobId, Queue: pointer.String(queue), JobSet: pointer.String(jobSetName)},
+		})
+		assert.NoError(t, err)
+		job = getJob(t, db, JobId)
+		assert.Equal(t, "other-queue", job.Queue)
+		assert.Equal(t, "other-job-set", job.JobSet)
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestUpdateJobs_Suspended(t *testing.T) {
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		ldb := NewLookoutDb(db, fatalErrors, m, 10, 10)
+		err := ldb.CreateJobsBatch(armadacontext.Background(), defaultInstructionSet().JobsToCreate)
+		assert.Nil(t, err)
+
+		suspended := &model.UpdateJobInstruction{JobId: JobId, Suspended: pointer.Bool(true)}
+		err = ldb.UpdateJobsBatch(armadacontext.Background(), []*model.UpdateJobInstruction{suspended})
+		assert.Nil(t, err)
+		assert.True(t, getJob(t, db, JobId).Suspended)
+
+		// Other updates leave the suspended flag alone.
+		err = ldb.UpdateJobsScalar(armadacontext.Background(), defaultInstructionSet().JobsToUpdate)
+		assert.NoError(t, err)
+		assert.True(t, getJob(t, db, JobId).Suspended)
+
+		resumed := &model.UpdateJobInstruction{JobId: JobId, Suspended: pointer.Bool(false)}
+		err = ldb.UpdateJobsScalar(armadacontext.Background(), []*model.UpdateJobInstruction{resumed})
+		assert.NoError(t, err)
+		assert.False(t, getJob(t, db, JobId).Suspended)
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
 func TestUpdateJobsWithTerminal(t *testing.T) {
 	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
 		initial := []*model.CreateJobInstruction{
@@ -824,12 +887,13 @@ func TestConflateJobUpdates(t *testing.T) {
 	// Non-Empty
 	updates = conflateJobUpdates([]*model.UpdateJobInstruction{
 		{JobId: JobId, Priority: pointer.Int64(3)},
+		{JobId: JobId, Queue: pointer.String("other-queue"), JobSet: pointer.String("other-job-set")},
 		{JobId: JobId, State: pointer.Int32(2)},
 		{JobId: "someOtherJob", State: pointer.Int32(3)},
 	})
 
 	expected := []*model.UpdateJobInstruction{
-		{JobId: JobId, Priority: pointer.Int64(3), State: pointer.Int32(2)},
+		{JobId: JobId, Priority: pointer.Int64(3), State: pointer.Int32(2), Queue: pointer.String("other-queue"), JobSet: pointer.String("other-job-set")},
 		{JobId: "someOtherJob", State: pointer.Int32(3)},
 	}
 	sort.Slice(updates, func(i, j int) bool {
@@ -842,6 +906,19 @@ func TestConflateJobUpdates(t *testing.T) {
 	assert.Equal(t, expected, updates)
 }
 
+func TestConflateJobUpdatesWithMoveAfterLease(t *testing.T) {
+	// Moves of jobs that are no longer queued are ignored
+	updates := conflateJobUpdates([]*model.UpdateJobInstruction{
+		{JobId: JobId, State: pointer.Int32(lookout.JobLeasedOrdinal)},
+		{JobId: JobId, Queue: pointer.String("other-queue"), JobSet: pointer.String("other-job-set")},
+	})
+
+	expected := []*model.UpdateJobInstruction{
+		{JobId: JobId, State: pointer.Int32(lookout.JobLeasedOrdinal)},
+	}
+	assert.Equal(t, expected, updates)
+}
+
 func TestConflateJobUpdatesWithTerminal(t *testing.T) {
 	// Updates after the cancelled shouldn't be processed
 	updates := conflateJobUpdates([]*model.UpdateJobInstruction{
@@ -921,6 +998,27 @@ func TestConflateJobUpdatesWithNullState(t *testing.T) {
 	assert.Equal(t, expected, updates)
 }
 
+func TestConflateJobUpdatesWithRunsLeased(t *testing.T) {
+	firstLeased := time.Now()
+	secondLeased := firstLeased.Add(time.Minute)
+	updates := conflateJobUpdates([]*model.UpdateJobInstruction{
+		{JobId: JobId, LatestRunId: pointer.String("run-1"), RunsLeased: pointer.Int32(1), FirstLeased: &firstLeased},
+		{JobId: JobId, State: pointer.Int32(lookout.JobQueuedOrdinal)},
+		{JobId: JobId, LatestRunId: pointer.String("run-2"), RunsLeased: pointer.Int32(1), FirstLeased: &secondLeased},
+	})
+
+	expected := []*model.UpdateJobInstruction{
+		{
+			JobId:       JobId,
+			State:       pointer.Int32(lookout.JobQueuedOrdinal),
+			LatestRunId: pointer.String("run-2"),
+			RunsLeased:  pointer.Int32(2),
+			FirstLeased: &firstLeased,
+		},
+	}
+	assert.Equal(t, expected, updates)
+}
+
 func TestConflateJobRunUpdates(t *testing.T) {
 	// Empty
 	updates := conflateJobRunUpdates([]*model.UpdateJobRunInstruction{})
@@ -1120,7 +1218,8 @@ func getJob(t *testing.T, db *pgxpool.Pool, jobId string) JobRow {
 			cancel_reason,
 			cancel_user,
 			annotations,
-			external_job_uri
+			external_job_uri,
+			suspended
 		FROM job WHERE job_id = $1`,
 		jobId)
 	err := r.Scan(
@@ -1146,6 +1245,7 @@ func getJob(t *testing.T, db *pgxpool.Pool, jobId string) JobRow {
 		&job.CancelUser,
 		&job.Annotations,
 		&job.ExternalJobUri,
+		&job.Suspended,
 	)
 	assert.Nil(t, err)
 	return job
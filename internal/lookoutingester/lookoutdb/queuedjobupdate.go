@@ -0,0 +1,171 @@
+package lookoutdb
+
+import (
+	"maps"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/database/lookout"
+	commonmetrics "github.com/armadaproject/armada/internal/common/ingest/metrics"
+	log "github.com/armadaproject/armada/internal/common/logging"
+	"github.com/armadaproject/armada/internal/lookoutingester/instructions"
+	"github.com/armadaproject/armada/internal/lookoutingester/model"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// errJobNotQueued is returned when a queued job update is for a job that isn't queued, or that Lookout doesn't know.
+var errJobNotQueued = errors.New("job is not queued")
+
+// UpdateQueuedJobs applies updates to the specs of queued jobs, and to the resources and priority class stored for them
+// in the jobs table. An update only lists the containers it changes, so the new totals can only be calculated from the
+// stored spec. As in the scheduler, updates for jobs that are no longer queued are ignored.
+func (l *LookoutDb) UpdateQueuedJobs(ctx *armadacontext.Context, instructions []*model.UpdateQueuedJobInstruction) error {
+	if len(instructions) == 0 {
+		return nil
+	}
+	start := time.Now()
+	updated := 0
+	for _, i := range instructions {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		err := l.withDatabaseRetryInsert(ctx, func() error {
+			err := l.updateQueuedJob(ctx, i)
+			if err != nil && !errors.Is(err, errJobNotQueued) {
+				l.metrics.RecordDBError(commonmetrics.DBOperationUpdate)
+			}
+			return err
+		})
+		if errors.Is(err, errJobNotQueued) {
+			log.Infof("Ignoring update of job %s as it is not queued", i.JobId)
+			continue
+		}
+		if err != nil {
+			log.WithError(err).Warnf("Updating queued job %s failed", i.JobId)
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			continue
+		}
+		updated++
+	}
+	taken := time.Since(start)
+	l.metrics.RecordAvRowChangeTimeByOperation("job_spec", commonmetrics.DBOperationUpdate, len(instructions), taken)
+	l.metrics.RecordRowsChange("job_spec", commonmetrics.DBOperationUpdate, updated)
+	log.Infof("Updated %d queued jobs in %s", updated, taken)
+	return nil
+}
+
+func (l *LookoutDb) updateQueuedJob(ctx *armadacontext.Context, instruction *model.UpdateQueuedJobInstruction) error {
+	return pgx.BeginTxFunc(ctx, l.db, pgx.TxOptions{
+		IsoLevel:   pgx.ReadCommitted,
+		AccessMode: pgx.ReadWrite,
+	}, func(tx pgx.Tx) error {
+		var jobSpec []byte
+		err := tx.QueryRow(ctx, `
+			SELECT COALESCE(job_spec.job_spec, job.job_spec)
+			FROM job LEFT JOIN job_spec ON job.job_id = job_spec.job_id
+			WHERE job.job_id = $1 AND job.state = $2
+			FOR UPDATE OF job`,
+			instruction.JobId, lookout.JobQueuedOrdinal,
+		).Scan(&jobSpec)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errJobNotQueued
+		}
+		if err != nil {
+			return err
+		}
+
+		job, err := l.decompressJobSpec(jobSpec)
+		if err != nil {
+			// Retrying won't help, so the job is left as it is.
+			log.WithError(err).Warnf("Couldn't read spec of job %s; not updating it", instruction.JobId)
+			return nil
+		}
+		applyQueuedJobUpdate(job.GetMainPodSpec(), instruction)
+		jobSpec, err = l.compressJobSpec(job)
+		if err != nil {
+			log.WithError(err).Warnf("Couldn't write spec of job %s; not updating it", instruction.JobId)
+			return nil
+		}
+
+		resources := instructions.GetJobResources(job)
+		_, err = tx.Exec(ctx, `
+			UPDATE job
+			SET
+				cpu               = $2,
+				memory            = $3,
+				ephemeral_storage = $4,
+				gpu               = $5,
+				priority_class    = $6
+			WHERE job_id = $1`,
+			instruction.JobId,
+			resources.Cpu,
+			resources.Memory,
+			resources.EphemeralStorage,
+			resources.Gpu,
+			instructions.GetJobPriorityClass(job),
+		)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(ctx, `
+			INSERT INTO job_spec (job_id, job_spec) VALUES ($1, $2)
+			ON CONFLICT (job_id) DO UPDATE SET job_spec = EXCLUDED.job_spec`,
+			instruction.JobId, jobSpec,
+		)
+		return err
+	})
+}
+
+func (l *LookoutDb) decompressJobSpec(jobSpec []byte) (*api.Job, error) {
+	decompressed, err := l.jobSpecDecompressor.Decompress(jobSpec)
+	if err != nil {
+		return nil, err
+	}
+	job := &api.Job{}
+	if err := proto.Unmarshal(decompressed, job); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if job.GetMainPodSpec() == nil {
+		return nil, errors.New("job has no pod spec")
+	}
+	return job, nil
+}
+
+func (l *LookoutDb) compressJobSpec(job *api.Job) ([]byte, error) {
+	uncompressed, err := proto.Marshal(job)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return l.jobSpecCompressor.Compress(uncompressed)
+}
+
+// applyQueuedJobUpdate applies an update to the pod spec of a job in the same way as the scheduler ingester, except that
+// containers the update names but the pod doesn't have are skipped; such updates are never published by the server.
+func applyQueuedJobUpdate(podSpec *v1.PodSpec, instruction *model.UpdateQueuedJobInstruction) {
+	for i, container := range podSpec.Containers {
+		if resources, ok := instruction.ContainerResources[container.Name]; ok && resources != nil {
+			podSpec.Containers[i].Resources = *resources
+		}
+	}
+	if len(instruction.NodeSelector) > 0 {
+		podSpec.NodeSelector = maps.Clone(instruction.NodeSelector)
+	}
+	if len(instruction.Tolerations) > 0 {
+		podSpec.Tolerations = make([]v1.Toleration, 0, len(instruction.Tolerations))
+		for _, toleration := range instruction.Tolerations {
+			if toleration != nil {
+				podSpec.Tolerations = append(podSpec.Tolerations, *toleration)
+			}
+		}
+	}
+	if instruction.PriorityClassName != "" {
+		podSpec.PriorityClassName = instruction.PriorityClassName
+	}
+}
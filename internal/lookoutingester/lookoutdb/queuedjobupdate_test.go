@@ -0,0 +1,99 @@
+package lookoutdb
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/common/database/lookout"
+	"github.com/armadaproject/armada/internal/lookoutingester/model"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestUpdateQueuedJobs(t *testing.T) {
+	oneCpu := v1.ResourceRequirements{Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")}}
+	twoCpu := v1.ResourceRequirements{Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("2")}}
+	job := &api.Job{
+		Id: JobId,
+		PodSpec: &v1.PodSpec{
+			PriorityClassName: priorityClass,
+			Containers:        []v1.Container{{Name: "main", Resources: oneCpu}, {Name: "sidecar", Resources: oneCpu}},
+		},
+	}
+	jobBytes, err := proto.Marshal(job)
+	require.NoError(t, err)
+	compressor, err := compress.NewZlibCompressor(0)
+	require.NoError(t, err)
+	compressedJob, err := compressor.Compress(jobBytes)
+	require.NoError(t, err)
+
+	update := &model.UpdateQueuedJobInstruction{
+		JobId:              JobId,
+		ContainerResources: map[string]*v1.ResourceRequirements{"main": &twoCpu},
+		PriorityClassName:  "other-priority",
+	}
+
+	err = lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		ldb := NewLookoutDb(db, fatalErrors, m, 10, 10)
+		createJob := makeCreateJobInstruction(JobId)
+		createJob.JobProto = compressedJob
+		require.NoError(t, ldb.CreateJobs(armadacontext.Background(), []*model.CreateJobInstruction{createJob}))
+		require.NoError(t, ldb.CreateJobSpecs(armadacontext.Background(), []*model.CreateJobInstruction{createJob}))
+
+		require.NoError(t, ldb.UpdateQueuedJobs(armadacontext.Background(), []*model.UpdateQueuedJobInstruction{update}))
+
+		// The totals include the container left unchanged.
+		row := getJob(t, db, JobId)
+		assert.Equal(t, int64(3000), row.Cpu)
+		assert.Equal(t, "other-priority", row.PriorityClass)
+		updatedJob := decompressJob(t, getJobSpec(t, db, JobId).JobProto)
+		assert.Equal(t, twoCpu, updatedJob.PodSpec.Containers[0].Resources)
+		assert.Equal(t, oneCpu, updatedJob.PodSpec.Containers[1].Resources)
+		assert.Equal(t, "other-priority", updatedJob.PodSpec.PriorityClassName)
+
+		// Jobs that are no longer queued are left as they are.
+		require.NoError(t, ldb.UpdateJobs(armadacontext.Background(), []*model.UpdateJobInstruction{makeUpdateJobInstruction(JobId, lookout.JobLeasedOrdinal)}))
+		update.ContainerResources = map[string]*v1.ResourceRequirements{"main": &oneCpu}
+		require.NoError(t, ldb.UpdateQueuedJobs(armadacontext.Background(), []*model.UpdateQueuedJobInstruction{update}))
+		assert.Equal(t, int64(3000), getJob(t, db, JobId).Cpu)
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestApplyQueuedJobUpdate(t *testing.T) {
+	twoCpu := v1.ResourceRequirements{Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("2")}}
+	toleration := v1.Toleration{Key: "gpu", Operator: v1.TolerationOpExists}
+	podSpec := &v1.PodSpec{
+		PriorityClassName: priorityClass,
+		NodeSelector:      map[string]string{"a": "b"},
+		Containers:        []v1.Container{{Name: "main"}, {Name: "sidecar"}},
+	}
+
+	applyQueuedJobUpdate(podSpec, &model.UpdateQueuedJobInstruction{
+		ContainerResources: map[string]*v1.ResourceRequirements{"main": &twoCpu, "missing": &twoCpu},
+		Tolerations:        []*v1.Toleration{&toleration},
+	})
+
+	assert.Equal(t, &v1.PodSpec{
+		PriorityClassName: priorityClass,
+		NodeSelector:      map[string]string{"a": "b"},
+		Tolerations:       []v1.Toleration{toleration},
+		Containers:        []v1.Container{{Name: "main", Resources: twoCpu}, {Name: "sidecar"}},
+	}, podSpec)
+}
+
+func decompressJob(t *testing.T, b []byte) *api.Job {
+	decompressed, err := compress.NewZlibDecompressor().Decompress(b)
+	require.NoError(t, err)
+	job := &api.Job{}
+	require.NoError(t, proto.Unmarshal(decompressed, job))
+	return job
+}
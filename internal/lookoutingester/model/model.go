@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/apache/pulsar-client-go/pulsar"
+	v1 "k8s.io/api/core/v1"
 )
 
 // CreateJobInstruction is an instruction to insert a new row into the jobs table
@@ -40,6 +41,22 @@ type UpdateJobInstruction struct {
 	LastTransitionTimeSeconds *int64
 	Duplicate                 *bool
 	LatestRunId               *string
+	Queue                     *string
+	JobSet                    *string
+	Suspended                 *bool
+	// RunsLeased is the number of runs leased, counted only if LatestRunId is a new run
+	RunsLeased  *int32
+	FirstLeased *time.Time
+}
+
+// UpdateQueuedJobInstruction is an instruction to update the spec of a job that is still queued, along with the
+// resources and priority class stored for it in the jobs table. Fields left empty are not changed.
+type UpdateQueuedJobInstruction struct {
+	JobId              string
+	ContainerResources map[string]*v1.ResourceRequirements
+	NodeSelector       map[string]string
+	Tolerations        []*v1.Toleration
+	PriorityClassName  string
 }
 
 // CreateJobRunInstruction is an instruction to update an existing row in the jobRuns table
@@ -82,12 +99,13 @@ type CreateJobErrorInstruction struct {
 // own ordered list representing the order it was received.  We also store the original message ids corresponding to
 // these instructions so that when they are saved to the database, we can ACK the corresponding messages.
 type InstructionSet struct {
-	JobsToCreate      []*CreateJobInstruction
-	JobsToUpdate      []*UpdateJobInstruction
-	JobRunsToCreate   []*CreateJobRunInstruction
-	JobRunsToUpdate   []*UpdateJobRunInstruction
-	JobErrorsToCreate []*CreateJobErrorInstruction
-	MessageIds        []pulsar.MessageID
+	JobsToCreate       []*CreateJobInstruction
+	JobsToUpdate       []*UpdateJobInstruction
+	QueuedJobsToUpdate []*UpdateQueuedJobInstruction
+	JobRunsToCreate    []*CreateJobRunInstruction
+	JobRunsToUpdate    []*UpdateJobRunInstruction
+	JobErrorsToCreate  []*CreateJobErrorInstruction
+	MessageIds         []pulsar.MessageID
 }
 
 func (i *InstructionSet) GetMessageIDs() []pulsar.MessageID {
@@ -0,0 +1,134 @@
+// Package statistics records the job statistics served by Lookout's job statistics endpoint. Once a minute, the
+// number of queued, leased, pending and running jobs, and the resources requested by running jobs, are counted per
+// queue, pool and priority class, and the counts are added to the job_statistics table. The counts of each minute of
+// an hour are also summed into a row for the hour, so that long time ranges can be queried without reading every
+// minute. Each row records how many minutes it's the sum of, so that the counts can be averaged over the minutes
+// actually recorded.
+package statistics
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+	"k8s.io/utils/clock"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/database/lookout"
+)
+
+const (
+	// MinuteResolution and HourResolution are the lengths, in seconds, of the buckets of the rows recorded
+	MinuteResolution = 60
+	HourResolution   = 3600
+)
+
+// Recorder records job statistics once a minute
+type Recorder struct {
+	db    *pgxpool.Pool
+	clock clock.Clock
+}
+
+func NewRecorder(db *pgxpool.Pool, clock clock.Clock) *Recorder {
+	return &Recorder{
+		db:    db,
+		clock: clock,
+	}
+}
+
+// Run records job statistics at the start of every minute until the supplied context is cancelled
+func (r *Recorder) Run(ctx *armadacontext.Context) error {
+	ctx.Infof("Will record job statistics every minute")
+	for {
+		now := r.clock.Now()
+		next := now.Truncate(time.Minute).Add(time.Minute)
+		select {
+		case <-ctx.Done():
+			ctx.Debugf("Context cancelled, returning..")
+			return nil
+		case <-r.clock.After(next.Sub(now)):
+			if err := r.Record(ctx); err != nil {
+				ctx.Logger().
+					WithStacktrace(err).
+					Warnf("error recording job statistics")
+			}
+		}
+	}
+}
+
+// Record records the statistics of the current minute, replacing them if they have already been recorded, and then
+// updates the row of the current hour to include them
+func (r *Recorder) Record(ctx *armadacontext.Context) error {
+	minute := r.clock.Now().UTC().Truncate(time.Minute)
+	hour := minute.Truncate(time.Hour)
+	return pgx.BeginTxFunc(ctx, r.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, "DELETE FROM job_statistics WHERE resolution = $1 AND bucket = $2", MinuteResolution, minute); err != nil {
+			return errors.Wrap(err, "error deleting job statistics of minute")
+		}
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO job_statistics (
+				resolution, bucket, queue, pool, priority_class, queued, leased, pending, running, running_cpu,
+				running_memory, running_gpu, samples
+			)
+			SELECT
+				$1, $2, j.queue, COALESCE(jr.pool, ''), COALESCE(j.priority_class, ''),
+				count(*) FILTER (WHERE j.state = $3),
+				count(*) FILTER (WHERE j.state = $4),
+				count(*) FILTER (WHERE j.state = $5),
+				count(*) FILTER (WHERE j.state = $6),
+				COALESCE(sum(j.cpu) FILTER (WHERE j.state = $6), 0),
+				COALESCE(sum(j.memory) FILTER (WHERE j.state = $6), 0),
+				COALESCE(sum(j.gpu) FILTER (WHERE j.state = $6), 0),
+				1
+			FROM job j
+			LEFT JOIN job_run jr ON jr.run_id = j.latest_run_id
+			WHERE j.state IN ($3, $4, $5, $6)
+			GROUP BY j.queue, COALESCE(jr.pool, ''), COALESCE(j.priority_class, '')
+			ON CONFLICT (resolution, queue, bucket, pool, priority_class) DO UPDATE SET
+				queued = EXCLUDED.queued,
+				leased = EXCLUDED.leased,
+				pending = EXCLUDED.pending,
+				running = EXCLUDED.running,
+				running_cpu = EXCLUDED.running_cpu,
+				running_memory = EXCLUDED.running_memory,
+				running_gpu = EXCLUDED.running_gpu,
+				samples = EXCLUDED.samples`,
+			MinuteResolution, minute,
+			lookout.JobQueuedOrdinal, lookout.JobLeasedOrdinal, lookout.JobPendingOrdinal, lookout.JobRunningOrdinal,
+		); err != nil {
+			return errors.Wrap(err, "error recording job statistics of minute")
+		}
+
+		if _, err := tx.Exec(ctx, "DELETE FROM job_statistics WHERE resolution = $1 AND bucket = $2", HourResolution, hour); err != nil {
+			return errors.Wrap(err, "error deleting job statistics of hour")
+		}
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO job_statistics (
+				resolution, bucket, queue, pool, priority_class, queued, leased, pending, running, running_cpu,
+				running_memory, running_gpu, samples
+			)
+			SELECT
+				$1, $2, queue, pool, priority_class, sum(queued), sum(leased), sum(pending), sum(running),
+				sum(running_cpu), sum(running_memory), sum(running_gpu),
+				-- Minutes in which a queue had no jobs have no row for it, but still count, as zeros
+				(SELECT count(DISTINCT bucket) FROM job_statistics WHERE resolution = $3 AND bucket >= $2 AND bucket < $4)
+			FROM job_statistics
+			WHERE resolution = $3 AND bucket >= $2 AND bucket < $4
+			GROUP BY queue, pool, priority_class
+			ON CONFLICT (resolution, queue, bucket, pool, priority_class) DO UPDATE SET
+				queued = EXCLUDED.queued,
+				leased = EXCLUDED.leased,
+				pending = EXCLUDED.pending,
+				running = EXCLUDED.running,
+				running_cpu = EXCLUDED.running_cpu,
+				running_memory = EXCLUDED.running_memory,
+				running_gpu = EXCLUDED.running_gpu,
+				samples = EXCLUDED.samples`,
+			HourResolution, hour, MinuteResolution, hour.Add(time.Hour),
+		); err != nil {
+			return errors.Wrap(err, "error recording job statistics of hour")
+		}
+		return nil
+	})
+}
@@ -173,6 +173,14 @@ export function getMatch(match: Match): (a: any, b: any) => boolean {
       return (a, b) => a <= b
     case "anyOf":
       return (a, b) => b.includes(a)
+    case "regex":
+      return (a, b) => isString(a) && isString(b) && new RegExp(b).test(a)
+    case "notEqual":
+      return (a, b) => a !== b
+    case "notAnyOf":
+      return (a, b) => !b.includes(a)
+    case "notContains":
+      return (a, b) => !(isString(a) && isString(b) && a.includes(b))
     default:
       // eslint-disable-next-line no-console
       console.error(`Unknown match: ${match}`)
@@ -45,6 +45,10 @@ const FILTER_TYPE_DISPLAY_STRINGS: Record<Match, string> = {
   [Match.LessThanOrEqual]: `Less than or equal to${ELLIPSIS}`,
   [Match.AnyOf]: `Filter${ELLIPSIS}`,
   [Match.Exists]: `Annotation exists`,
+  [Match.Regex]: `Matches regex${ELLIPSIS}`,
+  [Match.NotEqual]: `Not equal to${ELLIPSIS}`,
+  [Match.NotAnyOf]: `Exclude${ELLIPSIS}`,
+  [Match.NotContains]: `Does not contain${ELLIPSIS}`,
 }
 
 export interface JobsTableFilterProps {
@@ -152,6 +152,7 @@ export type Job = {
   pool?: string
   exitCode?: number
   runtimeSeconds?: number
+  suspended?: boolean
 }
 
 export type JobKey = keyof Job
@@ -183,6 +184,10 @@ export enum Match {
   LessThanOrEqual = "lessThanOrEqualTo",
   AnyOf = "anyOf",
   Exists = "exists",
+  Regex = "regex",
+  NotEqual = "notEqual",
+  NotAnyOf = "notAnyOf",
+  NotContains = "notContains",
 }
 
 export const MATCH_DISPLAY_STRINGS: Record<Match, string> = {
@@ -195,6 +200,10 @@ export const MATCH_DISPLAY_STRINGS: Record<Match, string> = {
   [Match.LessThanOrEqual]: "Less than or equal to",
   [Match.AnyOf]: "Any of",
   [Match.Exists]: "Exists",
+  [Match.Regex]: "Matches regex",
+  [Match.NotEqual]: "Not equal to",
+  [Match.NotAnyOf]: "None of",
+  [Match.NotContains]: "Does not contain",
 }
 
 export const isValidMatch = (match: string): match is Match => (Object.values(Match) as string[]).includes(match)
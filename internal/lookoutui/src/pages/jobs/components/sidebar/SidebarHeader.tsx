@@ -63,6 +63,11 @@ export const SidebarHeader = memo(({ job, onClose }: SidebarHeaderProps) => (
               Cancelled by <strong>{job.cancelUser}</strong>.
             </Typography>
           )}
+          {job.suspended && (
+            <Typography variant="body2" component="span" display="block" marginTop={SPACING.xs}>
+              Suspended. The job will not be scheduled until it is resumed.
+            </Typography>
+          )}
         </div>
       }
     />
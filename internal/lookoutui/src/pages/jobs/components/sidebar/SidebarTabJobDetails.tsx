@@ -27,6 +27,7 @@ export const SidebarTabJobDetails = ({ job }: SidebarTabJobDetailsProps) => {
     { key: "Submitted", value: formatIsoTimestamp(job.submitted, "full") },
     ...(job.cancelReason ? [{ key: "Cancel Reason", value: job.cancelReason, allowCopy: true }] : []),
     ...(job.cancelUser ? [{ key: "Cancelled By", value: job.cancelUser, allowCopy: true }] : []),
+    ...(job.suspended ? [{ key: "Suspended", value: "Yes" }] : []),
   ]
   return (
     <>
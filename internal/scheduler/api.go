@@ -2,8 +2,10 @@ package scheduler
 
 import (
 	"context"
+	"slices"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
@@ -25,6 +27,7 @@ import (
 	"github.com/armadaproject/armada/internal/common/pulsarutils"
 	priorityTypes "github.com/armadaproject/armada/internal/common/types"
 	"github.com/armadaproject/armada/internal/scheduler/database"
+	"github.com/armadaproject/armada/internal/scheduler/queue"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
 	"github.com/armadaproject/armada/internal/server/permissions"
 	"github.com/armadaproject/armada/pkg/armadaevents"
@@ -53,8 +56,10 @@ type ExecutorApi struct {
 	nodeIdLabelWarnings sync.Map
 	// See scheduling schedulingConfig.
 	priorityClassNameOverride *string
-	clock                     clock.Clock
-	authorizer                auth.ActionAuthorizer
+	// Used to send the labels of each leased job's queue, which the executor may act on, e.g., to isolate the job's network.
+	queueCache queue.QueueCache
+	clock      clock.Clock
+	authorizer auth.ActionAuthorizer
 }
 
 func NewExecutorApi(publisher pulsarutils.Publisher[*armadaevents.EventSequence],
@@ -65,6 +70,7 @@ func NewExecutorApi(publisher pulsarutils.Publisher[*armadaevents.EventSequence]
 	nodeIdLabel string,
 	priorityClassNameOverride *string,
 	priorityClasses map[string]priorityTypes.PriorityClass,
+	queueCache queue.QueueCache,
 	authorizer auth.ActionAuthorizer,
 ) (*ExecutorApi, error) {
 	if len(allowedPriorities) == 0 {
@@ -79,6 +85,7 @@ func NewExecutorApi(publisher pulsarutils.Publisher[*armadaevents.EventSequence]
 		nodeIdLabel:               nodeIdLabel,
 		priorityClassNameOverride: priorityClassNameOverride,
 		priorityClasses:           priorityClasses,
+		queueCache:                queueCache,
 		clock:                     clock.RealClock{},
 		authorizer:                authorizer,
 	}, nil
@@ -118,6 +125,20 @@ func (srv *ExecutorApi) LeaseJobRuns(stream executorapi.ExecutorApi_LeaseJobRuns
 	if err != nil {
 		return err
 	}
+	queueLabels, err := srv.queueLabelsByName(ctx, newRuns)
+	if err != nil {
+		return err
+	}
+	// Release requests the executor has acted on needn't be sent again.
+	if processedReleases := processedNodeQuarantineReleasesFromLeaseRequest(req); len(processedReleases) > 0 {
+		if err := srv.executorRepository.DeleteNodeQuarantineReleases(ctx, req.ExecutorId, processedReleases); err != nil {
+			return err
+		}
+	}
+	nodeQuarantineReleases, err := srv.executorRepository.GetNodeQuarantineReleases(ctx, req.ExecutorId)
+	if err != nil {
+		return err
+	}
 	ctx.Infof(
 		"Executor currently has %d job runs; sending %d cancellations and %d new runs",
 		len(requestRuns), len(runsToCancel), len(newRuns),
@@ -136,6 +157,32 @@ func (srv *ExecutorApi) LeaseJobRuns(stream executorapi.ExecutorApi_LeaseJobRuns
 		}
 	}
 
+	// Send any requests to release nodes from quarantine.
+	// These are sent on every call until the executor reports having acted on them.
+	if len(nodeQuarantineReleases) > 0 {
+		nodeNames := make([]string, 0, len(nodeQuarantineReleases))
+		for nodeName := range nodeQuarantineReleases {
+			nodeNames = append(nodeNames, nodeName)
+		}
+		slices.Sort(nodeNames)
+		releases := make([]*executorapi.NodeQuarantineRelease, 0, len(nodeNames))
+		for _, nodeName := range nodeNames {
+			releases = append(releases, &executorapi.NodeQuarantineRelease{
+				NodeName:    nodeName,
+				RequestedAt: protoutil.ToTimestamp(nodeQuarantineReleases[nodeName]),
+			})
+		}
+		if err := stream.Send(&executorapi.LeaseStreamMessage{
+			Event: &executorapi.LeaseStreamMessage_ReleaseNodeQuarantines{
+				ReleaseNodeQuarantines: &executorapi.ReleaseNodeQuarantines{
+					Releases: releases,
+				},
+			},
+		}); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
 	// Send any scheduled jobs the executor doesn't already have.
 	decompressor := compress.NewZlibDecompressor()
 	for _, lease := range newRuns {
@@ -179,12 +226,13 @@ func (srv *ExecutorApi) LeaseJobRuns(stream executorapi.ExecutorApi_LeaseJobRuns
 		err := stream.Send(&executorapi.LeaseStreamMessage{
 			Event: &executorapi.LeaseStreamMessage_Lease{
 				Lease: &executorapi.JobRunLease{
-					JobRunId: lease.RunID,
-					Queue:    lease.Queue,
-					Jobset:   lease.JobSet,
-					User:     lease.UserID,
-					Groups:   groups,
-					Job:      submitMsg,
+					JobRunId:    lease.RunID,
+					Queue:       lease.Queue,
+					Jobset:      lease.JobSet,
+					User:        lease.UserID,
+					Groups:      groups,
+					Job:         submitMsg,
+					QueueLabels: queueLabels[lease.Queue],
 				},
 			},
 		})
@@ -482,6 +530,45 @@ func runIdsFromLeaseRequest(req *executorapi.LeaseRequest) ([]string, error) {
 	return runIds, nil
 }
 
+// queueLabelsByName returns a map of queue name -> labels for the queues of the given runs.
+// Queues without any runs are left out, so nothing is looked up if there are no runs.
+func (srv *ExecutorApi) queueLabelsByName(ctx *armadacontext.Context, runs []*database.JobRunLease) (map[string]map[string]string, error) {
+	if len(runs) == 0 {
+		return nil, nil
+	}
+	leasedQueues := make(map[string]bool, len(runs))
+	for _, run := range runs {
+		leasedQueues[run.Queue] = true
+	}
+	queues, err := srv.queueCache.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	labelsByName := make(map[string]map[string]string, len(leasedQueues))
+	for _, q := range queues {
+		if leasedQueues[q.Name] {
+			labelsByName[q.Name] = q.Labels
+		}
+	}
+	return labelsByName, nil
+}
+
+// processedNodeQuarantineReleasesFromLeaseRequest returns a map of node name -> time of the latest release request the
+// executor has acted on for that node.
+func processedNodeQuarantineReleasesFromLeaseRequest(req *executorapi.LeaseRequest) map[string]time.Time {
+	releases := make(map[string]time.Time, len(req.ProcessedNodeQuarantineReleases))
+	for _, release := range req.ProcessedNodeQuarantineReleases {
+		if release == nil || release.NodeName == "" || release.RequestedAt == nil {
+			continue
+		}
+		requestedAt := protoutil.ToStdTime(release.RequestedAt)
+		if requestedAt.After(releases[release.NodeName]) {
+			releases[release.NodeName] = requestedAt
+		}
+	}
+	return releases
+}
+
 func unmarshalFromCompressedBytes(bytes []byte, decompressor compress.Decompressor, msg proto.Message) error {
 	decompressedBytes, err := decompressor.Decompress(bytes)
 	if err != nil {
@@ -57,6 +57,8 @@ func TestExecutorApi_LeaseJobRuns(t *testing.T) {
 	runId2 := uuid.NewString()
 	runId3 := uuid.NewString()
 	groups, compressedGroups := groups(t)
+	queueLabels := map[string]string{"network-isolation": "true"}
+	queueCache := &testQueueCache{queues: []*api.Queue{{Name: "test-queue", Labels: queueLabels}}}
 	defaultRequest := &executorapi.LeaseRequest{
 		ExecutorId: "test-executor",
 		Pool:       "test-pool",
@@ -198,12 +200,20 @@ func TestExecutorApi_LeaseJobRuns(t *testing.T) {
 	)
 	submitWithOverlay.JobId = submit.JobId
 
+	requestWithProcessedReleases := proto.Clone(defaultRequest).(*executorapi.LeaseRequest)
+	requestWithProcessedReleases.ProcessedNodeQuarantineReleases = []*executorapi.NodeQuarantineRelease{
+		{NodeName: "node-1", RequestedAt: protoutil.ToTimestamp(testClock.Now().UTC().Add(-2 * time.Minute))},
+		{NodeName: "node-1", RequestedAt: protoutil.ToTimestamp(testClock.Now().UTC().Add(-time.Minute))},
+	}
+
 	tests := map[string]struct {
-		request          *executorapi.LeaseRequest
-		runsToCancel     []string
-		leases           []*database.JobRunLease
-		expectedExecutor *schedulerobjects.Executor
-		expectedMsgs     []*executorapi.LeaseStreamMessage
+		request                           *executorapi.LeaseRequest
+		runsToCancel                      []string
+		leases                            []*database.JobRunLease
+		nodeQuarantineReleases            map[string]time.Time
+		expectedDeletedQuarantineReleases map[string]time.Time
+		expectedExecutor                  *schedulerobjects.Executor
+		expectedMsgs                      []*executorapi.LeaseStreamMessage
 	}{
 		"lease and cancel": {
 			request:          defaultRequest,
@@ -218,12 +228,13 @@ func TestExecutorApi_LeaseJobRuns(t *testing.T) {
 				},
 				{
 					Event: &executorapi.LeaseStreamMessage_Lease{Lease: &executorapi.JobRunLease{
-						JobRunId: defaultLease.RunID,
-						Queue:    defaultLease.Queue,
-						Jobset:   defaultLease.JobSet,
-						User:     defaultLease.UserID,
-						Groups:   groups,
-						Job:      submit,
+						JobRunId:    defaultLease.RunID,
+						Queue:       defaultLease.Queue,
+						Jobset:      defaultLease.JobSet,
+						User:        defaultLease.UserID,
+						Groups:      groups,
+						Job:         submit,
+						QueueLabels: queueLabels,
 					}},
 				},
 				{
@@ -238,12 +249,13 @@ func TestExecutorApi_LeaseJobRuns(t *testing.T) {
 			expectedMsgs: []*executorapi.LeaseStreamMessage{
 				{
 					Event: &executorapi.LeaseStreamMessage_Lease{Lease: &executorapi.JobRunLease{
-						JobRunId: leaseWithoutNode.RunID,
-						Queue:    leaseWithoutNode.Queue,
-						Jobset:   leaseWithoutNode.JobSet,
-						User:     leaseWithoutNode.UserID,
-						Groups:   groups,
-						Job:      submitWithoutNodeSelector,
+						JobRunId:    leaseWithoutNode.RunID,
+						Queue:       leaseWithoutNode.Queue,
+						Jobset:      leaseWithoutNode.JobSet,
+						User:        leaseWithoutNode.UserID,
+						Groups:      groups,
+						Job:         submitWithoutNodeSelector,
+						QueueLabels: queueLabels,
 					}},
 				},
 				{
@@ -258,12 +270,13 @@ func TestExecutorApi_LeaseJobRuns(t *testing.T) {
 			expectedMsgs: []*executorapi.LeaseStreamMessage{
 				{
 					Event: &executorapi.LeaseStreamMessage_Lease{Lease: &executorapi.JobRunLease{
-						JobRunId: leaseWithOverlay.RunID,
-						Queue:    leaseWithOverlay.Queue,
-						Jobset:   leaseWithOverlay.JobSet,
-						User:     leaseWithOverlay.UserID,
-						Groups:   groups,
-						Job:      submitWithOverlay,
+						JobRunId:    leaseWithOverlay.RunID,
+						Queue:       leaseWithOverlay.Queue,
+						Jobset:      leaseWithOverlay.JobSet,
+						User:        leaseWithOverlay.UserID,
+						Groups:      groups,
+						Job:         submitWithOverlay,
+						QueueLabels: queueLabels,
 					}},
 				},
 				{
@@ -278,12 +291,13 @@ func TestExecutorApi_LeaseJobRuns(t *testing.T) {
 			expectedMsgs: []*executorapi.LeaseStreamMessage{
 				{
 					Event: &executorapi.LeaseStreamMessage_Lease{Lease: &executorapi.JobRunLease{
-						JobRunId: preemptibleLease.RunID,
-						Queue:    preemptibleLease.Queue,
-						Jobset:   preemptibleLease.JobSet,
-						User:     preemptibleLease.UserID,
-						Groups:   groups,
-						Job:      preemptibleSubmit,
+						JobRunId:    preemptibleLease.RunID,
+						Queue:       preemptibleLease.Queue,
+						Jobset:      preemptibleLease.JobSet,
+						User:        preemptibleLease.UserID,
+						Groups:      groups,
+						Job:         preemptibleSubmit,
+						QueueLabels: queueLabels,
 					}},
 				},
 				{
@@ -291,6 +305,53 @@ func TestExecutorApi_LeaseJobRuns(t *testing.T) {
 				},
 			},
 		},
+		"release node quarantines": {
+			request: defaultRequest,
+			nodeQuarantineReleases: map[string]time.Time{
+				"node-2": testClock.Now().UTC(),
+				"node-1": testClock.Now().UTC().Add(-time.Minute),
+			},
+			expectedExecutor: defaultExpectedExecutor,
+			expectedMsgs: []*executorapi.LeaseStreamMessage{
+				{
+					Event: &executorapi.LeaseStreamMessage_ReleaseNodeQuarantines{
+						ReleaseNodeQuarantines: &executorapi.ReleaseNodeQuarantines{
+							Releases: []*executorapi.NodeQuarantineRelease{
+								{NodeName: "node-1", RequestedAt: protoutil.ToTimestamp(testClock.Now().UTC().Add(-time.Minute))},
+								{NodeName: "node-2", RequestedAt: protoutil.ToTimestamp(testClock.Now().UTC())},
+							},
+						},
+					},
+				},
+				{
+					Event: &executorapi.LeaseStreamMessage_End{End: &executorapi.EndMarker{}},
+				},
+			},
+		},
+		"delete processed node quarantine releases": {
+			request: requestWithProcessedReleases,
+			nodeQuarantineReleases: map[string]time.Time{
+				"node-2": testClock.Now().UTC(),
+			},
+			expectedDeletedQuarantineReleases: map[string]time.Time{
+				"node-1": testClock.Now().UTC().Add(-time.Minute),
+			},
+			expectedExecutor: defaultExpectedExecutor,
+			expectedMsgs: []*executorapi.LeaseStreamMessage{
+				{
+					Event: &executorapi.LeaseStreamMessage_ReleaseNodeQuarantines{
+						ReleaseNodeQuarantines: &executorapi.ReleaseNodeQuarantines{
+							Releases: []*executorapi.NodeQuarantineRelease{
+								{NodeName: "node-2", RequestedAt: protoutil.ToTimestamp(testClock.Now().UTC())},
+							},
+						},
+					},
+				},
+				{
+					Event: &executorapi.LeaseStreamMessage_End{End: &executorapi.EndMarker{}},
+				},
+			},
+		},
 		"do nothing": {
 			request:          defaultRequest,
 			expectedExecutor: defaultExpectedExecutor,
@@ -323,6 +384,10 @@ func TestExecutorApi_LeaseJobRuns(t *testing.T) {
 			}).Times(1)
 			mockJobRepository.EXPECT().FindInactiveRuns(gomock.Any(), schedulermocks.SliceMatcher{Expected: runIds}).Return(tc.runsToCancel, nil).Times(1)
 			mockJobRepository.EXPECT().FetchJobRunLeases(gomock.Any(), tc.request.ExecutorId, maxJobsPerCall, runIds).Return(tc.leases, nil).Times(1)
+			if len(tc.expectedDeletedQuarantineReleases) > 0 {
+				mockExecutorRepository.EXPECT().DeleteNodeQuarantineReleases(gomock.Any(), tc.request.ExecutorId, tc.expectedDeletedQuarantineReleases).Return(nil).Times(1)
+			}
+			mockExecutorRepository.EXPECT().GetNodeQuarantineReleases(gomock.Any(), tc.request.ExecutorId).Return(tc.nodeQuarantineReleases, nil).Times(1)
 			mockAuthorizer.EXPECT().AuthorizeAction(gomock.Any(), permission.Permission(permissions.ExecuteJobs)).Return(nil).Times(1)
 
 			// capture all sent messages
@@ -341,6 +406,7 @@ func TestExecutorApi_LeaseJobRuns(t *testing.T) {
 				"kubernetes.io/hostname",
 				nil,
 				priorityClasses,
+				queueCache,
 				mockAuthorizer,
 			)
 			require.NoError(t, err)
@@ -398,6 +464,7 @@ func TestExecutorApi_LeaseJobRuns_Unauthorised(t *testing.T) {
 		"kubernetes.io/hostname",
 		nil,
 		priorityClasses,
+		&testQueueCache{},
 		mockAuthorizer,
 	)
 
@@ -412,6 +479,35 @@ func TestExecutorApi_LeaseJobRuns_Unauthorised(t *testing.T) {
 	assert.Equal(t, codes.PermissionDenied, statusErr.Code())
 }
 
+func TestExecutorApi_QueueLabelsByName(t *testing.T) {
+	tests := map[string]struct {
+		runs           []*database.JobRunLease
+		expectedLabels map[string]map[string]string
+	}{
+		"no runs": {
+			runs:           nil,
+			expectedLabels: nil,
+		},
+		"only the queues of the runs": {
+			runs:           []*database.JobRunLease{{Queue: "queue-a"}, {Queue: "queue-a"}},
+			expectedLabels: map[string]map[string]string{"queue-a": {"team": "a"}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := &ExecutorApi{
+				queueCache: &testQueueCache{queues: []*api.Queue{
+					{Name: "queue-a", Labels: map[string]string{"team": "a"}},
+					{Name: "queue-b", Labels: map[string]string{"team": "b"}},
+				}},
+			}
+			labels, err := srv.queueLabelsByName(armadacontext.Background(), tc.runs)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedLabels, labels)
+		})
+	}
+}
+
 func TestAddNodeSelector(t *testing.T) {
 	withNodeSelector := &armadaevents.PodSpecWithAvoidList{
 		PodSpec: &v1.PodSpec{
@@ -618,6 +714,7 @@ func TestExecutorApi_Publish(t *testing.T) {
 				"kubernetes.io/hostname",
 				nil,
 				priorityClasses,
+				&testQueueCache{},
 				mockAuthorizer,
 			)
 
@@ -665,6 +762,7 @@ func TestExecutorApi_Publish_Unauthorised(t *testing.T) {
 		"kubernetes.io/hostname",
 		nil,
 		priorityClasses,
+		&testQueueCache{},
 		mockAuthorizer,
 	)
 
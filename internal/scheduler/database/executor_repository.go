@@ -23,6 +23,12 @@ type ExecutorRepository interface {
 	GetLastUpdateTimes(ctx *armadacontext.Context) (map[string]time.Time, error)
 	// StoreExecutor persists the latest executor state
 	StoreExecutor(ctx *armadacontext.Context, executor *schedulerobjects.Executor) error
+	// GetNodeQuarantineReleases returns a map of node name -> time a release from quarantine was last requested
+	// for the nodes of the given executor
+	GetNodeQuarantineReleases(ctx *armadacontext.Context, executorId string) (map[string]time.Time, error)
+	// DeleteNodeQuarantineReleases deletes the release requests for the given nodes of the given executor that were
+	// made at or before the given times. Later requests are kept.
+	DeleteNodeQuarantineReleases(ctx *armadacontext.Context, executorId string, releases map[string]time.Time) error
 }
 
 // PostgresExecutorRepository is an implementation of ExecutorRepository that stores its state in postgres
@@ -118,6 +124,41 @@ func (r *PostgresExecutorRepository) GetExecutorSettings(ctx *armadacontext.Cont
 	return executorSettings, nil
 }
 
+// GetNodeQuarantineReleases returns a map of node name -> time a release from quarantine was last requested
+// for the nodes of the given executor
+func (r *PostgresExecutorRepository) GetNodeQuarantineReleases(ctx *armadacontext.Context, executorId string) (map[string]time.Time, error) {
+	queries := New(r.db)
+	rows, err := queries.SelectNodeQuarantineReleasesByExecutor(ctx, executorId)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	releases := make(map[string]time.Time, len(rows))
+	for _, row := range rows {
+		// pgx defaults to local time so we convert to utc here
+		releases[row.NodeName] = row.RequestedAt.UTC()
+	}
+	return releases, nil
+}
+
+// DeleteNodeQuarantineReleases deletes the release requests for the given nodes of the given executor that were
+// made at or before the given times. Later requests are kept.
+func (r *PostgresExecutorRepository) DeleteNodeQuarantineReleases(ctx *armadacontext.Context, executorId string, releases map[string]time.Time) error {
+	if len(releases) == 0 {
+		return nil
+	}
+	params := DeleteNodeQuarantineReleasesParams{
+		NodeNames:    make([]string, 0, len(releases)),
+		RequestedAts: make([]time.Time, 0, len(releases)),
+		ExecutorID:   executorId,
+	}
+	for nodeName, requestedAt := range releases {
+		params.NodeNames = append(params.NodeNames, nodeName)
+		params.RequestedAts = append(params.RequestedAts, requestedAt)
+	}
+	queries := New(r.db)
+	return errors.WithStack(queries.DeleteNodeQuarantineReleases(ctx, params))
+}
+
 func decompressAndMarshall(b []byte, decompressor compress.Decompressor, msg proto.Message) error {
 	decompressed, err := decompressor.Decompress(b)
 	if err != nil {
@@ -132,6 +132,36 @@ func TestExecutorRepository_GetLastUpdateTimes(t *testing.T) {
 	}
 }
 
+func TestExecutorRepository_DeleteNodeQuarantineReleases(t *testing.T) {
+	t1 := time.Now().UTC().Round(1 * time.Microsecond) // postgres only stores times with micro precision
+	t2 := t1.Add(time.Minute)
+	err := WithTestDb(func(queries *Queries, db *pgxpool.Pool) error {
+		ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Second)
+		defer cancel()
+		repo := NewPostgresExecutorRepository(db)
+		for _, params := range []UpsertNodeQuarantineReleaseParams{
+			{ExecutorID: "test-executor-1", NodeName: "node-1", RequestedAt: t1},
+			{ExecutorID: "test-executor-1", NodeName: "node-2", RequestedAt: t2},
+			{ExecutorID: "test-executor-2", NodeName: "node-1", RequestedAt: t1},
+		} {
+			require.NoError(t, queries.UpsertNodeQuarantineRelease(ctx, params))
+		}
+
+		// node-2 was requested again after the request the executor acted on, so it's kept.
+		err := repo.DeleteNodeQuarantineReleases(ctx, "test-executor-1", map[string]time.Time{"node-1": t1, "node-2": t1})
+		require.NoError(t, err)
+
+		releases, err := repo.GetNodeQuarantineReleases(ctx, "test-executor-1")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]time.Time{"node-2": t2}, releases)
+		releases, err = repo.GetNodeQuarantineReleases(ctx, "test-executor-2")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]time.Time{"node-1": t1}, releases)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
 func withExecutorRepository(action func(repository *PostgresExecutorRepository) error) error {
 	return WithTestDb(func(_ *Queries, db *pgxpool.Pool) error {
 		repo := NewPostgresExecutorRepository(db)
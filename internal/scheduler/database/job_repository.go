@@ -134,6 +134,7 @@ func (r *PostgresJobRepository) FetchInitialJobs(ctx *armadacontext.Context) ([]
 				Serial:                  row.Serial,
 				Pools:                   row.Pools,
 				PriceBand:               row.PriceBand,
+				Suspended:               row.Suspended,
 			}
 		}
 
@@ -281,6 +282,7 @@ func (r *PostgresJobRepository) FetchJobUpdates(ctx *armadacontext.Context, jobS
 				Serial:                  row.Serial,
 				Pools:                   row.Pools,
 				PriceBand:               row.PriceBand,
+				Suspended:               row.Suspended,
 			}
 		}
 
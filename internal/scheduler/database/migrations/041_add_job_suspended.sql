@@ -0,0 +1 @@
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS suspended boolean NOT NULL DEFAULT false;
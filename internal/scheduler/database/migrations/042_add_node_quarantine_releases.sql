@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS node_quarantine_releases (
+  executor_id text NOT NULL,
+  node_name text NOT NULL,
+  requested_at timestamptz NOT NULL,
+  PRIMARY KEY (executor_id, node_name)
+);
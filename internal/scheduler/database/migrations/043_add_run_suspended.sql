@@ -0,0 +1 @@
+ALTER TABLE runs ADD COLUMN IF NOT EXISTS suspended boolean NOT NULL DEFAULT false;
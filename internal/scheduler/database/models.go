@@ -49,6 +49,7 @@ type Job struct {
 	PriceBand               int32     `db:"price_band"`
 	Terminated              *bool     `db:"terminated"`
 	CancelReason            *string   `db:"cancel_reason"`
+	Suspended               bool      `db:"suspended"`
 }
 
 type JobMetadatum struct {
@@ -69,6 +70,12 @@ type Marker struct {
 	Created     time.Time `db:"created"`
 }
 
+type NodeQuarantineRelease struct {
+	ExecutorID  string    `db:"executor_id"`
+	NodeName    string    `db:"node_name"`
+	RequestedAt time.Time `db:"requested_at"`
+}
+
 type Run struct {
 	RunID                  string     `db:"run_id"`
 	JobID                  string     `db:"job_id"`
@@ -98,4 +105,5 @@ type Run struct {
 	Pool                   string     `db:"pool"`
 	Terminated             bool       `db:"terminated"`
 	PreemptReason          *string    `db:"preempt_reason"`
+	Suspended              bool       `db:"suspended"`
 }
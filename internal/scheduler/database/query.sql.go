@@ -32,6 +32,25 @@ func (q *Queries) DeleteExecutorSettings(ctx context.Context, executorID string)
 	return err
 }
 
+const deleteNodeQuarantineReleases = `-- name: DeleteNodeQuarantineReleases :exec
+DELETE FROM node_quarantine_releases r
+USING unnest($1::text[], $2::timestamptz[]) AS processed(node_name, requested_at)
+WHERE r.executor_id = $3::text
+  AND r.node_name = processed.node_name
+  AND r.requested_at <= processed.requested_at
+`
+
+type DeleteNodeQuarantineReleasesParams struct {
+	NodeNames    []string    `db:"node_names"`
+	RequestedAts []time.Time `db:"requested_ats"`
+	ExecutorID   string      `db:"executor_id"`
+}
+
+func (q *Queries) DeleteNodeQuarantineReleases(ctx context.Context, arg DeleteNodeQuarantineReleasesParams) error {
+	_, err := q.db.Exec(ctx, deleteNodeQuarantineReleases, arg.NodeNames, arg.RequestedAts, arg.ExecutorID)
+	return err
+}
+
 const deleteOldMarkers = `-- name: DeleteOldMarkers :exec
 DELETE FROM markers WHERE created < $1::timestamptz
 `
@@ -420,7 +439,7 @@ func (q *Queries) SelectExecutorUpdateTimes(ctx context.Context) ([]SelectExecut
 }
 
 const selectInitialJobs = `-- name: SelectInitialJobs :many
-SELECT job_id, job_set, queue, priority, submitted, queued, queued_version, validated, cancel_requested, cancel_user, cancel_reason, cancel_by_jobset_requested, cancelled, succeeded, failed, scheduling_info, scheduling_info_version, pools, price_band, serial FROM jobs WHERE serial > $1 AND terminated = false ORDER BY serial LIMIT $2
+SELECT job_id, job_set, queue, priority, submitted, queued, queued_version, validated, cancel_requested, cancel_user, cancel_reason, cancel_by_jobset_requested, cancelled, succeeded, failed, scheduling_info, scheduling_info_version, pools, price_band, suspended, serial FROM jobs WHERE serial > $1 AND terminated = false ORDER BY serial LIMIT $2
 `
 
 type SelectInitialJobsParams struct {
@@ -448,6 +467,7 @@ type SelectInitialJobsRow struct {
 	SchedulingInfoVersion   int32    `db:"scheduling_info_version"`
 	Pools                   []string `db:"pools"`
 	PriceBand               int32    `db:"price_band"`
+	Suspended               bool     `db:"suspended"`
 	Serial                  int64    `db:"serial"`
 }
 
@@ -480,6 +500,7 @@ func (q *Queries) SelectInitialJobs(ctx context.Context, arg SelectInitialJobsPa
 			&i.SchedulingInfoVersion,
 			&i.Pools,
 			&i.PriceBand,
+			&i.Suspended,
 			&i.Serial,
 		); err != nil {
 			return nil, err
@@ -493,7 +514,7 @@ func (q *Queries) SelectInitialJobs(ctx context.Context, arg SelectInitialJobsPa
 }
 
 const selectInitialRuns = `-- name: SelectInitialRuns :many
-SELECT run_id, job_id, created, job_set, executor, node, cancelled, running, succeeded, failed, returned, run_attempted, serial, last_modified, leased_timestamp, pending_timestamp, running_timestamp, terminated_timestamp, scheduled_at_priority, preempted, pending, preempted_timestamp, pod_requirements_overlay, preempt_requested, queue, pool, terminated, preempt_reason FROM runs WHERE serial > $1 AND job_id = ANY($3::text[]) ORDER BY serial LIMIT $2
+SELECT run_id, job_id, created, job_set, executor, node, cancelled, running, succeeded, failed, returned, run_attempted, serial, last_modified, leased_timestamp, pending_timestamp, running_timestamp, terminated_timestamp, scheduled_at_priority, preempted, pending, preempted_timestamp, pod_requirements_overlay, preempt_requested, queue, pool, terminated, preempt_reason, suspended FROM runs WHERE serial > $1 AND job_id = ANY($3::text[]) ORDER BY serial LIMIT $2
 `
 
 type SelectInitialRunsParams struct {
@@ -540,6 +561,7 @@ func (q *Queries) SelectInitialRuns(ctx context.Context, arg SelectInitialRunsPa
 			&i.Pool,
 			&i.Terminated,
 			&i.PreemptReason,
+			&i.Suspended,
 		); err != nil {
 			return nil, err
 		}
@@ -576,7 +598,7 @@ func (q *Queries) SelectJobMetadata(ctx context.Context, jobIds []string) ([]Job
 }
 
 const selectJobsByExecutorAndQueues = `-- name: SelectJobsByExecutorAndQueues :many
-SELECT j.job_id, j.job_set, j.queue, j.user_id, j.submitted, j.priority, j.queued, j.queued_version, j.cancel_requested, j.cancelled, j.cancel_by_jobset_requested, j.succeeded, j.failed, j.scheduling_info, j.scheduling_info_version, j.serial, j.last_modified, j.validated, j.pools, j.bid_price, j.cancel_user, j.price_band, j.terminated, j.cancel_reason
+SELECT j.job_id, j.job_set, j.queue, j.user_id, j.submitted, j.priority, j.queued, j.queued_version, j.cancel_requested, j.cancelled, j.cancel_by_jobset_requested, j.succeeded, j.failed, j.scheduling_info, j.scheduling_info_version, j.serial, j.last_modified, j.validated, j.pools, j.bid_price, j.cancel_user, j.price_band, j.terminated, j.cancel_reason, j.suspended
 FROM runs jr
        JOIN jobs j
             ON jr.job_id = j.job_id
@@ -627,6 +649,7 @@ func (q *Queries) SelectJobsByExecutorAndQueues(ctx context.Context, arg SelectJ
 			&i.PriceBand,
 			&i.Terminated,
 			&i.CancelReason,
+			&i.Suspended,
 		); err != nil {
 			return nil, err
 		}
@@ -639,7 +662,7 @@ func (q *Queries) SelectJobsByExecutorAndQueues(ctx context.Context, arg SelectJ
 }
 
 const selectJobsByNodeAndExecutorAndQueues = `-- name: SelectJobsByNodeAndExecutorAndQueues :many
-SELECT j.job_id, j.job_set, j.queue, j.user_id, j.submitted, j.priority, j.queued, j.queued_version, j.cancel_requested, j.cancelled, j.cancel_by_jobset_requested, j.succeeded, j.failed, j.scheduling_info, j.scheduling_info_version, j.serial, j.last_modified, j.validated, j.pools, j.bid_price, j.cancel_user, j.price_band, j.terminated, j.cancel_reason
+SELECT j.job_id, j.job_set, j.queue, j.user_id, j.submitted, j.priority, j.queued, j.queued_version, j.cancel_requested, j.cancelled, j.cancel_by_jobset_requested, j.succeeded, j.failed, j.scheduling_info, j.scheduling_info_version, j.serial, j.last_modified, j.validated, j.pools, j.bid_price, j.cancel_user, j.price_band, j.terminated, j.cancel_reason, j.suspended
 FROM runs jr
         JOIN jobs j
              ON jr.job_id = j.job_id
@@ -690,6 +713,7 @@ func (q *Queries) SelectJobsByNodeAndExecutorAndQueues(ctx context.Context, arg
 			&i.PriceBand,
 			&i.Terminated,
 			&i.CancelReason,
+			&i.Suspended,
 		); err != nil {
 			return nil, err
 		}
@@ -724,7 +748,7 @@ func (q *Queries) SelectLatestJobSerial(ctx context.Context) (int64, error) {
 }
 
 const selectLeasedJobsByQueue = `-- name: SelectLeasedJobsByQueue :many
-SELECT j.job_id, j.job_set, j.queue, j.user_id, j.submitted, j.priority, j.queued, j.queued_version, j.cancel_requested, j.cancelled, j.cancel_by_jobset_requested, j.succeeded, j.failed, j.scheduling_info, j.scheduling_info_version, j.serial, j.last_modified, j.validated, j.pools, j.bid_price, j.cancel_user, j.price_band, j.terminated, j.cancel_reason
+SELECT j.job_id, j.job_set, j.queue, j.user_id, j.submitted, j.priority, j.queued, j.queued_version, j.cancel_requested, j.cancelled, j.cancel_by_jobset_requested, j.succeeded, j.failed, j.scheduling_info, j.scheduling_info_version, j.serial, j.last_modified, j.validated, j.pools, j.bid_price, j.cancel_user, j.price_band, j.terminated, j.cancel_reason, j.suspended
 FROM runs jr
        JOIN jobs j
             ON jr.job_id = j.job_id
@@ -775,6 +799,7 @@ func (q *Queries) SelectLeasedJobsByQueue(ctx context.Context, arg SelectLeasedJ
 			&i.PriceBand,
 			&i.Terminated,
 			&i.CancelReason,
+			&i.Suspended,
 		); err != nil {
 			return nil, err
 		}
@@ -809,7 +834,7 @@ func (q *Queries) SelectMaxRunSerial(ctx context.Context) (int64, error) {
 }
 
 const selectNewJobs = `-- name: SelectNewJobs :many
-SELECT job_id, job_set, queue, user_id, submitted, priority, queued, queued_version, cancel_requested, cancelled, cancel_by_jobset_requested, succeeded, failed, scheduling_info, scheduling_info_version, serial, last_modified, validated, pools, bid_price, cancel_user, price_band, terminated, cancel_reason FROM jobs WHERE serial > $1 ORDER BY serial LIMIT $2
+SELECT job_id, job_set, queue, user_id, submitted, priority, queued, queued_version, cancel_requested, cancelled, cancel_by_jobset_requested, succeeded, failed, scheduling_info, scheduling_info_version, serial, last_modified, validated, pools, bid_price, cancel_user, price_band, terminated, cancel_reason, suspended FROM jobs WHERE serial > $1 ORDER BY serial LIMIT $2
 `
 
 type SelectNewJobsParams struct {
@@ -851,6 +876,7 @@ func (q *Queries) SelectNewJobs(ctx context.Context, arg SelectNewJobsParams) ([
 			&i.PriceBand,
 			&i.Terminated,
 			&i.CancelReason,
+			&i.Suspended,
 		); err != nil {
 			return nil, err
 		}
@@ -863,7 +889,7 @@ func (q *Queries) SelectNewJobs(ctx context.Context, arg SelectNewJobsParams) ([
 }
 
 const selectNewRuns = `-- name: SelectNewRuns :many
-SELECT run_id, job_id, created, job_set, executor, node, cancelled, running, succeeded, failed, returned, run_attempted, serial, last_modified, leased_timestamp, pending_timestamp, running_timestamp, terminated_timestamp, scheduled_at_priority, preempted, pending, preempted_timestamp, pod_requirements_overlay, preempt_requested, queue, pool, terminated, preempt_reason FROM runs WHERE serial > $1 ORDER BY serial LIMIT $2
+SELECT run_id, job_id, created, job_set, executor, node, cancelled, running, succeeded, failed, returned, run_attempted, serial, last_modified, leased_timestamp, pending_timestamp, running_timestamp, terminated_timestamp, scheduled_at_priority, preempted, pending, preempted_timestamp, pod_requirements_overlay, preempt_requested, queue, pool, terminated, preempt_reason, suspended FROM runs WHERE serial > $1 ORDER BY serial LIMIT $2
 `
 
 type SelectNewRunsParams struct {
@@ -909,6 +935,7 @@ func (q *Queries) SelectNewRuns(ctx context.Context, arg SelectNewRunsParams) ([
 			&i.Pool,
 			&i.Terminated,
 			&i.PreemptReason,
+			&i.Suspended,
 		); err != nil {
 			return nil, err
 		}
@@ -921,7 +948,7 @@ func (q *Queries) SelectNewRuns(ctx context.Context, arg SelectNewRunsParams) ([
 }
 
 const selectNewRunsForJobs = `-- name: SelectNewRunsForJobs :many
-SELECT run_id, job_id, created, job_set, executor, node, cancelled, running, succeeded, failed, returned, run_attempted, serial, last_modified, leased_timestamp, pending_timestamp, running_timestamp, terminated_timestamp, scheduled_at_priority, preempted, pending, preempted_timestamp, pod_requirements_overlay, preempt_requested, queue, pool, terminated, preempt_reason FROM runs WHERE serial > $1 AND job_id = ANY($2::text[]) ORDER BY serial
+SELECT run_id, job_id, created, job_set, executor, node, cancelled, running, succeeded, failed, returned, run_attempted, serial, last_modified, leased_timestamp, pending_timestamp, running_timestamp, terminated_timestamp, scheduled_at_priority, preempted, pending, preempted_timestamp, pod_requirements_overlay, preempt_requested, queue, pool, terminated, preempt_reason, suspended FROM runs WHERE serial > $1 AND job_id = ANY($2::text[]) ORDER BY serial
 `
 
 type SelectNewRunsForJobsParams struct {
@@ -967,6 +994,7 @@ func (q *Queries) SelectNewRunsForJobs(ctx context.Context, arg SelectNewRunsFor
 			&i.Pool,
 			&i.Terminated,
 			&i.PreemptReason,
+			&i.Suspended,
 		); err != nil {
 			return nil, err
 		}
@@ -978,8 +1006,37 @@ func (q *Queries) SelectNewRunsForJobs(ctx context.Context, arg SelectNewRunsFor
 	return items, nil
 }
 
+const selectNodeQuarantineReleasesByExecutor = `-- name: SelectNodeQuarantineReleasesByExecutor :many
+SELECT node_name, requested_at FROM node_quarantine_releases WHERE executor_id = $1::text
+`
+
+type SelectNodeQuarantineReleasesByExecutorRow struct {
+	NodeName    string    `db:"node_name"`
+	RequestedAt time.Time `db:"requested_at"`
+}
+
+func (q *Queries) SelectNodeQuarantineReleasesByExecutor(ctx context.Context, executorID string) ([]SelectNodeQuarantineReleasesByExecutorRow, error) {
+	rows, err := q.db.Query(ctx, selectNodeQuarantineReleasesByExecutor, executorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SelectNodeQuarantineReleasesByExecutorRow
+	for rows.Next() {
+		var i SelectNodeQuarantineReleasesByExecutorRow
+		if err := rows.Scan(&i.NodeName, &i.RequestedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const selectPendingJobsByQueue = `-- name: SelectPendingJobsByQueue :many
-SELECT j.job_id, j.job_set, j.queue, j.user_id, j.submitted, j.priority, j.queued, j.queued_version, j.cancel_requested, j.cancelled, j.cancel_by_jobset_requested, j.succeeded, j.failed, j.scheduling_info, j.scheduling_info_version, j.serial, j.last_modified, j.validated, j.pools, j.bid_price, j.cancel_user, j.price_band, j.terminated, j.cancel_reason
+SELECT j.job_id, j.job_set, j.queue, j.user_id, j.submitted, j.priority, j.queued, j.queued_version, j.cancel_requested, j.cancelled, j.cancel_by_jobset_requested, j.succeeded, j.failed, j.scheduling_info, j.scheduling_info_version, j.serial, j.last_modified, j.validated, j.pools, j.bid_price, j.cancel_user, j.price_band, j.terminated, j.cancel_reason, j.suspended
 FROM runs jr
        JOIN jobs j
             ON jr.job_id = j.job_id
@@ -1030,6 +1087,7 @@ func (q *Queries) SelectPendingJobsByQueue(ctx context.Context, arg SelectPendin
 			&i.PriceBand,
 			&i.Terminated,
 			&i.CancelReason,
+			&i.Suspended,
 		); err != nil {
 			return nil, err
 		}
@@ -1042,7 +1100,7 @@ func (q *Queries) SelectPendingJobsByQueue(ctx context.Context, arg SelectPendin
 }
 
 const selectQueuedJobsByQueue = `-- name: SelectQueuedJobsByQueue :many
-SELECT j.job_id, j.job_set, j.queue, j.user_id, j.submitted, j.priority, j.queued, j.queued_version, j.cancel_requested, j.cancelled, j.cancel_by_jobset_requested, j.succeeded, j.failed, j.scheduling_info, j.scheduling_info_version, j.serial, j.last_modified, j.validated, j.pools, j.bid_price, j.cancel_user, j.price_band, j.terminated, j.cancel_reason
+SELECT j.job_id, j.job_set, j.queue, j.user_id, j.submitted, j.priority, j.queued, j.queued_version, j.cancel_requested, j.cancelled, j.cancel_by_jobset_requested, j.succeeded, j.failed, j.scheduling_info, j.scheduling_info_version, j.serial, j.last_modified, j.validated, j.pools, j.bid_price, j.cancel_user, j.price_band, j.terminated, j.cancel_reason, j.suspended
 FROM jobs j
 WHERE j.queue = ANY($1::text[])
   AND j.queued = true
@@ -1088,6 +1146,7 @@ func (q *Queries) SelectQueuedJobsByQueue(ctx context.Context, arg SelectQueuedJ
 			&i.PriceBand,
 			&i.Terminated,
 			&i.CancelReason,
+			&i.Suspended,
 		); err != nil {
 			return nil, err
 		}
@@ -1125,7 +1184,7 @@ func (q *Queries) SelectRunErrorsById(ctx context.Context, runIds []string) ([]J
 }
 
 const selectRunningJobsByQueue = `-- name: SelectRunningJobsByQueue :many
-SELECT j.job_id, j.job_set, j.queue, j.user_id, j.submitted, j.priority, j.queued, j.queued_version, j.cancel_requested, j.cancelled, j.cancel_by_jobset_requested, j.succeeded, j.failed, j.scheduling_info, j.scheduling_info_version, j.serial, j.last_modified, j.validated, j.pools, j.bid_price, j.cancel_user, j.price_band, j.terminated, j.cancel_reason
+SELECT j.job_id, j.job_set, j.queue, j.user_id, j.submitted, j.priority, j.queued, j.queued_version, j.cancel_requested, j.cancelled, j.cancel_by_jobset_requested, j.succeeded, j.failed, j.scheduling_info, j.scheduling_info_version, j.serial, j.last_modified, j.validated, j.pools, j.bid_price, j.cancel_user, j.price_band, j.terminated, j.cancel_reason, j.suspended
 FROM runs jr
        JOIN jobs j
             ON jr.job_id = j.job_id
@@ -1176,6 +1235,7 @@ func (q *Queries) SelectRunningJobsByQueue(ctx context.Context, arg SelectRunnin
 			&i.PriceBand,
 			&i.Terminated,
 			&i.CancelReason,
+			&i.Suspended,
 		); err != nil {
 			return nil, err
 		}
@@ -1188,7 +1248,7 @@ func (q *Queries) SelectRunningJobsByQueue(ctx context.Context, arg SelectRunnin
 }
 
 const selectUpdatedJobs = `-- name: SelectUpdatedJobs :many
-SELECT job_id, job_set, queue, priority, submitted, queued, queued_version, validated, cancel_requested, cancel_user, cancel_reason, cancel_by_jobset_requested, cancelled, succeeded, failed, scheduling_info, scheduling_info_version, pools, price_band, serial FROM jobs WHERE serial > $1 ORDER BY serial LIMIT $2
+SELECT job_id, job_set, queue, priority, submitted, queued, queued_version, validated, cancel_requested, cancel_user, cancel_reason, cancel_by_jobset_requested, cancelled, succeeded, failed, scheduling_info, scheduling_info_version, pools, price_band, suspended, serial FROM jobs WHERE serial > $1 ORDER BY serial LIMIT $2
 `
 
 type SelectUpdatedJobsParams struct {
@@ -1216,6 +1276,7 @@ type SelectUpdatedJobsRow struct {
 	SchedulingInfoVersion   int32    `db:"scheduling_info_version"`
 	Pools                   []string `db:"pools"`
 	PriceBand               int32    `db:"price_band"`
+	Suspended               bool     `db:"suspended"`
 	Serial                  int64    `db:"serial"`
 }
 
@@ -1248,6 +1309,7 @@ func (q *Queries) SelectUpdatedJobs(ctx context.Context, arg SelectUpdatedJobsPa
 			&i.SchedulingInfoVersion,
 			&i.Pools,
 			&i.PriceBand,
+			&i.Suspended,
 			&i.Serial,
 		); err != nil {
 			return nil, err
@@ -1352,6 +1414,20 @@ func (q *Queries) UpdateJobPriorityByJobSet(ctx context.Context, arg UpdateJobPr
 	return err
 }
 
+const updateJobSuspendedById = `-- name: UpdateJobSuspendedById :exec
+UPDATE jobs SET suspended = $1 WHERE job_id = ANY($2::text[]) and terminated = false
+`
+
+type UpdateJobSuspendedByIdParams struct {
+	Suspended bool     `db:"suspended"`
+	JobIds    []string `db:"job_ids"`
+}
+
+func (q *Queries) UpdateJobSuspendedById(ctx context.Context, arg UpdateJobSuspendedByIdParams) error {
+	_, err := q.db.Exec(ctx, updateJobSuspendedById, arg.Suspended, arg.JobIds)
+	return err
+}
+
 const upsertExecutor = `-- name: UpsertExecutor :exec
 INSERT INTO executors (executor_id, last_request, last_updated)
 VALUES($1::text, $2::bytea, $3::timestamptz)
@@ -1398,3 +1474,21 @@ func (q *Queries) UpsertExecutorSettings(ctx context.Context, arg UpsertExecutor
 	)
 	return err
 }
+
+const upsertNodeQuarantineRelease = `-- name: UpsertNodeQuarantineRelease :exec
+INSERT INTO node_quarantine_releases (executor_id, node_name, requested_at)
+VALUES ($1::text, $2::text, $3::timestamptz)
+ON CONFLICT (executor_id, node_name) DO UPDATE
+  SET requested_at = excluded.requested_at
+`
+
+type UpsertNodeQuarantineReleaseParams struct {
+	ExecutorID  string    `db:"executor_id"`
+	NodeName    string    `db:"node_name"`
+	RequestedAt time.Time `db:"requested_at"`
+}
+
+func (q *Queries) UpsertNodeQuarantineRelease(ctx context.Context, arg UpsertNodeQuarantineReleaseParams) error {
+	_, err := q.db.Exec(ctx, upsertNodeQuarantineRelease, arg.ExecutorID, arg.NodeName, arg.RequestedAt)
+	return err
+}
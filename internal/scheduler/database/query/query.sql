@@ -14,10 +14,10 @@ SELECT serial FROM jobs ORDER BY serial DESC LIMIT 1;
 SELECT serial FROM runs ORDER BY serial DESC LIMIT 1;
 
 -- name: SelectInitialJobs :many
-SELECT job_id, job_set, queue, priority, submitted, queued, queued_version, validated, cancel_requested, cancel_user, cancel_reason, cancel_by_jobset_requested, cancelled, succeeded, failed, scheduling_info, scheduling_info_version, pools, price_band, serial FROM jobs WHERE serial > $1 AND terminated = false ORDER BY serial LIMIT $2;
+SELECT job_id, job_set, queue, priority, submitted, queued, queued_version, validated, cancel_requested, cancel_user, cancel_reason, cancel_by_jobset_requested, cancelled, succeeded, failed, scheduling_info, scheduling_info_version, pools, price_band, suspended, serial FROM jobs WHERE serial > $1 AND terminated = false ORDER BY serial LIMIT $2;
 
 -- name: SelectUpdatedJobs :many
-SELECT job_id, job_set, queue, priority, submitted, queued, queued_version, validated, cancel_requested, cancel_user, cancel_reason, cancel_by_jobset_requested, cancelled, succeeded, failed, scheduling_info, scheduling_info_version, pools, price_band, serial FROM jobs WHERE serial > $1 ORDER BY serial LIMIT $2;
+SELECT job_id, job_set, queue, priority, submitted, queued, queued_version, validated, cancel_requested, cancel_user, cancel_reason, cancel_by_jobset_requested, cancelled, succeeded, failed, scheduling_info, scheduling_info_version, pools, price_band, suspended, serial FROM jobs WHERE serial > $1 ORDER BY serial LIMIT $2;
 
 -- name: UpdateJobPriorityByJobSet :exec
 UPDATE jobs SET priority = $1 WHERE job_set = $2 and queue = $3 and terminated = false;
@@ -40,6 +40,9 @@ UPDATE jobs SET cancelled = true WHERE job_id = ANY(sqlc.arg(job_ids)::text[]);
 -- name: MarkJobsFailedById :exec
 UPDATE jobs SET failed = true WHERE job_id = ANY(sqlc.arg(job_ids)::text[]);
 
+-- name: UpdateJobSuspendedById :exec
+UPDATE jobs SET suspended = $1 WHERE job_id = ANY(sqlc.arg(job_ids)::text[]) and terminated = false;
+
 -- name: UpdateJobPriorityById :exec
 UPDATE jobs SET priority = $1 WHERE queue = sqlc.arg(queue) and job_set = sqlc.arg(job_set) and job_id = ANY(sqlc.arg(job_ids)::text[]) and terminated = false;
 
@@ -134,6 +137,22 @@ DELETE FROM executor_settings WHERE executor_id = @executor_id::text;
 -- name: SelectAllExecutorSettings :many
 SELECT executor_id, cordoned, cordon_reason, set_by_user, set_at_time FROM executor_settings;
 
+-- name: UpsertNodeQuarantineRelease :exec
+INSERT INTO node_quarantine_releases (executor_id, node_name, requested_at)
+VALUES (@executor_id::text, @node_name::text, @requested_at::timestamptz)
+ON CONFLICT (executor_id, node_name) DO UPDATE
+  SET requested_at = excluded.requested_at;
+
+-- name: SelectNodeQuarantineReleasesByExecutor :many
+SELECT node_name, requested_at FROM node_quarantine_releases WHERE executor_id = @executor_id::text;
+
+-- name: DeleteNodeQuarantineReleases :exec
+DELETE FROM node_quarantine_releases r
+USING unnest(@node_names::text[], @requested_ats::timestamptz[]) AS processed(node_name, requested_at)
+WHERE r.executor_id = @executor_id::text
+  AND r.node_name = processed.node_name
+  AND r.requested_at <= processed.requested_at;
+
 -- name: SelectLatestJobSerial :one
 SELECT serial FROM jobs ORDER BY serial DESC LIMIT 1;
 
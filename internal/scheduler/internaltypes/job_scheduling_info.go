@@ -23,6 +23,11 @@ type JobSchedulingInfo struct {
 	// ResourceMutations records the job's total resource growth from retry
 	// mutations. Nil means no growth.
 	ResourceMutations *RetryResourceMutations
+	// Labels and SubmittedAnnotations are the labels and annotations the job was submitted with.
+	// Unlike PodRequirements.Annotations, which only holds annotations relevant for scheduling,
+	// these are only used to select jobs for bulk operations.
+	Labels               map[string]string
+	SubmittedAnnotations map[string]string
 }
 
 // RetryResourceMutations mirrors schedulerobjects.RetryResourceMutations,
@@ -43,13 +48,15 @@ func (m *RetryResourceMutations) DeepCopy() *RetryResourceMutations {
 
 func (j *JobSchedulingInfo) DeepCopy() *JobSchedulingInfo {
 	return &JobSchedulingInfo{
-		Lifetime:          j.Lifetime,
-		PriorityClass:     j.PriorityClass,
-		SubmitTime:        j.SubmitTime,
-		Priority:          j.Priority,
-		PodRequirements:   j.PodRequirements.DeepCopy(),
-		Version:           j.Version,
-		ResourceMutations: j.ResourceMutations.DeepCopy(),
+		Lifetime:             j.Lifetime,
+		PriorityClass:        j.PriorityClass,
+		SubmitTime:           j.SubmitTime,
+		Priority:             j.Priority,
+		PodRequirements:      j.PodRequirements.DeepCopy(),
+		Version:              j.Version,
+		ResourceMutations:    j.ResourceMutations.DeepCopy(),
+		Labels:               maps.Clone(j.Labels),
+		SubmittedAnnotations: maps.Clone(j.SubmittedAnnotations),
 	}
 }
 
@@ -123,8 +130,10 @@ func FromSchedulerObjectsJobSchedulingInfo(j *schedulerobjects.JobSchedulingInfo
 			Annotations:          maps.Clone(podRequirements.Annotations),
 			ResourceRequirements: *rr,
 		},
-		Version:           j.Version,
-		ResourceMutations: retryResourceMutationsFromProto(j.ResourceMutations),
+		Version:              j.Version,
+		ResourceMutations:    retryResourceMutationsFromProto(j.ResourceMutations),
+		Labels:               maps.Clone(j.Labels),
+		SubmittedAnnotations: maps.Clone(j.Annotations),
 	}, nil
 }
 
@@ -168,5 +177,7 @@ func ToSchedulerObjectsJobSchedulingInfo(j *JobSchedulingInfo) *schedulerobjects
 		},
 		Version:           j.Version,
 		ResourceMutations: RetryResourceMutationsToProto(j.ResourceMutations),
+		Labels:            maps.Clone(j.Labels),
+		Annotations:       maps.Clone(j.SubmittedAnnotations),
 	}
 }
@@ -0,0 +1,102 @@
+package scheduler
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/auth"
+	"github.com/armadaproject/armada/internal/scheduler/jobdb"
+	"github.com/armadaproject/armada/internal/server/permissions"
+	"github.com/armadaproject/armada/pkg/api/schedulerobjects"
+)
+
+// JobSelectionServer resolves job selectors against the jobDb, allowing bulk operations to be performed
+// on jobs without clients having to list job ids.
+// Since this exposes job ids across all queues, callers must have the SelectJobs permission,
+// which is intended to be granted only to the Armada server.
+type JobSelectionServer struct {
+	jobDb      *jobdb.JobDb
+	authorizer auth.ActionAuthorizer
+}
+
+func NewJobSelectionServer(jobDb *jobdb.JobDb, authorizer auth.ActionAuthorizer) *JobSelectionServer {
+	return &JobSelectionServer{jobDb: jobDb, authorizer: authorizer}
+}
+
+// SelectJobs returns active jobs in the requested queue that match the selector.
+// If req.JobIds is non-empty, only those jobs are considered. Otherwise only jobs with labels or annotations stored
+// for selection are considered, since a selector without job ids must contain at least one label or annotation.
+// At most req.MaxJobs jobs are returned, but all matching jobs are counted.
+func (s *JobSelectionServer) SelectJobs(grpcCtx context.Context, req *schedulerobjects.SelectJobsRequest) (*schedulerobjects.SelectJobsResponse, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	err := s.authorizer.AuthorizeAction(ctx, permissions.SelectJobs)
+	var ep *armadaerrors.ErrUnauthorized
+	if errors.As(err, &ep) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "error checking permissions: %s", err)
+	}
+
+	if req.Queue == "" {
+		return nil, status.Error(codes.InvalidArgument, "queue must be provided")
+	}
+	txn := s.jobDb.ReadTxn()
+	candidates := txn.GetSelectableJobs(req.Queue)
+	if len(req.JobIds) > 0 {
+		candidates = make([]*jobdb.Job, 0, len(req.JobIds))
+		for _, jobId := range req.JobIds {
+			if job := txn.GetById(jobId); job != nil {
+				candidates = append(candidates, job)
+			}
+		}
+	}
+	selected := make([]*schedulerobjects.SelectedJob, 0)
+	matched := 0
+	for _, job := range candidates {
+		if !jobMatchesSelector(job, req) {
+			continue
+		}
+		matched++
+		if req.MaxJobs != 0 && matched > int(req.MaxJobs) {
+			continue
+		}
+		selectedJob := &schedulerobjects.SelectedJob{
+			JobId:           job.Id(),
+			JobSet:          job.Jobset(),
+			Queued:          job.Queued(),
+			Suspended:       job.Suspended(),
+			CancelRequested: job.CancelRequested() || job.CancelByJobsetRequested(),
+		}
+		if job.IsInGang() {
+			selectedJob.GangId = job.GetGangInfo().Id()
+		}
+		selected = append(selected, selectedJob)
+	}
+	return &schedulerobjects.SelectJobsResponse{Jobs: selected, MatchedJobs: int32(matched)}, nil
+}
+
+func jobMatchesSelector(job *jobdb.Job, req *schedulerobjects.SelectJobsRequest) bool {
+	if job.Queue() != req.Queue || job.InTerminalState() {
+		return false
+	}
+	if !strings.HasPrefix(job.Jobset(), req.JobSetPrefix) {
+		return false
+	}
+	return mapContainsAll(job.Labels(), req.Labels) && mapContainsAll(job.SubmittedAnnotations(), req.Annotations)
+}
+
+// mapContainsAll returns true if m contains every key/value pair in subset.
+func mapContainsAll(m map[string]string, subset map[string]string) bool {
+	for k, v := range subset {
+		if actual, ok := m[k]; !ok || actual != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,145 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/auth/permission"
+	"github.com/armadaproject/armada/internal/scheduler/jobdb"
+	"github.com/armadaproject/armada/internal/scheduler/testfixtures"
+	servermocks "github.com/armadaproject/armada/internal/server/mocks"
+	"github.com/armadaproject/armada/internal/server/permissions"
+	"github.com/armadaproject/armada/pkg/api/schedulerobjects"
+)
+
+func TestJobSelectionServer_SelectJobs(t *testing.T) {
+	withSelectable := func(job *jobdb.Job, jobSet string, labels, annotations map[string]string) *jobdb.Job {
+		schedulingInfo := job.JobSchedulingInfo().DeepCopy()
+		schedulingInfo.Labels = labels
+		schedulingInfo.SubmittedAnnotations = annotations
+		job, err := job.WithJobSchedulingInfo(schedulingInfo)
+		require.NoError(t, err)
+		return job.WithJobset(jobSet)
+	}
+
+	teamA := withSelectable(testfixtures.Test1Cpu4GiJob("queue-a", testfixtures.PriorityClass0), "set-1", map[string]string{"team": "a"}, nil)
+	teamAOtherJobSet := withSelectable(testfixtures.Test1Cpu4GiJob("queue-a", testfixtures.PriorityClass0), "other-1", map[string]string{"team": "a", "tier": "gold"}, nil)
+	teamB := withSelectable(testfixtures.Test1Cpu4GiJob("queue-a", testfixtures.PriorityClass0), "set-2", map[string]string{"team": "b"}, map[string]string{"owner": "bob"})
+	teamAOtherQueue := withSelectable(testfixtures.Test1Cpu4GiJob("queue-b", testfixtures.PriorityClass0), "set-1", map[string]string{"team": "a"}, nil)
+	teamACancelled := withSelectable(testfixtures.Test1Cpu4GiJob("queue-a", testfixtures.PriorityClass0), "set-1", map[string]string{"team": "a"}, nil).WithCancelled(true)
+	leased := withSelectable(testfixtures.Test1Cpu4GiJob("queue-a", testfixtures.PriorityClass0), "set-3", nil, nil).WithQueued(false)
+	gangMember := withSelectable(testfixtures.Test1Cpu4GiJob("queue-a", testfixtures.PriorityClass0), "set-3", nil, nil).
+		WithGangInfo(jobdb.CreateGangInfo("gang-1", 2, ""))
+	cancelRequested := withSelectable(testfixtures.Test1Cpu4GiJob("queue-a", testfixtures.PriorityClass0), "set-3", nil, nil).
+		WithCancelRequested(true)
+
+	jobDb := testfixtures.NewJobDbWithJobs([]*jobdb.Job{teamA, teamAOtherJobSet, teamB, teamAOtherQueue, teamACancelled, leased, gangMember, cancelRequested})
+
+	tests := map[string]struct {
+		req             *schedulerobjects.SelectJobsRequest
+		expectedJobs    []*jobdb.Job
+		expectedMatched int32
+		expectError     bool
+	}{
+		"select by label": {
+			req:             &schedulerobjects.SelectJobsRequest{Queue: "queue-a", Labels: map[string]string{"team": "a"}},
+			expectedJobs:    []*jobdb.Job{teamA, teamAOtherJobSet},
+			expectedMatched: 2,
+		},
+		"select by multiple labels": {
+			req:             &schedulerobjects.SelectJobsRequest{Queue: "queue-a", Labels: map[string]string{"team": "a", "tier": "gold"}},
+			expectedJobs:    []*jobdb.Job{teamAOtherJobSet},
+			expectedMatched: 1,
+		},
+		"select by annotation": {
+			req:             &schedulerobjects.SelectJobsRequest{Queue: "queue-a", Annotations: map[string]string{"owner": "bob"}},
+			expectedJobs:    []*jobdb.Job{teamB},
+			expectedMatched: 1,
+		},
+		"select by label and job set prefix": {
+			req:             &schedulerobjects.SelectJobsRequest{Queue: "queue-a", Labels: map[string]string{"team": "a"}, JobSetPrefix: "set-"},
+			expectedJobs:    []*jobdb.Job{teamA},
+			expectedMatched: 1,
+		},
+		"no matches": {
+			req:          &schedulerobjects.SelectJobsRequest{Queue: "queue-a", Labels: map[string]string{"team": "c"}},
+			expectedJobs: []*jobdb.Job{},
+		},
+		"matches beyond max jobs are counted but not returned": {
+			req:             &schedulerobjects.SelectJobsRequest{Queue: "queue-a", Labels: map[string]string{"team": "a"}, MaxJobs: 1},
+			expectedMatched: 2,
+		},
+		"select by job id": {
+			req:             &schedulerobjects.SelectJobsRequest{Queue: "queue-a", JobIds: []string{teamB.Id(), leased.Id(), teamAOtherQueue.Id(), teamACancelled.Id(), "missing"}},
+			expectedJobs:    []*jobdb.Job{teamB, leased},
+			expectedMatched: 2,
+		},
+		"gang membership and cancel requests are returned": {
+			req:             &schedulerobjects.SelectJobsRequest{Queue: "queue-a", JobIds: []string{gangMember.Id(), cancelRequested.Id()}},
+			expectedJobs:    []*jobdb.Job{gangMember, cancelRequested},
+			expectedMatched: 2,
+		},
+		"select by job id and label": {
+			req:             &schedulerobjects.SelectJobsRequest{Queue: "queue-a", JobIds: []string{teamA.Id(), teamB.Id()}, Labels: map[string]string{"team": "a"}},
+			expectedJobs:    []*jobdb.Job{teamA},
+			expectedMatched: 1,
+		},
+		"missing queue": {
+			req:         &schedulerobjects.SelectJobsRequest{Labels: map[string]string{"team": "a"}},
+			expectError: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAuthorizer := servermocks.NewMockActionAuthorizer(ctrl)
+			mockAuthorizer.EXPECT().AuthorizeAction(gomock.Any(), permission.Permission(permissions.SelectJobs)).Return(nil).Times(1)
+
+			server := NewJobSelectionServer(jobDb, mockAuthorizer)
+			resp, err := server.SelectJobs(context.Background(), tc.req)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedMatched, resp.MatchedJobs)
+			if tc.req.MaxJobs != 0 {
+				assert.Len(t, resp.Jobs, int(tc.req.MaxJobs))
+				return
+			}
+			expected := make([]*schedulerobjects.SelectedJob, len(tc.expectedJobs))
+			for i, job := range tc.expectedJobs {
+				expected[i] = &schedulerobjects.SelectedJob{
+					JobId:           job.Id(),
+					JobSet:          job.Jobset(),
+					Queued:          job.Queued(),
+					CancelRequested: job.CancelRequested(),
+				}
+				if job.IsInGang() {
+					expected[i].GangId = job.GetGangInfo().Id()
+				}
+			}
+			assert.ElementsMatch(t, expected, resp.Jobs)
+		})
+	}
+}
+
+func TestJobSelectionServer_SelectJobs_Unauthorized(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAuthorizer := servermocks.NewMockActionAuthorizer(ctrl)
+	mockAuthorizer.EXPECT().
+		AuthorizeAction(gomock.Any(), permission.Permission(permissions.SelectJobs)).
+		Return(&armadaerrors.ErrUnauthorized{Message: "unauthorized"}).
+		Times(1)
+
+	server := NewJobSelectionServer(testfixtures.NewJobDb(testfixtures.TestResourceListFactory), mockAuthorizer)
+	_, err := server.SelectJobs(context.Background(), &schedulerobjects.SelectJobsRequest{Queue: "queue-a", Labels: map[string]string{"team": "a"}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
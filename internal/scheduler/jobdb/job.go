@@ -49,6 +49,9 @@ type Job struct {
 	queued bool
 	// The current version of the queued state.
 	queuedVersion int32
+	// True if the job has been suspended by a user.
+	// Suspended jobs are not considered for scheduling until they are resumed.
+	suspended bool
 	// Scheduling requirements of this job.
 	jobSchedulingInfo *internaltypes.JobSchedulingInfo
 	// All resource requirements, including floating resources, for this job
@@ -349,6 +352,9 @@ func (job *Job) Equal(other *Job) bool {
 	if job.validated != other.validated {
 		return false
 	}
+	if job.suspended != other.suspended {
+		return false
+	}
 	if job.cancelRequested != other.cancelRequested {
 		return false
 	}
@@ -561,6 +567,28 @@ func (job *Job) Annotations() map[string]string {
 	return nil
 }
 
+// Labels returns the labels the job was submitted with.
+func (job *Job) Labels() map[string]string {
+	if schedulingInfo := job.JobSchedulingInfo(); schedulingInfo != nil {
+		return schedulingInfo.Labels
+	}
+	return nil
+}
+
+// SubmittedAnnotations returns all annotations the job was submitted with.
+// Unlike Annotations, this is not restricted to annotations relevant for scheduling.
+func (job *Job) SubmittedAnnotations() map[string]string {
+	if schedulingInfo := job.JobSchedulingInfo(); schedulingInfo != nil {
+		return schedulingInfo.SubmittedAnnotations
+	}
+	return nil
+}
+
+// Selectable returns true if the job has labels or annotations that can be matched by a job selector.
+func (job *Job) Selectable() bool {
+	return len(job.Labels()) > 0 || len(job.SubmittedAnnotations()) > 0
+}
+
 // PriorityClassName returns the name of the job's Priority Class
 // TODO: this can be inconsistent with job.PriorityClass()
 func (job *Job) PriorityClassName() string {
@@ -656,6 +684,24 @@ func (job *Job) WithQueuedVersion(version int32) *Job {
 	return j
 }
 
+// Suspended returns true if the job has been suspended and not yet resumed.
+func (job *Job) Suspended() bool {
+	return job.suspended
+}
+
+// WithSuspended returns a copy of the job with the suspended status updated.
+func (job *Job) WithSuspended(suspended bool) *Job {
+	j := shallowCopyJob(*job)
+	j.suspended = suspended
+	return j
+}
+
+// Schedulable returns true if the job is queued and not suspended,
+// i.e., if it is eligible to be assigned to a node.
+func (job *Job) Schedulable() bool {
+	return job.queued && !job.suspended
+}
+
 // CancelRequested returns true if the user has requested this job be cancelled.
 func (job *Job) CancelRequested() bool {
 	return job.cancelRequested
@@ -850,7 +896,7 @@ func (job *Job) NumReturned() uint {
 func (job *Job) NumAttempts() uint {
 	attempts := uint(0)
 	for _, run := range job.runsById {
-		if run.runAttempted {
+		if run.runAttempted && !run.suspended {
 			attempts++
 		}
 	}
@@ -56,6 +56,9 @@ type JobRun struct {
 	// API-preemption path marks preempted runs failed to terminate them on the
 	// executor) from the job's genuine-failure count.
 	everPreempted bool
+	// True if the run was preempted because its job was suspended.
+	// Such runs are not counted by Job.NumAttempts.
+	suspended bool
 	// The time at which the run was reported as preempted by the executor.
 	preemptedTime *time.Time
 	// True if the job has been reported as succeeded by the executor.
@@ -168,6 +171,9 @@ func (run *JobRun) Equal(other *JobRun) bool {
 	if run.everPreempted != other.everPreempted {
 		return false
 	}
+	if run.suspended != other.suspended {
+		return false
+	}
 	if run.jobId != other.jobId {
 		return false
 	}
@@ -486,6 +492,18 @@ func (run *JobRun) EverPreempted() bool {
 	return run.everPreempted
 }
 
+// Suspended returns true if the run was preempted because its job was suspended.
+func (run *JobRun) Suspended() bool {
+	return run.suspended
+}
+
+// WithSuspended returns a copy of the job run with the suspended status updated.
+func (run *JobRun) WithSuspended(suspended bool) *JobRun {
+	run = run.DeepCopy()
+	run.suspended = suspended
+	return run
+}
+
 func (run *JobRun) WithPreemptedTime(preemptedTime *time.Time) *JobRun {
 	run = run.DeepCopy()
 	run.preemptedTime = preemptedTime
@@ -420,6 +420,14 @@ func TestJob_TestNumAttempts(t *testing.T) {
 	// two returned runs
 	returned3 := returned2.WithUpdatedRun(attemptedRun())
 	assert.Equal(t, uint(2), returned3.NumAttempts())
+
+	// runs preempted for other reasons still count
+	preempted := returned3.WithUpdatedRun(attemptedRun().WithPreempted(true))
+	assert.Equal(t, uint(3), preempted.NumAttempts())
+
+	// runs ended by suspending the job don't count
+	suspended := preempted.WithUpdatedRun(attemptedRun().WithPreempted(true).WithSuspended(true))
+	assert.Equal(t, uint(3), suspended.NumAttempts())
 }
 
 func TestJob_FailureCount(t *testing.T) {
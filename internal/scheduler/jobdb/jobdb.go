@@ -73,6 +73,8 @@ type JobDb struct {
 	jobsByPoolAndQueue map[string]map[string]immutable.SortedSet[*Job]
 	leasedJobs         *immutable.Set[*Job]
 	unvalidatedJobs    *immutable.Set[*Job]
+	// Ids of the jobs in each queue that can be matched by a job selector.
+	selectableJobsByQueue map[string]immutable.Set[string]
 	// Configured priority classes.
 	priorityClasses map[string]types.PriorityClass
 	// Priority class assigned to jobs with a priorityClassName not in jobDb.priorityClasses.
@@ -144,6 +146,7 @@ func NewJobDbWithSchedulingKeyGenerator(
 		jobsByPoolAndQueue:     map[string]map[string]immutable.SortedSet[*Job]{},
 		leasedJobs:             &leasedJobs,
 		unvalidatedJobs:        &unvalidatedJobs,
+		selectableJobsByQueue:  map[string]immutable.Set[string]{},
 		priorityClasses:        priorityClasses,
 		defaultPriorityClass:   defaultPriorityClass,
 		schedulingKeyGenerator: skg,
@@ -176,6 +179,7 @@ func (jobDb *JobDb) Clone() *JobDb {
 		jobsByPoolAndQueue:     deepClone(jobDb.jobsByPoolAndQueue),
 		leasedJobs:             jobDb.leasedJobs,
 		unvalidatedJobs:        jobDb.unvalidatedJobs,
+		selectableJobsByQueue:  maps.Clone(jobDb.selectableJobsByQueue),
 		priorityClasses:        jobDb.priorityClasses,
 		defaultPriorityClass:   jobDb.defaultPriorityClass,
 		schedulingKeyGenerator: jobDb.schedulingKeyGenerator,
@@ -186,6 +190,15 @@ func (jobDb *JobDb) Clone() *JobDb {
 	}
 }
 
+// priorityClassOrDefault returns the priority class with the given name,
+// or the default priority class if there is no such priority class.
+func (jobDb *JobDb) priorityClassOrDefault(name string) types.PriorityClass {
+	if priorityClass, ok := jobDb.priorityClasses[name]; ok {
+		return priorityClass
+	}
+	return jobDb.defaultPriorityClass
+}
+
 // NewJob creates a new scheduler job.
 // The new job is not automatically inserted into the jobDb; call jobDb.Upsert to upsert it.
 func (jobDb *JobDb) NewJob(
@@ -205,26 +218,17 @@ func (jobDb *JobDb) NewJob(
 	priceBand int32,
 ) (*Job, error) {
 	schedulingInfo = jobDb.internJobSchedulingInfoStrings(schedulingInfo)
-	priorityClass, ok := jobDb.priorityClasses[schedulingInfo.PriorityClass]
-	if !ok {
-		priorityClass = jobDb.defaultPriorityClass
-	}
+	priorityClass := jobDb.priorityClassOrDefault(schedulingInfo.PriorityClass)
 
 	rr := jobDb.getResourceRequirements(schedulingInfo)
 
-	_, ok = bidstore.PriceBand_name[priceBand]
+	_, ok := bidstore.PriceBand_name[priceBand]
 	pb := bidstore.PriceBand_PRICE_BAND_UNSPECIFIED
 	if ok {
 		pb = bidstore.PriceBand(priceBand)
 	}
 
-	bidPrices := map[string]pricing.Bid{}
-	if jobDb.bidPriceSnapshot != nil {
-		prices, ok := jobDb.bidPriceSnapshot.GetPrice(queue, pb)
-		if ok {
-			bidPrices = maps.Clone(prices)
-		}
-	}
+	bidPrices := jobDb.bidPrices(queue, pb)
 
 	gangInfo, err := GangInfoFromMinimalJob(schedulingInfo)
 	if err != nil {
@@ -263,6 +267,18 @@ func (jobDb *JobDb) NewJob(
 	return job, nil
 }
 
+// bidPrices returns the current bid prices for jobs in the given queue and price band.
+func (jobDb *JobDb) bidPrices(queue string, priceBand bidstore.PriceBand) map[string]pricing.Bid {
+	bidPrices := map[string]pricing.Bid{}
+	if jobDb.bidPriceSnapshot != nil {
+		prices, ok := jobDb.bidPriceSnapshot.GetPrice(queue, priceBand)
+		if ok {
+			bidPrices = maps.Clone(prices)
+		}
+	}
+	return bidPrices
+}
+
 func (jobDb *JobDb) getResourceRequirements(schedulingInfo *internaltypes.JobSchedulingInfo) internaltypes.ResourceList {
 	requirements := safeGetRequirements(schedulingInfo)
 	if jobDb.respectNodePodLimits {
@@ -301,6 +317,9 @@ func (jobDb *JobDb) internJobSchedulingInfoStrings(info *internaltypes.JobSchedu
 	}
 	pr.NodeSelector = newNodeSelector
 
+	info.Labels = jobDb.internStringMap(info.Labels)
+	info.SubmittedAnnotations = jobDb.internStringMap(info.SubmittedAnnotations)
+
 	for idx, toleration := range pr.Tolerations {
 		pr.Tolerations[idx] = v1.Toleration{
 			Key:               jobDb.stringInterner.Intern(toleration.Key),
@@ -328,6 +347,17 @@ func (jobDb *JobDb) internJobSchedulingInfoStrings(info *internaltypes.JobSchedu
 	return info
 }
 
+func (jobDb *JobDb) internStringMap(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	newMap := make(map[string]string, len(m))
+	for k, v := range m {
+		newMap[jobDb.stringInterner.Intern(k)] = jobDb.stringInterner.Intern(v)
+	}
+	return newMap
+}
+
 func (jobDb *JobDb) internPools(pools []string) []string {
 	newPools := make([]string, len(pools))
 	for idx, pool := range pools {
@@ -342,17 +372,18 @@ func (jobDb *JobDb) ReadTxn() *Txn {
 	jobDb.copyMutex.Lock()
 	defer jobDb.copyMutex.Unlock()
 	return &Txn{
-		readOnly:           true,
-		jobsById:           jobDb.jobsById,
-		jobsByRunId:        jobDb.jobsByRunId,
-		jobsByGangKey:      jobDb.jobsByGangKey,
-		jobsByQueue:        jobDb.jobsByQueue,
-		jobsByPoolAndQueue: jobDb.jobsByPoolAndQueue,
-		leasedJobs:         jobDb.leasedJobs,
-		unvalidatedJobs:    jobDb.unvalidatedJobs,
-		bidPriceSnapshot:   jobDb.bidPriceSnapshot,
-		active:             true,
-		jobDb:              jobDb,
+		readOnly:              true,
+		jobsById:              jobDb.jobsById,
+		jobsByRunId:           jobDb.jobsByRunId,
+		jobsByGangKey:         jobDb.jobsByGangKey,
+		jobsByQueue:           jobDb.jobsByQueue,
+		jobsByPoolAndQueue:    jobDb.jobsByPoolAndQueue,
+		leasedJobs:            jobDb.leasedJobs,
+		unvalidatedJobs:       jobDb.unvalidatedJobs,
+		selectableJobsByQueue: jobDb.selectableJobsByQueue,
+		bidPriceSnapshot:      jobDb.bidPriceSnapshot,
+		active:                true,
+		jobDb:                 jobDb,
 	}
 }
 
@@ -364,17 +395,18 @@ func (jobDb *JobDb) WriteTxn() *Txn {
 	jobDb.copyMutex.Lock()
 	defer jobDb.copyMutex.Unlock()
 	return &Txn{
-		readOnly:           false,
-		jobsById:           jobDb.jobsById,
-		jobsByRunId:        jobDb.jobsByRunId,
-		jobsByGangKey:      maps.Clone(jobDb.jobsByGangKey),
-		jobsByQueue:        maps.Clone(jobDb.jobsByQueue),
-		jobsByPoolAndQueue: deepClone(jobDb.jobsByPoolAndQueue),
-		leasedJobs:         jobDb.leasedJobs,
-		unvalidatedJobs:    jobDb.unvalidatedJobs,
-		bidPriceSnapshot:   jobDb.bidPriceSnapshot,
-		active:             true,
-		jobDb:              jobDb,
+		readOnly:              false,
+		jobsById:              jobDb.jobsById,
+		jobsByRunId:           jobDb.jobsByRunId,
+		jobsByGangKey:         maps.Clone(jobDb.jobsByGangKey),
+		jobsByQueue:           maps.Clone(jobDb.jobsByQueue),
+		jobsByPoolAndQueue:    deepClone(jobDb.jobsByPoolAndQueue),
+		leasedJobs:            jobDb.leasedJobs,
+		unvalidatedJobs:       jobDb.unvalidatedJobs,
+		selectableJobsByQueue: maps.Clone(jobDb.selectableJobsByQueue),
+		bidPriceSnapshot:      jobDb.bidPriceSnapshot,
+		active:                true,
+		jobDb:                 jobDb,
 	}
 }
 
@@ -385,18 +417,19 @@ func (jobDb *JobDb) DryRunTxn() *Txn {
 	jobDb.copyMutex.Lock()
 	defer jobDb.copyMutex.Unlock()
 	return &Txn{
-		readOnly:           false,
-		dryRun:             true,
-		jobsById:           jobDb.jobsById,
-		jobsByRunId:        jobDb.jobsByRunId,
-		jobsByGangKey:      maps.Clone(jobDb.jobsByGangKey),
-		jobsByQueue:        maps.Clone(jobDb.jobsByQueue),
-		jobsByPoolAndQueue: deepClone(jobDb.jobsByPoolAndQueue),
-		leasedJobs:         jobDb.leasedJobs,
-		unvalidatedJobs:    jobDb.unvalidatedJobs,
-		bidPriceSnapshot:   jobDb.bidPriceSnapshot,
-		active:             true,
-		jobDb:              jobDb,
+		readOnly:              false,
+		dryRun:                true,
+		jobsById:              jobDb.jobsById,
+		jobsByRunId:           jobDb.jobsByRunId,
+		jobsByGangKey:         maps.Clone(jobDb.jobsByGangKey),
+		jobsByQueue:           maps.Clone(jobDb.jobsByQueue),
+		jobsByPoolAndQueue:    deepClone(jobDb.jobsByPoolAndQueue),
+		leasedJobs:            jobDb.leasedJobs,
+		unvalidatedJobs:       jobDb.unvalidatedJobs,
+		selectableJobsByQueue: maps.Clone(jobDb.selectableJobsByQueue),
+		bidPriceSnapshot:      jobDb.bidPriceSnapshot,
+		active:                true,
+		jobDb:                 jobDb,
 	}
 }
 
@@ -439,6 +472,8 @@ type Txn struct {
 	leasedJobs *immutable.Set[*Job]
 	// Jobs that require submit checking
 	unvalidatedJobs *immutable.Set[*Job]
+	// Ids of the jobs in each queue that can be matched by a job selector.
+	selectableJobsByQueue map[string]immutable.Set[string]
 	// The current snapshot of bid prices - allowing look up of bidding prices on job creation
 	bidPriceSnapshot *pricing.BidPriceSnapshot
 	// The jobDb from which this transaction was created.
@@ -465,6 +500,7 @@ func (txn *Txn) Commit() {
 	txn.jobDb.jobsByPoolAndQueue = txn.jobsByPoolAndQueue
 	txn.jobDb.leasedJobs = txn.leasedJobs
 	txn.jobDb.unvalidatedJobs = txn.unvalidatedJobs
+	txn.jobDb.selectableJobsByQueue = txn.selectableJobsByQueue
 	txn.jobDb.bidPriceSnapshot = txn.bidPriceSnapshot
 
 	txn.active = false
@@ -488,7 +524,7 @@ func (txn *Txn) Assert(assertOnlyActiveJobs bool) error {
 		if assertOnlyActiveJobs && job.InTerminalState() {
 			return errors.Errorf("jobDb contains an inactive job %s", job)
 		}
-		if job.Queued() {
+		if job.Schedulable() {
 			if queue, ok := txn.jobsByQueue[job.queue]; !ok {
 				return errors.Errorf("jobDb contains queued job %s but there is no sorted set for this queue", job)
 			} else if !queue.Has(job) {
@@ -592,11 +628,24 @@ func (txn *Txn) Upsert(jobs []*Job) error {
 					if !present {
 						continue
 					}
-					existingJobs, present := txn.jobsByPoolAndQueue[pool][job.queue]
+					existingJobs, present := txn.jobsByPoolAndQueue[pool][existingJob.queue]
 					if !present {
 						continue
 					}
-					txn.jobsByPoolAndQueue[pool][job.queue] = existingJobs.Delete(existingJob)
+					txn.jobsByPoolAndQueue[pool][existingJob.queue] = existingJobs.Delete(existingJob)
+				}
+
+				// Jobs moved to another queue leave the gang they were part of in the old queue.
+				if existingJob.IsInGang() && existingJob.queue != job.queue {
+					key := gangKey{queue: existingJob.queue, gangId: existingJob.GetGangInfo().Id()}
+					if gangJobIds, ok := txn.jobsByGangKey[key]; ok {
+						newGangJobIds := gangJobIds.Delete(existingJob.Id())
+						if newGangJobIds.Len() > 0 {
+							txn.jobsByGangKey[key] = newGangJobIds
+						} else {
+							delete(txn.jobsByGangKey, key)
+						}
+					}
 				}
 
 				if existingJob.Leased() {
@@ -608,10 +657,25 @@ func (txn *Txn) Upsert(jobs []*Job) error {
 					newUnvalidatedJobs := txn.unvalidatedJobs.Delete(existingJob)
 					txn.unvalidatedJobs = &newUnvalidatedJobs
 				}
+
+				if existingJob.Selectable() && existingJob.queue != job.queue {
+					txn.deleteSelectableJob(existingJob)
+				}
 			}
 		}
 	}
 
+	// Jobs that can be matched by a job selector are indexed by queue, so selecting jobs doesn't scan the whole jobDb.
+	for _, job := range jobs {
+		if job.Selectable() {
+			selectableJobs, ok := txn.selectableJobsByQueue[job.queue]
+			if !ok {
+				selectableJobs = immutable.NewSet[string](JobIdHasher{})
+			}
+			txn.selectableJobsByQueue[job.queue] = selectableJobs.Add(job.id)
+		}
+	}
+
 	// Now need to insert jobs, runs and queuedJobs. This can be done in parallel.
 	wg := sync.WaitGroup{}
 	wg.Add(6)
@@ -687,11 +751,12 @@ func (txn *Txn) Upsert(jobs []*Job) error {
 
 	// Queued jobs are additionally stored in an ordered set.
 	// To enable iterating over them in the order they should be scheduled.
+	// Suspended jobs are left out, so the scheduler skips them until they are resumed.
 	go func() {
 		defer wg.Done()
 		if hasJobs {
 			for _, job := range jobs {
-				if job.Queued() {
+				if job.Schedulable() {
 					newQueue, ok := txn.jobsByQueue[job.queue]
 					if !ok {
 						newQueue = emptyList
@@ -718,7 +783,7 @@ func (txn *Txn) Upsert(jobs []*Job) error {
 			jobsByPoolAndQueue := map[string]map[string]map[*Job]bool{}
 
 			for _, job := range jobs {
-				if job.Queued() {
+				if job.Schedulable() {
 					if _, ok := jobsByQueue[job.queue]; !ok {
 						jobsByQueue[job.queue] = map[*Job]bool{}
 					}
@@ -929,6 +994,24 @@ func (txn *Txn) GetAll() []*Job {
 	return allJobs
 }
 
+// GetSelectableJobs returns the jobs in the given queue that can be matched by a job selector,
+// i.e., those with labels or annotations stored for selection.
+func (txn *Txn) GetSelectableJobs(queue string) []*Job {
+	selectableJobs, ok := txn.selectableJobsByQueue[queue]
+	if !ok {
+		return nil
+	}
+	jobs := make([]*Job, 0, selectableJobs.Len())
+	iter := selectableJobs.Iterator()
+	for !iter.Done() {
+		jobId, _ := iter.Next()
+		if job, ok := txn.jobsById.Get(jobId); ok {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs
+}
+
 // GetQueuedJobsByPool returns all queued jobs against a given pool
 func (txn *Txn) GetQueuedJobsByPool(pool string) []*Job {
 	allJobs := make([]*Job, 0)
@@ -992,6 +1075,23 @@ func (txn *Txn) delete(jobId string) {
 
 		newUnvalidatedJobs := txn.unvalidatedJobs.Delete(job)
 		txn.unvalidatedJobs = &newUnvalidatedJobs
+
+		if job.Selectable() {
+			txn.deleteSelectableJob(job)
+		}
+	}
+}
+
+func (txn *Txn) deleteSelectableJob(job *Job) {
+	selectableJobs, ok := txn.selectableJobsByQueue[job.queue]
+	if !ok {
+		return
+	}
+	newSelectableJobs := selectableJobs.Delete(job.id)
+	if newSelectableJobs.Len() > 0 {
+		txn.selectableJobsByQueue[job.queue] = newSelectableJobs
+	} else {
+		delete(txn.selectableJobsByQueue, job.queue)
 	}
 }
 
@@ -206,6 +206,44 @@ func TestJobDb_TestGetJobsByGangId(t *testing.T) {
 	assert.Empty(t, result)
 }
 
+func TestJobDb_TestUpsert_MovedToAnotherQueue(t *testing.T) {
+	jobDb := NewTestJobDb()
+	job := newJob().WithQueued(true)
+	gangJob := newGangJob().WithQueued(true)
+	txn := jobDb.WriteTxn()
+	require.NoError(t, txn.Upsert([]*Job{job, gangJob}))
+
+	movedJob := job.WithQueue("other-queue")
+	movedGangJob := gangJob.WithQueue("other-queue")
+	require.NoError(t, txn.Upsert([]*Job{movedJob, movedGangJob}))
+
+	assert.False(t, txn.HasQueuedJobs("test-queue"))
+	assert.True(t, txn.HasQueuedJobs("other-queue"))
+	assert.ElementsMatch(t, []*Job{movedJob, movedGangJob}, txn.GetQueuedJobsByPool("pool"))
+	assert.Empty(t, txn.GetGangJobsIdsByGangId("test-queue", gangJob.GetGangInfo().Id()))
+	assert.Equal(t, []string{gangJob.Id()}, txn.GetGangJobsIdsByGangId("other-queue", gangJob.GetGangInfo().Id()))
+}
+
+func TestJobDb_TestGetSelectableJobs(t *testing.T) {
+	jobDb := NewTestJobDb()
+	schedulingInfo := jobSchedulingInfo.DeepCopy()
+	schedulingInfo.Labels = map[string]string{"team": "a"}
+	selectableJob, err := newJob().WithJobSchedulingInfo(schedulingInfo)
+	require.NoError(t, err)
+	otherJob := newJob()
+	txn := jobDb.WriteTxn()
+	require.NoError(t, txn.Upsert([]*Job{selectableJob, otherJob}))
+	assert.Equal(t, []*Job{selectableJob}, txn.GetSelectableJobs("test-queue"))
+
+	movedJob := selectableJob.WithQueue("other-queue")
+	require.NoError(t, txn.Upsert([]*Job{movedJob}))
+	assert.Empty(t, txn.GetSelectableJobs("test-queue"))
+	assert.Equal(t, []*Job{movedJob}, txn.GetSelectableJobs("other-queue"))
+
+	require.NoError(t, txn.BatchDelete([]string{movedJob.Id()}))
+	assert.Empty(t, txn.GetSelectableJobs("other-queue"))
+}
+
 func TestJobDb_TestGetJobsByGangId_NonGangJob(t *testing.T) {
 	jobDb := NewTestJobDb()
 	job1 := newJob()
@@ -323,6 +361,15 @@ func TestJobDb_TestQueuedJobs(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, []*Job{updatedJob, job10, jobs[0], jobs[2], jobs[6], jobs[9]}, collect())
 
+	// suspended jobs are not returned until resumed
+	suspendedJob := jobs[6].WithSuspended(true)
+	err = txn.Upsert([]*Job{suspendedJob})
+	require.NoError(t, err)
+	assert.Equal(t, []*Job{updatedJob, job10, jobs[0], jobs[2], jobs[9]}, collect())
+	err = txn.Upsert([]*Job{suspendedJob.WithSuspended(false)})
+	require.NoError(t, err)
+	assert.Equal(t, []*Job{updatedJob, job10, jobs[0], jobs[2], jobs[6], jobs[9]}, collect())
+
 	// clear all jobs
 	err = txn.BatchDelete([]string{updatedJob.id, job10.id, jobs[0].id, jobs[2].id, jobs[6].id, jobs[9].id})
 	require.NoError(t, err)
@@ -191,6 +191,18 @@ func (jobDb *JobDb) reconcileJobDifferences(job *Job, jobRepoJob *database.Job,
 		if jobRepoJob.Failed && !job.Failed() {
 			job = job.WithFailed(true)
 		}
+		if jobRepoJob.Queue != job.Queue() {
+			// Bid prices depend on the queue, so are refreshed for jobs moved to another queue.
+			job = job.
+				WithQueue(jobDb.stringInterner.Intern(jobRepoJob.Queue)).
+				WithBidPrices(jobDb.bidPrices(jobRepoJob.Queue, job.GetPriceBand()))
+		}
+		if jobRepoJob.JobSet != job.Jobset() {
+			job = job.WithJobset(jobDb.stringInterner.Intern(jobRepoJob.JobSet))
+		}
+		if jobRepoJob.Suspended != job.Suspended() {
+			job = job.WithSuspended(jobRepoJob.Suspended)
+		}
 		if uint32(jobRepoJob.Priority) != job.RequestedPriority() {
 			job = job.WithRequestedPriority(uint32(jobRepoJob.Priority))
 		}
@@ -205,11 +217,19 @@ func (jobDb *JobDb) reconcileJobDifferences(job *Job, jobRepoJob *database.Job,
 				err = errors.Wrapf(err, "error converting scheduler info for job %s", jobRepoJob.JobID)
 				return jst, err
 			}
+			previousPriorityClassName := job.PriorityClassName()
 			job, err = job.WithJobSchedulingInfo(schedulingInfo)
 			if err != nil {
 				err = errors.Wrapf(err, "error unmarshalling scheduling info for job %s", jobRepoJob.JobID)
 				return jst, err
 			}
+			if schedulingInfo.PriorityClass != previousPriorityClassName {
+				job = job.WithPriorityClass(jobDb.priorityClassOrDefault(schedulingInfo.PriorityClass))
+			}
+			// Jobs whose scheduling requirements were updated while queued must be validated again.
+			if !jobRepoJob.Validated && job.Validated() {
+				job = job.WithValidated(false)
+			}
 		}
 		if jobRepoJob.QueuedVersion > job.QueuedVersion() {
 			job = job.WithQueuedVersion(jobRepoJob.QueuedVersion)
@@ -283,6 +303,9 @@ func (jobDb *JobDb) reconcileRunDifferences(jobRun *JobRun, jobRepoRun *database
 		if jobRepoRun.RunAttempted && !jobRun.RunAttempted() {
 			jobRun = jobRun.WithAttempted(true)
 		}
+		if jobRepoRun.Suspended && !jobRun.Suspended() {
+			jobRun = jobRun.WithSuspended(true)
+		}
 	}
 	jobRun = jobDb.enforceTerminalStateExclusivity(jobRun, &rst)
 	return rst
@@ -360,13 +383,16 @@ func (jobDb *JobDb) schedulerJobFromDatabaseJob(dbJob *database.Job) (*Job, erro
 	if dbJob.CancelReason != nil {
 		job = job.WithCancelReason(dbJob.CancelReason)
 	}
+	if dbJob.Suspended {
+		job = job.WithSuspended(true)
+	}
 	return job, nil
 }
 
 // schedulerRunFromDatabaseRun creates a new scheduler job run from a database job run
 func (jobDb *JobDb) schedulerRunFromDatabaseRun(dbRun *database.Run) *JobRun {
 	nodeId := api.NodeIdFromExecutorAndNodeName(dbRun.Executor, dbRun.Node)
-	return jobDb.CreateRun(
+	run := jobDb.CreateRun(
 		dbRun.RunID,
 		dbRun.JobID,
 		dbRun.Created,
@@ -392,4 +418,8 @@ func (jobDb *JobDb) schedulerRunFromDatabaseRun(dbRun *database.Run) *JobRun {
 		dbRun.Returned,
 		dbRun.RunAttempted,
 	)
+	if dbRun.Suspended {
+		run = run.WithSuspended(true)
+	}
+	return run
 }
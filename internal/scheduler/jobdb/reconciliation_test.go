@@ -136,3 +136,63 @@ func TestReconcileJobDifferences_InitialLoadWithCancelReason(t *testing.T) {
 	require.NotNil(t, jst.Job.CancelReason())
 	assert.Equal(t, cancelReason, *jst.Job.CancelReason())
 }
+
+// TestReconcileJobDifferences_UpdatedSchedulingInfoRequiresValidation verifies that a job whose
+// scheduling info was updated while queued, and marked as not validated in the DB, is validated again.
+func TestReconcileJobDifferences_UpdatedSchedulingInfoRequiresValidation(t *testing.T) {
+	jobDb := NewTestJobDb()
+	existingJob := newJob().WithQueued(true).WithValidated(true)
+
+	updatedSchedulingInfoBytes := protoutil.MustMarshall(&schedulerobjects.JobSchedulingInfo{
+		PriorityClassName: "bar",
+		Version:           existingJob.JobSchedulingInfo().Version + 1,
+		ObjectRequirements: []*schedulerobjects.ObjectRequirements{
+			{
+				Requirements: &schedulerobjects.ObjectRequirements_PodRequirements{
+					PodRequirements: &schedulerobjects.PodRequirements{NodeSelector: map[string]string{"foo": "bar"}},
+				},
+			},
+		},
+	})
+	dbJob := &database.Job{
+		JobID:                 existingJob.Id(),
+		JobSet:                existingJob.Jobset(),
+		Queue:                 existingJob.Queue(),
+		Queued:                true,
+		SchedulingInfo:        updatedSchedulingInfoBytes,
+		SchedulingInfoVersion: int32(existingJob.JobSchedulingInfo().Version + 1),
+		Validated:             false,
+	}
+
+	jst, err := jobDb.reconcileJobDifferences(existingJob, dbJob, nil)
+	require.NoError(t, err)
+	assert.False(t, jst.Job.Validated())
+	assert.Equal(t, "bar", jst.Job.PriorityClassName())
+	assert.Equal(t, map[string]string{"foo": "bar"}, jst.Job.NodeSelector())
+
+	// Once validated again, the job is not invalidated by the same scheduling info.
+	dbJob.Validated = true
+	jst, err = jobDb.reconcileJobDifferences(jst.Job, dbJob, nil)
+	require.NoError(t, err)
+	assert.True(t, jst.Job.Validated())
+}
+
+func TestReconcileJobDifferences_MovedJob(t *testing.T) {
+	jobDb := NewTestJobDb()
+	existingJob := newJob().WithQueued(true).WithJobset("test-jobset")
+
+	dbJob := &database.Job{
+		JobID:                 existingJob.Id(),
+		JobSet:                "other-jobset",
+		Queue:                 "other-queue",
+		Queued:                true,
+		SchedulingInfoVersion: int32(existingJob.JobSchedulingInfo().Version),
+	}
+
+	jst, err := jobDb.reconcileJobDifferences(existingJob, dbJob, nil)
+	require.NoError(t, err)
+	assert.Equal(t, existingJob.Id(), jst.Job.Id())
+	assert.Equal(t, "other-queue", jst.Job.Queue())
+	assert.Equal(t, "other-jobset", jst.Job.Jobset())
+	assert.True(t, jst.Job.Queued())
+}
@@ -84,6 +84,26 @@ func (mr *MockSubmitClientMockRecorder) CancelJobs(ctx, in any, opts ...any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelJobs", reflect.TypeOf((*MockSubmitClient)(nil).CancelJobs), varargs...)
 }
 
+// CancelJobsBySelector mocks base method.
+func (m *MockSubmitClient) CancelJobsBySelector(ctx context.Context, in *api.JobSelectorCancelRequest, opts ...grpc.CallOption) (*api.JobSelectorResult, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelJobsBySelector", varargs...)
+	ret0, _ := ret[0].(*api.JobSelectorResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelJobsBySelector indicates an expected call of CancelJobsBySelector.
+func (mr *MockSubmitClientMockRecorder) CancelJobsBySelector(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelJobsBySelector", reflect.TypeOf((*MockSubmitClient)(nil).CancelJobsBySelector), varargs...)
+}
+
 // CreateQueue mocks base method.
 func (m *MockSubmitClient) CreateQueue(ctx context.Context, in *api.Queue, opts ...grpc.CallOption) (*types.Empty, error) {
 	m.ctrl.T.Helper()
@@ -204,6 +224,26 @@ func (mr *MockSubmitClientMockRecorder) Health(ctx, in any, opts ...any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Health", reflect.TypeOf((*MockSubmitClient)(nil).Health), varargs...)
 }
 
+// MoveJobs mocks base method.
+func (m *MockSubmitClient) MoveJobs(ctx context.Context, in *api.JobMoveRequest, opts ...grpc.CallOption) (*api.JobMoveResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveJobs", varargs...)
+	ret0, _ := ret[0].(*api.JobMoveResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveJobs indicates an expected call of MoveJobs.
+func (mr *MockSubmitClientMockRecorder) MoveJobs(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveJobs", reflect.TypeOf((*MockSubmitClient)(nil).MoveJobs), varargs...)
+}
+
 // PreemptJobs mocks base method.
 func (m *MockSubmitClient) PreemptJobs(ctx context.Context, in *api.JobPreemptRequest, opts ...grpc.CallOption) (*api.PreemptionResult, error) {
 	m.ctrl.T.Helper()
@@ -244,6 +284,66 @@ func (mr *MockSubmitClientMockRecorder) ReprioritizeJobs(ctx, in any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReprioritizeJobs", reflect.TypeOf((*MockSubmitClient)(nil).ReprioritizeJobs), varargs...)
 }
 
+// ReprioritizeJobsBySelector mocks base method.
+func (m *MockSubmitClient) ReprioritizeJobsBySelector(ctx context.Context, in *api.JobSelectorReprioritizeRequest, opts ...grpc.CallOption) (*api.JobSelectorResult, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReprioritizeJobsBySelector", varargs...)
+	ret0, _ := ret[0].(*api.JobSelectorResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReprioritizeJobsBySelector indicates an expected call of ReprioritizeJobsBySelector.
+func (mr *MockSubmitClientMockRecorder) ReprioritizeJobsBySelector(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReprioritizeJobsBySelector", reflect.TypeOf((*MockSubmitClient)(nil).ReprioritizeJobsBySelector), varargs...)
+}
+
+// ResumeJobSet mocks base method.
+func (m *MockSubmitClient) ResumeJobSet(ctx context.Context, in *api.JobSetResumeRequest, opts ...grpc.CallOption) (*api.JobSuspensionResult, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResumeJobSet", varargs...)
+	ret0, _ := ret[0].(*api.JobSuspensionResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeJobSet indicates an expected call of ResumeJobSet.
+func (mr *MockSubmitClientMockRecorder) ResumeJobSet(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeJobSet", reflect.TypeOf((*MockSubmitClient)(nil).ResumeJobSet), varargs...)
+}
+
+// ResumeJobs mocks base method.
+func (m *MockSubmitClient) ResumeJobs(ctx context.Context, in *api.JobResumeRequest, opts ...grpc.CallOption) (*api.JobSuspensionResult, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResumeJobs", varargs...)
+	ret0, _ := ret[0].(*api.JobSuspensionResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeJobs indicates an expected call of ResumeJobs.
+func (mr *MockSubmitClientMockRecorder) ResumeJobs(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeJobs", reflect.TypeOf((*MockSubmitClient)(nil).ResumeJobs), varargs...)
+}
+
 // SubmitJobs mocks base method.
 func (m *MockSubmitClient) SubmitJobs(ctx context.Context, in *api.JobSubmitRequest, opts ...grpc.CallOption) (*api.JobSubmitResponse, error) {
 	m.ctrl.T.Helper()
@@ -264,6 +364,46 @@ func (mr *MockSubmitClientMockRecorder) SubmitJobs(ctx, in any, opts ...any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitJobs", reflect.TypeOf((*MockSubmitClient)(nil).SubmitJobs), varargs...)
 }
 
+// SuspendJobSet mocks base method.
+func (m *MockSubmitClient) SuspendJobSet(ctx context.Context, in *api.JobSetSuspendRequest, opts ...grpc.CallOption) (*api.JobSuspensionResult, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SuspendJobSet", varargs...)
+	ret0, _ := ret[0].(*api.JobSuspensionResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuspendJobSet indicates an expected call of SuspendJobSet.
+func (mr *MockSubmitClientMockRecorder) SuspendJobSet(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendJobSet", reflect.TypeOf((*MockSubmitClient)(nil).SuspendJobSet), varargs...)
+}
+
+// SuspendJobs mocks base method.
+func (m *MockSubmitClient) SuspendJobs(ctx context.Context, in *api.JobSuspendRequest, opts ...grpc.CallOption) (*api.JobSuspensionResult, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SuspendJobs", varargs...)
+	ret0, _ := ret[0].(*api.JobSuspensionResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuspendJobs indicates an expected call of SuspendJobs.
+func (mr *MockSubmitClientMockRecorder) SuspendJobs(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendJobs", reflect.TypeOf((*MockSubmitClient)(nil).SuspendJobs), varargs...)
+}
+
 // UpdateQueue mocks base method.
 func (m *MockSubmitClient) UpdateQueue(ctx context.Context, in *api.Queue, opts ...grpc.CallOption) (*types.Empty, error) {
 	m.ctrl.T.Helper()
@@ -284,6 +424,26 @@ func (mr *MockSubmitClientMockRecorder) UpdateQueue(ctx, in any, opts ...any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQueue", reflect.TypeOf((*MockSubmitClient)(nil).UpdateQueue), varargs...)
 }
 
+// UpdateQueuedJobs mocks base method.
+func (m *MockSubmitClient) UpdateQueuedJobs(ctx context.Context, in *api.JobUpdateRequest, opts ...grpc.CallOption) (*api.JobUpdateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateQueuedJobs", varargs...)
+	ret0, _ := ret[0].(*api.JobUpdateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateQueuedJobs indicates an expected call of UpdateQueuedJobs.
+func (mr *MockSubmitClientMockRecorder) UpdateQueuedJobs(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQueuedJobs", reflect.TypeOf((*MockSubmitClient)(nil).UpdateQueuedJobs), varargs...)
+}
+
 // UpdateQueues mocks base method.
 func (m *MockSubmitClient) UpdateQueues(ctx context.Context, in *api.QueueList, opts ...grpc.CallOption) (*api.BatchQueueUpdateResponse, error) {
 	m.ctrl.T.Helper()
@@ -42,6 +42,20 @@ func (m *MockExecutorRepository) EXPECT() *MockExecutorRepositoryMockRecorder {
 	return m.recorder
 }
 
+// DeleteNodeQuarantineReleases mocks base method.
+func (m *MockExecutorRepository) DeleteNodeQuarantineReleases(ctx *armadacontext.Context, executorId string, releases map[string]time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNodeQuarantineReleases", ctx, executorId, releases)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNodeQuarantineReleases indicates an expected call of DeleteNodeQuarantineReleases.
+func (mr *MockExecutorRepositoryMockRecorder) DeleteNodeQuarantineReleases(ctx, executorId, releases any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNodeQuarantineReleases", reflect.TypeOf((*MockExecutorRepository)(nil).DeleteNodeQuarantineReleases), ctx, executorId, releases)
+}
+
 // GetExecutorSettings mocks base method.
 func (m *MockExecutorRepository) GetExecutorSettings(ctx *armadacontext.Context) ([]*schedulerobjects.ExecutorSettings, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +101,21 @@ func (mr *MockExecutorRepositoryMockRecorder) GetLastUpdateTimes(ctx any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastUpdateTimes", reflect.TypeOf((*MockExecutorRepository)(nil).GetLastUpdateTimes), ctx)
 }
 
+// GetNodeQuarantineReleases mocks base method.
+func (m *MockExecutorRepository) GetNodeQuarantineReleases(ctx *armadacontext.Context, executorId string) (map[string]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNodeQuarantineReleases", ctx, executorId)
+	ret0, _ := ret[0].(map[string]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNodeQuarantineReleases indicates an expected call of GetNodeQuarantineReleases.
+func (mr *MockExecutorRepositoryMockRecorder) GetNodeQuarantineReleases(ctx, executorId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodeQuarantineReleases", reflect.TypeOf((*MockExecutorRepository)(nil).GetNodeQuarantineReleases), ctx, executorId)
+}
+
 // StoreExecutor mocks base method.
 func (m *MockExecutorRepository) StoreExecutor(ctx *armadacontext.Context, executor *schedulerobjects.Executor) error {
 	m.ctrl.T.Helper()
@@ -889,23 +889,12 @@ func createEventsForLeaseExpiredRetry(job *jobdb.Job, leaseExpiredError *armadae
 }
 
 func createEventsForPreemptedJob(jobId string, runId string, preemptingJobId string, reason string, time time.Time) []*armadaevents.EventSequence_Event {
-	return []*armadaevents.EventSequence_Event{
-		{
-			Created: protoutil.ToTimestamp(time),
-			Event: &armadaevents.EventSequence_Event_JobRunPreempted{
-				JobRunPreempted: &armadaevents.JobRunPreempted{
-					PreemptedRunId:  runId,
-					PreemptedJobId:  jobId,
-					Reason:          reason,
-					PreemptingJobId: preemptingJobId,
-				},
-			},
-		},
-		{
+	return append(
+		createEventsForPreemptedRun(jobId, runId, preemptingJobId, reason, time),
+		&armadaevents.EventSequence_Event{
 			Created: protoutil.ToTimestamp(time),
-			Event: &armadaevents.EventSequence_Event_JobRunErrors{
-				JobRunErrors: &armadaevents.JobRunErrors{
-					RunId: runId,
+			Event: &armadaevents.EventSequence_Event_JobErrors{
+				JobErrors: &armadaevents.JobErrors{
 					JobId: jobId,
 					Errors: []*armadaevents.Error{
 						{
@@ -920,10 +909,28 @@ func createEventsForPreemptedJob(jobId string, runId string, preemptingJobId str
 				},
 			},
 		},
+	)
+}
+
+// createEventsForPreemptedRun returns the events marking a run as preempted, without failing the job.
+func createEventsForPreemptedRun(jobId string, runId string, preemptingJobId string, reason string, time time.Time) []*armadaevents.EventSequence_Event {
+	return []*armadaevents.EventSequence_Event{
 		{
 			Created: protoutil.ToTimestamp(time),
-			Event: &armadaevents.EventSequence_Event_JobErrors{
-				JobErrors: &armadaevents.JobErrors{
+			Event: &armadaevents.EventSequence_Event_JobRunPreempted{
+				JobRunPreempted: &armadaevents.JobRunPreempted{
+					PreemptedRunId:  runId,
+					PreemptedJobId:  jobId,
+					Reason:          reason,
+					PreemptingJobId: preemptingJobId,
+				},
+			},
+		},
+		{
+			Created: protoutil.ToTimestamp(time),
+			Event: &armadaevents.EventSequence_Event_JobRunErrors{
+				JobRunErrors: &armadaevents.JobRunErrors{
+					RunId: runId,
 					JobId: jobId,
 					Errors: []*armadaevents.Error{
 						{
@@ -1272,6 +1279,30 @@ func (s *Scheduler) generateUpdateMessagesFromJob(ctx *armadacontext.Context, jo
 			},
 		}
 		events = append(events, cancel)
+	} else if job.Suspended() && !job.Queued() && job.HasRuns() && !job.LatestRun().InTerminalState() {
+		// Suspending a job preempts its active run. The job is requeued straight away so that it can be
+		// resumed without further scheduler involvement, but the jobDb keeps suspended jobs out of the
+		// scheduling queues. Runs ended by suspension don't count towards the job's retry limits.
+		lastRun := job.LatestRun()
+		reason := "Preempted - job suspended via API"
+		job = job.
+			WithUpdatedRun(lastRun.WithoutTerminal().WithPreempted(true).WithFailed(true).WithSuspended(true)).
+			WithQueued(true).
+			WithQueuedVersion(job.QueuedVersion() + 1)
+		preemptedEvents := createEventsForPreemptedRun(job.Id(), lastRun.Id(), "", reason, s.clock.Now())
+		preemptedEvents[0].GetJobRunPreempted().Suspended = true
+		events = append(events, preemptedEvents...)
+		events = append(events, &armadaevents.EventSequence_Event{
+			Created: s.now(),
+			Event: &armadaevents.EventSequence_Event_JobRequeued{
+				JobRequeued: &armadaevents.JobRequeued{
+					JobId:                job.Id(),
+					SchedulingInfo:       internaltypes.ToSchedulerObjectsJobSchedulingInfo(job.JobSchedulingInfo()),
+					UpdateSequenceNumber: job.QueuedVersion(),
+				},
+			},
+		})
+		s.metrics.ReportJobPreemptedWithType(job, schedulercontext.PreemptedViaApi)
 	} else if job.HasRuns() {
 		lastRun := job.LatestRun()
 		// InTerminalState states. Can only have one of these
@@ -1686,8 +1717,9 @@ func (s *Scheduler) submitCheck(ctx *armadacontext.Context, txn *jobdb.Txn) ([]*
 
 			es.Events[0].Event = &armadaevents.EventSequence_Event_JobValidated{
 				JobValidated: &armadaevents.JobValidated{
-					JobId: job.Id(),
-					Pools: result.pools,
+					JobId:                 job.Id(),
+					Pools:                 result.pools,
+					SchedulingInfoVersion: job.JobSchedulingInfo().Version,
 				},
 			}
 		} else {
@@ -140,7 +140,8 @@ var (
 		Version: 1,
 	}
 	updatedSchedulingInfo = &schedulerobjects.JobSchedulingInfo{
-		AtMostOnce: true,
+		AtMostOnce:        true,
+		PriorityClassName: testfixtures.PriorityClass2NonPreemptible,
 		ObjectRequirements: []*schedulerobjects.ObjectRequirements{
 			{
 				Requirements: &schedulerobjects.ObjectRequirements_PodRequirements{
@@ -1019,6 +1020,23 @@ func TestScheduler_TestCycle(t *testing.T) {
 			expectedTerminal:          []string{leasedJob.Id()},
 			expectedQueuedVersion:     leasedJob.QueuedVersion(),
 		},
+		"Leased job suspended": {
+			initialJobs: []*jobdb.Job{leasedJob},
+			jobUpdates: []database.Job{
+				{
+					JobID:     leasedJob.Id(),
+					JobSet:    "testJobSet",
+					Queue:     "testQueue",
+					Suspended: true,
+					Serial:    1,
+				},
+			},
+			expectedJobRunPreempted: []jobRunId{{jobId: leasedJob.Id(), runId: leasedJob.LatestRun().Id()}},
+			expectedJobRunErrors:    []jobRunId{{jobId: leasedJob.Id(), runId: leasedJob.LatestRun().Id()}},
+			expectedQueued:          []string{leasedJob.Id()},
+			expectedRequeued:        []string{leasedJob.Id()},
+			expectedQueuedVersion:   leasedJob.QueuedVersion() + 1,
+		},
 		"Fetch fails": {
 			initialJobs:           []*jobdb.Job{leasedJob},
 			fetchError:            true,
@@ -2314,6 +2332,7 @@ func TestScheduler_TestSyncState(t *testing.T) {
 					Priority:              int64(leasedJob.Priority()),
 					SchedulingInfo:        updatedSchedulingInfoBytes,
 					SchedulingInfoVersion: int32(updatedSchedulingInfo.Version),
+					Validated:             true,
 					Serial:                1,
 				},
 			},
@@ -2510,6 +2529,14 @@ func (t testExecutorRepository) StoreExecutor(ctx *armadacontext.Context, execut
 	panic("not implemented")
 }
 
+func (t testExecutorRepository) GetNodeQuarantineReleases(ctx *armadacontext.Context, executorId string) (map[string]time.Time, error) {
+	panic("not implemented")
+}
+
+func (t testExecutorRepository) DeleteNodeQuarantineReleases(ctx *armadacontext.Context, executorId string, releases map[string]time.Time) error {
+	panic("not implemented")
+}
+
 type testSchedulingAlgo struct {
 	numberOfScheduleCalls            atomic.Int64
 	jobsToPreempt                    []string
@@ -3570,7 +3597,7 @@ func TestCycleConsistency(t *testing.T) {
 				queueByJobId[jobUpdate.JobID] = jobUpdate.Queue
 				jobSetByJobId[jobUpdate.JobID] = jobUpdate.JobSet
 			}
-			instructionConverter, err := scheduleringester.NewJobSetEventsInstructionConverter(nil)
+			instructionConverter, err := scheduleringester.NewJobSetEventsInstructionConverter(nil, nil, nil)
 			require.NoError(t, err)
 
 			// Declared here so the cycle/persist closures can reach the current
@@ -334,6 +334,7 @@ func Run(config schedulerconfig.Configuration) error {
 		config.Scheduling.NodeIdLabel,
 		config.Scheduling.PriorityClassNameOverride,
 		config.Scheduling.PriorityClasses,
+		queueCache,
 		authorizer,
 	)
 	if err != nil {
@@ -409,6 +410,7 @@ func Run(config schedulerconfig.Configuration) error {
 		resourceListFactory,
 	)
 	jobDb.SetRespectNodePodLimits(config.Scheduling.RespectNodePodLimits)
+	schedulerobjects.RegisterJobSelectionServer(grpcServer, NewJobSelectionServer(jobDb, authorizer))
 
 	err = populateInitialBidPrices(ctx, bidPriceProvider, jobDb)
 	if err != nil {
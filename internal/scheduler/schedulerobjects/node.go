@@ -9,6 +9,9 @@ func (node *Node) AvailableArmadaResource() resource.ComputeResources {
 	for _, rl := range node.UnallocatableResources {
 		cr.Sub(rl.ToComputeResources())
 	}
+	if node.UnhealthyResources != nil {
+		cr.Sub(node.UnhealthyResources.ToComputeResources())
+	}
 	cr.LimitToZero()
 	return cr
 }
@@ -37,6 +37,20 @@ func TestAvailableArmadaResource_FloorsAtZero(t *testing.T) {
 	assert.True(t, fooBar("6", "0").ToComputeResources().Equal(result), result)
 }
 
+func TestAvailableArmadaResource_ExcludesUnhealthyResources(t *testing.T) {
+	total := *fooBar("10", "8")
+	unAllocatable := map[int32]*ResourceList{
+		1: fooBar("1", "2"),
+	}
+	node := &Node{
+		TotalResources:         &total,
+		UnallocatableResources: unAllocatable,
+		UnhealthyResources:     fooBar("0", "4"),
+	}
+	result := node.AvailableArmadaResource()
+	assert.True(t, fooBar("9", "2").ToComputeResources().Equal(result), result)
+}
+
 func fooBar(foo, bar string) *ResourceList {
 	return &ResourceList{
 		Resources: map[string]*resource.Quantity{
@@ -174,6 +174,8 @@ type Node struct {
 	ResourceUsageByQueueAndPool []*PoolQueueResource `protobuf:"bytes,20,rep,name=resource_usage_by_queue_and_pool,json=resourceUsageByQueueAndPool,proto3" json:"resourceUsageByQueueAndPool,omitempty"`
 	// The name of the reservation for this node, will be empty if this node is not reserved
 	Reservation string `protobuf:"bytes,21,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	// Resources included in total_resources that are unhealthy, e.g., failed GPUs. These aren't available for scheduling.
+	UnhealthyResources *ResourceList `protobuf:"bytes,22,opt,name=unhealthy_resources,json=unhealthyResources,proto3" json:"unhealthyResources,omitempty"`
 }
 
 func (m *Node) Reset()         { *m = Node{} }
@@ -307,6 +309,13 @@ func (m *Node) GetReservation() string {
 	return ""
 }
 
+func (m *Node) GetUnhealthyResources() *ResourceList {
+	if m != nil {
+		return m.UnhealthyResources
+	}
+	return nil
+}
+
 type PoolQueueResource struct {
 	Pool      string        `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
 	Queue     string        `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
@@ -428,6 +437,10 @@ type JobSchedulingInfo struct {
 	// mutations. The scheduler applies it to the pod spec when it serves a
 	// lease, so the executor receives a finished spec.
 	ResourceMutations *RetryResourceMutations `protobuf:"bytes,11,opt,name=resource_mutations,json=resourceMutations,proto3" json:"resourceMutations,omitempty"`
+	// Labels and annotations the job was submitted with. These are not used for scheduling
+	// but allow jobs to be selected for bulk operations.
+	Labels      map[string]string `protobuf:"bytes,12,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Annotations map[string]string `protobuf:"bytes,13,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *JobSchedulingInfo) Reset()         { *m = JobSchedulingInfo{} }
@@ -533,6 +546,20 @@ func (m *JobSchedulingInfo) GetResourceMutations() *RetryResourceMutations {
 	return nil
 }
 
+func (m *JobSchedulingInfo) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *JobSchedulingInfo) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
 // RetryResourceMutations is the total resource growth from a job's retry
 // mutations. At most one field is set. The zero value means no growth.
 type RetryResourceMutations struct {
@@ -853,6 +880,8 @@ func init() {
 	proto.RegisterType((*ResourceList)(nil), "schedulerobjects.ResourceList")
 	proto.RegisterMapType((map[string]*resource.Quantity)(nil), "schedulerobjects.ResourceList.ResourcesEntry")
 	proto.RegisterType((*JobSchedulingInfo)(nil), "schedulerobjects.JobSchedulingInfo")
+	proto.RegisterMapType((map[string]string)(nil), "schedulerobjects.JobSchedulingInfo.AnnotationsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "schedulerobjects.JobSchedulingInfo.LabelsEntry")
 	proto.RegisterType((*RetryResourceMutations)(nil), "schedulerobjects.RetryResourceMutations")
 	proto.RegisterType((*ObjectRequirements)(nil), "schedulerobjects.ObjectRequirements")
 	proto.RegisterType((*PodRequirements)(nil), "schedulerobjects.PodRequirements")
@@ -866,121 +895,124 @@ func init() {
 }
 
 var fileDescriptor_97dadc5fbd620721 = []byte{
-	// 1810 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6f, 0xdb, 0xc8,
-	0x15, 0x37, 0x6d, 0xd9, 0xa6, 0x46, 0xb2, 0x4d, 0x8d, 0x1d, 0x87, 0x51, 0xb2, 0xa2, 0xaa, 0xdd,
-	0x16, 0x4e, 0xff, 0x50, 0x58, 0x6f, 0x0b, 0x04, 0x29, 0xd0, 0xc2, 0x4c, 0xbc, 0x1b, 0xab, 0x59,
-	0xd9, 0xb1, 0x23, 0x14, 0x6d, 0x51, 0xb0, 0x23, 0x72, 0xa4, 0x70, 0x4d, 0xcd, 0x68, 0xc9, 0xa1,
-	0x1b, 0xde, 0x7a, 0x2d, 0x7a, 0xd9, 0x2d, 0xda, 0x4b, 0xaf, 0xfd, 0x1c, 0xbd, 0x16, 0x45, 0x4f,
-	0x39, 0xf6, 0x44, 0x14, 0xc9, 0x8d, 0x9f, 0x62, 0x31, 0x43, 0x52, 0x1a, 0xfd, 0x71, 0xec, 0x4b,
-	0x4e, 0x12, 0x7f, 0xef, 0xbd, 0xdf, 0x9b, 0x79, 0xf3, 0xe6, 0xbd, 0x47, 0x82, 0xc7, 0x1e, 0x61,
-	0x38, 0x20, 0xc8, 0x6f, 0x87, 0xce, 0x2b, 0xec, 0x46, 0x3e, 0x0e, 0xa6, 0xff, 0x68, 0xff, 0x2b,
-	0xec, 0xb0, 0x70, 0x01, 0x30, 0xc7, 0x01, 0x65, 0x14, 0x6a, 0xf3, 0x78, 0xdd, 0x18, 0x52, 0x3a,
-	0xf4, 0x71, 0x5b, 0xc8, 0xfb, 0xd1, 0xa0, 0xcd, 0xbc, 0x11, 0x0e, 0x19, 0x1a, 0x8d, 0x33, 0x93,
-	0x7a, 0xeb, 0xf2, 0x51, 0x68, 0x7a, 0xb4, 0x8d, 0xc6, 0x5e, 0xdb, 0xa1, 0x01, 0x6e, 0x5f, 0x7d,
-	0xda, 0x1e, 0x62, 0x82, 0x03, 0xc4, 0xb0, 0x9b, 0xeb, 0xfc, 0x74, 0xaa, 0x33, 0x42, 0xce, 0x2b,
-	0x8f, 0xe0, 0x20, 0x6e, 0x8f, 0x2f, 0x87, 0xc2, 0x28, 0xc0, 0x21, 0x8d, 0x02, 0x07, 0xcf, 0x5b,
-	0xb5, 0xde, 0xae, 0x02, 0xf5, 0xf8, 0x35, 0x76, 0x22, 0x46, 0x03, 0xd8, 0x04, 0xab, 0x9e, 0xab,
-	0x2b, 0x4d, 0xe5, 0xa0, 0x6c, 0x69, 0x69, 0x62, 0x54, 0x3d, 0xf7, 0xc7, 0x74, 0xe4, 0x31, 0x3c,
-	0x1a, 0xb3, 0xf8, 0x7c, 0xd5, 0x73, 0xe1, 0x0f, 0x40, 0x69, 0x4c, 0xa9, 0xaf, 0xaf, 0x0a, 0x1d,
-	0x98, 0x26, 0xc6, 0x36, 0x7f, 0x96, 0xb4, 0x84, 0x1c, 0x1e, 0x81, 0x75, 0x42, 0x5d, 0x1c, 0xea,
-	0x6b, 0xcd, 0xb5, 0x83, 0xca, 0xe1, 0xbe, 0xb9, 0x10, 0x8b, 0x2e, 0x75, 0xb1, 0xb5, 0x9b, 0x26,
-	0xc6, 0x8e, 0x50, 0x94, 0x18, 0x32, 0x4b, 0xf8, 0x07, 0xb0, 0xed, 0xa3, 0x90, 0xf5, 0xc6, 0x2e,
-	0x62, 0xf8, 0xa5, 0x37, 0xc2, 0xfa, 0x7a, 0x53, 0x39, 0xa8, 0x1c, 0xd6, 0xcd, 0x2c, 0x5a, 0x66,
-	0x11, 0x2d, 0xf3, 0x65, 0x11, 0x2d, 0xeb, 0x41, 0x9a, 0x18, 0xfa, 0xac, 0x95, 0x44, 0x3c, 0xc7,
-	0x07, 0x4f, 0xc1, 0x6e, 0x44, 0x50, 0x18, 0x7a, 0x43, 0x82, 0x5d, 0xfb, 0x2b, 0xda, 0xb7, 0x83,
-	0x88, 0x84, 0x7a, 0xb9, 0xb9, 0x76, 0x50, 0xb6, 0x8c, 0x34, 0x31, 0xee, 0x4f, 0xc5, 0x1d, 0xda,
-	0x3f, 0x8f, 0x88, 0xbc, 0xcc, 0xda, 0x82, 0xb0, 0x53, 0x52, 0x4b, 0xda, 0x7a, 0xa7, 0xa4, 0x6e,
-	0x68, 0x9b, 0x9d, 0x92, 0xba, 0xa9, 0xa9, 0x9d, 0x92, 0xaa, 0x6a, 0xe5, 0xd6, 0x3f, 0xab, 0xa0,
-	0xc4, 0xf7, 0x7b, 0xbb, 0x00, 0x13, 0x34, 0xc2, 0x7a, 0x75, 0x1a, 0x60, 0xfe, 0x2c, 0x07, 0x98,
-	0x3f, 0xc3, 0x43, 0xa0, 0xe2, 0xfc, 0xd8, 0xf4, 0x5d, 0xa1, 0xbb, 0x9f, 0x26, 0x06, 0x2c, 0x30,
-	0x49, 0x7f, 0xa2, 0x07, 0x4f, 0x41, 0x99, 0x47, 0xc0, 0x0e, 0x31, 0x26, 0xe2, 0x04, 0xdf, 0x1f,
-	0x4c, 0x41, 0xc8, 0x0d, 0x2e, 0x30, 0x26, 0x32, 0x61, 0x81, 0xc1, 0x2f, 0xc0, 0x06, 0x43, 0x1e,
-	0x61, 0xa1, 0xbe, 0x2e, 0x8e, 0xf9, 0x9e, 0x99, 0xe5, 0xa0, 0x89, 0xc6, 0x9e, 0xc9, 0xf3, 0xd4,
-	0xbc, 0xfa, 0xd4, 0x7c, 0xc9, 0x35, 0xac, 0xbd, 0x34, 0x31, 0xb4, 0x4c, 0x59, 0xa2, 0xca, 0xcd,
-	0xe1, 0x19, 0xd8, 0xf0, 0x51, 0x1f, 0xfb, 0xa1, 0xbe, 0x21, 0x88, 0x5a, 0xcb, 0xf3, 0xc5, 0x7c,
-	0x2e, 0x94, 0x8e, 0x09, 0x0b, 0xe2, 0x8c, 0x31, 0xb3, 0x92, 0x19, 0x33, 0x04, 0x62, 0xb0, 0xc3,
-	0x28, 0x43, 0xbe, 0x5d, 0x64, 0x7e, 0xa8, 0x6f, 0x8a, 0x1d, 0x37, 0x16, 0xa9, 0xcf, 0x73, 0x95,
-	0xe7, 0x5e, 0xc8, 0xb2, 0x14, 0x12, 0xa6, 0x05, 0x2c, 0xd3, 0x6f, 0xcf, 0x4a, 0xe0, 0x6b, 0xb0,
-	0x1b, 0x32, 0xc4, 0xb0, 0xdd, 0x8f, 0x8b, 0x04, 0xb2, 0x3d, 0x57, 0xa4, 0x50, 0xe5, 0xf0, 0x47,
-	0xd7, 0xec, 0xe2, 0x82, 0x5b, 0x58, 0x71, 0x96, 0x35, 0x27, 0x6e, 0xb6, 0x9d, 0x8f, 0xd2, 0xc4,
-	0xb8, 0x17, 0xce, 0x4a, 0x24, 0xc7, 0x3b, 0x73, 0x22, 0xf8, 0xad, 0x02, 0xee, 0x46, 0x04, 0xf9,
-	0x3e, 0x75, 0x10, 0x43, 0x7d, 0x1f, 0x4b, 0x3b, 0xdd, 0x12, 0xee, 0x0f, 0xaf, 0x71, 0xdf, 0x93,
-	0xad, 0x26, 0x5b, 0xc9, 0x56, 0xf1, 0x49, 0x9a, 0x18, 0xcd, 0x68, 0xa9, 0x82, 0xb4, 0x98, 0xfd,
-	0xe5, 0x1a, 0xf0, 0x08, 0x6c, 0x45, 0x24, 0x77, 0xca, 0x25, 0xfa, 0x4e, 0x53, 0x39, 0x50, 0xad,
-	0xfb, 0x69, 0x62, 0xdc, 0x9d, 0x11, 0x48, 0x5c, 0xb3, 0x16, 0xfc, 0x4e, 0x06, 0x78, 0x4c, 0x03,
-	0xe6, 0x91, 0xa1, 0xcd, 0x0b, 0x81, 0xcd, 0xe2, 0x31, 0xd6, 0x6b, 0x22, 0xc5, 0xc5, 0x9d, 0x9c,
-	0x88, 0xf9, 0x66, 0x5e, 0xc6, 0x63, 0x99, 0xac, 0xb6, 0x20, 0x9c, 0x54, 0x2c, 0x78, 0x43, 0xc5,
-	0xfa, 0xbb, 0x02, 0x9a, 0x45, 0x04, 0xed, 0x28, 0x44, 0x43, 0x71, 0xa6, 0x5f, 0x47, 0x38, 0xc2,
-	0x36, 0x22, 0xae, 0x2d, 0x48, 0xf6, 0x44, 0x60, 0x3f, 0x5e, 0x0c, 0xec, 0x19, 0xa5, 0xfe, 0x0b,
-	0xae, 0x5b, 0x04, 0xc3, 0x7a, 0x98, 0x26, 0xc6, 0xf7, 0x0b, 0xc2, 0x1e, 0xe7, 0xb3, 0x62, 0xa1,
-	0x71, 0x44, 0xdc, 0xb3, 0xd9, 0x05, 0xdc, 0x7f, 0x8f, 0x1a, 0xfc, 0x39, 0xa8, 0x04, 0x38, 0xc4,
-	0xc1, 0x15, 0x62, 0x1e, 0x25, 0xfa, 0x1d, 0xb1, 0x8d, 0x7b, 0x69, 0x62, 0xdc, 0x91, 0x60, 0x89,
-	0x4c, 0xd6, 0xae, 0x23, 0x50, 0x91, 0xae, 0x0c, 0xfc, 0x18, 0xac, 0x5d, 0xe2, 0x38, 0xaf, 0x3f,
-	0xb5, 0x34, 0x31, 0xb6, 0x2e, 0x71, 0x2c, 0xd9, 0x72, 0x29, 0x7c, 0x08, 0xd6, 0xaf, 0x90, 0x1f,
-	0xe1, 0xbc, 0xc6, 0x8b, 0x12, 0x2d, 0x00, 0xb9, 0x44, 0x0b, 0xe0, 0xf1, 0xea, 0x23, 0xa5, 0xfe,
-	0x67, 0x05, 0xec, 0x2d, 0x4b, 0xe8, 0xdb, 0x39, 0x7b, 0x26, 0x3b, 0xdb, 0x3e, 0xfc, 0x68, 0x31,
-	0xb2, 0x19, 0x69, 0xe6, 0xe1, 0xa6, 0xb5, 0x7c, 0xab, 0x80, 0xfb, 0xef, 0xc9, 0x6e, 0x79, 0x49,
-	0xeb, 0xd7, 0x2e, 0xe9, 0x44, 0x5e, 0xd2, 0xcd, 0xf5, 0xe2, 0x86, 0x35, 0x75, 0x4a, 0xea, 0x9a,
-	0x56, 0x9a, 0x74, 0x06, 0x55, 0x2b, 0x77, 0x4a, 0x2a, 0xd0, 0x2a, 0x9d, 0x92, 0x5a, 0xd1, 0xaa,
-	0x9d, 0x92, 0xba, 0xad, 0xed, 0x74, 0x4a, 0xaa, 0xa6, 0xd5, 0x5a, 0xff, 0x52, 0x40, 0x6d, 0x21,
-	0x8f, 0x26, 0xf9, 0xab, 0xdc, 0x90, 0xbf, 0x0f, 0xc1, 0xba, 0x48, 0x56, 0xf9, 0xd8, 0x04, 0x20,
-	0x2f, 0x4b, 0x00, 0xb0, 0x07, 0xca, 0xd3, 0x5a, 0xb1, 0x76, 0xab, 0x5d, 0xde, 0x4d, 0x13, 0x63,
-	0x37, 0x58, 0x52, 0x0a, 0xa6, 0x4c, 0xad, 0xbf, 0xac, 0x82, 0xaa, 0x6c, 0x04, 0x5d, 0xd9, 0x8f,
-	0x22, 0xae, 0xce, 0x4f, 0xde, 0xef, 0xc7, 0x9c, 0x2b, 0x47, 0xb7, 0x70, 0x5b, 0xff, 0x9b, 0x02,
-	0xb6, 0xaf, 0x3f, 0xe7, 0xeb, 0x53, 0xef, 0x37, 0xb3, 0xe7, 0x6c, 0x4a, 0xbd, 0x6b, 0x32, 0x3f,
-	0x99, 0xe3, 0xcb, 0xa1, 0x68, 0x66, 0x85, 0x3b, 0xf3, 0x45, 0x84, 0x08, 0xf3, 0x58, 0x7c, 0xd3,
-	0xb9, 0xb7, 0xbe, 0xd9, 0x00, 0xb5, 0x0e, 0xed, 0x5f, 0x64, 0xdb, 0xf5, 0xc8, 0xf0, 0x84, 0x0c,
-	0x28, 0x6f, 0xdb, 0xbe, 0x37, 0xc0, 0x7c, 0xbe, 0x13, 0xcb, 0xdb, 0xca, 0xbb, 0x6c, 0x8e, 0xcd,
-	0x74, 0xd9, 0x1c, 0x83, 0x8f, 0x41, 0x15, 0x31, 0x7b, 0x44, 0x43, 0x66, 0x53, 0xe2, 0x64, 0xeb,
-	0x55, 0x2d, 0x3d, 0x4d, 0x8c, 0x3d, 0xc4, 0xbe, 0xa4, 0x21, 0x3b, 0x25, 0x8e, 0x6c, 0x09, 0xa6,
-	0x28, 0xaf, 0x1e, 0xe3, 0x00, 0x73, 0xdc, 0xe3, 0xf5, 0x78, 0x4d, 0x98, 0x8a, 0xea, 0x21, 0xc1,
-	0x72, 0xf5, 0x90, 0x60, 0xf8, 0x0c, 0x68, 0x0e, 0x25, 0x4e, 0x14, 0x04, 0x98, 0x38, 0xb1, 0x1d,
-	0xa2, 0x01, 0xd6, 0x4b, 0x82, 0x41, 0x34, 0x2b, 0x49, 0x76, 0x81, 0x06, 0x32, 0xcb, 0xce, 0x9c,
-	0x88, 0x57, 0xf5, 0x71, 0xe0, 0xd1, 0xc0, 0x63, 0xb1, 0xed, 0xf8, 0x28, 0x0c, 0x6d, 0x31, 0xe4,
-	0x6c, 0x4c, 0xab, 0x7a, 0x21, 0x7e, 0xc2, 0xa5, 0xdd, 0xd9, 0x89, 0xa7, 0xb6, 0x20, 0x84, 0x3d,
-	0x50, 0x09, 0xa3, 0xfe, 0xc8, 0x63, 0xb6, 0x08, 0xe5, 0xe6, 0x8d, 0xc3, 0x8c, 0x08, 0x57, 0x66,
-	0x32, 0x37, 0x15, 0x82, 0x29, 0xca, 0x8f, 0xa7, 0xf0, 0xa5, 0xab, 0xd3, 0xe3, 0x29, 0x30, 0xf9,
-	0x78, 0x0a, 0x0c, 0xfe, 0x11, 0xec, 0x66, 0xa9, 0x6c, 0x07, 0xf8, 0xeb, 0xc8, 0x0b, 0xf0, 0x08,
-	0x4f, 0x27, 0xa2, 0x4f, 0x16, 0xf3, 0xfd, 0x54, 0xfc, 0x9e, 0x4b, 0xba, 0x56, 0x33, 0x4d, 0x8c,
-	0x07, 0x74, 0x01, 0x97, 0xdc, 0xc1, 0x45, 0x29, 0x6c, 0x83, 0xcd, 0x2b, 0x1c, 0x84, 0xbc, 0x2b,
-	0x94, 0xc5, 0x5a, 0xef, 0xa4, 0x89, 0x51, 0xcb, 0x21, 0xc9, 0xb6, 0xd0, 0x82, 0xaf, 0x01, 0x9c,
-	0x74, 0xb8, 0x51, 0xc4, 0x44, 0x8b, 0x08, 0xf5, 0x8a, 0x88, 0xdd, 0xc1, 0xb2, 0x8b, 0xc9, 0x82,
-	0xb8, 0xb8, 0x59, 0x5f, 0x16, 0xfa, 0x45, 0x13, 0x9e, 0x83, 0x67, 0x9b, 0xf0, 0x9c, 0x30, 0x2b,
-	0x79, 0xad, 0x7f, 0x28, 0x60, 0x7f, 0x39, 0x29, 0xfc, 0x25, 0xd8, 0x1a, 0xe1, 0x11, 0x0d, 0x62,
-	0x7b, 0x80, 0x1c, 0x3e, 0xd3, 0xf2, 0xcb, 0xa1, 0x58, 0xf5, 0x34, 0x31, 0xf6, 0x33, 0xc1, 0xe7,
-	0x02, 0x97, 0xdc, 0x54, 0x65, 0x5c, 0x22, 0xe0, 0x83, 0x92, 0xe7, 0xe4, 0x65, 0x50, 0x22, 0xb8,
-	0x10, 0xf8, 0x22, 0x41, 0x86, 0xb7, 0xfe, 0xaa, 0x00, 0xb8, 0x78, 0x34, 0xd0, 0x07, 0x3b, 0x63,
-	0xea, 0xca, 0x90, 0x58, 0x5a, 0xe5, 0xf0, 0x7b, 0xcb, 0x86, 0x80, 0x19, 0xc5, 0xec, 0x96, 0xcc,
-	0x59, 0x4f, 0xfd, 0x3f, 0x5b, 0x39, 0x9f, 0xa7, 0xb6, 0xb6, 0x41, 0x55, 0x4e, 0xa2, 0xd6, 0xbf,
-	0x37, 0xc1, 0xce, 0x1c, 0x2b, 0x0c, 0x41, 0x95, 0xcf, 0x45, 0x17, 0xd8, 0xc7, 0x79, 0xa4, 0x78,
-	0xa2, 0x7d, 0x76, 0xe3, 0x72, 0xc4, 0xf0, 0x57, 0x58, 0x65, 0xe5, 0x55, 0x44, 0x47, 0x26, 0x93,
-	0xa3, 0x23, 0xe3, 0xf0, 0x0c, 0xa8, 0x68, 0x30, 0xf0, 0x08, 0xbf, 0x18, 0x59, 0xbd, 0x7c, 0xb0,
-	0x6c, 0xd6, 0x3f, 0xca, 0x75, 0xb2, 0x6b, 0x53, 0x58, 0xc8, 0xd7, 0xa6, 0xc0, 0xe0, 0xef, 0x40,
-	0x85, 0x51, 0x9f, 0xbf, 0x8b, 0x8a, 0x2c, 0xcc, 0xde, 0x13, 0x1b, 0x4b, 0x5f, 0x20, 0x26, 0x6a,
-	0x59, 0xe5, 0x92, 0xcc, 0xe4, 0xca, 0x25, 0xc1, 0x90, 0x82, 0x0a, 0x22, 0x84, 0x16, 0x29, 0xbe,
-	0x79, 0xdd, 0x3c, 0x3c, 0x1f, 0xa2, 0xa3, 0xa9, 0x51, 0x16, 0x21, 0xe1, 0x50, 0xa2, 0x92, 0x1d,
-	0x4a, 0x30, 0xec, 0x00, 0xad, 0xa8, 0x9c, 0x94, 0x9c, 0x51, 0xdf, 0x73, 0x62, 0xf1, 0xba, 0x5a,
-	0xb6, 0x1a, 0x69, 0x62, 0xd4, 0xe7, 0x65, 0x12, 0xcd, 0x82, 0x1d, 0xfc, 0x93, 0x02, 0xf6, 0x8a,
-	0x2b, 0x34, 0x93, 0x78, 0x1b, 0xf9, 0x4d, 0x5d, 0x12, 0xa3, 0xf3, 0x25, 0xfa, 0x56, 0x2b, 0x4d,
-	0x8c, 0xc6, 0x32, 0x26, 0xc9, 0xfd, 0x52, 0x4f, 0xd7, 0x54, 0x8a, 0xf2, 0x87, 0xaf, 0x14, 0xf5,
-	0x21, 0xa8, 0x2d, 0xe4, 0xe9, 0x07, 0x99, 0x5b, 0x07, 0x40, 0x9b, 0x3f, 0xed, 0x0f, 0xe1, 0x27,
-	0xff, 0x02, 0xf0, 0xdf, 0x55, 0xa0, 0x15, 0x9f, 0x59, 0x2e, 0x30, 0xe3, 0x6f, 0x28, 0x21, 0x7c,
-	0x04, 0x40, 0xf1, 0x6e, 0x7e, 0x52, 0x7c, 0x15, 0x10, 0x7d, 0x6a, 0x8a, 0xca, 0x7d, 0x6a, 0x8a,
-	0xf2, 0x3e, 0xe5, 0xd0, 0xc0, 0xa5, 0x04, 0xbb, 0xf9, 0x38, 0x20, 0x2e, 0x5c, 0x81, 0xc9, 0x17,
-	0xae, 0xc0, 0xe0, 0x2f, 0x40, 0x35, 0xfb, 0x7f, 0x8e, 0x51, 0x48, 0x89, 0x98, 0x05, 0xf2, 0x02,
-	0x29, 0xe3, 0x72, 0x09, 0x90, 0x71, 0xf8, 0x33, 0x50, 0x0e, 0x31, 0xb3, 0xe2, 0x5e, 0x88, 0x03,
-	0x31, 0x06, 0x94, 0xb3, 0xf1, 0x6c, 0x02, 0xca, 0xe3, 0xd9, 0x04, 0x84, 0x2f, 0x84, 0xd9, 0x11,
-	0xbb, 0xe5, 0x17, 0x9c, 0x82, 0xf2, 0x68, 0xbe, 0x4d, 0x4f, 0x59, 0x7e, 0x78, 0x0a, 0x2a, 0xd2,
-	0x5b, 0x01, 0xac, 0x80, 0xcd, 0x5e, 0xf7, 0x57, 0xdd, 0xd3, 0x5f, 0x77, 0xb5, 0x15, 0xfe, 0x70,
-	0x76, 0xdc, 0x7d, 0x7a, 0xd2, 0xfd, 0x42, 0x53, 0xf8, 0xc3, 0x79, 0xaf, 0xdb, 0xe5, 0x0f, 0xab,
-	0x70, 0x0b, 0x94, 0x2f, 0x7a, 0x4f, 0x9e, 0x1c, 0x1f, 0x3f, 0x3d, 0x7e, 0xaa, 0xad, 0x41, 0x00,
-	0x36, 0x3e, 0x3f, 0x3a, 0x79, 0x7e, 0xfc, 0x54, 0x2b, 0x59, 0xbf, 0xff, 0xcf, 0xdb, 0x86, 0xf2,
-	0xe6, 0x6d, 0x43, 0xf9, 0xff, 0xdb, 0x86, 0xf2, 0xcd, 0xbb, 0xc6, 0xca, 0x9b, 0x77, 0x8d, 0x95,
-	0xff, 0xbd, 0x6b, 0xac, 0xfc, 0xf6, 0xc9, 0xd0, 0x63, 0xaf, 0xa2, 0xbe, 0xe9, 0xd0, 0x51, 0x1b,
-	0x05, 0x23, 0xe4, 0xa2, 0x71, 0x40, 0x79, 0xd2, 0xe7, 0x4f, 0xed, 0x5b, 0x7c, 0x07, 0xec, 0x6f,
-	0x88, 0x7d, 0x7e, 0xf6, 0x5d, 0x00, 0x00, 0x00, 0xff, 0xff, 0x73, 0x61, 0x82, 0x2b, 0x35, 0x14,
-	0x00, 0x00,
+	// 1863 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4d, 0x6f, 0xdb, 0xc8,
+	0x19, 0x36, 0x25, 0xd9, 0xa6, 0x46, 0xb2, 0x4d, 0x8d, 0x1d, 0x87, 0x51, 0xb2, 0xa2, 0xaa, 0xdd,
+	0x16, 0x4e, 0x3f, 0x28, 0xac, 0x77, 0x0b, 0x04, 0x29, 0xd0, 0xc2, 0x4a, 0xbc, 0x1b, 0xab, 0x59,
+	0xd9, 0xb1, 0x23, 0x14, 0x6d, 0xb1, 0x60, 0x47, 0xe4, 0x48, 0xe6, 0x9a, 0xe2, 0x28, 0xe4, 0xd0,
+	0x8d, 0x6e, 0xbd, 0x16, 0xbd, 0x74, 0x8b, 0xb6, 0x87, 0xfe, 0x87, 0xfe, 0x84, 0x5e, 0x8b, 0xa2,
+	0xa7, 0x3d, 0xf6, 0x44, 0x14, 0xc9, 0x8d, 0xbf, 0xa2, 0x98, 0x21, 0x29, 0x8e, 0x44, 0x39, 0x72,
+	0x0b, 0x6c, 0x81, 0x3d, 0x49, 0x7c, 0xde, 0xcf, 0x79, 0xe7, 0xfd, 0x22, 0xc1, 0x63, 0xdb, 0xa5,
+	0xd8, 0x73, 0x91, 0xd3, 0xf6, 0xcd, 0x4b, 0x6c, 0x05, 0x0e, 0xf6, 0xb2, 0x7f, 0x64, 0xf0, 0x05,
+	0x36, 0xa9, 0x9f, 0x03, 0xf4, 0x89, 0x47, 0x28, 0x81, 0xca, 0x22, 0x5e, 0xd7, 0x46, 0x84, 0x8c,
+	0x1c, 0xdc, 0xe6, 0xf4, 0x41, 0x30, 0x6c, 0x53, 0x7b, 0x8c, 0x7d, 0x8a, 0xc6, 0x93, 0x58, 0xa4,
+	0xde, 0xba, 0x7a, 0xe4, 0xeb, 0x36, 0x69, 0xa3, 0x89, 0xdd, 0x36, 0x89, 0x87, 0xdb, 0xd7, 0x1f,
+	0xb6, 0x47, 0xd8, 0xc5, 0x1e, 0xa2, 0xd8, 0x4a, 0x78, 0x3e, 0xce, 0x78, 0xc6, 0xc8, 0xbc, 0xb4,
+	0x5d, 0xec, 0x4d, 0xdb, 0x93, 0xab, 0x11, 0x17, 0xf2, 0xb0, 0x4f, 0x02, 0xcf, 0xc4, 0x8b, 0x52,
+	0xad, 0x37, 0x05, 0x20, 0x1f, 0xbf, 0xc6, 0x66, 0x40, 0x89, 0x07, 0x9b, 0xa0, 0x60, 0x5b, 0xaa,
+	0xd4, 0x94, 0x0e, 0xca, 0x1d, 0x25, 0x0a, 0xb5, 0xaa, 0x6d, 0x7d, 0x9f, 0x8c, 0x6d, 0x8a, 0xc7,
+	0x13, 0x3a, 0x3d, 0x2f, 0xd8, 0x16, 0xfc, 0x0e, 0x28, 0x4d, 0x08, 0x71, 0xd4, 0x02, 0xe7, 0x81,
+	0x51, 0xa8, 0x6d, 0xb3, 0x67, 0x81, 0x8b, 0xd3, 0xe1, 0x11, 0x58, 0x77, 0x89, 0x85, 0x7d, 0xb5,
+	0xd8, 0x2c, 0x1e, 0x54, 0x0e, 0xf7, 0xf5, 0x5c, 0x2c, 0x7a, 0xc4, 0xc2, 0x9d, 0xdd, 0x28, 0xd4,
+	0x76, 0x38, 0xa3, 0xa0, 0x21, 0x96, 0x84, 0xbf, 0x02, 0xdb, 0x0e, 0xf2, 0x69, 0x7f, 0x62, 0x21,
+	0x8a, 0x5f, 0xda, 0x63, 0xac, 0xae, 0x37, 0xa5, 0x83, 0xca, 0x61, 0x5d, 0x8f, 0xa3, 0xa5, 0xa7,
+	0xd1, 0xd2, 0x5f, 0xa6, 0xd1, 0xea, 0x3c, 0x88, 0x42, 0x4d, 0x9d, 0x97, 0x12, 0x14, 0x2f, 0xe8,
+	0x83, 0xa7, 0x60, 0x37, 0x70, 0x91, 0xef, 0xdb, 0x23, 0x17, 0x5b, 0xc6, 0x17, 0x64, 0x60, 0x78,
+	0x81, 0xeb, 0xab, 0xe5, 0x66, 0xf1, 0xa0, 0xdc, 0xd1, 0xa2, 0x50, 0xbb, 0x9f, 0x91, 0xbb, 0x64,
+	0x70, 0x1e, 0xb8, 0xa2, 0x9b, 0xb5, 0x1c, 0xb1, 0x5b, 0x92, 0x4b, 0xca, 0x7a, 0xb7, 0x24, 0x6f,
+	0x28, 0x9b, 0xdd, 0x92, 0xbc, 0xa9, 0xc8, 0xdd, 0x92, 0x2c, 0x2b, 0xe5, 0xd6, 0x9f, 0xb7, 0x40,
+	0x89, 0x9d, 0xf7, 0x76, 0x01, 0x76, 0xd1, 0x18, 0xab, 0xd5, 0x2c, 0xc0, 0xec, 0x59, 0x0c, 0x30,
+	0x7b, 0x86, 0x87, 0x40, 0xc6, 0xc9, 0xb5, 0xa9, 0xbb, 0x9c, 0x77, 0x3f, 0x0a, 0x35, 0x98, 0x62,
+	0x02, 0xff, 0x8c, 0x0f, 0x9e, 0x82, 0x32, 0x8b, 0x80, 0xe1, 0x63, 0xec, 0xaa, 0x85, 0x95, 0xc1,
+	0xe4, 0x0a, 0x99, 0xc0, 0x05, 0xc6, 0xae, 0xa8, 0x30, 0xc5, 0xe0, 0xa7, 0x60, 0x83, 0x22, 0xdb,
+	0xa5, 0xbe, 0xba, 0xce, 0xaf, 0xf9, 0x9e, 0x1e, 0xe7, 0xa0, 0x8e, 0x26, 0xb6, 0xce, 0xf2, 0x54,
+	0xbf, 0xfe, 0x50, 0x7f, 0xc9, 0x38, 0x3a, 0x7b, 0x51, 0xa8, 0x29, 0x31, 0xb3, 0xa0, 0x2a, 0x11,
+	0x87, 0x67, 0x60, 0xc3, 0x41, 0x03, 0xec, 0xf8, 0xea, 0x06, 0x57, 0xd4, 0x5a, 0x9e, 0x2f, 0xfa,
+	0x73, 0xce, 0x74, 0xec, 0x52, 0x6f, 0x1a, 0x6b, 0x8c, 0xa5, 0x44, 0x8d, 0x31, 0x02, 0x31, 0xd8,
+	0xa1, 0x84, 0x22, 0xc7, 0x48, 0x33, 0xdf, 0x57, 0x37, 0xf9, 0x89, 0x1b, 0x79, 0xd5, 0xe7, 0x09,
+	0xcb, 0x73, 0xdb, 0xa7, 0x71, 0x0a, 0x71, 0xd1, 0x14, 0x16, 0xd5, 0x6f, 0xcf, 0x53, 0xe0, 0x6b,
+	0xb0, 0xeb, 0x53, 0x44, 0xb1, 0x31, 0x98, 0xa6, 0x09, 0x64, 0xd8, 0x16, 0x4f, 0xa1, 0xca, 0xe1,
+	0xf7, 0x6e, 0x38, 0xc5, 0x05, 0x93, 0xe8, 0x4c, 0xe3, 0xac, 0x39, 0xb1, 0xe2, 0xe3, 0xbc, 0x17,
+	0x85, 0xda, 0x3d, 0x7f, 0x9e, 0x22, 0x18, 0xde, 0x59, 0x20, 0xc1, 0x2f, 0x25, 0x70, 0x37, 0x70,
+	0x91, 0xe3, 0x10, 0x13, 0x51, 0x34, 0x70, 0xb0, 0x70, 0xd2, 0x2d, 0x6e, 0xfe, 0xf0, 0x06, 0xf3,
+	0x7d, 0x51, 0x6a, 0x76, 0x94, 0xd8, 0x8b, 0x0f, 0xa2, 0x50, 0x6b, 0x06, 0x4b, 0x19, 0x04, 0x67,
+	0xf6, 0x97, 0x73, 0xc0, 0x23, 0xb0, 0x15, 0xb8, 0x89, 0x51, 0x46, 0x51, 0x77, 0x9a, 0xd2, 0x81,
+	0xdc, 0xb9, 0x1f, 0x85, 0xda, 0xdd, 0x39, 0x82, 0xa0, 0x6b, 0x5e, 0x82, 0xd5, 0xa4, 0x87, 0x27,
+	0xc4, 0xa3, 0xb6, 0x3b, 0x32, 0x58, 0x23, 0x30, 0xe8, 0x74, 0x82, 0xd5, 0x5a, 0x53, 0x4a, 0x6b,
+	0x72, 0x46, 0x66, 0x87, 0x79, 0x39, 0x9d, 0x88, 0xca, 0x6a, 0x39, 0xe2, 0xac, 0x63, 0xc1, 0x15,
+	0x1d, 0xeb, 0x4f, 0x12, 0x68, 0xa6, 0x11, 0x34, 0x02, 0x1f, 0x8d, 0xf8, 0x9d, 0xbe, 0x0a, 0x70,
+	0x80, 0x0d, 0xe4, 0x5a, 0x06, 0x57, 0xb2, 0xc7, 0x03, 0xfb, 0x7e, 0x3e, 0xb0, 0x67, 0x84, 0x38,
+	0x2f, 0x18, 0x6f, 0x1a, 0x8c, 0xce, 0xc3, 0x28, 0xd4, 0xbe, 0x9d, 0x2a, 0xec, 0x33, 0x7d, 0x9d,
+	0x29, 0xe7, 0x38, 0x72, 0xad, 0xb3, 0x79, 0x07, 0xee, 0xbf, 0x83, 0x0d, 0xfe, 0x08, 0x54, 0x3c,
+	0xec, 0x63, 0xef, 0x1a, 0x51, 0x9b, 0xb8, 0xea, 0x1d, 0x7e, 0x8c, 0x7b, 0x51, 0xa8, 0xdd, 0x11,
+	0x60, 0x41, 0x99, 0xc8, 0x0d, 0x5f, 0xb1, 0x0e, 0x77, 0x89, 0x91, 0x43, 0x2f, 0xa7, 0x42, 0x7e,
+	0xec, 0xdf, 0xaa, 0x12, 0x9a, 0x51, 0xa8, 0x3d, 0x98, 0x89, 0x2f, 0xcb, 0x03, 0x98, 0xa7, 0xd6,
+	0x11, 0xa8, 0x08, 0x55, 0x0a, 0xdf, 0x07, 0xc5, 0x2b, 0x3c, 0x4d, 0x5a, 0x5e, 0x2d, 0x0a, 0xb5,
+	0xad, 0x2b, 0x3c, 0x15, 0x54, 0x30, 0x2a, 0x7c, 0x08, 0xd6, 0xaf, 0x91, 0x13, 0xe0, 0x64, 0xac,
+	0xf0, 0xa9, 0xc0, 0x01, 0x71, 0x2a, 0x70, 0xe0, 0x71, 0xe1, 0x91, 0x54, 0xff, 0xad, 0x04, 0xf6,
+	0x96, 0xd5, 0xd0, 0xed, 0x8c, 0x3d, 0x13, 0x8d, 0x6d, 0x1f, 0xbe, 0x97, 0x8f, 0x42, 0xac, 0x34,
+	0xb6, 0xb0, 0xca, 0x97, 0x2f, 0x25, 0x70, 0xff, 0x1d, 0x05, 0x25, 0xba, 0xb4, 0x7e, 0xa3, 0x4b,
+	0x27, 0xa2, 0x4b, 0xab, 0x2f, 0x66, 0x85, 0x4f, 0xdd, 0x92, 0x5c, 0x54, 0x4a, 0xb3, 0x61, 0x24,
+	0x2b, 0xe5, 0x6e, 0x49, 0x06, 0x4a, 0xa5, 0x5b, 0x92, 0x2b, 0x4a, 0xb5, 0x5b, 0x92, 0xb7, 0x95,
+	0x9d, 0x6e, 0x49, 0x56, 0x94, 0x5a, 0xeb, 0x6f, 0x12, 0xa8, 0xe5, 0x52, 0x77, 0x56, 0x32, 0xd2,
+	0x8a, 0x92, 0x79, 0x08, 0xd6, 0x79, 0x7d, 0x88, 0xd7, 0xc6, 0x01, 0xd1, 0x2d, 0x0e, 0xc0, 0x3e,
+	0x28, 0x67, 0xe9, 0x57, 0xbc, 0xd5, 0x29, 0xef, 0x46, 0xa1, 0xb6, 0xeb, 0x2d, 0xc9, 0xba, 0x4c,
+	0x53, 0xeb, 0x77, 0x05, 0x50, 0x15, 0x85, 0xa0, 0x25, 0xda, 0x91, 0x78, 0xb5, 0xfe, 0xe0, 0xdd,
+	0x76, 0xf4, 0x85, 0x0e, 0x78, 0x0b, 0xb3, 0xf5, 0x3f, 0x4a, 0x60, 0xfb, 0xe6, 0x7b, 0xbe, 0x39,
+	0xf5, 0x7e, 0x3e, 0x7f, 0xcf, 0xba, 0x30, 0x2e, 0x67, 0x2b, 0x9b, 0x3e, 0xb9, 0x1a, 0x31, 0x40,
+	0x4f, 0xcd, 0xe9, 0x2f, 0x02, 0xe4, 0x52, 0x9b, 0x4e, 0x57, 0xdd, 0x7b, 0xeb, 0xaf, 0x65, 0x50,
+	0xeb, 0x92, 0xc1, 0x45, 0x7c, 0x5c, 0xdb, 0x1d, 0x9d, 0xb8, 0x43, 0xc2, 0x36, 0x05, 0xc7, 0x1e,
+	0x62, 0xca, 0x36, 0x28, 0xe6, 0xde, 0x56, 0x32, 0xd8, 0x13, 0x6c, 0x6e, 0xb0, 0x27, 0x18, 0x7c,
+	0x0c, 0xaa, 0x88, 0x1a, 0x63, 0xe2, 0x53, 0x83, 0xb8, 0x66, 0xec, 0xaf, 0xdc, 0x51, 0xa3, 0x50,
+	0xdb, 0x43, 0xf4, 0x33, 0xe2, 0xd3, 0x53, 0xd7, 0x14, 0x25, 0x41, 0x86, 0xb2, 0x86, 0x35, 0xf1,
+	0x30, 0xc3, 0x6d, 0x36, 0x02, 0x8a, 0x5c, 0x94, 0x37, 0x2c, 0x01, 0x16, 0x1b, 0x96, 0x00, 0xc3,
+	0x67, 0x40, 0x31, 0x89, 0x6b, 0x06, 0x9e, 0x87, 0x5d, 0x73, 0x6a, 0xf8, 0x68, 0x88, 0xd5, 0x12,
+	0xd7, 0xc0, 0xe7, 0xa3, 0x40, 0xbb, 0x40, 0x43, 0x51, 0xcb, 0xce, 0x02, 0x89, 0x0d, 0x92, 0x89,
+	0x67, 0x13, 0xcf, 0xa6, 0x53, 0xc3, 0x74, 0x90, 0xef, 0x1b, 0x7c, 0xaf, 0xda, 0xc8, 0x06, 0x49,
+	0x4a, 0x7e, 0xc2, 0xa8, 0xbd, 0xf9, 0x25, 0xab, 0x96, 0x23, 0xc2, 0x3e, 0xa8, 0xf8, 0xc1, 0x60,
+	0x6c, 0x53, 0x83, 0x87, 0x72, 0x73, 0xe5, 0xfe, 0xc4, 0xc3, 0x15, 0x8b, 0x2c, 0x2c, 0xa2, 0x20,
+	0x43, 0xd9, 0xf5, 0xa4, 0xb6, 0x54, 0x39, 0xbb, 0x9e, 0x14, 0x13, 0xaf, 0x27, 0xc5, 0xe0, 0xaf,
+	0xc1, 0x6e, 0x9c, 0xca, 0x86, 0x87, 0x5f, 0x05, 0xb6, 0x87, 0xc7, 0x38, 0x5b, 0xc2, 0x3e, 0xc8,
+	0xe7, 0xfb, 0x29, 0xff, 0x3d, 0x17, 0x78, 0xe3, 0xe6, 0x4e, 0x72, 0xb8, 0xd8, 0xdc, 0xf3, 0x54,
+	0xd8, 0x06, 0x9b, 0xd7, 0xd8, 0xf3, 0xd9, 0x20, 0x2a, 0x73, 0x5f, 0xef, 0x44, 0xa1, 0x56, 0x4b,
+	0x20, 0x41, 0x36, 0xe5, 0x82, 0xaf, 0x01, 0x9c, 0x0d, 0xd5, 0x71, 0x40, 0xf9, 0x54, 0xf2, 0xd5,
+	0x0a, 0x8f, 0xdd, 0xc1, 0xb2, 0xc2, 0xa4, 0xde, 0x6c, 0x96, 0x7c, 0x96, 0xf2, 0xa7, 0x73, 0x7f,
+	0x01, 0x9e, 0x9f, 0xfb, 0x0b, 0x44, 0xf8, 0xf9, 0x6c, 0xa5, 0xac, 0xf2, 0xb0, 0xb4, 0x97, 0xf6,
+	0xf9, 0xf9, 0x5a, 0xf9, 0x2f, 0xf6, 0xcb, 0x57, 0xa0, 0x82, 0x5c, 0x97, 0xa4, 0x27, 0x8a, 0x37,
+	0xae, 0x8f, 0x6f, 0x63, 0xe3, 0x28, 0x13, 0x8b, 0x0d, 0xf1, 0xda, 0x10, 0x94, 0x89, 0xb5, 0x21,
+	0xc0, 0xff, 0x8f, 0xc9, 0x3a, 0x04, 0xca, 0xa2, 0x7b, 0x5f, 0x87, 0x9d, 0x78, 0x1e, 0xb5, 0xfe,
+	0x22, 0x81, 0xfd, 0xe5, 0x37, 0x0e, 0x7f, 0x02, 0xb6, 0xc6, 0x78, 0x4c, 0xbc, 0xa9, 0x31, 0x44,
+	0x26, 0x7b, 0xc7, 0x61, 0xe6, 0xa5, 0x4e, 0x3d, 0x0a, 0xb5, 0xfd, 0x98, 0xf0, 0x09, 0xc7, 0x05,
+	0xf5, 0x55, 0x11, 0x17, 0x14, 0xb0, 0xc5, 0xd9, 0x36, 0x13, 0xc7, 0x04, 0x05, 0x17, 0x1c, 0xcf,
+	0x2b, 0x88, 0xf1, 0xd6, 0x1f, 0x24, 0x00, 0xf3, 0x75, 0x03, 0x1d, 0xb0, 0x33, 0x21, 0x96, 0x08,
+	0x71, 0xd7, 0x2a, 0x87, 0xdf, 0x5a, 0xb6, 0x14, 0xce, 0x31, 0xc6, 0x2d, 0x6c, 0x41, 0x3a, 0xb3,
+	0xff, 0x6c, 0xed, 0x7c, 0x51, 0x75, 0x67, 0x1b, 0x54, 0xc5, 0x0a, 0x6f, 0xfd, 0x7d, 0x13, 0xec,
+	0x2c, 0x68, 0x85, 0x3e, 0xa8, 0xb2, 0x3d, 0xf9, 0x02, 0x3b, 0x38, 0x89, 0x14, 0x4b, 0xc5, 0x8f,
+	0x56, 0xba, 0xa3, 0xf7, 0x04, 0xa9, 0x38, 0x13, 0x79, 0x74, 0x44, 0x65, 0x62, 0x74, 0x44, 0x1c,
+	0x9e, 0x01, 0x19, 0x0d, 0x87, 0xb6, 0xcb, 0xba, 0x56, 0x3c, 0xcc, 0x1e, 0x2c, 0x7b, 0xf7, 0x3b,
+	0x4a, 0x78, 0xe2, 0x9e, 0x96, 0x4a, 0x88, 0x3d, 0x2d, 0xc5, 0xe0, 0x2f, 0x41, 0x85, 0x12, 0x07,
+	0x7b, 0x49, 0x41, 0xc5, 0xdf, 0x0d, 0x1a, 0x4b, 0x5f, 0x28, 0x67, 0x6c, 0x71, 0xe9, 0x08, 0x62,
+	0x62, 0xe9, 0x08, 0x30, 0x24, 0xf3, 0xd5, 0xba, 0x79, 0xd3, 0xfb, 0xd1, 0x62, 0x88, 0xfe, 0xd7,
+	0x5a, 0x85, 0x5d, 0xa0, 0xa4, 0x63, 0x8d, 0xb8, 0x67, 0xc4, 0xb1, 0xcd, 0x29, 0xff, 0x7c, 0x51,
+	0xee, 0x34, 0xa2, 0x50, 0xab, 0x2f, 0xd2, 0x04, 0x35, 0x39, 0x39, 0xf8, 0x1b, 0x09, 0xec, 0xa5,
+	0xfd, 0x6d, 0x2e, 0xf1, 0x36, 0x92, 0x36, 0xba, 0x24, 0x46, 0xe7, 0x4b, 0xf8, 0x3b, 0xad, 0x28,
+	0xd4, 0x1a, 0xcb, 0x34, 0x09, 0xe6, 0x97, 0x5a, 0xba, 0xa1, 0x8d, 0x97, 0xbf, 0xfe, 0x36, 0x5e,
+	0x1f, 0x81, 0x5a, 0x2e, 0x4f, 0xbf, 0xe1, 0xad, 0x8f, 0x7d, 0x11, 0xfa, 0x67, 0x01, 0x28, 0xe9,
+	0x67, 0xb7, 0x0b, 0x4c, 0xd9, 0x1b, 0xab, 0x0f, 0x1f, 0x01, 0x90, 0x7e, 0xab, 0x39, 0x49, 0xbf,
+	0x12, 0xf1, 0x25, 0x22, 0x43, 0xc5, 0x25, 0x22, 0x43, 0xd9, 0x12, 0x61, 0x12, 0xcf, 0x22, 0x2e,
+	0xb6, 0x92, 0x5d, 0x8d, 0x17, 0x5c, 0x8a, 0x89, 0x05, 0x97, 0x62, 0xf0, 0xc7, 0xa0, 0x1a, 0xff,
+	0x3f, 0xc7, 0xc8, 0x27, 0xae, 0x5a, 0xcc, 0x1a, 0xa4, 0x88, 0x8b, 0x2d, 0x40, 0xc4, 0xe1, 0x0f,
+	0x41, 0xd9, 0xc7, 0xb4, 0x33, 0xed, 0xfb, 0xd8, 0xe3, 0x3b, 0x5a, 0x39, 0xde, 0x9d, 0x67, 0xa0,
+	0xb8, 0x3b, 0xcf, 0x40, 0xf8, 0x82, 0x8b, 0x1d, 0xd1, 0x5b, 0x7e, 0xd1, 0x4b, 0x55, 0x1e, 0x2d,
+	0xee, 0x50, 0x99, 0x96, 0xef, 0x9e, 0x82, 0x8a, 0xf0, 0xca, 0x06, 0x2b, 0x60, 0xb3, 0xdf, 0xfb,
+	0x69, 0xef, 0xf4, 0x67, 0x3d, 0x65, 0x8d, 0x3d, 0x9c, 0x1d, 0xf7, 0x9e, 0x9e, 0xf4, 0x3e, 0x55,
+	0x24, 0xf6, 0x70, 0xde, 0xef, 0xf5, 0xd8, 0x43, 0x01, 0x6e, 0x81, 0xf2, 0x45, 0xff, 0xc9, 0x93,
+	0xe3, 0xe3, 0xa7, 0xc7, 0x4f, 0x95, 0x22, 0x04, 0x60, 0xe3, 0x93, 0xa3, 0x93, 0xe7, 0xc7, 0x4f,
+	0x95, 0x52, 0xe7, 0xf3, 0x7f, 0xbc, 0x69, 0x48, 0x5f, 0xbd, 0x69, 0x48, 0xff, 0x7e, 0xd3, 0x90,
+	0x7e, 0xff, 0xb6, 0xb1, 0xf6, 0xd5, 0xdb, 0xc6, 0xda, 0xbf, 0xde, 0x36, 0xd6, 0x7e, 0xf1, 0x64,
+	0x64, 0xd3, 0xcb, 0x60, 0xa0, 0x9b, 0x64, 0xdc, 0x46, 0xde, 0x18, 0x59, 0x68, 0xe2, 0x11, 0x96,
+	0xf4, 0xc9, 0x53, 0xfb, 0x16, 0xdf, 0x85, 0x07, 0x1b, 0xfc, 0x9c, 0x1f, 0xfd, 0x67, 0x00, 0xd1,
+	0xf6, 0x77, 0x64, 0x45, 0x16, 0x00, 0x00,
 }
 
 func (m *Executor) Marshal() (dAtA []byte, err error) {
@@ -1075,6 +1107,20 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UnhealthyResources != nil {
+		{
+			size, err := m.UnhealthyResources.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSchedulerobjects(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
 	if len(m.Reservation) > 0 {
 		i -= len(m.Reservation)
 		copy(dAtA[i:], m.Reservation)
@@ -1370,6 +1416,44 @@ func (m *JobSchedulingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Annotations) > 0 {
+		for k := range m.Annotations {
+			v := m.Annotations[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSchedulerobjects(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSchedulerobjects(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSchedulerobjects(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSchedulerobjects(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSchedulerobjects(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSchedulerobjects(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if m.ResourceMutations != nil {
 		{
 			size, err := m.ResourceMutations.MarshalToSizedBuffer(dAtA[:i])
@@ -1862,6 +1946,10 @@ func (m *Node) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovSchedulerobjects(uint64(l))
 	}
+	if m.UnhealthyResources != nil {
+		l = m.UnhealthyResources.Size()
+		n += 2 + l + sovSchedulerobjects(uint64(l))
+	}
 	return n
 }
 
@@ -1950,6 +2038,22 @@ func (m *JobSchedulingInfo) Size() (n int) {
 		l = m.ResourceMutations.Size()
 		n += 1 + l + sovSchedulerobjects(uint64(l))
 	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSchedulerobjects(uint64(len(k))) + 1 + len(v) + sovSchedulerobjects(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSchedulerobjects(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSchedulerobjects(uint64(len(k))) + 1 + len(v) + sovSchedulerobjects(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSchedulerobjects(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -3026,6 +3130,42 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			}
 			m.Reservation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnhealthyResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UnhealthyResources == nil {
+				m.UnhealthyResources = &ResourceList{}
+			}
+			if err := m.UnhealthyResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedulerobjects(dAtA[iNdEx:])
@@ -3660,6 +3800,260 @@ func (m *JobSchedulingInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSchedulerobjects
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerobjects
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSchedulerobjects
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSchedulerobjects
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerobjects
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSchedulerobjects
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSchedulerobjects
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSchedulerobjects(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSchedulerobjects
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSchedulerobjects
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerobjects
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSchedulerobjects
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSchedulerobjects
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerobjects
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSchedulerobjects
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSchedulerobjects
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSchedulerobjects(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSchedulerobjects
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedulerobjects(dAtA[iNdEx:])
@@ -28,6 +28,12 @@ type Configuration struct {
 	BatchDuration time.Duration
 	// If non-nil, configures pprof profiling
 	Profiling *profilingconfig.ProfilingConfig
+	// Keys of the labels stored with each job so that jobs can be cancelled or reprioritized by selector.
+	// Other labels aren't stored by the scheduler, so can't be used in job selectors.
+	// Must match the server's submission.selectableJobLabels.
+	SelectableJobLabels []string
+	// As SelectableJobLabels, but for annotations.
+	SelectableJobAnnotations []string
 }
 
 func (c *Configuration) Mutate() (commonconfig.Config, error) {
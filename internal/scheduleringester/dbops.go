@@ -7,6 +7,7 @@ import (
 	"golang.org/x/exp/maps"
 
 	schedulerdb "github.com/armadaproject/armada/internal/scheduler/database"
+	"github.com/armadaproject/armada/pkg/armadaevents"
 	"github.com/armadaproject/armada/pkg/controlplaneevents"
 )
 
@@ -69,6 +70,20 @@ type JobInsertionMetadata struct {
 	Groups        []byte
 }
 
+// JobValidation describes the pools a job was validated against, and the version of its scheduling info that was validated.
+type JobValidation struct {
+	Pools                 []string
+	SchedulingInfoVersion int32
+}
+
+// JobMove describes moving a job from one queue and job set to another.
+type JobMove struct {
+	Queue             string
+	JobSet            string
+	DestinationQueue  string
+	DestinationJobSet string
+}
+
 type JobQueuedStateUpdate struct {
 	Queued             bool
 	QueuedStateVersion int32
@@ -107,6 +122,12 @@ type CancelOnNode struct {
 	PriorityClasses []string
 }
 
+type NodeQuarantineRelease struct {
+	Name        string
+	Executor    string
+	RequestedAt time.Time
+}
+
 type CancelOnExecutor struct {
 	Name            string
 	Queues          []string
@@ -211,6 +232,7 @@ type (
 	MarkJobsCancelled              map[string]time.Time
 	MarkJobsSucceeded              map[string]bool
 	MarkJobsFailed                 map[string]bool
+	MarkJobsSuspended              map[string]bool
 	UpdateJobSchedulingInfo        map[string]*JobSchedulingInfoUpdate
 	UpdateJobQueuedState           map[string]*JobQueuedStateUpdate
 	MarkRunsSucceeded              map[string]time.Time
@@ -219,12 +241,15 @@ type (
 	MarkRunsRunning                map[string]time.Time
 	MarkRunsPending                map[string]time.Time
 	MarkRunsPreempted              map[string]time.Time
+	MarkRunsSuspended              map[string]bool
 	InsertJobRunErrors             map[string]*schedulerdb.JobRunError
 	UpdateJobPriorities            struct {
 		key    JobReprioritiseKey
 		jobIds []string
 	}
-	MarkJobsValidated     map[string][]string
+	MarkJobsValidated     map[string]*JobValidation
+	UpdateQueuedJobs      map[string][]*armadaevents.UpdateQueuedJob
+	MoveJobs              map[string]*JobMove
 	InsertPartitionMarker struct {
 		markers []*schedulerdb.Marker
 	}
@@ -235,6 +260,7 @@ type (
 	CancelExecutor         map[string]*CancelOnExecutor
 	PreemptNode            map[NodeOnExecutor]*PreemptOnNode
 	CancelNode             map[NodeOnExecutor]*CancelOnNode
+	ReleaseNodeQuarantine  map[NodeOnExecutor]*NodeQuarantineRelease
 	PreemptQueue           map[string]*PreemptOnQueue
 	CancelQueue            map[string]*CancelOnQueue
 )
@@ -258,6 +284,17 @@ func (a MarkJobSetsCancelRequested) AffectsJobSet(queue string, jobSet string) b
 	return ok
 }
 
+// AffectsJobSet returns true if any job is moved into or out of the given job set.
+func (a MoveJobs) AffectsJobSet(queue string, jobSet string) bool {
+	for _, move := range a {
+		if (move.Queue == queue && move.JobSet == jobSet) ||
+			(move.DestinationQueue == queue && move.DestinationJobSet == jobSet) {
+			return true
+		}
+	}
+	return false
+}
+
 func (a InsertJobs) Merge(b DbOperation) bool {
 	return mergeInMap(a, b)
 }
@@ -366,6 +403,10 @@ func (a MarkJobsFailed) Merge(b DbOperation) bool {
 	return mergeInMap(a, b)
 }
 
+func (a MarkJobsSuspended) Merge(b DbOperation) bool {
+	return mergeInMap(a, b)
+}
+
 func (a *UpdateJobPriorities) Merge(b DbOperation) bool {
 	switch op := b.(type) {
 	case *UpdateJobPriorities:
@@ -397,6 +438,10 @@ func (a MarkRunsPreempted) Merge(b DbOperation) bool {
 	return mergeInMap(a, b)
 }
 
+func (a MarkRunsSuspended) Merge(b DbOperation) bool {
+	return mergeInMap(a, b)
+}
+
 func (a InsertJobRunErrors) Merge(b DbOperation) bool {
 	return mergeInMap(a, b)
 }
@@ -405,6 +450,30 @@ func (a MarkJobsValidated) Merge(b DbOperation) bool {
 	return mergeInMap(a, b)
 }
 
+func (a UpdateQueuedJobs) Merge(b DbOperation) bool {
+	switch op := b.(type) {
+	case UpdateQueuedJobs:
+		for key, value := range op {
+			a[key] = append(a[key], value...)
+		}
+		return true
+	}
+	return false
+}
+
+// Moves of the same job are not merged, since each move only applies to a job in its source job set.
+func (a MoveJobs) Merge(b DbOperation) bool {
+	switch op := b.(type) {
+	case MoveJobs:
+		if sharesKey(a, op) {
+			return false
+		}
+		maps.Copy(a, op)
+		return true
+	}
+	return false
+}
+
 func (a *InsertPartitionMarker) Merge(b DbOperation) bool {
 	switch op := b.(type) {
 	case *InsertPartitionMarker:
@@ -434,6 +503,10 @@ func (cn CancelNode) Merge(_ DbOperation) bool {
 	return false
 }
 
+func (rn ReleaseNodeQuarantine) Merge(_ DbOperation) bool {
+	return false
+}
+
 func (ce CancelExecutor) Merge(_ DbOperation) bool {
 	return false
 }
@@ -519,15 +592,25 @@ func (a MarkJobsFailed) CanBeAppliedBefore(b DbOperation) bool {
 	return !definesJob(a, b)
 }
 
+func (a MarkJobsSuspended) CanBeAppliedBefore(b DbOperation) bool {
+	return !definesJob(a, b)
+}
+
 func (a MarkJobsCancelled) CanBeAppliedBefore(b DbOperation) bool {
 	return !definesJob(a, b)
 }
 
 func (a UpdateJobSchedulingInfo) CanBeAppliedBefore(b DbOperation) bool {
+	if op, ok := b.(UpdateQueuedJobs); ok && sharesKey(a, op) {
+		return false
+	}
 	return !definesJob(a, b)
 }
 
 func (a UpdateJobQueuedState) CanBeAppliedBefore(b DbOperation) bool {
+	if op, ok := b.(UpdateQueuedJobs); ok && sharesKey(a, op) {
+		return false
+	}
 	return !definesJob(a, b)
 }
 
@@ -559,6 +642,10 @@ func (a MarkRunsPreempted) CanBeAppliedBefore(b DbOperation) bool {
 	return !definesRun(a, b)
 }
 
+func (a MarkRunsSuspended) CanBeAppliedBefore(b DbOperation) bool {
+	return !definesRun(a, b)
+}
+
 func (a *InsertPartitionMarker) CanBeAppliedBefore(b DbOperation) bool {
 	// Partition markers can never be brought forward
 	return false
@@ -574,6 +661,26 @@ func (a MarkJobsValidated) CanBeAppliedBefore(b DbOperation) bool {
 	return !definesJob(a, b)
 }
 
+// Updates are only applied to jobs that are still queued and build on the current scheduling info of the job,
+// so they can't be reordered with ops that change either for the same job.
+func (a UpdateQueuedJobs) CanBeAppliedBefore(b DbOperation) bool {
+	switch op := b.(type) {
+	case UpdateJobQueuedState:
+		return !sharesKey(a, op)
+	case UpdateJobSchedulingInfo:
+		return !sharesKey(a, op)
+	case MarkJobsCancelRequested, MarkJobSetsCancelRequested:
+		return false
+	}
+	return !definesJob(a, b)
+}
+
+// Moves change the queue and job set that most other ops use to find jobs,
+// so they are never brought forward.
+func (a MoveJobs) CanBeAppliedBefore(_ DbOperation) bool {
+	return false
+}
+
 // Can be applied before another operation only if it relates to a different executor
 func (a UpsertExecutorSettings) CanBeAppliedBefore(b DbOperation) bool {
 	switch op := b.(type) {
@@ -648,6 +755,18 @@ func (cn CancelNode) CanBeAppliedBefore(b DbOperation) bool {
 	return true
 }
 
+func (rn ReleaseNodeQuarantine) CanBeAppliedBefore(b DbOperation) bool {
+	switch op := b.(type) {
+	case nodeOperation:
+		for nodeOnExecutor := range rn {
+			if affectsNode := op.affectsNodeOnExecutor(nodeOnExecutor); affectsNode {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 func (pq PreemptQueue) CanBeAppliedBefore(b DbOperation) bool {
 	switch op := b.(type) {
 	case queueOperation:
@@ -674,14 +793,22 @@ func (cq CancelQueue) CanBeAppliedBefore(b DbOperation) bool {
 
 // definesJobInSet returns true if b is an InsertJobs operation
 // that inserts at least one job in any of the job sets that make
-// up the keys of a.
+// up the keys of a, or a MoveJobs operation that moves at least one job
+// into or out of any of those job sets.
 func definesJobInSet[M ~map[JobSetKey]V, V any](a M, b DbOperation) bool {
-	if op, ok := b.(InsertJobs); ok {
+	switch op := b.(type) {
+	case InsertJobs:
 		for _, job := range op {
 			if _, ok := a[JobSetKey{queue: job.Job.Queue, jobSet: job.Job.JobSet}]; ok {
 				return true
 			}
 		}
+	case MoveJobs:
+		for key := range a {
+			if op.AffectsJobSet(key.queue, key.jobSet) {
+				return true
+			}
+		}
 	}
 	return false
 }
@@ -699,14 +826,28 @@ func definesRunInSet[M ~map[JobSetKey]V, V any](a M, b DbOperation) bool {
 }
 
 // definesJob returns true if b is an InsertJobs operation
-// that inserts at least one job with id equal to any of the keys of a.
+// that inserts at least one job with id equal to any of the keys of a,
+// or a MoveJobs operation that moves at least one such job.
 func definesJob[M ~map[string]V, V any](a M, b DbOperation) bool {
-	if op, ok := b.(InsertJobs); ok {
+	switch op := b.(type) {
+	case InsertJobs:
 		for _, job := range op {
 			if _, ok := a[job.Job.JobID]; ok {
 				return true
 			}
 		}
+	case MoveJobs:
+		return sharesKey(a, op)
+	}
+	return false
+}
+
+// sharesKey returns true if a and b have at least one key in common.
+func sharesKey[M ~map[string]V, N ~map[string]W, V any, W any](a M, b N) bool {
+	for key := range a {
+		if _, ok := b[key]; ok {
+			return true
+		}
 	}
 	return false
 }
@@ -768,6 +909,10 @@ func (a MarkJobsFailed) GetOperation() Operation {
 	return JobSetOperation
 }
 
+func (a MarkJobsSuspended) GetOperation() Operation {
+	return JobSetOperation
+}
+
 func (a *UpdateJobPriorities) GetOperation() Operation {
 	return JobSetOperation
 }
@@ -792,6 +937,10 @@ func (a MarkRunsPreempted) GetOperation() Operation {
 	return JobSetOperation
 }
 
+func (a MarkRunsSuspended) GetOperation() Operation {
+	return JobSetOperation
+}
+
 func (a InsertJobRunErrors) GetOperation() Operation {
 	return JobSetOperation
 }
@@ -800,6 +949,14 @@ func (a MarkJobsValidated) GetOperation() Operation {
 	return JobSetOperation
 }
 
+func (a UpdateQueuedJobs) GetOperation() Operation {
+	return JobSetOperation
+}
+
+func (a MoveJobs) GetOperation() Operation {
+	return JobSetOperation
+}
+
 func (a *InsertPartitionMarker) GetOperation() Operation {
 	return JobSetOperation
 }
@@ -828,6 +985,10 @@ func (cn CancelNode) GetOperation() Operation {
 	return ControlPlaneOperation
 }
 
+func (rn ReleaseNodeQuarantine) GetOperation() Operation {
+	return ControlPlaneOperation
+}
+
 func (pq PreemptQueue) GetOperation() Operation {
 	return ControlPlaneOperation
 }
@@ -874,6 +1035,11 @@ func (cn CancelNode) affectsNodeOnExecutor(nodeOnExecutor NodeOnExecutor) bool {
 	return ok
 }
 
+func (rn ReleaseNodeQuarantine) affectsNodeOnExecutor(nodeOnExecutor NodeOnExecutor) bool {
+	_, ok := rn[nodeOnExecutor]
+	return ok
+}
+
 type queueOperation interface {
 	affectsQueue(string) bool
 }
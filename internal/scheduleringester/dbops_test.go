@@ -12,6 +12,7 @@ import (
 	f "github.com/armadaproject/armada/internal/common/ingest/testfixtures"
 	"github.com/armadaproject/armada/internal/common/util"
 	schedulerdb "github.com/armadaproject/armada/internal/scheduler/database"
+	"github.com/armadaproject/armada/pkg/armadaevents"
 )
 
 func TestMerge(t *testing.T) {
@@ -106,6 +107,91 @@ func TestMerge_UpdateJobQueuedState(t *testing.T) {
 	assert.Equal(t, expectedResult, updatedJobQueuedState1)
 }
 
+func TestMerge_UpdateQueuedJobs(t *testing.T) {
+	jobId1 := util.NewULID()
+	jobId2 := util.NewULID()
+	update1 := &armadaevents.UpdateQueuedJob{JobId: jobId1, PriorityClassName: "pc1"}
+	update2 := &armadaevents.UpdateQueuedJob{JobId: jobId1, NodeSelector: map[string]string{"foo": "bar"}}
+	update3 := &armadaevents.UpdateQueuedJob{JobId: jobId2, PriorityClassName: "pc2"}
+	updateQueuedJobs := UpdateQueuedJobs{jobId1: {update1}}
+	ok := updateQueuedJobs.Merge(UpdateQueuedJobs{jobId1: {update2}, jobId2: {update3}})
+	assert.True(t, ok)
+	// Updates to the same job are kept in order, since later updates may only partially overwrite earlier ones.
+	assert.Equal(t, UpdateQueuedJobs{jobId1: {update1, update2}, jobId2: {update3}}, updateQueuedJobs)
+}
+
+func TestCanBeAppliedBefore_UpdateQueuedJobs(t *testing.T) {
+	jobId1 := util.NewULID()
+	jobId2 := util.NewULID()
+	updateQueuedJobs := UpdateQueuedJobs{jobId1: {{JobId: jobId1, PriorityClassName: "pc1"}}}
+	tests := map[string]struct {
+		op       DbOperation
+		expected bool
+	}{
+		"lease of the same job":           {op: UpdateJobQueuedState{jobId1: &JobQueuedStateUpdate{false, 1}}, expected: false},
+		"lease of another job":            {op: UpdateJobQueuedState{jobId2: &JobQueuedStateUpdate{false, 1}}, expected: true},
+		"scheduling info of the same job": {op: UpdateJobSchedulingInfo{jobId1: &JobSchedulingInfoUpdate{[]byte("job 1"), 1}}, expected: false},
+		"scheduling info of another job":  {op: UpdateJobSchedulingInfo{jobId2: &JobSchedulingInfoUpdate{[]byte("job 2"), 1}}, expected: true},
+		"insertion of the same job":       {op: InsertJobs{jobId1: &JobInsertion{Job: &schedulerdb.Job{JobID: jobId1}}}, expected: false},
+		"job set cancellation":            {op: MarkJobSetsCancelRequested{jobSets: map[JobSetKey]*JobSetCancelAction{{queue: testQueueName, jobSet: "set1"}: {}}}, expected: false},
+		"unrelated op":                    {op: MarkJobsValidated{jobId2: &JobValidation{Pools: []string{"cpu"}}}, expected: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, updateQueuedJobs.CanBeAppliedBefore(tc.op))
+		})
+	}
+
+	// Neither may a lease or scheduling info change of the same job be moved before an update.
+	assert.False(t, UpdateJobQueuedState{jobId1: &JobQueuedStateUpdate{false, 1}}.CanBeAppliedBefore(updateQueuedJobs))
+	assert.False(t, UpdateJobSchedulingInfo{jobId1: &JobSchedulingInfoUpdate{[]byte("job 1"), 1}}.CanBeAppliedBefore(updateQueuedJobs))
+	assert.True(t, UpdateJobQueuedState{jobId2: &JobQueuedStateUpdate{false, 1}}.CanBeAppliedBefore(updateQueuedJobs))
+}
+
+func TestMerge_MoveJobs(t *testing.T) {
+	jobId1 := util.NewULID()
+	jobId2 := util.NewULID()
+	move1 := &JobMove{Queue: testQueueName, JobSet: "set1", DestinationQueue: "queue2", DestinationJobSet: "set1"}
+	move2 := &JobMove{Queue: "queue2", JobSet: "set1", DestinationQueue: "queue3", DestinationJobSet: "set1"}
+	moveJobs := MoveJobs{jobId1: move1}
+
+	// Moves of different jobs are merged.
+	assert.True(t, moveJobs.Merge(MoveJobs{jobId2: move1}))
+	assert.Equal(t, MoveJobs{jobId1: move1, jobId2: move1}, moveJobs)
+
+	// A second move of the same job starts from where the first one left it, so must be applied separately.
+	assert.False(t, moveJobs.Merge(MoveJobs{jobId1: move2}))
+	assert.Equal(t, MoveJobs{jobId1: move1, jobId2: move1}, moveJobs)
+}
+
+func TestCanBeAppliedBefore_MoveJobs(t *testing.T) {
+	jobId1 := util.NewULID()
+	jobId2 := util.NewULID()
+	moveJobs := MoveJobs{jobId1: &JobMove{Queue: testQueueName, JobSet: "set1", DestinationQueue: "queue2", DestinationJobSet: "set2"}}
+	tests := map[string]struct {
+		op       DbOperation
+		expected bool
+	}{
+		"lease of the same job":              {op: UpdateJobQueuedState{jobId1: &JobQueuedStateUpdate{false, 1}}, expected: false},
+		"lease of another job":               {op: UpdateJobQueuedState{jobId2: &JobQueuedStateUpdate{false, 1}}, expected: true},
+		"update of the same job":             {op: UpdateQueuedJobs{jobId1: {{JobId: jobId1, PriorityClassName: "pc1"}}}, expected: false},
+		"cancellation of the same job":       {op: MarkJobsCancelled{jobId1: time.Time{}}, expected: false},
+		"cancellation of the source set":     {op: MarkJobSetsCancelRequested{jobSets: map[JobSetKey]*JobSetCancelAction{{queue: testQueueName, jobSet: "set1"}: {}}}, expected: false},
+		"cancellation of the destination":    {op: MarkJobSetsCancelRequested{jobSets: map[JobSetKey]*JobSetCancelAction{{queue: "queue2", jobSet: "set2"}: {}}}, expected: false},
+		"cancellation of an unrelated set":   {op: MarkJobSetsCancelRequested{jobSets: map[JobSetKey]*JobSetCancelAction{{queue: testQueueName, jobSet: "set3"}: {}}}, expected: true},
+		"reprioritisation of the source set": {op: UpdateJobSetPriorities{{queue: testQueueName, jobSet: "set1"}: 1}, expected: false},
+		"insertion into the destination":     {op: InsertJobs{jobId2: &JobInsertion{Job: &schedulerdb.Job{JobID: jobId2, Queue: "queue2", JobSet: "set2"}}}, expected: false},
+		"insertion into an unrelated set":    {op: InsertJobs{jobId2: &JobInsertion{Job: &schedulerdb.Job{JobID: jobId2, Queue: testQueueName, JobSet: "set3"}}}, expected: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Moves themselves are never brought forward.
+			assert.False(t, moveJobs.CanBeAppliedBefore(tc.op))
+			assert.Equal(t, tc.expected, tc.op.CanBeAppliedBefore(moveJobs))
+		})
+	}
+}
+
 func TestMerge_InsertPartitionMarker(t *testing.T) {
 	marker1 := &InsertPartitionMarker{markers: []*schedulerdb.Marker{
 		{
@@ -246,6 +332,14 @@ func TestDbOperationOptimisation(t *testing.T) {
 			MarkJobsFailed{jobIds[1]: true},                                               // 2
 			InsertJobs{jobIds[2]: &JobInsertion{Job: &schedulerdb.Job{JobID: jobIds[2]}}}, // 2
 		}},
+		"MarkJobsSuspended": {N: 2, Ops: []DbOperation{
+			InsertJobs{jobIds[0]: &JobInsertion{Job: &schedulerdb.Job{JobID: jobIds[0]}}}, // 1
+			MarkJobsSuspended{jobIds[0]: true},                                            // 2
+			InsertJobs{jobIds[1]: &JobInsertion{Job: &schedulerdb.Job{JobID: jobIds[1]}}}, // 2
+			MarkJobsSuspended{jobIds[1]: true},                                            // 2
+			MarkJobsSuspended{jobIds[0]: false},                                           // 2
+			InsertJobs{jobIds[2]: &JobInsertion{Job: &schedulerdb.Job{JobID: jobIds[2]}}}, // 2
+		}},
 		"MarkJobsCancelled": {N: 2, Ops: []DbOperation{
 			InsertJobs{jobIds[0]: &JobInsertion{Job: &schedulerdb.Job{JobID: jobIds[0]}}}, // 1
 			MarkJobsCancelled{jobIds[0]: time.Time{}},                                     // 2
@@ -514,6 +608,14 @@ func (db *mockDb) apply(op DbOperation) error {
 				return errors.Errorf("job %s not in db", jobId)
 			}
 		}
+	case MarkJobsSuspended:
+		for jobId, suspended := range o {
+			if job, ok := db.Jobs[jobId]; ok {
+				job.Suspended = suspended
+			} else {
+				return errors.Errorf("job %s not in db", jobId)
+			}
+		}
 	case *UpdateJobPriorities:
 		for _, jobId := range o.jobIds {
 			job, ok := db.Jobs[jobId]
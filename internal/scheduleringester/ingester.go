@@ -33,7 +33,7 @@ func Run(config Configuration) error {
 	}
 	schedulerDb := NewSchedulerDb(db, svcMetrics, 100*time.Millisecond, 60*time.Second, 5*time.Second)
 
-	jobSetEventsConverter, err := NewJobSetEventsInstructionConverter(svcMetrics)
+	jobSetEventsConverter, err := NewJobSetEventsInstructionConverter(svcMetrics, config.SelectableJobLabels, config.SelectableJobAnnotations)
 	if err != nil {
 		return err
 	}
@@ -45,6 +45,9 @@ type eventSequenceCommon struct {
 type JobSetEventsInstructionConverter struct {
 	metrics    *metrics.Metrics
 	compressor compress.Compressor
+	// Keys of the labels and annotations stored with each job for use by job selectors.
+	selectableLabels      []string
+	selectableAnnotations []string
 }
 
 type ControlPlaneEventsInstructionConverter struct {
@@ -53,14 +56,18 @@ type ControlPlaneEventsInstructionConverter struct {
 
 func NewJobSetEventsInstructionConverter(
 	metrics *metrics.Metrics,
+	selectableLabels []string,
+	selectableAnnotations []string,
 ) (*JobSetEventsInstructionConverter, error) {
 	compressor, err := compress.NewZlibCompressor(1024)
 	if err != nil {
 		return nil, errors.WithMessage(err, "failed to create compressor")
 	}
 	return &JobSetEventsInstructionConverter{
-		metrics:    metrics,
-		compressor: compressor,
+		metrics:               metrics,
+		compressor:            compressor,
+		selectableLabels:      selectableLabels,
+		selectableAnnotations: selectableAnnotations,
 	}, nil
 }
 
@@ -127,6 +134,14 @@ func (c *JobSetEventsInstructionConverter) dbOperationsFromEventSequence(es *arm
 			operationsFromEvent, err = c.handleJobRunAssigned(event.GetJobRunAssigned(), eventTime)
 		case *armadaevents.EventSequence_Event_JobValidated:
 			operationsFromEvent, err = c.handleJobValidated(event.GetJobValidated())
+		case *armadaevents.EventSequence_Event_UpdateQueuedJob:
+			operationsFromEvent, err = c.handleUpdateQueuedJob(event.GetUpdateQueuedJob())
+		case *armadaevents.EventSequence_Event_MoveJob:
+			operationsFromEvent, err = c.handleMoveJob(es.Queue, es.JobSetName, event.GetMoveJob())
+		case *armadaevents.EventSequence_Event_SuspendJob:
+			operationsFromEvent, err = c.handleSuspendJob(event.GetSuspendJob())
+		case *armadaevents.EventSequence_Event_ResumeJob:
+			operationsFromEvent, err = c.handleResumeJob(event.GetResumeJob())
 		case *armadaevents.EventSequence_Event_ReprioritisedJob,
 			*armadaevents.EventSequence_Event_ResourceUtilisation,
 			*armadaevents.EventSequence_Event_JobRunCancelled,
@@ -273,6 +288,9 @@ func (c *JobSetEventsInstructionConverter) handleJobRunSucceeded(jobRunSucceeded
 
 func (c *JobSetEventsInstructionConverter) handleJobRunPreempted(jobRunPreempted *armadaevents.JobRunPreempted, preemptedTime time.Time) ([]DbOperation, error) {
 	runId := jobRunPreempted.PreemptedRunId
+	if jobRunPreempted.Suspended {
+		return []DbOperation{MarkRunsPreempted{runId: preemptedTime}, MarkRunsSuspended{runId: true}}, nil
+	}
 	return []DbOperation{MarkRunsPreempted{runId: preemptedTime}}, nil
 }
 
@@ -432,10 +450,38 @@ func (c *JobSetEventsInstructionConverter) handlePartitionMarker(pm *armadaevent
 
 func (c *JobSetEventsInstructionConverter) handleJobValidated(checked *armadaevents.JobValidated) ([]DbOperation, error) {
 	return []DbOperation{
-		MarkJobsValidated{checked.JobId: checked.Pools},
+		MarkJobsValidated{checked.JobId: &JobValidation{
+			Pools:                 checked.Pools,
+			SchedulingInfoVersion: int32(checked.SchedulingInfoVersion),
+		}},
+	}, nil
+}
+
+func (c *JobSetEventsInstructionConverter) handleUpdateQueuedJob(update *armadaevents.UpdateQueuedJob) ([]DbOperation, error) {
+	return []DbOperation{
+		UpdateQueuedJobs{update.JobId: {update}},
+	}, nil
+}
+
+func (c *JobSetEventsInstructionConverter) handleMoveJob(queue string, jobSet string, move *armadaevents.MoveJob) ([]DbOperation, error) {
+	return []DbOperation{
+		MoveJobs{move.JobId: &JobMove{
+			Queue:             queue,
+			JobSet:            jobSet,
+			DestinationQueue:  move.DestinationQueue,
+			DestinationJobSet: move.DestinationJobSetId,
+		}},
 	}, nil
 }
 
+func (c *JobSetEventsInstructionConverter) handleSuspendJob(suspendJob *armadaevents.SuspendJob) ([]DbOperation, error) {
+	return []DbOperation{MarkJobsSuspended{suspendJob.JobId: true}}, nil
+}
+
+func (c *JobSetEventsInstructionConverter) handleResumeJob(resumeJob *armadaevents.ResumeJob) ([]DbOperation, error) {
+	return []DbOperation{MarkJobsSuspended{resumeJob.JobId: false}}, nil
+}
+
 func NewControlPlaneEventsInstructionConverter(
 	metrics *metrics.Metrics,
 ) (*ControlPlaneEventsInstructionConverter, error) {
@@ -481,6 +527,9 @@ func (c *ControlPlaneEventsInstructionConverter) dbOperationFromControlPlaneEven
 		operations, err = c.handleCancelOnQueue(event.GetCancelOnQueue())
 	case *controlplaneevents.Event_PreemptOnNode:
 		operations, err = c.handlePreemptOnNode(event.GetPreemptOnNode())
+	case *controlplaneevents.Event_ReleaseNodeQuarantine:
+		eventTime := protoutil.ToStdTime(event.Created)
+		operations, err = c.handleReleaseNodeQuarantine(event.GetReleaseNodeQuarantine(), eventTime)
 	default:
 		log.Errorf("Unknown event of type %T", ev)
 	}
@@ -545,6 +594,21 @@ func (c *ControlPlaneEventsInstructionConverter) handleCancelOnNode(cancel *cont
 	}, nil
 }
 
+func (c *ControlPlaneEventsInstructionConverter) handleReleaseNodeQuarantine(release *controlplaneevents.ReleaseNodeQuarantine, requestedAt time.Time) ([]DbOperation, error) {
+	return []DbOperation{
+		ReleaseNodeQuarantine{
+			NodeOnExecutor{
+				Node:     release.Name,
+				Executor: release.Executor,
+			}: {
+				Name:        release.Name,
+				Executor:    release.Executor,
+				RequestedAt: requestedAt,
+			},
+		},
+	}, nil
+}
+
 func (c *ControlPlaneEventsInstructionConverter) handleCancelOnExecutor(cancel *controlplaneevents.CancelOnExecutor) ([]DbOperation, error) {
 	return []DbOperation{
 		CancelExecutor{
@@ -599,9 +663,33 @@ func (c *ControlPlaneEventsInstructionConverter) handleCancelOnQueue(cancel *con
 	}, nil
 }
 
-// schedulingInfoFromSubmitJob returns a minimal representation of a job containing only the info needed by the scheduler.
+// schedulingInfoFromSubmitJob returns a minimal representation of a job containing only the info needed by the scheduler,
+// along with the job's selectable labels and annotations.
 func (c *JobSetEventsInstructionConverter) schedulingInfoFromSubmitJob(submitJob *armadaevents.SubmitJob, submitTime time.Time) (*schedulerobjects.JobSchedulingInfo, error) {
-	return SchedulingInfoFromSubmitJob(submitJob, submitTime)
+	schedulingInfo, err := SchedulingInfoFromSubmitJob(submitJob, submitTime)
+	if err != nil {
+		return nil, err
+	}
+	if submitJob.ObjectMeta != nil {
+		schedulingInfo.Labels = selectableEntries(submitJob.ObjectMeta.Labels, c.selectableLabels)
+		schedulingInfo.Annotations = selectableEntries(submitJob.ObjectMeta.Annotations, c.selectableAnnotations)
+	}
+	return schedulingInfo, nil
+}
+
+// selectableEntries returns the entries of m with the given keys, or nil if there are none.
+// Only these are stored, since every entry stored adds to the size of each job in the scheduler.
+func selectableEntries(m map[string]string, keys []string) map[string]string {
+	var selectable map[string]string
+	for _, key := range keys {
+		if value, ok := m[key]; ok {
+			if selectable == nil {
+				selectable = make(map[string]string, len(keys))
+			}
+			selectable[key] = value
+		}
+	}
+	return selectable
 }
 
 // SchedulingInfoFromSubmitJob returns a minimal representation of a job containing only the info needed by the scheduler.
@@ -616,7 +704,6 @@ func SchedulingInfoFromSubmitJob(submitJob *armadaevents.SubmitJob, submitTime t
 		Priority:        submitJob.Priority,
 		Version:         0,
 	}
-
 	// Scheduling requirements specific to the objects that make up this job.
 	switch object := submitJob.MainObject.Object.(type) {
 	case *armadaevents.KubernetesMainObject_PodSpec:
@@ -29,6 +29,10 @@ var (
 )
 
 func TestConvertEventSequence(t *testing.T) {
+	submitWithLabels, err := f.DeepCopy(f.Submit)
+	require.NoError(t, err)
+	submitWithLabels.GetSubmitJob().ObjectMeta.Labels = map[string]string{"team": "a", "app": "b"}
+
 	tests := map[string]struct {
 		events   []*armadaevents.EventSequence_Event
 		expected []DbOperation
@@ -45,7 +49,7 @@ func TestConvertEventSequence(t *testing.T) {
 					QueuedVersion:  0,
 					Priority:       int64(f.Priority),
 					Submitted:      f.BaseTime.UnixNano(),
-					SchedulingInfo: protoutil.MustMarshall(getExpectedSubmitMessageSchedulingInfo(t)),
+					SchedulingInfo: protoutil.MustMarshall(getExpectedSubmitMessageSchedulingInfo(t, nil, map[string]string{"foo": "bar"})),
 					PriceBand:      1,
 				},
 				Metadata: JobInsertionMetadata{
@@ -66,7 +70,7 @@ func TestConvertEventSequence(t *testing.T) {
 					QueuedVersion:  0,
 					Priority:       int64(f.Priority),
 					Submitted:      f.BaseTime.UnixNano(),
-					SchedulingInfo: protoutil.MustMarshall(getExpectedSubmitMessageSchedulingInfo(t)),
+					SchedulingInfo: protoutil.MustMarshall(getExpectedSubmitMessageSchedulingInfo(t, nil, map[string]string{"foo": "bar"})),
 					PriceBand:      1,
 				},
 				Metadata: JobInsertionMetadata{
@@ -75,6 +79,27 @@ func TestConvertEventSequence(t *testing.T) {
 				},
 			}}},
 		},
+		"submit with labels": {
+			events: []*armadaevents.EventSequence_Event{submitWithLabels},
+			expected: []DbOperation{InsertJobs{f.JobId: &JobInsertion{
+				Job: &schedulerdb.Job{
+					JobID:          f.JobId,
+					JobSet:         f.JobsetName,
+					UserID:         f.UserId,
+					Queue:          f.Queue,
+					Queued:         true,
+					QueuedVersion:  0,
+					Priority:       int64(f.Priority),
+					Submitted:      f.BaseTime.UnixNano(),
+					SchedulingInfo: protoutil.MustMarshall(getExpectedSubmitMessageSchedulingInfo(t, map[string]string{"team": "a"}, map[string]string{"foo": "bar"})),
+					PriceBand:      1,
+				},
+				Metadata: JobInsertionMetadata{
+					Groups:        compress.MustCompressStringArray(f.Groups, compressor),
+					SubmitMessage: protoutil.MustMarshallAndCompress(submitWithLabels.GetSubmitJob(), compressor),
+				},
+			}}},
+		},
 		"job run leased": {
 			events: []*armadaevents.EventSequence_Event{f.Leased},
 			expected: []DbOperation{
@@ -131,6 +156,19 @@ func TestConvertEventSequence(t *testing.T) {
 			events:   []*armadaevents.EventSequence_Event{f.JobRunPreempted},
 			expected: []DbOperation{MarkRunsPreempted{f.RunId: f.BaseTime}},
 		},
+		"job run preempted by suspension": {
+			events: []*armadaevents.EventSequence_Event{{
+				Created: f.BaseTimeProto,
+				Event: &armadaevents.EventSequence_Event_JobRunPreempted{
+					JobRunPreempted: &armadaevents.JobRunPreempted{
+						PreemptedJobId: f.JobId,
+						PreemptedRunId: f.RunId,
+						Suspended:      true,
+					},
+				},
+			}},
+			expected: []DbOperation{MarkRunsPreempted{f.RunId: f.BaseTime}, MarkRunsSuspended{f.RunId: true}},
+		},
 		"lease returned": {
 			events: []*armadaevents.EventSequence_Event{f.LeaseReturned},
 			expected: []DbOperation{
@@ -265,9 +303,34 @@ func TestConvertEventSequence(t *testing.T) {
 		"SubmitChecked": {
 			events: []*armadaevents.EventSequence_Event{f.JobValidated},
 			expected: []DbOperation{
-				MarkJobsValidated{f.JobId: []string{"cpu"}},
+				MarkJobsValidated{f.JobId: &JobValidation{Pools: []string{"cpu"}}},
+			},
+		},
+		"UpdateQueuedJob": {
+			events: []*armadaevents.EventSequence_Event{f.UpdateQueuedJob},
+			expected: []DbOperation{
+				UpdateQueuedJobs{f.JobId: {f.UpdateQueuedJob.GetUpdateQueuedJob()}},
+			},
+		},
+		"MoveJob": {
+			events: []*armadaevents.EventSequence_Event{f.MoveJob},
+			expected: []DbOperation{
+				MoveJobs{f.JobId: &JobMove{
+					Queue:             f.Queue,
+					JobSet:            f.JobsetName,
+					DestinationQueue:  "destination-queue",
+					DestinationJobSet: "destination-job-set",
+				}},
 			},
 		},
+		"SuspendJob": {
+			events:   []*armadaevents.EventSequence_Event{f.SuspendJob},
+			expected: []DbOperation{MarkJobsSuspended{f.JobId: true}},
+		},
+		"ResumeJob": {
+			events:   []*armadaevents.EventSequence_Event{f.ResumeJob},
+			expected: []DbOperation{MarkJobsSuspended{f.JobId: false}},
+		},
 		"PositionMarker": {
 			events: []*armadaevents.EventSequence_Event{f.PartitionMarker},
 			expected: []DbOperation{
@@ -306,7 +369,12 @@ func TestConvertEventSequence(t *testing.T) {
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			converter := JobSetEventsInstructionConverter{m, compressor}
+			converter := JobSetEventsInstructionConverter{
+				metrics:               m,
+				compressor:            compressor,
+				selectableLabels:      []string{"team"},
+				selectableAnnotations: []string{"foo"},
+			}
 			es := f.NewEventSequence(tc.events...)
 			results := converter.dbOperationsFromEventSequence(es)
 			assertOperationsEqual(t, tc.expected, results)
@@ -419,6 +487,19 @@ func TestConvertControlPlaneEvent(t *testing.T) {
 				},
 			}},
 		},
+		"release node quarantine": {
+			event: f.ReleaseNodeQuarantine,
+			expected: []DbOperation{ReleaseNodeQuarantine{
+				NodeOnExecutor{
+					Executor: f.ExecutorId,
+					Node:     f.NodeName,
+				}: &NodeQuarantineRelease{
+					Name:        f.NodeName,
+					Executor:    f.ExecutorId,
+					RequestedAt: f.BaseTime,
+				},
+			}},
+		},
 		"preempt on queue": {
 			event: f.PreemptOnQueue,
 			expected: []DbOperation{PreemptQueue{
@@ -546,8 +627,10 @@ func assertErrorMessagesEqual(t *testing.T, expectedBytes []byte, actualBytes []
 	assert.Equal(t, expectedError, actualError)
 }
 
-func getExpectedSubmitMessageSchedulingInfo(t *testing.T) *schedulerobjects.JobSchedulingInfo {
+func getExpectedSubmitMessageSchedulingInfo(t *testing.T, labels map[string]string, annotations map[string]string) *schedulerobjects.JobSchedulingInfo {
 	expectedSubmitSchedulingInfo := &schedulerobjects.JobSchedulingInfo{
+		Labels:            labels,
+		Annotations:       annotations,
 		Lifetime:          0,
 		AtMostOnce:        true,
 		Preemptible:       true,
@@ -0,0 +1,73 @@
+package scheduleringester
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+// applyQueuedJobUpdate applies update to both the submit message of a job, from which its pod is created,
+// and its scheduling info, which is what the scheduler considers when scheduling it.
+// The scheduling info version is bumped so that the scheduler picks up the change.
+// Neither argument is modified if an error is returned.
+func applyQueuedJobUpdate(
+	submitJob *armadaevents.SubmitJob,
+	schedulingInfo *schedulerobjects.JobSchedulingInfo,
+	update *armadaevents.UpdateQueuedJob,
+) error {
+	mainObject, ok := submitJob.GetMainObject().GetObject().(*armadaevents.KubernetesMainObject_PodSpec)
+	if !ok || mainObject.PodSpec.GetPodSpec() == nil {
+		return errors.Errorf("job %s has no pod spec", update.JobId)
+	}
+	podSpec := mainObject.PodSpec.PodSpec
+	podRequirements := schedulingInfo.GetPodRequirements()
+	if podRequirements == nil {
+		return errors.Errorf("job %s has no pod requirements", update.JobId)
+	}
+
+	containerIndices := make(map[string]int, len(update.ContainerResources))
+	for name := range update.ContainerResources {
+		i := slices.IndexFunc(podSpec.Containers, func(c v1.Container) bool { return c.Name == name })
+		if i < 0 {
+			return errors.Errorf("job %s has no container named %s", update.JobId, name)
+		}
+		containerIndices[name] = i
+	}
+
+	for name, resources := range update.ContainerResources {
+		if resources != nil {
+			podSpec.Containers[containerIndices[name]].Resources = *resources
+		}
+	}
+	if len(update.ContainerResources) > 0 {
+		// Replaces any resource growth from retries; the explicitly requested resources take precedence.
+		podRequirements.ResourceRequirements = api.SchedulingResourceRequirementsFromPodSpec(podSpec)
+		schedulingInfo.ResourceMutations = nil
+	}
+	if len(update.NodeSelector) > 0 {
+		podSpec.NodeSelector = maps.Clone(update.NodeSelector)
+		podRequirements.NodeSelector = maps.Clone(update.NodeSelector)
+	}
+	if len(update.Tolerations) > 0 {
+		podSpec.Tolerations = make([]v1.Toleration, 0, len(update.Tolerations))
+		podRequirements.Tolerations = make([]*v1.Toleration, 0, len(update.Tolerations))
+		for _, toleration := range update.Tolerations {
+			if toleration != nil {
+				podSpec.Tolerations = append(podSpec.Tolerations, *toleration)
+				podRequirements.Tolerations = append(podRequirements.Tolerations, toleration.DeepCopy())
+			}
+		}
+	}
+	if update.PriorityClassName != "" {
+		podSpec.PriorityClassName = update.PriorityClassName
+		schedulingInfo.PriorityClassName = update.PriorityClassName
+	}
+	schedulingInfo.Version++
+	return nil
+}
@@ -0,0 +1,111 @@
+package scheduleringester
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	f "github.com/armadaproject/armada/internal/common/ingest/testfixtures"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+func TestApplyQueuedJobUpdate(t *testing.T) {
+	twoCpu := v1.ResourceRequirements{
+		Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("2"), v1.ResourceMemory: resource.MustParse("1Gi")},
+		Limits:   v1.ResourceList{v1.ResourceCPU: resource.MustParse("2"), v1.ResourceMemory: resource.MustParse("1Gi")},
+	}
+	toleration := v1.Toleration{Key: "gpu", Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule}
+	newSubmitJob := func() *armadaevents.SubmitJob {
+		event, err := f.DeepCopy(f.Submit)
+		require.NoError(t, err)
+		return event.GetSubmitJob()
+	}
+
+	tests := map[string]struct {
+		update      *armadaevents.UpdateQueuedJob
+		expectError bool
+		// Applied to copies of the original submit message and scheduling info to produce the expected result.
+		expectedPodSpec        func(*v1.PodSpec)
+		expectedSchedulingInfo func(*schedulerobjects.JobSchedulingInfo)
+	}{
+		"container resources": {
+			update: &armadaevents.UpdateQueuedJob{ContainerResources: map[string]*v1.ResourceRequirements{"container1": &twoCpu}},
+			expectedPodSpec: func(podSpec *v1.PodSpec) {
+				podSpec.Containers[0].Resources = twoCpu
+			},
+			expectedSchedulingInfo: func(info *schedulerobjects.JobSchedulingInfo) {
+				info.GetPodRequirements().ResourceRequirements = api.SchedulingResourceRequirementsFromPodSpec(&v1.PodSpec{Containers: []v1.Container{{Resources: twoCpu}}})
+				info.ResourceMutations = nil
+			},
+		},
+		"node selector": {
+			update: &armadaevents.UpdateQueuedJob{NodeSelector: map[string]string{"foo": "bar"}},
+			expectedPodSpec: func(podSpec *v1.PodSpec) {
+				podSpec.NodeSelector = map[string]string{"foo": "bar"}
+			},
+			expectedSchedulingInfo: func(info *schedulerobjects.JobSchedulingInfo) {
+				info.GetPodRequirements().NodeSelector = map[string]string{"foo": "bar"}
+			},
+		},
+		"tolerations": {
+			update: &armadaevents.UpdateQueuedJob{Tolerations: []*v1.Toleration{&toleration}},
+			expectedPodSpec: func(podSpec *v1.PodSpec) {
+				podSpec.Tolerations = []v1.Toleration{toleration}
+			},
+			expectedSchedulingInfo: func(info *schedulerobjects.JobSchedulingInfo) {
+				info.GetPodRequirements().Tolerations = []*v1.Toleration{&toleration}
+			},
+		},
+		"priority class": {
+			update: &armadaevents.UpdateQueuedJob{PriorityClassName: "other-priority"},
+			expectedPodSpec: func(podSpec *v1.PodSpec) {
+				podSpec.PriorityClassName = "other-priority"
+			},
+			expectedSchedulingInfo: func(info *schedulerobjects.JobSchedulingInfo) {
+				info.PriorityClassName = "other-priority"
+			},
+		},
+		"unknown container": {
+			update: &armadaevents.UpdateQueuedJob{
+				ContainerResources: map[string]*v1.ResourceRequirements{"container1": &twoCpu, "missing": &twoCpu},
+				PriorityClassName:  "other-priority",
+			},
+			expectError: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.update.JobId = f.JobId
+			submitJob := newSubmitJob()
+			schedulingInfo, err := SchedulingInfoFromSubmitJob(submitJob, time.Now())
+			require.NoError(t, err)
+			// Resource growth from earlier retries; only explicitly updated resources replace it.
+			schedulingInfo.ResourceMutations = &schedulerobjects.RetryResourceMutations{MemoryFactor: 2}
+			expectedSubmitJob := newSubmitJob()
+			expectedSchedulingInfo := proto.Clone(schedulingInfo).(*schedulerobjects.JobSchedulingInfo)
+
+			err = applyQueuedJobUpdate(submitJob, schedulingInfo, tc.update)
+			if tc.expectError {
+				assert.Error(t, err)
+				// Nothing is modified if the update can't be applied.
+				assert.Equal(t, expectedSubmitJob, submitJob)
+				assert.Equal(t, expectedSchedulingInfo, schedulingInfo)
+				return
+			}
+			require.NoError(t, err)
+
+			tc.expectedPodSpec(expectedSubmitJob.GetMainObject().GetPodSpec().GetPodSpec())
+			tc.expectedSchedulingInfo(expectedSchedulingInfo)
+			expectedSchedulingInfo.Version++
+			assert.Equal(t, expectedSubmitJob, submitJob)
+			assert.Equal(t, expectedSchedulingInfo, schedulingInfo)
+		})
+	}
+}
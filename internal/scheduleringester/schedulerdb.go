@@ -11,12 +11,15 @@ import (
 	"golang.org/x/exp/maps"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/compress"
 	"github.com/armadaproject/armada/internal/common/database"
 	"github.com/armadaproject/armada/internal/common/ingest"
 	"github.com/armadaproject/armada/internal/common/ingest/metrics"
+	log "github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/common/slices"
 	schedulerdb "github.com/armadaproject/armada/internal/scheduler/database"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/pkg/armadaevents"
 	"github.com/armadaproject/armada/pkg/controlplaneevents"
 )
 
@@ -33,6 +36,9 @@ type SchedulerDb struct {
 	initialBackOff time.Duration
 	maxBackOff     time.Duration
 	lockTimeout    time.Duration
+	// Used to rewrite the submit messages of jobs whose scheduling requirements are updated.
+	compressor   compress.Compressor
+	decompressor compress.Decompressor
 }
 
 func NewSchedulerDb(
@@ -48,6 +54,8 @@ func NewSchedulerDb(
 		initialBackOff: initialBackOff,
 		maxBackOff:     maxBackOff,
 		lockTimeout:    lockTimeout,
+		compressor:     compress.NewThreadSafeZlibCompressor(1024),
+		decompressor:   compress.NewThreadSafeZlibDecompressor(),
 	}
 }
 
@@ -274,6 +282,20 @@ func (s *SchedulerDb) WriteDbOp(ctx *armadacontext.Context, tx pgx.Tx, op DbOper
 		if err != nil {
 			return errors.WithStack(err)
 		}
+	case MarkJobsSuspended:
+		jobIdsBySuspended := map[bool][]string{}
+		for jobId, suspended := range o {
+			jobIdsBySuspended[suspended] = append(jobIdsBySuspended[suspended], jobId)
+		}
+		for suspended, jobIds := range jobIdsBySuspended {
+			err := queries.UpdateJobSuspendedById(ctx, schedulerdb.UpdateJobSuspendedByIdParams{
+				Suspended: suspended,
+				JobIds:    jobIds,
+			})
+			if err != nil {
+				return errors.WithStack(err)
+			}
+		}
 	case *UpdateJobPriorities:
 		err := queries.UpdateJobPriorityById(ctx, schedulerdb.UpdateJobPriorityByIdParams{
 			Queue:    o.key.queue,
@@ -367,6 +389,14 @@ func (s *SchedulerDb) WriteDbOp(ctx *armadacontext.Context, tx pgx.Tx, op DbOper
 		if _, err := tx.Exec(ctx, sqlStmt, runIds, preempted, preemptedTimes); err != nil {
 			return errors.WithStack(err)
 		}
+	case MarkRunsSuspended:
+		runIds := make([]string, 0, len(o))
+		for runId := range o {
+			runIds = append(runIds, runId)
+		}
+		if _, err := tx.Exec(ctx, `UPDATE runs SET suspended = true WHERE run_id = ANY($1::text[])`, runIds); err != nil {
+			return errors.WithStack(err)
+		}
 	case InsertJobRunErrors:
 		records := make([]any, len(o))
 		i := 0
@@ -388,15 +418,25 @@ func (s *SchedulerDb) WriteDbOp(ctx *armadacontext.Context, tx pgx.Tx, op DbOper
 		}
 		return nil
 	case MarkJobsValidated:
-		markValidatedSqlStatement := `UPDATE jobs SET validated = true, pools = $1 WHERE job_id = $2`
+		// Jobs whose scheduling info has changed since they were validated, e.g., because they were updated while
+		// queued, are left unvalidated, so that the scheduler validates them again.
+		markValidatedSqlStatement := `UPDATE jobs SET validated = true, pools = $1 WHERE job_id = $2 AND scheduling_info_version = $3`
 		batch := &pgx.Batch{}
 		for key, value := range o {
-			batch.Queue(markValidatedSqlStatement, value, key)
+			batch.Queue(markValidatedSqlStatement, value.Pools, key, value.SchedulingInfoVersion)
 		}
 		err := execBatch(ctx, tx, batch)
 		if err != nil {
 			return errors.WithStack(err)
 		}
+	case UpdateQueuedJobs:
+		if err := s.updateQueuedJobs(ctx, tx, o); err != nil {
+			return err
+		}
+	case MoveJobs:
+		if err := s.moveJobs(ctx, tx, o); err != nil {
+			return err
+		}
 	case UpsertExecutorSettings:
 		for _, settingsUpsert := range o {
 			err := queries.UpsertExecutorSettings(ctx, schedulerdb.UpsertExecutorSettingsParams{
@@ -531,6 +571,17 @@ func (s *SchedulerDb) WriteDbOp(ctx *armadacontext.Context, tx pgx.Tx, op DbOper
 			}
 		}
 
+	case ReleaseNodeQuarantine:
+		for nodeOnExecutor, release := range o {
+			err := queries.UpsertNodeQuarantineRelease(ctx, schedulerdb.UpsertNodeQuarantineReleaseParams{
+				ExecutorID:  nodeOnExecutor.Executor,
+				NodeName:    nodeOnExecutor.Node,
+				RequestedAt: release.RequestedAt,
+			})
+			if err != nil {
+				return errors.Wrapf(err, "error releasing node %s on executor %s from quarantine", nodeOnExecutor.Node, nodeOnExecutor.Executor)
+			}
+		}
 	case CancelQueue:
 		for _, cancelRequest := range o {
 			jobs, err := s.selectAllJobsByQueueAndJobState(ctx, queries, cancelRequest.Name, cancelRequest.JobStates, cancelRequest.Pools)
@@ -577,6 +628,112 @@ func (s *SchedulerDb) WriteDbOp(ctx *armadacontext.Context, tx pgx.Tx, op DbOper
 	return nil
 }
 
+// updateQueuedJobs applies updates to the submit message and scheduling info of jobs that are still queued.
+// Updated jobs are marked as not validated, so that the scheduler checks they can still be scheduled.
+// Updates that can't be applied, e.g., because the job has since been leased, are logged and dropped,
+// since retrying them would never succeed.
+func (s *SchedulerDb) updateQueuedJobs(ctx *armadacontext.Context, tx pgx.Tx, updates UpdateQueuedJobs) error {
+	type queuedJob struct {
+		jobId          string
+		schedulingInfo []byte
+		submitMessage  []byte
+	}
+	rows, err := tx.Query(ctx, `
+		SELECT j.job_id, j.scheduling_info, m.submit_message
+		FROM jobs j JOIN job_metadata m ON j.job_id = m.job_id
+		WHERE j.job_id = ANY($1)
+		AND j.queued AND NOT j.cancel_requested AND NOT j.cancelled AND NOT j.succeeded AND NOT j.failed`,
+		maps.Keys(updates),
+	)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	jobs, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (queuedJob, error) {
+		var job queuedJob
+		err := row.Scan(&job.jobId, &job.schedulingInfo, &job.submitMessage)
+		return job, err
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if len(jobs) < len(updates) {
+		log.Infof("Ignoring updates to %d jobs that are no longer queued", len(updates)-len(jobs))
+	}
+
+	batch := &pgx.Batch{}
+	for _, job := range jobs {
+		submitJob := &armadaevents.SubmitJob{}
+		submitJobBytes, err := s.decompressor.Decompress(job.submitMessage)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if err := proto.Unmarshal(submitJobBytes, submitJob); err != nil {
+			return errors.WithStack(err)
+		}
+		schedulingInfo := &schedulerobjects.JobSchedulingInfo{}
+		if err := proto.Unmarshal(job.schedulingInfo, schedulingInfo); err != nil {
+			return errors.WithStack(err)
+		}
+
+		originalVersion := schedulingInfo.Version
+		for _, update := range updates[job.jobId] {
+			if err := applyQueuedJobUpdate(submitJob, schedulingInfo, update); err != nil {
+				log.WithError(err).Warnf("Ignoring update to job %s", job.jobId)
+			}
+		}
+		if schedulingInfo.Version == originalVersion {
+			continue
+		}
+
+		submitJobBytes, err = proto.Marshal(submitJob)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		compressedSubmitJobBytes, err := s.compressor.Compress(submitJobBytes)
+		if err != nil {
+			return err
+		}
+		schedulingInfoBytes, err := proto.Marshal(schedulingInfo)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		batch.Queue(`UPDATE job_metadata SET submit_message = $1 WHERE job_id = $2`, compressedSubmitJobBytes, job.jobId)
+		batch.Queue(
+			`UPDATE jobs SET scheduling_info = $1, scheduling_info_version = $2, validated = false WHERE job_id = $3`,
+			schedulingInfoBytes, int32(schedulingInfo.Version), job.jobId,
+		)
+	}
+	return errors.WithStack(execBatch(ctx, tx, batch))
+}
+
+// moveJobs moves jobs that are still queued in their source job set to their destination queue and job set.
+// The runs of moved jobs, all of which have finished, are moved with them.
+func (s *SchedulerDb) moveJobs(ctx *armadacontext.Context, tx pgx.Tx, moves MoveJobs) error {
+	for jobId, move := range moves {
+		tag, err := tx.Exec(ctx, `
+			UPDATE jobs SET queue = $1, job_set = $2
+			WHERE job_id = $3 AND queue = $4 AND job_set = $5
+			AND queued AND NOT cancel_requested AND NOT cancelled AND NOT succeeded AND NOT failed`,
+			move.DestinationQueue, move.DestinationJobSet, jobId, move.Queue, move.JobSet,
+		)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if tag.RowsAffected() == 0 {
+			log.Infof("Ignoring move of job %s, which is no longer queued in job set %s of queue %s", jobId, move.JobSet, move.Queue)
+			continue
+		}
+		_, err = tx.Exec(ctx,
+			`UPDATE runs SET queue = $1, job_set = $2 WHERE job_id = $3`,
+			move.DestinationQueue, move.DestinationJobSet, jobId,
+		)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	return nil
+}
+
 func (s *SchedulerDb) selectAllJobsByQueueAndJobState(ctx *armadacontext.Context, queries *schedulerdb.Queries, queue string, jobStates []controlplaneevents.ActiveJobState, pools []string) ([]schedulerdb.Job, error) {
 	items := []schedulerdb.Job{}
 	for _, state := range jobStates {
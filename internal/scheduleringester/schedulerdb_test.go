@@ -16,12 +16,14 @@ import (
 	"k8s.io/utils/strings/slices"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/compress"
 	"github.com/armadaproject/armada/internal/common/ingest/metrics"
 	"github.com/armadaproject/armada/internal/common/ingest/testfixtures"
 	armadaslices "github.com/armadaproject/armada/internal/common/slices"
 	"github.com/armadaproject/armada/internal/common/util"
 	schedulerdb "github.com/armadaproject/armada/internal/scheduler/database"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/pkg/armadaevents"
 	"github.com/armadaproject/armada/pkg/controlplaneevents"
 )
 
@@ -57,8 +59,8 @@ func TestWriteOps(t *testing.T) {
 				jobIds[1]: &JobInsertion{Job: &schedulerdb.Job{JobID: jobIds[1], JobSet: "set2"}},
 			},
 			MarkJobsValidated{
-				jobIds[0]: []string{"cpu"},
-				jobIds[1]: []string{"gpu", "cpu"},
+				jobIds[0]: &JobValidation{Pools: []string{"cpu"}},
+				jobIds[1]: &JobValidation{Pools: []string{"gpu", "cpu"}},
 			},
 		}},
 		"InsertRuns": {Ops: []DbOperation{
@@ -163,6 +165,12 @@ func TestWriteOps(t *testing.T) {
 				},
 			},
 		}},
+		"ReleaseNodeQuarantine": {Ops: []DbOperation{
+			ReleaseNodeQuarantine{
+				NodeOnExecutor{Node: "node-1", Executor: "executor-1"}: &NodeQuarantineRelease{Name: "node-1", Executor: "executor-1", RequestedAt: time.Now().UTC().Truncate(time.Microsecond)},
+				NodeOnExecutor{Node: "node-2", Executor: "executor-1"}: &NodeQuarantineRelease{Name: "node-2", Executor: "executor-1", RequestedAt: time.Now().UTC().Truncate(time.Microsecond)},
+			},
+		}},
 		"MarkJobSetsCancelRequested": {Ops: []DbOperation{
 			InsertJobs{
 				jobIds[0]: &JobInsertion{Job: &schedulerdb.Job{JobID: jobIds[0], Queue: testQueueName, JobSet: "set1"}},
@@ -278,6 +286,19 @@ func TestWriteOps(t *testing.T) {
 				runIds[0]: testfixtures.BaseTime,
 			},
 		}},
+		"MarkRunsSuspended": {Ops: []DbOperation{
+			InsertJobs{
+				jobIds[0]: &JobInsertion{Job: &schedulerdb.Job{JobID: jobIds[0]}},
+				jobIds[1]: &JobInsertion{Job: &schedulerdb.Job{JobID: jobIds[1]}},
+			},
+			InsertRuns{
+				runIds[0]: &JobRunDetails{Queue: testQueueName, DbRun: &schedulerdb.Run{JobID: jobIds[0], RunID: runIds[0]}},
+				runIds[1]: &JobRunDetails{Queue: testQueueName, DbRun: &schedulerdb.Run{JobID: jobIds[1], RunID: runIds[1]}},
+			},
+			MarkRunsSuspended{
+				runIds[0]: true,
+			},
+		}},
 		"MarkJobsFailed": {Ops: []DbOperation{
 			InsertJobs{
 				jobIds[0]: &JobInsertion{Job: &schedulerdb.Job{JobID: jobIds[0], JobSet: "set1"}},
@@ -972,6 +993,27 @@ func assertOpSuccess(t *testing.T, schedulerDb *SchedulerDb, serials map[string]
 		}
 		assert.Equal(t, numChanged, 1)
 		assert.Equal(t, len(expected), len(runs))
+	case MarkRunsSuspended:
+		jobs, err := selectNewJobs(ctx, 0)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		jobIds := make([]string, 0)
+		for _, job := range jobs {
+			jobIds = append(jobIds, job.JobID)
+		}
+		runs, err := queries.SelectNewRunsForJobs(ctx, schedulerdb.SelectNewRunsForJobsParams{
+			Serial: serials["runs"],
+			JobIds: jobIds,
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		for _, run := range runs {
+			_, ok := expected[run.RunID]
+			assert.Equal(t, ok, run.Suspended)
+		}
+		assert.Equal(t, len(expected), 1)
 	case InsertJobRunErrors:
 		expectedIds := maps.Keys(expected)
 		as, err := queries.SelectRunErrorsById(ctx, expectedIds)
@@ -1145,6 +1187,20 @@ func assertOpSuccess(t *testing.T, schedulerDb *SchedulerDb, serials map[string]
 			return ok
 		})
 		assert.Equal(t, 0, len(filtered))
+	case ReleaseNodeQuarantine:
+		for nodeOnExecutor, release := range expected {
+			releases, err := queries.SelectNodeQuarantineReleasesByExecutor(ctx, nodeOnExecutor.Executor)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			releasesByNode := make(map[string]time.Time, len(releases))
+			for _, r := range releases {
+				releasesByNode[r.NodeName] = r.RequestedAt
+			}
+			requestedAt, ok := releasesByNode[nodeOnExecutor.Node]
+			assert.True(t, ok)
+			assert.True(t, release.RequestedAt.Equal(requestedAt))
+		}
 	default:
 		return errors.Errorf("received unexpected op %+v", op)
 	}
@@ -1550,3 +1606,181 @@ func TestMarkJobsCancelRequested_EmptyReasonThenReal(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestUpdateQueuedJobs(t *testing.T) {
+	queuedJobId := util.NewULID()
+	leasedJobId := util.NewULID()
+	compressor, err := compress.NewZlibCompressor(0)
+	require.NoError(t, err)
+	decompressor := compress.NewZlibDecompressor()
+
+	submitJob := testfixtures.Submit.GetSubmitJob()
+	submitMessage, err := proto.Marshal(submitJob)
+	require.NoError(t, err)
+	compressedSubmitMessage, err := compressor.Compress(submitMessage)
+	require.NoError(t, err)
+	schedulingInfo, err := SchedulingInfoFromSubmitJob(submitJob, testfixtures.BaseTime)
+	require.NoError(t, err)
+	schedulingInfoBytes, err := proto.Marshal(schedulingInfo)
+	require.NoError(t, err)
+
+	insertJob := func(jobId string, queued bool) *JobInsertion {
+		return &JobInsertion{
+			Job: &schedulerdb.Job{
+				JobID:          jobId,
+				JobSet:         "set1",
+				Queue:          testQueueName,
+				Queued:         queued,
+				Validated:      true,
+				SchedulingInfo: schedulingInfoBytes,
+			},
+			Metadata: JobInsertionMetadata{SubmitMessage: compressedSubmitMessage},
+		}
+	}
+	nodeSelector := map[string]string{"foo": "bar"}
+	ops := []DbOperation{
+		addDefaultValues(InsertJobs{
+			queuedJobId: insertJob(queuedJobId, true),
+			leasedJobId: insertJob(leasedJobId, false),
+		}),
+		UpdateQueuedJobs{
+			queuedJobId: {{JobId: queuedJobId, NodeSelector: nodeSelector}},
+			leasedJobId: {{JobId: leasedJobId, NodeSelector: nodeSelector}},
+		},
+	}
+
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 10*time.Second)
+	defer cancel()
+	err = schedulerdb.WithTestDb(func(q *schedulerdb.Queries, db *pgxpool.Pool) error {
+		schedulerDb := NewSchedulerDb(db, metrics.NewMetrics("test"), time.Second, time.Second, 10*time.Second)
+		require.NoError(t, schedulerDb.Store(ctx, &DbOperationsWithMessageIds{Ops: ops}))
+
+		jobs, err := q.SelectNewJobs(ctx, schedulerdb.SelectNewJobsParams{Serial: 0, Limit: 10})
+		require.NoError(t, err)
+		jobsById := armadaslices.GroupByFuncUnique(jobs, func(job schedulerdb.Job) string { return job.JobID })
+
+		// The queued job is updated and must be validated again.
+		updatedJob := jobsById[queuedJobId]
+		assert.False(t, updatedJob.Validated)
+		assert.Equal(t, int32(schedulingInfo.Version+1), updatedJob.SchedulingInfoVersion)
+		updatedSchedulingInfo := &schedulerobjects.JobSchedulingInfo{}
+		require.NoError(t, proto.Unmarshal(updatedJob.SchedulingInfo, updatedSchedulingInfo))
+		assert.Equal(t, nodeSelector, updatedSchedulingInfo.GetPodRequirements().NodeSelector)
+
+		// The leased job is left as it was.
+		leasedJob := jobsById[leasedJobId]
+		assert.True(t, leasedJob.Validated)
+		assert.Equal(t, schedulingInfoBytes, leasedJob.SchedulingInfo)
+
+		metadata, err := q.SelectJobMetadata(ctx, []string{queuedJobId, leasedJobId})
+		require.NoError(t, err)
+		for _, m := range metadata {
+			decompressed, err := decompressor.Decompress(m.SubmitMessage)
+			require.NoError(t, err)
+			updatedSubmitJob := &armadaevents.SubmitJob{}
+			require.NoError(t, proto.Unmarshal(decompressed, updatedSubmitJob))
+			podSpec := updatedSubmitJob.GetMainObject().GetPodSpec().GetPodSpec()
+			if m.JobID == queuedJobId {
+				assert.Equal(t, nodeSelector, podSpec.NodeSelector)
+			} else {
+				assert.Equal(t, submitJob.GetMainObject().GetPodSpec().GetPodSpec().NodeSelector, podSpec.NodeSelector)
+			}
+		}
+
+		// Validating the job as it was before the update doesn't mark the updated job as validated.
+		isValidated := func() bool {
+			var validated bool
+			require.NoError(t, db.QueryRow(ctx, "SELECT validated FROM jobs WHERE job_id = $1", queuedJobId).Scan(&validated))
+			return validated
+		}
+		staleValidation := MarkJobsValidated{queuedJobId: &JobValidation{Pools: []string{"cpu"}, SchedulingInfoVersion: int32(schedulingInfo.Version)}}
+		require.NoError(t, schedulerDb.Store(ctx, &DbOperationsWithMessageIds{Ops: []DbOperation{staleValidation}}))
+		assert.False(t, isValidated())
+
+		validation := MarkJobsValidated{queuedJobId: &JobValidation{Pools: []string{"cpu"}, SchedulingInfoVersion: int32(schedulingInfo.Version + 1)}}
+		require.NoError(t, schedulerDb.Store(ctx, &DbOperationsWithMessageIds{Ops: []DbOperation{validation}}))
+		assert.True(t, isValidated())
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestMoveJobs(t *testing.T) {
+	queuedJobId := util.NewULID()
+	leasedJobId := util.NewULID()
+	otherJobSetJobId := util.NewULID()
+	runId := uuid.NewString()
+	move := &JobMove{Queue: testQueueName, JobSet: "set1", DestinationQueue: "queue2", DestinationJobSet: "set2"}
+	ops := []DbOperation{
+		addDefaultValues(InsertJobs{
+			queuedJobId:      &JobInsertion{Job: &schedulerdb.Job{JobID: queuedJobId, Queue: testQueueName, JobSet: "set1", Queued: true}},
+			leasedJobId:      &JobInsertion{Job: &schedulerdb.Job{JobID: leasedJobId, Queue: testQueueName, JobSet: "set1"}},
+			otherJobSetJobId: &JobInsertion{Job: &schedulerdb.Job{JobID: otherJobSetJobId, Queue: testQueueName, JobSet: "set3", Queued: true}},
+		}),
+		// A run from an earlier lease of the queued job.
+		InsertRuns{
+			runId: &JobRunDetails{Queue: testQueueName, DbRun: &schedulerdb.Run{JobID: queuedJobId, RunID: runId, Queue: testQueueName, JobSet: "set1"}},
+		},
+		MoveJobs{queuedJobId: move, leasedJobId: move, otherJobSetJobId: move},
+	}
+
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 10*time.Second)
+	defer cancel()
+	err := schedulerdb.WithTestDb(func(q *schedulerdb.Queries, db *pgxpool.Pool) error {
+		schedulerDb := NewSchedulerDb(db, metrics.NewMetrics("test"), time.Second, time.Second, 10*time.Second)
+		require.NoError(t, schedulerDb.Store(ctx, &DbOperationsWithMessageIds{Ops: ops}))
+
+		jobs, err := q.SelectNewJobs(ctx, schedulerdb.SelectNewJobsParams{Serial: 0, Limit: 10})
+		require.NoError(t, err)
+		jobsById := armadaslices.GroupByFuncUnique(jobs, func(job schedulerdb.Job) string { return job.JobID })
+
+		// Only the job that is queued in the source job set is moved.
+		assert.Equal(t, "queue2", jobsById[queuedJobId].Queue)
+		assert.Equal(t, "set2", jobsById[queuedJobId].JobSet)
+		assert.Equal(t, testQueueName, jobsById[leasedJobId].Queue)
+		assert.Equal(t, "set1", jobsById[leasedJobId].JobSet)
+		assert.Equal(t, testQueueName, jobsById[otherJobSetJobId].Queue)
+		assert.Equal(t, "set3", jobsById[otherJobSetJobId].JobSet)
+
+		runs, err := q.SelectNewRuns(ctx, schedulerdb.SelectNewRunsParams{Serial: 0, Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, runs, 1)
+		assert.Equal(t, "queue2", runs[0].Queue)
+		assert.Equal(t, "set2", runs[0].JobSet)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestSuspendJobs(t *testing.T) {
+	suspendedJobId := util.NewULID()
+	resumedJobId := util.NewULID()
+	failedJobId := util.NewULID()
+	ops := []DbOperation{
+		addDefaultValues(InsertJobs{
+			suspendedJobId: &JobInsertion{Job: &schedulerdb.Job{JobID: suspendedJobId, Queue: testQueueName, JobSet: "set1", Queued: true}},
+			resumedJobId:   &JobInsertion{Job: &schedulerdb.Job{JobID: resumedJobId, Queue: testQueueName, JobSet: "set1", Queued: true}},
+			failedJobId:    &JobInsertion{Job: &schedulerdb.Job{JobID: failedJobId, Queue: testQueueName, JobSet: "set1", Failed: true}},
+		}),
+		MarkJobsSuspended{suspendedJobId: true, resumedJobId: true, failedJobId: true},
+		MarkJobsSuspended{resumedJobId: false},
+	}
+
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 10*time.Second)
+	defer cancel()
+	err := schedulerdb.WithTestDb(func(q *schedulerdb.Queries, db *pgxpool.Pool) error {
+		schedulerDb := NewSchedulerDb(db, metrics.NewMetrics("test"), time.Second, time.Second, 10*time.Second)
+		require.NoError(t, schedulerDb.Store(ctx, &DbOperationsWithMessageIds{Ops: ops}))
+
+		jobs, err := q.SelectNewJobs(ctx, schedulerdb.SelectNewJobsParams{Serial: 0, Limit: 10})
+		require.NoError(t, err)
+		jobsById := armadaslices.GroupByFuncUnique(jobs, func(job schedulerdb.Job) string { return job.JobID })
+
+		assert.True(t, jobsById[suspendedJobId].Suspended)
+		assert.False(t, jobsById[resumedJobId].Suspended)
+		// Finished jobs can't be suspended.
+		assert.False(t, jobsById[failedJobId].Suspended)
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -99,6 +99,21 @@ type SubmissionConfig struct {
 	AddGangIdLabel bool
 	// Controls whether custom service names are allowed
 	AllowCustomServiceNames bool
+	// How long the results of cancel, preempt and reprioritize requests carrying an idempotency key are replayed for.
+	// Zero means results are replayed until removed by the Lookout pruner, which uses its deduplication lifetime.
+	IdempotencyKeyTtl time.Duration
+	// Maximum number of jobs a single cancel or reprioritize by selector request may act on.
+	// Requests matching more jobs than this are rejected; dry runs still report the full count.
+	MaxJobSelectorMatches uint32
+	// Keys of the labels that can be used in job selectors. The scheduler only stores these labels for each job,
+	// so this must match the scheduler ingester's selectableJobLabels.
+	SelectableJobLabels []string
+	// As SelectableJobLabels, but for annotations.
+	SelectableJobAnnotations []string
+	// Names of the secrets, held in executors' job secret namespaces, that jobs in each queue may copy.
+	// Jobs referencing any other secret are rejected. Executors additionally only copy secrets whose
+	// armadaproject.io/jobSecretQueues annotation lists the job's queue.
+	JobSecretsByQueue map[string][]string
 }
 
 // TODO: we can probably just typedef this to map[string]string
@@ -34,6 +34,12 @@ func FromEventSequence(es *armadaevents.EventSequence) ([]*api.EventMessage, err
 			convertedEvents, err = FromInternalReprioritiseJob(es.UserId, es.Queue, es.JobSetName, eventTs, esEvent.ReprioritiseJob)
 		case *armadaevents.EventSequence_Event_ReprioritisedJob:
 			convertedEvents, err = FromInternalReprioritisedJob(es.UserId, es.Queue, es.JobSetName, eventTs, esEvent.ReprioritisedJob)
+		case *armadaevents.EventSequence_Event_MoveJob:
+			convertedEvents, err = FromInternalMoveJob(es.UserId, es.Queue, es.JobSetName, eventTs, esEvent.MoveJob)
+		case *armadaevents.EventSequence_Event_SuspendJob:
+			convertedEvents, err = FromInternalSuspendJob(es.UserId, es.Queue, es.JobSetName, eventTs, esEvent.SuspendJob)
+		case *armadaevents.EventSequence_Event_ResumeJob:
+			convertedEvents, err = FromInternalResumeJob(es.UserId, es.Queue, es.JobSetName, eventTs, esEvent.ResumeJob)
 		case *armadaevents.EventSequence_Event_JobRunLeased:
 			convertedEvents, err = FromInternalLogJobRunLeased(es.Queue, es.JobSetName, eventTs, esEvent.JobRunLeased)
 		case *armadaevents.EventSequence_Event_JobRunErrors:
@@ -60,6 +66,7 @@ func FromEventSequence(es *armadaevents.EventSequence) ([]*api.EventMessage, err
 			*armadaevents.EventSequence_Event_JobRunSucceeded,
 			*armadaevents.EventSequence_Event_JobRequeued,
 			*armadaevents.EventSequence_Event_JobValidated,
+			*armadaevents.EventSequence_Event_UpdateQueuedJob,
 			*armadaevents.EventSequence_Event_PartitionMarker:
 			// These events have no api analog right now, so we ignore
 			log.Debugf("ignoring event type %T", esEvent)
@@ -194,6 +201,57 @@ func FromInternalReprioritisedJob(userId string, queueName string, jobSetName st
 	}, nil
 }
 
+func FromInternalMoveJob(userId string, queueName string, jobSetName string, time time.Time, e *armadaevents.MoveJob) ([]*api.EventMessage, error) {
+	return []*api.EventMessage{
+		{
+			Events: &api.EventMessage_Moved{
+				Moved: &api.JobMovedEvent{
+					JobId:               e.JobId,
+					JobSetId:            jobSetName,
+					Queue:               queueName,
+					Created:             protoutil.ToTimestamp(time),
+					DestinationQueue:    e.DestinationQueue,
+					DestinationJobSetId: e.DestinationJobSetId,
+					Requestor:           userId,
+				},
+			},
+		},
+	}, nil
+}
+
+func FromInternalSuspendJob(userId string, queueName string, jobSetName string, time time.Time, e *armadaevents.SuspendJob) ([]*api.EventMessage, error) {
+	return []*api.EventMessage{
+		{
+			Events: &api.EventMessage_Suspended{
+				Suspended: &api.JobSuspendedEvent{
+					JobId:     e.JobId,
+					JobSetId:  jobSetName,
+					Queue:     queueName,
+					Created:   protoutil.ToTimestamp(time),
+					Requestor: userId,
+					Reason:    e.Reason,
+				},
+			},
+		},
+	}, nil
+}
+
+func FromInternalResumeJob(userId string, queueName string, jobSetName string, time time.Time, e *armadaevents.ResumeJob) ([]*api.EventMessage, error) {
+	return []*api.EventMessage{
+		{
+			Events: &api.EventMessage_Resumed{
+				Resumed: &api.JobResumedEvent{
+					JobId:     e.JobId,
+					JobSetId:  jobSetName,
+					Queue:     queueName,
+					Created:   protoutil.ToTimestamp(time),
+					Requestor: userId,
+				},
+			},
+		},
+	}, nil
+}
+
 func FromInternalLogJobRunLeased(queueName string, jobSetName string, time time.Time, e *armadaevents.JobRunLeased) ([]*api.EventMessage, error) {
 	return []*api.EventMessage{
 		{
@@ -226,6 +226,99 @@ func TestConvertReprioritised(t *testing.T) {
 	assert.Equal(t, expected, apiEvents)
 }
 
+func TestConvertMoveJob(t *testing.T) {
+	moved := &armadaevents.EventSequence_Event{
+		Created: baseTimeProto,
+		Event: &armadaevents.EventSequence_Event_MoveJob{
+			MoveJob: &armadaevents.MoveJob{
+				JobId:               jobId,
+				DestinationQueue:    "other-queue",
+				DestinationJobSetId: "other-job-set",
+			},
+		},
+	}
+
+	expected := []*api.EventMessage{
+		{
+			Events: &api.EventMessage_Moved{
+				Moved: &api.JobMovedEvent{
+					JobId:               jobId,
+					JobSetId:            jobSetName,
+					Queue:               queue,
+					Created:             protoutil.ToTimestamp(baseTime),
+					DestinationQueue:    "other-queue",
+					DestinationJobSetId: "other-job-set",
+					Requestor:           userId,
+				},
+			},
+		},
+	}
+
+	apiEvents, err := FromEventSequence(toEventSeq(moved))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, apiEvents)
+}
+
+func TestConvertSuspendJob(t *testing.T) {
+	suspended := &armadaevents.EventSequence_Event{
+		Created: baseTimeProto,
+		Event: &armadaevents.EventSequence_Event_SuspendJob{
+			SuspendJob: &armadaevents.SuspendJob{
+				JobId:  jobId,
+				Reason: "maintenance",
+			},
+		},
+	}
+
+	expected := []*api.EventMessage{
+		{
+			Events: &api.EventMessage_Suspended{
+				Suspended: &api.JobSuspendedEvent{
+					JobId:     jobId,
+					JobSetId:  jobSetName,
+					Queue:     queue,
+					Created:   protoutil.ToTimestamp(baseTime),
+					Requestor: userId,
+					Reason:    "maintenance",
+				},
+			},
+		},
+	}
+
+	apiEvents, err := FromEventSequence(toEventSeq(suspended))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, apiEvents)
+}
+
+func TestConvertResumeJob(t *testing.T) {
+	resumed := &armadaevents.EventSequence_Event{
+		Created: baseTimeProto,
+		Event: &armadaevents.EventSequence_Event_ResumeJob{
+			ResumeJob: &armadaevents.ResumeJob{
+				JobId: jobId,
+			},
+		},
+	}
+
+	expected := []*api.EventMessage{
+		{
+			Events: &api.EventMessage_Resumed{
+				Resumed: &api.JobResumedEvent{
+					JobId:     jobId,
+					JobSetId:  jobSetName,
+					Queue:     queue,
+					Created:   protoutil.ToTimestamp(baseTime),
+					Requestor: userId,
+				},
+			},
+		},
+	}
+
+	apiEvents, err := FromEventSequence(toEventSeq(resumed))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, apiEvents)
+}
+
 func TestConvertLeased(t *testing.T) {
 	leased := &armadaevents.EventSequence_Event{
 		Created: baseTimeProto,
@@ -5,3 +5,4 @@ package mocks
 //go:generate mockgen -destination=./mock_authorizer.go -package=mocks "github.com/armadaproject/armada/internal/common/auth" ActionAuthorizer
 //go:generate mockgen -destination=./mock_repository.go -package=mocks "github.com/armadaproject/armada/internal/server/queue" QueueRepository
 //go:generate mockgen -destination=./mock_retry_policy_repository.go -package=mocks "github.com/armadaproject/armada/internal/server/retrypolicy" RetryPolicyRepository
+//go:generate mockgen -destination=./mock_job_selection_client.go -package=mocks "github.com/armadaproject/armada/pkg/api/schedulerobjects" JobSelectionClient
@@ -56,6 +56,23 @@ func (mr *MockDeduplicatorMockRecorder) GetOriginalJobIds(ctx, queue, jobRequest
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOriginalJobIds", reflect.TypeOf((*MockDeduplicator)(nil).GetOriginalJobIds), ctx, queue, jobRequests)
 }
 
+// GetRequestResult mocks base method.
+func (m *MockDeduplicator) GetRequestResult(ctx *armadacontext.Context, queue, requestType, idempotencyKey string) (string, []byte, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequestResult", ctx, queue, requestType, idempotencyKey)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(bool)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetRequestResult indicates an expected call of GetRequestResult.
+func (mr *MockDeduplicatorMockRecorder) GetRequestResult(ctx, queue, requestType, idempotencyKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestResult", reflect.TypeOf((*MockDeduplicator)(nil).GetRequestResult), ctx, queue, requestType, idempotencyKey)
+}
+
 // StoreOriginalJobIds mocks base method.
 func (m *MockDeduplicator) StoreOriginalJobIds(ctx *armadacontext.Context, queue string, mappings map[string]string) error {
 	m.ctrl.T.Helper()
@@ -69,3 +86,17 @@ func (mr *MockDeduplicatorMockRecorder) StoreOriginalJobIds(ctx, queue, mappings
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreOriginalJobIds", reflect.TypeOf((*MockDeduplicator)(nil).StoreOriginalJobIds), ctx, queue, mappings)
 }
+
+// StoreRequestResult mocks base method.
+func (m *MockDeduplicator) StoreRequestResult(ctx *armadacontext.Context, queue, requestType, idempotencyKey, requestHash string, result []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreRequestResult", ctx, queue, requestType, idempotencyKey, requestHash, result)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreRequestResult indicates an expected call of StoreRequestResult.
+func (mr *MockDeduplicatorMockRecorder) StoreRequestResult(ctx, queue, requestType, idempotencyKey, requestHash, result any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreRequestResult", reflect.TypeOf((*MockDeduplicator)(nil).StoreRequestResult), ctx, queue, requestType, idempotencyKey, requestHash, result)
+}
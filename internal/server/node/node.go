@@ -115,3 +115,40 @@ func (s *Server) CancelOnNode(grpcCtx context.Context, req *api.NodeCancelReques
 
 	return &types.Empty{}, nil
 }
+
+// ReleaseNodeQuarantine implements api.NodeServer.
+func (s *Server) ReleaseNodeQuarantine(grpcCtx context.Context, req *api.NodeQuarantineReleaseRequest) (*types.Empty, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	err := s.authorizer.AuthorizeAction(ctx, permissions.CordonNodes)
+
+	var ep *armadaerrors.ErrUnauthorized
+	if errors.As(err, &ep) {
+		return nil, status.Errorf(codes.PermissionDenied, "error releasing node %s from quarantine: %s", req.Name, ep)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "error checking permissions: %s", err)
+	}
+
+	if req.Name == "" {
+		return nil, fmt.Errorf("must provide non-empty node name when releasing a node from quarantine")
+	}
+
+	if req.Executor == "" {
+		return nil, fmt.Errorf("must provide non-empty executor id when releasing a node from quarantine")
+	}
+
+	es := &controlplaneevents.Event{
+		Created: protoutil.ToTimestamp(s.clock.Now().UTC()),
+		Event: &controlplaneevents.Event_ReleaseNodeQuarantine{
+			ReleaseNodeQuarantine: &controlplaneevents.ReleaseNodeQuarantine{
+				Name:     req.Name,
+				Executor: req.Executor,
+			},
+		},
+	}
+
+	if err := s.publisher.PublishMessages(ctx, es); err != nil {
+		return nil, status.Errorf(codes.Internal, "error publishing release node quarantine event for node %s: %s", req.Name, err)
+	}
+
+	return &types.Empty{}, nil
+}
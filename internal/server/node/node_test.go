@@ -265,3 +265,73 @@ func TestCancelOnNode_SuccessPublishesExpectedEvent(t *testing.T) {
 	assert.Equal(t, req.Queues, wrapped.CancelOnNode.Queues)
 	assert.Equal(t, req.PriorityClasses, wrapped.CancelOnNode.PriorityClasses)
 }
+
+func TestReleaseNodeQuarantine_PermissionDenied(t *testing.T) {
+	s, m := newTestServer(t)
+	ctx := armadacontext.Background()
+
+	m.authorizer.
+		EXPECT().
+		AuthorizeAction(ctx, permission.Permission(permissions.CordonNodes)).
+		Return(&armadaerrors.ErrUnauthorized{Principal: "alice", Permission: "cordon"}).
+		Times(1)
+
+	_, err := s.ReleaseNodeQuarantine(ctx, &api.NodeQuarantineReleaseRequest{Name: "node-1", Executor: "executor-id"})
+	require.Error(t, err)
+	requireGrpcCode(t, err, codes.PermissionDenied)
+}
+
+func TestReleaseNodeQuarantine_Validation(t *testing.T) {
+	s, m := newTestServer(t)
+	ctx := armadacontext.Background()
+
+	m.authorizer.
+		EXPECT().
+		AuthorizeAction(ctx, permission.Permission(permissions.CordonNodes)).
+		Return(nil).
+		Times(1)
+
+	_, err := s.ReleaseNodeQuarantine(ctx, &api.NodeQuarantineReleaseRequest{Name: "node-1", Executor: ""})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must provide non-empty executor id")
+}
+
+func TestReleaseNodeQuarantine_SuccessPublishesExpectedEvent(t *testing.T) {
+	s, m := newTestServer(t)
+	ctx := armadacontext.Background()
+
+	fixedTime := time.Date(2025, 2, 3, 4, 5, 6, 0, time.UTC)
+	s.clock = clocktesting.NewFakeClock(fixedTime)
+
+	req := &api.NodeQuarantineReleaseRequest{
+		Name:     "node-1",
+		Executor: "executor-id",
+	}
+
+	m.authorizer.
+		EXPECT().
+		AuthorizeAction(ctx, permission.Permission(permissions.CordonNodes)).
+		Return(nil).
+		Times(1)
+
+	var captured *controlplaneevents.Event
+	m.publisher.
+		EXPECT().
+		PublishMessages(ctx, gomock.Any()).
+		Do(func(_ *armadacontext.Context, ev *controlplaneevents.Event) {
+			captured = ev
+		}).
+		Return(nil).
+		Times(1)
+
+	_, err := s.ReleaseNodeQuarantine(ctx, req)
+	require.NoError(t, err)
+	require.NotNil(t, captured)
+	assert.Equal(t, protoutil.ToTimestamp(fixedTime.UTC()), captured.Created)
+
+	wrapped, ok := captured.Event.(*controlplaneevents.Event_ReleaseNodeQuarantine)
+	require.True(t, ok, "expected Event_ReleaseNodeQuarantine")
+	require.NotNil(t, wrapped.ReleaseNodeQuarantine)
+	assert.Equal(t, req.Name, wrapped.ReleaseNodeQuarantine.Name)
+	assert.Equal(t, req.Executor, wrapped.ReleaseNodeQuarantine.Executor)
+}
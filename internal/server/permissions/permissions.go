@@ -20,4 +20,5 @@ const (
 	CreateRetryPolicy                            = "create_retry_policy"
 	UpdateRetryPolicy                            = "update_retry_policy"
 	DeleteRetryPolicy                            = "delete_retry_policy"
+	SelectJobs                                   = "select_jobs"
 )
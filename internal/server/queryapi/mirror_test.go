@@ -12,14 +12,12 @@ import (
 	dbcommon "github.com/armadaproject/armada/internal/common/database"
 	"github.com/armadaproject/armada/internal/common/database/lookout"
 	lookoutschema "github.com/armadaproject/armada/internal/lookout/schema"
-	lookouthcschema "github.com/armadaproject/armada/internal/lookouthc/schema"
 	"github.com/armadaproject/armada/internal/server/queryapi/database"
 	"github.com/armadaproject/armada/pkg/api"
 )
 
-// withPrimaryAndMirrorDbs runs action with two independent test databases: a
-// primary carrying the standard lookout schema and a mirror carrying the
-// experimental hot-cold partitioned schema.
+// withPrimaryAndMirrorDbs runs action with two independent test databases,
+// both carrying the lookout schema: a primary and a mirror.
 func withPrimaryAndMirrorDbs(t *testing.T, action func(primary, mirror *pgxpool.Pool)) {
 	t.Helper()
 	migrations, err := lookoutschema.LookoutMigrations()
@@ -27,7 +25,6 @@ func withPrimaryAndMirrorDbs(t *testing.T, action func(primary, mirror *pgxpool.
 
 	err = dbcommon.WithTestDb(migrations, func(primary *pgxpool.Pool) error {
 		return dbcommon.WithTestDb(migrations, func(mirror *pgxpool.Pool) error {
-			require.NoError(t, lookouthcschema.ApplyPartitioner(armadacontext.Background(), mirror))
 			action(primary, mirror)
 			return nil
 		})
@@ -185,18 +185,20 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 	queueServer := queue.NewServer(controlPlaneEventsPublisher, queueRepository, authorizer)
 	retryPolicyServer := retrypolicy.NewServer(retryPolicyRepo, authorizer)
 
+	schedulerApiConnection, err := createApiConnection(config.SchedulerApiConnection)
+	if err != nil {
+		return errors.Wrapf(err, "error creating connection to scheduler api")
+	}
+
 	submitServer := submit.NewServer(
 		queueServer,
 		jobSetEventsPublisher,
 		queueCache,
 		config.Submission,
-		submit.NewDeduplicator(dbPool),
-		authorizer)
+		submit.NewDeduplicator(dbPool, config.Submission.IdempotencyKeyTtl),
+		authorizer,
+		schedulerobjects.NewJobSelectionClient(schedulerApiConnection))
 
-	schedulerApiConnection, err := createApiConnection(config.SchedulerApiConnection)
-	if err != nil {
-		return errors.Wrapf(err, "error creating connection to scheduler api")
-	}
 	schedulerApiReportsClient := schedulerobjects.NewSchedulerReportingClient(schedulerApiConnection)
 	schedulingReportsServer := reports.NewProxyingSchedulingReportsServer(schedulerApiReportsClient)
 
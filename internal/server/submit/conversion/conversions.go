@@ -28,7 +28,9 @@ func SubmitJobFromApiRequest(
 ) *armadaevents.SubmitJob {
 	jobId := idGen()
 	priority := PriorityAsInt32(jobReq.GetPriority())
-	ingressesAndServices := convertIngressesAndServices(config, jobReq, jobId, jobSetId, queue, owner)
+	objects := convertIngressesAndServices(config, jobReq, jobId)
+	objects = append(objects, convertConfigMapsSecretsAndVolumeClaims(jobReq)...)
+	addStandardObjectMetadata(objects, jobReq.Namespace, jobId, jobSetId, queue, owner)
 
 	// Resolve externalJobUri: prefer the proto field, fall back to annotation.
 	externalJobUri := jobReq.GetExternalJobUri()
@@ -52,7 +54,7 @@ func SubmitJobFromApiRequest(
 				},
 			},
 		},
-		Objects:        ingressesAndServices,
+		Objects:        objects,
 		Scheduler:      jobReq.Scheduler,
 		ExternalJobUri: externalJobUri,
 	}
@@ -66,7 +68,7 @@ func SubmitJobFromApiRequest(
 func convertIngressesAndServices(
 	config configuration.SubmissionConfig,
 	jobReq *api.JobSubmitRequestItem,
-	jobId, jobsetId, queue, owner string,
+	jobId string,
 ) []*armadaevents.KubernetesObject {
 	objects := make([]*armadaevents.KubernetesObject, 0, 2*len(jobReq.Ingress)+len(jobReq.Services))
 	serviceIdx := 0
@@ -193,10 +195,64 @@ func convertIngressesAndServices(
 		}
 	}
 
-	// Add standard annotations and labels to all objects
+	return objects
+}
+
+// Creates KubernetesObjects representing the ConfigMaps, secret references and PersistentVolumeClaim templates
+// from the *api.JobSubmitRequestItem. Secrets are carried by reference only; the executor copies the data.
+func convertConfigMapsSecretsAndVolumeClaims(jobReq *api.JobSubmitRequestItem) []*armadaevents.KubernetesObject {
+	objects := make([]*armadaevents.KubernetesObject, 0, len(jobReq.ConfigMaps)+len(jobReq.Secrets)+len(jobReq.VolumeClaimTemplates))
+	for _, configMap := range jobReq.ConfigMaps {
+		objects = append(objects, &armadaevents.KubernetesObject{
+			ObjectMeta: &armadaevents.ObjectMeta{
+				Name:        configMap.Name,
+				Annotations: util.MergeMaps(map[string]string{}, configMap.Annotations),
+				Labels:      util.MergeMaps(map[string]string{}, configMap.Labels),
+			},
+			Object: &armadaevents.KubernetesObject_ConfigMap{
+				ConfigMap: &v1.ConfigMap{
+					Immutable:  configMap.Immutable,
+					Data:       configMap.Data,
+					BinaryData: configMap.BinaryData,
+				},
+			},
+		})
+	}
+	for _, secret := range jobReq.Secrets {
+		objects = append(objects, &armadaevents.KubernetesObject{
+			ObjectMeta: &armadaevents.ObjectMeta{
+				Name:        secret.Name,
+				Annotations: map[string]string{},
+				Labels:      map[string]string{},
+			},
+			Object: &armadaevents.KubernetesObject_Secret{
+				Secret: &armadaevents.SecretReference{
+					SourceName: secret.SourceName,
+				},
+			},
+		})
+	}
+	for _, template := range jobReq.VolumeClaimTemplates {
+		spec := template.Spec
+		objects = append(objects, &armadaevents.KubernetesObject{
+			ObjectMeta: &armadaevents.ObjectMeta{
+				Name:        template.Name,
+				Annotations: util.MergeMaps(map[string]string{}, template.Annotations),
+				Labels:      util.MergeMaps(map[string]string{}, template.Labels),
+			},
+			Object: &armadaevents.KubernetesObject_PersistentVolumeClaim{
+				PersistentVolumeClaim: &spec,
+			},
+		})
+	}
+	return objects
+}
+
+// Adds the namespace and standard annotations and labels to all objects.
+func addStandardObjectMetadata(objects []*armadaevents.KubernetesObject, namespace, jobId, jobsetId, queue, owner string) {
 	for _, object := range objects {
 		md := object.GetObjectMeta()
-		md.Namespace = jobReq.Namespace
+		md.Namespace = namespace
 
 		annotations := md.GetAnnotations()
 		annotations[domain.JobSetId] = jobsetId
@@ -206,8 +262,6 @@ func convertIngressesAndServices(
 		labels[domain.JobId] = jobId
 		labels[domain.Queue] = queue
 	}
-
-	return objects
 }
 
 func createService(
@@ -9,6 +9,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/armadaproject/armada/internal/common/constants"
 	"github.com/armadaproject/armada/internal/server/configuration"
@@ -597,6 +598,71 @@ func TestSubmitJobFromApiRequest(t *testing.T) {
 			}),
 			submissionConfig: testfixtures.DefaultSubmissionConfig(),
 		},
+		"ConfigMaps, secrets and volume claims": {
+			jobReq: jobSubmitRequestItemWithAdditionalObjects(),
+			expectedSubmitJob: SubmitJobMsgWithK8sObjects([]*armadaevents.KubernetesObject{
+				{
+					ObjectMeta: &armadaevents.ObjectMeta{
+						Namespace: testfixtures.DefaultNamespace,
+						Name:      "job-config",
+						Annotations: map[string]string{
+							"armada_jobset_id": testfixtures.DefaultJobset,
+							"armada_owner":     testfixtures.DefaultOwner,
+						},
+						Labels: map[string]string{
+							"app":             "test",
+							"armada_job_id":   "00000000000000000000000001",
+							"armada_queue_id": testfixtures.DefaultQueue.Name,
+						},
+					},
+					Object: &armadaevents.KubernetesObject_ConfigMap{
+						ConfigMap: &v1.ConfigMap{
+							Data: map[string]string{"config.yaml": "key: value"},
+						},
+					},
+				},
+				{
+					ObjectMeta: &armadaevents.ObjectMeta{
+						Namespace: testfixtures.DefaultNamespace,
+						Name:      "job-creds",
+						Annotations: map[string]string{
+							"armada_jobset_id": testfixtures.DefaultJobset,
+							"armada_owner":     testfixtures.DefaultOwner,
+						},
+						Labels: map[string]string{
+							"armada_job_id":   "00000000000000000000000001",
+							"armada_queue_id": testfixtures.DefaultQueue.Name,
+						},
+					},
+					Object: &armadaevents.KubernetesObject_Secret{
+						Secret: &armadaevents.SecretReference{SourceName: "team-creds"},
+					},
+				},
+				{
+					ObjectMeta: &armadaevents.ObjectMeta{
+						Namespace: testfixtures.DefaultNamespace,
+						Name:      "job-scratch",
+						Annotations: map[string]string{
+							"armada_jobset_id": testfixtures.DefaultJobset,
+							"armada_owner":     testfixtures.DefaultOwner,
+						},
+						Labels: map[string]string{
+							"armada_job_id":   "00000000000000000000000001",
+							"armada_queue_id": testfixtures.DefaultQueue.Name,
+						},
+					},
+					Object: &armadaevents.KubernetesObject_PersistentVolumeClaim{
+						PersistentVolumeClaim: &v1.PersistentVolumeClaimSpec{
+							AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+							Resources: v1.VolumeResourceRequirements{
+								Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse("1Gi")},
+							},
+						},
+					},
+				},
+			}),
+			submissionConfig: testfixtures.DefaultSubmissionConfig(),
+		},
 	}
 
 	for name, tc := range tests {
@@ -811,6 +877,29 @@ func jobSubmitRequestItemWithServices(s []*api.ServiceConfig) *api.JobSubmitRequ
 	return req
 }
 
+func jobSubmitRequestItemWithAdditionalObjects() *api.JobSubmitRequestItem {
+	req := testfixtures.JobSubmitRequestItem(1)
+	req.ConfigMaps = []*v1.ConfigMap{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "job-config", Labels: map[string]string{"app": "test"}},
+			Data:       map[string]string{"config.yaml": "key: value"},
+		},
+	}
+	req.Secrets = []*api.SecretReference{{Name: "job-creds", SourceName: "team-creds"}}
+	req.VolumeClaimTemplates = []*v1.PersistentVolumeClaimTemplate{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "job-scratch"},
+			Spec: v1.PersistentVolumeClaimSpec{
+				AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+				Resources: v1.VolumeResourceRequirements{
+					Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse("1Gi")},
+				},
+			},
+		},
+	}
+	return req
+}
+
 func jobSubmitRequestItemWithIngresses(i []*api.IngressConfig) *api.JobSubmitRequestItem {
 	req := testfixtures.JobSubmitRequestItem(1)
 	req.Ingress = i
@@ -92,7 +92,7 @@ func TestDeduplicator(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Second)
 			err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
-				deduplicator := NewDeduplicator(db)
+				deduplicator := NewDeduplicator(db, time.Hour)
 
 				// Store
 				for _, keys := range tc.initialKeys {
@@ -113,3 +113,56 @@ func TestDeduplicator(t *testing.T) {
 		})
 	}
 }
+
+func TestDeduplicator_RequestResults(t *testing.T) {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Second)
+	defer cancel()
+	err := lookout.WithLookoutDb(func(db *pgxpool.Pool) error {
+		deduplicator := NewDeduplicator(db, time.Hour)
+
+		// Nothing stored
+		_, _, exists, err := deduplicator.GetRequestResult(ctx, "testQueue", "cancel-jobs", "foo")
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		// Store and fetch
+		err = deduplicator.StoreRequestResult(ctx, "testQueue", "cancel-jobs", "foo", "hash", []byte("original"))
+		require.NoError(t, err)
+		hash, result, exists, err := deduplicator.GetRequestResult(ctx, "testQueue", "cancel-jobs", "foo")
+		require.NoError(t, err)
+		assert.True(t, exists)
+		assert.Equal(t, "hash", hash)
+		assert.Equal(t, []byte("original"), result)
+
+		// Storing again keeps the original result
+		err = deduplicator.StoreRequestResult(ctx, "testQueue", "cancel-jobs", "foo", "other", []byte("retry"))
+		require.NoError(t, err)
+		hash, result, _, err = deduplicator.GetRequestResult(ctx, "testQueue", "cancel-jobs", "foo")
+		require.NoError(t, err)
+		assert.Equal(t, "hash", hash)
+		assert.Equal(t, []byte("original"), result)
+
+		// Queue and request type are part of the key
+		_, _, exists, err = deduplicator.GetRequestResult(ctx, "anotherTestQueue", "cancel-jobs", "foo")
+		require.NoError(t, err)
+		assert.False(t, exists)
+		_, _, exists, err = deduplicator.GetRequestResult(ctx, "testQueue", "preempt-jobs", "foo")
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		// Results older than the TTL are not returned
+		_, err = db.Exec(ctx, "UPDATE request_deduplication SET inserted = now() - interval '2 hours'")
+		require.NoError(t, err)
+		_, _, exists, err = deduplicator.GetRequestResult(ctx, "testQueue", "cancel-jobs", "foo")
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		// Unless there is no TTL
+		_, result, exists, err = NewDeduplicator(db, 0).GetRequestResult(ctx, "testQueue", "cancel-jobs", "foo")
+		require.NoError(t, err)
+		assert.True(t, exists)
+		assert.Equal(t, []byte("original"), result)
+		return nil
+	})
+	assert.NoError(t, err)
+}
@@ -1,8 +1,11 @@
 package submit
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"golang.org/x/exp/maps"
 
@@ -10,19 +13,24 @@ import (
 	"github.com/armadaproject/armada/pkg/api"
 )
 
-// Deduplicator deduplicates jobs submitted ot armada in order to prevent double submission
+// Deduplicator deduplicates jobs submitted ot armada in order to prevent double submission.
+// It also stores the results of requests carrying an idempotency key so that retried requests can be replayed.
 type Deduplicator interface {
 	GetOriginalJobIds(ctx *armadacontext.Context, queue string, jobRequests []*api.JobSubmitRequestItem) (map[string]string, error)
 	StoreOriginalJobIds(ctx *armadacontext.Context, queue string, mappings map[string]string) error
+	GetRequestResult(ctx *armadacontext.Context, queue string, requestType string, idempotencyKey string) (string, []byte, bool, error)
+	StoreRequestResult(ctx *armadacontext.Context, queue string, requestType string, idempotencyKey string, requestHash string, result []byte) error
 }
 
 // PostgresDeduplicator is an implementation of a Deduplicator that uses a pgkeyvalue.KeyValueStore as its state store
 type PostgresDeduplicator struct {
 	db *pgxpool.Pool
+	// How long request results are returned for. Zero means results are returned until they are pruned.
+	requestResultTtl time.Duration
 }
 
-func NewDeduplicator(db *pgxpool.Pool) *PostgresDeduplicator {
-	return &PostgresDeduplicator{db: db}
+func NewDeduplicator(db *pgxpool.Pool, requestResultTtl time.Duration) *PostgresDeduplicator {
+	return &PostgresDeduplicator{db: db, requestResultTtl: requestResultTtl}
 }
 
 func (s *PostgresDeduplicator) GetOriginalJobIds(ctx *armadacontext.Context, queue string, jobRequests []*api.JobSubmitRequestItem) (map[string]string, error) {
@@ -63,10 +71,50 @@ func (s *PostgresDeduplicator) StoreOriginalJobIds(ctx *armadacontext.Context, q
 	return s.storeMappings(ctx, kvs)
 }
 
+// GetRequestResult returns the hash and serialised result of a previously processed request with the given
+// idempotency key. The request hash allows callers to detect a key being reused for a different request.
+// The boolean return value is false if no such request has been processed within the request result TTL.
+// Expired results are deleted separately by the Lookout pruner.
+func (s *PostgresDeduplicator) GetRequestResult(ctx *armadacontext.Context, queue string, requestType string, idempotencyKey string) (string, []byte, bool, error) {
+	sql := `
+        SELECT request_hash, result
+        FROM request_deduplication
+        WHERE deduplication_id = $1
+        AND ($2::float8 = 0 OR inserted > now() - make_interval(secs => $2::float8))
+    `
+	var requestHash string
+	var result []byte
+	err := s.db.QueryRow(ctx, sql, s.requestKey(queue, requestType, idempotencyKey), s.requestResultTtl.Seconds()).
+		Scan(&requestHash, &result)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", nil, false, nil
+	}
+	if err != nil {
+		return "", nil, false, err
+	}
+	return requestHash, result, true, nil
+}
+
+// StoreRequestResult stores the hash and serialised result of a request with the given idempotency key.
+// If a result has already been stored for this key then the original result is kept.
+func (s *PostgresDeduplicator) StoreRequestResult(ctx *armadacontext.Context, queue string, requestType string, idempotencyKey string, requestHash string, result []byte) error {
+	sql := `
+        INSERT INTO request_deduplication (deduplication_id, request_hash, result)
+        VALUES ($1, $2, $3)
+        ON CONFLICT (deduplication_id) DO NOTHING
+    `
+	_, err := s.db.Exec(ctx, sql, s.requestKey(queue, requestType, idempotencyKey), requestHash, result)
+	return err
+}
+
 func (s *PostgresDeduplicator) jobKey(queue, clientId string) string {
 	return fmt.Sprintf("%s:%s", queue, clientId)
 }
 
+func (s *PostgresDeduplicator) requestKey(queue, requestType, idempotencyKey string) string {
+	return fmt.Sprintf("%s:%s:%s", queue, requestType, idempotencyKey)
+}
+
 func (s *PostgresDeduplicator) storeMappings(ctx *armadacontext.Context, mappings map[string]string) error {
 	deduplicationIDs := make([]string, 0, len(mappings))
 	jobIDs := make([]string, 0, len(mappings))
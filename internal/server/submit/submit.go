@@ -2,8 +2,12 @@ package submit
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"sort"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/gogo/status"
 	"google.golang.org/grpc/codes"
@@ -13,6 +17,7 @@ import (
 	"github.com/armadaproject/armada/internal/common/auth"
 	"github.com/armadaproject/armada/internal/common/auth/permission"
 	log "github.com/armadaproject/armada/internal/common/logging"
+	armadamaps "github.com/armadaproject/armada/internal/common/maps"
 	protoutil "github.com/armadaproject/armada/internal/common/proto"
 	"github.com/armadaproject/armada/internal/common/pulsarutils"
 	"github.com/armadaproject/armada/internal/common/slices"
@@ -23,10 +28,19 @@ import (
 	"github.com/armadaproject/armada/internal/server/submit/conversion"
 	"github.com/armadaproject/armada/internal/server/submit/validation"
 	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/api/schedulerobjects"
 	"github.com/armadaproject/armada/pkg/armadaevents"
 	"github.com/armadaproject/armada/pkg/client/queue"
 )
 
+// Request types used to namespace idempotency keys, so that the same key may be reused across different operations.
+const (
+	cancelJobsRequestType       = "cancel-jobs"
+	cancelJobSetRequestType     = "cancel-job-set"
+	preemptJobsRequestType      = "preempt-jobs"
+	reprioritizeJobsRequestType = "reprioritize-jobs"
+)
+
 // Server is a service that accepts API calls according to the original Armada submit API and publishes messages
 // to Pulsar based on those calls.
 type Server struct {
@@ -36,6 +50,8 @@ type Server struct {
 	submissionConfig configuration.SubmissionConfig
 	deduplicator     Deduplicator
 	authorizer       auth.ActionAuthorizer
+	// Used to resolve job selectors against the scheduler's view of active jobs
+	jobSelectionClient schedulerobjects.JobSelectionClient
 	// Below are used only for testing
 	clock       clock.Clock
 	idGenerator func() string
@@ -48,16 +64,18 @@ func NewServer(
 	submissionConfig configuration.SubmissionConfig,
 	deduplicator Deduplicator,
 	authorizer auth.ActionAuthorizer,
+	jobSelectionClient schedulerobjects.JobSelectionClient,
 ) *Server {
 	return &Server{
-		queueService:     queueService,
-		publisher:        publisher,
-		queueCache:       queueCache,
-		submissionConfig: submissionConfig,
-		deduplicator:     deduplicator,
-		authorizer:       authorizer,
-		clock:            clock.RealClock{},
-		idGenerator:      util.NewULID,
+		queueService:       queueService,
+		publisher:          publisher,
+		queueCache:         queueCache,
+		submissionConfig:   submissionConfig,
+		deduplicator:       deduplicator,
+		authorizer:         authorizer,
+		jobSelectionClient: jobSelectionClient,
+		clock:              clock.RealClock{},
+		idGenerator:        util.NewULID,
 	}
 }
 
@@ -169,9 +187,10 @@ func (s *Server) CancelJobs(grpcCtx context.Context, req *api.JobCancelRequest)
 	if len(jobIds) == 0 {
 		log.Warnf("CancelJobs called for queue=%s and jobset=%s but with empty job id. Redirecting to CancelJobSet()", req.Queue, req.JobSetId)
 		_, err := s.CancelJobSet(ctx, &api.JobSetCancelRequest{
-			Queue:    req.Queue,
-			JobSetId: req.JobSetId,
-			Reason:   req.Reason,
+			Queue:          req.Queue,
+			JobSetId:       req.JobSetId,
+			Reason:         req.Reason,
+			IdempotencyKey: req.IdempotencyKey,
 		})
 		if err != nil {
 			return nil, err
@@ -191,6 +210,13 @@ func (s *Server) CancelJobs(grpcCtx context.Context, req *api.JobCancelRequest)
 		return nil, err
 	}
 
+	originalResult := &api.CancellationResult{}
+	if replayed, err := s.getIdempotentResult(ctx, req.Queue, cancelJobsRequestType, req.IdempotencyKey, req, originalResult); err != nil {
+		return nil, err
+	} else if replayed {
+		return originalResult, nil
+	}
+
 	var cancelledIds []string
 	es, cancelledIds := eventSequenceForJobIds(s.clock, jobIds, req.Queue, req.JobSetId, userId, groups, req.Reason)
 
@@ -199,9 +225,12 @@ func (s *Server) CancelJobs(grpcCtx context.Context, req *api.JobCancelRequest)
 		log.WithError(err).Error("failed send to Pulsar")
 		return nil, status.Error(codes.Internal, "Failed to send message")
 	}
-	return &api.CancellationResult{
+
+	result := &api.CancellationResult{
 		CancelledIds: cancelledIds,
-	}, nil
+	}
+	s.storeIdempotentResult(ctx, req.Queue, cancelJobsRequestType, req.IdempotencyKey, req, result)
+	return result, nil
 }
 
 func (s *Server) PreemptJobs(grpcCtx context.Context, req *api.JobPreemptRequest) (*api.PreemptionResult, error) {
@@ -221,6 +250,13 @@ func (s *Server) PreemptJobs(grpcCtx context.Context, req *api.JobPreemptRequest
 		return nil, err
 	}
 
+	originalResult := &api.PreemptionResult{}
+	if replayed, err := s.getIdempotentResult(ctx, req.Queue, preemptJobsRequestType, req.IdempotencyKey, req, originalResult); err != nil {
+		return nil, err
+	} else if replayed {
+		return originalResult, nil
+	}
+
 	// results maps job ids to strings containing error messages.
 	results := make(map[string]string)
 
@@ -239,9 +275,11 @@ func (s *Server) PreemptJobs(grpcCtx context.Context, req *api.JobPreemptRequest
 		results[jobId] = "" // empty string indicates no error
 	}
 
-	return &api.PreemptionResult{
+	result := &api.PreemptionResult{
 		PreemptionResults: results,
-	}, nil
+	}
+	s.storeIdempotentResult(ctx, req.Queue, preemptJobsRequestType, req.IdempotencyKey, req, result)
+	return result, nil
 }
 
 func preemptJobEventSequenceForJobIds(clock clock.Clock, jobIds []string, q, jobSet, userId, reason string, groups []string) (*armadaevents.EventSequence, error) {
@@ -279,6 +317,13 @@ func (s *Server) ReprioritizeJobs(grpcCtx context.Context, req *api.JobRepriorit
 		return nil, err
 	}
 
+	originalResult := &api.JobReprioritizeResponse{}
+	if replayed, err := s.getIdempotentResult(ctx, req.Queue, reprioritizeJobsRequestType, req.IdempotencyKey, req, originalResult); err != nil {
+		return nil, err
+	} else if replayed {
+		return originalResult, nil
+	}
+
 	// results maps job ids to strings containing error messages.
 	results := make(map[string]string)
 	priority := conversion.PriorityAsInt32(req.NewPriority)
@@ -327,9 +372,11 @@ func (s *Server) ReprioritizeJobs(grpcCtx context.Context, req *api.JobRepriorit
 		return nil, status.Error(codes.Internal, "Failed to send message")
 	}
 
-	return &api.JobReprioritizeResponse{
+	result := &api.JobReprioritizeResponse{
 		ReprioritizationResults: results,
-	}, nil
+	}
+	s.storeIdempotentResult(ctx, req.Queue, reprioritizeJobsRequestType, req.IdempotencyKey, req, result)
+	return result, nil
 }
 
 func (s *Server) CancelJobSet(grpcCtx context.Context, req *api.JobSetCancelRequest) (*types.Empty, error) {
@@ -354,6 +401,12 @@ func (s *Server) CancelJobSet(grpcCtx context.Context, req *api.JobSetCancelRequ
 		return nil, err
 	}
 
+	if replayed, err := s.getIdempotentResult(ctx, req.Queue, cancelJobSetRequestType, req.IdempotencyKey, req, &types.Empty{}); err != nil {
+		return nil, err
+	} else if replayed {
+		return &types.Empty{}, nil
+	}
+
 	states := make([]armadaevents.JobState, len(req.GetFilter().GetStates()))
 	for i := 0; i < len(states); i++ {
 		switch req.GetFilter().GetStates()[i] {
@@ -389,7 +442,432 @@ func (s *Server) CancelJobSet(grpcCtx context.Context, req *api.JobSetCancelRequ
 		return nil, status.Error(codes.Internal, "failed to send cancel jobset message to pulsar")
 	}
 
-	return &types.Empty{}, err
+	result := &types.Empty{}
+	s.storeIdempotentResult(ctx, req.Queue, cancelJobSetRequestType, req.IdempotencyKey, req, result)
+	return result, err
+}
+
+// CancelJobsBySelector cancels all active jobs in a queue whose labels and annotations match the provided selector.
+// Matching jobs are resolved by the scheduler, so clients do not need to list job ids themselves.
+// If DryRun is set then no jobs are cancelled and only the number of matching jobs is returned.
+func (s *Server) CancelJobsBySelector(grpcCtx context.Context, req *api.JobSelectorCancelRequest) (*api.JobSelectorResult, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+
+	if err := validation.ValidateReason(req); err != nil {
+		return nil, err
+	}
+
+	if err := validation.ValidateJobSelector(req, s.submissionConfig); err != nil {
+		return nil, err
+	}
+
+	userId, groups, err := s.authorize(ctx, req.Queue, permissions.CancelAnyJobs, queue.PermissionVerbCancel)
+	if err != nil {
+		return nil, err
+	}
+
+	jobIdsByJobSet, matchedJobs, err := s.selectJobs(ctx, req.Queue, req.Selector)
+	if err != nil {
+		return nil, err
+	}
+	if req.DryRun || matchedJobs == 0 {
+		return &api.JobSelectorResult{MatchedJobs: matchedJobs}, nil
+	}
+	if err := s.checkJobSelectorMatches(matchedJobs); err != nil {
+		return nil, err
+	}
+
+	sequences := make([]*armadaevents.EventSequence, 0, len(jobIdsByJobSet))
+	for _, jobSet := range sortedJobSets(jobIdsByJobSet) {
+		es, _ := eventSequenceForJobIds(s.clock, jobIdsByJobSet[jobSet], req.Queue, jobSet, userId, groups, req.Reason)
+		sequences = append(sequences, es)
+	}
+
+	err = s.publisher.PublishMessages(ctx, sequences...)
+	if err != nil {
+		log.WithError(err).Error("failed send to Pulsar")
+		return nil, status.Error(codes.Internal, "Failed to send message")
+	}
+	return &api.JobSelectorResult{MatchedJobs: matchedJobs}, nil
+}
+
+// ReprioritizeJobsBySelector reprioritizes all active jobs in a queue whose labels and annotations match the provided
+// selector. If DryRun is set then no jobs are reprioritized and only the number of matching jobs is returned.
+func (s *Server) ReprioritizeJobsBySelector(grpcCtx context.Context, req *api.JobSelectorReprioritizeRequest) (*api.JobSelectorResult, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+
+	if err := validation.ValidateJobSelector(req, s.submissionConfig); err != nil {
+		return nil, err
+	}
+
+	userId, groups, err := s.authorize(ctx, req.Queue, permissions.ReprioritizeAnyJobs, queue.PermissionVerbReprioritize)
+	if err != nil {
+		return nil, err
+	}
+
+	jobIdsByJobSet, matchedJobs, err := s.selectJobs(ctx, req.Queue, req.Selector)
+	if err != nil {
+		return nil, err
+	}
+	if req.DryRun || matchedJobs == 0 {
+		return &api.JobSelectorResult{MatchedJobs: matchedJobs}, nil
+	}
+	if err := s.checkJobSelectorMatches(matchedJobs); err != nil {
+		return nil, err
+	}
+
+	priority := conversion.PriorityAsInt32(req.NewPriority)
+	eventTime := protoutil.ToTimestamp(s.clock.Now().UTC())
+	sequences := make([]*armadaevents.EventSequence, 0, len(jobIdsByJobSet))
+	for _, jobSet := range sortedJobSets(jobIdsByJobSet) {
+		sequence := &armadaevents.EventSequence{
+			Queue:      req.Queue,
+			JobSetName: jobSet,
+			UserId:     userId,
+			Groups:     groups,
+			Events:     make([]*armadaevents.EventSequence_Event, 0, len(jobIdsByJobSet[jobSet])),
+		}
+		for _, jobId := range jobIdsByJobSet[jobSet] {
+			sequence.Events = append(sequence.Events, &armadaevents.EventSequence_Event{
+				Created: eventTime,
+				Event: &armadaevents.EventSequence_Event_ReprioritiseJob{
+					ReprioritiseJob: &armadaevents.ReprioritiseJob{
+						JobId:    jobId,
+						Priority: priority,
+					},
+				},
+			})
+		}
+		sequences = append(sequences, sequence)
+	}
+
+	err = s.publisher.PublishMessages(ctx, sequences...)
+	if err != nil {
+		log.WithError(err).Error("failed send to Pulsar")
+		return nil, status.Error(codes.Internal, "Failed to send message")
+	}
+	return &api.JobSelectorResult{MatchedJobs: matchedJobs}, nil
+}
+
+func (s *Server) UpdateQueuedJobs(grpcCtx context.Context, req *api.JobUpdateRequest) (*api.JobUpdateResponse, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+
+	if err := validation.ValidateQueuedJobUpdate(req, s.submissionConfig); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	userId, groups, err := s.authorize(ctx, req.Queue, permissions.SubmitAnyJobs, queue.PermissionVerbSubmit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only jobs the scheduler currently considers queued may be updated.
+	// The scheduler ingester checks this again when applying the update, since a job may be leased in the meantime.
+	resp, err := s.jobSelectionClient.SelectJobs(ctx, &schedulerobjects.SelectJobsRequest{
+		Queue:  req.Queue,
+		JobIds: req.JobIds,
+	})
+	if err != nil {
+		log.WithError(err).Error("failed to select jobs from scheduler")
+		return nil, status.Error(codes.Unavailable, "Failed to select jobs")
+	}
+	jobsById := make(map[string]*schedulerobjects.SelectedJob, len(resp.Jobs))
+	for _, job := range resp.Jobs {
+		jobsById[job.JobId] = job
+	}
+
+	// results maps job ids to strings containing error messages.
+	results := make(map[string]string, len(req.JobIds))
+	sequence := &armadaevents.EventSequence{
+		Queue:      req.Queue,
+		JobSetName: req.JobSetId,
+		UserId:     userId,
+		Groups:     groups,
+		Events:     make([]*armadaevents.EventSequence_Event, 0, len(req.JobIds)),
+	}
+	eventTime := protoutil.ToTimestamp(s.clock.Now().UTC())
+	for _, jobId := range req.JobIds {
+		job, ok := jobsById[jobId]
+		if !ok || job.JobSet != req.JobSetId {
+			results[jobId] = fmt.Sprintf("job not found in job set %s", req.JobSetId)
+			continue
+		}
+		if !job.Queued {
+			results[jobId] = "job is not queued; only queued jobs can be updated"
+			continue
+		}
+		sequence.Events = append(sequence.Events, &armadaevents.EventSequence_Event{
+			Created: eventTime,
+			Event: &armadaevents.EventSequence_Event_UpdateQueuedJob{
+				UpdateQueuedJob: &armadaevents.UpdateQueuedJob{
+					JobId:              jobId,
+					ContainerResources: req.Update.ContainerResources,
+					NodeSelector:       req.Update.NodeSelector,
+					Tolerations:        req.Update.Tolerations,
+					PriorityClassName:  req.Update.PriorityClassName,
+				},
+			},
+		})
+		results[jobId] = "" // empty string indicates no error
+	}
+
+	if len(sequence.Events) > 0 {
+		if err := s.publisher.PublishMessages(ctx, sequence); err != nil {
+			log.WithError(err).Error("failed send to Pulsar")
+			return nil, status.Error(codes.Internal, "Failed to send message")
+		}
+	}
+	return &api.JobUpdateResponse{UpdateResults: results}, nil
+}
+
+func (s *Server) MoveJobs(grpcCtx context.Context, req *api.JobMoveRequest) (*api.JobMoveResponse, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+
+	if err := validation.ValidateJobMove(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	destinationQueue, destinationJobSetId := validation.MoveDestination(req)
+
+	// Moving jobs out of a queue removes them from it, so requires the same permission as cancelling them.
+	userId, groups, err := s.authorize(ctx, req.Queue, permissions.CancelAnyJobs, queue.PermissionVerbCancel)
+	if err != nil {
+		return nil, err
+	}
+	if destinationQueue != req.Queue {
+		if _, _, err := s.authorize(ctx, destinationQueue, permissions.SubmitAnyJobs, queue.PermissionVerbSubmit); err != nil {
+			return nil, err
+		}
+	}
+
+	// Only jobs the scheduler currently considers movable may be moved.
+	// The scheduler ingester checks this again when applying the move, since a job may be leased in the meantime.
+	resp, err := s.jobSelectionClient.SelectJobs(ctx, &schedulerobjects.SelectJobsRequest{
+		Queue:  req.Queue,
+		JobIds: req.JobIds,
+	})
+	if err != nil {
+		log.WithError(err).Error("failed to select jobs from scheduler")
+		return nil, status.Error(codes.Unavailable, "Failed to select jobs")
+	}
+	jobsById := make(map[string]*schedulerobjects.SelectedJob, len(resp.Jobs))
+	for _, job := range resp.Jobs {
+		jobsById[job.JobId] = job
+	}
+
+	// results maps job ids to strings containing error messages.
+	results := make(map[string]string, len(req.JobIds))
+	sequence := &armadaevents.EventSequence{
+		Queue:      req.Queue,
+		JobSetName: req.JobSetId,
+		UserId:     userId,
+		Groups:     groups,
+		Events:     make([]*armadaevents.EventSequence_Event, 0, len(req.JobIds)),
+	}
+	eventTime := protoutil.ToTimestamp(s.clock.Now().UTC())
+	for _, jobId := range req.JobIds {
+		job, ok := jobsById[jobId]
+		if !ok || job.JobSet != req.JobSetId {
+			results[jobId] = fmt.Sprintf("job not found in job set %s", req.JobSetId)
+			continue
+		}
+		if err := validation.ValidateJobMovable(job); err != nil {
+			results[jobId] = err.Error()
+			continue
+		}
+		sequence.Events = append(sequence.Events, &armadaevents.EventSequence_Event{
+			Created: eventTime,
+			Event: &armadaevents.EventSequence_Event_MoveJob{
+				MoveJob: &armadaevents.MoveJob{
+					JobId:               jobId,
+					DestinationQueue:    destinationQueue,
+					DestinationJobSetId: destinationJobSetId,
+				},
+			},
+		})
+		results[jobId] = "" // empty string indicates no error
+	}
+
+	if len(sequence.Events) > 0 {
+		if err := s.publisher.PublishMessages(ctx, sequence); err != nil {
+			log.WithError(err).Error("failed send to Pulsar")
+			return nil, status.Error(codes.Internal, "Failed to send message")
+		}
+	}
+	return &api.JobMoveResponse{MoveResults: results}, nil
+}
+
+// SuspendJobs suspends jobs so that they are not scheduled until resumed.
+// Running jobs are preempted without counting towards their retry limits.
+func (s *Server) SuspendJobs(grpcCtx context.Context, req *api.JobSuspendRequest) (*api.JobSuspensionResult, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := validation.ValidateReason(req); err != nil {
+		return nil, err
+	}
+	if err := validateJobIdsInJobSet(req); err != nil {
+		return nil, err
+	}
+	return s.setJobsSuspended(ctx, req.Queue, req.JobSetId, req.JobIds, true, req.Reason)
+}
+
+// ResumeJobs makes suspended jobs eligible for scheduling again.
+func (s *Server) ResumeJobs(grpcCtx context.Context, req *api.JobResumeRequest) (*api.JobSuspensionResult, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := validateJobIdsInJobSet(req); err != nil {
+		return nil, err
+	}
+	return s.setJobsSuspended(ctx, req.Queue, req.JobSetId, req.JobIds, false, "")
+}
+
+// SuspendJobSet suspends all active jobs in a job set.
+func (s *Server) SuspendJobSet(grpcCtx context.Context, req *api.JobSetSuspendRequest) (*api.JobSuspensionResult, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := validation.ValidateReason(req); err != nil {
+		return nil, err
+	}
+	if err := validation.ValidateQueueAndJobSet(req); err != nil {
+		return nil, err
+	}
+	return s.setJobsSuspended(ctx, req.Queue, req.JobSetId, nil, true, req.Reason)
+}
+
+// ResumeJobSet resumes all suspended jobs in a job set.
+func (s *Server) ResumeJobSet(grpcCtx context.Context, req *api.JobSetResumeRequest) (*api.JobSuspensionResult, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := validation.ValidateQueueAndJobSet(req); err != nil {
+		return nil, err
+	}
+	return s.setJobsSuspended(ctx, req.Queue, req.JobSetId, nil, false, "")
+}
+
+type jobIdsInJobSetRequest interface {
+	validation.JobSetRequest
+	GetJobIds() []string
+}
+
+func validateJobIdsInJobSet(req jobIdsInJobSetRequest) error {
+	if err := validation.ValidateQueueAndJobSet(req); err != nil {
+		return err
+	}
+	if len(req.GetJobIds()) == 0 {
+		return status.Error(codes.InvalidArgument, "at least one job id must be provided")
+	}
+	return nil
+}
+
+// setJobsSuspended publishes events suspending or resuming jobs in a job set.
+// If jobIds is empty, all active jobs in the job set not already in the requested state are affected.
+func (s *Server) setJobsSuspended(ctx *armadacontext.Context, queueName string, jobSetId string, jobIds []string, suspend bool, reason string) (*api.JobSuspensionResult, error) {
+	// Suspending a running job preempts it, so requires the same permission as preempting it.
+	userId, groups, err := s.authorize(ctx, queueName, permissions.PreemptAnyJobs, queue.PermissionVerbPreempt)
+	if err != nil {
+		return nil, err
+	}
+
+	selectRequest := &schedulerobjects.SelectJobsRequest{Queue: queueName, JobIds: jobIds}
+	if len(jobIds) == 0 {
+		selectRequest.JobSetPrefix = jobSetId
+	}
+	resp, err := s.jobSelectionClient.SelectJobs(ctx, selectRequest)
+	if err != nil {
+		log.WithError(err).Error("failed to select jobs from scheduler")
+		return nil, status.Error(codes.Unavailable, "Failed to select jobs")
+	}
+	jobsById := make(map[string]*schedulerobjects.SelectedJob, len(resp.Jobs))
+	for _, job := range resp.Jobs {
+		if job.JobSet != jobSetId {
+			continue
+		}
+		jobsById[job.JobId] = job
+		if len(selectRequest.JobIds) == 0 && job.Suspended != suspend {
+			jobIds = append(jobIds, job.JobId)
+		}
+	}
+
+	// results maps job ids to strings containing error messages.
+	results := make(map[string]string, len(jobIds))
+	sequence := &armadaevents.EventSequence{
+		Queue:      queueName,
+		JobSetName: jobSetId,
+		UserId:     userId,
+		Groups:     groups,
+		Events:     make([]*armadaevents.EventSequence_Event, 0, len(jobIds)),
+	}
+	eventTime := protoutil.ToTimestamp(s.clock.Now().UTC())
+	for _, jobId := range jobIds {
+		job, ok := jobsById[jobId]
+		if !ok {
+			results[jobId] = fmt.Sprintf("job not found in job set %s", jobSetId)
+			continue
+		}
+		if job.Suspended == suspend {
+			if suspend {
+				results[jobId] = "job is already suspended"
+			} else {
+				results[jobId] = "job is not suspended"
+			}
+			continue
+		}
+		event := &armadaevents.EventSequence_Event{Created: eventTime}
+		if suspend {
+			event.Event = &armadaevents.EventSequence_Event_SuspendJob{
+				SuspendJob: &armadaevents.SuspendJob{JobId: jobId, Reason: reason},
+			}
+		} else {
+			event.Event = &armadaevents.EventSequence_Event_ResumeJob{
+				ResumeJob: &armadaevents.ResumeJob{JobId: jobId},
+			}
+		}
+		sequence.Events = append(sequence.Events, event)
+		results[jobId] = "" // empty string indicates no error
+	}
+
+	if len(sequence.Events) > 0 {
+		if err := s.publisher.PublishMessages(ctx, sequence); err != nil {
+			log.WithError(err).Error("failed send to Pulsar")
+			return nil, status.Error(codes.Internal, "Failed to send message")
+		}
+	}
+	return &api.JobSuspensionResult{Results: results}, nil
+}
+
+// selectJobs asks the scheduler for all active jobs in the queue matching the selector.
+// Returns the ids of matching jobs grouped by job set, along with the total number of matching jobs.
+func (s *Server) selectJobs(ctx *armadacontext.Context, queueName string, selector *api.JobSelector) (map[string][]string, int32, error) {
+	resp, err := s.jobSelectionClient.SelectJobs(ctx, &schedulerobjects.SelectJobsRequest{
+		Queue:        queueName,
+		Labels:       selector.GetLabels(),
+		Annotations:  selector.GetAnnotations(),
+		JobSetPrefix: selector.GetJobSetPrefix(),
+		MaxJobs:      s.submissionConfig.MaxJobSelectorMatches,
+	})
+	if err != nil {
+		log.WithError(err).Error("failed to select jobs from scheduler")
+		return nil, 0, status.Error(codes.Unavailable, "Failed to select jobs")
+	}
+	jobIdsByJobSet := make(map[string][]string)
+	for _, job := range resp.Jobs {
+		jobIdsByJobSet[job.JobSet] = append(jobIdsByJobSet[job.JobSet], job.JobId)
+	}
+	return jobIdsByJobSet, resp.MatchedJobs, nil
+}
+
+// checkJobSelectorMatches returns an error if more jobs were matched by a selector than may be acted on in one request.
+func (s *Server) checkJobSelectorMatches(matchedJobs int32) error {
+	maxMatches := s.submissionConfig.MaxJobSelectorMatches
+	if maxMatches != 0 && int64(matchedJobs) > int64(maxMatches) {
+		return status.Errorf(
+			codes.FailedPrecondition,
+			"selector matches %d jobs, which exceeds the limit of %d; narrow the selector and try again", matchedJobs, maxMatches,
+		)
+	}
+	return nil
+}
+
+// sortedJobSets returns the job sets of jobIdsByJobSet in a deterministic order.
+func sortedJobSets(jobIdsByJobSet map[string][]string) []string {
+	jobSets := armadamaps.Keys(jobIdsByJobSet)
+	sort.Strings(jobSets)
+	return jobSets
 }
 
 // Returns event sequence along with all valid job ids in the sequence
@@ -419,6 +897,66 @@ func eventSequenceForJobIds(clock clock.Clock, jobIds []string, queue, jobSet, u
 	return sequence, validIds
 }
 
+// getIdempotentResult checks whether a request of the given type with the given idempotency key has already been
+// processed for this queue. If so, the original result is unmarshalled into result and true is returned.
+// An error is returned if the key was previously used for a different request.
+// Otherwise deduplication is best-effort, therefore errors are logged and the request is treated as new.
+func (s *Server) getIdempotentResult(ctx *armadacontext.Context, queue string, requestType string, idempotencyKey string, req proto.Message, result proto.Message) (bool, error) {
+	if idempotencyKey == "" {
+		return false, nil
+	}
+	originalHash, originalResult, exists, err := s.deduplicator.GetRequestResult(ctx, queue, requestType, idempotencyKey)
+	if err != nil {
+		log.WithError(err).Warnf("Error fetching result of %s request with idempotency key %s, deduplication will not occur.", requestType, idempotencyKey)
+		return false, nil
+	}
+	if !exists {
+		return false, nil
+	}
+	if originalHash != requestHash(req) {
+		return false, status.Errorf(
+			codes.InvalidArgument,
+			"idempotency key %s has already been used for a different %s request", idempotencyKey, requestType,
+		)
+	}
+	if err := proto.Unmarshal(originalResult, result); err != nil {
+		log.WithError(err).Warnf("Error unmarshalling result of %s request with idempotency key %s, deduplication will not occur.", requestType, idempotencyKey)
+		return false, nil
+	}
+	ctx.Infof("%s request with idempotency key %s is a duplicate, returning original result", requestType, idempotencyKey)
+	return true, nil
+}
+
+// storeIdempotentResult stores the result of a request so that later requests with the same idempotency key can
+// return it. Note that this is only called once events have been published, hence a partial publish can still
+// result in duplicate events.
+func (s *Server) storeIdempotentResult(ctx *armadacontext.Context, queue string, requestType string, idempotencyKey string, req proto.Message, result proto.Message) {
+	if idempotencyKey == "" {
+		return
+	}
+	bytes, err := proto.Marshal(result)
+	if err != nil {
+		log.WithError(err).Warnf("failed to marshal result of %s request", requestType)
+		return
+	}
+	if err := s.deduplicator.StoreRequestResult(ctx, queue, requestType, idempotencyKey, requestHash(req), bytes); err != nil {
+		log.WithError(err).Warnf("failed to store result of %s request with idempotency key %s", requestType, idempotencyKey)
+	}
+}
+
+// requestHash returns a hash of the serialised request, used to check that retries carrying the same
+// idempotency key are for the same request.
+func requestHash(req proto.Message) string {
+	bytes, err := proto.Marshal(req)
+	if err != nil {
+		// Requests are generated protobuf messages, so this should never happen.
+		log.WithError(err).Warnf("failed to marshal request of type %T", req)
+		return ""
+	}
+	hash := sha256.Sum256(bytes)
+	return hex.EncodeToString(hash[:])
+}
+
 // authorize authorizes a user request to submit a state transition message to the log.
 // User information used for authorization is extracted from the provided context.
 // Checks that the user has either anyPerm (e.g., permissions.SubmitAnyJobs) or perm (e.g., PermissionVerbSubmit) for this queue.
@@ -1,10 +1,13 @@
 package submit
 
 import (
+	"errors"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/codes"
@@ -17,30 +20,34 @@ import (
 	"github.com/armadaproject/armada/internal/common/armadaerrors"
 	"github.com/armadaproject/armada/internal/common/auth/permission"
 	commonMocks "github.com/armadaproject/armada/internal/common/mocks"
+	protoutil "github.com/armadaproject/armada/internal/common/proto"
 	"github.com/armadaproject/armada/internal/common/util"
 	"github.com/armadaproject/armada/internal/server/mocks"
 	"github.com/armadaproject/armada/internal/server/permissions"
 	"github.com/armadaproject/armada/internal/server/submit/testfixtures"
 	"github.com/armadaproject/armada/internal/server/submit/validation"
 	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/api/schedulerobjects"
 	"github.com/armadaproject/armada/pkg/armadaevents"
 	"github.com/armadaproject/armada/pkg/client/queue"
 )
 
 type mockObjects struct {
-	publisher    *commonMocks.MockPublisher[*armadaevents.EventSequence]
-	queueRepo    *mocks.MockQueueRepository
-	deduplicator *mocks.MockDeduplicator
-	authorizer   *mocks.MockActionAuthorizer
+	publisher          *commonMocks.MockPublisher[*armadaevents.EventSequence]
+	queueRepo          *mocks.MockQueueRepository
+	deduplicator       *mocks.MockDeduplicator
+	authorizer         *mocks.MockActionAuthorizer
+	jobSelectionClient *mocks.MockJobSelectionClient
 }
 
 func createMocks(t *testing.T) *mockObjects {
 	ctrl := gomock.NewController(t)
 	return &mockObjects{
-		publisher:    commonMocks.NewMockPublisher[*armadaevents.EventSequence](ctrl),
-		queueRepo:    mocks.NewMockQueueRepository(ctrl),
-		deduplicator: mocks.NewMockDeduplicator(ctrl),
-		authorizer:   mocks.NewMockActionAuthorizer(ctrl),
+		publisher:          commonMocks.NewMockPublisher[*armadaevents.EventSequence](ctrl),
+		queueRepo:          mocks.NewMockQueueRepository(ctrl),
+		deduplicator:       mocks.NewMockDeduplicator(ctrl),
+		authorizer:         mocks.NewMockActionAuthorizer(ctrl),
+		jobSelectionClient: mocks.NewMockJobSelectionClient(ctrl),
 	}
 }
 
@@ -265,6 +272,856 @@ func TestCancelJobs(t *testing.T) {
 	}
 }
 
+func TestIdempotencyKey(t *testing.T) {
+	jobId := util.ULID().String()
+	key := "retry-key"
+	queueName := testfixtures.DefaultQueue.Name
+	jobSet := testfixtures.DefaultJobset
+
+	type rpc struct {
+		call              func(s *Server, ctx *armadacontext.Context) (proto.Message, error)
+		anyPerm           permission.Permission
+		perm              queue.PermissionVerb
+		requestType       string
+		hashedRequest     proto.Message
+		storedResult      proto.Message
+		expectedResponse  proto.Message
+		numPublishedCalls int
+	}
+	rpcs := map[string]rpc{
+		"cancel jobs": {
+			call: func(s *Server, ctx *armadacontext.Context) (proto.Message, error) {
+				return s.CancelJobs(ctx, &api.JobCancelRequest{JobIds: []string{jobId}, Queue: queueName, JobSetId: jobSet, IdempotencyKey: key})
+			},
+			anyPerm:          permissions.CancelAnyJobs,
+			perm:             queue.PermissionVerbCancel,
+			requestType:      cancelJobsRequestType,
+			hashedRequest:    &api.JobCancelRequest{JobIds: []string{jobId}, Queue: queueName, JobSetId: jobSet, IdempotencyKey: key},
+			storedResult:     &api.CancellationResult{CancelledIds: []string{jobId}},
+			expectedResponse: &api.CancellationResult{CancelledIds: []string{jobId}},
+		},
+		"cancel jobs without job ids redirects to cancel job set": {
+			call: func(s *Server, ctx *armadacontext.Context) (proto.Message, error) {
+				return s.CancelJobs(ctx, &api.JobCancelRequest{Queue: queueName, JobSetId: jobSet, IdempotencyKey: key})
+			},
+			anyPerm:          permissions.CancelAnyJobs,
+			perm:             queue.PermissionVerbCancel,
+			requestType:      cancelJobSetRequestType,
+			hashedRequest:    &api.JobSetCancelRequest{Queue: queueName, JobSetId: jobSet, IdempotencyKey: key},
+			storedResult:     &types.Empty{},
+			expectedResponse: &api.CancellationResult{CancelledIds: []string{""}},
+		},
+		"cancel job set": {
+			call: func(s *Server, ctx *armadacontext.Context) (proto.Message, error) {
+				return s.CancelJobSet(ctx, &api.JobSetCancelRequest{Queue: queueName, JobSetId: jobSet, IdempotencyKey: key})
+			},
+			anyPerm:          permissions.CancelAnyJobs,
+			perm:             queue.PermissionVerbCancel,
+			requestType:      cancelJobSetRequestType,
+			hashedRequest:    &api.JobSetCancelRequest{Queue: queueName, JobSetId: jobSet, IdempotencyKey: key},
+			storedResult:     &types.Empty{},
+			expectedResponse: &types.Empty{},
+		},
+		"preempt jobs": {
+			call: func(s *Server, ctx *armadacontext.Context) (proto.Message, error) {
+				return s.PreemptJobs(ctx, &api.JobPreemptRequest{JobIds: []string{jobId}, Queue: queueName, JobSetId: jobSet, IdempotencyKey: key})
+			},
+			anyPerm:          permissions.PreemptAnyJobs,
+			perm:             queue.PermissionVerbPreempt,
+			requestType:      preemptJobsRequestType,
+			hashedRequest:    &api.JobPreemptRequest{JobIds: []string{jobId}, Queue: queueName, JobSetId: jobSet, IdempotencyKey: key},
+			storedResult:     &api.PreemptionResult{PreemptionResults: map[string]string{jobId: ""}},
+			expectedResponse: &api.PreemptionResult{PreemptionResults: map[string]string{jobId: ""}},
+		},
+		"reprioritize jobs": {
+			call: func(s *Server, ctx *armadacontext.Context) (proto.Message, error) {
+				return s.ReprioritizeJobs(ctx, &api.JobReprioritizeRequest{JobIds: []string{jobId}, Queue: queueName, JobSetId: jobSet, NewPriority: 2, IdempotencyKey: key})
+			},
+			anyPerm:          permissions.ReprioritizeAnyJobs,
+			perm:             queue.PermissionVerbReprioritize,
+			requestType:      reprioritizeJobsRequestType,
+			hashedRequest:    &api.JobReprioritizeRequest{JobIds: []string{jobId}, Queue: queueName, JobSetId: jobSet, NewPriority: 2, IdempotencyKey: key},
+			storedResult:     &api.JobReprioritizeResponse{ReprioritizationResults: map[string]string{jobId: ""}},
+			expectedResponse: &api.JobReprioritizeResponse{ReprioritizationResults: map[string]string{jobId: ""}},
+		},
+	}
+
+	scenarios := map[string]struct {
+		storedHash    func(rpc) string
+		lookupErr     error
+		stored        bool
+		expectPublish bool
+		expectedCode  codes.Code
+	}{
+		"new request is published and its result stored": {
+			expectPublish: true,
+		},
+		"replayed request returns original result": {
+			storedHash: func(r rpc) string { return requestHash(r.hashedRequest) },
+			stored:     true,
+		},
+		"key reused for a different request is rejected": {
+			storedHash:   func(r rpc) string { return "some-other-request" },
+			stored:       true,
+			expectedCode: codes.InvalidArgument,
+		},
+		"failed lookup processes request as new": {
+			lookupErr:     errors.New("database unavailable"),
+			expectPublish: true,
+		},
+	}
+
+	for rpcName, r := range rpcs {
+		for scenarioName, sc := range scenarios {
+			t.Run(rpcName+"/"+scenarioName, func(t *testing.T) {
+				ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Second)
+				ctx = armadacontext.WithValue(ctx, "principal", testfixtures.DefaultPrincipal)
+				defer cancel()
+
+				server, mockedObjects := createTestServer(t)
+
+				mockedObjects.queueRepo.
+					EXPECT().
+					GetQueue(ctx, queueName).
+					Return(testfixtures.DefaultQueue, nil).
+					Times(1)
+
+				mockedObjects.authorizer.
+					EXPECT().
+					AuthorizeQueueAction(ctx, testfixtures.DefaultQueue, permission.Permission(r.anyPerm), r.perm).
+					Return(nil).
+					Times(1)
+
+				var storedHash string
+				var storedResult []byte
+				if sc.stored {
+					storedHash = sc.storedHash(r)
+					storedResult = protoutil.MustMarshall(r.storedResult)
+				}
+				mockedObjects.deduplicator.
+					EXPECT().
+					GetRequestResult(ctx, queueName, r.requestType, key).
+					Return(storedHash, storedResult, sc.stored, sc.lookupErr).
+					Times(1)
+
+				if sc.expectPublish {
+					mockedObjects.publisher.
+						EXPECT().
+						PublishMessages(ctx, gomock.Any()).
+						Times(1)
+					mockedObjects.deduplicator.
+						EXPECT().
+						StoreRequestResult(ctx, queueName, r.requestType, key, requestHash(r.hashedRequest), protoutil.MustMarshall(r.storedResult)).
+						Return(nil).
+						Times(1)
+				}
+
+				resp, err := r.call(server, ctx)
+				if sc.expectedCode != codes.OK {
+					assert.Equal(t, sc.expectedCode, armadaerrors.CodeFromError(err))
+					return
+				}
+				assert.NoError(t, err)
+				assert.Equal(t, r.expectedResponse, resp)
+			})
+		}
+	}
+}
+
+func TestCancelJobsBySelector(t *testing.T) {
+	jobId1 := util.ULID().String()
+	jobId2 := util.ULID().String()
+	jobId3 := util.ULID().String()
+	selector := &api.JobSelector{Labels: map[string]string{"team": "a"}}
+
+	tests := map[string]struct {
+		dryRun           bool
+		selectedJobs     []*schedulerobjects.SelectedJob
+		matchedJobs      int32
+		expectedJobIds   map[string][]string
+		expectedResponse *api.JobSelectorResult
+		expectedCode     codes.Code
+	}{
+		"matching jobs are cancelled grouped by job set": {
+			selectedJobs: []*schedulerobjects.SelectedJob{
+				{JobId: jobId1, JobSet: "set-b"},
+				{JobId: jobId2, JobSet: "set-a"},
+				{JobId: jobId3, JobSet: "set-b"},
+			},
+			matchedJobs: 3,
+			expectedJobIds: map[string][]string{
+				"set-a": {jobId2},
+				"set-b": {jobId1, jobId3},
+			},
+			expectedResponse: &api.JobSelectorResult{MatchedJobs: 3},
+		},
+		"dry run returns count without cancelling": {
+			dryRun: true,
+			selectedJobs: []*schedulerobjects.SelectedJob{
+				{JobId: jobId1, JobSet: "set-a"},
+				{JobId: jobId2, JobSet: "set-b"},
+			},
+			matchedJobs:      2,
+			expectedResponse: &api.JobSelectorResult{MatchedJobs: 2},
+		},
+		"dry run reports matches beyond the limit": {
+			dryRun:           true,
+			selectedJobs:     []*schedulerobjects.SelectedJob{{JobId: jobId1, JobSet: "set-a"}},
+			matchedJobs:      101,
+			expectedResponse: &api.JobSelectorResult{MatchedJobs: 101},
+		},
+		"matches beyond the limit are rejected": {
+			selectedJobs: []*schedulerobjects.SelectedJob{{JobId: jobId1, JobSet: "set-a"}},
+			matchedJobs:  101,
+			expectedCode: codes.FailedPrecondition,
+		},
+		"no matching jobs": {
+			selectedJobs:     []*schedulerobjects.SelectedJob{},
+			expectedResponse: &api.JobSelectorResult{MatchedJobs: 0},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Second)
+			ctx = armadacontext.WithValue(ctx, "principal", testfixtures.DefaultPrincipal)
+			defer cancel()
+
+			server, mockedObjects := createTestServer(t)
+			req := &api.JobSelectorCancelRequest{
+				Queue:    testfixtures.DefaultQueue.Name,
+				Selector: selector,
+				DryRun:   tc.dryRun,
+			}
+
+			mockedObjects.queueRepo.
+				EXPECT().
+				GetQueue(ctx, req.Queue).
+				Return(testfixtures.DefaultQueue, nil).
+				Times(1)
+
+			mockedObjects.authorizer.
+				EXPECT().
+				AuthorizeQueueAction(ctx, testfixtures.DefaultQueue, permission.Permission(permissions.CancelAnyJobs), queue.PermissionVerbCancel).
+				Return(nil).
+				Times(1)
+
+			mockedObjects.jobSelectionClient.
+				EXPECT().
+				SelectJobs(ctx, &schedulerobjects.SelectJobsRequest{Queue: req.Queue, Labels: selector.Labels, MaxJobs: 100}).
+				Return(&schedulerobjects.SelectJobsResponse{Jobs: tc.selectedJobs, MatchedJobs: tc.matchedJobs}, nil).
+				Times(1)
+
+			capturedJobIds := map[string][]string{}
+			if tc.expectedJobIds != nil {
+				mockedObjects.publisher.
+					EXPECT().
+					PublishMessages(ctx, gomock.Any(), gomock.Any()).
+					Times(1).
+					Do(func(_ interface{}, sequences ...*armadaevents.EventSequence) {
+						for _, es := range sequences {
+							for _, event := range es.Events {
+								capturedJobIds[es.JobSetName] = append(capturedJobIds[es.JobSetName], event.GetCancelJob().JobId)
+							}
+						}
+					})
+			}
+
+			resp, err := server.CancelJobsBySelector(ctx, req)
+			if tc.expectedCode != codes.OK {
+				assert.Equal(t, tc.expectedCode, armadaerrors.CodeFromError(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedResponse, resp)
+			if tc.expectedJobIds != nil {
+				assert.Equal(t, tc.expectedJobIds, capturedJobIds)
+			}
+		})
+	}
+}
+
+func TestCancelJobsBySelector_FailedValidation(t *testing.T) {
+	tests := map[string]struct {
+		req *api.JobSelectorCancelRequest
+	}{
+		"Queue is empty": {
+			req: &api.JobSelectorCancelRequest{Selector: &api.JobSelector{Labels: map[string]string{"team": "a"}}},
+		},
+		"Selector is empty": {
+			req: &api.JobSelectorCancelRequest{Queue: testfixtures.DefaultQueue.Name, Selector: &api.JobSelector{}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Second)
+			defer cancel()
+			server, _ := createTestServer(t)
+			resp, err := server.CancelJobsBySelector(ctx, tc.req)
+			assert.Error(t, err)
+			assert.Nil(t, resp)
+		})
+	}
+}
+
+func TestReprioritizeJobsBySelector(t *testing.T) {
+	jobId1 := util.ULID().String()
+	jobId2 := util.ULID().String()
+	jobId3 := util.ULID().String()
+	selector := &api.JobSelector{Annotations: map[string]string{"owner": "bob"}, JobSetPrefix: "set-"}
+	selectedJobs := []*schedulerobjects.SelectedJob{
+		{JobId: jobId1, JobSet: "set-b"},
+		{JobId: jobId2, JobSet: "set-a"},
+		{JobId: jobId3, JobSet: "set-b"},
+	}
+
+	tests := map[string]struct {
+		dryRun         bool
+		expectedJobIds map[string][]string
+	}{
+		"matching jobs are reprioritized grouped by job set": {
+			expectedJobIds: map[string][]string{
+				"set-a": {jobId2},
+				"set-b": {jobId1, jobId3},
+			},
+		},
+		"dry run returns count without reprioritizing": {
+			dryRun: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Second)
+			ctx = armadacontext.WithValue(ctx, "principal", testfixtures.DefaultPrincipal)
+			defer cancel()
+
+			server, mockedObjects := createTestServer(t)
+			req := &api.JobSelectorReprioritizeRequest{
+				Queue:       testfixtures.DefaultQueue.Name,
+				Selector:    selector,
+				NewPriority: 3,
+				DryRun:      tc.dryRun,
+			}
+
+			mockedObjects.queueRepo.
+				EXPECT().
+				GetQueue(ctx, req.Queue).
+				Return(testfixtures.DefaultQueue, nil).
+				Times(1)
+
+			mockedObjects.authorizer.
+				EXPECT().
+				AuthorizeQueueAction(ctx, testfixtures.DefaultQueue, permission.Permission(permissions.ReprioritizeAnyJobs), queue.PermissionVerbReprioritize).
+				Return(nil).
+				Times(1)
+
+			mockedObjects.jobSelectionClient.
+				EXPECT().
+				SelectJobs(ctx, &schedulerobjects.SelectJobsRequest{
+					Queue:        req.Queue,
+					Annotations:  selector.Annotations,
+					JobSetPrefix: selector.JobSetPrefix,
+					MaxJobs:      100,
+				}).
+				Return(&schedulerobjects.SelectJobsResponse{Jobs: selectedJobs, MatchedJobs: int32(len(selectedJobs))}, nil).
+				Times(1)
+
+			capturedJobIds := map[string][]string{}
+			var capturedPriorities []uint32
+			if !tc.dryRun {
+				mockedObjects.publisher.
+					EXPECT().
+					PublishMessages(ctx, gomock.Any(), gomock.Any()).
+					Times(1).
+					Do(func(_ interface{}, sequences ...*armadaevents.EventSequence) {
+						for _, es := range sequences {
+							for _, event := range es.Events {
+								capturedJobIds[es.JobSetName] = append(capturedJobIds[es.JobSetName], event.GetReprioritiseJob().JobId)
+								capturedPriorities = append(capturedPriorities, event.GetReprioritiseJob().Priority)
+							}
+						}
+					})
+			}
+
+			resp, err := server.ReprioritizeJobsBySelector(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, &api.JobSelectorResult{MatchedJobs: 3}, resp)
+			if !tc.dryRun {
+				assert.Equal(t, tc.expectedJobIds, capturedJobIds)
+				assert.Equal(t, []uint32{3, 3, 3}, capturedPriorities)
+			}
+		})
+	}
+}
+
+func TestUpdateQueuedJobs(t *testing.T) {
+	queuedJobId := util.ULID().String()
+	leasedJobId := util.ULID().String()
+	otherJobSetJobId := util.ULID().String()
+	missingJobId := util.ULID().String()
+	update := &api.QueuedJobUpdate{
+		NodeSelector:      map[string]string{"foo": "bar"},
+		PriorityClassName: testfixtures.DefaultPriorityClass,
+	}
+	expectedEvent := func(jobId string) *armadaevents.EventSequence_Event {
+		return &armadaevents.EventSequence_Event{
+			Created: protoutil.ToTimestamp(testfixtures.DefaultTime),
+			Event: &armadaevents.EventSequence_Event_UpdateQueuedJob{
+				UpdateQueuedJob: &armadaevents.UpdateQueuedJob{
+					JobId:             jobId,
+					NodeSelector:      update.NodeSelector,
+					PriorityClassName: update.PriorityClassName,
+				},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		jobIds          []string
+		expectedResults map[string]string
+		expectedEvents  []*armadaevents.EventSequence_Event
+	}{
+		"queued job is updated": {
+			jobIds:          []string{queuedJobId},
+			expectedResults: map[string]string{queuedJobId: ""},
+			expectedEvents:  []*armadaevents.EventSequence_Event{expectedEvent(queuedJobId)},
+		},
+		"only queued jobs in the job set are updated": {
+			jobIds: []string{queuedJobId, leasedJobId, otherJobSetJobId, missingJobId},
+			expectedResults: map[string]string{
+				queuedJobId:      "",
+				leasedJobId:      "job is not queued; only queued jobs can be updated",
+				otherJobSetJobId: "job not found in job set " + testfixtures.DefaultJobset,
+				missingJobId:     "job not found in job set " + testfixtures.DefaultJobset,
+			},
+			expectedEvents: []*armadaevents.EventSequence_Event{expectedEvent(queuedJobId)},
+		},
+		"nothing is published if no job can be updated": {
+			jobIds:          []string{leasedJobId},
+			expectedResults: map[string]string{leasedJobId: "job is not queued; only queued jobs can be updated"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Second)
+			ctx = armadacontext.WithValue(ctx, "principal", testfixtures.DefaultPrincipal)
+			defer cancel()
+
+			server, mockedObjects := createTestServer(t)
+			req := &api.JobUpdateRequest{
+				JobIds:   tc.jobIds,
+				Queue:    testfixtures.DefaultQueue.Name,
+				JobSetId: testfixtures.DefaultJobset,
+				Update:   update,
+			}
+
+			mockedObjects.queueRepo.
+				EXPECT().
+				GetQueue(ctx, req.Queue).
+				Return(testfixtures.DefaultQueue, nil).
+				Times(1)
+
+			mockedObjects.authorizer.
+				EXPECT().
+				AuthorizeQueueAction(ctx, testfixtures.DefaultQueue, permission.Permission(permissions.SubmitAnyJobs), queue.PermissionVerbSubmit).
+				Return(nil).
+				Times(1)
+
+			mockedObjects.jobSelectionClient.
+				EXPECT().
+				SelectJobs(ctx, &schedulerobjects.SelectJobsRequest{Queue: req.Queue, JobIds: tc.jobIds}).
+				Return(&schedulerobjects.SelectJobsResponse{
+					Jobs: []*schedulerobjects.SelectedJob{
+						{JobId: queuedJobId, JobSet: testfixtures.DefaultJobset, Queued: true},
+						{JobId: leasedJobId, JobSet: testfixtures.DefaultJobset, Queued: false},
+						{JobId: otherJobSetJobId, JobSet: "other", Queued: true},
+					},
+				}, nil).
+				Times(1)
+
+			var capturedEvents []*armadaevents.EventSequence_Event
+			if len(tc.expectedEvents) > 0 {
+				mockedObjects.publisher.
+					EXPECT().
+					PublishMessages(ctx, gomock.Any()).
+					Times(1).
+					Do(func(_ interface{}, sequences ...*armadaevents.EventSequence) {
+						for _, es := range sequences {
+							assert.Equal(t, testfixtures.DefaultJobset, es.JobSetName)
+							capturedEvents = append(capturedEvents, es.Events...)
+						}
+					})
+			}
+
+			resp, err := server.UpdateQueuedJobs(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedResults, resp.UpdateResults)
+			assert.Equal(t, tc.expectedEvents, capturedEvents)
+		})
+	}
+}
+
+func TestUpdateQueuedJobs_FailedValidation(t *testing.T) {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Second)
+	defer cancel()
+	server, _ := createTestServer(t)
+
+	resp, err := server.UpdateQueuedJobs(ctx, &api.JobUpdateRequest{
+		JobIds:   []string{util.ULID().String()},
+		Queue:    testfixtures.DefaultQueue.Name,
+		JobSetId: testfixtures.DefaultJobset,
+		Update:   &api.QueuedJobUpdate{PriorityClassName: "notValid"},
+	})
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, armadaerrors.CodeFromError(err))
+}
+
+func TestMoveJobs(t *testing.T) {
+	queuedJobId := util.ULID().String()
+	leasedJobId := util.ULID().String()
+	otherJobSetJobId := util.ULID().String()
+	gangJobId := util.ULID().String()
+	destinationQueue := queue.Queue{Name: "destination-queue", PriorityFactor: 1}
+	expectedEvent := func(jobId string, destinationQueue string, destinationJobSetId string) *armadaevents.EventSequence_Event {
+		return &armadaevents.EventSequence_Event{
+			Created: protoutil.ToTimestamp(testfixtures.DefaultTime),
+			Event: &armadaevents.EventSequence_Event_MoveJob{
+				MoveJob: &armadaevents.MoveJob{
+					JobId:               jobId,
+					DestinationQueue:    destinationQueue,
+					DestinationJobSetId: destinationJobSetId,
+				},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		jobIds              []string
+		destinationQueue    string
+		destinationJobSetId string
+		expectedResults     map[string]string
+		expectedEvents      []*armadaevents.EventSequence_Event
+	}{
+		"move to another queue": {
+			jobIds:           []string{queuedJobId},
+			destinationQueue: destinationQueue.Name,
+			expectedResults:  map[string]string{queuedJobId: ""},
+			expectedEvents:   []*armadaevents.EventSequence_Event{expectedEvent(queuedJobId, destinationQueue.Name, testfixtures.DefaultJobset)},
+		},
+		"move to another job set": {
+			jobIds:              []string{queuedJobId},
+			destinationJobSetId: "destination-job-set",
+			expectedResults:     map[string]string{queuedJobId: ""},
+			expectedEvents:      []*armadaevents.EventSequence_Event{expectedEvent(queuedJobId, testfixtures.DefaultQueue.Name, "destination-job-set")},
+		},
+		"only queued jobs in the job set are moved": {
+			jobIds:              []string{queuedJobId, leasedJobId, otherJobSetJobId},
+			destinationQueue:    destinationQueue.Name,
+			destinationJobSetId: "destination-job-set",
+			expectedResults: map[string]string{
+				queuedJobId:      "",
+				leasedJobId:      "job is not queued; only queued jobs can be moved",
+				otherJobSetJobId: "job not found in job set " + testfixtures.DefaultJobset,
+			},
+			expectedEvents: []*armadaevents.EventSequence_Event{expectedEvent(queuedJobId, destinationQueue.Name, "destination-job-set")},
+		},
+		"gang jobs are not moved": {
+			jobIds:           []string{queuedJobId, gangJobId},
+			destinationQueue: destinationQueue.Name,
+			expectedResults: map[string]string{
+				queuedJobId: "",
+				gangJobId:   "job is a member of gang gang-1; gang jobs can't be moved",
+			},
+			expectedEvents: []*armadaevents.EventSequence_Event{expectedEvent(queuedJobId, destinationQueue.Name, testfixtures.DefaultJobset)},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Second)
+			ctx = armadacontext.WithValue(ctx, "principal", testfixtures.DefaultPrincipal)
+			defer cancel()
+
+			server, mockedObjects := createTestServer(t)
+			req := &api.JobMoveRequest{
+				JobIds:              tc.jobIds,
+				Queue:               testfixtures.DefaultQueue.Name,
+				JobSetId:            testfixtures.DefaultJobset,
+				DestinationQueue:    tc.destinationQueue,
+				DestinationJobSetId: tc.destinationJobSetId,
+			}
+
+			mockedObjects.queueRepo.
+				EXPECT().
+				GetQueue(ctx, req.Queue).
+				Return(testfixtures.DefaultQueue, nil).
+				Times(1)
+
+			mockedObjects.authorizer.
+				EXPECT().
+				AuthorizeQueueAction(ctx, testfixtures.DefaultQueue, permission.Permission(permissions.CancelAnyJobs), queue.PermissionVerbCancel).
+				Return(nil).
+				Times(1)
+
+			if tc.destinationQueue != "" {
+				mockedObjects.queueRepo.
+					EXPECT().
+					GetQueue(ctx, destinationQueue.Name).
+					Return(destinationQueue, nil).
+					Times(1)
+
+				mockedObjects.authorizer.
+					EXPECT().
+					AuthorizeQueueAction(ctx, destinationQueue, permission.Permission(permissions.SubmitAnyJobs), queue.PermissionVerbSubmit).
+					Return(nil).
+					Times(1)
+			}
+
+			mockedObjects.jobSelectionClient.
+				EXPECT().
+				SelectJobs(ctx, &schedulerobjects.SelectJobsRequest{Queue: req.Queue, JobIds: tc.jobIds}).
+				Return(&schedulerobjects.SelectJobsResponse{
+					Jobs: []*schedulerobjects.SelectedJob{
+						{JobId: queuedJobId, JobSet: testfixtures.DefaultJobset, Queued: true},
+						{JobId: leasedJobId, JobSet: testfixtures.DefaultJobset, Queued: false},
+						{JobId: otherJobSetJobId, JobSet: "other", Queued: true},
+						{JobId: gangJobId, JobSet: testfixtures.DefaultJobset, Queued: true, GangId: "gang-1"},
+					},
+				}, nil).
+				Times(1)
+
+			var capturedEvents []*armadaevents.EventSequence_Event
+			mockedObjects.publisher.
+				EXPECT().
+				PublishMessages(ctx, gomock.Any()).
+				Times(1).
+				Do(func(_ interface{}, sequences ...*armadaevents.EventSequence) {
+					for _, es := range sequences {
+						// Move events are published in the job set the jobs are moved from.
+						assert.Equal(t, testfixtures.DefaultQueue.Name, es.Queue)
+						assert.Equal(t, testfixtures.DefaultJobset, es.JobSetName)
+						capturedEvents = append(capturedEvents, es.Events...)
+					}
+				})
+
+			resp, err := server.MoveJobs(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedResults, resp.MoveResults)
+			assert.Equal(t, tc.expectedEvents, capturedEvents)
+		})
+	}
+}
+
+func TestMoveJobs_DestinationQueueNotAuthorized(t *testing.T) {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Second)
+	ctx = armadacontext.WithValue(ctx, "principal", testfixtures.DefaultPrincipal)
+	defer cancel()
+
+	server, mockedObjects := createTestServer(t)
+	destinationQueue := queue.Queue{Name: "destination-queue", PriorityFactor: 1}
+	req := &api.JobMoveRequest{
+		JobIds:           []string{util.ULID().String()},
+		Queue:            testfixtures.DefaultQueue.Name,
+		JobSetId:         testfixtures.DefaultJobset,
+		DestinationQueue: destinationQueue.Name,
+	}
+
+	mockedObjects.queueRepo.
+		EXPECT().
+		GetQueue(ctx, req.Queue).
+		Return(testfixtures.DefaultQueue, nil).
+		Times(1)
+	mockedObjects.authorizer.
+		EXPECT().
+		AuthorizeQueueAction(ctx, testfixtures.DefaultQueue, permission.Permission(permissions.CancelAnyJobs), queue.PermissionVerbCancel).
+		Return(nil).
+		Times(1)
+	mockedObjects.queueRepo.
+		EXPECT().
+		GetQueue(ctx, destinationQueue.Name).
+		Return(destinationQueue, nil).
+		Times(1)
+	mockedObjects.authorizer.
+		EXPECT().
+		AuthorizeQueueAction(ctx, destinationQueue, permission.Permission(permissions.SubmitAnyJobs), queue.PermissionVerbSubmit).
+		Return(&armadaerrors.ErrUnauthorized{Principal: testfixtures.DefaultPrincipal.GetName(), Permission: string(permissions.SubmitAnyJobs)}).
+		Times(1)
+
+	resp, err := server.MoveJobs(ctx, req)
+	assert.Nil(t, resp)
+	assert.Error(t, err)
+}
+
+func TestMoveJobs_FailedValidation(t *testing.T) {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Second)
+	defer cancel()
+	server, _ := createTestServer(t)
+
+	resp, err := server.MoveJobs(ctx, &api.JobMoveRequest{
+		JobIds:   []string{util.ULID().String()},
+		Queue:    testfixtures.DefaultQueue.Name,
+		JobSetId: testfixtures.DefaultJobset,
+	})
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, armadaerrors.CodeFromError(err))
+}
+func TestSuspendAndResumeJobs(t *testing.T) {
+	activeJobId := util.ULID().String()
+	suspendedJobId := util.ULID().String()
+	otherJobSetJobId := util.ULID().String()
+	suspendEvent := func(jobId string) *armadaevents.EventSequence_Event {
+		return &armadaevents.EventSequence_Event{
+			Created: protoutil.ToTimestamp(testfixtures.DefaultTime),
+			Event: &armadaevents.EventSequence_Event_SuspendJob{
+				SuspendJob: &armadaevents.SuspendJob{JobId: jobId, Reason: "maintenance"},
+			},
+		}
+	}
+	resumeEvent := func(jobId string) *armadaevents.EventSequence_Event {
+		return &armadaevents.EventSequence_Event{
+			Created: protoutil.ToTimestamp(testfixtures.DefaultTime),
+			Event: &armadaevents.EventSequence_Event_ResumeJob{
+				ResumeJob: &armadaevents.ResumeJob{JobId: jobId},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		call            func(ctx *armadacontext.Context, server *Server) (*api.JobSuspensionResult, error)
+		expectedSelect  *schedulerobjects.SelectJobsRequest
+		expectedResults map[string]string
+		expectedEvents  []*armadaevents.EventSequence_Event
+	}{
+		"suspend jobs": {
+			call: func(ctx *armadacontext.Context, server *Server) (*api.JobSuspensionResult, error) {
+				return server.SuspendJobs(ctx, &api.JobSuspendRequest{
+					JobIds:   []string{activeJobId, suspendedJobId, otherJobSetJobId},
+					Queue:    testfixtures.DefaultQueue.Name,
+					JobSetId: testfixtures.DefaultJobset,
+					Reason:   "maintenance",
+				})
+			},
+			expectedSelect: &schedulerobjects.SelectJobsRequest{
+				Queue:  testfixtures.DefaultQueue.Name,
+				JobIds: []string{activeJobId, suspendedJobId, otherJobSetJobId},
+			},
+			expectedResults: map[string]string{
+				activeJobId:      "",
+				suspendedJobId:   "job is already suspended",
+				otherJobSetJobId: "job not found in job set " + testfixtures.DefaultJobset,
+			},
+			expectedEvents: []*armadaevents.EventSequence_Event{suspendEvent(activeJobId)},
+		},
+		"resume jobs": {
+			call: func(ctx *armadacontext.Context, server *Server) (*api.JobSuspensionResult, error) {
+				return server.ResumeJobs(ctx, &api.JobResumeRequest{
+					JobIds:   []string{activeJobId, suspendedJobId},
+					Queue:    testfixtures.DefaultQueue.Name,
+					JobSetId: testfixtures.DefaultJobset,
+				})
+			},
+			expectedSelect: &schedulerobjects.SelectJobsRequest{
+				Queue:  testfixtures.DefaultQueue.Name,
+				JobIds: []string{activeJobId, suspendedJobId},
+			},
+			expectedResults: map[string]string{
+				activeJobId:    "job is not suspended",
+				suspendedJobId: "",
+			},
+			expectedEvents: []*armadaevents.EventSequence_Event{resumeEvent(suspendedJobId)},
+		},
+		"suspend job set": {
+			call: func(ctx *armadacontext.Context, server *Server) (*api.JobSuspensionResult, error) {
+				return server.SuspendJobSet(ctx, &api.JobSetSuspendRequest{
+					Queue:    testfixtures.DefaultQueue.Name,
+					JobSetId: testfixtures.DefaultJobset,
+					Reason:   "maintenance",
+				})
+			},
+			expectedSelect: &schedulerobjects.SelectJobsRequest{
+				Queue:        testfixtures.DefaultQueue.Name,
+				JobSetPrefix: testfixtures.DefaultJobset,
+			},
+			expectedResults: map[string]string{activeJobId: ""},
+			expectedEvents:  []*armadaevents.EventSequence_Event{suspendEvent(activeJobId)},
+		},
+		"resume job set": {
+			call: func(ctx *armadacontext.Context, server *Server) (*api.JobSuspensionResult, error) {
+				return server.ResumeJobSet(ctx, &api.JobSetResumeRequest{
+					Queue:    testfixtures.DefaultQueue.Name,
+					JobSetId: testfixtures.DefaultJobset,
+				})
+			},
+			expectedSelect: &schedulerobjects.SelectJobsRequest{
+				Queue:        testfixtures.DefaultQueue.Name,
+				JobSetPrefix: testfixtures.DefaultJobset,
+			},
+			expectedResults: map[string]string{suspendedJobId: ""},
+			expectedEvents:  []*armadaevents.EventSequence_Event{resumeEvent(suspendedJobId)},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Second)
+			ctx = armadacontext.WithValue(ctx, "principal", testfixtures.DefaultPrincipal)
+			defer cancel()
+
+			server, mockedObjects := createTestServer(t)
+
+			mockedObjects.queueRepo.
+				EXPECT().
+				GetQueue(ctx, testfixtures.DefaultQueue.Name).
+				Return(testfixtures.DefaultQueue, nil).
+				Times(1)
+
+			mockedObjects.authorizer.
+				EXPECT().
+				AuthorizeQueueAction(ctx, testfixtures.DefaultQueue, permission.Permission(permissions.PreemptAnyJobs), queue.PermissionVerbPreempt).
+				Return(nil).
+				Times(1)
+
+			mockedObjects.jobSelectionClient.
+				EXPECT().
+				SelectJobs(ctx, tc.expectedSelect).
+				Return(&schedulerobjects.SelectJobsResponse{
+					Jobs: []*schedulerobjects.SelectedJob{
+						{JobId: activeJobId, JobSet: testfixtures.DefaultJobset},
+						{JobId: suspendedJobId, JobSet: testfixtures.DefaultJobset, Suspended: true},
+						{JobId: otherJobSetJobId, JobSet: "other"},
+					},
+				}, nil).
+				Times(1)
+
+			var capturedEvents []*armadaevents.EventSequence_Event
+			mockedObjects.publisher.
+				EXPECT().
+				PublishMessages(ctx, gomock.Any()).
+				Times(1).
+				Do(func(_ interface{}, sequences ...*armadaevents.EventSequence) {
+					for _, es := range sequences {
+						capturedEvents = append(capturedEvents, es.Events...)
+					}
+				})
+
+			resp, err := tc.call(ctx, server)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedResults, resp.Results)
+			assert.Equal(t, tc.expectedEvents, capturedEvents)
+		})
+	}
+}
+
+func TestSuspendJobs_FailedValidation(t *testing.T) {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Second)
+	defer cancel()
+	server, _ := createTestServer(t)
+
+	resp, err := server.SuspendJobs(ctx, &api.JobSuspendRequest{
+		Queue:    testfixtures.DefaultQueue.Name,
+		JobSetId: testfixtures.DefaultJobset,
+	})
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, armadaerrors.CodeFromError(err))
+}
+
 func TestCancelJobs_FailedValidation(t *testing.T) {
 	jobId1 := util.ULID().String()
 	tests := map[string]struct {
@@ -591,7 +1448,8 @@ func createTestServer(t *testing.T) (*Server, *mockObjects) {
 		m.queueRepo,
 		testfixtures.DefaultSubmissionConfig(),
 		m.deduplicator,
-		m.authorizer)
+		m.authorizer,
+		m.jobSelectionClient)
 	server.clock = clock.NewFakeClock(testfixtures.DefaultTime)
 	server.idGenerator = testfixtures.TestUlidGenerator()
 	return server, m
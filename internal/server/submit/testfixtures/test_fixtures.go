@@ -76,6 +76,9 @@ func DefaultSubmissionConfig() configuration.SubmissionConfig {
 		MinTerminationGracePeriod: 30 * time.Second,
 		MaxTerminationGracePeriod: 300 * time.Second,
 		DefaultActiveDeadline:     1 * time.Hour,
+		MaxJobSelectorMatches:     100,
+		SelectableJobLabels:       []string{"team"},
+		SelectableJobAnnotations:  []string{"owner"},
 	}
 }
 
@@ -0,0 +1,80 @@
+package validation
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/api/schedulerobjects"
+)
+
+// ValidateJobMove ensures that an api.JobMoveRequest is well-formed.
+// The request must name at least one job and a destination that differs from the source.
+func ValidateJobMove(req *api.JobMoveRequest) error {
+	if err := ValidateQueueAndJobSet(req); err != nil {
+		return err
+	}
+	if len(req.JobIds) == 0 {
+		return &armadaerrors.ErrInvalidArgument{
+			Name:    "JobIds",
+			Value:   req.JobIds,
+			Message: "at least one job id must be provided",
+		}
+	}
+	if req.DestinationQueue == "" && req.DestinationJobSetId == "" {
+		return &armadaerrors.ErrInvalidArgument{
+			Name:    "DestinationQueue",
+			Value:   req.DestinationQueue,
+			Message: "at least one of destination queue or destination job set must be provided",
+		}
+	}
+	destinationQueue, destinationJobSetId := MoveDestination(req)
+	if destinationQueue == req.Queue && destinationJobSetId == req.JobSetId {
+		return &armadaerrors.ErrInvalidArgument{
+			Name:    "DestinationJobSetId",
+			Value:   req.DestinationJobSetId,
+			Message: "destination must differ from the queue and job set the jobs are moved from",
+		}
+	}
+	if len(destinationJobSetId) >= maxJobSetIdChars {
+		return &armadaerrors.ErrInvalidArgument{
+			Name:    "DestinationJobSetId",
+			Value:   destinationJobSetId,
+			Message: fmt.Sprintf("job set id of length %d must be less than max character length %d", len(destinationJobSetId), maxJobSetIdChars),
+		}
+	}
+	return nil
+}
+
+// ValidateJobMovable ensures that a job, as currently known to the scheduler, can be moved.
+// The scheduler ingester ignores moves of jobs that aren't queued or are being cancelled, so these are rejected
+// up front. Gang jobs are rejected since the members of a gang must be scheduled together, and moving only some
+// of them would split the gang across queues.
+func ValidateJobMovable(job *schedulerobjects.SelectedJob) error {
+	if !job.Queued {
+		return errors.New("job is not queued; only queued jobs can be moved")
+	}
+	if job.CancelRequested {
+		return errors.New("job is being cancelled; only queued jobs can be moved")
+	}
+	if job.GangId != "" {
+		return errors.Errorf("job is a member of gang %s; gang jobs can't be moved", job.GangId)
+	}
+	return nil
+}
+
+// MoveDestination returns the queue and job set that jobs are moved to.
+// Destination fields left empty default to the queue and job set the jobs are moved from.
+func MoveDestination(req *api.JobMoveRequest) (string, string) {
+	destinationQueue := req.DestinationQueue
+	if destinationQueue == "" {
+		destinationQueue = req.Queue
+	}
+	destinationJobSetId := req.DestinationJobSetId
+	if destinationJobSetId == "" {
+		destinationJobSetId = req.JobSetId
+	}
+	return destinationQueue, destinationJobSetId
+}
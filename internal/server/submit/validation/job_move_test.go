@@ -0,0 +1,108 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/api/schedulerobjects"
+)
+
+func TestValidateJobMove(t *testing.T) {
+	tests := map[string]struct {
+		req         *api.JobMoveRequest
+		expectError bool
+	}{
+		"move to another queue": {
+			req: &api.JobMoveRequest{Queue: "queue", JobSetId: "job-set", JobIds: []string{"job-1"}, DestinationQueue: "other-queue"},
+		},
+		"move to another job set": {
+			req: &api.JobMoveRequest{Queue: "queue", JobSetId: "job-set", JobIds: []string{"job-1"}, DestinationJobSetId: "other-job-set"},
+		},
+		"move to another queue and job set": {
+			req: &api.JobMoveRequest{Queue: "queue", JobSetId: "job-set", JobIds: []string{"job-1"}, DestinationQueue: "other-queue", DestinationJobSetId: "other-job-set"},
+		},
+		"missing queue": {
+			req:         &api.JobMoveRequest{JobSetId: "job-set", JobIds: []string{"job-1"}, DestinationQueue: "other-queue"},
+			expectError: true,
+		},
+		"missing job set": {
+			req:         &api.JobMoveRequest{Queue: "queue", JobIds: []string{"job-1"}, DestinationQueue: "other-queue"},
+			expectError: true,
+		},
+		"missing job ids": {
+			req:         &api.JobMoveRequest{Queue: "queue", JobSetId: "job-set", DestinationQueue: "other-queue"},
+			expectError: true,
+		},
+		"missing destination": {
+			req:         &api.JobMoveRequest{Queue: "queue", JobSetId: "job-set", JobIds: []string{"job-1"}},
+			expectError: true,
+		},
+		"destination equal to source": {
+			req:         &api.JobMoveRequest{Queue: "queue", JobSetId: "job-set", JobIds: []string{"job-1"}, DestinationQueue: "queue", DestinationJobSetId: "job-set"},
+			expectError: true,
+		},
+		"destination job set too long": {
+			req:         &api.JobMoveRequest{Queue: "queue", JobSetId: "job-set", JobIds: []string{"job-1"}, DestinationJobSetId: strings.Repeat("a", maxJobSetIdChars)},
+			expectError: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateJobMove(tc.req)
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateJobMovable(t *testing.T) {
+	tests := map[string]struct {
+		job         *schedulerobjects.SelectedJob
+		expectError bool
+	}{
+		"queued": {
+			job: &schedulerobjects.SelectedJob{JobId: "job-1", Queued: true},
+		},
+		"suspended": {
+			job: &schedulerobjects.SelectedJob{JobId: "job-1", Queued: true, Suspended: true},
+		},
+		"not queued": {
+			job:         &schedulerobjects.SelectedJob{JobId: "job-1"},
+			expectError: true,
+		},
+		"cancel requested": {
+			job:         &schedulerobjects.SelectedJob{JobId: "job-1", Queued: true, CancelRequested: true},
+			expectError: true,
+		},
+		"gang member": {
+			job:         &schedulerobjects.SelectedJob{JobId: "job-1", Queued: true, GangId: "gang-1"},
+			expectError: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateJobMovable(tc.job)
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMoveDestination(t *testing.T) {
+	queue, jobSetId := MoveDestination(&api.JobMoveRequest{Queue: "queue", JobSetId: "job-set", DestinationQueue: "other-queue"})
+	assert.Equal(t, "other-queue", queue)
+	assert.Equal(t, "job-set", jobSetId)
+
+	queue, jobSetId = MoveDestination(&api.JobMoveRequest{Queue: "queue", JobSetId: "job-set", DestinationJobSetId: "other-job-set"})
+	assert.Equal(t, "queue", queue)
+	assert.Equal(t, "other-job-set", jobSetId)
+}
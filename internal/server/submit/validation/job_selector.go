@@ -0,0 +1,56 @@
+package validation
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/server/configuration"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+type JobSelectorRequest interface {
+	GetQueue() string
+	GetSelector() *api.JobSelector
+}
+
+// ValidateJobSelector checks that a queue is provided and that the selector has at least one label or annotation.
+// Selecting by job set prefix alone is not allowed, since CancelJobSet and ReprioritizeJobs already cover whole job sets.
+// Only the labels and annotations the scheduler stores for each job can be selected on, so selectors using any others
+// are rejected rather than silently matching no jobs.
+func ValidateJobSelector(req JobSelectorRequest, config configuration.SubmissionConfig) error {
+	if req.GetQueue() == "" {
+		return &armadaerrors.ErrInvalidArgument{
+			Name:    "Queue",
+			Value:   req.GetQueue(),
+			Message: "queue cannot be empty",
+		}
+	}
+	selector := req.GetSelector()
+	if len(selector.GetLabels()) == 0 && len(selector.GetAnnotations()) == 0 {
+		return &armadaerrors.ErrInvalidArgument{
+			Name:    "Selector",
+			Value:   selector,
+			Message: "selector must contain at least one label or annotation",
+		}
+	}
+	for key := range selector.GetLabels() {
+		if !slices.Contains(config.SelectableJobLabels, key) {
+			return &armadaerrors.ErrInvalidArgument{
+				Name:    "Selector",
+				Value:   selector,
+				Message: fmt.Sprintf("label %s can't be used in job selectors; selectable labels are %v", key, config.SelectableJobLabels),
+			}
+		}
+	}
+	for key := range selector.GetAnnotations() {
+		if !slices.Contains(config.SelectableJobAnnotations, key) {
+			return &armadaerrors.ErrInvalidArgument{
+				Name:    "Selector",
+				Value:   selector,
+				Message: fmt.Sprintf("annotation %s can't be used in job selectors; selectable annotations are %v", key, config.SelectableJobAnnotations),
+			}
+		}
+	}
+	return nil
+}
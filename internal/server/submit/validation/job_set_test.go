@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/armadaproject/armada/internal/server/configuration"
 	"github.com/armadaproject/armada/pkg/api"
 )
 
@@ -62,3 +63,50 @@ func TestValidateReason(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateJobSelector(t *testing.T) {
+	tests := map[string]struct {
+		req         *api.JobSelectorCancelRequest
+		expectError bool
+	}{
+		"labels": {
+			req:         &api.JobSelectorCancelRequest{Queue: "queue", Selector: &api.JobSelector{Labels: map[string]string{"foo": "bar"}}},
+			expectError: false,
+		},
+		"annotations": {
+			req:         &api.JobSelectorCancelRequest{Queue: "queue", Selector: &api.JobSelector{Annotations: map[string]string{"foo": "bar"}}},
+			expectError: false,
+		},
+		"missing queue": {
+			req:         &api.JobSelectorCancelRequest{Selector: &api.JobSelector{Labels: map[string]string{"foo": "bar"}}},
+			expectError: true,
+		},
+		"missing selector": {
+			req:         &api.JobSelectorCancelRequest{Queue: "queue"},
+			expectError: true,
+		},
+		"job set prefix only": {
+			req:         &api.JobSelectorCancelRequest{Queue: "queue", Selector: &api.JobSelector{JobSetPrefix: "foo"}},
+			expectError: true,
+		},
+		"label not selectable": {
+			req:         &api.JobSelectorCancelRequest{Queue: "queue", Selector: &api.JobSelector{Labels: map[string]string{"fizz": "bar"}}},
+			expectError: true,
+		},
+		"annotation not selectable": {
+			req:         &api.JobSelectorCancelRequest{Queue: "queue", Selector: &api.JobSelector{Annotations: map[string]string{"fizz": "bar"}}},
+			expectError: true,
+		},
+	}
+	config := configuration.SubmissionConfig{SelectableJobLabels: []string{"foo"}, SelectableJobAnnotations: []string{"foo"}}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateJobSelector(tc.req, config)
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
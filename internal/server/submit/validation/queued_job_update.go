@@ -0,0 +1,76 @@
+package validation
+
+import (
+	"maps"
+	"slices"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/server/configuration"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// Validators applied to the fields of a QueuedJobUpdate.
+// These are the same validators used at submission, so an update can't produce a job that couldn't have been submitted.
+var queuedJobUpdateValidators = []itemValidator{
+	validateResources,
+	validatePriorityClasses,
+	validateTolerations,
+}
+
+// ValidateQueuedJobUpdate ensures that an api.JobUpdateRequest is well-formed.
+// The update must name at least one job and change at least one field,
+// and each changed field must pass the checks applied to the same field at submission.
+func ValidateQueuedJobUpdate(req *api.JobUpdateRequest, config configuration.SubmissionConfig) error {
+	if err := ValidateQueueAndJobSet(req); err != nil {
+		return err
+	}
+	if len(req.JobIds) == 0 {
+		return &armadaerrors.ErrInvalidArgument{
+			Name:    "JobIds",
+			Value:   req.JobIds,
+			Message: "at least one job id must be provided",
+		}
+	}
+	update := req.GetUpdate()
+	if len(update.GetContainerResources()) == 0 &&
+		len(update.GetNodeSelector()) == 0 &&
+		len(update.GetTolerations()) == 0 &&
+		update.GetPriorityClassName() == "" {
+		return &armadaerrors.ErrInvalidArgument{
+			Name:    "Update",
+			Value:   update,
+			Message: "update must change at least one of container resources, node selector, tolerations or priority class",
+		}
+	}
+
+	item := &api.JobSubmitRequestItem{PodSpec: podSpecFromQueuedJobUpdate(update)}
+	for _, validationFunc := range queuedJobUpdateValidators {
+		if err := validationFunc(item, config); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// podSpecFromQueuedJobUpdate returns a pod spec containing only the fields set by the update.
+func podSpecFromQueuedJobUpdate(update *api.QueuedJobUpdate) *v1.PodSpec {
+	podSpec := &v1.PodSpec{
+		NodeSelector:      update.NodeSelector,
+		PriorityClassName: update.PriorityClassName,
+	}
+	for _, name := range slices.Sorted(maps.Keys(update.ContainerResources)) {
+		container := v1.Container{Name: name}
+		if resources := update.ContainerResources[name]; resources != nil {
+			container.Resources = *resources
+		}
+		podSpec.Containers = append(podSpec.Containers, container)
+	}
+	for _, toleration := range update.Tolerations {
+		if toleration != nil {
+			podSpec.Tolerations = append(podSpec.Tolerations, *toleration)
+		}
+	}
+	return podSpec
+}
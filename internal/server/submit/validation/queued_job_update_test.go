@@ -0,0 +1,96 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/internal/server/configuration"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestValidateQueuedJobUpdate(t *testing.T) {
+	config := configuration.SubmissionConfig{
+		AllowedPriorityClassNames: map[string]bool{"pc1": true},
+		RestrictedTolerationKeys:  []string{"restricted"},
+	}
+	resources := func(request string, limit string) *v1.ResourceRequirements {
+		return &v1.ResourceRequirements{
+			Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse(request)},
+			Limits:   v1.ResourceList{v1.ResourceCPU: resource.MustParse(limit)},
+		}
+	}
+	request := func(update *api.QueuedJobUpdate) *api.JobUpdateRequest {
+		return &api.JobUpdateRequest{Queue: "queue", JobSetId: "job-set", JobIds: []string{"job-1"}, Update: update}
+	}
+
+	tests := map[string]struct {
+		req         *api.JobUpdateRequest
+		expectError bool
+	}{
+		"container resources": {
+			req: request(&api.QueuedJobUpdate{ContainerResources: map[string]*v1.ResourceRequirements{"main": resources("2", "2")}}),
+		},
+		"node selector": {
+			req: request(&api.QueuedJobUpdate{NodeSelector: map[string]string{"foo": "bar"}}),
+		},
+		"tolerations": {
+			req: request(&api.QueuedJobUpdate{Tolerations: []*v1.Toleration{{Key: "foo", Operator: v1.TolerationOpExists}}}),
+		},
+		"priority class": {
+			req: request(&api.QueuedJobUpdate{PriorityClassName: "pc1"}),
+		},
+		"missing queue": {
+			req:         &api.JobUpdateRequest{JobSetId: "job-set", JobIds: []string{"job-1"}, Update: &api.QueuedJobUpdate{PriorityClassName: "pc1"}},
+			expectError: true,
+		},
+		"missing job set": {
+			req:         &api.JobUpdateRequest{Queue: "queue", JobIds: []string{"job-1"}, Update: &api.QueuedJobUpdate{PriorityClassName: "pc1"}},
+			expectError: true,
+		},
+		"missing job ids": {
+			req:         &api.JobUpdateRequest{Queue: "queue", JobSetId: "job-set", Update: &api.QueuedJobUpdate{PriorityClassName: "pc1"}},
+			expectError: true,
+		},
+		"missing update": {
+			req:         request(nil),
+			expectError: true,
+		},
+		"empty update": {
+			req:         request(&api.QueuedJobUpdate{}),
+			expectError: true,
+		},
+		"limits smaller than requests": {
+			req:         request(&api.QueuedJobUpdate{ContainerResources: map[string]*v1.ResourceRequirements{"main": resources("2", "1")}}),
+			expectError: true,
+		},
+		"negative resources": {
+			req:         request(&api.QueuedJobUpdate{ContainerResources: map[string]*v1.ResourceRequirements{"main": resources("-1", "-1")}}),
+			expectError: true,
+		},
+		"no resources": {
+			req:         request(&api.QueuedJobUpdate{ContainerResources: map[string]*v1.ResourceRequirements{"main": {}}}),
+			expectError: true,
+		},
+		"unsupported priority class": {
+			req:         request(&api.QueuedJobUpdate{PriorityClassName: "notValid"}),
+			expectError: true,
+		},
+		"restricted toleration": {
+			req:         request(&api.QueuedJobUpdate{Tolerations: []*v1.Toleration{{Key: "restricted", Operator: v1.TolerationOpExists}}}),
+			expectError: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateQueuedJobUpdate(tc.req, config)
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
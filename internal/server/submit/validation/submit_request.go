@@ -2,9 +2,11 @@ package validation
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/pkg/errors"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/component-helpers/scheduling/corev1/nodeaffinity"
 
 	"github.com/armadaproject/armada/internal/common/constants"
@@ -27,6 +29,7 @@ var (
 		validateGangs,
 		validateHasJobSetId,
 		validateJobSetIdLength,
+		validateSecretReferences,
 	}
 	itemValidators = []itemValidator{
 		validateHasNamespace,
@@ -38,6 +41,7 @@ var (
 		validatePriorityClasses,
 		validateTerminationGracePeriod,
 		validateIngresses,
+		validateAdditionalObjects,
 		validatePorts,
 		validateClientId,
 		validateTolerations,
@@ -108,6 +112,66 @@ func validateIngresses(j *api.JobSubmitRequestItem, _ configuration.SubmissionCo
 	return nil
 }
 
+// Ensures that ConfigMaps, secret references and PersistentVolumeClaim templates have valid, unique names
+// and that each secret reference names the secret to copy.
+func validateAdditionalObjects(j *api.JobSubmitRequestItem, _ configuration.SubmissionConfig) error {
+	configMapNames := make([]string, 0, len(j.ConfigMaps))
+	for _, configMap := range j.ConfigMaps {
+		configMapNames = append(configMapNames, configMap.Name)
+	}
+	if err := validateObjectNames("configMap", configMapNames); err != nil {
+		return err
+	}
+
+	secretNames := make([]string, 0, len(j.Secrets))
+	for _, secret := range j.Secrets {
+		if secret.SourceName == "" {
+			return fmt.Errorf("secret %q does not specify a source secret", secret.Name)
+		}
+		secretNames = append(secretNames, secret.Name)
+	}
+	if err := validateObjectNames("secret", secretNames); err != nil {
+		return err
+	}
+
+	claimNames := make([]string, 0, len(j.VolumeClaimTemplates))
+	for _, template := range j.VolumeClaimTemplates {
+		claimNames = append(claimNames, template.Name)
+	}
+	return validateObjectNames("volumeClaimTemplate", claimNames)
+}
+
+func validateObjectNames(kind string, names []string) error {
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if name == "" {
+			return fmt.Errorf("%s has no name. Each %s must be named so the job's pod can refer to it", kind, kind)
+		}
+		if errs := k8svalidation.IsDNS1123Subdomain(name); len(errs) > 0 {
+			return fmt.Errorf("%s name %q is invalid: %s", kind, name, strings.Join(errs, ", "))
+		}
+		if seen[name] {
+			return fmt.Errorf("%s name %q is used more than once", kind, name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+// Ensures that jobs only reference secrets the queue is allowed to copy.
+// Without this, any job could copy any secret held by an executor into its own namespace.
+func validateSecretReferences(r *api.JobSubmitRequest, config configuration.SubmissionConfig) error {
+	allowedSecrets := config.JobSecretsByQueue[r.Queue]
+	for _, item := range r.JobRequestItems {
+		for _, secret := range item.Secrets {
+			if !slices.Contains(allowedSecrets, secret.SourceName) {
+				return fmt.Errorf("secret %q may not be used by jobs in queue %s", secret.SourceName, r.Queue)
+			}
+		}
+	}
+	return nil
+}
+
 // Ensures that the request has non-empty job set id field.
 func validateHasJobSetId(j *api.JobSubmitRequest, _ configuration.SubmissionConfig) error {
 	if len(j.JobSetId) == 0 {
@@ -116,9 +180,10 @@ func validateHasJobSetId(j *api.JobSubmitRequest, _ configuration.SubmissionConf
 	return nil
 }
 
+const maxJobSetIdChars = 1024
+
 // Ensures that the request has job set id field isn't too long.
 func validateJobSetIdLength(j *api.JobSubmitRequest, _ configuration.SubmissionConfig) error {
-	const maxJobSetIdChars = 1024
 	if len(j.GetJobSetId()) >= maxJobSetIdChars {
 		return fmt.Errorf("job set id of length %d must be less than max character length %d", len(j.GetJobSetId()), maxJobSetIdChars)
 	}
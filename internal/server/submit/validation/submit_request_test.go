@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 
 	"github.com/armadaproject/armada/internal/common/constants"
@@ -412,6 +413,86 @@ func TestValidateGangs(t *testing.T) {
 	}
 }
 
+func TestValidateAdditionalObjects(t *testing.T) {
+	configMap := func(name string) *v1.ConfigMap {
+		return &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	}
+	claim := func(name string) *v1.PersistentVolumeClaimTemplate {
+		return &v1.PersistentVolumeClaimTemplate{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	}
+	tests := map[string]struct {
+		req           *api.JobSubmitRequestItem
+		expectSuccess bool
+	}{
+		"no objects": {
+			req:           &api.JobSubmitRequestItem{},
+			expectSuccess: true,
+		},
+		"valid objects": {
+			req: &api.JobSubmitRequestItem{
+				ConfigMaps:           []*v1.ConfigMap{configMap("config-a"), configMap("config-b")},
+				Secrets:              []*api.SecretReference{{Name: "creds", SourceName: "team-creds"}},
+				VolumeClaimTemplates: []*v1.PersistentVolumeClaimTemplate{claim("scratch")},
+			},
+			expectSuccess: true,
+		},
+		"same name for different kinds": {
+			req: &api.JobSubmitRequestItem{
+				ConfigMaps:           []*v1.ConfigMap{configMap("data")},
+				Secrets:              []*api.SecretReference{{Name: "data", SourceName: "data"}},
+				VolumeClaimTemplates: []*v1.PersistentVolumeClaimTemplate{claim("data")},
+			},
+			expectSuccess: true,
+		},
+		"unnamed configMap": {
+			req: &api.JobSubmitRequestItem{
+				ConfigMaps: []*v1.ConfigMap{configMap("")},
+			},
+			expectSuccess: false,
+		},
+		"invalid configMap name": {
+			req: &api.JobSubmitRequestItem{
+				ConfigMaps: []*v1.ConfigMap{configMap("Not_Valid")},
+			},
+			expectSuccess: false,
+		},
+		"duplicate configMap names": {
+			req: &api.JobSubmitRequestItem{
+				ConfigMaps: []*v1.ConfigMap{configMap("config"), configMap("config")},
+			},
+			expectSuccess: false,
+		},
+		"secret without source": {
+			req: &api.JobSubmitRequestItem{
+				Secrets: []*api.SecretReference{{Name: "creds"}},
+			},
+			expectSuccess: false,
+		},
+		"unnamed secret": {
+			req: &api.JobSubmitRequestItem{
+				Secrets: []*api.SecretReference{{SourceName: "team-creds"}},
+			},
+			expectSuccess: false,
+		},
+		"duplicate volume claim names": {
+			req: &api.JobSubmitRequestItem{
+				VolumeClaimTemplates: []*v1.PersistentVolumeClaimTemplate{claim("scratch"), claim("scratch")},
+			},
+			expectSuccess: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateAdditionalObjects(tc.req, configuration.SubmissionConfig{})
+			if tc.expectSuccess {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateIngresses(t *testing.T) {
 	tests := map[string]struct {
 		req           *api.JobSubmitRequestItem
@@ -578,6 +659,53 @@ func TestValidateHasJobSetId(t *testing.T) {
 	}
 }
 
+func TestValidateSecretReferences(t *testing.T) {
+	config := configuration.SubmissionConfig{
+		JobSecretsByQueue: map[string][]string{"queue-a": {"team-a-creds"}},
+	}
+	request := func(queue string, sourceNames ...string) *api.JobSubmitRequest {
+		secrets := make([]*api.SecretReference, 0, len(sourceNames))
+		for _, sourceName := range sourceNames {
+			secrets = append(secrets, &api.SecretReference{Name: "creds", SourceName: sourceName})
+		}
+		return &api.JobSubmitRequest{
+			Queue:           queue,
+			JobRequestItems: []*api.JobSubmitRequestItem{{}, {Secrets: secrets}},
+		}
+	}
+	tests := map[string]struct {
+		req           *api.JobSubmitRequest
+		expectSuccess bool
+	}{
+		"no secrets": {
+			req:           request("queue-b"),
+			expectSuccess: true,
+		},
+		"allowed secret": {
+			req:           request("queue-a", "team-a-creds"),
+			expectSuccess: true,
+		},
+		"secret of another queue": {
+			req:           request("queue-b", "team-a-creds"),
+			expectSuccess: false,
+		},
+		"unlisted secret": {
+			req:           request("queue-a", "team-a-creds", "other-creds"),
+			expectSuccess: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateSecretReferences(tc.req, config)
+			if tc.expectSuccess {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateJobSetIdLength(t *testing.T) {
 	tests := map[string]struct {
 		req           *api.JobSubmitRequest
@@ -213,6 +213,38 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"/v1/job/cancel-by-selector\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"CancelJobsBySelector\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSelectorCancelRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSelectorResult\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"/v1/job/details\": {\n" +
 		"      \"post\": {\n" +
 		"        \"tags\": [\n" +
@@ -277,6 +309,39 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"/v1/job/move\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"Move queued jobs to another queue and/or job set. Job ids are unchanged.\\nJobs that are leased, running, finished, being cancelled or in a gang are not moved.\",\n" +
+		"        \"operationId\": \"MoveJobs\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobMoveRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobMoveResponse\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"/v1/job/preempt\": {\n" +
 		"      \"post\": {\n" +
 		"        \"tags\": [\n" +
@@ -341,6 +406,70 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"/v1/job/reprioritize-by-selector\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"ReprioritizeJobsBySelector\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSelectorReprioritizeRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSelectorResult\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/job/resume\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"ResumeJobs\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobResumeRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSuspensionResult\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"/v1/job/status\": {\n" +
 		"      \"post\": {\n" +
 		"        \"tags\": [\n" +
@@ -437,6 +566,72 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"/v1/job/suspend\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"Suspend jobs. Suspended jobs are not scheduled. Running jobs are preempted without\\nconsuming a retry and stay suspended until resumed.\",\n" +
+		"        \"operationId\": \"SuspendJobs\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSuspendRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSuspensionResult\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/job/update\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"Change the scheduling requirements of queued jobs.\\nJobs that are leased, running or finished are not updated.\",\n" +
+		"        \"operationId\": \"UpdateQueuedJobs\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobUpdateRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobUpdateResponse\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"/v1/jobset/cancel\": {\n" +
 		"      \"post\": {\n" +
 		"        \"tags\": [\n" +
@@ -467,6 +662,70 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"/v1/jobset/resume\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"ResumeJobSet\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSetResumeRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSuspensionResult\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/jobset/suspend\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"SuspendJobSet\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSetSuspendRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSuspensionResult\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"/v1/node/cancel/{name}\": {\n" +
 		"      \"post\": {\n" +
 		"        \"tags\": [\n" +
@@ -539,6 +798,42 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"/v1/node/release-quarantine/{name}\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Node\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"ReleaseNodeQuarantine\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"name\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiNodeQuarantineReleaseRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {}\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"/v1/queue\": {\n" +
 		"      \"post\": {\n" +
 		"        \"tags\": [\n" +
@@ -1000,6 +1295,9 @@ func SwaggerJsonTemplate() string {
 		"        \"leased\": {\n" +
 		"          \"$ref\": \"#/definitions/apiJobLeasedEvent\"\n" +
 		"        },\n" +
+		"        \"moved\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobMovedEvent\"\n" +
+		"        },\n" +
 		"        \"pending\": {\n" +
 		"          \"$ref\": \"#/definitions/apiJobPendingEvent\"\n" +
 		"        },\n" +
@@ -1018,6 +1316,9 @@ func SwaggerJsonTemplate() string {
 		"        \"reprioritizing\": {\n" +
 		"          \"$ref\": \"#/definitions/apiJobReprioritizingEvent\"\n" +
 		"        },\n" +
+		"        \"resumed\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobResumedEvent\"\n" +
+		"        },\n" +
 		"        \"running\": {\n" +
 		"          \"$ref\": \"#/definitions/apiJobRunningEvent\"\n" +
 		"        },\n" +
@@ -1027,6 +1328,9 @@ func SwaggerJsonTemplate() string {
 		"        \"succeeded\": {\n" +
 		"          \"$ref\": \"#/definitions/apiJobSucceededEvent\"\n" +
 		"        },\n" +
+		"        \"suspended\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobSuspendedEvent\"\n" +
+		"        },\n" +
 		"        \"utilisation\": {\n" +
 		"          \"$ref\": \"#/definitions/apiJobUtilisationEvent\"\n" +
 		"        }\n" +
@@ -1202,6 +1506,10 @@ func SwaggerJsonTemplate() string {
 		"      \"type\": \"object\",\n" +
 		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
+		"        \"idempotencyKey\": {\n" +
+		"          \"description\": \"Optional key used to make retries of this request idempotent.\\nA request replayed with the same key returns the result of the original request.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
 		"        \"jobId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
@@ -1555,6 +1863,73 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiJobMoveRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"destinationJobSetId\": {\n" +
+		"          \"description\": \"Job set to move the jobs to. If empty, the jobs keep their current job set.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"destinationQueue\": {\n" +
+		"          \"description\": \"Queue to move the jobs to. If empty, the jobs stay in their current queue.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobMoveResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"moveResults\": {\n" +
+		"          \"description\": \"Maps each job id to an error message, or to the empty string if the move was accepted.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobMovedEvent\": {\n" +
+		"      \"description\": \"Emitted in the job set a job is moved out of.\\nLater events for the job are published in the destination queue and job set.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"destinationJobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"destinationQueue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"requestor\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiJobPendingEvent\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
@@ -1596,6 +1971,10 @@ func SwaggerJsonTemplate() string {
 		"      \"type\": \"object\",\n" +
 		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
+		"        \"idempotencyKey\": {\n" +
+		"          \"description\": \"Optional key used to make retries of this request idempotent.\\nA request replayed with the same key returns the result of the original request.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
 		"        \"jobIds\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
@@ -1692,6 +2071,10 @@ func SwaggerJsonTemplate() string {
 		"      \"type\": \"object\",\n" +
 		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
+		"        \"idempotencyKey\": {\n" +
+		"          \"description\": \"Optional key used to make retries of this request idempotent.\\nA request replayed with the same key returns the result of the original request.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
 		"        \"jobIds\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
@@ -1735,19 +2118,62 @@ func SwaggerJsonTemplate() string {
 		"        \"jobSetId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"newPriority\": {\n" +
-		"          \"type\": \"number\",\n" +
-		"          \"format\": \"double\"\n" +
-		"        },\n" +
+		"        \"newPriority\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"requestor\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobReprioritizingEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"newPriority\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"requestor\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobResumeRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
 		"        \"queue\": {\n" +
 		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"requestor\": {\n" +
-		"          \"type\": \"string\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobReprioritizingEvent\": {\n" +
+		"    \"apiJobResumedEvent\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
 		"        \"created\": {\n" +
@@ -1760,10 +2186,6 @@ func SwaggerJsonTemplate() string {
 		"        \"jobSetId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"newPriority\": {\n" +
-		"          \"type\": \"number\",\n" +
-		"          \"format\": \"double\"\n" +
-		"        },\n" +
 		"        \"queue\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
@@ -1892,6 +2314,80 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiJobSelector\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Selects active jobs within a queue by their labels and annotations.\\nOnly labels and annotations configured as selectable on the server can be used, since the scheduler doesn't store any others.\\nJobs submitted before a label or annotation was made selectable are never matched by it.\\nswagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"annotations\": {\n" +
+		"          \"description\": \"Only jobs with all of these annotations are selected.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"jobSetPrefix\": {\n" +
+		"          \"description\": \"If set, only jobs in job sets whose name starts with this prefix are selected.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"labels\": {\n" +
+		"          \"description\": \"Only jobs with all of these labels are selected.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobSelectorCancelRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"dryRun\": {\n" +
+		"          \"description\": \"If true, no jobs are cancelled and only the number of matching jobs is returned.\",\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"selector\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobSelector\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobSelectorReprioritizeRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"dryRun\": {\n" +
+		"          \"description\": \"If true, no jobs are reprioritized and only the number of matching jobs is returned.\",\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"newPriority\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"selector\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobSelector\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobSelectorResult\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"matchedJobs\": {\n" +
+		"          \"description\": \"Number of jobs matching the selector.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiJobSetCancelRequest\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"title\": \"swagger:model\",\n" +
@@ -1899,6 +2395,10 @@ func SwaggerJsonTemplate() string {
 		"        \"filter\": {\n" +
 		"          \"$ref\": \"#/definitions/apiJobSetFilter\"\n" +
 		"        },\n" +
+		"        \"idempotencyKey\": {\n" +
+		"          \"description\": \"Optional key used to make retries of this request idempotent.\\nA request replayed with the same key returns the result of the original request.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
 		"        \"jobSetId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
@@ -1943,6 +2443,33 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiJobSetResumeRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobSetSuspendRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiJobState\": {\n" +
 		"      \"type\": \"string\",\n" +
 		"      \"title\": \"swagger:model\",\n" +
@@ -2027,6 +2554,13 @@ func SwaggerJsonTemplate() string {
 		"        \"clientId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
+		"        \"configMaps\": {\n" +
+		"          \"description\": \"ConfigMaps created in the job's namespace alongside the job's pod.\\nEach ConfigMap must be named and is deleted once the job finishes.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/v1ConfigMap\"\n" +
+		"          }\n" +
+		"        },\n" +
 		"        \"externalJobUri\": {\n" +
 		"          \"description\": \"URI identifying this job in an external system (e.g. Airflow).\\nIf not set, the server falls back to the \\\"armadaproject.io/externalJobUri\\\" annotation.\",\n" +
 		"          \"type\": \"string\"\n" +
@@ -2069,11 +2603,25 @@ func SwaggerJsonTemplate() string {
 		"          \"description\": \"Indicates which scheduler should manage this job.\\nIf empty, the default scheduler is used.\",\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
+		"        \"secrets\": {\n" +
+		"          \"description\": \"Secrets copied into the job's namespace from secrets held by the executor.\\nSecret data is never included in the job itself; see SecretReference.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiSecretReference\"\n" +
+		"          }\n" +
+		"        },\n" +
 		"        \"services\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
 		"            \"$ref\": \"#/definitions/apiServiceConfig\"\n" +
 		"          }\n" +
+		"        },\n" +
+		"        \"volumeClaimTemplates\": {\n" +
+		"          \"description\": \"PersistentVolumeClaims created in the job's namespace alongside the job's pod.\\nEach claim must be named and is deleted once the job finishes.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/v1PersistentVolumeClaimTemplate\"\n" +
+		"          }\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
@@ -2158,6 +2706,98 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiJobSuspendRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobSuspendedEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"requestor\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobSuspensionResult\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"results\": {\n" +
+		"          \"description\": \"Maps each job id to an error message, or to the empty string if the request was accepted.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobUpdateRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"update\": {\n" +
+		"          \"$ref\": \"#/definitions/apiQueuedJobUpdate\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobUpdateResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"updateResults\": {\n" +
+		"          \"description\": \"Maps each job id to an error message, or to the empty string if the update was accepted.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiJobUtilisationEvent\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
@@ -2261,6 +2901,18 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiNodeQuarantineReleaseRequest\": {\n" +
+		"      \"description\": \"The specified node will be released from quarantine by its executor.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"executor\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"name\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiPreemptionResult\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"title\": \"swagger:model\",\n" +
@@ -2409,6 +3061,37 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiQueuedJobUpdate\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Changes to the scheduling requirements of a queued job.\\nFields left empty are not changed.\\nswagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"containerResources\": {\n" +
+		"          \"description\": \"New resource requirements, keyed by container name. Containers not listed keep their current resources.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/v1ResourceRequirements\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"nodeSelector\": {\n" +
+		"          \"description\": \"If non-empty, replaces the node selector of the job.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"priorityClassName\": {\n" +
+		"          \"description\": \"If set, replaces the priority class of the job.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"tolerations\": {\n" +
+		"          \"description\": \"If non-empty, replaces the tolerations of the job.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/v1Toleration\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiRetryAction\": {\n" +
 		"      \"type\": \"string\",\n" +
 		"      \"default\": \"RETRY_ACTION_UNSPECIFIED\",\n" +
@@ -2519,6 +3202,20 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiSecretReference\": {\n" +
+		"      \"description\": \"A reference to a secret in the executor's job secret namespace.\\nThe executor copies the referenced secret into the job's namespace under the given name,\\nso that it can be mounted by the job's pod, and deletes the copy once the job finishes.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"name\": {\n" +
+		"          \"description\": \"Name of the secret created in the job's namespace.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"sourceName\": {\n" +
+		"          \"description\": \"Name of the secret to copy from the executor's job secret namespace.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiServiceConfig\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
@@ -2978,6 +3675,35 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"v1ConfigMap\": {\n" +
+		"      \"description\": \"ConfigMap holds configuration data for pods to consume.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"binaryData\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"title\": \"BinaryData contains the binary data.\\nEach key must consist of alphanumeric characters, '-', '_' or '.'.\\nBinaryData can contain byte sequences that are not in the UTF-8 range.\\nThe keys stored in BinaryData must not overlap with the ones in\\nthe Data field, this is enforced during validation process.\\nUsing this field will require 1.10+ apiserver and\\nkubelet.\\n+optional\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"format\": \"byte\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"data\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"title\": \"Data contains the configuration data.\\nEach key must consist of alphanumeric characters, '-', '_' or '.'.\\nValues with non-UTF-8 byte sequences must use the BinaryData field.\\nThe keys stored in Data must not overlap with the keys in\\nthe BinaryData field, this is enforced during validation process.\\n+optional\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"immutable\": {\n" +
+		"          \"type\": \"boolean\",\n" +
+		"          \"title\": \"Immutable, if set to true, ensures that data stored in the ConfigMap cannot\\nbe updated (only object metadata can be modified).\\nIf not set to true, the field can be modified at any time.\\nDefaulted to nil.\\n+optional\"\n" +
+		"        },\n" +
+		"        \"metadata\": {\n" +
+		"          \"title\": \"Standard object's metadata.\\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata\\n+optional\",\n" +
+		"          \"$ref\": \"#/definitions/v1ObjectMeta\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"v1ConfigMapEnvSource\": {\n" +
 		"      \"description\": \"The contents of the target ConfigMap's Data field will represent the\\nkey-value pairs as environment variables.\",\n" +
 		"      \"type\": \"object\",\n" +
@@ -1627,6 +1627,260 @@ func (m *JobReprioritizedEvent) GetRequestor() string {
 	return ""
 }
 
+// Emitted in the job set a job is moved out of.
+// Later events for the job are published in the destination queue and job set.
+type JobMovedEvent struct {
+	JobId               string           `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId            string           `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue               string           `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created             *types.Timestamp `protobuf:"bytes,4,opt,name=created,proto3" json:"created,omitempty"`
+	DestinationQueue    string           `protobuf:"bytes,5,opt,name=destination_queue,json=destinationQueue,proto3" json:"destinationQueue,omitempty"`
+	DestinationJobSetId string           `protobuf:"bytes,6,opt,name=destination_job_set_id,json=destinationJobSetId,proto3" json:"destinationJobSetId,omitempty"`
+	Requestor           string           `protobuf:"bytes,7,opt,name=requestor,proto3" json:"requestor,omitempty"`
+}
+
+func (m *JobMovedEvent) Reset()         { *m = JobMovedEvent{} }
+func (m *JobMovedEvent) String() string { return proto.CompactTextString(m) }
+func (*JobMovedEvent) ProtoMessage()    {}
+func (*JobMovedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{15}
+}
+func (m *JobMovedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobMovedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobMovedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobMovedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobMovedEvent.Merge(m, src)
+}
+func (m *JobMovedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobMovedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobMovedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobMovedEvent proto.InternalMessageInfo
+
+func (m *JobMovedEvent) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *JobMovedEvent) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
+}
+
+func (m *JobMovedEvent) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *JobMovedEvent) GetCreated() *types.Timestamp {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *JobMovedEvent) GetDestinationQueue() string {
+	if m != nil {
+		return m.DestinationQueue
+	}
+	return ""
+}
+
+func (m *JobMovedEvent) GetDestinationJobSetId() string {
+	if m != nil {
+		return m.DestinationJobSetId
+	}
+	return ""
+}
+
+func (m *JobMovedEvent) GetRequestor() string {
+	if m != nil {
+		return m.Requestor
+	}
+	return ""
+}
+
+type JobSuspendedEvent struct {
+	JobId     string           `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId  string           `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue     string           `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created   *types.Timestamp `protobuf:"bytes,4,opt,name=created,proto3" json:"created,omitempty"`
+	Requestor string           `protobuf:"bytes,5,opt,name=requestor,proto3" json:"requestor,omitempty"`
+	Reason    string           `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *JobSuspendedEvent) Reset()         { *m = JobSuspendedEvent{} }
+func (m *JobSuspendedEvent) String() string { return proto.CompactTextString(m) }
+func (*JobSuspendedEvent) ProtoMessage()    {}
+func (*JobSuspendedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{16}
+}
+func (m *JobSuspendedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobSuspendedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSuspendedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobSuspendedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSuspendedEvent.Merge(m, src)
+}
+func (m *JobSuspendedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobSuspendedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSuspendedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobSuspendedEvent proto.InternalMessageInfo
+
+func (m *JobSuspendedEvent) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *JobSuspendedEvent) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
+}
+
+func (m *JobSuspendedEvent) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *JobSuspendedEvent) GetCreated() *types.Timestamp {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *JobSuspendedEvent) GetRequestor() string {
+	if m != nil {
+		return m.Requestor
+	}
+	return ""
+}
+
+func (m *JobSuspendedEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type JobResumedEvent struct {
+	JobId     string           `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId  string           `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue     string           `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created   *types.Timestamp `protobuf:"bytes,4,opt,name=created,proto3" json:"created,omitempty"`
+	Requestor string           `protobuf:"bytes,5,opt,name=requestor,proto3" json:"requestor,omitempty"`
+}
+
+func (m *JobResumedEvent) Reset()         { *m = JobResumedEvent{} }
+func (m *JobResumedEvent) String() string { return proto.CompactTextString(m) }
+func (*JobResumedEvent) ProtoMessage()    {}
+func (*JobResumedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{17}
+}
+func (m *JobResumedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobResumedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobResumedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobResumedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobResumedEvent.Merge(m, src)
+}
+func (m *JobResumedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobResumedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobResumedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobResumedEvent proto.InternalMessageInfo
+
+func (m *JobResumedEvent) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *JobResumedEvent) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
+}
+
+func (m *JobResumedEvent) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *JobResumedEvent) GetCreated() *types.Timestamp {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *JobResumedEvent) GetRequestor() string {
+	if m != nil {
+		return m.Requestor
+	}
+	return ""
+}
+
 type JobCancellingEvent struct {
 	JobId     string           `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
 	JobSetId  string           `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
@@ -1640,7 +1894,7 @@ func (m *JobCancellingEvent) Reset()         { *m = JobCancellingEvent{} }
 func (m *JobCancellingEvent) String() string { return proto.CompactTextString(m) }
 func (*JobCancellingEvent) ProtoMessage()    {}
 func (*JobCancellingEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{15}
+	return fileDescriptor_7758595c3bb8cf56, []int{18}
 }
 func (m *JobCancellingEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1724,7 +1978,7 @@ func (m *JobCancelledEvent) Reset()         { *m = JobCancelledEvent{} }
 func (m *JobCancelledEvent) String() string { return proto.CompactTextString(m) }
 func (*JobCancelledEvent) ProtoMessage()    {}
 func (*JobCancelledEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{16}
+	return fileDescriptor_7758595c3bb8cf56, []int{19}
 }
 func (m *JobCancelledEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1812,7 +2066,7 @@ func (m *JobTerminatedEvent) Reset()         { *m = JobTerminatedEvent{} }
 func (m *JobTerminatedEvent) String() string { return proto.CompactTextString(m) }
 func (*JobTerminatedEvent) ProtoMessage()    {}
 func (*JobTerminatedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{17}
+	return fileDescriptor_7758595c3bb8cf56, []int{20}
 }
 func (m *JobTerminatedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1930,6 +2184,9 @@ type EventMessage struct {
 	//	*EventMessage_Reprioritizing
 	//	*EventMessage_Preempted
 	//	*EventMessage_Preempting
+	//	*EventMessage_Moved
+	//	*EventMessage_Suspended
+	//	*EventMessage_Resumed
 	Events isEventMessage_Events `protobuf_oneof:"events"`
 }
 
@@ -1937,7 +2194,7 @@ func (m *EventMessage) Reset()         { *m = EventMessage{} }
 func (m *EventMessage) String() string { return proto.CompactTextString(m) }
 func (*EventMessage) ProtoMessage()    {}
 func (*EventMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{18}
+	return fileDescriptor_7758595c3bb8cf56, []int{21}
 }
 func (m *EventMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2023,6 +2280,15 @@ type EventMessage_Preempted struct {
 type EventMessage_Preempting struct {
 	Preempting *JobPreemptingEvent `protobuf:"bytes,22,opt,name=preempting,proto3,oneof" json:"preempting,omitempty"`
 }
+type EventMessage_Moved struct {
+	Moved *JobMovedEvent `protobuf:"bytes,23,opt,name=moved,proto3,oneof" json:"moved,omitempty"`
+}
+type EventMessage_Suspended struct {
+	Suspended *JobSuspendedEvent `protobuf:"bytes,24,opt,name=suspended,proto3,oneof" json:"suspended,omitempty"`
+}
+type EventMessage_Resumed struct {
+	Resumed *JobResumedEvent `protobuf:"bytes,25,opt,name=resumed,proto3,oneof" json:"resumed,omitempty"`
+}
 
 func (*EventMessage_Submitted) isEventMessage_Events()      {}
 func (*EventMessage_Queued) isEventMessage_Events()         {}
@@ -2041,6 +2307,9 @@ func (*EventMessage_IngressInfo) isEventMessage_Events()    {}
 func (*EventMessage_Reprioritizing) isEventMessage_Events() {}
 func (*EventMessage_Preempted) isEventMessage_Events()      {}
 func (*EventMessage_Preempting) isEventMessage_Events()     {}
+func (*EventMessage_Moved) isEventMessage_Events()          {}
+func (*EventMessage_Suspended) isEventMessage_Events()      {}
+func (*EventMessage_Resumed) isEventMessage_Events()        {}
 
 func (m *EventMessage) GetEvents() isEventMessage_Events {
 	if m != nil {
@@ -2168,6 +2437,27 @@ func (m *EventMessage) GetPreempting() *JobPreemptingEvent {
 	return nil
 }
 
+func (m *EventMessage) GetMoved() *JobMovedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Moved); ok {
+		return x.Moved
+	}
+	return nil
+}
+
+func (m *EventMessage) GetSuspended() *JobSuspendedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Suspended); ok {
+		return x.Suspended
+	}
+	return nil
+}
+
+func (m *EventMessage) GetResumed() *JobResumedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Resumed); ok {
+		return x.Resumed
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*EventMessage) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -2188,6 +2478,9 @@ func (*EventMessage) XXX_OneofWrappers() []interface{} {
 		(*EventMessage_Reprioritizing)(nil),
 		(*EventMessage_Preempted)(nil),
 		(*EventMessage_Preempting)(nil),
+		(*EventMessage_Moved)(nil),
+		(*EventMessage_Suspended)(nil),
+		(*EventMessage_Resumed)(nil),
 	}
 }
 
@@ -2203,7 +2496,7 @@ func (m *ContainerStatus) Reset()         { *m = ContainerStatus{} }
 func (m *ContainerStatus) String() string { return proto.CompactTextString(m) }
 func (*ContainerStatus) ProtoMessage()    {}
 func (*ContainerStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{19}
+	return fileDescriptor_7758595c3bb8cf56, []int{22}
 }
 func (m *ContainerStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2277,7 +2570,7 @@ func (m *EventStreamMessage) Reset()         { *m = EventStreamMessage{} }
 func (m *EventStreamMessage) String() string { return proto.CompactTextString(m) }
 func (*EventStreamMessage) ProtoMessage()    {}
 func (*EventStreamMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{20}
+	return fileDescriptor_7758595c3bb8cf56, []int{23}
 }
 func (m *EventStreamMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2333,7 +2626,7 @@ func (m *JobSetRequest) Reset()         { *m = JobSetRequest{} }
 func (m *JobSetRequest) String() string { return proto.CompactTextString(m) }
 func (*JobSetRequest) ProtoMessage()    {}
 func (*JobSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{21}
+	return fileDescriptor_7758595c3bb8cf56, []int{24}
 }
 func (m *JobSetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2407,7 +2700,7 @@ func (m *WatchRequest) Reset()         { *m = WatchRequest{} }
 func (m *WatchRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchRequest) ProtoMessage()    {}
 func (*WatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{22}
+	return fileDescriptor_7758595c3bb8cf56, []int{25}
 }
 func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2479,6 +2772,9 @@ func init() {
 	proto.RegisterMapType((map[string]*resource.Quantity)(nil), "api.JobUtilisationEvent.TotalCumulativeUsageEntry")
 	proto.RegisterType((*JobReprioritizingEvent)(nil), "api.JobReprioritizingEvent")
 	proto.RegisterType((*JobReprioritizedEvent)(nil), "api.JobReprioritizedEvent")
+	proto.RegisterType((*JobMovedEvent)(nil), "api.JobMovedEvent")
+	proto.RegisterType((*JobSuspendedEvent)(nil), "api.JobSuspendedEvent")
+	proto.RegisterType((*JobResumedEvent)(nil), "api.JobResumedEvent")
 	proto.RegisterType((*JobCancellingEvent)(nil), "api.JobCancellingEvent")
 	proto.RegisterType((*JobCancelledEvent)(nil), "api.JobCancelledEvent")
 	proto.RegisterType((*JobTerminatedEvent)(nil), "api.JobTerminatedEvent")
@@ -2492,158 +2788,167 @@ func init() {
 func init() { proto.RegisterFile("pkg/api/event.proto", fileDescriptor_7758595c3bb8cf56) }
 
 var fileDescriptor_7758595c3bb8cf56 = []byte{
-	// 2404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0xcf, 0x6f, 0x1b, 0xc7,
-	0xf5, 0xf7, 0x52, 0xfc, 0xb1, 0x1c, 0x4a, 0x22, 0x35, 0xfa, 0x61, 0x9a, 0x76, 0x44, 0x81, 0x06,
-	0xf2, 0x55, 0x8c, 0x98, 0xcc, 0x57, 0x4e, 0x8a, 0xc0, 0x28, 0x50, 0x58, 0x8a, 0x12, 0x8b, 0x8d,
-	0x6b, 0x9b, 0x72, 0x90, 0xb4, 0x08, 0xc0, 0x2e, 0xb9, 0x23, 0x6a, 0x25, 0x72, 0x87, 0x99, 0xdd,
-	0x95, 0xad, 0x18, 0xb9, 0xb4, 0x97, 0x1e, 0x8a, 0xa2, 0x3f, 0x8e, 0x2d, 0xda, 0x9e, 0x8b, 0x9e,
-	0x7a, 0xe9, 0xad, 0xe8, 0xa9, 0x28, 0x7a, 0x0a, 0x9a, 0x4b, 0x4f, 0x44, 0x6b, 0x17, 0x29, 0x40,
-	0xf4, 0xd0, 0x3f, 0xa1, 0x98, 0x37, 0xb3, 0xbb, 0x33, 0x14, 0x05, 0xc9, 0xca, 0x8f, 0x1a, 0x0a,
-	0x4f, 0xb6, 0x3e, 0x6f, 0xde, 0x9b, 0xb7, 0xef, 0x7d, 0x76, 0xe6, 0xcd, 0xbe, 0x21, 0x9a, 0xef,
-	0xef, 0x77, 0x6a, 0x56, 0xdf, 0xa9, 0x91, 0x03, 0xe2, 0xfa, 0xd5, 0x3e, 0xa3, 0x3e, 0xc5, 0x53,
-	0x56, 0xdf, 0x29, 0x95, 0x3b, 0x94, 0x76, 0xba, 0xa4, 0x06, 0x50, 0x2b, 0xd8, 0xa9, 0xf9, 0x4e,
-	0x8f, 0x78, 0xbe, 0xd5, 0xeb, 0x8b, 0x51, 0xa5, 0x85, 0x50, 0xd5, 0x0b, 0x5a, 0x3d, 0xc7, 0x1f,
-	0x45, 0x77, 0x89, 0xd5, 0xf5, 0x77, 0x25, 0x7a, 0x79, 0xd4, 0x18, 0xe9, 0xf5, 0xfd, 0x43, 0x29,
-	0xbc, 0x22, 0x85, 0x5c, 0xcb, 0x72, 0x5d, 0xea, 0x5b, 0xbe, 0x43, 0x5d, 0x4f, 0x4a, 0x5f, 0xdd,
-	0x7f, 0xdd, 0xab, 0x3a, 0x94, 0x4b, 0x7b, 0x56, 0x7b, 0xd7, 0x71, 0x09, 0x3b, 0xac, 0x85, 0x93,
-	0x30, 0xe2, 0xd1, 0x80, 0xb5, 0x49, 0xad, 0x43, 0x5c, 0xc2, 0x2c, 0x9f, 0xd8, 0x42, 0xab, 0xf2,
-	0x8b, 0x04, 0x9a, 0xab, 0xd3, 0xd6, 0x36, 0xb8, 0xe6, 0x13, 0x7b, 0x93, 0x3f, 0x1e, 0xbe, 0x86,
-	0xd2, 0x7b, 0xb4, 0xd5, 0x74, 0xec, 0xa2, 0xb1, 0x62, 0xac, 0x66, 0xd7, 0xe7, 0x87, 0x83, 0x72,
-	0x7e, 0x8f, 0xb6, 0xb6, 0xec, 0x97, 0x69, 0xcf, 0xf1, 0xc1, 0xa9, 0x46, 0x0a, 0x00, 0xfc, 0x2a,
-	0x42, 0x7c, 0xac, 0x47, 0x7c, 0x3e, 0x3e, 0x01, 0xe3, 0x97, 0x86, 0x83, 0x32, 0xde, 0xa3, 0xad,
-	0x6d, 0xe2, 0x6b, 0x2a, 0x66, 0x88, 0xe1, 0x97, 0x50, 0xea, 0x83, 0x80, 0x04, 0xa4, 0x38, 0x15,
-	0x4f, 0x00, 0x80, 0x3a, 0x01, 0x00, 0xf8, 0x9b, 0x28, 0xd3, 0x66, 0x84, 0xfb, 0x5c, 0x4c, 0xae,
-	0x18, 0xab, 0xb9, 0xb5, 0x52, 0x55, 0x04, 0xa2, 0x1a, 0x46, 0xa9, 0xfa, 0x20, 0x0c, 0xf9, 0xfa,
-	0xe2, 0x70, 0x50, 0x9e, 0x93, 0xc3, 0x15, 0x53, 0xa1, 0x05, 0x7c, 0x1d, 0x4d, 0xed, 0xd1, 0x56,
-	0x31, 0x05, 0x86, 0xcc, 0xaa, 0xd5, 0x77, 0xaa, 0x75, 0xda, 0x5a, 0x9f, 0x1b, 0x0e, 0xca, 0x33,
-	0x7b, 0xb4, 0xa5, 0xa8, 0xf0, 0x71, 0x95, 0xa1, 0x81, 0x66, 0xeb, 0xb4, 0x75, 0x9f, 0x3b, 0x72,
-	0xde, 0x63, 0x53, 0xf9, 0x4b, 0x02, 0x1e, 0xf6, 0x6d, 0x62, 0x79, 0xe7, 0x9f, 0x08, 0x5f, 0x43,
-	0xa8, 0xdd, 0x0d, 0x3c, 0x9f, 0x30, 0xee, 0x6d, 0x0a, 0x26, 0xbf, 0x38, 0x1c, 0x94, 0xe7, 0x25,
-	0xaa, 0xb9, 0x9b, 0x8d, 0x40, 0xfc, 0x22, 0x4a, 0xf6, 0x29, 0xed, 0x16, 0xd3, 0xa0, 0x81, 0x87,
-	0x83, 0xf2, 0x2c, 0xff, 0x5b, 0x19, 0x0c, 0xf2, 0xca, 0x4f, 0x92, 0x68, 0x31, 0x0c, 0x66, 0x83,
-	0xf8, 0x01, 0x73, 0x27, 0x31, 0x3d, 0x2e, 0xa6, 0x2f, 0xa3, 0x34, 0x23, 0x96, 0x47, 0x5d, 0x19,
-	0xd5, 0x85, 0xe1, 0xa0, 0x5c, 0x10, 0x88, 0xa2, 0x20, 0xc7, 0xe0, 0x6f, 0xa0, 0x99, 0xfd, 0xa0,
-	0x45, 0x98, 0x4b, 0x7c, 0xe2, 0xf1, 0x89, 0x32, 0xa0, 0x54, 0x1a, 0x0e, 0xca, 0x4b, 0xb1, 0x40,
-	0x9b, 0x6b, 0x5a, 0xc5, 0xb9, 0x9b, 0x7d, 0x6a, 0x37, 0xdd, 0xa0, 0xd7, 0x22, 0xac, 0x68, 0xae,
-	0x18, 0xab, 0x29, 0xe1, 0x66, 0x9f, 0xda, 0xdf, 0x02, 0x50, 0x75, 0x33, 0x02, 0xf9, 0xc4, 0x2c,
-	0x70, 0x9b, 0x96, 0x0f, 0x22, 0x62, 0x17, 0xb3, 0x2b, 0xc6, 0xaa, 0x29, 0x26, 0x66, 0x81, 0x7b,
-	0x2b, 0xc4, 0xd5, 0x89, 0x55, 0xbc, 0xf2, 0x1f, 0x03, 0x2d, 0x84, 0x9c, 0xd8, 0x7c, 0xd4, 0x77,
-	0xd8, 0xf9, 0x5f, 0x53, 0x7e, 0x9f, 0x44, 0xf9, 0x3a, 0x6d, 0xdd, 0x23, 0xae, 0xed, 0xb8, 0x9d,
-	0xc9, 0x0b, 0x30, 0xfe, 0x05, 0x38, 0x42, 0xe9, 0xf4, 0x67, 0xa2, 0x74, 0xe6, 0xd4, 0x94, 0x7e,
-	0x05, 0x99, 0xa0, 0x67, 0xf5, 0x08, 0xbc, 0x08, 0x59, 0xf1, 0x88, 0x7c, 0x80, 0xd5, 0x53, 0xa3,
-	0x95, 0x91, 0x10, 0x77, 0x35, 0xd4, 0xf0, 0xfa, 0x56, 0x9b, 0xc0, 0x4b, 0x20, 0x5d, 0x95, 0x63,
-	0x00, 0x57, 0x5d, 0x55, 0xf1, 0x68, 0x01, 0x45, 0x27, 0x2c, 0xa0, 0xff, 0x16, 0xcc, 0x69, 0x04,
-	0xae, 0x3b, 0x61, 0xce, 0x17, 0xc7, 0x9c, 0x1b, 0x28, 0xeb, 0x52, 0x9b, 0x08, 0x0a, 0x64, 0xe2,
-	0x28, 0x71, 0x70, 0x84, 0x03, 0x66, 0x88, 0x9d, 0x79, 0x05, 0x55, 0xe9, 0x96, 0x3d, 0x1b, 0xdd,
-	0xd0, 0x19, 0xe9, 0x96, 0x3b, 0x81, 0x6e, 0xbf, 0x4b, 0xa3, 0xf9, 0x3a, 0x6d, 0x6d, 0xb9, 0x1d,
-	0x46, 0x3c, 0x6f, 0xcb, 0xdd, 0xa1, 0x13, 0xca, 0x9d, 0x37, 0xca, 0xa1, 0xb3, 0x51, 0x2e, 0xf7,
-	0x8c, 0x94, 0x7b, 0x8c, 0xe6, 0x1c, 0x41, 0xa3, 0xa6, 0x65, 0xdb, 0xfc, 0x5f, 0xe2, 0x15, 0xb3,
-	0x2b, 0x53, 0xab, 0xb9, 0xb5, 0x6a, 0x78, 0xe2, 0x18, 0xe5, 0x59, 0x55, 0x02, 0xb7, 0x42, 0x85,
-	0x4d, 0xd7, 0x67, 0x87, 0xeb, 0xcb, 0xc3, 0x41, 0xb9, 0xe4, 0x8c, 0x88, 0x94, 0x89, 0x0b, 0xa3,
-	0xb2, 0xd2, 0x3e, 0x5a, 0x1c, 0x6b, 0x0a, 0x5f, 0x45, 0x53, 0xfb, 0xe4, 0x10, 0x58, 0x9c, 0x12,
-	0xe7, 0x9d, 0x7d, 0x72, 0xa8, 0x9e, 0x77, 0xf6, 0xc9, 0x21, 0xe7, 0xe2, 0x81, 0xd5, 0x0d, 0x88,
-	0x24, 0x2f, 0x70, 0x11, 0x00, 0x95, 0x8b, 0x00, 0xdc, 0x4c, 0xbc, 0x6e, 0x54, 0x7e, 0x9b, 0x85,
-	0x13, 0xc3, 0x9b, 0x96, 0xd3, 0x9d, 0x54, 0xb7, 0x9f, 0x4f, 0x75, 0xfb, 0x3e, 0x42, 0xe4, 0x91,
-	0xe3, 0x37, 0xdb, 0xd4, 0x26, 0x5e, 0x31, 0x03, 0xac, 0xa9, 0x84, 0xac, 0x51, 0x02, 0x5d, 0xdd,
-	0x7c, 0xe4, 0xf8, 0x1b, 0x7c, 0x90, 0x60, 0xca, 0x25, 0xee, 0x09, 0x09, 0xb1, 0xd8, 0x70, 0xd1,
-	0x68, 0x64, 0x23, 0xf8, 0xe8, 0xbb, 0x6b, 0x7e, 0x96, 0x77, 0x37, 0x7b, 0xa6, 0x77, 0x17, 0x9d,
-	0xe9, 0xdd, 0x9d, 0x39, 0xdb, 0xbb, 0x3b, 0xfb, 0x8c, 0xef, 0xae, 0x8d, 0x70, 0x9b, 0xba, 0xbe,
-	0xe5, 0xb8, 0x84, 0x35, 0x3d, 0xdf, 0xf2, 0x03, 0xfe, 0xf2, 0xe6, 0x20, 0x0d, 0x0b, 0x90, 0x86,
-	0x8d, 0x50, 0xbc, 0x0d, 0xd2, 0xf5, 0xf2, 0x70, 0x50, 0xbe, 0xdc, 0xd6, 0x41, 0xed, 0x1d, 0x9d,
-	0x3b, 0x22, 0xc4, 0xaf, 0xa1, 0x54, 0xdb, 0x0a, 0x3c, 0x52, 0x9c, 0x5e, 0x31, 0x56, 0x67, 0xd7,
-	0x90, 0x30, 0xcc, 0x11, 0x41, 0x67, 0x10, 0xaa, 0x74, 0x06, 0x00, 0xdf, 0x46, 0x85, 0x1d, 0xcb,
-	0xe9, 0x06, 0x8c, 0x34, 0xdb, 0x96, 0x4f, 0x3a, 0x94, 0x1d, 0x16, 0x0b, 0xf0, 0x80, 0x2f, 0x0c,
-	0x07, 0xe5, 0x4b, 0x52, 0xb6, 0x21, 0x45, 0x8a, 0x7e, 0x7e, 0x44, 0x84, 0xef, 0xa3, 0xf9, 0xd0,
-	0x92, 0x17, 0xb4, 0x22, 0x63, 0x73, 0x60, 0x6c, 0x65, 0x38, 0x28, 0x5f, 0x91, 0xe2, 0xed, 0x58,
-	0xaa, 0xd8, 0xc3, 0x47, 0xa5, 0xf8, 0x35, 0x94, 0x65, 0xc4, 0x67, 0x87, 0x56, 0xab, 0x4b, 0x8a,
-	0x18, 0x4e, 0x46, 0x90, 0xe3, 0x08, 0x54, 0x73, 0x1c, 0x81, 0x25, 0x1b, 0xcd, 0xea, 0x4c, 0x56,
-	0x17, 0xaa, 0xec, 0xe9, 0x16, 0xaa, 0xd4, 0x49, 0x0b, 0x55, 0x3d, 0x69, 0xe6, 0x0b, 0x85, 0xca,
-	0x27, 0x09, 0x84, 0xf9, 0x61, 0x84, 0x11, 0x3e, 0xe0, 0x2b, 0x50, 0x55, 0x42, 0x4e, 0x3e, 0x08,
-	0x88, 0xe7, 0x53, 0xa6, 0xae, 0x58, 0x11, 0xa8, 0xe7, 0x44, 0x82, 0xcf, 0xb6, 0x62, 0x55, 0x7e,
-	0x9e, 0x84, 0x4f, 0x88, 0x32, 0xaa, 0x93, 0x7d, 0xe0, 0xb8, 0x7d, 0xe0, 0x1a, 0x4a, 0xb3, 0xc0,
-	0x8d, 0x0b, 0x26, 0x70, 0x98, 0x05, 0xae, 0x1e, 0x11, 0x00, 0xf0, 0x16, 0x9a, 0xeb, 0x4b, 0x96,
-	0x1e, 0x90, 0xa6, 0x54, 0x33, 0xe3, 0x57, 0x3d, 0x16, 0x36, 0x46, 0x0c, 0xe4, 0x47, 0x44, 0x4a,
-	0x32, 0xb3, 0xa7, 0xd8, 0x7e, 0x94, 0x89, 0xdd, 0x4e, 0x53, 0x66, 0x10, 0x1d, 0x9d, 0xd8, 0xed,
-	0xd4, 0x47, 0x72, 0x99, 0x1f, 0x11, 0xd5, 0x93, 0x66, 0xa6, 0x60, 0x56, 0xfe, 0x94, 0x94, 0x1f,
-	0x98, 0xdb, 0x6d, 0x42, 0xec, 0x09, 0x3b, 0x26, 0x07, 0xb9, 0xb3, 0x1d, 0xe4, 0x2a, 0xff, 0xc8,
-	0xc1, 0x01, 0xed, 0x1d, 0xdf, 0xe9, 0x3a, 0x1e, 0x74, 0x3e, 0x26, 0x54, 0xfa, 0x82, 0xa8, 0xf4,
-	0x23, 0x03, 0x2d, 0xde, 0xb1, 0x1e, 0x35, 0x64, 0xd3, 0xc8, 0x7b, 0x93, 0xb2, 0x7b, 0x84, 0x39,
-	0xd4, 0x96, 0xf5, 0xe8, 0x8d, 0xb0, 0x1e, 0x1d, 0x4d, 0x46, 0x75, 0xac, 0x96, 0x28, 0x50, 0xaf,
-	0x0e, 0x07, 0xe5, 0xf2, 0x58, 0xb9, 0xe2, 0xc7, 0xf8, 0x69, 0x75, 0x6e, 0x9b, 0x67, 0xe2, 0x76,
-	0xf6, 0x79, 0x3e, 0x31, 0xfe, 0xd0, 0x40, 0x4b, 0x3e, 0xf5, 0xad, 0x6e, 0xb3, 0x1d, 0xf4, 0x82,
-	0xae, 0x05, 0xab, 0x7e, 0xe0, 0x59, 0x1d, 0x5e, 0x21, 0xf2, 0x88, 0xaf, 0x1d, 0x1b, 0xf1, 0x07,
-	0x5c, 0x6d, 0x23, 0xd2, 0x7a, 0x87, 0x2b, 0x89, 0x80, 0x57, 0x86, 0x83, 0xf2, 0xb2, 0x3f, 0x46,
-	0xac, 0xb8, 0xb1, 0x30, 0x4e, 0x0e, 0xf9, 0xbf, 0x75, 0xd0, 0x19, 0x93, 0xff, 0x99, 0x13, 0xf2,
-	0x3f, 0x56, 0x4b, 0xc9, 0xff, 0x58, 0xb9, 0x9a, 0xff, 0xb1, 0x03, 0x4a, 0xbf, 0x36, 0x50, 0xe9,
-	0x78, 0x6a, 0x9d, 0xae, 0x62, 0xfc, 0xb6, 0x5a, 0x31, 0xf2, 0x93, 0xb8, 0xe8, 0x97, 0x56, 0xd5,
-	0x7e, 0x69, 0xb5, 0xbf, 0xdf, 0x81, 0x67, 0x0b, 0xfb, 0xa5, 0xd5, 0xfb, 0x81, 0xe5, 0xfa, 0x8e,
-	0x7f, 0x78, 0x52, 0x85, 0x59, 0xfa, 0x95, 0x81, 0x2e, 0x1d, 0x9b, 0x8b, 0xe7, 0xc2, 0x43, 0x1e,
-	0xc4, 0xe3, 0xf3, 0xf3, 0x3c, 0xb8, 0x58, 0xf9, 0x57, 0x02, 0x2d, 0xd5, 0x69, 0xab, 0x41, 0xfa,
-	0xcc, 0xa1, 0xcc, 0xf1, 0x9d, 0x0f, 0xbf, 0x02, 0x45, 0xfa, 0xd7, 0xd1, 0xb4, 0x4b, 0x1e, 0x36,
-	0xe5, 0x23, 0x1f, 0xc2, 0x42, 0x6f, 0xc0, 0x79, 0x7e, 0xd1, 0x25, 0x0f, 0xef, 0x49, 0x58, 0xd1,
-	0xcc, 0x29, 0xb0, 0x5e, 0xe2, 0xa7, 0x4f, 0x5b, 0xe2, 0x57, 0x3e, 0x4d, 0x40, 0x7b, 0x52, 0x89,
-	0xf4, 0xf9, 0x2f, 0xcd, 0xfe, 0x27, 0x81, 0x96, 0x67, 0xce, 0x0d, 0xcb, 0x6d, 0x93, 0x6e, 0x77,
-	0x72, 0xe6, 0xfc, 0x7c, 0xce, 0x9c, 0x7f, 0x15, 0xd7, 0x56, 0x64, 0x54, 0xcf, 0x3f, 0x75, 0xbf,
-	0x94, 0xa0, 0xfe, 0x31, 0x09, 0x54, 0x7d, 0x40, 0x58, 0xcf, 0x71, 0xad, 0xc9, 0x49, 0xfe, 0xf9,
-	0x6e, 0xd7, 0x7e, 0x49, 0xfd, 0xb3, 0x98, 0x42, 0xe6, 0x29, 0x28, 0xf4, 0x87, 0x1c, 0x9a, 0x06,
-	0xd6, 0xdc, 0x21, 0x1e, 0x94, 0x92, 0x77, 0x51, 0xd6, 0x0b, 0xef, 0x96, 0x01, 0x7f, 0x72, 0x6b,
-	0x4b, 0x61, 0xf5, 0xa8, 0x5f, 0x3a, 0x13, 0x01, 0x88, 0x06, 0xc7, 0xc6, 0x6f, 0x5f, 0x68, 0xc4,
-	0x36, 0xf0, 0x06, 0x4a, 0x03, 0x13, 0x6c, 0x59, 0x82, 0xcc, 0x87, 0xd6, 0x94, 0x3b, 0x5a, 0xc2,
-	0x49, 0x31, 0x4c, 0xb3, 0x23, 0x55, 0xb9, 0x91, 0x2e, 0xdc, 0x72, 0x02, 0xc6, 0x29, 0x46, 0x94,
-	0xbb, 0x4f, 0xc2, 0x88, 0x18, 0xa6, 0x1b, 0x11, 0x18, 0xfe, 0x2e, 0x9a, 0x85, 0xff, 0x35, 0x99,
-	0xbc, 0xde, 0x13, 0x31, 0x52, 0x35, 0xa6, 0xdd, 0xfd, 0x59, 0xbf, 0x3c, 0x1c, 0x94, 0x2f, 0x76,
-	0x55, 0x5c, 0x33, 0x3d, 0xa3, 0x89, 0xf0, 0xfb, 0x48, 0x00, 0x4d, 0x22, 0x2e, 0x8b, 0xc8, 0x6b,
-	0x6b, 0x97, 0xb4, 0x09, 0xd4, 0x8b, 0x24, 0x22, 0xaf, 0x5d, 0x05, 0xd6, 0xcc, 0x4f, 0xab, 0x12,
-	0xfc, 0x16, 0xca, 0xf4, 0xc5, 0xb5, 0x0c, 0xe0, 0x6f, 0xf8, 0x7d, 0x7b, 0xe4, 0xb6, 0x86, 0x64,
-	0x98, 0x40, 0x34, 0x6b, 0xa1, 0x36, 0x37, 0xc4, 0x44, 0x97, 0x1e, 0xa8, 0xac, 0x18, 0x52, 0x9b,
-	0xf7, 0xc2, 0x90, 0x1c, 0xa8, 0x1b, 0x92, 0x20, 0x4f, 0xcb, 0x0e, 0x74, 0x38, 0x80, 0xdc, 0x4a,
-	0x5a, 0x94, 0xbe, 0x87, 0x48, 0x8b, 0x18, 0xa6, 0xa7, 0x45, 0x60, 0x82, 0x71, 0xf2, 0x63, 0x13,
-	0xb0, 0x5d, 0x63, 0x9c, 0xfa, 0x15, 0x2a, 0x64, 0x9c, 0xc4, 0x46, 0x19, 0x27, 0x61, 0xdc, 0x44,
-	0x33, 0x4c, 0x2d, 0x93, 0xe0, 0x74, 0xa7, 0xa4, 0xf9, 0x68, 0x0d, 0x25, 0xd2, 0xac, 0x29, 0xe9,
-	0x69, 0xd6, 0x44, 0x78, 0x1b, 0xa1, 0x76, 0x54, 0x1e, 0x40, 0x4b, 0x20, 0xb7, 0x76, 0x31, 0xb4,
-	0x3e, 0x52, 0x38, 0xac, 0x17, 0x87, 0x83, 0xf2, 0x42, 0x3c, 0x5c, 0xb3, 0xab, 0x98, 0xe1, 0x61,
-	0x68, 0x87, 0xbb, 0x23, 0x34, 0x4f, 0x94, 0x30, 0xe8, 0xdb, 0xa6, 0x5c, 0xf2, 0x42, 0x4c, 0x0f,
-	0x43, 0x04, 0xe3, 0x77, 0x51, 0x2e, 0x88, 0x8f, 0x7b, 0xc5, 0x3c, 0x98, 0x2c, 0x1e, 0x77, 0x12,
-	0x14, 0x65, 0x95, 0xa2, 0xa0, 0x99, 0x55, 0x2d, 0xe1, 0xf7, 0xd0, 0x74, 0xd8, 0x2e, 0x75, 0xdc,
-	0x1d, 0x0a, 0x4d, 0x08, 0xc5, 0xf2, 0x68, 0xa7, 0x54, 0x58, 0x76, 0x62, 0x54, 0xb7, 0xac, 0x08,
-	0x70, 0x1b, 0xcd, 0x32, 0xed, 0x28, 0x01, 0x7d, 0x89, 0xdc, 0xda, 0xe5, 0x31, 0xa9, 0x8b, 0x02,
-	0x7c, 0x65, 0x38, 0x28, 0x17, 0x75, 0x35, 0x6d, 0x86, 0x11, 0x93, 0x3c, 0xd0, 0xfd, 0xf0, 0xd3,
-	0x77, 0x71, 0x51, 0x0f, 0xb4, 0xfe, 0x4d, 0x5c, 0x2e, 0xf1, 0x21, 0xa6, 0x07, 0x3a, 0x82, 0x39,
-	0x1d, 0xe2, 0x4f, 0xa9, 0xc5, 0x25, 0x9d, 0x0e, 0x23, 0xbd, 0x0b, 0x41, 0x87, 0x78, 0xb8, 0x4e,
-	0x87, 0x18, 0x5f, 0x37, 0x51, 0x1a, 0x6e, 0x2e, 0x7b, 0xf5, 0xa4, 0x69, 0x16, 0xb2, 0xf5, 0xa4,
-	0x39, 0x5b, 0xc8, 0xd7, 0x93, 0x66, 0xa1, 0x30, 0x57, 0x4f, 0x9a, 0xf3, 0x85, 0x85, 0x7a, 0xd2,
-	0x5c, 0x28, 0x2c, 0x56, 0xbe, 0x9f, 0x40, 0xf9, 0x91, 0x0e, 0x17, 0x7e, 0x11, 0x25, 0x61, 0xc7,
-	0x31, 0xe2, 0x2b, 0x14, 0xae, 0xbe, 0xdd, 0x80, 0x1c, 0xaf, 0x21, 0x33, 0xec, 0x34, 0xca, 0xb6,
-	0x0c, 0x6c, 0xfd, 0x21, 0xa6, 0x6e, 0xfd, 0x21, 0x86, 0x6b, 0x28, 0xd3, 0x13, 0x5b, 0x85, 0xdc,
-	0xfc, 0x61, 0x95, 0x90, 0x90, 0xba, 0xa1, 0x49, 0x48, 0xd9, 0x8f, 0x92, 0xa7, 0xf8, 0x9c, 0x1d,
-	0x35, 0xda, 0x52, 0xcf, 0xd2, 0x68, 0xab, 0x7c, 0x88, 0x30, 0x84, 0x77, 0xdb, 0x67, 0xc4, 0xea,
-	0x85, 0x7b, 0xd9, 0x0a, 0x4a, 0x44, 0x45, 0x50, 0x61, 0x38, 0x28, 0x4f, 0x3b, 0xea, 0x0e, 0x9f,
-	0x70, 0x6c, 0xbc, 0x1e, 0x3f, 0x8d, 0xd8, 0x9d, 0xe6, 0x60, 0x42, 0x75, 0x47, 0x3c, 0xe9, 0x01,
-	0x2b, 0x3f, 0x4d, 0xa0, 0x99, 0x3a, 0x54, 0x46, 0x0d, 0x51, 0xc7, 0x9d, 0x62, 0xde, 0x97, 0x50,
-	0xea, 0xa1, 0xe5, 0xb7, 0x77, 0x61, 0x56, 0x53, 0x3c, 0x1a, 0x00, 0xea, 0xa3, 0x01, 0x80, 0x37,
-	0x50, 0x7e, 0x87, 0xd1, 0x5e, 0x53, 0x4e, 0xc7, 0xab, 0x17, 0x11, 0x78, 0x58, 0xb3, 0xb8, 0x48,
-	0x3a, 0xaa, 0x95, 0x2f, 0x33, 0x9a, 0x20, 0x2e, 0xd8, 0x92, 0x27, 0x16, 0x6c, 0x6f, 0xa0, 0x59,
-	0xc2, 0x18, 0x65, 0x5b, 0x3b, 0x77, 0x1c, 0xcf, 0xe3, 0x8c, 0x4e, 0x81, 0x8f, 0xf0, 0x9a, 0xe9,
-	0x12, 0x45, 0x79, 0x44, 0xa7, 0xf2, 0x4b, 0x03, 0x4d, 0xbf, 0xcb, 0xfd, 0x0f, 0x63, 0x12, 0x79,
-	0x60, 0x9c, 0xe8, 0xc1, 0xd9, 0x6a, 0xd2, 0xeb, 0x28, 0x03, 0x71, 0x8a, 0xe2, 0x23, 0xf6, 0x1d,
-	0x46, 0x7b, 0x9a, 0x42, 0x5a, 0x20, 0xd7, 0xde, 0x46, 0x29, 0xa0, 0x15, 0xce, 0xa2, 0xd4, 0x26,
-	0xf7, 0xbd, 0x70, 0x01, 0xe7, 0x50, 0x66, 0xf3, 0xc0, 0x69, 0xfb, 0xc4, 0x2e, 0x18, 0x38, 0x83,
-	0xa6, 0xee, 0xde, 0xbd, 0x53, 0x48, 0xe0, 0x05, 0x54, 0x78, 0x83, 0x58, 0x76, 0xd7, 0x71, 0xc9,
-	0xe6, 0x23, 0xb1, 0xc5, 0x14, 0xa6, 0xf0, 0x34, 0x32, 0x1b, 0x64, 0x8f, 0xc0, 0xe0, 0xe4, 0xda,
-	0xa7, 0x06, 0x4a, 0x89, 0xe2, 0x9b, 0xa0, 0xfc, 0x5b, 0xc4, 0x17, 0x7c, 0x00, 0xc4, 0xc3, 0x38,
-	0xda, 0xcd, 0x22, 0x8a, 0x94, 0x2e, 0xc6, 0x3c, 0xd3, 0x38, 0x5b, 0xb9, 0xfa, 0xbd, 0x4f, 0xfe,
-	0xf9, 0xb3, 0xc4, 0x0b, 0x95, 0x62, 0xed, 0xe0, 0xff, 0x6b, 0x7b, 0xb4, 0x75, 0xdd, 0x23, 0x7e,
-	0xed, 0x31, 0x04, 0xe6, 0xa3, 0xda, 0x63, 0xc7, 0xfe, 0xe8, 0xa6, 0x71, 0xed, 0x15, 0x03, 0xdf,
-	0x44, 0x29, 0x08, 0x2f, 0x16, 0x84, 0x55, 0x43, 0x7d, 0xbc, 0xed, 0xa9, 0x1f, 0x24, 0x0c, 0xd0,
-	0x4d, 0xdf, 0x86, 0xdf, 0x30, 0xe0, 0xa5, 0x23, 0xb5, 0xf8, 0x26, 0x0f, 0x52, 0x49, 0xac, 0xe5,
-	0x62, 0xd0, 0xc6, 0x2e, 0x69, 0xef, 0x37, 0x88, 0xd7, 0xa7, 0xae, 0x47, 0xd6, 0xdf, 0xfb, 0xf3,
-	0x93, 0x65, 0xe3, 0xe3, 0x27, 0xcb, 0xc6, 0xdf, 0x9f, 0x2c, 0x1b, 0x3f, 0x7e, 0xba, 0x7c, 0xe1,
-	0xe3, 0xa7, 0xcb, 0x17, 0xfe, 0xf6, 0x74, 0xf9, 0xc2, 0x77, 0xfe, 0xaf, 0xe3, 0xf8, 0xbb, 0x41,
-	0xab, 0xda, 0xa6, 0xbd, 0x9a, 0xc5, 0x7a, 0x96, 0x6d, 0xf5, 0x19, 0xe5, 0x01, 0x92, 0x7f, 0x85,
-	0x3f, 0x6d, 0xf8, 0x4d, 0x62, 0xe1, 0x16, 0x00, 0xf7, 0x84, 0xb8, 0xba, 0x45, 0xab, 0xb7, 0xfa,
-	0x4e, 0x2b, 0x0d, 0x3e, 0xdc, 0xf8, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x02, 0x31, 0x18, 0x15,
-	0xb9, 0x31, 0x00, 0x00,
+	// 2549 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0xcb, 0x6f, 0x1b, 0xd7,
+	0xd5, 0xf7, 0x50, 0x7c, 0x0c, 0x0f, 0xf5, 0x20, 0xaf, 0x1e, 0x1e, 0xd3, 0xb6, 0x46, 0x1f, 0x0d,
+	0x7c, 0x55, 0x8c, 0x98, 0x4c, 0xe5, 0xa4, 0x08, 0x8c, 0x02, 0x81, 0xa5, 0x28, 0xb1, 0x98, 0xb8,
+	0xb6, 0x25, 0xa7, 0x49, 0x8b, 0x00, 0xec, 0x90, 0x73, 0x45, 0x8d, 0x44, 0xce, 0x65, 0xe6, 0x21,
+	0x5b, 0x31, 0xb2, 0x69, 0x37, 0x5d, 0x14, 0x45, 0x1f, 0xab, 0xa2, 0x45, 0x5b, 0x74, 0x59, 0x74,
+	0xd5, 0x4d, 0xb7, 0xdd, 0xb4, 0x28, 0xba, 0x0a, 0x9a, 0x4d, 0x57, 0x44, 0x6b, 0x17, 0x29, 0x40,
+	0x74, 0xd1, 0x3f, 0xa1, 0xb8, 0x8f, 0xe1, 0xdc, 0x3b, 0xa2, 0x20, 0x59, 0xb1, 0x03, 0xc3, 0xe6,
+	0xca, 0xd6, 0xef, 0xdc, 0x73, 0xee, 0x99, 0x73, 0x7e, 0xf7, 0xde, 0x73, 0x1f, 0x84, 0xd9, 0xde,
+	0x5e, 0xbb, 0x66, 0xf5, 0x9c, 0x1a, 0xde, 0xc7, 0x6e, 0x50, 0xed, 0x79, 0x24, 0x20, 0x68, 0xc2,
+	0xea, 0x39, 0x65, 0xb3, 0x4d, 0x48, 0xbb, 0x83, 0x6b, 0x0c, 0x6a, 0x86, 0xdb, 0xb5, 0xc0, 0xe9,
+	0x62, 0x3f, 0xb0, 0xba, 0x3d, 0xde, 0xaa, 0x3c, 0x17, 0xa9, 0xfa, 0x61, 0xb3, 0xeb, 0x04, 0x49,
+	0x74, 0x07, 0x5b, 0x9d, 0x60, 0x47, 0xa0, 0xe7, 0x93, 0xc6, 0x70, 0xb7, 0x17, 0x1c, 0x08, 0xe1,
+	0x05, 0x21, 0xa4, 0x5a, 0x96, 0xeb, 0x92, 0xc0, 0x0a, 0x1c, 0xe2, 0xfa, 0x42, 0xfa, 0xea, 0xde,
+	0xeb, 0x7e, 0xd5, 0x21, 0x54, 0xda, 0xb5, 0x5a, 0x3b, 0x8e, 0x8b, 0xbd, 0x83, 0x5a, 0xd4, 0x89,
+	0x87, 0x7d, 0x12, 0x7a, 0x2d, 0x5c, 0x6b, 0x63, 0x17, 0x7b, 0x56, 0x80, 0x6d, 0xae, 0x55, 0xf9,
+	0x45, 0x0a, 0x4a, 0x75, 0xd2, 0xdc, 0x62, 0xae, 0x05, 0xd8, 0x5e, 0xa7, 0x9f, 0x87, 0x2e, 0x43,
+	0x76, 0x97, 0x34, 0x1b, 0x8e, 0x6d, 0x68, 0x4b, 0xda, 0x72, 0x7e, 0x75, 0x76, 0xd0, 0x37, 0x67,
+	0x76, 0x49, 0x73, 0xc3, 0x7e, 0x99, 0x74, 0x9d, 0x80, 0x39, 0xb5, 0x99, 0x61, 0x00, 0x7a, 0x15,
+	0x80, 0xb6, 0xf5, 0x71, 0x40, 0xdb, 0xa7, 0x58, 0xfb, 0x85, 0x41, 0xdf, 0x44, 0xbb, 0xa4, 0xb9,
+	0x85, 0x03, 0x45, 0x45, 0x8f, 0x30, 0xf4, 0x12, 0x64, 0x3e, 0x0a, 0x71, 0x88, 0x8d, 0x89, 0xb8,
+	0x03, 0x06, 0xc8, 0x1d, 0x30, 0x00, 0xbd, 0x03, 0xb9, 0x96, 0x87, 0xa9, 0xcf, 0x46, 0x7a, 0x49,
+	0x5b, 0x2e, 0xac, 0x94, 0xab, 0x3c, 0x10, 0xd5, 0x28, 0x4a, 0xd5, 0xbb, 0x51, 0xc8, 0x57, 0xe7,
+	0x07, 0x7d, 0xb3, 0x24, 0x9a, 0x4b, 0xa6, 0x22, 0x0b, 0xe8, 0x0a, 0x4c, 0xec, 0x92, 0xa6, 0x91,
+	0x61, 0x86, 0xf4, 0xaa, 0xd5, 0x73, 0xaa, 0x75, 0xd2, 0x5c, 0x2d, 0x0d, 0xfa, 0xe6, 0xd4, 0x2e,
+	0x69, 0x4a, 0x2a, 0xb4, 0x5d, 0x65, 0xa0, 0xc1, 0x74, 0x9d, 0x34, 0xef, 0x50, 0x47, 0x9e, 0xf7,
+	0xd8, 0x54, 0xfe, 0x9a, 0x62, 0x1f, 0xfb, 0x2e, 0xb6, 0xfc, 0xe7, 0x9f, 0x08, 0x5f, 0x03, 0x68,
+	0x75, 0x42, 0x3f, 0xc0, 0x1e, 0xf5, 0x36, 0xc3, 0x3a, 0x3f, 0x3b, 0xe8, 0x9b, 0xb3, 0x02, 0x55,
+	0xdc, 0xcd, 0x0f, 0x41, 0xf4, 0xff, 0x90, 0xee, 0x11, 0xd2, 0x31, 0xb2, 0x4c, 0x03, 0x0d, 0xfa,
+	0xe6, 0x34, 0xfd, 0x5b, 0x6a, 0xcc, 0xe4, 0x95, 0x1f, 0xa7, 0x61, 0x3e, 0x0a, 0xe6, 0x26, 0x0e,
+	0x42, 0xcf, 0x1d, 0xc7, 0xf4, 0xa8, 0x98, 0xbe, 0x0c, 0x59, 0x0f, 0x5b, 0x3e, 0x71, 0x45, 0x54,
+	0xe7, 0x06, 0x7d, 0xb3, 0xc8, 0x11, 0x49, 0x41, 0xb4, 0x41, 0x6f, 0xc0, 0xd4, 0x5e, 0xd8, 0xc4,
+	0x9e, 0x8b, 0x03, 0xec, 0xd3, 0x8e, 0x72, 0x4c, 0xa9, 0x3c, 0xe8, 0x9b, 0x0b, 0xb1, 0x40, 0xe9,
+	0x6b, 0x52, 0xc6, 0xa9, 0x9b, 0x3d, 0x62, 0x37, 0xdc, 0xb0, 0xdb, 0xc4, 0x9e, 0xa1, 0x2f, 0x69,
+	0xcb, 0x19, 0xee, 0x66, 0x8f, 0xd8, 0xdf, 0x60, 0xa0, 0xec, 0xe6, 0x10, 0xa4, 0x1d, 0x7b, 0xa1,
+	0xdb, 0xb0, 0x02, 0x26, 0xc2, 0xb6, 0x91, 0x5f, 0xd2, 0x96, 0x75, 0xde, 0xb1, 0x17, 0xba, 0xd7,
+	0x23, 0x5c, 0xee, 0x58, 0xc6, 0x2b, 0xff, 0xd5, 0x60, 0x2e, 0xe2, 0xc4, 0xfa, 0xfd, 0x9e, 0xe3,
+	0x3d, 0xff, 0x73, 0xca, 0x1f, 0xd2, 0x30, 0x53, 0x27, 0xcd, 0xdb, 0xd8, 0xb5, 0x1d, 0xb7, 0x3d,
+	0x1e, 0x00, 0xa3, 0x07, 0xc0, 0x21, 0x4a, 0x67, 0xbf, 0x10, 0xa5, 0x73, 0x27, 0xa6, 0xf4, 0x2b,
+	0xa0, 0x33, 0x3d, 0xab, 0x8b, 0xd9, 0x40, 0xc8, 0xf3, 0x4f, 0xa4, 0x0d, 0xac, 0xae, 0x1c, 0xad,
+	0x9c, 0x80, 0xa8, 0xab, 0x91, 0x86, 0xdf, 0xb3, 0x5a, 0xd8, 0xc8, 0xc7, 0xae, 0x8a, 0x36, 0x0c,
+	0x97, 0x5d, 0x95, 0xf1, 0xe1, 0x04, 0x0a, 0xc7, 0x4c, 0xa0, 0xff, 0xe1, 0xcc, 0xd9, 0x0c, 0x5d,
+	0x77, 0xcc, 0x9c, 0xa7, 0xc7, 0x9c, 0xab, 0x90, 0x77, 0x89, 0x8d, 0x39, 0x05, 0x72, 0x71, 0x94,
+	0x28, 0x98, 0xe0, 0x80, 0x1e, 0x61, 0xa7, 0x9e, 0x41, 0x65, 0xba, 0xe5, 0x4f, 0x47, 0x37, 0x38,
+	0x25, 0xdd, 0x0a, 0xc7, 0xd0, 0xed, 0xf7, 0x59, 0x98, 0xad, 0x93, 0xe6, 0x86, 0xdb, 0xf6, 0xb0,
+	0xef, 0x6f, 0xb8, 0xdb, 0x64, 0x4c, 0xb9, 0xe7, 0x8d, 0x72, 0x70, 0x3a, 0xca, 0x15, 0x1e, 0x93,
+	0x72, 0x0f, 0xa0, 0xe4, 0x70, 0x1a, 0x35, 0x2c, 0xdb, 0xa6, 0xff, 0x62, 0xdf, 0xc8, 0x2f, 0x4d,
+	0x2c, 0x17, 0x56, 0xaa, 0xd1, 0x8e, 0x23, 0xc9, 0xb3, 0xaa, 0x00, 0xae, 0x47, 0x0a, 0xeb, 0x6e,
+	0xe0, 0x1d, 0xac, 0x2e, 0x0e, 0xfa, 0x66, 0xd9, 0x49, 0x88, 0xa4, 0x8e, 0x8b, 0x49, 0x59, 0x79,
+	0x0f, 0xe6, 0x47, 0x9a, 0x42, 0x97, 0x60, 0x62, 0x0f, 0x1f, 0x30, 0x16, 0x67, 0xf8, 0x7e, 0x67,
+	0x0f, 0x1f, 0xc8, 0xfb, 0x9d, 0x3d, 0x7c, 0x40, 0xb9, 0xb8, 0x6f, 0x75, 0x42, 0x6c, 0xa4, 0x62,
+	0x2e, 0x32, 0x40, 0xe6, 0x22, 0x03, 0xae, 0xa5, 0x5e, 0xd7, 0x2a, 0xbf, 0xcb, 0xb3, 0x1d, 0xc3,
+	0x5b, 0x96, 0xd3, 0x19, 0x57, 0xb7, 0x4f, 0xa6, 0xba, 0xfd, 0x10, 0x00, 0xdf, 0x77, 0x82, 0x46,
+	0x8b, 0xd8, 0xd8, 0x37, 0x72, 0x8c, 0x35, 0x95, 0x88, 0x35, 0x52, 0xa0, 0xab, 0xeb, 0xf7, 0x9d,
+	0x60, 0x8d, 0xd8, 0x22, 0xbd, 0xab, 0xe7, 0xa8, 0x27, 0x38, 0xc2, 0x62, 0xc3, 0x86, 0xb6, 0x99,
+	0x1f, 0xc2, 0x87, 0xc7, 0xae, 0xfe, 0x45, 0xc6, 0x6e, 0xfe, 0x54, 0x63, 0x17, 0x4e, 0x35, 0x76,
+	0xa7, 0x4e, 0x37, 0x76, 0xa7, 0x1f, 0x73, 0xec, 0xda, 0x80, 0x5a, 0xc4, 0x0d, 0x2c, 0x7a, 0x7c,
+	0xd2, 0xf0, 0x03, 0x2b, 0x08, 0xe9, 0xe0, 0x2d, 0xb0, 0x34, 0xcc, 0xb1, 0x34, 0xac, 0x45, 0xe2,
+	0x2d, 0x26, 0x5d, 0x35, 0x07, 0x7d, 0xf3, 0x7c, 0x4b, 0x05, 0x95, 0x31, 0x5a, 0x3a, 0x24, 0x44,
+	0xaf, 0x41, 0xa6, 0x65, 0x85, 0x3e, 0x36, 0x26, 0x97, 0xb4, 0xe5, 0xe9, 0x15, 0xe0, 0x86, 0x29,
+	0xc2, 0xe9, 0xcc, 0x84, 0x32, 0x9d, 0x19, 0x80, 0x6e, 0x40, 0x71, 0xdb, 0x72, 0x3a, 0xa1, 0x87,
+	0x1b, 0x2d, 0x2b, 0xc0, 0x6d, 0xe2, 0x1d, 0x18, 0x45, 0xf6, 0x81, 0x17, 0x07, 0x7d, 0xf3, 0x9c,
+	0x90, 0xad, 0x09, 0x91, 0xa4, 0x3f, 0x93, 0x10, 0xa1, 0x3b, 0x30, 0x1b, 0x59, 0xf2, 0xc3, 0xe6,
+	0xd0, 0x58, 0x89, 0x19, 0x5b, 0x1a, 0xf4, 0xcd, 0x0b, 0x42, 0xbc, 0x15, 0x4b, 0x25, 0x7b, 0xe8,
+	0xb0, 0x14, 0xbd, 0x06, 0x79, 0x0f, 0x07, 0xde, 0x81, 0xd5, 0xec, 0x60, 0x03, 0xb1, 0x9d, 0x11,
+	0xcb, 0xf1, 0x10, 0x94, 0x73, 0x3c, 0x04, 0xcb, 0x36, 0x4c, 0xab, 0x4c, 0x96, 0x27, 0xaa, 0xfc,
+	0xc9, 0x26, 0xaa, 0xcc, 0x71, 0x13, 0x55, 0x3d, 0xad, 0xcf, 0x14, 0x8b, 0x95, 0xcf, 0x52, 0x80,
+	0xe8, 0x66, 0xc4, 0xc3, 0xb4, 0xc1, 0x0b, 0x50, 0x55, 0xb2, 0x9c, 0x7c, 0x14, 0x62, 0x3f, 0x20,
+	0x9e, 0x3c, 0x63, 0x0d, 0x41, 0x35, 0x27, 0x02, 0x7c, 0xbc, 0x19, 0xab, 0xf2, 0xf3, 0x34, 0x94,
+	0xe2, 0xa8, 0x8e, 0xd7, 0x81, 0xa3, 0xd6, 0x81, 0xcb, 0x90, 0xa5, 0xc7, 0x07, 0xc3, 0x82, 0x89,
+	0x39, 0xec, 0x85, 0xae, 0x1a, 0x11, 0x06, 0xa0, 0x0d, 0x28, 0xf5, 0x04, 0x4b, 0xf7, 0x71, 0x43,
+	0xa8, 0xe9, 0xf1, 0x50, 0x8f, 0x85, 0x9b, 0x09, 0x03, 0x33, 0x09, 0x91, 0x94, 0xcc, 0xfc, 0x09,
+	0x96, 0x1f, 0xa9, 0x63, 0xb7, 0xdd, 0x10, 0x19, 0x84, 0xc3, 0x1d, 0xbb, 0xed, 0x3a, 0x69, 0x8e,
+	0xee, 0x58, 0x88, 0xea, 0x69, 0x3d, 0x57, 0xd4, 0x2b, 0x7f, 0x4e, 0x8b, 0x03, 0xe6, 0x56, 0x0b,
+	0x63, 0x7b, 0xcc, 0x8e, 0xf1, 0x46, 0xee, 0x74, 0x1b, 0xb9, 0xca, 0x3f, 0x0b, 0x6c, 0x83, 0xf6,
+	0x5e, 0xe0, 0x74, 0x1c, 0x9f, 0xdd, 0x7c, 0x8c, 0xa9, 0xf4, 0x94, 0xa8, 0xf4, 0x43, 0x0d, 0xe6,
+	0x6f, 0x5a, 0xf7, 0x37, 0xc5, 0xa5, 0x91, 0xff, 0x16, 0xf1, 0x6e, 0x63, 0xcf, 0x21, 0xb6, 0xa8,
+	0x47, 0xaf, 0x46, 0xf5, 0x68, 0x32, 0x19, 0xd5, 0x91, 0x5a, 0xbc, 0x40, 0xbd, 0x34, 0xe8, 0x9b,
+	0xe6, 0x48, 0xb9, 0xe4, 0xc7, 0xe8, 0x6e, 0x55, 0x6e, 0xeb, 0xa7, 0xe2, 0x76, 0xfe, 0x59, 0xde,
+	0x31, 0xfe, 0x40, 0x83, 0x85, 0x80, 0x04, 0x56, 0xa7, 0xd1, 0x0a, 0xbb, 0x61, 0xc7, 0x62, 0xb3,
+	0x7e, 0xe8, 0x5b, 0x6d, 0x5a, 0x21, 0xd2, 0x88, 0xaf, 0x1c, 0x19, 0xf1, 0xbb, 0x54, 0x6d, 0x6d,
+	0xa8, 0xf5, 0x1e, 0x55, 0xe2, 0x01, 0xaf, 0x0c, 0xfa, 0xe6, 0x62, 0x30, 0x42, 0x2c, 0xb9, 0x31,
+	0x37, 0x4a, 0xce, 0xf2, 0x7f, 0x7d, 0xbf, 0x3d, 0x22, 0xff, 0x53, 0xc7, 0xe4, 0x7f, 0xa4, 0x96,
+	0x94, 0xff, 0x91, 0x72, 0x39, 0xff, 0x23, 0x1b, 0x94, 0x7f, 0xad, 0x41, 0xf9, 0x68, 0x6a, 0x9d,
+	0xac, 0x62, 0xfc, 0x96, 0x5c, 0x31, 0xd2, 0x9d, 0x38, 0xbf, 0x2f, 0xad, 0xca, 0xf7, 0xa5, 0xd5,
+	0xde, 0x5e, 0x9b, 0x7d, 0x5b, 0x74, 0x5f, 0x5a, 0xbd, 0x13, 0x5a, 0x6e, 0xe0, 0x04, 0x07, 0xc7,
+	0x55, 0x98, 0xe5, 0x5f, 0x69, 0x70, 0xee, 0xc8, 0x5c, 0x3c, 0x13, 0x1e, 0xd2, 0x20, 0x1e, 0x9d,
+	0x9f, 0x67, 0xc1, 0xc5, 0xca, 0xbf, 0x53, 0xb0, 0x40, 0xcf, 0x7c, 0x71, 0xcf, 0x73, 0x88, 0xe7,
+	0x04, 0xce, 0xc7, 0x2f, 0x40, 0x91, 0xfe, 0x75, 0x98, 0x74, 0xf1, 0xbd, 0x86, 0xf8, 0xe4, 0x03,
+	0x36, 0xd1, 0x6b, 0x6c, 0x3f, 0x3f, 0xef, 0xe2, 0x7b, 0xb7, 0x05, 0x2c, 0x69, 0x16, 0x24, 0x58,
+	0x2d, 0xf1, 0xb3, 0x27, 0x2d, 0xf1, 0x2b, 0x9f, 0xa7, 0x60, 0x5e, 0x8d, 0x34, 0xb6, 0xc7, 0x81,
+	0x7e, 0x0a, 0x81, 0xfe, 0xd3, 0x04, 0x4c, 0xd5, 0x49, 0xf3, 0x26, 0xd9, 0x7f, 0xfe, 0x03, 0xfc,
+	0x0e, 0x94, 0x6c, 0xec, 0x07, 0x8e, 0xcb, 0x56, 0x84, 0x06, 0xf7, 0x81, 0xd7, 0x2d, 0xec, 0x20,
+	0x53, 0x12, 0xde, 0x49, 0xb8, 0x53, 0x4c, 0xca, 0xd0, 0x37, 0x61, 0x41, 0x36, 0x26, 0x85, 0x81,
+	0x07, 0xff, 0xff, 0x06, 0x7d, 0xf3, 0xa2, 0xd4, 0xa2, 0x7e, 0x38, 0x22, 0xb3, 0x23, 0xc4, 0x6a,
+	0x1e, 0x73, 0x27, 0xce, 0xe3, 0xdf, 0xa2, 0x87, 0x32, 0x7e, 0x0f, 0xbb, 0x2f, 0xc0, 0x3e, 0xe6,
+	0x4b, 0x39, 0x3a, 0xf8, 0x4d, 0x8a, 0xdf, 0xf1, 0x61, 0x3f, 0xec, 0x8e, 0x43, 0x7a, 0x04, 0xf3,
+	0xc4, 0xa9, 0xd5, 0x9a, 0xe5, 0xb6, 0x70, 0xa7, 0x33, 0x3e, 0xb5, 0x7a, 0x32, 0xd4, 0x13, 0xe3,
+	0x59, 0x44, 0x75, 0x4c, 0xbe, 0x27, 0x12, 0xd4, 0x3f, 0xa6, 0x19, 0x55, 0xef, 0x62, 0xaf, 0x4b,
+	0xa7, 0xdd, 0xf1, 0x69, 0xcf, 0x33, 0xfd, 0xe0, 0xe3, 0x4b, 0xba, 0x81, 0x8f, 0x29, 0xa4, 0x9f,
+	0x80, 0x42, 0x3f, 0x9b, 0x82, 0x49, 0xc6, 0x9a, 0x9b, 0xd8, 0x67, 0x9b, 0xd1, 0x5b, 0x90, 0xf7,
+	0xa3, 0xd7, 0xa9, 0x8c, 0x3f, 0x85, 0x95, 0x85, 0x68, 0xff, 0xa9, 0x3e, 0x5b, 0xe5, 0x01, 0x18,
+	0x36, 0x8e, 0x8d, 0xdf, 0x38, 0xb3, 0x19, 0xdb, 0x40, 0x6b, 0x90, 0x65, 0x4c, 0xb0, 0xc5, 0x26,
+	0x66, 0x36, 0xb2, 0x26, 0xbd, 0xf2, 0xe4, 0x4e, 0xf2, 0x66, 0x8a, 0x1d, 0xa1, 0x4a, 0x8d, 0x74,
+	0xd8, 0x3b, 0x49, 0x63, 0x42, 0x35, 0x22, 0xbd, 0x9e, 0xe4, 0x46, 0x78, 0x33, 0xd5, 0x08, 0xc7,
+	0xd0, 0x77, 0x60, 0x9a, 0xfd, 0xaf, 0xe1, 0x89, 0x07, 0x82, 0x43, 0x46, 0xca, 0xc6, 0x94, 0xd7,
+	0x83, 0xab, 0xe7, 0x07, 0x7d, 0xf3, 0x6c, 0x47, 0xc6, 0x15, 0xd3, 0x53, 0x8a, 0x08, 0x7d, 0x08,
+	0x1c, 0x68, 0x60, 0xfe, 0xdc, 0x4c, 0x3c, 0x7c, 0x3d, 0xa7, 0x74, 0x20, 0x3f, 0x45, 0xe3, 0x79,
+	0xed, 0x48, 0xb0, 0x62, 0x7e, 0x52, 0x96, 0xa0, 0xb7, 0x21, 0xd7, 0xe3, 0x0f, 0xbb, 0x18, 0x7f,
+	0xa3, 0x1b, 0xb2, 0xc4, 0x7b, 0x2f, 0xc1, 0x30, 0x8e, 0x28, 0xd6, 0x22, 0x6d, 0x6a, 0xc8, 0xe3,
+	0xef, 0x7c, 0x8c, 0x9c, 0x6a, 0x48, 0x7e, 0xfe, 0xc3, 0x0d, 0x89, 0x86, 0xaa, 0x21, 0x01, 0xd2,
+	0xb4, 0x6c, 0xb3, 0x3b, 0x52, 0x23, 0xaf, 0xa6, 0x45, 0xba, 0x39, 0xe5, 0x69, 0xe1, 0xcd, 0xd4,
+	0xb4, 0x70, 0x8c, 0x33, 0x4e, 0x1c, 0x57, 0x1b, 0x90, 0x64, 0x9c, 0x7c, 0x8e, 0x1d, 0x31, 0x4e,
+	0x60, 0x49, 0xc6, 0x09, 0x18, 0x35, 0x60, 0xca, 0x93, 0x37, 0x5a, 0x46, 0x41, 0x4d, 0xf3, 0xe1,
+	0x5d, 0x18, 0x4f, 0xb3, 0xa2, 0xa4, 0xa6, 0x59, 0x11, 0xa1, 0x2d, 0x80, 0xd6, 0xb0, 0x3c, 0x60,
+	0x97, 0x8a, 0x85, 0x95, 0xb3, 0x91, 0xf5, 0x44, 0xe1, 0xb0, 0x6a, 0x0c, 0xfa, 0xe6, 0x5c, 0xdc,
+	0x5c, 0xb1, 0x2b, 0x99, 0xa1, 0x61, 0x68, 0x45, 0xab, 0xa3, 0x31, 0xa5, 0x86, 0x41, 0x5d, 0x36,
+	0xc5, 0x94, 0x17, 0x61, 0x6a, 0x18, 0x86, 0x30, 0x7a, 0x1f, 0x0a, 0x61, 0x7c, 0x60, 0x64, 0xcc,
+	0x30, 0x93, 0xc6, 0x51, 0x67, 0x49, 0x7c, 0x63, 0x26, 0x29, 0x28, 0x66, 0x65, 0x4b, 0xe8, 0x03,
+	0x98, 0x8c, 0x1e, 0x5c, 0x38, 0xee, 0x36, 0x31, 0x4a, 0xaa, 0xe5, 0xe4, 0x5b, 0x0b, 0x6e, 0xd9,
+	0x89, 0x51, 0xd5, 0xb2, 0x24, 0x40, 0x2d, 0x98, 0xf6, 0x94, 0xc3, 0x08, 0x76, 0xb3, 0x59, 0x58,
+	0x39, 0x3f, 0x22, 0x75, 0xc3, 0x00, 0x5f, 0x18, 0xf4, 0x4d, 0x43, 0x55, 0x53, 0x7a, 0x48, 0x98,
+	0xa4, 0x81, 0xee, 0x45, 0x97, 0x67, 0xc6, 0xbc, 0x1a, 0x68, 0xf5, 0x56, 0x4d, 0x4c, 0xf1, 0x11,
+	0xa6, 0x06, 0x7a, 0x08, 0x53, 0x3a, 0xc4, 0x97, 0x31, 0xc6, 0x82, 0x4a, 0x87, 0xc4, 0xed, 0x27,
+	0xa7, 0x43, 0xdc, 0x5c, 0xa5, 0x43, 0x8c, 0xa3, 0x37, 0x20, 0xd3, 0xa5, 0x9b, 0x58, 0xe3, 0x2c,
+	0xb3, 0x87, 0x22, 0x7b, 0xf1, 0xce, 0x96, 0x2f, 0xbb, 0xac, 0x91, 0x62, 0x85, 0xeb, 0xf1, 0x61,
+	0x25, 0x76, 0x4f, 0x86, 0x91, 0x1c, 0x56, 0xf2, 0xb6, 0x2a, 0x1a, 0x56, 0x02, 0x4b, 0x0e, 0x2b,
+	0x01, 0xb3, 0x59, 0x83, 0xef, 0x1c, 0x8c, 0x73, 0x89, 0x59, 0x43, 0xda, 0x50, 0x88, 0x59, 0x83,
+	0x23, 0x89, 0x59, 0x83, 0x83, 0xab, 0x3a, 0x64, 0xd9, 0xcf, 0x3a, 0xfc, 0x7a, 0x5a, 0xd7, 0x8b,
+	0xf9, 0x7a, 0x5a, 0x9f, 0x2e, 0xce, 0xd4, 0xd3, 0x7a, 0xb1, 0x58, 0xaa, 0xa7, 0xf5, 0xd9, 0xe2,
+	0x5c, 0x3d, 0xad, 0xcf, 0x15, 0xe7, 0x2b, 0xdf, 0x4b, 0xc1, 0x4c, 0xe2, 0xfa, 0x9f, 0xbe, 0x2f,
+	0x63, 0x8b, 0xa9, 0x16, 0xbf, 0x2f, 0x73, 0xd5, 0x95, 0x94, 0xc9, 0xd1, 0x0a, 0xe8, 0xd1, 0x33,
+	0x0c, 0x71, 0x67, 0xcd, 0xaa, 0x9a, 0x08, 0x93, 0xab, 0x9a, 0x08, 0x43, 0x35, 0xc8, 0x75, 0xf9,
+	0x2a, 0x28, 0xea, 0x1a, 0xf6, 0x29, 0x02, 0x92, 0xd7, 0x6a, 0x01, 0x49, 0x4b, 0x6d, 0xfa, 0x04,
+	0x77, 0x7d, 0xc3, 0x57, 0x08, 0x99, 0xc7, 0x79, 0x85, 0x50, 0xf9, 0x18, 0x10, 0x0b, 0xec, 0x56,
+	0xe0, 0x61, 0xab, 0x1b, 0x2d, 0xd3, 0x4b, 0x90, 0x1a, 0xd6, 0x77, 0xc5, 0x41, 0xdf, 0x9c, 0x74,
+	0xe4, 0xe2, 0x25, 0xe5, 0xd8, 0x68, 0x35, 0xfe, 0x1a, 0xbe, 0xf0, 0x96, 0x58, 0x87, 0xf2, 0x62,
+	0x7f, 0xdc, 0x07, 0x56, 0x7e, 0x92, 0x62, 0xa7, 0x29, 0x5b, 0x38, 0xd8, 0xe4, 0x25, 0xea, 0x09,
+	0xfa, 0x7d, 0x09, 0x32, 0xf7, 0xac, 0xa0, 0xb5, 0xc3, 0x7a, 0xd5, 0xf9, 0xa7, 0x31, 0x40, 0xfe,
+	0x34, 0x06, 0xa0, 0x35, 0x98, 0xd9, 0xf6, 0x48, 0xb7, 0x21, 0xba, 0xa3, 0x85, 0x19, 0x0f, 0x3c,
+	0x9b, 0x8e, 0xa9, 0x48, 0x38, 0xaa, 0x54, 0x66, 0x53, 0x8a, 0x20, 0xae, 0x45, 0xd3, 0xc7, 0xd6,
+	0xa2, 0x6f, 0xc2, 0x34, 0xf6, 0x3c, 0xe2, 0x6d, 0x6c, 0xdf, 0x74, 0x7c, 0x9f, 0x0e, 0xd6, 0x0c,
+	0xf3, 0x91, 0xcd, 0x20, 0xaa, 0x44, 0x52, 0x4e, 0xe8, 0x54, 0x7e, 0xa9, 0xc1, 0xe4, 0xfb, 0xd4,
+	0xff, 0x28, 0x26, 0x43, 0x0f, 0xb4, 0x63, 0x3d, 0x38, 0x5d, 0xb9, 0x7d, 0x05, 0x72, 0x2c, 0x4e,
+	0xc3, 0xf8, 0xf0, 0x25, 0xd5, 0x23, 0x5d, 0x45, 0x21, 0xcb, 0x91, 0xcb, 0xef, 0x42, 0x86, 0xd1,
+	0x0a, 0xe5, 0x21, 0xb3, 0x4e, 0x7d, 0x2f, 0x9e, 0x41, 0x05, 0xc8, 0xad, 0xef, 0x3b, 0xad, 0x00,
+	0xdb, 0x45, 0x0d, 0xe5, 0x60, 0xe2, 0xd6, 0xad, 0x9b, 0xc5, 0x14, 0x9a, 0x83, 0xe2, 0x9b, 0xd8,
+	0xb2, 0x3b, 0x8e, 0x8b, 0xd7, 0xef, 0xf3, 0xd5, 0xb3, 0x38, 0x81, 0x26, 0x41, 0xdf, 0xc4, 0xbb,
+	0x98, 0x35, 0x4e, 0xaf, 0x7c, 0xae, 0x41, 0x86, 0xef, 0x2b, 0x30, 0xcc, 0xbc, 0x8d, 0x03, 0xce,
+	0x07, 0x86, 0xf8, 0x68, 0x38, 0x2d, 0xc5, 0x14, 0x29, 0x9f, 0x8d, 0x79, 0xa6, 0x70, 0xb6, 0x72,
+	0xe9, 0xbb, 0x9f, 0xfd, 0xeb, 0xa7, 0xa9, 0x8b, 0x15, 0xa3, 0xb6, 0xff, 0xd5, 0xda, 0x2e, 0x69,
+	0x5e, 0xf1, 0x71, 0x50, 0x7b, 0xc0, 0x02, 0xf3, 0x49, 0xed, 0x81, 0x63, 0x7f, 0x72, 0x4d, 0xbb,
+	0xfc, 0x8a, 0x86, 0xae, 0x41, 0x86, 0x85, 0x17, 0x71, 0xc2, 0xca, 0xa1, 0x3e, 0xda, 0xf6, 0xc4,
+	0xf7, 0x53, 0x1a, 0xd3, 0xcd, 0xde, 0x60, 0x3f, 0xf0, 0x42, 0x0b, 0x87, 0xb6, 0x19, 0xeb, 0x34,
+	0x48, 0x65, 0xbe, 0x4c, 0xf1, 0x46, 0x6b, 0x3b, 0xb8, 0xb5, 0xb7, 0x89, 0xfd, 0x1e, 0x71, 0x7d,
+	0xbc, 0xfa, 0xc1, 0x5f, 0x1e, 0x2e, 0x6a, 0x9f, 0x3e, 0x5c, 0xd4, 0xfe, 0xf1, 0x70, 0x51, 0xfb,
+	0xd1, 0xa3, 0xc5, 0x33, 0x9f, 0x3e, 0x5a, 0x3c, 0xf3, 0xf7, 0x47, 0x8b, 0x67, 0xbe, 0xfd, 0x95,
+	0xb6, 0x13, 0xec, 0x84, 0xcd, 0x6a, 0x8b, 0x74, 0x6b, 0x96, 0xd7, 0xb5, 0x6c, 0xab, 0xe7, 0x11,
+	0x1a, 0x20, 0xf1, 0x57, 0xf4, 0xbb, 0xaf, 0xdf, 0xa6, 0xe6, 0xae, 0x33, 0xe0, 0x36, 0x17, 0x57,
+	0x37, 0x48, 0xf5, 0x7a, 0xcf, 0x69, 0x66, 0x99, 0x0f, 0x57, 0xff, 0x37, 0x00, 0xf8, 0x0c, 0x46,
+	0xc0, 0xd6, 0x36, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4246,7 +4551,7 @@ func (m *JobReprioritizedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *JobCancellingEvent) Marshal() (dAtA []byte, err error) {
+func (m *JobMovedEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4256,28 +4561,35 @@ func (m *JobCancellingEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobCancellingEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobMovedEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobCancellingEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobMovedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x32
-	}
 	if len(m.Requestor) > 0 {
 		i -= len(m.Requestor)
 		copy(dAtA[i:], m.Requestor)
 		i = encodeVarintEvent(dAtA, i, uint64(len(m.Requestor)))
 		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.DestinationJobSetId) > 0 {
+		i -= len(m.DestinationJobSetId)
+		copy(dAtA[i:], m.DestinationJobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.DestinationJobSetId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DestinationQueue) > 0 {
+		i -= len(m.DestinationQueue)
+		copy(dAtA[i:], m.DestinationQueue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.DestinationQueue)))
+		i--
 		dAtA[i] = 0x2a
 	}
 	if m.Created != nil {
@@ -4316,7 +4628,7 @@ func (m *JobCancellingEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *JobCancelledEvent) Marshal() (dAtA []byte, err error) {
+func (m *JobSuspendedEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4326,12 +4638,12 @@ func (m *JobCancelledEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobCancelledEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobSuspendedEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobCancelledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobSuspendedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4386,7 +4698,7 @@ func (m *JobCancelledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *JobTerminatedEvent) Marshal() (dAtA []byte, err error) {
+func (m *JobResumedEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4396,29 +4708,232 @@ func (m *JobTerminatedEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobTerminatedEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobResumedEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobTerminatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobResumedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PodNamespace) > 0 {
-		i -= len(m.PodNamespace)
-		copy(dAtA[i:], m.PodNamespace)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodNamespace)))
+	if len(m.Requestor) > 0 {
+		i -= len(m.Requestor)
+		copy(dAtA[i:], m.Requestor)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Requestor)))
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x2a
 	}
-	if len(m.PodName) > 0 {
-		i -= len(m.PodName)
-		copy(dAtA[i:], m.PodName)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodName)))
+	if m.Created != nil {
+		{
+			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x22
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobCancellingEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobCancellingEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobCancellingEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Requestor) > 0 {
+		i -= len(m.Requestor)
+		copy(dAtA[i:], m.Requestor)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Requestor)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Created != nil {
+		{
+			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobCancelledEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobCancelledEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobCancelledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Requestor) > 0 {
+		i -= len(m.Requestor)
+		copy(dAtA[i:], m.Requestor)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Requestor)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Created != nil {
+		{
+			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobTerminatedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobTerminatedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobTerminatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PodNamespace) > 0 {
+		i -= len(m.PodNamespace)
+		copy(dAtA[i:], m.PodNamespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodNamespace)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.PodName) > 0 {
+		i -= len(m.PodName)
+		copy(dAtA[i:], m.PodName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodName)))
+		i--
+		dAtA[i] = 0x4a
 	}
 	if len(m.Reason) > 0 {
 		i -= len(m.Reason)
@@ -4879,6 +5394,75 @@ func (m *EventMessage_Preempting) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	}
 	return len(dAtA) - i, nil
 }
+func (m *EventMessage_Moved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Moved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Moved != nil {
+		{
+			size, err := m.Moved.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessage_Suspended) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Suspended) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Suspended != nil {
+		{
+			size, err := m.Suspended.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessage_Resumed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Resumed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Resumed != nil {
+		{
+			size, err := m.Resumed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ContainerStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5762,7 +6346,7 @@ func (m *JobReprioritizedEvent) Size() (n int) {
 	return n
 }
 
-func (m *JobCancellingEvent) Size() (n int) {
+func (m *JobMovedEvent) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5784,15 +6368,114 @@ func (m *JobCancellingEvent) Size() (n int) {
 		l = m.Created.Size()
 		n += 1 + l + sovEvent(uint64(l))
 	}
-	l = len(m.Requestor)
+	l = len(m.DestinationQueue)
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
-	l = len(m.Reason)
+	l = len(m.DestinationJobSetId)
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
-	return n
+	l = len(m.Requestor)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSuspendedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Requestor)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobResumedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Requestor)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobCancellingEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Requestor)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
 }
 
 func (m *JobCancelledEvent) Size() (n int) {
@@ -6092,6 +6775,42 @@ func (m *EventMessage_Preempting) Size() (n int) {
 	}
 	return n
 }
+func (m *EventMessage_Moved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Moved != nil {
+		l = m.Moved.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Suspended) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Suspended != nil {
+		l = m.Suspended.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Resumed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resumed != nil {
+		l = m.Resumed.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
 func (m *ContainerStatus) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10767,120 +11486,848 @@ func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AvgResourcesForPeriod == nil {
+				m.AvgResourcesForPeriod = make(map[string]*resource.Quantity)
+			}
+			var mapkey string
+			var mapvalue *resource.Quantity
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AvgResourcesForPeriod[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobReprioritizingEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobReprioritizingEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobReprioritizingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &types.Timestamp{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPriority", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.NewPriority = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requestor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobReprioritizedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobReprioritizedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &types.Timestamp{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPriority", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.NewPriority = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requestor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobMovedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobMovedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobMovedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &types.Timestamp{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationJobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationJobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AvgResourcesForPeriod == nil {
-				m.AvgResourcesForPeriod = make(map[string]*resource.Quantity)
-			}
-			var mapkey string
-			var mapvalue *resource.Quantity
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthEvent
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthEvent
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthEvent
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthEvent
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &resource.Quantity{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipEvent(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthEvent
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.AvgResourcesForPeriod[mapkey] = mapvalue
+			m.Requestor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10903,7 +12350,7 @@ func (m *JobUtilisationEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobReprioritizingEvent) Unmarshal(dAtA []byte) error {
+func (m *JobSuspendedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10926,10 +12373,10 @@ func (m *JobReprioritizingEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobReprioritizingEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSuspendedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobReprioritizingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSuspendedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11065,19 +12512,40 @@ func (m *JobReprioritizingEvent) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPriority", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.NewPriority = float64(math.Float64frombits(v))
+			m.Requestor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11105,7 +12573,7 @@ func (m *JobReprioritizingEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requestor = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11128,7 +12596,7 @@ func (m *JobReprioritizingEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
+func (m *JobResumedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11151,10 +12619,10 @@ func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobReprioritizedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobResumedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobReprioritizedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobResumedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11290,17 +12758,6 @@ func (m *JobReprioritizedEvent) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPriority", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.NewPriority = float64(math.Float64frombits(v))
-		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
 			}
@@ -12830,6 +14287,111 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			}
 			m.Events = &EventMessage_Preempting{v}
 			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moved", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobMovedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Moved{v}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobSuspendedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Suspended{v}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resumed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobResumedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Resumed{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
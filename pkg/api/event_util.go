@@ -69,6 +69,12 @@ func UnwrapEvent(message *EventMessage) (Event, error) {
 		return event.Preempting, nil
 	case *EventMessage_Preempted:
 		return event.Preempted, nil
+	case *EventMessage_Moved:
+		return event.Moved, nil
+	case *EventMessage_Suspended:
+		return event.Suspended, nil
+	case *EventMessage_Resumed:
+		return event.Resumed, nil
 	}
 	return nil, errors.Errorf("unknown event type: %s", reflect.TypeOf(message.Events))
 }
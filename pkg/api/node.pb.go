@@ -167,41 +167,98 @@ func (m *NodeCancelRequest) GetPriorityClasses() []string {
 	return nil
 }
 
+// The specified node will be released from quarantine by its executor.
+type NodeQuarantineReleaseRequest struct {
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Executor string `protobuf:"bytes,2,opt,name=executor,proto3" json:"executor,omitempty"`
+}
+
+func (m *NodeQuarantineReleaseRequest) Reset()         { *m = NodeQuarantineReleaseRequest{} }
+func (m *NodeQuarantineReleaseRequest) String() string { return proto.CompactTextString(m) }
+func (*NodeQuarantineReleaseRequest) ProtoMessage()    {}
+func (*NodeQuarantineReleaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9cf137a57d72e6f, []int{2}
+}
+func (m *NodeQuarantineReleaseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeQuarantineReleaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeQuarantineReleaseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeQuarantineReleaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeQuarantineReleaseRequest.Merge(m, src)
+}
+func (m *NodeQuarantineReleaseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeQuarantineReleaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeQuarantineReleaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeQuarantineReleaseRequest proto.InternalMessageInfo
+
+func (m *NodeQuarantineReleaseRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NodeQuarantineReleaseRequest) GetExecutor() string {
+	if m != nil {
+		return m.Executor
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*NodePreemptRequest)(nil), "api.NodePreemptRequest")
 	proto.RegisterType((*NodeCancelRequest)(nil), "api.NodeCancelRequest")
+	proto.RegisterType((*NodeQuarantineReleaseRequest)(nil), "api.NodeQuarantineReleaseRequest")
 }
 
 func init() { proto.RegisterFile("pkg/api/node.proto", fileDescriptor_e9cf137a57d72e6f) }
 
 var fileDescriptor_e9cf137a57d72e6f = []byte{
-	// 403 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x53, 0xcd, 0x6e, 0xda, 0x30,
-	0x1c, 0xc7, 0x80, 0xd0, 0xb0, 0xf6, 0x69, 0x4d, 0x21, 0x03, 0x96, 0xb0, 0x1c, 0x36, 0x34, 0xa1,
-	0x58, 0x63, 0xb7, 0xdd, 0x06, 0x9a, 0x76, 0xdb, 0x26, 0x8e, 0xbb, 0x99, 0xc4, 0xcb, 0xdc, 0x92,
-	0xd8, 0x24, 0x4e, 0x55, 0x54, 0xf5, 0xd2, 0x27, 0xa8, 0xd4, 0x97, 0xea, 0xad, 0x48, 0xbd, 0xf4,
-	0x14, 0x55, 0x50, 0xb5, 0x52, 0x9e, 0xa2, 0x8a, 0x13, 0x28, 0xa5, 0xea, 0x0b, 0xf4, 0xf8, 0xff,
-	0x7d, 0xf8, 0xa7, 0xff, 0x87, 0x21, 0x12, 0xbb, 0x1e, 0x26, 0x82, 0xe1, 0x80, 0xbb, 0xd4, 0x16,
-	0x21, 0x97, 0x1c, 0x55, 0x88, 0x60, 0xcd, 0xb6, 0xc7, 0xb9, 0x37, 0xa1, 0x8a, 0x23, 0x41, 0xc0,
-	0x25, 0x91, 0x8c, 0x07, 0x51, 0x2e, 0x69, 0xb6, 0x0a, 0x56, 0x55, 0xe3, 0xf8, 0x1f, 0xa6, 0xbe,
-	0x90, 0xb3, 0x9c, 0xb4, 0x6e, 0x00, 0x44, 0xbf, 0xb8, 0x4b, 0xff, 0x84, 0x34, 0x83, 0x47, 0x74,
-	0x1a, 0xd3, 0x48, 0xa2, 0x8f, 0xb0, 0x1a, 0x10, 0x9f, 0xea, 0xa0, 0x03, 0xba, 0xf5, 0x01, 0x4a,
-	0x13, 0xf3, 0x65, 0x56, 0xf7, 0xb8, 0xcf, 0xa4, 0xb2, 0x8f, 0x14, 0x8f, 0xfa, 0xf0, 0x19, 0xdd,
-	0xa7, 0x4e, 0x2c, 0x79, 0xa8, 0x97, 0x95, 0x56, 0x4b, 0x13, 0x13, 0xad, 0xb0, 0x0d, 0xfd, 0x5a,
-	0x87, 0x7a, 0xb0, 0x36, 0x8d, 0x69, 0x4c, 0x23, 0xbd, 0xd2, 0xa9, 0x74, 0xeb, 0x83, 0xb7, 0x69,
-	0x62, 0xbe, 0xce, 0x91, 0x0d, 0x7d, 0xa1, 0x41, 0x3f, 0xe1, 0x2b, 0x11, 0x32, 0x1e, 0x32, 0x39,
-	0x1b, 0x4e, 0x48, 0x14, 0xd1, 0x48, 0xaf, 0x2a, 0xdb, 0xfb, 0x34, 0x31, 0xdf, 0x6d, 0x51, 0x1b,
-	0xfe, 0x6d, 0x97, 0x75, 0x0d, 0xe0, 0x9b, 0xac, 0xd3, 0x21, 0x09, 0x1c, 0x3a, 0x79, 0xba, 0x8d,
-	0xf6, 0xcf, 0x00, 0xac, 0x66, 0x8d, 0x22, 0x17, 0xbe, 0x28, 0xd6, 0xfa, 0x3b, 0x50, 0x40, 0xc3,
-	0x26, 0x82, 0xd9, 0x0f, 0xd7, 0xdd, 0xd4, 0xec, 0xfc, 0x46, 0xec, 0xd5, 0x8d, 0xd8, 0x3f, 0xb2,
-	0xb7, 0x2d, 0xeb, 0xe8, 0xfc, 0xea, 0xa4, 0xdc, 0xb6, 0x1a, 0x78, 0xef, 0x8b, 0xba, 0x3a, 0x2c,
-	0x72, 0x23, 0x3e, 0xc8, 0xa6, 0x72, 0xf8, 0x0d, 0x7c, 0x46, 0x04, 0x3e, 0xcf, 0x47, 0x5a, 0x84,
-	0x68, 0xeb, 0x90, 0x7b, 0x93, 0x7e, 0x34, 0xe3, 0x83, 0xca, 0x68, 0x59, 0xda, 0x3a, 0xc3, 0x51,
-	0xbe, 0xbb, 0x88, 0xc1, 0xf7, 0xd3, 0x85, 0x01, 0xe6, 0x0b, 0x03, 0x5c, 0x2e, 0x0c, 0x70, 0xbc,
-	0x34, 0x4a, 0xf3, 0xa5, 0x51, 0xba, 0x58, 0x1a, 0xa5, 0xbf, 0x9f, 0x3c, 0x26, 0xff, 0xc7, 0x63,
-	0xdb, 0xe1, 0x3e, 0x26, 0xa1, 0x4f, 0x5c, 0x22, 0x42, 0xbe, 0x43, 0x1d, 0x59, 0x54, 0xb8, 0xf8,
-	0x32, 0xe3, 0x9a, 0x4a, 0xfd, 0x7a, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xaf, 0x58, 0x08, 0xa6, 0x44,
-	0x03, 0x00, 0x00,
+	// 458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x94, 0xcd, 0x6e, 0x13, 0x31,
+	0x10, 0xc7, 0xe3, 0x24, 0xaa, 0xa8, 0xc5, 0xa7, 0x05, 0x69, 0x48, 0xc3, 0xa6, 0xf5, 0x81, 0x56,
+	0x55, 0xbb, 0x16, 0xe5, 0xd6, 0x1b, 0xa9, 0x10, 0x37, 0x3e, 0x72, 0xe4, 0xe6, 0x6c, 0x86, 0x60,
+	0xc8, 0xda, 0x8e, 0xd7, 0x8b, 0x28, 0x88, 0x03, 0x3c, 0x01, 0x12, 0x2f, 0xc5, 0xb1, 0x52, 0x2f,
+	0x9c, 0x56, 0x28, 0x41, 0x20, 0xed, 0x53, 0xa0, 0xf5, 0xba, 0x4b, 0x1a, 0x94, 0x2b, 0x07, 0x8e,
+	0x33, 0xf3, 0xff, 0xfb, 0xe7, 0x19, 0x8f, 0x8c, 0x89, 0x7e, 0x3d, 0x66, 0x5c, 0x0b, 0x26, 0xd5,
+	0x08, 0x42, 0x6d, 0x94, 0x55, 0xa4, 0xc1, 0xb5, 0xe8, 0x74, 0xc7, 0x4a, 0x8d, 0x27, 0xe0, 0x6a,
+	0x5c, 0x4a, 0x65, 0xb9, 0x15, 0x4a, 0x26, 0xa5, 0xa4, 0xb3, 0xe9, 0xab, 0x2e, 0x1a, 0xa6, 0x2f,
+	0x18, 0xc4, 0xda, 0x9e, 0x94, 0x45, 0xfa, 0x0b, 0x61, 0xf2, 0x58, 0x8d, 0xe0, 0xa9, 0x81, 0x22,
+	0x3d, 0x80, 0x69, 0x0a, 0x89, 0x25, 0x77, 0x71, 0x53, 0xf2, 0x18, 0xda, 0x68, 0x0b, 0xed, 0xae,
+	0xf7, 0x49, 0x9e, 0xf5, 0xae, 0x16, 0xf1, 0xbe, 0x8a, 0x85, 0x75, 0xf6, 0x81, 0xab, 0x93, 0x43,
+	0x7c, 0x09, 0xde, 0x42, 0x94, 0x5a, 0x65, 0xda, 0x75, 0xa7, 0x6d, 0xe5, 0x59, 0x8f, 0x9c, 0xe7,
+	0x16, 0xf4, 0x95, 0x8e, 0xec, 0xe3, 0xb5, 0x69, 0x0a, 0x29, 0x24, 0xed, 0xc6, 0x56, 0x63, 0x77,
+	0xbd, 0x7f, 0x33, 0xcf, 0x7a, 0xd7, 0xcb, 0xcc, 0x82, 0xde, 0x6b, 0xc8, 0x23, 0x7c, 0x4d, 0x1b,
+	0xa1, 0x8c, 0xb0, 0x27, 0xc7, 0x13, 0x9e, 0x24, 0x90, 0xb4, 0x9b, 0xce, 0x76, 0x27, 0xcf, 0x7a,
+	0xb7, 0x97, 0x4a, 0x0b, 0xfe, 0x65, 0x17, 0xfd, 0x89, 0xf0, 0x8d, 0xa2, 0xd3, 0x63, 0x2e, 0x23,
+	0x98, 0xfc, 0xc7, 0x8d, 0xbe, 0xc3, 0xdd, 0xa2, 0xcf, 0x67, 0x29, 0x37, 0x5c, 0x5a, 0x21, 0x61,
+	0x00, 0x13, 0xe0, 0x09, 0xfc, 0x83, 0x96, 0x0f, 0xcf, 0xea, 0xb8, 0x59, 0xc0, 0xc9, 0x08, 0x5f,
+	0xf1, 0x2b, 0xf5, 0x44, 0xba, 0xc4, 0x46, 0xc8, 0xb5, 0x08, 0xff, 0x5e, 0xb5, 0x4e, 0x2b, 0x2c,
+	0xf7, 0x33, 0x3c, 0xdf, 0xcf, 0xf0, 0x61, 0x71, 0x28, 0xa5, 0x9f, 0xce, 0x7e, 0x7c, 0xa9, 0x77,
+	0xe9, 0x06, 0x7b, 0x73, 0xcf, 0x6d, 0x3c, 0xd3, 0xa5, 0x91, 0xbd, 0x2f, 0xae, 0xf7, 0xe1, 0x08,
+	0xed, 0x11, 0x8e, 0x2f, 0x97, 0xcf, 0xe9, 0x21, 0xad, 0x0a, 0x72, 0xe1, 0x95, 0x57, 0x32, 0xb6,
+	0x1d, 0x63, 0xf3, 0x08, 0xed, 0xd1, 0x56, 0x85, 0x89, 0x9c, 0xd5, 0x53, 0xc8, 0x47, 0x84, 0x6f,
+	0xf9, 0x01, 0x5e, 0x9c, 0x2a, 0xd9, 0xae, 0x60, 0xab, 0x46, 0xbd, 0x92, 0x7b, 0xe0, 0xb8, 0x3b,
+	0x94, 0x56, 0x50, 0x53, 0x1a, 0x0f, 0xa6, 0xd5, 0x51, 0x7f, 0xda, 0xec, 0x3f, 0xf8, 0x3a, 0x0b,
+	0xd0, 0xe9, 0x2c, 0x40, 0xdf, 0x67, 0x01, 0xfa, 0x3c, 0x0f, 0x6a, 0xa7, 0xf3, 0xa0, 0xf6, 0x6d,
+	0x1e, 0xd4, 0x9e, 0xef, 0x8c, 0x85, 0x7d, 0x99, 0x0e, 0xc3, 0x48, 0xc5, 0x8c, 0x9b, 0x98, 0x8f,
+	0xb8, 0x36, 0xea, 0x15, 0x44, 0xd6, 0x47, 0xcc, 0x7f, 0x19, 0xc3, 0x35, 0x77, 0x83, 0xfb, 0xbf,
+	0x07, 0x00, 0x4c, 0x63, 0x12, 0x77, 0x44, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -218,6 +275,7 @@ const _ = grpc.SupportPackageIsVersion4
 type NodeClient interface {
 	PreemptOnNode(ctx context.Context, in *NodePreemptRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	CancelOnNode(ctx context.Context, in *NodeCancelRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	ReleaseNodeQuarantine(ctx context.Context, in *NodeQuarantineReleaseRequest, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type nodeClient struct {
@@ -246,10 +304,20 @@ func (c *nodeClient) CancelOnNode(ctx context.Context, in *NodeCancelRequest, op
 	return out, nil
 }
 
+func (c *nodeClient) ReleaseNodeQuarantine(ctx context.Context, in *NodeQuarantineReleaseRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Node/ReleaseNodeQuarantine", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NodeServer is the server API for Node service.
 type NodeServer interface {
 	PreemptOnNode(context.Context, *NodePreemptRequest) (*types.Empty, error)
 	CancelOnNode(context.Context, *NodeCancelRequest) (*types.Empty, error)
+	ReleaseNodeQuarantine(context.Context, *NodeQuarantineReleaseRequest) (*types.Empty, error)
 }
 
 // UnimplementedNodeServer can be embedded to have forward compatible implementations.
@@ -262,6 +330,9 @@ func (*UnimplementedNodeServer) PreemptOnNode(ctx context.Context, req *NodePree
 func (*UnimplementedNodeServer) CancelOnNode(ctx context.Context, req *NodeCancelRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelOnNode not implemented")
 }
+func (*UnimplementedNodeServer) ReleaseNodeQuarantine(ctx context.Context, req *NodeQuarantineReleaseRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseNodeQuarantine not implemented")
+}
 
 func RegisterNodeServer(s *grpc.Server, srv NodeServer) {
 	s.RegisterService(&_Node_serviceDesc, srv)
@@ -303,6 +374,24 @@ func _Node_CancelOnNode_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Node_ReleaseNodeQuarantine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeQuarantineReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).ReleaseNodeQuarantine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Node/ReleaseNodeQuarantine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).ReleaseNodeQuarantine(ctx, req.(*NodeQuarantineReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Node_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.Node",
 	HandlerType: (*NodeServer)(nil),
@@ -315,6 +404,10 @@ var _Node_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelOnNode",
 			Handler:    _Node_CancelOnNode_Handler,
 		},
+		{
+			MethodName: "ReleaseNodeQuarantine",
+			Handler:    _Node_ReleaseNodeQuarantine_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/api/node.proto",
@@ -430,6 +523,43 @@ func (m *NodeCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NodeQuarantineReleaseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeQuarantineReleaseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeQuarantineReleaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintNode(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintNode(dAtA []byte, offset int, v uint64) int {
 	offset -= sovNode(v)
 	base := offset
@@ -499,6 +629,23 @@ func (m *NodeCancelRequest) Size() (n int) {
 	return n
 }
 
+func (m *NodeQuarantineReleaseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	return n
+}
+
 func sovNode(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -861,6 +1008,120 @@ func (m *NodeCancelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *NodeQuarantineReleaseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeQuarantineReleaseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeQuarantineReleaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNode(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
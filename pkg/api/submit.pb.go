@@ -178,6 +178,15 @@ type JobSubmitRequestItem struct {
 	// URI identifying this job in an external system (e.g. Airflow).
 	// If not set, the server falls back to the "armadaproject.io/externalJobUri" annotation.
 	ExternalJobUri string `protobuf:"bytes,13,opt,name=external_job_uri,json=externalJobUri,proto3" json:"externalJobUri,omitempty"`
+	// ConfigMaps created in the job's namespace alongside the job's pod.
+	// Each ConfigMap must be named and is deleted once the job finishes.
+	ConfigMaps []*v1.ConfigMap `protobuf:"bytes,14,rep,name=config_maps,json=configMaps,proto3" json:"configMaps,omitempty"`
+	// Secrets copied into the job's namespace from secrets held by the executor.
+	// Secret data is never included in the job itself; see SecretReference.
+	Secrets []*SecretReference `protobuf:"bytes,15,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	// PersistentVolumeClaims created in the job's namespace alongside the job's pod.
+	// Each claim must be named and is deleted once the job finishes.
+	VolumeClaimTemplates []*v1.PersistentVolumeClaimTemplate `protobuf:"bytes,16,rep,name=volume_claim_templates,json=volumeClaimTemplates,proto3" json:"volumeClaimTemplates,omitempty"`
 }
 
 func (m *JobSubmitRequestItem) Reset()         { *m = JobSubmitRequestItem{} }
@@ -299,6 +308,84 @@ func (m *JobSubmitRequestItem) GetExternalJobUri() string {
 	return ""
 }
 
+func (m *JobSubmitRequestItem) GetConfigMaps() []*v1.ConfigMap {
+	if m != nil {
+		return m.ConfigMaps
+	}
+	return nil
+}
+
+func (m *JobSubmitRequestItem) GetSecrets() []*SecretReference {
+	if m != nil {
+		return m.Secrets
+	}
+	return nil
+}
+
+func (m *JobSubmitRequestItem) GetVolumeClaimTemplates() []*v1.PersistentVolumeClaimTemplate {
+	if m != nil {
+		return m.VolumeClaimTemplates
+	}
+	return nil
+}
+
+// A reference to a secret in the executor's job secret namespace.
+// The executor copies the referenced secret into the job's namespace under the given name,
+// so that it can be mounted by the job's pod, and deletes the copy once the job finishes.
+type SecretReference struct {
+	// Name of the secret created in the job's namespace.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Name of the secret to copy from the executor's job secret namespace.
+	SourceName string `protobuf:"bytes,2,opt,name=source_name,json=sourceName,proto3" json:"sourceName,omitempty"`
+}
+
+func (m *SecretReference) Reset()         { *m = SecretReference{} }
+func (m *SecretReference) String() string { return proto.CompactTextString(m) }
+func (*SecretReference) ProtoMessage()    {}
+func (*SecretReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{1}
+}
+func (m *SecretReference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecretReference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SecretReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretReference.Merge(m, src)
+}
+func (m *SecretReference) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretReference proto.InternalMessageInfo
+
+func (m *SecretReference) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SecretReference) GetSourceName() string {
+	if m != nil {
+		return m.SourceName
+	}
+	return ""
+}
+
 type IngressConfig struct {
 	Type         IngressType       `protobuf:"varint,1,opt,name=type,proto3,enum=api.IngressType" json:"type,omitempty"` // Deprecated: Do not use.
 	Ports        []uint32          `protobuf:"varint,2,rep,packed,name=ports,proto3" json:"ports,omitempty"`
@@ -312,7 +399,7 @@ func (m *IngressConfig) Reset()         { *m = IngressConfig{} }
 func (m *IngressConfig) String() string { return proto.CompactTextString(m) }
 func (*IngressConfig) ProtoMessage()    {}
 func (*IngressConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{1}
+	return fileDescriptor_e998bacb27df16c1, []int{2}
 }
 func (m *IngressConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,7 +481,7 @@ func (m *ServiceConfig) Reset()         { *m = ServiceConfig{} }
 func (m *ServiceConfig) String() string { return proto.CompactTextString(m) }
 func (*ServiceConfig) ProtoMessage()    {}
 func (*ServiceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{2}
+	return fileDescriptor_e998bacb27df16c1, []int{3}
 }
 func (m *ServiceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -455,7 +542,7 @@ func (m *JobSubmitRequest) Reset()         { *m = JobSubmitRequest{} }
 func (m *JobSubmitRequest) String() string { return proto.CompactTextString(m) }
 func (*JobSubmitRequest) ProtoMessage()    {}
 func (*JobSubmitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{3}
+	return fileDescriptor_e998bacb27df16c1, []int{4}
 }
 func (m *JobSubmitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -511,13 +598,16 @@ type JobPreemptRequest struct {
 	JobSetId string   `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
 	JobIds   []string `protobuf:"bytes,3,rep,name=job_ids,json=jobIds,proto3" json:"jobIds,omitempty"`
 	Reason   string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Optional key used to make retries of this request idempotent.
+	// A request replayed with the same key returns the result of the original request.
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
 }
 
 func (m *JobPreemptRequest) Reset()         { *m = JobPreemptRequest{} }
 func (m *JobPreemptRequest) String() string { return proto.CompactTextString(m) }
 func (*JobPreemptRequest) ProtoMessage()    {}
 func (*JobPreemptRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{4}
+	return fileDescriptor_e998bacb27df16c1, []int{5}
 }
 func (m *JobPreemptRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -574,6 +664,13 @@ func (m *JobPreemptRequest) GetReason() string {
 	return ""
 }
 
+func (m *JobPreemptRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
 // swagger:model
 type JobCancelRequest struct {
 	JobId    string   `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
@@ -581,13 +678,16 @@ type JobCancelRequest struct {
 	Queue    string   `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
 	JobIds   []string `protobuf:"bytes,4,rep,name=job_ids,json=jobIds,proto3" json:"jobIds,omitempty"`
 	Reason   string   `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Optional key used to make retries of this request idempotent.
+	// A request replayed with the same key returns the result of the original request.
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
 }
 
 func (m *JobCancelRequest) Reset()         { *m = JobCancelRequest{} }
 func (m *JobCancelRequest) String() string { return proto.CompactTextString(m) }
 func (*JobCancelRequest) ProtoMessage()    {}
 func (*JobCancelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{5}
+	return fileDescriptor_e998bacb27df16c1, []int{6}
 }
 func (m *JobCancelRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -651,19 +751,29 @@ func (m *JobCancelRequest) GetReason() string {
 	return ""
 }
 
+func (m *JobCancelRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
 // swagger:model
 type JobSetCancelRequest struct {
 	JobSetId string        `protobuf:"bytes,1,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
 	Queue    string        `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
 	Filter   *JobSetFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
 	Reason   string        `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Optional key used to make retries of this request idempotent.
+	// A request replayed with the same key returns the result of the original request.
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
 }
 
 func (m *JobSetCancelRequest) Reset()         { *m = JobSetCancelRequest{} }
 func (m *JobSetCancelRequest) String() string { return proto.CompactTextString(m) }
 func (*JobSetCancelRequest) ProtoMessage()    {}
 func (*JobSetCancelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{6}
+	return fileDescriptor_e998bacb27df16c1, []int{7}
 }
 func (m *JobSetCancelRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -720,6 +830,13 @@ func (m *JobSetCancelRequest) GetReason() string {
 	return ""
 }
 
+func (m *JobSetCancelRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
 // swagger:model
 type JobSetFilter struct {
 	States []JobState `protobuf:"varint,1,rep,packed,name=states,proto3,enum=api.JobState" json:"states,omitempty"`
@@ -729,7 +846,7 @@ func (m *JobSetFilter) Reset()         { *m = JobSetFilter{} }
 func (m *JobSetFilter) String() string { return proto.CompactTextString(m) }
 func (*JobSetFilter) ProtoMessage()    {}
 func (*JobSetFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{7}
+	return fileDescriptor_e998bacb27df16c1, []int{8}
 }
 func (m *JobSetFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -811,7 +928,7 @@ func (m *Job) Reset()         { *m = Job{} }
 func (m *Job) String() string { return proto.CompactTextString(m) }
 func (*Job) ProtoMessage()    {}
 func (*Job) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{8}
+	return fileDescriptor_e998bacb27df16c1, []int{9}
 }
 func (m *Job) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -995,13 +1112,16 @@ type JobReprioritizeRequest struct {
 	JobSetId    string   `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
 	Queue       string   `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
 	NewPriority float64  `protobuf:"fixed64,4,opt,name=new_priority,json=newPriority,proto3" json:"newPriority,omitempty"`
+	// Optional key used to make retries of this request idempotent.
+	// A request replayed with the same key returns the result of the original request.
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
 }
 
 func (m *JobReprioritizeRequest) Reset()         { *m = JobReprioritizeRequest{} }
 func (m *JobReprioritizeRequest) String() string { return proto.CompactTextString(m) }
 func (*JobReprioritizeRequest) ProtoMessage()    {}
 func (*JobReprioritizeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{9}
+	return fileDescriptor_e998bacb27df16c1, []int{10}
 }
 func (m *JobReprioritizeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1058,6 +1178,13 @@ func (m *JobReprioritizeRequest) GetNewPriority() float64 {
 	return 0
 }
 
+func (m *JobReprioritizeRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
 // swagger:model
 type JobReprioritizeResponse struct {
 	ReprioritizationResults map[string]string `protobuf:"bytes,1,rep,name=reprioritization_results,json=reprioritizationResults,proto3" json:"reprioritizationResults,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
@@ -1067,7 +1194,7 @@ func (m *JobReprioritizeResponse) Reset()         { *m = JobReprioritizeResponse
 func (m *JobReprioritizeResponse) String() string { return proto.CompactTextString(m) }
 func (*JobReprioritizeResponse) ProtoMessage()    {}
 func (*JobReprioritizeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{10}
+	return fileDescriptor_e998bacb27df16c1, []int{11}
 }
 func (m *JobReprioritizeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1112,7 +1239,7 @@ func (m *JobSubmitResponseItem) Reset()         { *m = JobSubmitResponseItem{} }
 func (m *JobSubmitResponseItem) String() string { return proto.CompactTextString(m) }
 func (*JobSubmitResponseItem) ProtoMessage()    {}
 func (*JobSubmitResponseItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{11}
+	return fileDescriptor_e998bacb27df16c1, []int{12}
 }
 func (m *JobSubmitResponseItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1164,7 +1291,7 @@ func (m *JobSubmitResponse) Reset()         { *m = JobSubmitResponse{} }
 func (m *JobSubmitResponse) String() string { return proto.CompactTextString(m) }
 func (*JobSubmitResponse) ProtoMessage()    {}
 func (*JobSubmitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{12}
+	return fileDescriptor_e998bacb27df16c1, []int{13}
 }
 func (m *JobSubmitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1226,7 +1353,7 @@ func (m *Queue) Reset()         { *m = Queue{} }
 func (m *Queue) String() string { return proto.CompactTextString(m) }
 func (*Queue) ProtoMessage()    {}
 func (*Queue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{13}
+	return fileDescriptor_e998bacb27df16c1, []int{14}
 }
 func (m *Queue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1343,7 +1470,7 @@ func (m *Queue_Permissions) Reset()         { *m = Queue_Permissions{} }
 func (m *Queue_Permissions) String() string { return proto.CompactTextString(m) }
 func (*Queue_Permissions) ProtoMessage()    {}
 func (*Queue_Permissions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{13, 0}
+	return fileDescriptor_e998bacb27df16c1, []int{14, 0}
 }
 func (m *Queue_Permissions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1395,7 +1522,7 @@ func (m *Queue_Permissions_Subject) Reset()         { *m = Queue_Permissions_Sub
 func (m *Queue_Permissions_Subject) String() string { return proto.CompactTextString(m) }
 func (*Queue_Permissions_Subject) ProtoMessage()    {}
 func (*Queue_Permissions_Subject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{13, 0, 0}
+	return fileDescriptor_e998bacb27df16c1, []int{14, 0, 0}
 }
 func (m *Queue_Permissions_Subject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1451,7 +1578,7 @@ func (m *PriorityClassResourceLimits) Reset()         { *m = PriorityClassResour
 func (m *PriorityClassResourceLimits) String() string { return proto.CompactTextString(m) }
 func (*PriorityClassResourceLimits) ProtoMessage()    {}
 func (*PriorityClassResourceLimits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{14}
+	return fileDescriptor_e998bacb27df16c1, []int{15}
 }
 func (m *PriorityClassResourceLimits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1502,7 +1629,7 @@ func (m *PriorityClassPoolResourceLimits) Reset()         { *m = PriorityClassPo
 func (m *PriorityClassPoolResourceLimits) String() string { return proto.CompactTextString(m) }
 func (*PriorityClassPoolResourceLimits) ProtoMessage()    {}
 func (*PriorityClassPoolResourceLimits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{15}
+	return fileDescriptor_e998bacb27df16c1, []int{16}
 }
 func (m *PriorityClassPoolResourceLimits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1547,7 +1674,7 @@ func (m *QueueList) Reset()         { *m = QueueList{} }
 func (m *QueueList) String() string { return proto.CompactTextString(m) }
 func (*QueueList) ProtoMessage()    {}
 func (*QueueList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{16}
+	return fileDescriptor_e998bacb27df16c1, []int{17}
 }
 func (m *QueueList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1592,7 +1719,7 @@ func (m *CancellationResult) Reset()         { *m = CancellationResult{} }
 func (m *CancellationResult) String() string { return proto.CompactTextString(m) }
 func (*CancellationResult) ProtoMessage()    {}
 func (*CancellationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{17}
+	return fileDescriptor_e998bacb27df16c1, []int{18}
 }
 func (m *CancellationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1637,7 +1764,7 @@ func (m *PreemptionResult) Reset()         { *m = PreemptionResult{} }
 func (m *PreemptionResult) String() string { return proto.CompactTextString(m) }
 func (*PreemptionResult) ProtoMessage()    {}
 func (*PreemptionResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{18}
+	return fileDescriptor_e998bacb27df16c1, []int{19}
 }
 func (m *PreemptionResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1673,29 +1800,31 @@ func (m *PreemptionResult) GetPreemptionResults() map[string]string {
 	return nil
 }
 
-// RetryPolicy defines rules that determine whether failed jobs should be retried.
-// Operators create policies and assign them to queues by name.
-type RetryPolicy struct {
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// retry_limit is the maximum number of retries after the initial failure.
-	// A retry_limit of 0 means the policy never retries.
-	RetryLimit    uint32       `protobuf:"varint,2,opt,name=retry_limit,json=retryLimit,proto3" json:"retryLimit,omitempty"`
-	DefaultAction RetryAction  `protobuf:"varint,3,opt,name=default_action,json=defaultAction,proto3,enum=api.RetryAction" json:"defaultAction,omitempty"`
-	Rules         []*RetryRule `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"`
-}
-
-func (m *RetryPolicy) Reset()         { *m = RetryPolicy{} }
-func (m *RetryPolicy) String() string { return proto.CompactTextString(m) }
-func (*RetryPolicy) ProtoMessage()    {}
-func (*RetryPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{19}
+// Selects active jobs within a queue by their labels and annotations.
+// Only labels and annotations configured as selectable on the server can be used, since the scheduler doesn't store any others.
+// Jobs submitted before a label or annotation was made selectable are never matched by it.
+// swagger:model
+type JobSelector struct {
+	// Only jobs with all of these labels are selected.
+	Labels map[string]string `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Only jobs with all of these annotations are selected.
+	Annotations map[string]string `protobuf:"bytes,2,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// If set, only jobs in job sets whose name starts with this prefix are selected.
+	JobSetPrefix string `protobuf:"bytes,3,opt,name=job_set_prefix,json=jobSetPrefix,proto3" json:"jobSetPrefix,omitempty"`
+}
+
+func (m *JobSelector) Reset()         { *m = JobSelector{} }
+func (m *JobSelector) String() string { return proto.CompactTextString(m) }
+func (*JobSelector) ProtoMessage()    {}
+func (*JobSelector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{20}
 }
-func (m *RetryPolicy) XXX_Unmarshal(b []byte) error {
+func (m *JobSelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobSelector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RetryPolicy.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobSelector.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1705,69 +1834,60 @@ func (m *RetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *RetryPolicy) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RetryPolicy.Merge(m, src)
+func (m *JobSelector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSelector.Merge(m, src)
 }
-func (m *RetryPolicy) XXX_Size() int {
+func (m *JobSelector) XXX_Size() int {
 	return m.Size()
 }
-func (m *RetryPolicy) XXX_DiscardUnknown() {
-	xxx_messageInfo_RetryPolicy.DiscardUnknown(m)
+func (m *JobSelector) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSelector.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RetryPolicy proto.InternalMessageInfo
-
-func (m *RetryPolicy) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
+var xxx_messageInfo_JobSelector proto.InternalMessageInfo
 
-func (m *RetryPolicy) GetRetryLimit() uint32 {
+func (m *JobSelector) GetLabels() map[string]string {
 	if m != nil {
-		return m.RetryLimit
+		return m.Labels
 	}
-	return 0
+	return nil
 }
 
-func (m *RetryPolicy) GetDefaultAction() RetryAction {
+func (m *JobSelector) GetAnnotations() map[string]string {
 	if m != nil {
-		return m.DefaultAction
+		return m.Annotations
 	}
-	return RetryAction_RETRY_ACTION_UNSPECIFIED
+	return nil
 }
 
-func (m *RetryPolicy) GetRules() []*RetryRule {
+func (m *JobSelector) GetJobSetPrefix() string {
 	if m != nil {
-		return m.Rules
+		return m.JobSetPrefix
 	}
-	return nil
-}
-
-type RetryRule struct {
-	Action RetryAction `protobuf:"varint,1,opt,name=action,proto3,enum=api.RetryAction" json:"action,omitempty"`
-	// on_category matches against Error.failure_category. When set with on_subcategory,
-	// both must match.
-	OnCategory    string `protobuf:"bytes,5,opt,name=on_category,json=onCategory,proto3" json:"onCategory,omitempty"`
-	OnSubcategory string `protobuf:"bytes,6,opt,name=on_subcategory,json=onSubcategory,proto3" json:"onSubcategory,omitempty"`
-	// mutate describes changes applied to the job when this rule retries it.
-	// Only meaningful when action is Retry.
-	Mutate *RetryMutation `protobuf:"bytes,7,opt,name=mutate,proto3" json:"mutate,omitempty"`
+	return ""
 }
 
-func (m *RetryRule) Reset()         { *m = RetryRule{} }
-func (m *RetryRule) String() string { return proto.CompactTextString(m) }
-func (*RetryRule) ProtoMessage()    {}
-func (*RetryRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{20}
+// swagger:model
+type JobSelectorCancelRequest struct {
+	Queue    string       `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	Selector *JobSelector `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
+	Reason   string       `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// If true, no jobs are cancelled and only the number of matching jobs is returned.
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dryRun,omitempty"`
+}
+
+func (m *JobSelectorCancelRequest) Reset()         { *m = JobSelectorCancelRequest{} }
+func (m *JobSelectorCancelRequest) String() string { return proto.CompactTextString(m) }
+func (*JobSelectorCancelRequest) ProtoMessage()    {}
+func (*JobSelectorCancelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{21}
 }
-func (m *RetryRule) XXX_Unmarshal(b []byte) error {
+func (m *JobSelectorCancelRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RetryRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobSelectorCancelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RetryRule.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobSelectorCancelRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1777,68 +1897,67 @@ func (m *RetryRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *RetryRule) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RetryRule.Merge(m, src)
+func (m *JobSelectorCancelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSelectorCancelRequest.Merge(m, src)
 }
-func (m *RetryRule) XXX_Size() int {
+func (m *JobSelectorCancelRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RetryRule) XXX_DiscardUnknown() {
-	xxx_messageInfo_RetryRule.DiscardUnknown(m)
+func (m *JobSelectorCancelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSelectorCancelRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RetryRule proto.InternalMessageInfo
+var xxx_messageInfo_JobSelectorCancelRequest proto.InternalMessageInfo
 
-func (m *RetryRule) GetAction() RetryAction {
+func (m *JobSelectorCancelRequest) GetQueue() string {
 	if m != nil {
-		return m.Action
+		return m.Queue
 	}
-	return RetryAction_RETRY_ACTION_UNSPECIFIED
+	return ""
 }
 
-func (m *RetryRule) GetOnCategory() string {
+func (m *JobSelectorCancelRequest) GetSelector() *JobSelector {
 	if m != nil {
-		return m.OnCategory
+		return m.Selector
 	}
-	return ""
+	return nil
 }
 
-func (m *RetryRule) GetOnSubcategory() string {
+func (m *JobSelectorCancelRequest) GetReason() string {
 	if m != nil {
-		return m.OnSubcategory
+		return m.Reason
 	}
 	return ""
 }
 
-func (m *RetryRule) GetMutate() *RetryMutation {
+func (m *JobSelectorCancelRequest) GetDryRun() bool {
 	if m != nil {
-		return m.Mutate
+		return m.DryRun
 	}
-	return nil
-}
-
-// RetryMutation groups the changes applied to a job on a policy-driven retry.
-// Fields are additive: new mutation kinds get new fields over time.
-type RetryMutation struct {
-	// affinity changes where the retry is allowed to run.
-	Affinity *RetryAffinityMutation `protobuf:"bytes,1,opt,name=affinity,proto3" json:"affinity,omitempty"`
-	// resources bumps the retried job's resource requirements. If the bumped
-	// job no longer fits any node, the job fails terminally.
-	Resources *RetryResourceMutation `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
+	return false
 }
 
-func (m *RetryMutation) Reset()         { *m = RetryMutation{} }
-func (m *RetryMutation) String() string { return proto.CompactTextString(m) }
-func (*RetryMutation) ProtoMessage()    {}
-func (*RetryMutation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{21}
+// swagger:model
+type JobSelectorReprioritizeRequest struct {
+	Queue       string       `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	Selector    *JobSelector `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
+	NewPriority float64      `protobuf:"fixed64,3,opt,name=new_priority,json=newPriority,proto3" json:"newPriority,omitempty"`
+	// If true, no jobs are reprioritized and only the number of matching jobs is returned.
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dryRun,omitempty"`
+}
+
+func (m *JobSelectorReprioritizeRequest) Reset()         { *m = JobSelectorReprioritizeRequest{} }
+func (m *JobSelectorReprioritizeRequest) String() string { return proto.CompactTextString(m) }
+func (*JobSelectorReprioritizeRequest) ProtoMessage()    {}
+func (*JobSelectorReprioritizeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{22}
 }
-func (m *RetryMutation) XXX_Unmarshal(b []byte) error {
+func (m *JobSelectorReprioritizeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RetryMutation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobSelectorReprioritizeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RetryMutation.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobSelectorReprioritizeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1848,53 +1967,64 @@ func (m *RetryMutation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *RetryMutation) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RetryMutation.Merge(m, src)
+func (m *JobSelectorReprioritizeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSelectorReprioritizeRequest.Merge(m, src)
 }
-func (m *RetryMutation) XXX_Size() int {
+func (m *JobSelectorReprioritizeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RetryMutation) XXX_DiscardUnknown() {
-	xxx_messageInfo_RetryMutation.DiscardUnknown(m)
+func (m *JobSelectorReprioritizeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSelectorReprioritizeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RetryMutation proto.InternalMessageInfo
+var xxx_messageInfo_JobSelectorReprioritizeRequest proto.InternalMessageInfo
 
-func (m *RetryMutation) GetAffinity() *RetryAffinityMutation {
+func (m *JobSelectorReprioritizeRequest) GetQueue() string {
 	if m != nil {
-		return m.Affinity
+		return m.Queue
 	}
-	return nil
+	return ""
 }
 
-func (m *RetryMutation) GetResources() *RetryResourceMutation {
+func (m *JobSelectorReprioritizeRequest) GetSelector() *JobSelector {
 	if m != nil {
-		return m.Resources
+		return m.Selector
 	}
 	return nil
 }
 
-// RetryAffinityMutation groups placement changes applied to a retried job.
-type RetryAffinityMutation struct {
-	// avoid_same_node, when true, steers the retry away from every node a
-	// previous run attempted. This matches the lease-return retry behaviour:
-	// the job fails if the anti-affinity makes it unschedulable. Default
-	// false: the retry requeues without the per-job scheduling probe.
-	AvoidSameNode bool `protobuf:"varint,1,opt,name=avoid_same_node,json=avoidSameNode,proto3" json:"avoidSameNode,omitempty"`
+func (m *JobSelectorReprioritizeRequest) GetNewPriority() float64 {
+	if m != nil {
+		return m.NewPriority
+	}
+	return 0
 }
 
-func (m *RetryAffinityMutation) Reset()         { *m = RetryAffinityMutation{} }
-func (m *RetryAffinityMutation) String() string { return proto.CompactTextString(m) }
-func (*RetryAffinityMutation) ProtoMessage()    {}
-func (*RetryAffinityMutation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{22}
+func (m *JobSelectorReprioritizeRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
 }
-func (m *RetryAffinityMutation) XXX_Unmarshal(b []byte) error {
+
+// swagger:model
+type JobSelectorResult struct {
+	// Number of jobs matching the selector.
+	MatchedJobs int32 `protobuf:"varint,1,opt,name=matched_jobs,json=matchedJobs,proto3" json:"matchedJobs,omitempty"`
+}
+
+func (m *JobSelectorResult) Reset()         { *m = JobSelectorResult{} }
+func (m *JobSelectorResult) String() string { return proto.CompactTextString(m) }
+func (*JobSelectorResult) ProtoMessage()    {}
+func (*JobSelectorResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{23}
+}
+func (m *JobSelectorResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RetryAffinityMutation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobSelectorResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RetryAffinityMutation.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobSelectorResult.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1904,43 +2034,51 @@ func (m *RetryAffinityMutation) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *RetryAffinityMutation) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RetryAffinityMutation.Merge(m, src)
+func (m *JobSelectorResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSelectorResult.Merge(m, src)
 }
-func (m *RetryAffinityMutation) XXX_Size() int {
+func (m *JobSelectorResult) XXX_Size() int {
 	return m.Size()
 }
-func (m *RetryAffinityMutation) XXX_DiscardUnknown() {
-	xxx_messageInfo_RetryAffinityMutation.DiscardUnknown(m)
+func (m *JobSelectorResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSelectorResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RetryAffinityMutation proto.InternalMessageInfo
+var xxx_messageInfo_JobSelectorResult proto.InternalMessageInfo
 
-func (m *RetryAffinityMutation) GetAvoidSameNode() bool {
+func (m *JobSelectorResult) GetMatchedJobs() int32 {
 	if m != nil {
-		return m.AvoidSameNode
+		return m.MatchedJobs
 	}
-	return false
-}
-
-// RetryResourceMutation groups per-resource bumps applied to a retried job.
-// New resources get new fields over time.
-type RetryResourceMutation struct {
-	Memory *RetryResourceBump `protobuf:"bytes,1,opt,name=memory,proto3" json:"memory,omitempty"`
+	return 0
 }
 
-func (m *RetryResourceMutation) Reset()         { *m = RetryResourceMutation{} }
-func (m *RetryResourceMutation) String() string { return proto.CompactTextString(m) }
-func (*RetryResourceMutation) ProtoMessage()    {}
-func (*RetryResourceMutation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{23}
+// Changes to the scheduling requirements of a queued job.
+// Fields left empty are not changed.
+// swagger:model
+type QueuedJobUpdate struct {
+	// New resource requirements, keyed by container name. Containers not listed keep their current resources.
+	ContainerResources map[string]*v1.ResourceRequirements `protobuf:"bytes,1,rep,name=container_resources,json=containerResources,proto3" json:"containerResources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// If non-empty, replaces the node selector of the job.
+	NodeSelector map[string]string `protobuf:"bytes,2,rep,name=node_selector,json=nodeSelector,proto3" json:"nodeSelector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// If non-empty, replaces the tolerations of the job.
+	Tolerations []*v1.Toleration `protobuf:"bytes,3,rep,name=tolerations,proto3" json:"tolerations,omitempty"`
+	// If set, replaces the priority class of the job.
+	PriorityClassName string `protobuf:"bytes,4,opt,name=priority_class_name,json=priorityClassName,proto3" json:"priorityClassName,omitempty"`
+}
+
+func (m *QueuedJobUpdate) Reset()         { *m = QueuedJobUpdate{} }
+func (m *QueuedJobUpdate) String() string { return proto.CompactTextString(m) }
+func (*QueuedJobUpdate) ProtoMessage()    {}
+func (*QueuedJobUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{24}
 }
-func (m *RetryResourceMutation) XXX_Unmarshal(b []byte) error {
+func (m *QueuedJobUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RetryResourceMutation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueuedJobUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RetryResourceMutation.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueuedJobUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1950,48 +2088,66 @@ func (m *RetryResourceMutation) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *RetryResourceMutation) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RetryResourceMutation.Merge(m, src)
+func (m *QueuedJobUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedJobUpdate.Merge(m, src)
 }
-func (m *RetryResourceMutation) XXX_Size() int {
+func (m *QueuedJobUpdate) XXX_Size() int {
 	return m.Size()
 }
-func (m *RetryResourceMutation) XXX_DiscardUnknown() {
-	xxx_messageInfo_RetryResourceMutation.DiscardUnknown(m)
+func (m *QueuedJobUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedJobUpdate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RetryResourceMutation proto.InternalMessageInfo
+var xxx_messageInfo_QueuedJobUpdate proto.InternalMessageInfo
 
-func (m *RetryResourceMutation) GetMemory() *RetryResourceBump {
+func (m *QueuedJobUpdate) GetContainerResources() map[string]*v1.ResourceRequirements {
 	if m != nil {
-		return m.Memory
+		return m.ContainerResources
 	}
 	return nil
 }
 
-// RetryResourceBump grows one resource on retry. Set exactly one field.
-// The bump changes requests and limits together, and it compounds across
-// retries: each retry grows the amount the previous retry produced.
-type RetryResourceBump struct {
-	// static adds a fixed amount, as a Kubernetes quantity, e.g. "512Mi".
-	Static string `protobuf:"bytes,1,opt,name=static,proto3" json:"static,omitempty"`
-	// factor multiplies the current amount. 1.1 means a 10% increase.
-	// Must be greater than 1.0 when set.
-	Factor float64 `protobuf:"fixed64,2,opt,name=factor,proto3" json:"factor,omitempty"`
+func (m *QueuedJobUpdate) GetNodeSelector() map[string]string {
+	if m != nil {
+		return m.NodeSelector
+	}
+	return nil
 }
 
-func (m *RetryResourceBump) Reset()         { *m = RetryResourceBump{} }
-func (m *RetryResourceBump) String() string { return proto.CompactTextString(m) }
-func (*RetryResourceBump) ProtoMessage()    {}
-func (*RetryResourceBump) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{24}
+func (m *QueuedJobUpdate) GetTolerations() []*v1.Toleration {
+	if m != nil {
+		return m.Tolerations
+	}
+	return nil
 }
-func (m *RetryResourceBump) XXX_Unmarshal(b []byte) error {
+
+func (m *QueuedJobUpdate) GetPriorityClassName() string {
+	if m != nil {
+		return m.PriorityClassName
+	}
+	return ""
+}
+
+// swagger:model
+type JobUpdateRequest struct {
+	JobIds   []string         `protobuf:"bytes,1,rep,name=job_ids,json=jobIds,proto3" json:"jobIds,omitempty"`
+	JobSetId string           `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue    string           `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Update   *QueuedJobUpdate `protobuf:"bytes,4,opt,name=update,proto3" json:"update,omitempty"`
+}
+
+func (m *JobUpdateRequest) Reset()         { *m = JobUpdateRequest{} }
+func (m *JobUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*JobUpdateRequest) ProtoMessage()    {}
+func (*JobUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{25}
+}
+func (m *JobUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RetryResourceBump) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RetryResourceBump.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobUpdateRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2001,48 +2157,64 @@ func (m *RetryResourceBump) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *RetryResourceBump) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RetryResourceBump.Merge(m, src)
+func (m *JobUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobUpdateRequest.Merge(m, src)
 }
-func (m *RetryResourceBump) XXX_Size() int {
+func (m *JobUpdateRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RetryResourceBump) XXX_DiscardUnknown() {
-	xxx_messageInfo_RetryResourceBump.DiscardUnknown(m)
+func (m *JobUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobUpdateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RetryResourceBump proto.InternalMessageInfo
+var xxx_messageInfo_JobUpdateRequest proto.InternalMessageInfo
 
-func (m *RetryResourceBump) GetStatic() string {
+func (m *JobUpdateRequest) GetJobIds() []string {
 	if m != nil {
-		return m.Static
+		return m.JobIds
+	}
+	return nil
+}
+
+func (m *JobUpdateRequest) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
 	}
 	return ""
 }
 
-func (m *RetryResourceBump) GetFactor() float64 {
+func (m *JobUpdateRequest) GetQueue() string {
 	if m != nil {
-		return m.Factor
+		return m.Queue
 	}
-	return 0
+	return ""
 }
 
-type RetryPolicyGetRequest struct {
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+func (m *JobUpdateRequest) GetUpdate() *QueuedJobUpdate {
+	if m != nil {
+		return m.Update
+	}
+	return nil
 }
 
-func (m *RetryPolicyGetRequest) Reset()         { *m = RetryPolicyGetRequest{} }
-func (m *RetryPolicyGetRequest) String() string { return proto.CompactTextString(m) }
-func (*RetryPolicyGetRequest) ProtoMessage()    {}
-func (*RetryPolicyGetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{25}
+// swagger:model
+type JobUpdateResponse struct {
+	// Maps each job id to an error message, or to the empty string if the update was accepted.
+	UpdateResults map[string]string `protobuf:"bytes,1,rep,name=update_results,json=updateResults,proto3" json:"updateResults,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
-func (m *RetryPolicyGetRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *JobUpdateResponse) Reset()         { *m = JobUpdateResponse{} }
+func (m *JobUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*JobUpdateResponse) ProtoMessage()    {}
+func (*JobUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{26}
+}
+func (m *JobUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RetryPolicyGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RetryPolicyGetRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobUpdateResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2052,41 +2224,48 @@ func (m *RetryPolicyGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *RetryPolicyGetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RetryPolicyGetRequest.Merge(m, src)
+func (m *JobUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobUpdateResponse.Merge(m, src)
 }
-func (m *RetryPolicyGetRequest) XXX_Size() int {
+func (m *JobUpdateResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RetryPolicyGetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RetryPolicyGetRequest.DiscardUnknown(m)
+func (m *JobUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobUpdateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RetryPolicyGetRequest proto.InternalMessageInfo
+var xxx_messageInfo_JobUpdateResponse proto.InternalMessageInfo
 
-func (m *RetryPolicyGetRequest) GetName() string {
+func (m *JobUpdateResponse) GetUpdateResults() map[string]string {
 	if m != nil {
-		return m.Name
+		return m.UpdateResults
 	}
-	return ""
+	return nil
 }
 
-type RetryPolicyDeleteRequest struct {
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+// swagger:model
+type JobMoveRequest struct {
+	JobIds   []string `protobuf:"bytes,1,rep,name=job_ids,json=jobIds,proto3" json:"jobIds,omitempty"`
+	JobSetId string   `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue    string   `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	// Queue to move the jobs to. If empty, the jobs stay in their current queue.
+	DestinationQueue string `protobuf:"bytes,4,opt,name=destination_queue,json=destinationQueue,proto3" json:"destinationQueue,omitempty"`
+	// Job set to move the jobs to. If empty, the jobs keep their current job set.
+	DestinationJobSetId string `protobuf:"bytes,5,opt,name=destination_job_set_id,json=destinationJobSetId,proto3" json:"destinationJobSetId,omitempty"`
 }
 
-func (m *RetryPolicyDeleteRequest) Reset()         { *m = RetryPolicyDeleteRequest{} }
-func (m *RetryPolicyDeleteRequest) String() string { return proto.CompactTextString(m) }
-func (*RetryPolicyDeleteRequest) ProtoMessage()    {}
-func (*RetryPolicyDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{26}
+func (m *JobMoveRequest) Reset()         { *m = JobMoveRequest{} }
+func (m *JobMoveRequest) String() string { return proto.CompactTextString(m) }
+func (*JobMoveRequest) ProtoMessage()    {}
+func (*JobMoveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{27}
 }
-func (m *RetryPolicyDeleteRequest) XXX_Unmarshal(b []byte) error {
+func (m *JobMoveRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RetryPolicyDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobMoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RetryPolicyDeleteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobMoveRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2096,40 +2275,71 @@ func (m *RetryPolicyDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *RetryPolicyDeleteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RetryPolicyDeleteRequest.Merge(m, src)
+func (m *JobMoveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobMoveRequest.Merge(m, src)
 }
-func (m *RetryPolicyDeleteRequest) XXX_Size() int {
+func (m *JobMoveRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RetryPolicyDeleteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RetryPolicyDeleteRequest.DiscardUnknown(m)
+func (m *JobMoveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobMoveRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RetryPolicyDeleteRequest proto.InternalMessageInfo
+var xxx_messageInfo_JobMoveRequest proto.InternalMessageInfo
 
-func (m *RetryPolicyDeleteRequest) GetName() string {
+func (m *JobMoveRequest) GetJobIds() []string {
 	if m != nil {
-		return m.Name
+		return m.JobIds
+	}
+	return nil
+}
+
+func (m *JobMoveRequest) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
 	}
 	return ""
 }
 
-type RetryPolicyListRequest struct {
+func (m *JobMoveRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
 }
 
-func (m *RetryPolicyListRequest) Reset()         { *m = RetryPolicyListRequest{} }
-func (m *RetryPolicyListRequest) String() string { return proto.CompactTextString(m) }
-func (*RetryPolicyListRequest) ProtoMessage()    {}
-func (*RetryPolicyListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{27}
+func (m *JobMoveRequest) GetDestinationQueue() string {
+	if m != nil {
+		return m.DestinationQueue
+	}
+	return ""
 }
-func (m *RetryPolicyListRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *JobMoveRequest) GetDestinationJobSetId() string {
+	if m != nil {
+		return m.DestinationJobSetId
+	}
+	return ""
+}
+
+// swagger:model
+type JobMoveResponse struct {
+	// Maps each job id to an error message, or to the empty string if the move was accepted.
+	MoveResults map[string]string `protobuf:"bytes,1,rep,name=move_results,json=moveResults,proto3" json:"moveResults,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *JobMoveResponse) Reset()         { *m = JobMoveResponse{} }
+func (m *JobMoveResponse) String() string { return proto.CompactTextString(m) }
+func (*JobMoveResponse) ProtoMessage()    {}
+func (*JobMoveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{28}
+}
+func (m *JobMoveResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RetryPolicyListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobMoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RetryPolicyListRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobMoveResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2139,34 +2349,45 @@ func (m *RetryPolicyListRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *RetryPolicyListRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RetryPolicyListRequest.Merge(m, src)
+func (m *JobMoveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobMoveResponse.Merge(m, src)
 }
-func (m *RetryPolicyListRequest) XXX_Size() int {
+func (m *JobMoveResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RetryPolicyListRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RetryPolicyListRequest.DiscardUnknown(m)
+func (m *JobMoveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobMoveResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RetryPolicyListRequest proto.InternalMessageInfo
+var xxx_messageInfo_JobMoveResponse proto.InternalMessageInfo
 
-type RetryPolicyList struct {
-	RetryPolicies []*RetryPolicy `protobuf:"bytes,1,rep,name=retry_policies,json=retryPolicies,proto3" json:"retryPolicies,omitempty"`
+func (m *JobMoveResponse) GetMoveResults() map[string]string {
+	if m != nil {
+		return m.MoveResults
+	}
+	return nil
 }
 
-func (m *RetryPolicyList) Reset()         { *m = RetryPolicyList{} }
-func (m *RetryPolicyList) String() string { return proto.CompactTextString(m) }
-func (*RetryPolicyList) ProtoMessage()    {}
-func (*RetryPolicyList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{28}
+// swagger:model
+type JobSuspendRequest struct {
+	JobIds   []string `protobuf:"bytes,1,rep,name=job_ids,json=jobIds,proto3" json:"jobIds,omitempty"`
+	JobSetId string   `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue    string   `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Reason   string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
-func (m *RetryPolicyList) XXX_Unmarshal(b []byte) error {
+
+func (m *JobSuspendRequest) Reset()         { *m = JobSuspendRequest{} }
+func (m *JobSuspendRequest) String() string { return proto.CompactTextString(m) }
+func (*JobSuspendRequest) ProtoMessage()    {}
+func (*JobSuspendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{29}
+}
+func (m *JobSuspendRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RetryPolicyList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobSuspendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RetryPolicyList.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobSuspendRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2176,42 +2397,65 @@ func (m *RetryPolicyList) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *RetryPolicyList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RetryPolicyList.Merge(m, src)
+func (m *JobSuspendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSuspendRequest.Merge(m, src)
 }
-func (m *RetryPolicyList) XXX_Size() int {
+func (m *JobSuspendRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RetryPolicyList) XXX_DiscardUnknown() {
-	xxx_messageInfo_RetryPolicyList.DiscardUnknown(m)
+func (m *JobSuspendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSuspendRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RetryPolicyList proto.InternalMessageInfo
+var xxx_messageInfo_JobSuspendRequest proto.InternalMessageInfo
 
-func (m *RetryPolicyList) GetRetryPolicies() []*RetryPolicy {
+func (m *JobSuspendRequest) GetJobIds() []string {
 	if m != nil {
-		return m.RetryPolicies
+		return m.JobIds
 	}
 	return nil
 }
 
-//swagger:model
-type QueueGetRequest struct {
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+func (m *JobSuspendRequest) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
 }
 
-func (m *QueueGetRequest) Reset()         { *m = QueueGetRequest{} }
-func (m *QueueGetRequest) String() string { return proto.CompactTextString(m) }
-func (*QueueGetRequest) ProtoMessage()    {}
-func (*QueueGetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{29}
+func (m *JobSuspendRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
 }
-func (m *QueueGetRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *JobSuspendRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// swagger:model
+type JobResumeRequest struct {
+	JobIds   []string `protobuf:"bytes,1,rep,name=job_ids,json=jobIds,proto3" json:"jobIds,omitempty"`
+	JobSetId string   `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue    string   `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+}
+
+func (m *JobResumeRequest) Reset()         { *m = JobResumeRequest{} }
+func (m *JobResumeRequest) String() string { return proto.CompactTextString(m) }
+func (*JobResumeRequest) ProtoMessage()    {}
+func (*JobResumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{30}
+}
+func (m *JobResumeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueueGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobResumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueueGetRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobResumeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2221,42 +2465,58 @@ func (m *QueueGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *QueueGetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueueGetRequest.Merge(m, src)
+func (m *JobResumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobResumeRequest.Merge(m, src)
 }
-func (m *QueueGetRequest) XXX_Size() int {
+func (m *JobResumeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueueGetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueueGetRequest.DiscardUnknown(m)
+func (m *JobResumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobResumeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueueGetRequest proto.InternalMessageInfo
+var xxx_messageInfo_JobResumeRequest proto.InternalMessageInfo
 
-func (m *QueueGetRequest) GetName() string {
+func (m *JobResumeRequest) GetJobIds() []string {
 	if m != nil {
-		return m.Name
+		return m.JobIds
+	}
+	return nil
+}
+
+func (m *JobResumeRequest) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
 	}
 	return ""
 }
 
-//swagger:model
-type QueueCordonRequest struct {
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+func (m *JobResumeRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
 }
 
-func (m *QueueCordonRequest) Reset()         { *m = QueueCordonRequest{} }
-func (m *QueueCordonRequest) String() string { return proto.CompactTextString(m) }
-func (*QueueCordonRequest) ProtoMessage()    {}
-func (*QueueCordonRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{30}
+// swagger:model
+type JobSetSuspendRequest struct {
+	JobSetId string `protobuf:"bytes,1,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue    string `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	Reason   string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
-func (m *QueueCordonRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *JobSetSuspendRequest) Reset()         { *m = JobSetSuspendRequest{} }
+func (m *JobSetSuspendRequest) String() string { return proto.CompactTextString(m) }
+func (*JobSetSuspendRequest) ProtoMessage()    {}
+func (*JobSetSuspendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{31}
+}
+func (m *JobSetSuspendRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueueCordonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobSetSuspendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueueCordonRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobSetSuspendRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2266,42 +2526,57 @@ func (m *QueueCordonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueueCordonRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueueCordonRequest.Merge(m, src)
+func (m *JobSetSuspendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSetSuspendRequest.Merge(m, src)
 }
-func (m *QueueCordonRequest) XXX_Size() int {
+func (m *JobSetSuspendRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueueCordonRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueueCordonRequest.DiscardUnknown(m)
+func (m *JobSetSuspendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSetSuspendRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueueCordonRequest proto.InternalMessageInfo
+var xxx_messageInfo_JobSetSuspendRequest proto.InternalMessageInfo
 
-func (m *QueueCordonRequest) GetName() string {
+func (m *JobSetSuspendRequest) GetJobSetId() string {
 	if m != nil {
-		return m.Name
+		return m.JobSetId
 	}
 	return ""
 }
 
-//swagger:model
-type QueueUncordonRequest struct {
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+func (m *JobSetSuspendRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
 }
 
-func (m *QueueUncordonRequest) Reset()         { *m = QueueUncordonRequest{} }
-func (m *QueueUncordonRequest) String() string { return proto.CompactTextString(m) }
-func (*QueueUncordonRequest) ProtoMessage()    {}
-func (*QueueUncordonRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{31}
+func (m *JobSetSuspendRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
-func (m *QueueUncordonRequest) XXX_Unmarshal(b []byte) error {
+
+// swagger:model
+type JobSetResumeRequest struct {
+	JobSetId string `protobuf:"bytes,1,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue    string `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+}
+
+func (m *JobSetResumeRequest) Reset()         { *m = JobSetResumeRequest{} }
+func (m *JobSetResumeRequest) String() string { return proto.CompactTextString(m) }
+func (*JobSetResumeRequest) ProtoMessage()    {}
+func (*JobSetResumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{32}
+}
+func (m *JobSetResumeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueueUncordonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobSetResumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueueUncordonRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobSetResumeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2311,42 +2586,50 @@ func (m *QueueUncordonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueueUncordonRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueueUncordonRequest.Merge(m, src)
+func (m *JobSetResumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSetResumeRequest.Merge(m, src)
 }
-func (m *QueueUncordonRequest) XXX_Size() int {
+func (m *JobSetResumeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueueUncordonRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueueUncordonRequest.DiscardUnknown(m)
+func (m *JobSetResumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSetResumeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueueUncordonRequest proto.InternalMessageInfo
+var xxx_messageInfo_JobSetResumeRequest proto.InternalMessageInfo
 
-func (m *QueueUncordonRequest) GetName() string {
+func (m *JobSetResumeRequest) GetJobSetId() string {
 	if m != nil {
-		return m.Name
+		return m.JobSetId
 	}
 	return ""
 }
 
-//swagger:model
-type StreamingQueueGetRequest struct {
-	Num uint32 `protobuf:"varint,1,opt,name=num,proto3" json:"num,omitempty"`
+func (m *JobSetResumeRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
 }
 
-func (m *StreamingQueueGetRequest) Reset()         { *m = StreamingQueueGetRequest{} }
-func (m *StreamingQueueGetRequest) String() string { return proto.CompactTextString(m) }
-func (*StreamingQueueGetRequest) ProtoMessage()    {}
-func (*StreamingQueueGetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{32}
+// swagger:model
+type JobSuspensionResult struct {
+	// Maps each job id to an error message, or to the empty string if the request was accepted.
+	Results map[string]string `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
-func (m *StreamingQueueGetRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *JobSuspensionResult) Reset()         { *m = JobSuspensionResult{} }
+func (m *JobSuspensionResult) String() string { return proto.CompactTextString(m) }
+func (*JobSuspensionResult) ProtoMessage()    {}
+func (*JobSuspensionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{33}
+}
+func (m *JobSuspensionResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StreamingQueueGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobSuspensionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StreamingQueueGetRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobSuspensionResult.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2356,42 +2639,48 @@ func (m *StreamingQueueGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *StreamingQueueGetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamingQueueGetRequest.Merge(m, src)
+func (m *JobSuspensionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSuspensionResult.Merge(m, src)
 }
-func (m *StreamingQueueGetRequest) XXX_Size() int {
+func (m *JobSuspensionResult) XXX_Size() int {
 	return m.Size()
 }
-func (m *StreamingQueueGetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamingQueueGetRequest.DiscardUnknown(m)
+func (m *JobSuspensionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSuspensionResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamingQueueGetRequest proto.InternalMessageInfo
+var xxx_messageInfo_JobSuspensionResult proto.InternalMessageInfo
 
-func (m *StreamingQueueGetRequest) GetNum() uint32 {
+func (m *JobSuspensionResult) GetResults() map[string]string {
 	if m != nil {
-		return m.Num
+		return m.Results
 	}
-	return 0
+	return nil
 }
 
-//swagger:model
-type QueueDeleteRequest struct {
+// RetryPolicy defines rules that determine whether failed jobs should be retried.
+// Operators create policies and assign them to queues by name.
+type RetryPolicy struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// retry_limit is the maximum number of retries after the initial failure.
+	// A retry_limit of 0 means the policy never retries.
+	RetryLimit    uint32       `protobuf:"varint,2,opt,name=retry_limit,json=retryLimit,proto3" json:"retryLimit,omitempty"`
+	DefaultAction RetryAction  `protobuf:"varint,3,opt,name=default_action,json=defaultAction,proto3,enum=api.RetryAction" json:"defaultAction,omitempty"`
+	Rules         []*RetryRule `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"`
 }
 
-func (m *QueueDeleteRequest) Reset()         { *m = QueueDeleteRequest{} }
-func (m *QueueDeleteRequest) String() string { return proto.CompactTextString(m) }
-func (*QueueDeleteRequest) ProtoMessage()    {}
-func (*QueueDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{33}
+func (m *RetryPolicy) Reset()         { *m = RetryPolicy{} }
+func (m *RetryPolicy) String() string { return proto.CompactTextString(m) }
+func (*RetryPolicy) ProtoMessage()    {}
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{34}
 }
-func (m *QueueDeleteRequest) XXX_Unmarshal(b []byte) error {
+func (m *RetryPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueueDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueueDeleteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RetryPolicy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2401,43 +2690,69 @@ func (m *QueueDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueueDeleteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueueDeleteRequest.Merge(m, src)
+func (m *RetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryPolicy.Merge(m, src)
 }
-func (m *QueueDeleteRequest) XXX_Size() int {
+func (m *RetryPolicy) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueueDeleteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueueDeleteRequest.DiscardUnknown(m)
+func (m *RetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryPolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueueDeleteRequest proto.InternalMessageInfo
+var xxx_messageInfo_RetryPolicy proto.InternalMessageInfo
 
-func (m *QueueDeleteRequest) GetName() string {
+func (m *RetryPolicy) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-type JobSetInfo struct {
-	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	QueuedJobs int32  `protobuf:"varint,2,opt,name=queued_jobs,json=queuedJobs,proto3" json:"queuedJobs,omitempty"`
-	LeasedJobs int32  `protobuf:"varint,3,opt,name=leased_jobs,json=leasedJobs,proto3" json:"leasedJobs,omitempty"`
+func (m *RetryPolicy) GetRetryLimit() uint32 {
+	if m != nil {
+		return m.RetryLimit
+	}
+	return 0
 }
 
-func (m *JobSetInfo) Reset()         { *m = JobSetInfo{} }
-func (m *JobSetInfo) String() string { return proto.CompactTextString(m) }
-func (*JobSetInfo) ProtoMessage()    {}
-func (*JobSetInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{34}
+func (m *RetryPolicy) GetDefaultAction() RetryAction {
+	if m != nil {
+		return m.DefaultAction
+	}
+	return RetryAction_RETRY_ACTION_UNSPECIFIED
 }
-func (m *JobSetInfo) XXX_Unmarshal(b []byte) error {
+
+func (m *RetryPolicy) GetRules() []*RetryRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type RetryRule struct {
+	Action RetryAction `protobuf:"varint,1,opt,name=action,proto3,enum=api.RetryAction" json:"action,omitempty"`
+	// on_category matches against Error.failure_category. When set with on_subcategory,
+	// both must match.
+	OnCategory    string `protobuf:"bytes,5,opt,name=on_category,json=onCategory,proto3" json:"onCategory,omitempty"`
+	OnSubcategory string `protobuf:"bytes,6,opt,name=on_subcategory,json=onSubcategory,proto3" json:"onSubcategory,omitempty"`
+	// mutate describes changes applied to the job when this rule retries it.
+	// Only meaningful when action is Retry.
+	Mutate *RetryMutation `protobuf:"bytes,7,opt,name=mutate,proto3" json:"mutate,omitempty"`
+}
+
+func (m *RetryRule) Reset()         { *m = RetryRule{} }
+func (m *RetryRule) String() string { return proto.CompactTextString(m) }
+func (*RetryRule) ProtoMessage()    {}
+func (*RetryRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{35}
+}
+func (m *RetryRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobSetInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RetryRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobSetInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RetryRule.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2447,56 +2762,68 @@ func (m *JobSetInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *JobSetInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobSetInfo.Merge(m, src)
+func (m *RetryRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryRule.Merge(m, src)
 }
-func (m *JobSetInfo) XXX_Size() int {
+func (m *RetryRule) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobSetInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobSetInfo.DiscardUnknown(m)
+func (m *RetryRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryRule.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobSetInfo proto.InternalMessageInfo
+var xxx_messageInfo_RetryRule proto.InternalMessageInfo
 
-func (m *JobSetInfo) GetName() string {
+func (m *RetryRule) GetAction() RetryAction {
 	if m != nil {
-		return m.Name
+		return m.Action
+	}
+	return RetryAction_RETRY_ACTION_UNSPECIFIED
+}
+
+func (m *RetryRule) GetOnCategory() string {
+	if m != nil {
+		return m.OnCategory
 	}
 	return ""
 }
 
-func (m *JobSetInfo) GetQueuedJobs() int32 {
+func (m *RetryRule) GetOnSubcategory() string {
 	if m != nil {
-		return m.QueuedJobs
+		return m.OnSubcategory
 	}
-	return 0
+	return ""
 }
 
-func (m *JobSetInfo) GetLeasedJobs() int32 {
+func (m *RetryRule) GetMutate() *RetryMutation {
 	if m != nil {
-		return m.LeasedJobs
+		return m.Mutate
 	}
-	return 0
+	return nil
 }
 
-type QueueUpdateResponse struct {
-	Queue *Queue `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
-	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+// RetryMutation groups the changes applied to a job on a policy-driven retry.
+// Fields are additive: new mutation kinds get new fields over time.
+type RetryMutation struct {
+	// affinity changes where the retry is allowed to run.
+	Affinity *RetryAffinityMutation `protobuf:"bytes,1,opt,name=affinity,proto3" json:"affinity,omitempty"`
+	// resources bumps the retried job's resource requirements. If the bumped
+	// job no longer fits any node, the job fails terminally.
+	Resources *RetryResourceMutation `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
 }
 
-func (m *QueueUpdateResponse) Reset()         { *m = QueueUpdateResponse{} }
-func (m *QueueUpdateResponse) String() string { return proto.CompactTextString(m) }
-func (*QueueUpdateResponse) ProtoMessage()    {}
-func (*QueueUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{35}
+func (m *RetryMutation) Reset()         { *m = RetryMutation{} }
+func (m *RetryMutation) String() string { return proto.CompactTextString(m) }
+func (*RetryMutation) ProtoMessage()    {}
+func (*RetryMutation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{36}
 }
-func (m *QueueUpdateResponse) XXX_Unmarshal(b []byte) error {
+func (m *RetryMutation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueueUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RetryMutation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueueUpdateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RetryMutation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2506,48 +2833,53 @@ func (m *QueueUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueueUpdateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueueUpdateResponse.Merge(m, src)
+func (m *RetryMutation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryMutation.Merge(m, src)
 }
-func (m *QueueUpdateResponse) XXX_Size() int {
+func (m *RetryMutation) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueueUpdateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueueUpdateResponse.DiscardUnknown(m)
+func (m *RetryMutation) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryMutation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueueUpdateResponse proto.InternalMessageInfo
+var xxx_messageInfo_RetryMutation proto.InternalMessageInfo
 
-func (m *QueueUpdateResponse) GetQueue() *Queue {
+func (m *RetryMutation) GetAffinity() *RetryAffinityMutation {
 	if m != nil {
-		return m.Queue
+		return m.Affinity
 	}
 	return nil
 }
 
-func (m *QueueUpdateResponse) GetError() string {
+func (m *RetryMutation) GetResources() *RetryResourceMutation {
 	if m != nil {
-		return m.Error
+		return m.Resources
 	}
-	return ""
+	return nil
 }
 
-type BatchQueueUpdateResponse struct {
-	FailedQueues []*QueueUpdateResponse `protobuf:"bytes,1,rep,name=failed_queues,json=failedQueues,proto3" json:"failedQueues,omitempty"`
+// RetryAffinityMutation groups placement changes applied to a retried job.
+type RetryAffinityMutation struct {
+	// avoid_same_node, when true, steers the retry away from every node a
+	// previous run attempted. This matches the lease-return retry behaviour:
+	// the job fails if the anti-affinity makes it unschedulable. Default
+	// false: the retry requeues without the per-job scheduling probe.
+	AvoidSameNode bool `protobuf:"varint,1,opt,name=avoid_same_node,json=avoidSameNode,proto3" json:"avoidSameNode,omitempty"`
 }
 
-func (m *BatchQueueUpdateResponse) Reset()         { *m = BatchQueueUpdateResponse{} }
-func (m *BatchQueueUpdateResponse) String() string { return proto.CompactTextString(m) }
-func (*BatchQueueUpdateResponse) ProtoMessage()    {}
-func (*BatchQueueUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{36}
+func (m *RetryAffinityMutation) Reset()         { *m = RetryAffinityMutation{} }
+func (m *RetryAffinityMutation) String() string { return proto.CompactTextString(m) }
+func (*RetryAffinityMutation) ProtoMessage()    {}
+func (*RetryAffinityMutation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{37}
 }
-func (m *BatchQueueUpdateResponse) XXX_Unmarshal(b []byte) error {
+func (m *RetryAffinityMutation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BatchQueueUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RetryAffinityMutation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BatchQueueUpdateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RetryAffinityMutation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2557,42 +2889,43 @@ func (m *BatchQueueUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *BatchQueueUpdateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BatchQueueUpdateResponse.Merge(m, src)
+func (m *RetryAffinityMutation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryAffinityMutation.Merge(m, src)
 }
-func (m *BatchQueueUpdateResponse) XXX_Size() int {
+func (m *RetryAffinityMutation) XXX_Size() int {
 	return m.Size()
 }
-func (m *BatchQueueUpdateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BatchQueueUpdateResponse.DiscardUnknown(m)
+func (m *RetryAffinityMutation) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryAffinityMutation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BatchQueueUpdateResponse proto.InternalMessageInfo
+var xxx_messageInfo_RetryAffinityMutation proto.InternalMessageInfo
 
-func (m *BatchQueueUpdateResponse) GetFailedQueues() []*QueueUpdateResponse {
+func (m *RetryAffinityMutation) GetAvoidSameNode() bool {
 	if m != nil {
-		return m.FailedQueues
+		return m.AvoidSameNode
 	}
-	return nil
+	return false
 }
 
-type QueueCreateResponse struct {
-	Queue *Queue `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
-	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+// RetryResourceMutation groups per-resource bumps applied to a retried job.
+// New resources get new fields over time.
+type RetryResourceMutation struct {
+	Memory *RetryResourceBump `protobuf:"bytes,1,opt,name=memory,proto3" json:"memory,omitempty"`
 }
 
-func (m *QueueCreateResponse) Reset()         { *m = QueueCreateResponse{} }
-func (m *QueueCreateResponse) String() string { return proto.CompactTextString(m) }
-func (*QueueCreateResponse) ProtoMessage()    {}
-func (*QueueCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{37}
+func (m *RetryResourceMutation) Reset()         { *m = RetryResourceMutation{} }
+func (m *RetryResourceMutation) String() string { return proto.CompactTextString(m) }
+func (*RetryResourceMutation) ProtoMessage()    {}
+func (*RetryResourceMutation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{38}
 }
-func (m *QueueCreateResponse) XXX_Unmarshal(b []byte) error {
+func (m *RetryResourceMutation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueueCreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RetryResourceMutation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueueCreateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RetryResourceMutation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2602,48 +2935,48 @@ func (m *QueueCreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueueCreateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueueCreateResponse.Merge(m, src)
+func (m *RetryResourceMutation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryResourceMutation.Merge(m, src)
 }
-func (m *QueueCreateResponse) XXX_Size() int {
+func (m *RetryResourceMutation) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueueCreateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueueCreateResponse.DiscardUnknown(m)
+func (m *RetryResourceMutation) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryResourceMutation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueueCreateResponse proto.InternalMessageInfo
+var xxx_messageInfo_RetryResourceMutation proto.InternalMessageInfo
 
-func (m *QueueCreateResponse) GetQueue() *Queue {
+func (m *RetryResourceMutation) GetMemory() *RetryResourceBump {
 	if m != nil {
-		return m.Queue
+		return m.Memory
 	}
 	return nil
 }
 
-func (m *QueueCreateResponse) GetError() string {
-	if m != nil {
-		return m.Error
-	}
-	return ""
-}
-
-type BatchQueueCreateResponse struct {
-	FailedQueues []*QueueCreateResponse `protobuf:"bytes,1,rep,name=failed_queues,json=failedQueues,proto3" json:"failedQueues,omitempty"`
+// RetryResourceBump grows one resource on retry. Set exactly one field.
+// The bump changes requests and limits together, and it compounds across
+// retries: each retry grows the amount the previous retry produced.
+type RetryResourceBump struct {
+	// static adds a fixed amount, as a Kubernetes quantity, e.g. "512Mi".
+	Static string `protobuf:"bytes,1,opt,name=static,proto3" json:"static,omitempty"`
+	// factor multiplies the current amount. 1.1 means a 10% increase.
+	// Must be greater than 1.0 when set.
+	Factor float64 `protobuf:"fixed64,2,opt,name=factor,proto3" json:"factor,omitempty"`
 }
 
-func (m *BatchQueueCreateResponse) Reset()         { *m = BatchQueueCreateResponse{} }
-func (m *BatchQueueCreateResponse) String() string { return proto.CompactTextString(m) }
-func (*BatchQueueCreateResponse) ProtoMessage()    {}
-func (*BatchQueueCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{38}
+func (m *RetryResourceBump) Reset()         { *m = RetryResourceBump{} }
+func (m *RetryResourceBump) String() string { return proto.CompactTextString(m) }
+func (*RetryResourceBump) ProtoMessage()    {}
+func (*RetryResourceBump) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{39}
 }
-func (m *BatchQueueCreateResponse) XXX_Unmarshal(b []byte) error {
+func (m *RetryResourceBump) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BatchQueueCreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RetryResourceBump) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BatchQueueCreateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RetryResourceBump.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2653,41 +2986,48 @@ func (m *BatchQueueCreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *BatchQueueCreateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BatchQueueCreateResponse.Merge(m, src)
+func (m *RetryResourceBump) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryResourceBump.Merge(m, src)
 }
-func (m *BatchQueueCreateResponse) XXX_Size() int {
+func (m *RetryResourceBump) XXX_Size() int {
 	return m.Size()
 }
-func (m *BatchQueueCreateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BatchQueueCreateResponse.DiscardUnknown(m)
+func (m *RetryResourceBump) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryResourceBump.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BatchQueueCreateResponse proto.InternalMessageInfo
+var xxx_messageInfo_RetryResourceBump proto.InternalMessageInfo
 
-func (m *BatchQueueCreateResponse) GetFailedQueues() []*QueueCreateResponse {
+func (m *RetryResourceBump) GetStatic() string {
 	if m != nil {
-		return m.FailedQueues
+		return m.Static
 	}
-	return nil
+	return ""
 }
 
-// Indicates the end of streams
-type EndMarker struct {
+func (m *RetryResourceBump) GetFactor() float64 {
+	if m != nil {
+		return m.Factor
+	}
+	return 0
 }
 
-func (m *EndMarker) Reset()         { *m = EndMarker{} }
-func (m *EndMarker) String() string { return proto.CompactTextString(m) }
-func (*EndMarker) ProtoMessage()    {}
-func (*EndMarker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{39}
+type RetryPolicyGetRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
-func (m *EndMarker) XXX_Unmarshal(b []byte) error {
+
+func (m *RetryPolicyGetRequest) Reset()         { *m = RetryPolicyGetRequest{} }
+func (m *RetryPolicyGetRequest) String() string { return proto.CompactTextString(m) }
+func (*RetryPolicyGetRequest) ProtoMessage()    {}
+func (*RetryPolicyGetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{40}
+}
+func (m *RetryPolicyGetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EndMarker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RetryPolicyGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EndMarker.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RetryPolicyGetRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2697,37 +3037,41 @@ func (m *EndMarker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *EndMarker) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EndMarker.Merge(m, src)
+func (m *RetryPolicyGetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryPolicyGetRequest.Merge(m, src)
 }
-func (m *EndMarker) XXX_Size() int {
+func (m *RetryPolicyGetRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *EndMarker) XXX_DiscardUnknown() {
-	xxx_messageInfo_EndMarker.DiscardUnknown(m)
+func (m *RetryPolicyGetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryPolicyGetRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EndMarker proto.InternalMessageInfo
+var xxx_messageInfo_RetryPolicyGetRequest proto.InternalMessageInfo
 
-type StreamingQueueMessage struct {
-	// Types that are valid to be assigned to Event:
-	//	*StreamingQueueMessage_Queue
-	//	*StreamingQueueMessage_End
-	Event isStreamingQueueMessage_Event `protobuf_oneof:"event"`
+func (m *RetryPolicyGetRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *StreamingQueueMessage) Reset()         { *m = StreamingQueueMessage{} }
-func (m *StreamingQueueMessage) String() string { return proto.CompactTextString(m) }
-func (*StreamingQueueMessage) ProtoMessage()    {}
-func (*StreamingQueueMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{40}
+type RetryPolicyDeleteRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
-func (m *StreamingQueueMessage) XXX_Unmarshal(b []byte) error {
+
+func (m *RetryPolicyDeleteRequest) Reset()         { *m = RetryPolicyDeleteRequest{} }
+func (m *RetryPolicyDeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*RetryPolicyDeleteRequest) ProtoMessage()    {}
+func (*RetryPolicyDeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{41}
+}
+func (m *RetryPolicyDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StreamingQueueMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RetryPolicyDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StreamingQueueMessage.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RetryPolicyDeleteRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2737,82 +3081,122 @@ func (m *StreamingQueueMessage) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *StreamingQueueMessage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamingQueueMessage.Merge(m, src)
+func (m *RetryPolicyDeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryPolicyDeleteRequest.Merge(m, src)
 }
-func (m *StreamingQueueMessage) XXX_Size() int {
+func (m *RetryPolicyDeleteRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *StreamingQueueMessage) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamingQueueMessage.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_StreamingQueueMessage proto.InternalMessageInfo
-
-type isStreamingQueueMessage_Event interface {
-	isStreamingQueueMessage_Event()
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type StreamingQueueMessage_Queue struct {
-	Queue *Queue `protobuf:"bytes,1,opt,name=queue,proto3,oneof" json:"queue,omitempty"`
-}
-type StreamingQueueMessage_End struct {
-	End *EndMarker `protobuf:"bytes,2,opt,name=end,proto3,oneof" json:"end,omitempty"`
+func (m *RetryPolicyDeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryPolicyDeleteRequest.DiscardUnknown(m)
 }
 
-func (*StreamingQueueMessage_Queue) isStreamingQueueMessage_Event() {}
-func (*StreamingQueueMessage_End) isStreamingQueueMessage_Event()   {}
+var xxx_messageInfo_RetryPolicyDeleteRequest proto.InternalMessageInfo
 
-func (m *StreamingQueueMessage) GetEvent() isStreamingQueueMessage_Event {
+func (m *RetryPolicyDeleteRequest) GetName() string {
 	if m != nil {
-		return m.Event
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *StreamingQueueMessage) GetQueue() *Queue {
-	if x, ok := m.GetEvent().(*StreamingQueueMessage_Queue); ok {
-		return x.Queue
-	}
-	return nil
+type RetryPolicyListRequest struct {
 }
 
-func (m *StreamingQueueMessage) GetEnd() *EndMarker {
-	if x, ok := m.GetEvent().(*StreamingQueueMessage_End); ok {
-		return x.End
-	}
-	return nil
+func (m *RetryPolicyListRequest) Reset()         { *m = RetryPolicyListRequest{} }
+func (m *RetryPolicyListRequest) String() string { return proto.CompactTextString(m) }
+func (*RetryPolicyListRequest) ProtoMessage()    {}
+func (*RetryPolicyListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{42}
+}
+func (m *RetryPolicyListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryPolicyListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetryPolicyListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetryPolicyListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryPolicyListRequest.Merge(m, src)
+}
+func (m *RetryPolicyListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryPolicyListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryPolicyListRequest.DiscardUnknown(m)
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*StreamingQueueMessage) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*StreamingQueueMessage_Queue)(nil),
-		(*StreamingQueueMessage_End)(nil),
+var xxx_messageInfo_RetryPolicyListRequest proto.InternalMessageInfo
+
+type RetryPolicyList struct {
+	RetryPolicies []*RetryPolicy `protobuf:"bytes,1,rep,name=retry_policies,json=retryPolicies,proto3" json:"retryPolicies,omitempty"`
+}
+
+func (m *RetryPolicyList) Reset()         { *m = RetryPolicyList{} }
+func (m *RetryPolicyList) String() string { return proto.CompactTextString(m) }
+func (*RetryPolicyList) ProtoMessage()    {}
+func (*RetryPolicyList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{43}
+}
+func (m *RetryPolicyList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryPolicyList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetryPolicyList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetryPolicyList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryPolicyList.Merge(m, src)
+}
+func (m *RetryPolicyList) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryPolicyList) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryPolicyList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryPolicyList proto.InternalMessageInfo
+
+func (m *RetryPolicyList) GetRetryPolicies() []*RetryPolicy {
+	if m != nil {
+		return m.RetryPolicies
 	}
+	return nil
 }
 
-type QueuePreemptRequest struct {
-	Name            string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	PriorityClasses []string `protobuf:"bytes,2,rep,name=priorityClasses,proto3" json:"priorityClasses,omitempty"`
-	// If empty, jobs on all pools will be preempted
-	Pools []string `protobuf:"bytes,3,rep,name=pools,proto3" json:"pools,omitempty"`
+//swagger:model
+type QueueGetRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *QueuePreemptRequest) Reset()         { *m = QueuePreemptRequest{} }
-func (m *QueuePreemptRequest) String() string { return proto.CompactTextString(m) }
-func (*QueuePreemptRequest) ProtoMessage()    {}
-func (*QueuePreemptRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{41}
+func (m *QueueGetRequest) Reset()         { *m = QueueGetRequest{} }
+func (m *QueueGetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueueGetRequest) ProtoMessage()    {}
+func (*QueueGetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{44}
 }
-func (m *QueuePreemptRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueueGetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueuePreemptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueueGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueuePreemptRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueueGetRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2822,60 +3206,87 @@ func (m *QueuePreemptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueuePreemptRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueuePreemptRequest.Merge(m, src)
+func (m *QueueGetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueGetRequest.Merge(m, src)
 }
-func (m *QueuePreemptRequest) XXX_Size() int {
+func (m *QueueGetRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueuePreemptRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueuePreemptRequest.DiscardUnknown(m)
+func (m *QueueGetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueGetRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueuePreemptRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueueGetRequest proto.InternalMessageInfo
 
-func (m *QueuePreemptRequest) GetName() string {
+func (m *QueueGetRequest) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *QueuePreemptRequest) GetPriorityClasses() []string {
-	if m != nil {
-		return m.PriorityClasses
+//swagger:model
+type QueueCordonRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueueCordonRequest) Reset()         { *m = QueueCordonRequest{} }
+func (m *QueueCordonRequest) String() string { return proto.CompactTextString(m) }
+func (*QueueCordonRequest) ProtoMessage()    {}
+func (*QueueCordonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{45}
+}
+func (m *QueueCordonRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueCordonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueCordonRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *QueueCordonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueCordonRequest.Merge(m, src)
+}
+func (m *QueueCordonRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueCordonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueCordonRequest.DiscardUnknown(m)
 }
 
-func (m *QueuePreemptRequest) GetPools() []string {
+var xxx_messageInfo_QueueCordonRequest proto.InternalMessageInfo
+
+func (m *QueueCordonRequest) GetName() string {
 	if m != nil {
-		return m.Pools
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-type QueueCancelRequest struct {
-	Name            string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	PriorityClasses []string `protobuf:"bytes,2,rep,name=priorityClasses,proto3" json:"priorityClasses,omitempty"`
-	// In practice jobs are only cancellable from non-terminal states
-	JobStates []JobState `protobuf:"varint,3,rep,packed,name=jobStates,proto3,enum=api.JobState" json:"jobStates,omitempty"`
-	// If empty, jobs on all pools will be cancelled
-	Pools []string `protobuf:"bytes,4,rep,name=pools,proto3" json:"pools,omitempty"`
+//swagger:model
+type QueueUncordonRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *QueueCancelRequest) Reset()         { *m = QueueCancelRequest{} }
-func (m *QueueCancelRequest) String() string { return proto.CompactTextString(m) }
-func (*QueueCancelRequest) ProtoMessage()    {}
-func (*QueueCancelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e998bacb27df16c1, []int{42}
+func (m *QueueUncordonRequest) Reset()         { *m = QueueUncordonRequest{} }
+func (m *QueueUncordonRequest) String() string { return proto.CompactTextString(m) }
+func (*QueueUncordonRequest) ProtoMessage()    {}
+func (*QueueUncordonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{46}
 }
-func (m *QueueCancelRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueueUncordonRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueueCancelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueueUncordonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueueCancelRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueueUncordonRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2885,1177 +3296,1110 @@ func (m *QueueCancelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueueCancelRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueueCancelRequest.Merge(m, src)
+func (m *QueueUncordonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueUncordonRequest.Merge(m, src)
 }
-func (m *QueueCancelRequest) XXX_Size() int {
+func (m *QueueUncordonRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueueCancelRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueueCancelRequest.DiscardUnknown(m)
+func (m *QueueUncordonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueUncordonRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueueCancelRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueueUncordonRequest proto.InternalMessageInfo
 
-func (m *QueueCancelRequest) GetName() string {
+func (m *QueueUncordonRequest) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *QueueCancelRequest) GetPriorityClasses() []string {
-	if m != nil {
-		return m.PriorityClasses
-	}
-	return nil
+//swagger:model
+type StreamingQueueGetRequest struct {
+	Num uint32 `protobuf:"varint,1,opt,name=num,proto3" json:"num,omitempty"`
 }
 
-func (m *QueueCancelRequest) GetJobStates() []JobState {
-	if m != nil {
-		return m.JobStates
+func (m *StreamingQueueGetRequest) Reset()         { *m = StreamingQueueGetRequest{} }
+func (m *StreamingQueueGetRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamingQueueGetRequest) ProtoMessage()    {}
+func (*StreamingQueueGetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{47}
+}
+func (m *StreamingQueueGetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamingQueueGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamingQueueGetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *StreamingQueueGetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamingQueueGetRequest.Merge(m, src)
+}
+func (m *StreamingQueueGetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamingQueueGetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamingQueueGetRequest.DiscardUnknown(m)
 }
 
-func (m *QueueCancelRequest) GetPools() []string {
+var xxx_messageInfo_StreamingQueueGetRequest proto.InternalMessageInfo
+
+func (m *StreamingQueueGetRequest) GetNum() uint32 {
 	if m != nil {
-		return m.Pools
+		return m.Num
 	}
-	return nil
+	return 0
 }
 
-func init() {
-	proto.RegisterEnum("api.IngressType", IngressType_name, IngressType_value)
-	proto.RegisterEnum("api.ServiceType", ServiceType_name, ServiceType_value)
-	proto.RegisterEnum("api.JobState", JobState_name, JobState_value)
-	proto.RegisterEnum("api.RetryAction", RetryAction_name, RetryAction_value)
-	proto.RegisterType((*JobSubmitRequestItem)(nil), "api.JobSubmitRequestItem")
-	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.AnnotationsEntry")
-	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.LabelsEntry")
-	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.RequiredNodeLabelsEntry")
-	proto.RegisterType((*IngressConfig)(nil), "api.IngressConfig")
-	proto.RegisterMapType((map[string]string)(nil), "api.IngressConfig.AnnotationsEntry")
-	proto.RegisterType((*ServiceConfig)(nil), "api.ServiceConfig")
-	proto.RegisterType((*JobSubmitRequest)(nil), "api.JobSubmitRequest")
-	proto.RegisterType((*JobPreemptRequest)(nil), "api.JobPreemptRequest")
-	proto.RegisterType((*JobCancelRequest)(nil), "api.JobCancelRequest")
-	proto.RegisterType((*JobSetCancelRequest)(nil), "api.JobSetCancelRequest")
-	proto.RegisterType((*JobSetFilter)(nil), "api.JobSetFilter")
-	proto.RegisterType((*Job)(nil), "api.Job")
-	proto.RegisterMapType((map[string]string)(nil), "api.Job.AnnotationsEntry")
-	proto.RegisterMapType((map[string]string)(nil), "api.Job.LabelsEntry")
-	proto.RegisterMapType((map[string]string)(nil), "api.Job.RequiredNodeLabelsEntry")
-	proto.RegisterType((*JobReprioritizeRequest)(nil), "api.JobReprioritizeRequest")
-	proto.RegisterType((*JobReprioritizeResponse)(nil), "api.JobReprioritizeResponse")
-	proto.RegisterMapType((map[string]string)(nil), "api.JobReprioritizeResponse.ReprioritizationResultsEntry")
-	proto.RegisterType((*JobSubmitResponseItem)(nil), "api.JobSubmitResponseItem")
-	proto.RegisterType((*JobSubmitResponse)(nil), "api.JobSubmitResponse")
-	proto.RegisterType((*Queue)(nil), "api.Queue")
-	proto.RegisterMapType((map[string]string)(nil), "api.Queue.LabelsEntry")
-	proto.RegisterMapType((map[string]*PriorityClassResourceLimits)(nil), "api.Queue.ResourceLimitsByPriorityClassNameEntry")
-	proto.RegisterMapType((map[string]float64)(nil), "api.Queue.ResourceLimitsEntry")
-	proto.RegisterType((*Queue_Permissions)(nil), "api.Queue.Permissions")
-	proto.RegisterType((*Queue_Permissions_Subject)(nil), "api.Queue.Permissions.Subject")
-	proto.RegisterType((*PriorityClassResourceLimits)(nil), "api.PriorityClassResourceLimits")
-	proto.RegisterMapType((map[string]*PriorityClassPoolResourceLimits)(nil), "api.PriorityClassResourceLimits.MaximumResourceFractionByPoolEntry")
-	proto.RegisterMapType((map[string]float64)(nil), "api.PriorityClassResourceLimits.MaximumResourceFractionEntry")
-	proto.RegisterType((*PriorityClassPoolResourceLimits)(nil), "api.PriorityClassPoolResourceLimits")
-	proto.RegisterMapType((map[string]float64)(nil), "api.PriorityClassPoolResourceLimits.MaximumResourceFractionEntry")
-	proto.RegisterType((*QueueList)(nil), "api.QueueList")
-	proto.RegisterType((*CancellationResult)(nil), "api.CancellationResult")
-	proto.RegisterType((*PreemptionResult)(nil), "api.PreemptionResult")
-	proto.RegisterMapType((map[string]string)(nil), "api.PreemptionResult.PreemptionResultsEntry")
-	proto.RegisterType((*RetryPolicy)(nil), "api.RetryPolicy")
-	proto.RegisterType((*RetryRule)(nil), "api.RetryRule")
-	proto.RegisterType((*RetryMutation)(nil), "api.RetryMutation")
-	proto.RegisterType((*RetryAffinityMutation)(nil), "api.RetryAffinityMutation")
-	proto.RegisterType((*RetryResourceMutation)(nil), "api.RetryResourceMutation")
-	proto.RegisterType((*RetryResourceBump)(nil), "api.RetryResourceBump")
-	proto.RegisterType((*RetryPolicyGetRequest)(nil), "api.RetryPolicyGetRequest")
-	proto.RegisterType((*RetryPolicyDeleteRequest)(nil), "api.RetryPolicyDeleteRequest")
-	proto.RegisterType((*RetryPolicyListRequest)(nil), "api.RetryPolicyListRequest")
-	proto.RegisterType((*RetryPolicyList)(nil), "api.RetryPolicyList")
-	proto.RegisterType((*QueueGetRequest)(nil), "api.QueueGetRequest")
-	proto.RegisterType((*QueueCordonRequest)(nil), "api.QueueCordonRequest")
-	proto.RegisterType((*QueueUncordonRequest)(nil), "api.QueueUncordonRequest")
-	proto.RegisterType((*StreamingQueueGetRequest)(nil), "api.StreamingQueueGetRequest")
-	proto.RegisterType((*QueueDeleteRequest)(nil), "api.QueueDeleteRequest")
-	proto.RegisterType((*JobSetInfo)(nil), "api.JobSetInfo")
-	proto.RegisterType((*QueueUpdateResponse)(nil), "api.QueueUpdateResponse")
-	proto.RegisterType((*BatchQueueUpdateResponse)(nil), "api.BatchQueueUpdateResponse")
-	proto.RegisterType((*QueueCreateResponse)(nil), "api.QueueCreateResponse")
-	proto.RegisterType((*BatchQueueCreateResponse)(nil), "api.BatchQueueCreateResponse")
-	proto.RegisterType((*EndMarker)(nil), "api.EndMarker")
-	proto.RegisterType((*StreamingQueueMessage)(nil), "api.StreamingQueueMessage")
-	proto.RegisterType((*QueuePreemptRequest)(nil), "api.QueuePreemptRequest")
-	proto.RegisterType((*QueueCancelRequest)(nil), "api.QueueCancelRequest")
+//swagger:model
+type QueueDeleteRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func init() { proto.RegisterFile("pkg/api/submit.proto", fileDescriptor_e998bacb27df16c1) }
-
-var fileDescriptor_e998bacb27df16c1 = []byte{
-	// 3872 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0xcf, 0x6f, 0x23, 0x59,
-	0x5a, 0x29, 0x3b, 0x3f, 0xec, 0xcf, 0x71, 0xe2, 0xbc, 0x4e, 0xd2, 0x6e, 0xa7, 0x27, 0xce, 0xd4,
-	0xec, 0xf6, 0x66, 0xb2, 0xbd, 0xce, 0x4c, 0x86, 0x15, 0xdd, 0xc3, 0xb2, 0xbd, 0xb1, 0xe3, 0xee,
-	0x49, 0xa6, 0x3b, 0x9d, 0x76, 0x3a, 0xbb, 0x33, 0xa3, 0x85, 0xa2, 0xec, 0x7a, 0x49, 0x57, 0x62,
-	0x57, 0x79, 0xaa, 0xca, 0xe9, 0x09, 0xb0, 0x07, 0xd0, 0x4a, 0x48, 0x9c, 0x56, 0x70, 0x04, 0x01,
-	0x07, 0x0e, 0x68, 0x39, 0x81, 0xc4, 0x05, 0xf1, 0x07, 0x20, 0xb8, 0x2c, 0x82, 0x03, 0x5c, 0x2c,
-	0x34, 0xc3, 0x0f, 0xc9, 0x12, 0x07, 0x2e, 0x9c, 0x10, 0x42, 0xef, 0x7b, 0xaf, 0xaa, 0x5e, 0x95,
-	0xed, 0x24, 0x4e, 0x77, 0x66, 0x2e, 0x7b, 0x8b, 0xbf, 0xdf, 0xdf, 0x7b, 0xdf, 0xfb, 0x7e, 0xbc,
-	0x57, 0x81, 0xf9, 0xf6, 0xc9, 0xd1, 0xba, 0xde, 0x36, 0xd7, 0xdd, 0x4e, 0xbd, 0x65, 0x7a, 0xa5,
-	0xb6, 0x63, 0x7b, 0x36, 0x49, 0xea, 0x6d, 0xb3, 0xb0, 0x74, 0x64, 0xdb, 0x47, 0x4d, 0xba, 0x8e,
-	0xa0, 0x7a, 0xe7, 0x70, 0x9d, 0xb6, 0xda, 0xde, 0x19, 0xa7, 0x28, 0x14, 0xe3, 0x48, 0xcf, 0x6c,
-	0x51, 0xd7, 0xd3, 0x5b, 0x6d, 0x41, 0xa0, 0x9e, 0xdc, 0x73, 0x4b, 0xa6, 0x8d, 0xb2, 0x1b, 0xb6,
-	0x43, 0xd7, 0x4f, 0xdf, 0x5d, 0x3f, 0xa2, 0x16, 0x75, 0x74, 0x8f, 0x1a, 0x82, 0x66, 0x55, 0xa2,
-	0xb1, 0xa8, 0xf7, 0xd2, 0x76, 0x4e, 0x4c, 0xeb, 0x68, 0x10, 0xe5, 0x6d, 0xa1, 0x8e, 0x51, 0xea,
-	0x96, 0x65, 0x7b, 0xba, 0x67, 0xda, 0x96, 0x2b, 0xb0, 0x81, 0x13, 0x2f, 0xa8, 0xde, 0xf4, 0x5e,
-	0x70, 0xa8, 0xfa, 0x5f, 0x69, 0x98, 0xdf, 0xb1, 0xeb, 0xfb, 0xe8, 0x58, 0x8d, 0x7e, 0xda, 0xa1,
-	0xae, 0xb7, 0xed, 0xd1, 0x16, 0xd9, 0x80, 0x54, 0xdb, 0x31, 0x6d, 0xc7, 0xf4, 0xce, 0xf2, 0xca,
-	0x8a, 0xb2, 0xaa, 0x94, 0x17, 0x7b, 0xdd, 0x22, 0xf1, 0x61, 0x77, 0xed, 0x96, 0xe9, 0xa1, 0xaf,
-	0xb5, 0x80, 0x8e, 0x7c, 0x1b, 0xd2, 0x96, 0xde, 0xa2, 0x6e, 0x5b, 0x6f, 0xd0, 0x7c, 0x72, 0x45,
-	0x59, 0x4d, 0x97, 0x6f, 0xf6, 0xba, 0xc5, 0x1b, 0x01, 0x50, 0xe2, 0x0a, 0x29, 0xc9, 0x7b, 0x90,
-	0x6e, 0x34, 0x4d, 0x6a, 0x79, 0x9a, 0x69, 0xe4, 0x53, 0xc8, 0x86, 0xba, 0x38, 0x70, 0xdb, 0x90,
-	0x75, 0xf9, 0x30, 0xb2, 0x0f, 0x93, 0x4d, 0xbd, 0x4e, 0x9b, 0x6e, 0x7e, 0x7c, 0x25, 0xb9, 0x9a,
-	0xd9, 0xf8, 0x7a, 0x49, 0x6f, 0x9b, 0xa5, 0x41, 0xae, 0x94, 0x1e, 0x23, 0x5d, 0xd5, 0xf2, 0x9c,
-	0xb3, 0xf2, 0x7c, 0xaf, 0x5b, 0xcc, 0x71, 0x46, 0x49, 0xac, 0x10, 0x45, 0x8e, 0x20, 0x23, 0x2d,
-	0x5c, 0x7e, 0x02, 0x25, 0xaf, 0x0d, 0x97, 0xbc, 0x19, 0x12, 0x73, 0xf1, 0xb7, 0x7a, 0xdd, 0xe2,
-	0x82, 0x24, 0x42, 0xd2, 0x21, 0x4b, 0x26, 0xbf, 0xa3, 0xc0, 0xbc, 0x43, 0x3f, 0xed, 0x98, 0x0e,
-	0x35, 0x34, 0xcb, 0x36, 0xa8, 0x26, 0x9c, 0x99, 0x44, 0x95, 0xef, 0x0e, 0x57, 0x59, 0x13, 0x5c,
-	0xbb, 0xb6, 0x41, 0x65, 0xc7, 0xd4, 0x5e, 0xb7, 0x78, 0xdb, 0xe9, 0x43, 0x86, 0x06, 0xe4, 0x95,
-	0x1a, 0xe9, 0xc7, 0x93, 0xa7, 0x90, 0x6a, 0xdb, 0x86, 0xe6, 0xb6, 0x69, 0x23, 0x9f, 0x58, 0x51,
-	0x56, 0x33, 0x1b, 0x4b, 0x25, 0x1e, 0x71, 0x68, 0x03, 0x8b, 0xca, 0xd2, 0xe9, 0xbb, 0xa5, 0x3d,
-	0xdb, 0xd8, 0x6f, 0xd3, 0x06, 0xee, 0xe7, 0x5c, 0x9b, 0xff, 0x88, 0xc8, 0x9e, 0x12, 0x40, 0xb2,
-	0x07, 0x69, 0x5f, 0xa0, 0x9b, 0x9f, 0x42, 0x77, 0xce, 0x95, 0xc8, 0xc3, 0x8a, 0xff, 0x70, 0x23,
-	0x61, 0x25, 0x60, 0xa4, 0x02, 0x53, 0xa6, 0x75, 0xe4, 0x50, 0xd7, 0xcd, 0xa7, 0x51, 0x1e, 0x41,
-	0x41, 0xdb, 0x1c, 0x56, 0xb1, 0xad, 0x43, 0xf3, 0xa8, 0xbc, 0xc0, 0x0c, 0x13, 0x64, 0x92, 0x14,
-	0x9f, 0x93, 0x3c, 0x84, 0x94, 0x4b, 0x9d, 0x53, 0xb3, 0x41, 0xdd, 0x3c, 0x48, 0x52, 0xf6, 0x39,
-	0x50, 0x48, 0x41, 0x63, 0x7c, 0x3a, 0xd9, 0x18, 0x1f, 0xc6, 0x62, 0xdc, 0x6d, 0xbc, 0xa0, 0x46,
-	0xa7, 0x49, 0x9d, 0x7c, 0x26, 0x8c, 0xf1, 0x00, 0x28, 0xc7, 0x78, 0x00, 0x24, 0x0f, 0x21, 0x47,
-	0x3f, 0xf3, 0xa8, 0x63, 0xe9, 0x4d, 0xed, 0xd8, 0xae, 0x6b, 0x1d, 0xc7, 0xcc, 0x67, 0x91, 0xfb,
-	0x76, 0xaf, 0x5b, 0xcc, 0xfb, 0xb8, 0x1d, 0xbb, 0x7e, 0xe0, 0x98, 0x92, 0x88, 0x99, 0x28, 0xa6,
-	0xa0, 0x43, 0x46, 0xda, 0x75, 0xf2, 0x16, 0x24, 0x4f, 0x28, 0x3f, 0xa0, 0xe9, 0xf2, 0x5c, 0xaf,
-	0x5b, 0xcc, 0x9e, 0x50, 0xf9, 0x6c, 0x32, 0x2c, 0x79, 0x1b, 0x26, 0x4e, 0xf5, 0x66, 0x87, 0xe2,
-	0xfe, 0xa6, 0xcb, 0x37, 0x7a, 0xdd, 0xe2, 0x2c, 0x02, 0x24, 0x42, 0x4e, 0xf1, 0x7e, 0xe2, 0x9e,
-	0x52, 0x38, 0x84, 0x5c, 0x3c, 0xae, 0xaf, 0x45, 0x4f, 0x0b, 0x6e, 0x0e, 0x09, 0xe6, 0xeb, 0x50,
-	0xb7, 0x33, 0x9e, 0x9a, 0xce, 0x65, 0xd5, 0xff, 0x4e, 0x42, 0x36, 0x12, 0x38, 0xe4, 0x7d, 0x18,
-	0xf7, 0xce, 0xda, 0x14, 0x95, 0xcd, 0x6c, 0xe4, 0xe4, 0xd0, 0x7a, 0x7e, 0xd6, 0xa6, 0x98, 0x31,
-	0x66, 0x18, 0x45, 0x24, 0xdc, 0x91, 0x87, 0x99, 0xd0, 0xb6, 0x1d, 0xcf, 0xcd, 0x27, 0x56, 0x92,
-	0xab, 0x59, 0x6e, 0x02, 0x02, 0x64, 0x13, 0x10, 0x40, 0x7e, 0x2d, 0x9a, 0x5a, 0x92, 0x18, 0x82,
-	0x6f, 0xf5, 0x07, 0xf2, 0xd5, 0x73, 0xca, 0x7d, 0xc8, 0x78, 0x4d, 0x57, 0xa3, 0x96, 0x5e, 0x6f,
-	0x52, 0x23, 0x3f, 0xbe, 0xa2, 0xac, 0xa6, 0xca, 0xf9, 0x5e, 0xb7, 0x38, 0xef, 0xb1, 0x75, 0x45,
-	0xa8, 0xc4, 0x0b, 0x21, 0x14, 0x33, 0x30, 0x75, 0x3c, 0x8d, 0xe5, 0xe4, 0xfc, 0x84, 0x94, 0x81,
-	0xa9, 0xe3, 0xed, 0xea, 0x2d, 0x1a, 0xc9, 0xc0, 0x02, 0x46, 0x1e, 0x40, 0xb6, 0xe3, 0x52, 0xad,
-	0xd1, 0xec, 0xb8, 0x1e, 0x75, 0xb6, 0xf7, 0xf2, 0x93, 0xa8, 0xb1, 0xd0, 0xeb, 0x16, 0x17, 0x3b,
-	0x2e, 0xad, 0xf8, 0x70, 0x89, 0x79, 0x5a, 0x86, 0x7f, 0x59, 0x81, 0xa6, 0xfe, 0xa1, 0x02, 0xd9,
-	0xc8, 0x31, 0x27, 0xf7, 0x06, 0xec, 0xb9, 0xa0, 0xc0, 0x3d, 0x27, 0xfd, 0x7b, 0x3e, 0xfa, 0x8e,
-	0xdf, 0x81, 0x71, 0x5c, 0x4f, 0x5e, 0x08, 0x51, 0xa4, 0x15, 0x5d, 0x4b, 0xc4, 0xab, 0xff, 0xa2,
-	0x40, 0x2e, 0x9e, 0xea, 0x99, 0x9e, 0x4f, 0x3b, 0xb4, 0x43, 0xc5, 0x4a, 0xa0, 0x1e, 0x04, 0xc8,
-	0x7a, 0x10, 0x40, 0x7e, 0x01, 0x80, 0x65, 0x14, 0x97, 0x62, 0xfd, 0x4c, 0x84, 0xbb, 0x77, 0x6c,
-	0xd7, 0xf7, 0x69, 0xac, 0x7e, 0xfa, 0x30, 0x62, 0xc0, 0x1c, 0xe3, 0x72, 0xb8, 0x3e, 0x8d, 0x11,
-	0xf8, 0x51, 0x79, 0x6b, 0x68, 0xf5, 0x29, 0xbf, 0xd1, 0xeb, 0x16, 0x6f, 0x1d, 0xdb, 0x75, 0x09,
-	0x26, 0x7b, 0x3e, 0x1b, 0x43, 0xa9, 0xff, 0xa0, 0xc0, 0xdc, 0x8e, 0x5d, 0xdf, 0x73, 0x28, 0x23,
-	0xf8, 0xd2, 0x9c, 0xfb, 0x16, 0x4c, 0x31, 0x2e, 0xd3, 0xe0, 0x2e, 0xa5, 0x79, 0xd9, 0x3f, 0xb6,
-	0xeb, 0xdb, 0x46, 0xa4, 0xec, 0x73, 0x08, 0xb9, 0x0b, 0x93, 0x0e, 0xd5, 0x5d, 0xdb, 0xc2, 0x43,
-	0x23, 0xa8, 0x39, 0x44, 0xa6, 0xe6, 0x10, 0xf5, 0x7f, 0xf9, 0x7e, 0x55, 0x74, 0xab, 0x41, 0x9b,
-	0xbe, 0x4b, 0x6b, 0x30, 0xc9, 0x35, 0xca, 0x3e, 0xa1, 0x78, 0xd9, 0x27, 0x04, 0x5c, 0xd1, 0xa7,
-	0x60, 0xd1, 0x92, 0x17, 0x2e, 0x9a, 0xe4, 0xfe, 0xf8, 0x48, 0xee, 0x4f, 0x5c, 0xc2, 0xfd, 0x7f,
-	0x57, 0xe0, 0xc6, 0x0e, 0x1a, 0x15, 0x5d, 0x81, 0xa8, 0x57, 0xca, 0xa8, 0x5e, 0x25, 0x2e, 0xf4,
-	0xea, 0x01, 0x4c, 0x1e, 0x9a, 0x4d, 0x8f, 0x3a, 0xb8, 0x02, 0x99, 0x8d, 0xb9, 0x20, 0x4c, 0xa9,
-	0xf7, 0x10, 0x11, 0xdc, 0x72, 0x4e, 0x24, 0x5b, 0xce, 0x21, 0x23, 0x6e, 0xf3, 0x87, 0x30, 0x2d,
-	0xcb, 0x26, 0xbf, 0x04, 0x93, 0xae, 0xa7, 0x7b, 0xd4, 0xcd, 0x2b, 0x2b, 0xc9, 0xd5, 0x99, 0x8d,
-	0x6c, 0xa0, 0x9e, 0x41, 0xb9, 0x30, 0x4e, 0x20, 0x0b, 0xe3, 0x10, 0xf5, 0xcf, 0x66, 0x21, 0xb9,
-	0x63, 0xd7, 0xc9, 0x0a, 0x24, 0x82, 0xc5, 0xc9, 0xf5, 0xba, 0xc5, 0x69, 0x53, 0x5e, 0x96, 0x84,
-	0x69, 0x44, 0x9b, 0xe1, 0xec, 0x25, 0x9b, 0xe1, 0x6b, 0x8f, 0xa8, 0x48, 0x67, 0x3f, 0x75, 0xe9,
-	0xce, 0xbe, 0x1c, 0x34, 0xe9, 0xbc, 0x71, 0x9b, 0xf7, 0xd7, 0x6c, 0x84, 0x9e, 0xfc, 0xfb, 0xd1,
-	0xc2, 0x09, 0xd1, 0x14, 0x75, 0xf5, 0x72, 0x79, 0x3a, 0xa4, 0x03, 0xcf, 0xa0, 0x82, 0x95, 0x40,
-	0xc1, 0xeb, 0x6e, 0xb8, 0xdf, 0x86, 0x09, 0xfb, 0xa5, 0x45, 0x1d, 0x31, 0xe9, 0xe0, 0xaa, 0x23,
-	0x40, 0x5e, 0x75, 0x04, 0x10, 0x0a, 0x4b, 0xb8, 0xfc, 0x1a, 0xfe, 0x74, 0x5f, 0x98, 0x6d, 0xad,
-	0xe3, 0x52, 0x47, 0x3b, 0x72, 0xec, 0x4e, 0xdb, 0xcd, 0xcf, 0xe2, 0xd9, 0xbe, 0xd3, 0xeb, 0x16,
-	0x55, 0x24, 0x7b, 0xea, 0x53, 0x1d, 0xb8, 0xd4, 0x79, 0x84, 0x34, 0x92, 0xcc, 0xfc, 0x30, 0x1a,
-	0xf2, 0x63, 0x05, 0xee, 0x34, 0xec, 0x56, 0x9b, 0x35, 0x21, 0xd4, 0xd0, 0xce, 0x53, 0x79, 0x63,
-	0x45, 0x59, 0x9d, 0x2e, 0xbf, 0xd3, 0xeb, 0x16, 0xef, 0x86, 0x1c, 0xcf, 0x2e, 0x56, 0xae, 0x5e,
-	0x4c, 0x1d, 0x99, 0x38, 0xc7, 0x2f, 0x39, 0x71, 0xca, 0xd3, 0xcb, 0xc4, 0x6b, 0x9f, 0x5e, 0xa6,
-	0x5f, 0xc7, 0xf4, 0xf2, 0x27, 0x0a, 0xac, 0x88, 0x39, 0xc0, 0xb4, 0x8e, 0x34, 0x87, 0xba, 0x76,
-	0xc7, 0x69, 0x50, 0x4d, 0x84, 0x46, 0x8b, 0x5a, 0x9e, 0x9b, 0x5f, 0x40, 0xdb, 0x57, 0x07, 0x69,
-	0xaa, 0x09, 0x86, 0x9a, 0x44, 0x5f, 0xbe, 0xdb, 0xeb, 0x16, 0x57, 0x43, 0xa9, 0x83, 0x68, 0x24,
-	0x63, 0x96, 0xcf, 0xa7, 0x24, 0x1f, 0xc2, 0x54, 0xc3, 0xa1, 0xba, 0x47, 0x0d, 0xec, 0xe1, 0x32,
-	0x1b, 0x85, 0x12, 0xbf, 0x4a, 0x28, 0xf9, 0x37, 0x17, 0xa5, 0xe7, 0xfe, 0xcd, 0x05, 0x1f, 0xb4,
-	0x04, 0xb9, 0x3c, 0x68, 0x09, 0x90, 0x3c, 0xad, 0xcd, 0xbc, 0x96, 0x69, 0x2d, 0xf7, 0x0a, 0xd3,
-	0xda, 0x0f, 0x21, 0x73, 0x72, 0xcf, 0xd5, 0x7c, 0x83, 0xe6, 0x50, 0xd4, 0x9b, 0xf2, 0x32, 0x87,
-	0x57, 0x2a, 0x6c, 0xb1, 0x85, 0x95, 0xbc, 0x6d, 0x3e, 0xb9, 0xe7, 0x6e, 0xf7, 0x99, 0x08, 0x21,
-	0x94, 0xa5, 0x26, 0x26, 0x5d, 0x68, 0xcb, 0x93, 0xe1, 0xe1, 0x22, 0xec, 0x0e, 0xe4, 0x8a, 0xdf,
-	0x31, 0xb9, 0x02, 0x1a, 0x9d, 0x31, 0xe7, 0x2f, 0x3b, 0x63, 0xfe, 0x7c, 0x36, 0x7c, 0x85, 0xd9,
-	0x70, 0x31, 0x77, 0x73, 0x67, 0x3c, 0xb5, 0x9c, 0x2b, 0xaa, 0xff, 0xa1, 0xc0, 0xe2, 0x0e, 0x6b,
-	0x63, 0x45, 0x92, 0x31, 0x7f, 0x9d, 0xfa, 0x2d, 0x8e, 0xd4, 0x57, 0x29, 0x97, 0xe8, 0xab, 0xae,
-	0xbd, 0x2a, 0x7f, 0x07, 0xa6, 0x2d, 0xfa, 0x52, 0x8b, 0x65, 0x4d, 0x2c, 0x80, 0x16, 0x7d, 0xb9,
-	0xd7, 0x9f, 0x38, 0x33, 0x12, 0x58, 0xfd, 0xf3, 0x04, 0xdc, 0xec, 0x73, 0xd4, 0x6d, 0xdb, 0x96,
-	0x4b, 0xc9, 0x1f, 0x28, 0x90, 0x77, 0x42, 0x04, 0x6e, 0x37, 0x4b, 0x5d, 0x9d, 0xa6, 0xc7, 0x7d,
-	0xcf, 0x6c, 0xdc, 0xf7, 0x2b, 0xe4, 0x20, 0x01, 0xa5, 0x5a, 0x8c, 0xb9, 0xc6, 0x79, 0x79, 0xe9,
-	0xfc, 0x7a, 0xaf, 0x5b, 0x7c, 0xd3, 0x19, 0x4c, 0x21, 0x59, 0x7b, 0x73, 0x08, 0x49, 0xc1, 0x81,
-	0xdb, 0xe7, 0xc9, 0xbf, 0x96, 0x21, 0xd2, 0x82, 0x05, 0x69, 0x22, 0xe2, 0x5e, 0xe2, 0x45, 0xe9,
-	0x28, 0x9d, 0xff, 0xdb, 0x30, 0x41, 0x1d, 0xc7, 0x76, 0x64, 0x9d, 0x08, 0x90, 0x49, 0x11, 0xa0,
-	0xfe, 0x08, 0x07, 0xa7, 0xa8, 0x3e, 0xf2, 0x02, 0x08, 0x1f, 0xda, 0xf8, 0x6f, 0x31, 0xb5, 0xf1,
-	0xfd, 0x28, 0xc4, 0xa7, 0xb6, 0xd0, 0xc6, 0xf2, 0x72, 0xaf, 0x5b, 0x2c, 0xe0, 0x6c, 0x16, 0x02,
-	0xe5, 0x95, 0xce, 0xc5, 0x71, 0xea, 0xdf, 0x67, 0x60, 0x02, 0x2b, 0x75, 0x30, 0xc6, 0x2a, 0xe7,
-	0x8f, 0xb1, 0xa4, 0x0a, 0xb3, 0x7e, 0x20, 0x6a, 0x87, 0x7a, 0xc3, 0x13, 0x5e, 0x2a, 0xfc, 0x82,
-	0xcb, 0x47, 0x3d, 0x44, 0x8c, 0x7c, 0xc1, 0x15, 0xc5, 0x90, 0xfb, 0x90, 0xc1, 0x86, 0x83, 0xf7,
-	0x1f, 0x62, 0x7c, 0xc3, 0xb4, 0xc9, 0xc0, 0xbc, 0x6f, 0x90, 0xd3, 0x66, 0x08, 0x65, 0xc7, 0x01,
-	0xdb, 0x14, 0x9f, 0x97, 0xcf, 0x3e, 0x78, 0x1c, 0x10, 0xde, 0xc7, 0x9c, 0x91, 0xc0, 0xe4, 0x08,
-	0x66, 0x83, 0xda, 0xdc, 0x34, 0x5b, 0xa6, 0xe7, 0xdf, 0xff, 0x2e, 0xe3, 0xc2, 0xe2, 0x62, 0x04,
-	0xc5, 0xf8, 0x31, 0x12, 0xf0, 0x68, 0x66, 0x8b, 0x9b, 0x77, 0x22, 0x88, 0x48, 0x6f, 0x31, 0x13,
-	0xc5, 0x91, 0xbf, 0x52, 0xe0, 0x4e, 0x4c, 0x93, 0x56, 0x3f, 0x0b, 0x4e, 0xb1, 0xd6, 0x68, 0xea,
-	0xae, 0xcb, 0xaf, 0x62, 0xa6, 0xa4, 0xdb, 0xe0, 0x41, 0x06, 0x94, 0xcf, 0xfc, 0xd3, 0x5c, 0x61,
-	0x4c, 0xbb, 0x7a, 0x8b, 0x72, 0x9b, 0xd6, 0x7b, 0xdd, 0xe2, 0x37, 0x9d, 0x8b, 0x68, 0xa5, 0xa5,
-	0x78, 0xf3, 0x42, 0x62, 0xb2, 0x0f, 0x99, 0x36, 0x75, 0x5a, 0xa6, 0xeb, 0x62, 0x23, 0xce, 0x6f,
-	0xaa, 0x17, 0x25, 0xdb, 0xf6, 0x42, 0x2c, 0x5f, 0x75, 0x89, 0x5c, 0x5e, 0x75, 0x09, 0xcc, 0x9a,
-	0xbe, 0x86, 0xed, 0x18, 0xb6, 0x45, 0xf9, 0xd5, 0x7f, 0x4a, 0x4c, 0x3b, 0x02, 0x16, 0x99, 0x76,
-	0x04, 0x8c, 0x3c, 0x81, 0x39, 0xde, 0xab, 0x6b, 0x06, 0x6d, 0x3b, 0xb4, 0x81, 0x8d, 0x4b, 0x1a,
-	0x37, 0x7b, 0x85, 0x05, 0x3a, 0x47, 0x6e, 0x05, 0xb8, 0xc8, 0x6e, 0xe4, 0xe2, 0x58, 0xb2, 0x15,
-	0x0c, 0x29, 0xd0, 0xe7, 0xd2, 0xe5, 0xc7, 0x94, 0x32, 0xcc, 0x38, 0xd4, 0x73, 0xce, 0xb4, 0xb6,
-	0xdd, 0x34, 0x1b, 0x26, 0xe5, 0x83, 0x44, 0xba, 0xbc, 0xd4, 0xeb, 0x16, 0x6f, 0x22, 0x66, 0x4f,
-	0x20, 0x24, 0xe6, 0x6c, 0x04, 0x51, 0xf8, 0x4f, 0x05, 0x32, 0xd2, 0x22, 0x92, 0x1a, 0xa4, 0xdc,
-	0x4e, 0xfd, 0x98, 0x36, 0x82, 0xa4, 0xbb, 0x3c, 0x78, 0xb9, 0x4b, 0xfb, 0x9c, 0x4c, 0x74, 0x44,
-	0x82, 0x27, 0xd2, 0x11, 0x09, 0x18, 0xa6, 0x3d, 0xea, 0xd4, 0xf9, 0x05, 0x96, 0x9f, 0xf6, 0x18,
-	0x20, 0x92, 0xf6, 0x18, 0xa0, 0xf0, 0x31, 0x4c, 0x09, 0xb9, 0x2c, 0x09, 0x9c, 0x98, 0x96, 0x21,
-	0x27, 0x01, 0xf6, 0x5b, 0x4e, 0x02, 0xec, 0x77, 0x90, 0x2c, 0x12, 0xe7, 0x27, 0x8b, 0x82, 0x09,
-	0x37, 0x06, 0x1c, 0xa5, 0x2b, 0x24, 0x6e, 0xe5, 0xc2, 0x56, 0xe2, 0x8f, 0x14, 0xb8, 0x73, 0xb9,
-	0x53, 0x73, 0x39, 0xf5, 0x1f, 0xca, 0xea, 0xfd, 0x41, 0x31, 0x22, 0x30, 0xa6, 0xed, 0x22, 0x03,
-	0xaf, 0xbf, 0x6d, 0x53, 0x7f, 0x6f, 0x02, 0x96, 0xce, 0x31, 0x91, 0xcd, 0x28, 0xb7, 0x5a, 0xfa,
-	0x67, 0x66, 0xab, 0xd3, 0x0a, 0x07, 0x94, 0x43, 0x47, 0x6f, 0xb0, 0xd2, 0x2a, 0x42, 0xef, 0x97,
-	0x2f, 0x72, 0xb4, 0xf4, 0x84, 0x4b, 0xf0, 0xa1, 0x0f, 0x05, 0xbf, 0x54, 0xf3, 0x5b, 0x83, 0x29,
-	0xe4, 0x9a, 0x3f, 0x84, 0x84, 0xfc, 0xb5, 0x02, 0x6f, 0x0e, 0x35, 0x11, 0xf3, 0xa7, 0x6d, 0x37,
-	0x31, 0xa8, 0x33, 0x1b, 0x95, 0xab, 0x9a, 0x5a, 0x3e, 0xdb, 0xb3, 0xed, 0x26, 0x37, 0xf8, 0x9b,
-	0xbd, 0x6e, 0xf1, 0x1b, 0xad, 0xf3, 0xe8, 0x24, 0xb3, 0xdf, 0x38, 0x97, 0x90, 0x35, 0x2c, 0xe7,
-	0x2d, 0xce, 0x75, 0xc5, 0xbd, 0x7a, 0xb1, 0x9b, 0x97, 0x53, 0xfd, 0x34, 0x1a, 0xf3, 0x5f, 0xeb,
-	0x5f, 0x5f, 0x26, 0x70, 0xb4, 0xb8, 0x57, 0xff, 0x26, 0x01, 0xc5, 0x0b, 0x64, 0x90, 0x3f, 0xbd,
-	0x44, 0x60, 0x6e, 0x5e, 0xc6, 0x9a, 0x6b, 0x0d, 0xce, 0xaf, 0x62, 0x7f, 0xd5, 0x2a, 0xa4, 0xb1,
-	0x0e, 0x3c, 0x36, 0x5d, 0x8f, 0xdc, 0x83, 0x49, 0x1c, 0x09, 0xfc, 0x3a, 0x01, 0x61, 0x9d, 0xe0,
-	0x75, 0x8b, 0x63, 0xe5, 0xba, 0xc5, 0x21, 0xea, 0x01, 0x10, 0x7e, 0x8f, 0xdb, 0x94, 0xfa, 0x68,
-	0xf2, 0x00, 0xb2, 0x0d, 0x0e, 0xa5, 0x86, 0x34, 0xef, 0xe0, 0xdb, 0x4e, 0x80, 0x88, 0x4e, 0x3d,
-	0xd3, 0x32, 0x5c, 0xfd, 0x3f, 0x05, 0x72, 0xe2, 0xd6, 0x3f, 0x94, 0xfa, 0x9b, 0x40, 0xda, 0x01,
-	0x2c, 0x36, 0x4e, 0xdc, 0x15, 0xbb, 0x18, 0x65, 0xe9, 0x03, 0x88, 0x5a, 0x5c, 0xec, 0x75, 0x8b,
-	0x4b, 0xed, 0x38, 0x4e, 0xb2, 0x66, 0xae, 0x0f, 0x59, 0x68, 0xc2, 0xe2, 0x60, 0x69, 0xd7, 0x92,
-	0x72, 0x7f, 0x2b, 0x01, 0x99, 0x5a, 0x50, 0xdd, 0xcf, 0x2e, 0xdd, 0x46, 0xdf, 0x87, 0x0c, 0xef,
-	0x23, 0xb0, 0x33, 0x44, 0x65, 0x59, 0xde, 0xff, 0x22, 0x18, 0xa3, 0x59, 0xee, 0x7f, 0x43, 0x28,
-	0x79, 0x0e, 0x33, 0x06, 0x3d, 0xd4, 0x3b, 0x4d, 0x4f, 0x13, 0x07, 0x24, 0x29, 0xbd, 0x6f, 0xa1,
-	0x31, 0x9b, 0xfc, 0xfc, 0x63, 0x53, 0x22, 0x68, 0x37, 0xe3, 0x51, 0x9e, 0x8d, 0x20, 0xc8, 0x7d,
-	0x98, 0x70, 0x3a, 0x4d, 0xea, 0x7f, 0x67, 0x31, 0x13, 0x0a, 0xab, 0x75, 0x9a, 0x94, 0xaf, 0x03,
-	0x12, 0xc8, 0xeb, 0x80, 0x00, 0xf5, 0x9f, 0x12, 0x90, 0x0e, 0x28, 0xc9, 0x77, 0x61, 0x32, 0x38,
-	0xb7, 0x83, 0xcd, 0xc2, 0x48, 0xed, 0x3b, 0x75, 0x82, 0x8b, 0xad, 0x8c, 0x6d, 0x69, 0xac, 0x67,
-	0x3b, 0xb2, 0x9d, 0x33, 0xf1, 0x56, 0x81, 0x2b, 0x63, 0x5b, 0x15, 0x01, 0x95, 0x57, 0x26, 0x84,
-	0xb2, 0xe6, 0xcc, 0xb6, 0x34, 0xb7, 0x53, 0x0f, 0xb8, 0x27, 0x91, 0x1b, 0xd7, 0xc1, 0xb6, 0xf6,
-	0x43, 0x84, 0xbc, 0x0e, 0x11, 0x04, 0xf9, 0x1e, 0x4c, 0xb6, 0x3a, 0x9e, 0xee, 0xf1, 0xfb, 0x6f,
-	0xff, 0x42, 0x0a, 0xcd, 0x7f, 0xd2, 0xe1, 0x17, 0x22, 0xdc, 0x01, 0x4e, 0x25, 0x3b, 0xc0, 0x21,
-	0x3b, 0xe3, 0xa9, 0x44, 0x2e, 0xb9, 0x33, 0x9e, 0x4a, 0xe6, 0xc6, 0x77, 0xc6, 0x53, 0xe3, 0xb9,
-	0x09, 0xa6, 0x42, 0x6b, 0xd8, 0x96, 0x61, 0xe2, 0x75, 0x0a, 0xfe, 0xa4, 0x9f, 0x99, 0x9e, 0xd6,
-	0xb0, 0x0d, 0xea, 0xd6, 0x16, 0x6d, 0x4b, 0xf3, 0x58, 0x93, 0x67, 0xf1, 0xf9, 0xbb, 0x45, 0x5d,
-	0x57, 0x3f, 0xa2, 0xea, 0x5f, 0x2a, 0x90, 0x8d, 0xe8, 0x25, 0xbb, 0x90, 0xd2, 0x0f, 0x0f, 0x4d,
-	0xcb, 0xff, 0x58, 0xc7, 0x9f, 0x06, 0xf9, 0xe2, 0x0a, 0x4c, 0x60, 0x25, 0x36, 0x89, 0x3e, 0xbd,
-	0xdc, 0x24, 0xfa, 0x30, 0xf2, 0x0c, 0xd2, 0x7e, 0xb6, 0x75, 0x45, 0xce, 0x97, 0x04, 0xfa, 0x39,
-	0x2e, 0x10, 0x88, 0x97, 0x53, 0x01, 0x83, 0x7c, 0x39, 0x15, 0x00, 0xd5, 0x1f, 0xc2, 0xc2, 0x40,
-	0x6b, 0x48, 0x05, 0x66, 0xf5, 0x53, 0xdb, 0x34, 0x34, 0x57, 0x6f, 0x51, 0xbc, 0x89, 0x47, 0x17,
-	0x52, 0x7c, 0x73, 0x10, 0xb5, 0xaf, 0xb7, 0xe8, 0xae, 0x6d, 0xc8, 0x6b, 0x9a, 0x8d, 0x20, 0xd4,
-	0x5f, 0x11, 0xd2, 0xe3, 0xa6, 0xb1, 0xe6, 0xbe, 0x45, 0x5b, 0x6c, 0xc7, 0xf9, 0xba, 0x2c, 0xf6,
-	0xbb, 0x51, 0xee, 0xb4, 0xda, 0x62, 0xe7, 0x90, 0x32, 0xb2, 0x73, 0x08, 0x51, 0x6d, 0x98, 0xeb,
-	0x63, 0x21, 0x77, 0xf9, 0x83, 0x90, 0xd9, 0x10, 0x67, 0x3a, 0x78, 0x01, 0x32, 0x1b, 0xf1, 0x17,
-	0x20, 0xb3, 0xc1, 0xa8, 0x23, 0x53, 0x31, 0x7f, 0xaa, 0x8a, 0x4f, 0xc3, 0x82, 0x46, 0x7d, 0x20,
-	0xfc, 0xe1, 0xc9, 0xe3, 0x11, 0x0d, 0x9e, 0x4e, 0x2f, 0x99, 0x46, 0xd4, 0x32, 0xe4, 0x25, 0x01,
-	0x5b, 0xb4, 0x49, 0x3d, 0x3a, 0xaa, 0x8c, 0x3c, 0x2c, 0x4a, 0x32, 0x58, 0x9d, 0x11, 0x12, 0xd4,
-	0x23, 0x98, 0x8d, 0x61, 0x58, 0xf2, 0x89, 0xcd, 0x3f, 0x3c, 0xaf, 0x4b, 0xa7, 0x9c, 0x53, 0x8f,
-	0x32, 0x11, 0xa9, 0xf7, 0x61, 0x16, 0x8b, 0xd8, 0x15, 0x56, 0xe0, 0x3b, 0x40, 0x90, 0xb5, 0x82,
-	0x63, 0xe3, 0xa8, 0xdc, 0xdf, 0x85, 0x79, 0xe4, 0x3e, 0xb0, 0x1a, 0x57, 0xe2, 0x7f, 0x00, 0xf9,
-	0x7d, 0xcf, 0xa1, 0x7a, 0xcb, 0xb4, 0x8e, 0xe2, 0x1e, 0xbc, 0x05, 0x49, 0xab, 0xd3, 0x42, 0x11,
-	0x59, 0x5e, 0x6e, 0xac, 0x4e, 0x4b, 0x2e, 0x37, 0x56, 0xa7, 0x15, 0x98, 0x7f, 0xb5, 0xad, 0xfb,
-	0xa9, 0x02, 0xc0, 0x5f, 0x2f, 0xb7, 0xad, 0x43, 0x7b, 0x94, 0xe2, 0x83, 0x6d, 0x81, 0xa1, 0x1d,
-	0xdb, 0x75, 0x7e, 0xf2, 0x27, 0x78, 0x8a, 0xe5, 0xe0, 0x1d, 0x3b, 0x32, 0x27, 0x42, 0x08, 0x65,
-	0xac, 0x4d, 0xaa, 0xbb, 0x3e, 0x6b, 0x32, 0x64, 0xe5, 0xe0, 0x38, 0x6b, 0x08, 0x55, 0x5f, 0xc2,
-	0x0d, 0xbe, 0xd6, 0x6d, 0x43, 0xf7, 0xc2, 0x3b, 0xc8, 0x6f, 0xcb, 0x5f, 0x09, 0x44, 0x5b, 0x9a,
-	0xf3, 0x2e, 0x45, 0x47, 0xb8, 0x63, 0xeb, 0x40, 0xbe, 0xac, 0x7b, 0x8d, 0x17, 0x83, 0xb4, 0x7f,
-	0x0c, 0xd9, 0x43, 0xdd, 0x6c, 0xfa, 0xef, 0x61, 0x7e, 0x38, 0xe7, 0x43, 0x2b, 0xa2, 0x0c, 0xbc,
-	0x37, 0xe2, 0x2c, 0xcf, 0xe2, 0xcd, 0xd6, 0xb4, 0x0c, 0x0f, 0xfc, 0xad, 0xe0, 0x8b, 0xc9, 0x57,
-	0xe5, 0x6f, 0x4c, 0xfb, 0xc5, 0xfe, 0x46, 0x19, 0x46, 0xf0, 0x37, 0x03, 0xe9, 0xaa, 0x65, 0x3c,
-	0xd1, 0x9d, 0x13, 0xea, 0xa8, 0x3f, 0x51, 0x60, 0x21, 0x7a, 0x32, 0x9e, 0xf0, 0xb2, 0x46, 0x7e,
-	0x71, 0x34, 0xff, 0x3f, 0x18, 0x0b, 0x1f, 0xa7, 0x93, 0xd4, 0x32, 0x44, 0x9d, 0xe2, 0xfd, 0x49,
-	0xa0, 0x8f, 0x9f, 0x2f, 0x2a, 0x5f, 0x55, 0x7c, 0x30, 0x56, 0x63, 0xf4, 0xe5, 0x29, 0x98, 0xa0,
-	0xa7, 0xd4, 0xf2, 0xd4, 0xbf, 0x50, 0xc4, 0x86, 0xc4, 0x3e, 0x53, 0xb9, 0xec, 0xa9, 0x79, 0x14,
-	0xde, 0x7c, 0xe2, 0xf4, 0x41, 0xfd, 0xcb, 0x15, 0xfc, 0x5a, 0x26, 0x86, 0x92, 0xbf, 0x96, 0x89,
-	0xa1, 0xf8, 0xc7, 0x45, 0x76, 0xd3, 0xbf, 0xf5, 0x14, 0x1f, 0x17, 0xd9, 0xcd, 0xd8, 0xc7, 0x45,
-	0x76, 0xd3, 0x55, 0xff, 0x47, 0xf1, 0xd3, 0x5b, 0xe4, 0x23, 0x8c, 0x2f, 0xdd, 0xe4, 0x2d, 0x48,
-	0x1f, 0x8b, 0x4f, 0x20, 0xb8, 0xd9, 0x7d, 0x1f, 0x46, 0x60, 0x73, 0x10, 0xd0, 0xc8, 0xcd, 0x41,
-	0x00, 0x0c, 0x1d, 0x1f, 0xbf, 0xc8, 0xf1, 0xb5, 0x02, 0x64, 0xa4, 0xaf, 0xf3, 0x48, 0x06, 0xa6,
-	0xc4, 0xcf, 0xdc, 0xd8, 0xda, 0xdb, 0x90, 0x91, 0xbe, 0xe2, 0x22, 0xd3, 0x90, 0x62, 0xcd, 0xc1,
-	0x9e, 0xed, 0x78, 0xb9, 0x31, 0xf6, 0xeb, 0x03, 0xaa, 0x1b, 0x4d, 0x46, 0xaa, 0xac, 0xfd, 0xb1,
-	0x02, 0x29, 0xdf, 0x44, 0x02, 0x30, 0xf9, 0xec, 0xa0, 0x7a, 0x50, 0xdd, 0xca, 0x8d, 0x31, 0x81,
-	0x7b, 0xd5, 0xdd, 0xad, 0xed, 0xdd, 0x47, 0x39, 0x85, 0xfd, 0xa8, 0x1d, 0xec, 0xee, 0xb2, 0x1f,
-	0x09, 0x92, 0x85, 0xf4, 0xfe, 0x41, 0xa5, 0x52, 0xad, 0x6e, 0x55, 0xb7, 0x72, 0x49, 0xc6, 0xf4,
-	0x70, 0x73, 0xfb, 0x71, 0x75, 0x2b, 0x37, 0xce, 0xe8, 0x0e, 0x76, 0x3f, 0xdc, 0x7d, 0xfa, 0x83,
-	0xdd, 0xdc, 0x04, 0xa7, 0x2b, 0x3f, 0xd9, 0x7e, 0xfe, 0xbc, 0xba, 0x95, 0x9b, 0x64, 0x74, 0x8f,
-	0xab, 0x9b, 0xfb, 0xd5, 0xad, 0xdc, 0x14, 0x43, 0xed, 0xd5, 0xaa, 0xd5, 0x27, 0x7b, 0x0c, 0x95,
-	0x62, 0x3f, 0x2b, 0x9b, 0xbb, 0x95, 0xea, 0x63, 0x26, 0x25, 0xcd, 0x2c, 0xac, 0x55, 0x77, 0xaa,
-	0x15, 0x86, 0x84, 0xb5, 0x4f, 0xc4, 0xfc, 0x20, 0xda, 0xf0, 0xdb, 0x90, 0xaf, 0x55, 0x9f, 0xd7,
-	0x3e, 0xd6, 0x36, 0x2b, 0xcf, 0xb7, 0x9f, 0xee, 0x6a, 0x07, 0xbb, 0xfb, 0x7b, 0xd5, 0xca, 0xf6,
-	0xc3, 0x6d, 0xb4, 0x7a, 0x01, 0xe6, 0x22, 0x58, 0x66, 0x59, 0x4e, 0x21, 0x8b, 0x40, 0x22, 0x60,
-	0xfc, 0x91, 0x4b, 0x6c, 0xfc, 0xdd, 0x04, 0x4c, 0x63, 0xf4, 0xf8, 0x2f, 0x8e, 0xef, 0x41, 0x86,
-	0x1f, 0x6f, 0x7e, 0xe7, 0x2f, 0x9d, 0xbd, 0xc2, 0x62, 0xdf, 0x5b, 0x70, 0x95, 0xed, 0x87, 0x3a,
-	0x46, 0x1e, 0xc0, 0xb4, 0xc4, 0xe4, 0x92, 0x99, 0x90, 0x8b, 0xb5, 0x04, 0x85, 0x37, 0xf0, 0xf7,
-	0xb0, 0x8c, 0xa3, 0x8e, 0x31, 0xad, 0x3c, 0x89, 0x8e, 0xa8, 0x55, 0x62, 0xba, 0x58, 0x6b, 0x34,
-	0x4d, 0xab, 0x63, 0xe4, 0x7b, 0x90, 0xe1, 0x45, 0x95, 0x6b, 0xbd, 0x19, 0xf2, 0x47, 0x6a, 0xed,
-	0x39, 0x26, 0x94, 0x20, 0xf5, 0x88, 0x7a, 0x9c, 0x7d, 0x3e, 0x64, 0x0f, 0x4b, 0x7c, 0x41, 0x72,
-	0x45, 0x1d, 0x23, 0x3b, 0x90, 0xf6, 0xe9, 0x5d, 0xc2, 0xed, 0x1b, 0xd6, 0x1c, 0x14, 0x0a, 0x03,
-	0xd0, 0x22, 0x43, 0xaa, 0x63, 0xef, 0x28, 0xcc, 0x7a, 0xde, 0xd1, 0xf4, 0x59, 0x1f, 0x69, 0x74,
-	0xce, 0xb1, 0x7e, 0x0b, 0xb2, 0x7e, 0x57, 0xc3, 0x65, 0xdc, 0x92, 0x6a, 0x5a, 0xb4, 0xdd, 0x39,
-	0x57, 0xca, 0x8c, 0x48, 0x97, 0x4f, 0x85, 0x18, 0xa9, 0x54, 0x44, 0x13, 0xe9, 0x39, 0x52, 0xca,
-	0x90, 0xe5, 0x09, 0xec, 0xe9, 0x00, 0x7f, 0xe4, 0xcc, 0x36, 0x5c, 0xc6, 0xc6, 0x8f, 0xc7, 0x81,
-	0x48, 0xfd, 0xa5, 0x1f, 0xd2, 0x9f, 0xc0, 0x9c, 0x1f, 0x70, 0xe1, 0x14, 0xde, 0xd7, 0x8d, 0x0e,
-	0x95, 0xbb, 0xf4, 0xdb, 0xff, 0xf8, 0x6f, 0xbf, 0x9f, 0x58, 0x50, 0x73, 0xeb, 0xa7, 0xef, 0xae,
-	0x63, 0x53, 0xfa, 0x2d, 0xec, 0x6c, 0xcf, 0xde, 0x57, 0xd6, 0x88, 0x0e, 0x73, 0x7e, 0x58, 0x5d,
-	0x45, 0xb6, 0x8a, 0xb2, 0x6f, 0x17, 0x6e, 0xc6, 0x65, 0xaf, 0xff, 0x06, 0xcb, 0xce, 0x3f, 0x62,
-	0x2a, 0x4e, 0x60, 0xce, 0x0f, 0xc7, 0x50, 0xc5, 0x1b, 0x71, 0x15, 0x97, 0x8b, 0xd8, 0x22, 0xea,
-	0xbb, 0xb5, 0x36, 0x4c, 0x1f, 0xd1, 0x60, 0x06, 0x43, 0x30, 0xd4, 0x54, 0x88, 0x6b, 0x92, 0x42,
-	0xb4, 0xcf, 0x51, 0x5f, 0x01, 0x19, 0xaa, 0x40, 0x87, 0x5c, 0x44, 0x81, 0x49, 0x5d, 0xb2, 0x14,
-	0x17, 0x23, 0x4d, 0x18, 0x85, 0xf9, 0x41, 0x48, 0xb5, 0x80, 0x7a, 0xe6, 0x09, 0x89, 0xe9, 0x31,
-	0xa9, 0xbb, 0xf1, 0xbb, 0x69, 0x98, 0xe4, 0x4f, 0x9f, 0xe4, 0xfb, 0x00, 0xfc, 0x2f, 0xec, 0x4c,
-	0x17, 0x06, 0x7e, 0xce, 0x5a, 0x58, 0x1c, 0xfc, 0x5e, 0xaa, 0xde, 0x42, 0x1d, 0x37, 0xd4, 0x19,
-	0xa6, 0xe3, 0xd8, 0xae, 0x8b, 0x7f, 0xfa, 0x61, 0x7b, 0xf2, 0x03, 0x00, 0x1e, 0x94, 0x51, 0xb9,
-	0xd1, 0x40, 0xe5, 0x11, 0xdc, 0x7f, 0xa7, 0xd6, 0x2f, 0x98, 0x5f, 0x98, 0x31, 0xc1, 0xbf, 0x0a,
-	0xd3, 0x81, 0xe0, 0x7d, 0xea, 0x89, 0xa3, 0x34, 0xe0, 0x2b, 0xcb, 0xa1, 0x5b, 0x7c, 0x1b, 0x85,
-	0x2f, 0xaa, 0x73, 0x42, 0xb8, 0x4b, 0x3d, 0x49, 0xbe, 0x05, 0x39, 0xf9, 0x95, 0x1e, 0xcd, 0x5f,
-	0x1a, 0xfc, 0x7e, 0xcf, 0xd5, 0xdc, 0x3e, 0xef, 0x71, 0xdf, 0xdf, 0x6e, 0x75, 0xde, 0xf7, 0x44,
-	0x7a, 0xa8, 0xa7, 0x4c, 0xdf, 0x47, 0x90, 0x11, 0x29, 0x00, 0x55, 0x05, 0x4b, 0x1d, 0xcb, 0x0b,
-	0x0b, 0x03, 0xef, 0xfc, 0xfc, 0x5d, 0x56, 0x67, 0x7d, 0xf1, 0xe2, 0x2e, 0x8f, 0x49, 0x7e, 0x34,
-	0x7a, 0xa1, 0x9a, 0x47, 0x71, 0x33, 0x6a, 0x9a, 0x89, 0xc3, 0x8e, 0x91, 0x09, 0x6a, 0xbc, 0x5a,
-	0xf1, 0xfa, 0x1a, 0x0a, 0x5d, 0x56, 0x6f, 0x31, 0xa1, 0x75, 0x46, 0x45, 0x8d, 0x75, 0xfe, 0x09,
-	0x94, 0x68, 0xa0, 0x99, 0x92, 0xdd, 0xd1, 0x0b, 0x9c, 0xc8, 0x3b, 0x85, 0x5c, 0x60, 0xad, 0x94,
-	0x14, 0x1a, 0xaf, 0x56, 0xfb, 0x84, 0xd1, 0x85, 0x88, 0xd1, 0x1d, 0xa4, 0x91, 0x8c, 0xfe, 0xe8,
-	0x15, 0xeb, 0x63, 0x1e, 0xb5, 0x90, 0xb5, 0x3e, 0x0f, 0xc8, 0xc3, 0x91, 0xea, 0xa6, 0x90, 0x43,
-	0xfa, 0xe5, 0x18, 0xaf, 0xa9, 0x9e, 0x46, 0xd2, 0x89, 0xbf, 0x1e, 0x7c, 0x21, 0xde, 0x51, 0xc8,
-	0xfb, 0x30, 0xf9, 0x01, 0xfe, 0xab, 0x1c, 0x19, 0xe2, 0x69, 0x81, 0x1f, 0x53, 0x4e, 0x54, 0x79,
-	0x41, 0x1b, 0x27, 0xc1, 0x70, 0xf4, 0xd1, 0xdf, 0x7e, 0xbe, 0xac, 0xfc, 0xec, 0xf3, 0x65, 0xe5,
-	0x5f, 0x3f, 0x5f, 0x56, 0x7e, 0xf2, 0xc5, 0xf2, 0xd8, 0xcf, 0xbe, 0x58, 0x1e, 0xfb, 0xe7, 0x2f,
-	0x96, 0xc7, 0x3e, 0xf9, 0xc6, 0x91, 0xe9, 0xbd, 0xe8, 0xd4, 0x4b, 0x0d, 0xbb, 0xb5, 0xae, 0x3b,
-	0x2d, 0xdd, 0xd0, 0xdb, 0x8e, 0x7d, 0x4c, 0x1b, 0x9e, 0xf8, 0xb5, 0x2e, 0xfe, 0x4d, 0xef, 0xa7,
-	0x89, 0xf9, 0x4d, 0x04, 0xec, 0x71, 0x74, 0x69, 0xdb, 0x2e, 0x6d, 0xb6, 0xcd, 0xfa, 0x24, 0xda,
-	0xf0, 0xde, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x21, 0x60, 0xb8, 0x66, 0x94, 0x38, 0x00, 0x00,
+func (m *QueueDeleteRequest) Reset()         { *m = QueueDeleteRequest{} }
+func (m *QueueDeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueueDeleteRequest) ProtoMessage()    {}
+func (*QueueDeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{48}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueueServiceClient is the client API for QueueService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueueServiceClient interface {
-	CreateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error)
-	CreateQueues(ctx context.Context, in *QueueList, opts ...grpc.CallOption) (*BatchQueueCreateResponse, error)
-	UpdateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error)
-	UpdateQueues(ctx context.Context, in *QueueList, opts ...grpc.CallOption) (*BatchQueueUpdateResponse, error)
-	DeleteQueue(ctx context.Context, in *QueueDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	GetQueue(ctx context.Context, in *QueueGetRequest, opts ...grpc.CallOption) (*Queue, error)
-	GetQueues(ctx context.Context, in *StreamingQueueGetRequest, opts ...grpc.CallOption) (QueueService_GetQueuesClient, error)
-	CordonQueue(ctx context.Context, in *QueueCordonRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	UncordonQueue(ctx context.Context, in *QueueUncordonRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	PreemptOnQueue(ctx context.Context, in *QueuePreemptRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	CancelOnQueue(ctx context.Context, in *QueueCancelRequest, opts ...grpc.CallOption) (*types.Empty, error)
+func (m *QueueDeleteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queueServiceClient struct {
-	cc *grpc.ClientConn
+func (m *QueueDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueDeleteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueueServiceClient(cc *grpc.ClientConn) QueueServiceClient {
-	return &queueServiceClient{cc}
+func (m *QueueDeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueDeleteRequest.Merge(m, src)
 }
-
-func (c *queueServiceClient) CreateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.QueueService/CreateQueue", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueueDeleteRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queueServiceClient) CreateQueues(ctx context.Context, in *QueueList, opts ...grpc.CallOption) (*BatchQueueCreateResponse, error) {
-	out := new(BatchQueueCreateResponse)
-	err := c.cc.Invoke(ctx, "/api.QueueService/CreateQueues", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueueDeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueDeleteRequest.DiscardUnknown(m)
 }
 
-func (c *queueServiceClient) UpdateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.QueueService/UpdateQueue", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueueDeleteRequest proto.InternalMessageInfo
 
-func (c *queueServiceClient) UpdateQueues(ctx context.Context, in *QueueList, opts ...grpc.CallOption) (*BatchQueueUpdateResponse, error) {
-	out := new(BatchQueueUpdateResponse)
-	err := c.cc.Invoke(ctx, "/api.QueueService/UpdateQueues", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueueDeleteRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queueServiceClient) DeleteQueue(ctx context.Context, in *QueueDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.QueueService/DeleteQueue", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type JobSetInfo struct {
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	QueuedJobs int32  `protobuf:"varint,2,opt,name=queued_jobs,json=queuedJobs,proto3" json:"queuedJobs,omitempty"`
+	LeasedJobs int32  `protobuf:"varint,3,opt,name=leased_jobs,json=leasedJobs,proto3" json:"leasedJobs,omitempty"`
 }
 
-func (c *queueServiceClient) GetQueue(ctx context.Context, in *QueueGetRequest, opts ...grpc.CallOption) (*Queue, error) {
-	out := new(Queue)
-	err := c.cc.Invoke(ctx, "/api.QueueService/GetQueue", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *JobSetInfo) Reset()         { *m = JobSetInfo{} }
+func (m *JobSetInfo) String() string { return proto.CompactTextString(m) }
+func (*JobSetInfo) ProtoMessage()    {}
+func (*JobSetInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{49}
 }
-
-func (c *queueServiceClient) GetQueues(ctx context.Context, in *StreamingQueueGetRequest, opts ...grpc.CallOption) (QueueService_GetQueuesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_QueueService_serviceDesc.Streams[0], "/api.QueueService/GetQueues", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &queueServiceGetQueuesClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *JobSetInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobSetInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSetInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
 }
-
-type QueueService_GetQueuesClient interface {
-	Recv() (*StreamingQueueMessage, error)
-	grpc.ClientStream
+func (m *JobSetInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSetInfo.Merge(m, src)
 }
-
-type queueServiceGetQueuesClient struct {
-	grpc.ClientStream
+func (m *JobSetInfo) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *queueServiceGetQueuesClient) Recv() (*StreamingQueueMessage, error) {
-	m := new(StreamingQueueMessage)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *JobSetInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSetInfo.DiscardUnknown(m)
 }
 
-func (c *queueServiceClient) CordonQueue(ctx context.Context, in *QueueCordonRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.QueueService/CordonQueue", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_JobSetInfo proto.InternalMessageInfo
+
+func (m *JobSetInfo) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queueServiceClient) UncordonQueue(ctx context.Context, in *QueueUncordonRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.QueueService/UncordonQueue", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *JobSetInfo) GetQueuedJobs() int32 {
+	if m != nil {
+		return m.QueuedJobs
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queueServiceClient) PreemptOnQueue(ctx context.Context, in *QueuePreemptRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.QueueService/PreemptOnQueue", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *JobSetInfo) GetLeasedJobs() int32 {
+	if m != nil {
+		return m.LeasedJobs
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queueServiceClient) CancelOnQueue(ctx context.Context, in *QueueCancelRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.QueueService/CancelOnQueue", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueueUpdateResponse struct {
+	Queue *Queue `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-// QueueServiceServer is the server API for QueueService service.
-type QueueServiceServer interface {
-	CreateQueue(context.Context, *Queue) (*types.Empty, error)
-	CreateQueues(context.Context, *QueueList) (*BatchQueueCreateResponse, error)
-	UpdateQueue(context.Context, *Queue) (*types.Empty, error)
-	UpdateQueues(context.Context, *QueueList) (*BatchQueueUpdateResponse, error)
-	DeleteQueue(context.Context, *QueueDeleteRequest) (*types.Empty, error)
-	GetQueue(context.Context, *QueueGetRequest) (*Queue, error)
-	GetQueues(*StreamingQueueGetRequest, QueueService_GetQueuesServer) error
-	CordonQueue(context.Context, *QueueCordonRequest) (*types.Empty, error)
-	UncordonQueue(context.Context, *QueueUncordonRequest) (*types.Empty, error)
-	PreemptOnQueue(context.Context, *QueuePreemptRequest) (*types.Empty, error)
-	CancelOnQueue(context.Context, *QueueCancelRequest) (*types.Empty, error)
+func (m *QueueUpdateResponse) Reset()         { *m = QueueUpdateResponse{} }
+func (m *QueueUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueueUpdateResponse) ProtoMessage()    {}
+func (*QueueUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{50}
 }
-
-// UnimplementedQueueServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedQueueServiceServer struct {
+func (m *QueueUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*UnimplementedQueueServiceServer) CreateQueue(ctx context.Context, req *Queue) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateQueue not implemented")
+func (m *QueueUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueueServiceServer) CreateQueues(ctx context.Context, req *QueueList) (*BatchQueueCreateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateQueues not implemented")
+func (m *QueueUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueUpdateResponse.Merge(m, src)
 }
-func (*UnimplementedQueueServiceServer) UpdateQueue(ctx context.Context, req *Queue) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateQueue not implemented")
+func (m *QueueUpdateResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueueServiceServer) UpdateQueues(ctx context.Context, req *QueueList) (*BatchQueueUpdateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateQueues not implemented")
+func (m *QueueUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueUpdateResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueueServiceServer) DeleteQueue(ctx context.Context, req *QueueDeleteRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteQueue not implemented")
+
+var xxx_messageInfo_QueueUpdateResponse proto.InternalMessageInfo
+
+func (m *QueueUpdateResponse) GetQueue() *Queue {
+	if m != nil {
+		return m.Queue
+	}
+	return nil
 }
-func (*UnimplementedQueueServiceServer) GetQueue(ctx context.Context, req *QueueGetRequest) (*Queue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetQueue not implemented")
+
+func (m *QueueUpdateResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
-func (*UnimplementedQueueServiceServer) GetQueues(req *StreamingQueueGetRequest, srv QueueService_GetQueuesServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetQueues not implemented")
+
+type BatchQueueUpdateResponse struct {
+	FailedQueues []*QueueUpdateResponse `protobuf:"bytes,1,rep,name=failed_queues,json=failedQueues,proto3" json:"failedQueues,omitempty"`
 }
-func (*UnimplementedQueueServiceServer) CordonQueue(ctx context.Context, req *QueueCordonRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CordonQueue not implemented")
+
+func (m *BatchQueueUpdateResponse) Reset()         { *m = BatchQueueUpdateResponse{} }
+func (m *BatchQueueUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchQueueUpdateResponse) ProtoMessage()    {}
+func (*BatchQueueUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{51}
 }
-func (*UnimplementedQueueServiceServer) UncordonQueue(ctx context.Context, req *QueueUncordonRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UncordonQueue not implemented")
+func (m *BatchQueueUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueueServiceServer) PreemptOnQueue(ctx context.Context, req *QueuePreemptRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PreemptOnQueue not implemented")
+func (m *BatchQueueUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchQueueUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueueServiceServer) CancelOnQueue(ctx context.Context, req *QueueCancelRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelOnQueue not implemented")
+func (m *BatchQueueUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchQueueUpdateResponse.Merge(m, src)
 }
-
-func RegisterQueueServiceServer(s *grpc.Server, srv QueueServiceServer) {
-	s.RegisterService(&_QueueService_serviceDesc, srv)
+func (m *BatchQueueUpdateResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func _QueueService_CreateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Queue)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueueServiceServer).CreateQueue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.QueueService/CreateQueue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServiceServer).CreateQueue(ctx, req.(*Queue))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *BatchQueueUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchQueueUpdateResponse.DiscardUnknown(m)
 }
 
-func _QueueService_CreateQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueList)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueueServiceServer).CreateQueues(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.QueueService/CreateQueues",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServiceServer).CreateQueues(ctx, req.(*QueueList))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_BatchQueueUpdateResponse proto.InternalMessageInfo
 
-func _QueueService_UpdateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Queue)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueueServiceServer).UpdateQueue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.QueueService/UpdateQueue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServiceServer).UpdateQueue(ctx, req.(*Queue))
+func (m *BatchQueueUpdateResponse) GetFailedQueues() []*QueueUpdateResponse {
+	if m != nil {
+		return m.FailedQueues
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _QueueService_UpdateQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueList)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueueServiceServer).UpdateQueues(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.QueueService/UpdateQueues",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServiceServer).UpdateQueues(ctx, req.(*QueueList))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueueCreateResponse struct {
+	Queue *Queue `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func _QueueService_DeleteQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueDeleteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueueServiceServer).DeleteQueue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.QueueService/DeleteQueue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServiceServer).DeleteQueue(ctx, req.(*QueueDeleteRequest))
+func (m *QueueCreateResponse) Reset()         { *m = QueueCreateResponse{} }
+func (m *QueueCreateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueueCreateResponse) ProtoMessage()    {}
+func (*QueueCreateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{52}
+}
+func (m *QueueCreateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueCreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueCreateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueueCreateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueCreateResponse.Merge(m, src)
+}
+func (m *QueueCreateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueCreateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueCreateResponse.DiscardUnknown(m)
 }
 
-func _QueueService_GetQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueGetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueueServiceServer).GetQueue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.QueueService/GetQueue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServiceServer).GetQueue(ctx, req.(*QueueGetRequest))
+var xxx_messageInfo_QueueCreateResponse proto.InternalMessageInfo
+
+func (m *QueueCreateResponse) GetQueue() *Queue {
+	if m != nil {
+		return m.Queue
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _QueueService_GetQueues_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StreamingQueueGetRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *QueueCreateResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return srv.(QueueServiceServer).GetQueues(m, &queueServiceGetQueuesServer{stream})
+	return ""
 }
 
-type QueueService_GetQueuesServer interface {
-	Send(*StreamingQueueMessage) error
-	grpc.ServerStream
+type BatchQueueCreateResponse struct {
+	FailedQueues []*QueueCreateResponse `protobuf:"bytes,1,rep,name=failed_queues,json=failedQueues,proto3" json:"failedQueues,omitempty"`
 }
 
-type queueServiceGetQueuesServer struct {
-	grpc.ServerStream
+func (m *BatchQueueCreateResponse) Reset()         { *m = BatchQueueCreateResponse{} }
+func (m *BatchQueueCreateResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchQueueCreateResponse) ProtoMessage()    {}
+func (*BatchQueueCreateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{53}
 }
-
-func (x *queueServiceGetQueuesServer) Send(m *StreamingQueueMessage) error {
-	return x.ServerStream.SendMsg(m)
+func (m *BatchQueueCreateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _QueueService_CordonQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueCordonRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueueServiceServer).CordonQueue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.QueueService/CordonQueue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServiceServer).CordonQueue(ctx, req.(*QueueCordonRequest))
+func (m *BatchQueueCreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchQueueCreateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _QueueService_UncordonQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueUncordonRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueueServiceServer).UncordonQueue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.QueueService/UncordonQueue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServiceServer).UncordonQueue(ctx, req.(*QueueUncordonRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *BatchQueueCreateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchQueueCreateResponse.Merge(m, src)
 }
-
-func _QueueService_PreemptOnQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueuePreemptRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueueServiceServer).PreemptOnQueue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.QueueService/PreemptOnQueue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServiceServer).PreemptOnQueue(ctx, req.(*QueuePreemptRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *BatchQueueCreateResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func _QueueService_CancelOnQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueCancelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueueServiceServer).CancelOnQueue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.QueueService/CancelOnQueue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServiceServer).CancelOnQueue(ctx, req.(*QueueCancelRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *BatchQueueCreateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchQueueCreateResponse.DiscardUnknown(m)
 }
 
-var _QueueService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "api.QueueService",
-	HandlerType: (*QueueServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateQueue",
-			Handler:    _QueueService_CreateQueue_Handler,
-		},
-		{
-			MethodName: "CreateQueues",
-			Handler:    _QueueService_CreateQueues_Handler,
-		},
-		{
-			MethodName: "UpdateQueue",
-			Handler:    _QueueService_UpdateQueue_Handler,
-		},
-		{
-			MethodName: "UpdateQueues",
-			Handler:    _QueueService_UpdateQueues_Handler,
-		},
-		{
-			MethodName: "DeleteQueue",
-			Handler:    _QueueService_DeleteQueue_Handler,
-		},
-		{
-			MethodName: "GetQueue",
-			Handler:    _QueueService_GetQueue_Handler,
-		},
-		{
-			MethodName: "CordonQueue",
-			Handler:    _QueueService_CordonQueue_Handler,
-		},
-		{
-			MethodName: "UncordonQueue",
-			Handler:    _QueueService_UncordonQueue_Handler,
-		},
-		{
-			MethodName: "PreemptOnQueue",
-			Handler:    _QueueService_PreemptOnQueue_Handler,
-		},
-		{
-			MethodName: "CancelOnQueue",
-			Handler:    _QueueService_CancelOnQueue_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "GetQueues",
-			Handler:       _QueueService_GetQueues_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "pkg/api/submit.proto",
-}
+var xxx_messageInfo_BatchQueueCreateResponse proto.InternalMessageInfo
 
-// RetryPolicyServiceClient is the client API for RetryPolicyService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RetryPolicyServiceClient interface {
-	CreateRetryPolicy(ctx context.Context, in *RetryPolicy, opts ...grpc.CallOption) (*types.Empty, error)
-	UpdateRetryPolicy(ctx context.Context, in *RetryPolicy, opts ...grpc.CallOption) (*types.Empty, error)
-	DeleteRetryPolicy(ctx context.Context, in *RetryPolicyDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	GetRetryPolicy(ctx context.Context, in *RetryPolicyGetRequest, opts ...grpc.CallOption) (*RetryPolicy, error)
-	GetRetryPolicies(ctx context.Context, in *RetryPolicyListRequest, opts ...grpc.CallOption) (*RetryPolicyList, error)
+func (m *BatchQueueCreateResponse) GetFailedQueues() []*QueueCreateResponse {
+	if m != nil {
+		return m.FailedQueues
+	}
+	return nil
 }
 
-type retryPolicyServiceClient struct {
-	cc *grpc.ClientConn
+// Indicates the end of streams
+type EndMarker struct {
 }
 
-func NewRetryPolicyServiceClient(cc *grpc.ClientConn) RetryPolicyServiceClient {
-	return &retryPolicyServiceClient{cc}
+func (m *EndMarker) Reset()         { *m = EndMarker{} }
+func (m *EndMarker) String() string { return proto.CompactTextString(m) }
+func (*EndMarker) ProtoMessage()    {}
+func (*EndMarker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{54}
 }
-
-func (c *retryPolicyServiceClient) CreateRetryPolicy(ctx context.Context, in *RetryPolicy, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.RetryPolicyService/CreateRetryPolicy", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *EndMarker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *retryPolicyServiceClient) UpdateRetryPolicy(ctx context.Context, in *RetryPolicy, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.RetryPolicyService/UpdateRetryPolicy", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EndMarker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndMarker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *retryPolicyServiceClient) DeleteRetryPolicy(ctx context.Context, in *RetryPolicyDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.RetryPolicyService/DeleteRetryPolicy", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *EndMarker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndMarker.Merge(m, src)
 }
-
-func (c *retryPolicyServiceClient) GetRetryPolicy(ctx context.Context, in *RetryPolicyGetRequest, opts ...grpc.CallOption) (*RetryPolicy, error) {
-	out := new(RetryPolicy)
-	err := c.cc.Invoke(ctx, "/api.RetryPolicyService/GetRetryPolicy", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *EndMarker) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *retryPolicyServiceClient) GetRetryPolicies(ctx context.Context, in *RetryPolicyListRequest, opts ...grpc.CallOption) (*RetryPolicyList, error) {
-	out := new(RetryPolicyList)
-	err := c.cc.Invoke(ctx, "/api.RetryPolicyService/GetRetryPolicies", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *EndMarker) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndMarker.DiscardUnknown(m)
 }
 
-// RetryPolicyServiceServer is the server API for RetryPolicyService service.
-type RetryPolicyServiceServer interface {
-	CreateRetryPolicy(context.Context, *RetryPolicy) (*types.Empty, error)
-	UpdateRetryPolicy(context.Context, *RetryPolicy) (*types.Empty, error)
-	DeleteRetryPolicy(context.Context, *RetryPolicyDeleteRequest) (*types.Empty, error)
-	GetRetryPolicy(context.Context, *RetryPolicyGetRequest) (*RetryPolicy, error)
-	GetRetryPolicies(context.Context, *RetryPolicyListRequest) (*RetryPolicyList, error)
-}
+var xxx_messageInfo_EndMarker proto.InternalMessageInfo
 
-// UnimplementedRetryPolicyServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedRetryPolicyServiceServer struct {
+type StreamingQueueMessage struct {
+	// Types that are valid to be assigned to Event:
+	//	*StreamingQueueMessage_Queue
+	//	*StreamingQueueMessage_End
+	Event isStreamingQueueMessage_Event `protobuf_oneof:"event"`
 }
 
-func (*UnimplementedRetryPolicyServiceServer) CreateRetryPolicy(ctx context.Context, req *RetryPolicy) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRetryPolicy not implemented")
+func (m *StreamingQueueMessage) Reset()         { *m = StreamingQueueMessage{} }
+func (m *StreamingQueueMessage) String() string { return proto.CompactTextString(m) }
+func (*StreamingQueueMessage) ProtoMessage()    {}
+func (*StreamingQueueMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{55}
 }
-func (*UnimplementedRetryPolicyServiceServer) UpdateRetryPolicy(ctx context.Context, req *RetryPolicy) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRetryPolicy not implemented")
+func (m *StreamingQueueMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedRetryPolicyServiceServer) DeleteRetryPolicy(ctx context.Context, req *RetryPolicyDeleteRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRetryPolicy not implemented")
+func (m *StreamingQueueMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamingQueueMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedRetryPolicyServiceServer) GetRetryPolicy(ctx context.Context, req *RetryPolicyGetRequest) (*RetryPolicy, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRetryPolicy not implemented")
+func (m *StreamingQueueMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamingQueueMessage.Merge(m, src)
 }
-func (*UnimplementedRetryPolicyServiceServer) GetRetryPolicies(ctx context.Context, req *RetryPolicyListRequest) (*RetryPolicyList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRetryPolicies not implemented")
+func (m *StreamingQueueMessage) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterRetryPolicyServiceServer(s *grpc.Server, srv RetryPolicyServiceServer) {
-	s.RegisterService(&_RetryPolicyService_serviceDesc, srv)
+func (m *StreamingQueueMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamingQueueMessage.DiscardUnknown(m)
 }
 
-func _RetryPolicyService_CreateRetryPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RetryPolicy)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RetryPolicyServiceServer).CreateRetryPolicy(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.RetryPolicyService/CreateRetryPolicy",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RetryPolicyServiceServer).CreateRetryPolicy(ctx, req.(*RetryPolicy))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_StreamingQueueMessage proto.InternalMessageInfo
+
+type isStreamingQueueMessage_Event interface {
+	isStreamingQueueMessage_Event()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func _RetryPolicyService_UpdateRetryPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RetryPolicy)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RetryPolicyServiceServer).UpdateRetryPolicy(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.RetryPolicyService/UpdateRetryPolicy",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RetryPolicyServiceServer).UpdateRetryPolicy(ctx, req.(*RetryPolicy))
-	}
-	return interceptor(ctx, in, info, handler)
+type StreamingQueueMessage_Queue struct {
+	Queue *Queue `protobuf:"bytes,1,opt,name=queue,proto3,oneof" json:"queue,omitempty"`
+}
+type StreamingQueueMessage_End struct {
+	End *EndMarker `protobuf:"bytes,2,opt,name=end,proto3,oneof" json:"end,omitempty"`
 }
 
-func _RetryPolicyService_DeleteRetryPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RetryPolicyDeleteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RetryPolicyServiceServer).DeleteRetryPolicy(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.RetryPolicyService/DeleteRetryPolicy",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RetryPolicyServiceServer).DeleteRetryPolicy(ctx, req.(*RetryPolicyDeleteRequest))
+func (*StreamingQueueMessage_Queue) isStreamingQueueMessage_Event() {}
+func (*StreamingQueueMessage_End) isStreamingQueueMessage_Event()   {}
+
+func (m *StreamingQueueMessage) GetEvent() isStreamingQueueMessage_Event {
+	if m != nil {
+		return m.Event
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _RetryPolicyService_GetRetryPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RetryPolicyGetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RetryPolicyServiceServer).GetRetryPolicy(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.RetryPolicyService/GetRetryPolicy",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RetryPolicyServiceServer).GetRetryPolicy(ctx, req.(*RetryPolicyGetRequest))
+func (m *StreamingQueueMessage) GetQueue() *Queue {
+	if x, ok := m.GetEvent().(*StreamingQueueMessage_Queue); ok {
+		return x.Queue
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _RetryPolicyService_GetRetryPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RetryPolicyListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RetryPolicyServiceServer).GetRetryPolicies(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.RetryPolicyService/GetRetryPolicies",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RetryPolicyServiceServer).GetRetryPolicies(ctx, req.(*RetryPolicyListRequest))
+func (m *StreamingQueueMessage) GetEnd() *EndMarker {
+	if x, ok := m.GetEvent().(*StreamingQueueMessage_End); ok {
+		return x.End
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _RetryPolicyService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "api.RetryPolicyService",
-	HandlerType: (*RetryPolicyServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateRetryPolicy",
-			Handler:    _RetryPolicyService_CreateRetryPolicy_Handler,
-		},
-		{
-			MethodName: "UpdateRetryPolicy",
-			Handler:    _RetryPolicyService_UpdateRetryPolicy_Handler,
-		},
-		{
-			MethodName: "DeleteRetryPolicy",
-			Handler:    _RetryPolicyService_DeleteRetryPolicy_Handler,
-		},
-		{
-			MethodName: "GetRetryPolicy",
-			Handler:    _RetryPolicyService_GetRetryPolicy_Handler,
-		},
-		{
-			MethodName: "GetRetryPolicies",
-			Handler:    _RetryPolicyService_GetRetryPolicies_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pkg/api/submit.proto",
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*StreamingQueueMessage) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*StreamingQueueMessage_Queue)(nil),
+		(*StreamingQueueMessage_End)(nil),
+	}
 }
 
-// SubmitClient is the client API for Submit service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type SubmitClient interface {
-	SubmitJobs(ctx context.Context, in *JobSubmitRequest, opts ...grpc.CallOption) (*JobSubmitResponse, error)
-	CancelJobs(ctx context.Context, in *JobCancelRequest, opts ...grpc.CallOption) (*CancellationResult, error)
-	CancelJobSet(ctx context.Context, in *JobSetCancelRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	ReprioritizeJobs(ctx context.Context, in *JobReprioritizeRequest, opts ...grpc.CallOption) (*JobReprioritizeResponse, error)
-	PreemptJobs(ctx context.Context, in *JobPreemptRequest, opts ...grpc.CallOption) (*PreemptionResult, error)
-	CreateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error)
-	CreateQueues(ctx context.Context, in *QueueList, opts ...grpc.CallOption) (*BatchQueueCreateResponse, error)
-	UpdateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error)
-	UpdateQueues(ctx context.Context, in *QueueList, opts ...grpc.CallOption) (*BatchQueueUpdateResponse, error)
-	DeleteQueue(ctx context.Context, in *QueueDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	GetQueue(ctx context.Context, in *QueueGetRequest, opts ...grpc.CallOption) (*Queue, error)
-	GetQueues(ctx context.Context, in *StreamingQueueGetRequest, opts ...grpc.CallOption) (Submit_GetQueuesClient, error)
-	Health(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*HealthCheckResponse, error)
+type QueuePreemptRequest struct {
+	Name            string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PriorityClasses []string `protobuf:"bytes,2,rep,name=priorityClasses,proto3" json:"priorityClasses,omitempty"`
+	// If empty, jobs on all pools will be preempted
+	Pools []string `protobuf:"bytes,3,rep,name=pools,proto3" json:"pools,omitempty"`
 }
 
-type submitClient struct {
-	cc *grpc.ClientConn
+func (m *QueuePreemptRequest) Reset()         { *m = QueuePreemptRequest{} }
+func (m *QueuePreemptRequest) String() string { return proto.CompactTextString(m) }
+func (*QueuePreemptRequest) ProtoMessage()    {}
+func (*QueuePreemptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{56}
 }
-
-func NewSubmitClient(cc *grpc.ClientConn) SubmitClient {
-	return &submitClient{cc}
+func (m *QueuePreemptRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *submitClient) SubmitJobs(ctx context.Context, in *JobSubmitRequest, opts ...grpc.CallOption) (*JobSubmitResponse, error) {
-	out := new(JobSubmitResponse)
-	err := c.cc.Invoke(ctx, "/api.Submit/SubmitJobs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueuePreemptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuePreemptRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueuePreemptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuePreemptRequest.Merge(m, src)
+}
+func (m *QueuePreemptRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuePreemptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuePreemptRequest.DiscardUnknown(m)
 }
 
-func (c *submitClient) CancelJobs(ctx context.Context, in *JobCancelRequest, opts ...grpc.CallOption) (*CancellationResult, error) {
-	out := new(CancellationResult)
-	err := c.cc.Invoke(ctx, "/api.Submit/CancelJobs", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueuePreemptRequest proto.InternalMessageInfo
+
+func (m *QueuePreemptRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *submitClient) CancelJobSet(ctx context.Context, in *JobSetCancelRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.Submit/CancelJobSet", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueuePreemptRequest) GetPriorityClasses() []string {
+	if m != nil {
+		return m.PriorityClasses
 	}
-	return out, nil
+	return nil
 }
 
-func (c *submitClient) ReprioritizeJobs(ctx context.Context, in *JobReprioritizeRequest, opts ...grpc.CallOption) (*JobReprioritizeResponse, error) {
-	out := new(JobReprioritizeResponse)
-	err := c.cc.Invoke(ctx, "/api.Submit/ReprioritizeJobs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueuePreemptRequest) GetPools() []string {
+	if m != nil {
+		return m.Pools
 	}
-	return out, nil
+	return nil
 }
 
-func (c *submitClient) PreemptJobs(ctx context.Context, in *JobPreemptRequest, opts ...grpc.CallOption) (*PreemptionResult, error) {
-	out := new(PreemptionResult)
-	err := c.cc.Invoke(ctx, "/api.Submit/PreemptJobs", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueueCancelRequest struct {
+	Name            string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PriorityClasses []string `protobuf:"bytes,2,rep,name=priorityClasses,proto3" json:"priorityClasses,omitempty"`
+	// In practice jobs are only cancellable from non-terminal states
+	JobStates []JobState `protobuf:"varint,3,rep,packed,name=jobStates,proto3,enum=api.JobState" json:"jobStates,omitempty"`
+	// If empty, jobs on all pools will be cancelled
+	Pools []string `protobuf:"bytes,4,rep,name=pools,proto3" json:"pools,omitempty"`
+}
+
+func (m *QueueCancelRequest) Reset()         { *m = QueueCancelRequest{} }
+func (m *QueueCancelRequest) String() string { return proto.CompactTextString(m) }
+func (*QueueCancelRequest) ProtoMessage()    {}
+func (*QueueCancelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e998bacb27df16c1, []int{57}
+}
+func (m *QueueCancelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueCancelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueCancelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueueCancelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueCancelRequest.Merge(m, src)
+}
+func (m *QueueCancelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueCancelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueCancelRequest.DiscardUnknown(m)
 }
 
-func (c *submitClient) CreateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error) {
+var xxx_messageInfo_QueueCancelRequest proto.InternalMessageInfo
+
+func (m *QueueCancelRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *QueueCancelRequest) GetPriorityClasses() []string {
+	if m != nil {
+		return m.PriorityClasses
+	}
+	return nil
+}
+
+func (m *QueueCancelRequest) GetJobStates() []JobState {
+	if m != nil {
+		return m.JobStates
+	}
+	return nil
+}
+
+func (m *QueueCancelRequest) GetPools() []string {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("api.IngressType", IngressType_name, IngressType_value)
+	proto.RegisterEnum("api.ServiceType", ServiceType_name, ServiceType_value)
+	proto.RegisterEnum("api.JobState", JobState_name, JobState_value)
+	proto.RegisterEnum("api.RetryAction", RetryAction_name, RetryAction_value)
+	proto.RegisterType((*JobSubmitRequestItem)(nil), "api.JobSubmitRequestItem")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.AnnotationsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.LabelsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobSubmitRequestItem.RequiredNodeLabelsEntry")
+	proto.RegisterType((*SecretReference)(nil), "api.SecretReference")
+	proto.RegisterType((*IngressConfig)(nil), "api.IngressConfig")
+	proto.RegisterMapType((map[string]string)(nil), "api.IngressConfig.AnnotationsEntry")
+	proto.RegisterType((*ServiceConfig)(nil), "api.ServiceConfig")
+	proto.RegisterType((*JobSubmitRequest)(nil), "api.JobSubmitRequest")
+	proto.RegisterType((*JobPreemptRequest)(nil), "api.JobPreemptRequest")
+	proto.RegisterType((*JobCancelRequest)(nil), "api.JobCancelRequest")
+	proto.RegisterType((*JobSetCancelRequest)(nil), "api.JobSetCancelRequest")
+	proto.RegisterType((*JobSetFilter)(nil), "api.JobSetFilter")
+	proto.RegisterType((*Job)(nil), "api.Job")
+	proto.RegisterMapType((map[string]string)(nil), "api.Job.AnnotationsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.Job.LabelsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.Job.RequiredNodeLabelsEntry")
+	proto.RegisterType((*JobReprioritizeRequest)(nil), "api.JobReprioritizeRequest")
+	proto.RegisterType((*JobReprioritizeResponse)(nil), "api.JobReprioritizeResponse")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobReprioritizeResponse.ReprioritizationResultsEntry")
+	proto.RegisterType((*JobSubmitResponseItem)(nil), "api.JobSubmitResponseItem")
+	proto.RegisterType((*JobSubmitResponse)(nil), "api.JobSubmitResponse")
+	proto.RegisterType((*Queue)(nil), "api.Queue")
+	proto.RegisterMapType((map[string]string)(nil), "api.Queue.LabelsEntry")
+	proto.RegisterMapType((map[string]*PriorityClassResourceLimits)(nil), "api.Queue.ResourceLimitsByPriorityClassNameEntry")
+	proto.RegisterMapType((map[string]float64)(nil), "api.Queue.ResourceLimitsEntry")
+	proto.RegisterType((*Queue_Permissions)(nil), "api.Queue.Permissions")
+	proto.RegisterType((*Queue_Permissions_Subject)(nil), "api.Queue.Permissions.Subject")
+	proto.RegisterType((*PriorityClassResourceLimits)(nil), "api.PriorityClassResourceLimits")
+	proto.RegisterMapType((map[string]*PriorityClassPoolResourceLimits)(nil), "api.PriorityClassResourceLimits.MaximumResourceFractionByPoolEntry")
+	proto.RegisterMapType((map[string]float64)(nil), "api.PriorityClassResourceLimits.MaximumResourceFractionEntry")
+	proto.RegisterType((*PriorityClassPoolResourceLimits)(nil), "api.PriorityClassPoolResourceLimits")
+	proto.RegisterMapType((map[string]float64)(nil), "api.PriorityClassPoolResourceLimits.MaximumResourceFractionEntry")
+	proto.RegisterType((*QueueList)(nil), "api.QueueList")
+	proto.RegisterType((*CancellationResult)(nil), "api.CancellationResult")
+	proto.RegisterType((*PreemptionResult)(nil), "api.PreemptionResult")
+	proto.RegisterMapType((map[string]string)(nil), "api.PreemptionResult.PreemptionResultsEntry")
+	proto.RegisterType((*JobSelector)(nil), "api.JobSelector")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobSelector.AnnotationsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobSelector.LabelsEntry")
+	proto.RegisterType((*JobSelectorCancelRequest)(nil), "api.JobSelectorCancelRequest")
+	proto.RegisterType((*JobSelectorReprioritizeRequest)(nil), "api.JobSelectorReprioritizeRequest")
+	proto.RegisterType((*JobSelectorResult)(nil), "api.JobSelectorResult")
+	proto.RegisterType((*QueuedJobUpdate)(nil), "api.QueuedJobUpdate")
+	proto.RegisterMapType((map[string]*v1.ResourceRequirements)(nil), "api.QueuedJobUpdate.ContainerResourcesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "api.QueuedJobUpdate.NodeSelectorEntry")
+	proto.RegisterType((*JobUpdateRequest)(nil), "api.JobUpdateRequest")
+	proto.RegisterType((*JobUpdateResponse)(nil), "api.JobUpdateResponse")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobUpdateResponse.UpdateResultsEntry")
+	proto.RegisterType((*JobMoveRequest)(nil), "api.JobMoveRequest")
+	proto.RegisterType((*JobMoveResponse)(nil), "api.JobMoveResponse")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobMoveResponse.MoveResultsEntry")
+	proto.RegisterType((*JobSuspendRequest)(nil), "api.JobSuspendRequest")
+	proto.RegisterType((*JobResumeRequest)(nil), "api.JobResumeRequest")
+	proto.RegisterType((*JobSetSuspendRequest)(nil), "api.JobSetSuspendRequest")
+	proto.RegisterType((*JobSetResumeRequest)(nil), "api.JobSetResumeRequest")
+	proto.RegisterType((*JobSuspensionResult)(nil), "api.JobSuspensionResult")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobSuspensionResult.ResultsEntry")
+	proto.RegisterType((*RetryPolicy)(nil), "api.RetryPolicy")
+	proto.RegisterType((*RetryRule)(nil), "api.RetryRule")
+	proto.RegisterType((*RetryMutation)(nil), "api.RetryMutation")
+	proto.RegisterType((*RetryAffinityMutation)(nil), "api.RetryAffinityMutation")
+	proto.RegisterType((*RetryResourceMutation)(nil), "api.RetryResourceMutation")
+	proto.RegisterType((*RetryResourceBump)(nil), "api.RetryResourceBump")
+	proto.RegisterType((*RetryPolicyGetRequest)(nil), "api.RetryPolicyGetRequest")
+	proto.RegisterType((*RetryPolicyDeleteRequest)(nil), "api.RetryPolicyDeleteRequest")
+	proto.RegisterType((*RetryPolicyListRequest)(nil), "api.RetryPolicyListRequest")
+	proto.RegisterType((*RetryPolicyList)(nil), "api.RetryPolicyList")
+	proto.RegisterType((*QueueGetRequest)(nil), "api.QueueGetRequest")
+	proto.RegisterType((*QueueCordonRequest)(nil), "api.QueueCordonRequest")
+	proto.RegisterType((*QueueUncordonRequest)(nil), "api.QueueUncordonRequest")
+	proto.RegisterType((*StreamingQueueGetRequest)(nil), "api.StreamingQueueGetRequest")
+	proto.RegisterType((*QueueDeleteRequest)(nil), "api.QueueDeleteRequest")
+	proto.RegisterType((*JobSetInfo)(nil), "api.JobSetInfo")
+	proto.RegisterType((*QueueUpdateResponse)(nil), "api.QueueUpdateResponse")
+	proto.RegisterType((*BatchQueueUpdateResponse)(nil), "api.BatchQueueUpdateResponse")
+	proto.RegisterType((*QueueCreateResponse)(nil), "api.QueueCreateResponse")
+	proto.RegisterType((*BatchQueueCreateResponse)(nil), "api.BatchQueueCreateResponse")
+	proto.RegisterType((*EndMarker)(nil), "api.EndMarker")
+	proto.RegisterType((*StreamingQueueMessage)(nil), "api.StreamingQueueMessage")
+	proto.RegisterType((*QueuePreemptRequest)(nil), "api.QueuePreemptRequest")
+	proto.RegisterType((*QueueCancelRequest)(nil), "api.QueueCancelRequest")
+}
+
+func init() { proto.RegisterFile("pkg/api/submit.proto", fileDescriptor_e998bacb27df16c1) }
+
+var fileDescriptor_e998bacb27df16c1 = []byte{
+	// 4819 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0x5d, 0x6c, 0x23, 0x59,
+	0x56, 0x70, 0xca, 0xce, 0x8f, 0x7d, 0x1c, 0x3b, 0xf6, 0xcd, 0x9f, 0xdb, 0x9d, 0x8e, 0x33, 0x35,
+	0x3b, 0xbd, 0x99, 0xcc, 0x8c, 0x33, 0x93, 0xf9, 0x56, 0x5f, 0x4f, 0x33, 0xec, 0x4c, 0xec, 0xb8,
+	0x7b, 0x92, 0xee, 0x4e, 0xa7, 0x9d, 0x4e, 0xef, 0xcc, 0xec, 0xb0, 0xde, 0xb2, 0x7d, 0x93, 0xae,
+	0x8e, 0xab, 0xca, 0x53, 0x55, 0x4e, 0x4f, 0x80, 0x15, 0x02, 0xad, 0xe0, 0x81, 0x97, 0x15, 0x3c,
+	0x80, 0xc4, 0x0a, 0x10, 0x02, 0x09, 0x2d, 0xcb, 0x03, 0x48, 0xfb, 0x82, 0x78, 0x43, 0x20, 0x04,
+	0x2f, 0x8b, 0xe0, 0x01, 0x5e, 0x2c, 0x34, 0x83, 0x00, 0x59, 0xbc, 0xf0, 0xc2, 0x23, 0x42, 0xf7,
+	0xa7, 0xaa, 0xee, 0x2d, 0xdb, 0x89, 0x9d, 0xee, 0xf4, 0x8e, 0x10, 0x6f, 0xa9, 0x73, 0xce, 0x3d,
+	0xe7, 0xdc, 0x73, 0xcf, 0x3d, 0xf7, 0x9c, 0x73, 0xaf, 0x03, 0x73, 0xad, 0xe3, 0xa3, 0x75, 0xad,
+	0xa5, 0xaf, 0x3b, 0xed, 0x9a, 0xa1, 0xbb, 0x85, 0x96, 0x6d, 0xb9, 0x16, 0x8a, 0x6a, 0x2d, 0x3d,
+	0x77, 0xf5, 0xc8, 0xb2, 0x8e, 0x9a, 0x78, 0x9d, 0x82, 0x6a, 0xed, 0xc3, 0x75, 0x6c, 0xb4, 0xdc,
+	0x53, 0x46, 0x91, 0xcb, 0x87, 0x91, 0xae, 0x6e, 0x60, 0xc7, 0xd5, 0x8c, 0x16, 0x27, 0x50, 0x8f,
+	0x6f, 0x38, 0x05, 0xdd, 0xa2, 0xbc, 0xeb, 0x96, 0x8d, 0xd7, 0x4f, 0xde, 0x5a, 0x3f, 0xc2, 0x26,
+	0xb6, 0x35, 0x17, 0x37, 0x38, 0xcd, 0xaa, 0x40, 0x63, 0x62, 0xf7, 0xa9, 0x65, 0x1f, 0xeb, 0xe6,
+	0x51, 0x3f, 0xca, 0x25, 0x2e, 0x8e, 0x50, 0x6a, 0xa6, 0x69, 0xb9, 0x9a, 0xab, 0x5b, 0xa6, 0xc3,
+	0xb1, 0xfe, 0x24, 0x1e, 0x63, 0xad, 0xe9, 0x3e, 0x66, 0x50, 0xf5, 0xfb, 0xd3, 0x30, 0xb7, 0x63,
+	0xd5, 0xf6, 0xe9, 0xc4, 0x2a, 0xf8, 0xd3, 0x36, 0x76, 0xdc, 0x6d, 0x17, 0x1b, 0x68, 0x03, 0x62,
+	0x2d, 0x5b, 0xb7, 0x6c, 0xdd, 0x3d, 0xcd, 0x2a, 0x2b, 0xca, 0xaa, 0x52, 0x5c, 0xe8, 0x76, 0xf2,
+	0xc8, 0x83, 0xbd, 0x6e, 0x19, 0xba, 0x4b, 0xe7, 0x5a, 0xf1, 0xe9, 0xd0, 0xd7, 0x20, 0x6e, 0x6a,
+	0x06, 0x76, 0x5a, 0x5a, 0x1d, 0x67, 0xa3, 0x2b, 0xca, 0x6a, 0xbc, 0xb8, 0xd8, 0xed, 0xe4, 0x67,
+	0x7d, 0xa0, 0x30, 0x2a, 0xa0, 0x44, 0x6f, 0x43, 0xbc, 0xde, 0xd4, 0xb1, 0xe9, 0x56, 0xf5, 0x46,
+	0x36, 0x46, 0x87, 0x51, 0x59, 0x0c, 0xb8, 0xdd, 0x10, 0x65, 0x79, 0x30, 0xb4, 0x0f, 0x93, 0x4d,
+	0xad, 0x86, 0x9b, 0x4e, 0x76, 0x7c, 0x25, 0xba, 0x9a, 0xd8, 0x78, 0xa5, 0xa0, 0xb5, 0xf4, 0x42,
+	0xbf, 0xa9, 0x14, 0xee, 0x52, 0xba, 0xb2, 0xe9, 0xda, 0xa7, 0xc5, 0xb9, 0x6e, 0x27, 0x9f, 0x66,
+	0x03, 0x05, 0xb6, 0x9c, 0x15, 0x3a, 0x82, 0x84, 0x60, 0xb8, 0xec, 0x04, 0xe5, 0xbc, 0x36, 0x98,
+	0xf3, 0x66, 0x40, 0xcc, 0xd8, 0x5f, 0xe9, 0x76, 0xf2, 0xf3, 0x02, 0x0b, 0x41, 0x86, 0xc8, 0x19,
+	0xfd, 0x8a, 0x02, 0x73, 0x36, 0xfe, 0xb4, 0xad, 0xdb, 0xb8, 0x51, 0x35, 0xad, 0x06, 0xae, 0xf2,
+	0xc9, 0x4c, 0x52, 0x91, 0x6f, 0x0d, 0x16, 0x59, 0xe1, 0xa3, 0x76, 0xad, 0x06, 0x16, 0x27, 0xa6,
+	0x76, 0x3b, 0xf9, 0x25, 0xbb, 0x07, 0x19, 0x28, 0x90, 0x55, 0x2a, 0xa8, 0x17, 0x8f, 0xee, 0x43,
+	0xac, 0x65, 0x35, 0xaa, 0x4e, 0x0b, 0xd7, 0xb3, 0x91, 0x15, 0x65, 0x35, 0xb1, 0x71, 0xb5, 0xc0,
+	0x3c, 0x8e, 0xea, 0x40, 0xbc, 0xb2, 0x70, 0xf2, 0x56, 0x61, 0xcf, 0x6a, 0xec, 0xb7, 0x70, 0x9d,
+	0xae, 0x67, 0xa6, 0xc5, 0x3e, 0x24, 0xde, 0x53, 0x1c, 0x88, 0xf6, 0x20, 0xee, 0x31, 0x74, 0xb2,
+	0x53, 0x2b, 0xd1, 0xf3, 0x38, 0x32, 0xb7, 0x62, 0x1f, 0x8e, 0xe4, 0x56, 0x1c, 0x86, 0x4a, 0x30,
+	0xa5, 0x9b, 0x47, 0x36, 0x76, 0x9c, 0x6c, 0x9c, 0xf2, 0x43, 0x94, 0xd1, 0x36, 0x83, 0x95, 0x2c,
+	0xf3, 0x50, 0x3f, 0x2a, 0xce, 0x13, 0xc5, 0x38, 0x99, 0xc0, 0xc5, 0x1b, 0x89, 0x6e, 0x41, 0xcc,
+	0xc1, 0xf6, 0x89, 0x5e, 0xc7, 0x4e, 0x16, 0x04, 0x2e, 0xfb, 0x0c, 0xc8, 0xb9, 0x50, 0x65, 0x3c,
+	0x3a, 0x51, 0x19, 0x0f, 0x46, 0x7c, 0xdc, 0xa9, 0x3f, 0xc6, 0x8d, 0x76, 0x13, 0xdb, 0xd9, 0x44,
+	0xe0, 0xe3, 0x3e, 0x50, 0xf4, 0x71, 0x1f, 0x88, 0x6e, 0x41, 0x1a, 0x7f, 0xe6, 0x62, 0xdb, 0xd4,
+	0x9a, 0xd5, 0x27, 0x56, 0xad, 0xda, 0xb6, 0xf5, 0x6c, 0x92, 0x8e, 0x5e, 0xea, 0x76, 0xf2, 0x59,
+	0x0f, 0xb7, 0x63, 0xd5, 0x0e, 0x6c, 0x5d, 0x60, 0x91, 0x92, 0x31, 0xe8, 0x43, 0x48, 0xd4, 0xa9,
+	0xaa, 0x55, 0x43, 0x6b, 0x39, 0xd9, 0x14, 0x9d, 0xc9, 0xb5, 0x7e, 0xf6, 0x65, 0x33, 0xba, 0xa7,
+	0xb5, 0x8a, 0xd9, 0x6e, 0x27, 0x3f, 0x57, 0xf7, 0x3e, 0xc5, 0x69, 0x41, 0x00, 0x45, 0x65, 0x98,
+	0x72, 0x70, 0xdd, 0xc6, 0xae, 0x93, 0x9d, 0xa1, 0x5c, 0xe7, 0xb8, 0x7d, 0x08, 0xac, 0x82, 0x0f,
+	0xb1, 0x8d, 0xcd, 0x3a, 0x66, 0x76, 0xe6, 0x84, 0xa2, 0x9d, 0x39, 0x08, 0xfd, 0xaa, 0x02, 0x0b,
+	0x27, 0x56, 0xb3, 0x6d, 0xe0, 0x6a, 0xbd, 0xa9, 0xe9, 0x46, 0x95, 0x10, 0x34, 0x35, 0x17, 0x3b,
+	0xd9, 0x34, 0xf7, 0xed, 0x7e, 0xce, 0x80, 0x6d, 0x47, 0x77, 0x5c, 0x6c, 0xba, 0x8f, 0xe8, 0xd8,
+	0x12, 0x19, 0xfa, 0x90, 0x8f, 0xa4, 0xbe, 0xbd, 0x7c, 0xd2, 0x8b, 0x10, 0x15, 0x98, 0xeb, 0x87,
+	0xcf, 0x69, 0x90, 0x10, 0x36, 0x09, 0x7a, 0x19, 0xa2, 0xc7, 0x98, 0xc5, 0xb3, 0x78, 0x31, 0xd3,
+	0xed, 0xe4, 0x93, 0xc7, 0x58, 0x0c, 0x65, 0x04, 0x8b, 0x5e, 0x85, 0x89, 0x13, 0xad, 0xd9, 0xc6,
+	0x74, 0x3b, 0xc4, 0x8b, 0xb3, 0xdd, 0x4e, 0x7e, 0x86, 0x02, 0x04, 0x42, 0x46, 0x71, 0x33, 0x72,
+	0x43, 0xc9, 0x1d, 0x42, 0x3a, 0x1c, 0x06, 0x2e, 0x45, 0x8e, 0x01, 0x8b, 0x03, 0xf6, 0xfe, 0x65,
+	0x88, 0xdb, 0x19, 0x8f, 0x4d, 0xa7, 0x93, 0xaa, 0x0b, 0x33, 0x21, 0x07, 0x40, 0xd7, 0x61, 0x9c,
+	0x84, 0x6e, 0x2e, 0x0d, 0x75, 0x3b, 0xf9, 0x14, 0xf9, 0x16, 0xb8, 0x50, 0x3c, 0x7a, 0x07, 0x12,
+	0x8e, 0xd5, 0xb6, 0xeb, 0xb8, 0x4a, 0xc9, 0x99, 0x54, 0xea, 0x8a, 0x0c, 0xbc, 0x2b, 0x0f, 0x82,
+	0x00, 0xaa, 0xfe, 0x67, 0x14, 0x92, 0xd2, 0xee, 0x46, 0x37, 0x61, 0xdc, 0x3d, 0x6d, 0x31, 0xa1,
+	0xa9, 0x8d, 0xb4, 0xb8, 0xff, 0x1f, 0x9e, 0xb6, 0x30, 0x0d, 0xeb, 0x29, 0x42, 0x21, 0xc5, 0x24,
+	0x3a, 0x86, 0x4c, 0xbc, 0x65, 0xd9, 0xae, 0x93, 0x8d, 0xac, 0x44, 0x57, 0x93, 0x6c, 0xe2, 0x14,
+	0x20, 0x4e, 0x9c, 0x02, 0xd0, 0xb7, 0xe5, 0xf8, 0x1f, 0xa5, 0x0e, 0xfb, 0x72, 0x6f, 0xb4, 0xb9,
+	0x78, 0xe0, 0x7f, 0x07, 0x12, 0x6e, 0xd3, 0xa9, 0x62, 0x53, 0xab, 0x35, 0x71, 0x23, 0x3b, 0xbe,
+	0xa2, 0xac, 0xc6, 0x98, 0x55, 0x5c, 0xb2, 0x9a, 0x14, 0x2a, 0x5a, 0x25, 0x80, 0xd2, 0x63, 0x12,
+	0xdb, 0x2e, 0x33, 0xe7, 0x84, 0x70, 0x4c, 0x62, 0xdb, 0x0d, 0x19, 0x33, 0xe6, 0xc1, 0xd0, 0x7b,
+	0x90, 0x6c, 0x3b, 0x64, 0x2b, 0xb6, 0x1d, 0x17, 0xdb, 0xdb, 0x7b, 0xd9, 0x49, 0x2a, 0x31, 0xd7,
+	0xed, 0xe4, 0x17, 0xda, 0x0e, 0x2e, 0x79, 0x70, 0x61, 0xf0, 0xb4, 0x08, 0x7f, 0x51, 0xee, 0xad,
+	0x7e, 0x5f, 0x81, 0xa4, 0x14, 0x8b, 0xd1, 0x8d, 0x3e, 0x6b, 0xce, 0x29, 0xe8, 0x9a, 0xa3, 0xde,
+	0x35, 0x1f, 0x7d, 0xc5, 0x3d, 0x6f, 0x8e, 0x9e, 0xed, 0xcd, 0xea, 0x3f, 0x29, 0x90, 0x0e, 0x9f,
+	0xc7, 0x44, 0xce, 0xa7, 0x6d, 0xdc, 0xf6, 0xf6, 0x02, 0x95, 0x43, 0x01, 0xa2, 0x1c, 0x0a, 0x40,
+	0xff, 0x0f, 0x80, 0x84, 0x7d, 0x07, 0xd3, 0x24, 0x27, 0x12, 0xac, 0xde, 0x13, 0xab, 0xb6, 0x8f,
+	0x43, 0x49, 0x8e, 0x07, 0x43, 0x0d, 0xc8, 0x90, 0x51, 0x36, 0x93, 0x57, 0x25, 0x04, 0x9e, 0x57,
+	0x5e, 0x19, 0x98, 0x22, 0x14, 0xaf, 0x75, 0x3b, 0xf9, 0x2b, 0x4f, 0xac, 0x9a, 0x00, 0x13, 0x67,
+	0x3e, 0x13, 0x42, 0xa9, 0xbf, 0x19, 0x81, 0xcc, 0x8e, 0x55, 0xdb, 0xb3, 0x31, 0x21, 0x78, 0x61,
+	0x93, 0x7b, 0x03, 0xa6, 0xc8, 0x28, 0xbd, 0xc1, 0xa6, 0x14, 0x67, 0xb9, 0xd9, 0x13, 0xab, 0xb6,
+	0xdd, 0x90, 0x72, 0x33, 0x06, 0x41, 0xaf, 0xc3, 0xa4, 0x8d, 0x35, 0xc7, 0x32, 0xe9, 0xa6, 0xe1,
+	0xd4, 0x0c, 0x22, 0x52, 0x33, 0x08, 0x2a, 0xc3, 0x8c, 0xde, 0xc0, 0x46, 0xcb, 0x72, 0xb1, 0x59,
+	0x3f, 0xad, 0x12, 0x77, 0x9d, 0x08, 0x8e, 0x5b, 0x01, 0x75, 0x47, 0xf2, 0xdc, 0x94, 0x8c, 0x51,
+	0xff, 0x22, 0x42, 0x97, 0xbd, 0xa4, 0x99, 0x75, 0xdc, 0xf4, 0x2c, 0xb3, 0x06, 0x93, 0x4c, 0x71,
+	0xd1, 0x34, 0x54, 0x4b, 0xd1, 0x34, 0x14, 0x70, 0x41, 0xd3, 0xf8, 0xb6, 0x8f, 0x9e, 0x6b, 0x7b,
+	0xc1, 0x8a, 0xe3, 0x23, 0x59, 0x71, 0xe2, 0x62, 0x56, 0x9c, 0xbc, 0x80, 0x15, 0x7f, 0x18, 0x81,
+	0xd9, 0x1d, 0x3a, 0x37, 0xd9, 0x90, 0xb2, 0x71, 0x94, 0x51, 0x8d, 0x13, 0x39, 0xd7, 0x38, 0xef,
+	0xc1, 0xe4, 0xa1, 0xde, 0x74, 0xb1, 0x4d, 0x0d, 0x99, 0xd8, 0xc8, 0xf8, 0x9b, 0x06, 0xbb, 0xb7,
+	0x28, 0x82, 0x19, 0x80, 0x11, 0x89, 0x06, 0x60, 0x90, 0x9f, 0x8c, 0xd3, 0xdd, 0x81, 0x69, 0x51,
+	0x45, 0xf4, 0x53, 0x30, 0xe9, 0xb8, 0x34, 0x83, 0x52, 0x56, 0xa2, 0xab, 0xa9, 0x8d, 0xa4, 0x3f,
+	0x0b, 0x02, 0x65, 0x3a, 0x31, 0x02, 0x51, 0x27, 0x06, 0x51, 0xff, 0x70, 0x06, 0xa2, 0x3b, 0x56,
+	0x0d, 0xad, 0x40, 0xc4, 0xb7, 0x71, 0xba, 0xdb, 0xc9, 0x4f, 0xeb, 0xa2, 0x75, 0x23, 0x7a, 0x43,
+	0x2e, 0xc3, 0x92, 0x43, 0x96, 0x61, 0x97, 0xee, 0xdf, 0x52, 0x4d, 0x39, 0x35, 0x74, 0x4d, 0x59,
+	0xf4, 0xcb, 0xc3, 0xb8, 0x90, 0xcc, 0xee, 0x58, 0xb5, 0x11, 0xaa, 0xc1, 0x47, 0x72, 0x36, 0x00,
+	0x72, 0xdc, 0xbd, 0x78, 0x0e, 0x70, 0x32, 0xa0, 0xf6, 0x4b, 0x50, 0x01, 0x2b, 0xbe, 0x80, 0xe7,
+	0x5d, 0xea, 0xbd, 0x0a, 0x13, 0xd6, 0x53, 0x13, 0xdb, 0xd9, 0x58, 0x60, 0x75, 0x0a, 0x10, 0xad,
+	0x4e, 0x01, 0x08, 0xc3, 0x55, 0x6a, 0xfe, 0x2a, 0xfd, 0x74, 0x1e, 0xeb, 0xad, 0x6a, 0xdb, 0xc1,
+	0x76, 0xf5, 0xc8, 0xb6, 0xda, 0x2d, 0x56, 0x20, 0xc4, 0x8b, 0xd7, 0xbb, 0x9d, 0xbc, 0x4a, 0xc9,
+	0xee, 0x7b, 0x54, 0x07, 0x0e, 0xb6, 0x6f, 0x53, 0x1a, 0x81, 0x67, 0x76, 0x10, 0x0d, 0xfa, 0xae,
+	0x02, 0xd7, 0xeb, 0x96, 0xd1, 0x22, 0x99, 0x15, 0x6e, 0x54, 0xcf, 0x12, 0x39, 0xbb, 0xa2, 0xac,
+	0x4e, 0x17, 0xdf, 0xec, 0x76, 0xf2, 0xaf, 0x07, 0x23, 0x1e, 0x9c, 0x2f, 0x5c, 0x3d, 0x9f, 0x5a,
+	0xea, 0x75, 0x8c, 0x0f, 0xd9, 0xeb, 0x10, 0xeb, 0xe6, 0x89, 0xe7, 0x5e, 0x37, 0x4f, 0x3f, 0x8f,
+	0xba, 0xf9, 0x77, 0x15, 0x58, 0xe1, 0x15, 0xa8, 0x6e, 0x1e, 0x55, 0x6d, 0xcc, 0xf3, 0x71, 0xee,
+	0x1a, 0x06, 0x36, 0x5d, 0x27, 0x3b, 0x4f, 0x75, 0x5f, 0xed, 0x27, 0xa9, 0xc2, 0x07, 0x54, 0x04,
+	0xfa, 0xe2, 0xeb, 0xdd, 0x4e, 0x7e, 0x35, 0xe0, 0xda, 0x8f, 0x46, 0x50, 0x66, 0xf9, 0x6c, 0x4a,
+	0x74, 0x07, 0xa6, 0xea, 0x36, 0xd6, 0x5c, 0xdc, 0xa0, 0x07, 0x4b, 0x62, 0x23, 0x57, 0x60, 0x4d,
+	0xac, 0x82, 0xd7, 0x33, 0x2b, 0x3c, 0xf4, 0x7a, 0x66, 0xac, 0xf4, 0xe4, 0xe4, 0x62, 0xe9, 0xc9,
+	0x41, 0x62, 0x9f, 0x20, 0xf5, 0x5c, 0xfa, 0x04, 0xe9, 0x67, 0xe8, 0x13, 0x7c, 0x02, 0x89, 0xe3,
+	0x1b, 0x4e, 0xd5, 0x53, 0x28, 0x43, 0x59, 0xbd, 0x24, 0x9a, 0x39, 0x68, 0xe6, 0x11, 0x63, 0x73,
+	0x2d, 0x59, 0x2d, 0x70, 0x7c, 0xc3, 0xd9, 0xee, 0x51, 0x11, 0x02, 0x28, 0x7a, 0xc4, 0xb8, 0x73,
+	0x69, 0x59, 0x34, 0xd8, 0x5d, 0xb8, 0xde, 0x3e, 0x5f, 0xfe, 0x1d, 0xe2, 0xcb, 0xa1, 0x72, 0x77,
+	0x63, 0x6e, 0xd8, 0xee, 0xc6, 0xff, 0x95, 0xd9, 0xcf, 0x50, 0x66, 0x2f, 0xa4, 0x17, 0x77, 0xc6,
+	0x63, 0xcb, 0xe9, 0xbc, 0xfa, 0xc7, 0x11, 0x58, 0xd8, 0x21, 0xb9, 0x39, 0x0f, 0x32, 0xfa, 0xcf,
+	0x62, 0x2f, 0x53, 0x12, 0xb2, 0x3c, 0x65, 0x88, 0x2c, 0xef, 0xd2, 0x4f, 0xe5, 0x77, 0x61, 0xda,
+	0xc4, 0x4f, 0xab, 0xa1, 0xa8, 0x49, 0x0f, 0x40, 0x13, 0x3f, 0xdd, 0xeb, 0x0d, 0x9c, 0x09, 0x01,
+	0xfc, 0xbc, 0xf2, 0xa4, 0x3f, 0x8a, 0xc0, 0x62, 0x8f, 0xbd, 0x9c, 0x96, 0x65, 0x3a, 0x18, 0xfd,
+	0x96, 0x02, 0x59, 0x3b, 0x40, 0x50, 0xaf, 0x21, 0x11, 0xb0, 0xdd, 0x74, 0x99, 0x09, 0x13, 0x1b,
+	0xef, 0x78, 0x07, 0x6d, 0x3f, 0x06, 0x85, 0x4a, 0x68, 0x70, 0x85, 0x8d, 0x65, 0x27, 0xf0, 0x2b,
+	0xdd, 0x4e, 0xfe, 0x25, 0xbb, 0x3f, 0x85, 0xa0, 0xf0, 0xe2, 0x00, 0x92, 0x9c, 0x0d, 0x4b, 0x67,
+	0xf1, 0xbf, 0x94, 0x02, 0xdb, 0x84, 0x79, 0xa1, 0x5a, 0x64, 0xb3, 0xa4, 0x9d, 0xfe, 0x51, 0xca,
+	0x99, 0x57, 0x61, 0x02, 0xdb, 0xb6, 0x65, 0x8b, 0x32, 0x29, 0x40, 0x24, 0xa5, 0x00, 0xf5, 0x3b,
+	0x90, 0xe9, 0x91, 0x87, 0x1e, 0x03, 0x62, 0x05, 0x2d, 0xfb, 0xe6, 0x15, 0x2d, 0x5b, 0x8f, 0x5c,
+	0xb8, 0xa2, 0x0d, 0x74, 0x2c, 0x2e, 0x77, 0x3b, 0xf9, 0x1c, 0xad, 0x5b, 0x03, 0xa0, 0x68, 0xe9,
+	0x74, 0x18, 0xa7, 0xfe, 0x6d, 0x02, 0x26, 0xe8, 0x81, 0x3f, 0x74, 0xc3, 0xaa, 0x0c, 0x33, 0x9e,
+	0x3f, 0x57, 0x0f, 0xb5, 0xba, 0xcb, 0x67, 0xa9, 0x30, 0xaf, 0xf4, 0x50, 0xb7, 0x28, 0x46, 0xf4,
+	0x4a, 0x19, 0x43, 0x3a, 0x3c, 0x34, 0x6f, 0x61, 0x69, 0x0c, 0x2f, 0x6d, 0x69, 0xf4, 0x25, 0x60,
+	0x96, 0x7e, 0x88, 0xd1, 0x37, 0x80, 0x92, 0x5d, 0x45, 0xb3, 0x1d, 0x6f, 0x2c, 0x2b, 0xe8, 0xe8,
+	0xae, 0xa2, 0xf0, 0x9e, 0xc1, 0x09, 0x01, 0x8c, 0x8e, 0x60, 0xc6, 0x3f, 0xe2, 0x9b, 0xba, 0xa1,
+	0xbb, 0xde, 0x05, 0xc6, 0x32, 0x35, 0x2c, 0x35, 0x86, 0x7f, 0xa6, 0xdf, 0xa5, 0x04, 0xcc, 0x9b,
+	0x89, 0x71, 0xb3, 0xb6, 0x84, 0x90, 0x52, 0x94, 0x94, 0x8c, 0x43, 0x3f, 0x52, 0xe0, 0x7a, 0x48,
+	0x52, 0xb5, 0x76, 0xea, 0x07, 0x03, 0xd2, 0xf7, 0x75, 0x1c, 0xd6, 0xa6, 0x9a, 0x12, 0xae, 0x33,
+	0xfa, 0x29, 0x50, 0x3c, 0xf5, 0x82, 0x42, 0x89, 0x0c, 0x22, 0x2d, 0x2b, 0xa6, 0xd3, 0x7a, 0xb7,
+	0x93, 0x7f, 0xcd, 0x3e, 0x8f, 0x56, 0x30, 0xc5, 0x4b, 0xe7, 0x12, 0xa3, 0x7d, 0x48, 0xb4, 0xb0,
+	0x6d, 0xe8, 0x8e, 0x43, 0xf3, 0x79, 0x76, 0xd5, 0xb2, 0x20, 0xe8, 0xb6, 0x17, 0x60, 0x99, 0xd5,
+	0x05, 0x72, 0xd1, 0xea, 0x02, 0x98, 0xe4, 0x8e, 0x75, 0xcb, 0x6e, 0x58, 0x26, 0x66, 0x77, 0x57,
+	0x31, 0x5e, 0x34, 0x71, 0x98, 0x54, 0x34, 0x71, 0x18, 0xba, 0x07, 0x19, 0x96, 0xf2, 0x57, 0x1b,
+	0xb8, 0x65, 0xe3, 0x3a, 0xcd, 0x7f, 0xe2, 0x74, 0xb1, 0x57, 0x88, 0xa3, 0x33, 0xe4, 0x96, 0x8f,
+	0x93, 0x56, 0x23, 0x1d, 0xc6, 0xa2, 0x2d, 0xbf, 0xd6, 0x81, 0x9e, 0x29, 0x0d, 0x5f, 0xed, 0x14,
+	0x21, 0x65, 0x63, 0xd7, 0x3e, 0xad, 0xb6, 0xac, 0xa6, 0x5e, 0xd7, 0x31, 0xab, 0x47, 0xe2, 0xc5,
+	0xab, 0xdd, 0x4e, 0x7e, 0x91, 0x62, 0xf6, 0x38, 0x42, 0x18, 0x9c, 0x94, 0x10, 0xb9, 0x7f, 0x53,
+	0x20, 0x21, 0x18, 0x11, 0x55, 0x20, 0xe6, 0xb4, 0x6b, 0x4f, 0x70, 0xdd, 0x0f, 0xba, 0xcb, 0xfd,
+	0xcd, 0x5d, 0xd8, 0x67, 0x64, 0x3c, 0xb1, 0xe2, 0x63, 0xa4, 0xc4, 0x8a, 0xc3, 0x68, 0xd8, 0xc3,
+	0x76, 0x8d, 0x35, 0xf7, 0xbc, 0xb0, 0x47, 0x00, 0x52, 0xd8, 0x23, 0x80, 0xdc, 0x47, 0x30, 0xc5,
+	0xf9, 0x92, 0x20, 0x70, 0xac, 0x9b, 0x0d, 0x31, 0x08, 0x90, 0x6f, 0x31, 0x08, 0x90, 0x6f, 0x3f,
+	0x58, 0x44, 0xce, 0x0e, 0x16, 0x39, 0x1d, 0x66, 0xfb, 0x6c, 0xa5, 0x0b, 0x04, 0x6e, 0xe5, 0xdc,
+	0x8c, 0xe4, 0xb7, 0x15, 0xb8, 0x3e, 0xdc, 0xae, 0x19, 0x4e, 0xfc, 0x1d, 0x51, 0xbc, 0x57, 0x6f,
+	0x4a, 0x0c, 0x43, 0xd2, 0xce, 0x53, 0xf0, 0xf2, 0xb3, 0x3f, 0xf5, 0xd7, 0x26, 0xe0, 0xea, 0x19,
+	0x2a, 0x92, 0x52, 0xe7, 0x8a, 0xa1, 0x7d, 0xa6, 0x1b, 0x6d, 0x23, 0xa8, 0x73, 0x0e, 0x6d, 0xad,
+	0x4e, 0x8e, 0x56, 0xee, 0x7a, 0x3f, 0x7d, 0xde, 0x44, 0x0b, 0xf7, 0x18, 0x07, 0x0f, 0x7a, 0x8b,
+	0x8f, 0x17, 0xce, 0x7c, 0xa3, 0x3f, 0x85, 0x78, 0xe6, 0x0f, 0x20, 0x41, 0x7f, 0xa6, 0xc0, 0x4b,
+	0x03, 0x55, 0xa4, 0xf1, 0xd3, 0xb2, 0x9a, 0xd4, 0xa9, 0x13, 0x1b, 0xa5, 0x8b, 0xaa, 0x5a, 0x3c,
+	0xdd, 0xb3, 0xac, 0x26, 0x53, 0xf8, 0xb5, 0x6e, 0x27, 0xff, 0x55, 0xe3, 0x2c, 0x3a, 0x41, 0xed,
+	0x6b, 0x67, 0x12, 0x92, 0x84, 0xe5, 0x2c, 0xe3, 0x5c, 0x96, 0xdf, 0xab, 0xe7, 0x4f, 0x73, 0x38,
+	0xd1, 0xf7, 0x65, 0x9f, 0xff, 0x4a, 0xaf, 0x7d, 0x09, 0xc3, 0xd1, 0xfc, 0x5e, 0xfd, 0xf3, 0x08,
+	0xe4, 0xcf, 0xe1, 0x81, 0x7e, 0x7f, 0x08, 0xc7, 0xdc, 0x1c, 0x46, 0x9b, 0x4b, 0x75, 0xce, 0x9f,
+	0xc4, 0xfa, 0xaa, 0x65, 0x88, 0xd3, 0x73, 0xe0, 0xae, 0xee, 0xb8, 0xe8, 0x06, 0x4c, 0xd2, 0xca,
+	0xc2, 0x3b, 0x27, 0x20, 0x38, 0x27, 0xd8, 0xb9, 0xc5, 0xb0, 0xe2, 0xb9, 0xc5, 0x20, 0xea, 0x01,
+	0x20, 0xd6, 0x55, 0x6e, 0x0a, 0x79, 0x34, 0xb9, 0xf7, 0xaa, 0x33, 0x28, 0x6e, 0x08, 0x65, 0x13,
+	0xbd, 0xf7, 0xf2, 0x11, 0x72, 0xf1, 0x34, 0x2d, 0xc2, 0xd5, 0xff, 0x56, 0x20, 0xcd, 0x6f, 0x44,
+	0x02, 0xae, 0x3f, 0x0f, 0xa8, 0xe5, 0xc3, 0x42, 0xe5, 0xc4, 0xeb, 0x7c, 0x15, 0xe5, 0x21, 0x3d,
+	0x00, 0x7e, 0x16, 0xe7, 0xbb, 0x9d, 0xfc, 0xd5, 0x56, 0x18, 0x27, 0x68, 0x93, 0xe9, 0x41, 0xe6,
+	0x9a, 0xb0, 0xd0, 0x9f, 0xdb, 0xa5, 0x84, 0xdc, 0xbf, 0x8a, 0x42, 0x82, 0xb6, 0xa1, 0x9b, 0x98,
+	0xe6, 0xb5, 0x3b, 0x7e, 0x96, 0xc1, 0xe6, 0xbb, 0x14, 0xf4, 0xd2, 0x19, 0xc5, 0x08, 0xb9, 0xc6,
+	0xb7, 0xe4, 0xce, 0x6a, 0x84, 0x37, 0x47, 0xc2, 0x0c, 0x2f, 0xdc, 0x61, 0x7d, 0x1f, 0x52, 0x5e,
+	0xfd, 0xdb, 0xb2, 0xf1, 0xa1, 0xfe, 0x19, 0x2f, 0x69, 0xe9, 0xf2, 0xb3, 0x7a, 0x77, 0x8f, 0xc2,
+	0xc5, 0xe5, 0x17, 0xe1, 0xff, 0x8b, 0x3a, 0x1a, 0xea, 0xbf, 0x2b, 0x90, 0x15, 0xac, 0x2a, 0x5f,
+	0xc1, 0x8c, 0x70, 0xcb, 0xb7, 0x45, 0x3a, 0x63, 0x8c, 0x07, 0x0f, 0xa3, 0xe9, 0xf0, 0x8a, 0x79,
+	0x7d, 0x31, 0xf6, 0x25, 0xf7, 0xc5, 0xb8, 0x1b, 0x05, 0x37, 0x2a, 0xd1, 0x21, 0x6e, 0x54, 0xde,
+	0x80, 0xa9, 0x86, 0x7d, 0x5a, 0xb5, 0xdb, 0x26, 0xbf, 0x2a, 0xa7, 0xe4, 0x0d, 0xfb, 0xb4, 0xd2,
+	0x96, 0xc8, 0x19, 0x84, 0x6c, 0xda, 0x65, 0x41, 0x9d, 0x7e, 0x9d, 0x94, 0x17, 0x3e, 0xe1, 0x70,
+	0xab, 0x24, 0x3a, 0x52, 0xab, 0x64, 0x44, 0x03, 0x3c, 0x60, 0x45, 0xb7, 0x3f, 0x7f, 0x1a, 0xb5,
+	0xde, 0x85, 0x69, 0x43, 0x73, 0x49, 0xb3, 0x8e, 0x3c, 0x3d, 0x72, 0xe8, 0xcc, 0x27, 0x98, 0x06,
+	0x1c, 0xbe, 0x63, 0x49, 0xe9, 0x73, 0x42, 0x00, 0xab, 0x3f, 0x9c, 0x80, 0x19, 0x1a, 0x87, 0xc9,
+	0xe7, 0x41, 0xab, 0xa1, 0xb9, 0x18, 0xfd, 0x02, 0xcc, 0xd6, 0x2d, 0xd3, 0xd5, 0x74, 0x13, 0xdb,
+	0xfe, 0xb1, 0x26, 0x07, 0xc2, 0xd0, 0x90, 0x42, 0xc9, 0xa3, 0xf7, 0x0e, 0x18, 0xbe, 0xa5, 0x49,
+	0xc1, 0xb3, 0x54, 0xef, 0x41, 0x0a, 0xda, 0xa0, 0x5e, 0x2c, 0x3a, 0x82, 0x24, 0xbd, 0x39, 0x11,
+	0xd6, 0x87, 0x88, 0xbe, 0xde, 0x57, 0x34, 0xe9, 0xe3, 0x79, 0x26, 0x61, 0x42, 0x69, 0x1c, 0x30,
+	0x05, 0xb0, 0x18, 0x07, 0x44, 0x38, 0xfa, 0x26, 0x24, 0x5c, 0xab, 0x89, 0x6d, 0xe9, 0x45, 0xc8,
+	0x72, 0xbf, 0x46, 0xeb, 0x43, 0x9f, 0x8c, 0x99, 0x56, 0x18, 0x26, 0x9a, 0x56, 0x00, 0xa3, 0xfb,
+	0x30, 0xdb, 0xaf, 0x68, 0x66, 0x57, 0x8d, 0xfc, 0x84, 0x18, 0x5c, 0xf1, 0x66, 0x7a, 0x90, 0xb9,
+	0xdf, 0x50, 0x60, 0x71, 0x80, 0xa1, 0x87, 0x0b, 0x2d, 0xfb, 0x72, 0x9e, 0x34, 0xfc, 0xb5, 0xc0,
+	0x79, 0xc1, 0xee, 0x08, 0x32, 0x3d, 0xcb, 0x70, 0x29, 0xd1, 0xee, 0x5f, 0xd9, 0x43, 0x0d, 0xb6,
+	0xf4, 0x5f, 0xd6, 0xf6, 0x69, 0x11, 0x26, 0xdb, 0x54, 0x41, 0xba, 0xd6, 0xde, 0xed, 0x64, 0xc8,
+	0x6f, 0x99, 0x92, 0x8c, 0x4e, 0x54, 0x92, 0x41, 0xd4, 0xff, 0x50, 0x20, 0xe3, 0xd3, 0xfa, 0x0d,
+	0x36, 0x03, 0x52, 0x0c, 0x1f, 0xca, 0x4e, 0x5e, 0xf5, 0x22, 0x97, 0x4c, 0x5f, 0xf0, 0x3f, 0x83,
+	0xd4, 0x84, 0x16, 0xfc, 0x6d, 0x11, 0x2e, 0x16, 0xfc, 0x12, 0x22, 0xf7, 0x18, 0x50, 0x2f, 0x87,
+	0x4b, 0x59, 0xd7, 0xbf, 0x8c, 0x40, 0x6a, 0xc7, 0xaa, 0xdd, 0xb3, 0x4e, 0xbe, 0xb4, 0xab, 0x7a,
+	0x07, 0x32, 0x0d, 0xec, 0xb8, 0xba, 0xc9, 0xba, 0xcd, 0x6c, 0x18, 0xdb, 0xcc, 0xb4, 0x7f, 0x29,
+	0x20, 0x1f, 0x84, 0x38, 0xa4, 0xc3, 0x38, 0xf4, 0x08, 0x16, 0x44, 0x66, 0x82, 0xe6, 0xac, 0x55,
+	0xfe, 0x52, 0xb7, 0x93, 0xbf, 0x26, 0x50, 0xec, 0xf4, 0x4e, 0x62, 0xb6, 0x0f, 0x5a, 0xed, 0x28,
+	0x30, 0xe3, 0xdb, 0x91, 0x3b, 0x4d, 0x1d, 0xa6, 0x0d, 0xeb, 0x24, 0xec, 0x32, 0xfe, 0x8b, 0x6a,
+	0x91, 0xb6, 0xc0, 0x3f, 0x02, 0x77, 0x61, 0xe7, 0x48, 0x00, 0x95, 0xce, 0x91, 0x00, 0x4c, 0xd2,
+	0x9d, 0xf0, 0xd8, 0x4b, 0x71, 0x94, 0xbf, 0x53, 0x78, 0xe3, 0xd9, 0x69, 0x61, 0xb3, 0xf1, 0x65,
+	0xf5, 0x95, 0x91, 0x1e, 0x96, 0xa8, 0xbf, 0xc7, 0x82, 0x1a, 0xb1, 0x9d, 0xf1, 0x65, 0x75, 0x7f,
+	0xf5, 0x0f, 0x14, 0xf6, 0x53, 0x02, 0xec, 0x86, 0x6c, 0x7f, 0xe9, 0xcf, 0x7c, 0x46, 0xca, 0x29,
+	0xd5, 0x13, 0xef, 0x31, 0x92, 0x6c, 0xce, 0xcb, 0xd6, 0x92, 0x38, 0xe6, 0xac, 0xef, 0x98, 0x4e,
+	0x50, 0x54, 0x3e, 0x82, 0xa9, 0x01, 0x1b, 0x2f, 0x4c, 0x5a, 0x90, 0x36, 0x1e, 0xbd, 0xc9, 0xb6,
+	0x7b, 0x36, 0x9d, 0xc7, 0x2c, 0x57, 0x83, 0xe9, 0x4b, 0xdf, 0x6c, 0xbf, 0x18, 0x81, 0x44, 0xc5,
+	0x6f, 0x01, 0x9f, 0x8e, 0xf2, 0x38, 0x98, 0x35, 0x9b, 0xe9, 0xf5, 0x01, 0x15, 0x96, 0x64, 0x97,
+	0x24, 0x14, 0x4c, 0x5b, 0x1e, 0xc2, 0x20, 0x08, 0xa0, 0xe8, 0x21, 0xa4, 0x1a, 0xf8, 0x50, 0x6b,
+	0x37, 0xdd, 0x2a, 0xef, 0xa2, 0x44, 0x85, 0x07, 0xa2, 0x54, 0x99, 0x4d, 0x0a, 0x67, 0x07, 0x19,
+	0xa7, 0xdd, 0x0c, 0xb7, 0x42, 0x92, 0x12, 0x02, 0xbd, 0x03, 0x13, 0x76, 0xbb, 0x89, 0xbd, 0x5f,
+	0x93, 0xa4, 0x02, 0x66, 0x95, 0x76, 0x13, 0x33, 0x3b, 0x50, 0x02, 0xd1, 0x0e, 0x14, 0xa0, 0xfe,
+	0x43, 0x04, 0xe2, 0x3e, 0x25, 0xfa, 0x3a, 0x4c, 0xfa, 0xcd, 0x9d, 0xfe, 0x6a, 0x51, 0xef, 0xec,
+	0x69, 0xcd, 0xf0, 0x51, 0xc4, 0x32, 0x96, 0x59, 0xad, 0x6b, 0x2e, 0x3e, 0xb2, 0x6c, 0xef, 0x5e,
+	0x94, 0x5a, 0xc6, 0x32, 0x4b, 0x1c, 0x2a, 0x5a, 0x26, 0x80, 0x92, 0x0e, 0xbe, 0x65, 0x56, 0x9d,
+	0x76, 0xcd, 0x1f, 0xcd, 0x1e, 0xeb, 0x51, 0x3b, 0x58, 0xe6, 0x7e, 0x80, 0x10, 0xed, 0x20, 0x21,
+	0xd0, 0xfb, 0x30, 0x69, 0xb4, 0x5d, 0xcd, 0x65, 0x6f, 0xad, 0xbc, 0xc7, 0x0f, 0x54, 0xfd, 0x7b,
+	0x6d, 0x57, 0x0b, 0x26, 0xc0, 0xa8, 0xc4, 0x09, 0x30, 0xc8, 0xce, 0x78, 0x2c, 0x92, 0x8e, 0xee,
+	0x8c, 0xc7, 0xa2, 0xe9, 0xf1, 0x9d, 0xf1, 0xd8, 0x78, 0x7a, 0x82, 0x88, 0xa8, 0xd6, 0x2d, 0xb3,
+	0xa1, 0x93, 0xd1, 0x0e, 0xfd, 0xc4, 0x9f, 0xe9, 0x6e, 0xb5, 0x6e, 0x35, 0xb0, 0x53, 0x59, 0xb0,
+	0xcc, 0xaa, 0x8b, 0x6d, 0xc3, 0x3b, 0xe9, 0x0c, 0xec, 0x38, 0xda, 0x11, 0x56, 0xff, 0x54, 0x81,
+	0xa4, 0x24, 0x17, 0xed, 0x42, 0x4c, 0x3b, 0x3c, 0xd4, 0x4d, 0xef, 0x27, 0x49, 0xde, 0x95, 0x21,
+	0x33, 0x2e, 0xc7, 0xf8, 0x5a, 0xd2, 0xbd, 0xeb, 0xd1, 0x8b, 0x7b, 0xd7, 0x83, 0xa1, 0x07, 0x10,
+	0x0f, 0x6a, 0x97, 0x48, 0x98, 0xa1, 0x97, 0xe6, 0xfa, 0x0c, 0xe9, 0x43, 0x08, 0xbb, 0x4f, 0x81,
+	0x12, 0x70, 0x51, 0x3f, 0x81, 0xf9, 0xbe, 0xda, 0xa0, 0x12, 0xcc, 0x68, 0x27, 0x96, 0xde, 0xa8,
+	0x3a, 0x9a, 0x81, 0xe9, 0xab, 0x2f, 0x3a, 0x85, 0x18, 0x5b, 0x1c, 0x8a, 0xda, 0xd7, 0x0c, 0x4c,
+	0x72, 0x64, 0x71, 0x71, 0x24, 0x84, 0xfa, 0x33, 0x30, 0xdf, 0x57, 0x35, 0x72, 0x03, 0x64, 0x60,
+	0x83, 0xac, 0x38, 0xb3, 0xcb, 0x42, 0xef, 0x34, 0x8a, 0x6d, 0xa3, 0xc5, 0x57, 0x8e, 0x52, 0x4a,
+	0x2b, 0x47, 0x21, 0xaa, 0x05, 0x99, 0x9e, 0x21, 0x24, 0xb6, 0x3a, 0x44, 0x4a, 0x9d, 0xef, 0x69,
+	0xff, 0xb5, 0xa1, 0x5e, 0x0f, 0xbf, 0x36, 0xd4, 0xeb, 0x84, 0x5a, 0xba, 0x3a, 0xa5, 0xd4, 0x87,
+	0xe1, 0x2b, 0x53, 0x4e, 0xa3, 0xbe, 0x07, 0xf3, 0x42, 0xf0, 0xb8, 0x8d, 0xfd, 0xb7, 0xc7, 0x43,
+	0x86, 0x11, 0xb5, 0x08, 0x59, 0x81, 0xc1, 0x16, 0x6e, 0x62, 0x17, 0x8f, 0xca, 0x23, 0x0b, 0x0b,
+	0x02, 0x0f, 0xd2, 0x8c, 0xe4, 0x1c, 0xd4, 0x23, 0x98, 0x09, 0x61, 0x48, 0xf0, 0x09, 0x5d, 0x92,
+	0xb1, 0x90, 0x2d, 0xec, 0x72, 0x46, 0x3d, 0xca, 0xb5, 0x99, 0xfa, 0x0e, 0xaf, 0xb0, 0x2f, 0x60,
+	0x81, 0x77, 0x01, 0xd1, 0xa1, 0x25, 0x7a, 0xb7, 0x38, 0xea, 0xe8, 0xaf, 0xc3, 0x1c, 0x1d, 0x7d,
+	0x60, 0xd6, 0x2f, 0x34, 0xfe, 0x3d, 0xc8, 0xee, 0xbb, 0x36, 0xd6, 0x0c, 0xdd, 0x3c, 0x0a, 0xcf,
+	0xe0, 0x65, 0x88, 0x9a, 0x6d, 0x83, 0xb2, 0x48, 0xb2, 0xe3, 0xc6, 0x6c, 0x1b, 0xe2, 0x71, 0x63,
+	0xb6, 0x0d, 0x5f, 0xfd, 0x8b, 0x2d, 0xdd, 0x0f, 0x14, 0x00, 0x9e, 0xd8, 0x9a, 0x87, 0xd6, 0x28,
+	0x87, 0x0f, 0x3d, 0x91, 0x79, 0x3b, 0x24, 0x42, 0xdb, 0x21, 0x34, 0xc4, 0x7e, 0xea, 0x55, 0x60,
+	0xd2, 0x0d, 0x7d, 0x00, 0x25, 0x43, 0x9b, 0x58, 0x73, 0xbc, 0xa1, 0xd1, 0x60, 0x28, 0x03, 0x87,
+	0x87, 0x06, 0x50, 0xf5, 0x29, 0xcc, 0x32, 0x5b, 0xcb, 0x05, 0xdb, 0xd7, 0xc4, 0x7e, 0x94, 0xdc,
+	0xf7, 0x3e, 0x2b, 0xe5, 0x19, 0xe1, 0x21, 0x46, 0x1b, 0xb2, 0x45, 0xd2, 0xcf, 0xe9, 0x27, 0xfd,
+	0x23, 0x48, 0x1e, 0x6a, 0x7a, 0xd3, 0x7b, 0x7b, 0xe9, 0xb9, 0x73, 0x36, 0xd0, 0x42, 0x1e, 0xc0,
+	0x3a, 0x27, 0x6c, 0xc8, 0x83, 0x70, 0x47, 0x7e, 0x5a, 0x84, 0xfb, 0xf3, 0x2d, 0xd9, 0x58, 0x60,
+	0xf0, 0xa2, 0xe7, 0x1b, 0x92, 0x7e, 0xfe, 0x7c, 0xe5, 0x01, 0x23, 0xcc, 0x37, 0x01, 0xf1, 0xb2,
+	0xd9, 0xb8, 0xa7, 0xd9, 0xc7, 0xd8, 0x56, 0xbf, 0xa7, 0xc0, 0xbc, 0xbc, 0x33, 0xee, 0xb1, 0x63,
+	0x0d, 0xfd, 0xff, 0xd1, 0xe6, 0xff, 0xc1, 0x58, 0xf0, 0x10, 0x3a, 0x8a, 0xcd, 0x06, 0x3f, 0xa7,
+	0x58, 0x7e, 0xe2, 0xcb, 0x63, 0xfb, 0x0b, 0x8b, 0xf7, 0xd9, 0x1f, 0x8c, 0x55, 0x08, 0x7d, 0x71,
+	0x0a, 0x26, 0xf0, 0x09, 0x36, 0x5d, 0xf5, 0x4f, 0x14, 0xbe, 0x20, 0xa1, 0xdf, 0x79, 0x0c, 0xbb,
+	0x6b, 0x6e, 0xc3, 0x8c, 0xd4, 0x70, 0xc2, 0xde, 0x0d, 0x3c, 0xfd, 0xb9, 0x49, 0x08, 0x25, 0x8c,
+	0x0e, 0x8f, 0x62, 0xbf, 0xce, 0xb1, 0x9a, 0xde, 0xd3, 0x18, 0xfe, 0xeb, 0x1c, 0xab, 0x19, 0xfa,
+	0x75, 0x8e, 0xd5, 0x74, 0xd4, 0xff, 0x52, 0xbc, 0xf0, 0x26, 0x35, 0xad, 0x5f, 0xb8, 0xca, 0x5b,
+	0x10, 0x7f, 0xc2, 0x9f, 0xdb, 0x33, 0xb5, 0x7b, 0x1e, 0xe1, 0xd3, 0xe4, 0xc0, 0xa7, 0x11, 0x93,
+	0x03, 0x1f, 0x18, 0x4c, 0x7c, 0xfc, 0xbc, 0x89, 0xaf, 0xe5, 0x20, 0x21, 0xfc, 0xbc, 0x0d, 0x25,
+	0x60, 0x8a, 0x7f, 0xa6, 0xc7, 0xd6, 0x5e, 0x85, 0x84, 0xf0, 0x33, 0x28, 0x34, 0x0d, 0x31, 0x92,
+	0x1c, 0xec, 0x59, 0xb6, 0x9b, 0x1e, 0x23, 0x5f, 0x1f, 0x60, 0xad, 0xd1, 0x24, 0xa4, 0xca, 0xda,
+	0xef, 0x28, 0x10, 0xf3, 0x54, 0x44, 0x00, 0x93, 0x0f, 0x0e, 0xca, 0x07, 0xe5, 0xad, 0xf4, 0x18,
+	0x61, 0xb8, 0x57, 0xde, 0xdd, 0xda, 0xde, 0xbd, 0x9d, 0x56, 0xc8, 0x47, 0xe5, 0x60, 0x77, 0x97,
+	0x7c, 0x44, 0x50, 0x12, 0xe2, 0xfb, 0x07, 0xa5, 0x52, 0xb9, 0xbc, 0x55, 0xde, 0x4a, 0x47, 0xc9,
+	0xa0, 0x5b, 0x9b, 0xdb, 0x77, 0xcb, 0x5b, 0xe9, 0x71, 0x42, 0x77, 0xb0, 0x7b, 0x67, 0xf7, 0xfe,
+	0x37, 0x76, 0xd3, 0x13, 0x8c, 0xae, 0x78, 0x6f, 0xfb, 0xe1, 0xc3, 0xf2, 0x56, 0x7a, 0x92, 0xd0,
+	0xdd, 0x2d, 0x6f, 0xee, 0x97, 0xb7, 0xd2, 0x53, 0x04, 0xb5, 0x57, 0x29, 0x97, 0xef, 0xed, 0x11,
+	0x54, 0x8c, 0x7c, 0x96, 0x36, 0x77, 0x4b, 0xe5, 0xbb, 0x84, 0x4b, 0x9c, 0x68, 0x58, 0x29, 0xef,
+	0x94, 0x4b, 0x04, 0x09, 0x6b, 0x1f, 0x43, 0x42, 0xc8, 0x8d, 0xd1, 0x12, 0x64, 0x2b, 0xe5, 0x87,
+	0x95, 0x8f, 0xaa, 0x9b, 0xa5, 0x87, 0xdb, 0xf7, 0x77, 0xab, 0x07, 0xbb, 0xfb, 0x7b, 0xe5, 0xd2,
+	0xf6, 0xad, 0x6d, 0xaa, 0xf5, 0x3c, 0x64, 0x24, 0x2c, 0xd1, 0x2c, 0xad, 0xa0, 0x05, 0x40, 0x12,
+	0x98, 0x7e, 0xa4, 0x23, 0x1b, 0x7f, 0x33, 0x01, 0xd3, 0xd4, 0x7b, 0xbc, 0xd7, 0xad, 0x6f, 0x43,
+	0x82, 0x6d, 0x6f, 0x0a, 0x45, 0xc2, 0xde, 0xcb, 0x2d, 0xf4, 0xbc, 0x3b, 0x2e, 0x93, 0xf5, 0x50,
+	0xc7, 0xd0, 0x7b, 0x30, 0x2d, 0x0c, 0x72, 0x50, 0x2a, 0x18, 0x45, 0x52, 0x82, 0xdc, 0x35, 0xfa,
+	0x3d, 0x28, 0xe2, 0xa8, 0x63, 0x44, 0x2a, 0x0b, 0xa2, 0x23, 0x4a, 0x15, 0x06, 0x9d, 0x2f, 0x55,
+	0x0e, 0xd3, 0xea, 0x18, 0x7a, 0x1f, 0x12, 0xec, 0x50, 0x65, 0x52, 0x17, 0x83, 0xf1, 0xd2, 0x59,
+	0x7b, 0x86, 0x0a, 0x05, 0x88, 0xdd, 0xc6, 0x2e, 0x1b, 0x2e, 0x34, 0x28, 0x83, 0x23, 0x3e, 0x27,
+	0x4c, 0x45, 0x1d, 0x43, 0x3b, 0x10, 0xf7, 0xe8, 0x1d, 0xc4, 0xf4, 0x1b, 0x94, 0x1c, 0xe4, 0x72,
+	0x7d, 0xd0, 0x3c, 0x42, 0xaa, 0x63, 0x6f, 0x2a, 0x44, 0x7b, 0x96, 0xd1, 0xf4, 0x68, 0x2f, 0x25,
+	0x3a, 0x67, 0x68, 0xbf, 0x05, 0x49, 0x2f, 0xab, 0x61, 0x3c, 0xae, 0x08, 0x67, 0x9a, 0x59, 0x1f,
+	0x9a, 0x4b, 0x8a, 0x87, 0xcb, 0xfb, 0x9c, 0x8d, 0x70, 0x54, 0xc8, 0x81, 0xf4, 0x0c, 0x2e, 0x45,
+	0x48, 0xb2, 0x00, 0x76, 0xbf, 0xcf, 0x7c, 0xc4, 0xc8, 0x36, 0x98, 0xc7, 0xc6, 0x77, 0xc7, 0x01,
+	0x09, 0xf9, 0xa5, 0xe7, 0xd2, 0x1f, 0x43, 0xc6, 0x73, 0x38, 0x1f, 0x87, 0x7a, 0xb2, 0xd1, 0x81,
+	0x7c, 0xaf, 0xfe, 0xd2, 0xdf, 0xff, 0xcb, 0xaf, 0x47, 0xe6, 0x6f, 0x2a, 0x6b, 0x6a, 0x9a, 0xfc,
+	0x4b, 0x09, 0x9a, 0x97, 0xbe, 0xd1, 0x62, 0x6c, 0x34, 0xc8, 0x78, 0x6e, 0x75, 0x11, 0xde, 0x2a,
+	0xe5, 0xbd, 0x94, 0x5b, 0x0c, 0x33, 0x5e, 0xff, 0x39, 0x12, 0x9d, 0xbf, 0x73, 0x53, 0x59, 0x43,
+	0xc7, 0x90, 0xf1, 0xdc, 0x31, 0x10, 0x71, 0x2d, 0x2c, 0x62, 0x38, 0x8f, 0xcd, 0x53, 0x79, 0x57,
+	0xd6, 0x06, 0xc9, 0x43, 0x55, 0x48, 0x51, 0x17, 0x0c, 0x24, 0xe5, 0xc2, 0x92, 0x04, 0x17, 0xed,
+	0x99, 0xa8, 0x27, 0x00, 0x0d, 0x14, 0xa0, 0x41, 0x5a, 0x12, 0xa0, 0x63, 0x07, 0x5d, 0x0d, 0xb3,
+	0x11, 0x2a, 0x8c, 0xdc, 0x5c, 0x3f, 0xa4, 0x9a, 0xa3, 0x72, 0xe6, 0x10, 0x0a, 0xc9, 0xd1, 0xb1,
+	0xb3, 0xf1, 0xa3, 0x19, 0x98, 0x64, 0xef, 0x63, 0xd1, 0x23, 0x00, 0xf6, 0x17, 0xcd, 0x4c, 0xe7,
+	0xfb, 0xfe, 0x1e, 0x34, 0xb7, 0xd0, 0xff, 0x51, 0xad, 0x7a, 0x85, 0xca, 0x98, 0x55, 0x53, 0x44,
+	0xc6, 0x13, 0xab, 0xc6, 0xff, 0xb5, 0x09, 0x59, 0x93, 0x6f, 0x00, 0x30, 0xa7, 0x94, 0xf9, 0xca,
+	0x8e, 0xca, 0x3c, 0xb8, 0xf7, 0xe1, 0x85, 0xc7, 0x98, 0x78, 0x94, 0xcf, 0x9b, 0x3d, 0xac, 0x40,
+	0xdf, 0x82, 0x69, 0x9f, 0xf1, 0x3e, 0x76, 0xf9, 0x56, 0xea, 0xf3, 0xc3, 0xc0, 0x81, 0x4b, 0xbc,
+	0x44, 0x99, 0x2f, 0xa8, 0x19, 0xce, 0xd9, 0xc1, 0x2e, 0x67, 0x4e, 0x14, 0x37, 0x21, 0x2d, 0xde,
+	0xf8, 0x52, 0xf5, 0xaf, 0xf6, 0x7f, 0xe4, 0xcd, 0xc4, 0x2c, 0x9d, 0xf5, 0x02, 0xdc, 0x5b, 0x6e,
+	0x75, 0xce, 0x9b, 0x86, 0xf0, 0x9a, 0x1b, 0x13, 0x79, 0x1f, 0x42, 0x82, 0x87, 0x00, 0x2a, 0xca,
+	0x37, 0x75, 0x28, 0x2e, 0xcc, 0xf7, 0x7d, 0x18, 0xe2, 0xad, 0xb2, 0x3a, 0xe3, 0xb1, 0xe7, 0x0f,
+	0x3e, 0x08, 0x67, 0x17, 0xe6, 0x82, 0x25, 0x28, 0x9e, 0xfa, 0xb7, 0x91, 0xd7, 0xc2, 0xf7, 0xcf,
+	0x61, 0xb3, 0x85, 0xd0, 0x5c, 0xd4, 0x2b, 0x54, 0x54, 0x5e, 0xcd, 0xc9, 0x0b, 0xf2, 0x46, 0xed,
+	0xf4, 0x0d, 0xef, 0x02, 0x95, 0x48, 0xfd, 0x65, 0x05, 0x72, 0x61, 0x03, 0x0a, 0xc2, 0x5f, 0xee,
+	0xe5, 0xde, 0x6b, 0xd2, 0x41, 0x2a, 0xbc, 0x46, 0x55, 0x78, 0x45, 0x5d, 0xe9, 0x67, 0xcc, 0xb0,
+	0x22, 0x9f, 0x40, 0x5a, 0x38, 0xfc, 0x1a, 0xb2, 0x1f, 0x4a, 0x37, 0x7b, 0xb9, 0x85, 0x30, 0x58,
+	0xf6, 0x6f, 0xc9, 0x0d, 0xd9, 0xd5, 0x15, 0x69, 0x23, 0x91, 0x8b, 0x08, 0xca, 0x75, 0x56, 0xbe,
+	0xe3, 0x10, 0x77, 0x65, 0xe8, 0xe2, 0x43, 0x5d, 0xa4, 0x1c, 0x33, 0xea, 0xb4, 0xc7, 0x8e, 0x5c,
+	0x6e, 0x10, 0x6d, 0xbf, 0x09, 0x09, 0xde, 0xf0, 0x96, 0xdd, 0x40, 0xee, 0x82, 0xe7, 0xb2, 0x83,
+	0xba, 0xba, 0xbd, 0x9e, 0xe0, 0xb0, 0x91, 0xcc, 0xc7, 0x80, 0xb5, 0xa9, 0x65, 0x23, 0x48, 0xad,
+	0xeb, 0x33, 0x58, 0xf7, 0x6c, 0x73, 0x9b, 0x0e, 0x24, 0x9c, 0xeb, 0x90, 0x0c, 0xd4, 0x26, 0xdb,
+	0xf1, 0x8a, 0xb0, 0x1d, 0x87, 0xd6, 0xfd, 0x1a, 0x15, 0xb0, 0xa8, 0x22, 0x61, 0x47, 0x0a, 0xea,
+	0x7f, 0x1b, 0xa6, 0x7d, 0xf5, 0xc3, 0x5b, 0x7e, 0xd8, 0x39, 0xf4, 0xdb, 0xf4, 0xc1, 0x34, 0x6e,
+	0x8f, 0x9e, 0xd3, 0xcd, 0x51, 0x86, 0x29, 0x35, 0x4e, 0x18, 0xd2, 0xe2, 0x8a, 0xd9, 0xe3, 0x99,
+	0xf2, 0xbc, 0xaf, 0x50, 0xa6, 0xcb, 0xea, 0x15, 0xc2, 0xb4, 0xc6, 0xde, 0x4f, 0xac, 0xb3, 0x5f,
+	0xa6, 0xf1, 0x5a, 0x93, 0x08, 0xd9, 0x1d, 0x3d, 0x17, 0xe4, 0x47, 0x74, 0x2e, 0xed, 0x6b, 0x2b,
+	0x9c, 0x9f, 0xf5, 0x67, 0x4b, 0x13, 0xb9, 0xd2, 0x37, 0x95, 0xb5, 0x9c, 0xa4, 0x37, 0xbf, 0x42,
+	0x66, 0x7a, 0x93, 0x38, 0xf7, 0x4c, 0xa9, 0x64, 0x96, 0x4a, 0x41, 0x6b, 0x3d, 0x33, 0x20, 0x3f,
+	0xb6, 0x1b, 0x21, 0xc5, 0xe4, 0x7c, 0x50, 0x2f, 0x9f, 0xc6, 0x73, 0x4a, 0x3d, 0xa5, 0x93, 0xd7,
+	0x33, 0x06, 0xb3, 0xc2, 0x9b, 0x0a, 0xba, 0x09, 0x93, 0x1f, 0xd0, 0xff, 0x9d, 0x85, 0x06, 0xcc,
+	0x94, 0x3b, 0x31, 0x23, 0x2a, 0x3d, 0xc6, 0xf5, 0x63, 0xbf, 0x8f, 0xf0, 0xe1, 0x5f, 0x7f, 0xbe,
+	0xac, 0xfc, 0xf8, 0xf3, 0x65, 0xe5, 0x9f, 0x3f, 0x5f, 0x56, 0xbe, 0xf7, 0xc5, 0xf2, 0xd8, 0x8f,
+	0xbf, 0x58, 0x1e, 0xfb, 0xc7, 0x2f, 0x96, 0xc7, 0x3e, 0xfe, 0xea, 0x91, 0xee, 0x3e, 0x6e, 0xd7,
+	0x0a, 0x75, 0xcb, 0x58, 0xd7, 0x6c, 0x43, 0x6b, 0x68, 0x2d, 0xdb, 0x22, 0x6f, 0xd6, 0xf9, 0xd7,
+	0x3a, 0xff, 0xbf, 0x5d, 0x3f, 0x88, 0xcc, 0x6d, 0x52, 0xc0, 0x1e, 0x43, 0x17, 0xb6, 0xad, 0xc2,
+	0x66, 0x4b, 0xaf, 0x4d, 0x52, 0x1d, 0xde, 0xfe, 0x9f, 0x01, 0x00, 0x8b, 0xcc, 0xda, 0xe9, 0xa5,
+	0x4c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueueServiceClient is the client API for QueueService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueueServiceClient interface {
+	CreateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error)
+	CreateQueues(ctx context.Context, in *QueueList, opts ...grpc.CallOption) (*BatchQueueCreateResponse, error)
+	UpdateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error)
+	UpdateQueues(ctx context.Context, in *QueueList, opts ...grpc.CallOption) (*BatchQueueUpdateResponse, error)
+	DeleteQueue(ctx context.Context, in *QueueDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	GetQueue(ctx context.Context, in *QueueGetRequest, opts ...grpc.CallOption) (*Queue, error)
+	GetQueues(ctx context.Context, in *StreamingQueueGetRequest, opts ...grpc.CallOption) (QueueService_GetQueuesClient, error)
+	CordonQueue(ctx context.Context, in *QueueCordonRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	UncordonQueue(ctx context.Context, in *QueueUncordonRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	PreemptOnQueue(ctx context.Context, in *QueuePreemptRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	CancelOnQueue(ctx context.Context, in *QueueCancelRequest, opts ...grpc.CallOption) (*types.Empty, error)
+}
+
+type queueServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewQueueServiceClient(cc *grpc.ClientConn) QueueServiceClient {
+	return &queueServiceClient{cc}
+}
+
+func (c *queueServiceClient) CreateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error) {
 	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.Submit/CreateQueue", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/api.QueueService/CreateQueue", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *submitClient) CreateQueues(ctx context.Context, in *QueueList, opts ...grpc.CallOption) (*BatchQueueCreateResponse, error) {
+func (c *queueServiceClient) CreateQueues(ctx context.Context, in *QueueList, opts ...grpc.CallOption) (*BatchQueueCreateResponse, error) {
 	out := new(BatchQueueCreateResponse)
-	err := c.cc.Invoke(ctx, "/api.Submit/CreateQueues", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/api.QueueService/CreateQueues", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *submitClient) UpdateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error) {
+func (c *queueServiceClient) UpdateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error) {
 	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.Submit/UpdateQueue", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/api.QueueService/UpdateQueue", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *submitClient) UpdateQueues(ctx context.Context, in *QueueList, opts ...grpc.CallOption) (*BatchQueueUpdateResponse, error) {
+func (c *queueServiceClient) UpdateQueues(ctx context.Context, in *QueueList, opts ...grpc.CallOption) (*BatchQueueUpdateResponse, error) {
 	out := new(BatchQueueUpdateResponse)
-	err := c.cc.Invoke(ctx, "/api.Submit/UpdateQueues", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/api.QueueService/UpdateQueues", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *submitClient) DeleteQueue(ctx context.Context, in *QueueDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+func (c *queueServiceClient) DeleteQueue(ctx context.Context, in *QueueDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.Submit/DeleteQueue", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/api.QueueService/DeleteQueue", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *submitClient) GetQueue(ctx context.Context, in *QueueGetRequest, opts ...grpc.CallOption) (*Queue, error) {
+func (c *queueServiceClient) GetQueue(ctx context.Context, in *QueueGetRequest, opts ...grpc.CallOption) (*Queue, error) {
 	out := new(Queue)
-	err := c.cc.Invoke(ctx, "/api.Submit/GetQueue", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/api.QueueService/GetQueue", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *submitClient) GetQueues(ctx context.Context, in *StreamingQueueGetRequest, opts ...grpc.CallOption) (Submit_GetQueuesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Submit_serviceDesc.Streams[0], "/api.Submit/GetQueues", opts...)
+func (c *queueServiceClient) GetQueues(ctx context.Context, in *StreamingQueueGetRequest, opts ...grpc.CallOption) (QueueService_GetQueuesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_QueueService_serviceDesc.Streams[0], "/api.QueueService/GetQueues", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &submitGetQueuesClient{stream}
+	x := &queueServiceGetQueuesClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -4065,16 +4409,16 @@ func (c *submitClient) GetQueues(ctx context.Context, in *StreamingQueueGetReque
 	return x, nil
 }
 
-type Submit_GetQueuesClient interface {
+type QueueService_GetQueuesClient interface {
 	Recv() (*StreamingQueueMessage, error)
 	grpc.ClientStream
 }
 
-type submitGetQueuesClient struct {
+type queueServiceGetQueuesClient struct {
 	grpc.ClientStream
 }
 
-func (x *submitGetQueuesClient) Recv() (*StreamingQueueMessage, error) {
+func (x *queueServiceGetQueuesClient) Recv() (*StreamingQueueMessage, error) {
 	m := new(StreamingQueueMessage)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
@@ -4082,1701 +4426,1404 @@ func (x *submitGetQueuesClient) Recv() (*StreamingQueueMessage, error) {
 	return m, nil
 }
 
-func (c *submitClient) Health(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
-	out := new(HealthCheckResponse)
-	err := c.cc.Invoke(ctx, "/api.Submit/Health", in, out, opts...)
+func (c *queueServiceClient) CordonQueue(ctx context.Context, in *QueueCordonRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.QueueService/CordonQueue", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// SubmitServer is the server API for Submit service.
-type SubmitServer interface {
-	SubmitJobs(context.Context, *JobSubmitRequest) (*JobSubmitResponse, error)
-	CancelJobs(context.Context, *JobCancelRequest) (*CancellationResult, error)
-	CancelJobSet(context.Context, *JobSetCancelRequest) (*types.Empty, error)
-	ReprioritizeJobs(context.Context, *JobReprioritizeRequest) (*JobReprioritizeResponse, error)
-	PreemptJobs(context.Context, *JobPreemptRequest) (*PreemptionResult, error)
+func (c *queueServiceClient) UncordonQueue(ctx context.Context, in *QueueUncordonRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.QueueService/UncordonQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) PreemptOnQueue(ctx context.Context, in *QueuePreemptRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.QueueService/PreemptOnQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueServiceClient) CancelOnQueue(ctx context.Context, in *QueueCancelRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.QueueService/CancelOnQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueueServiceServer is the server API for QueueService service.
+type QueueServiceServer interface {
 	CreateQueue(context.Context, *Queue) (*types.Empty, error)
 	CreateQueues(context.Context, *QueueList) (*BatchQueueCreateResponse, error)
 	UpdateQueue(context.Context, *Queue) (*types.Empty, error)
 	UpdateQueues(context.Context, *QueueList) (*BatchQueueUpdateResponse, error)
 	DeleteQueue(context.Context, *QueueDeleteRequest) (*types.Empty, error)
 	GetQueue(context.Context, *QueueGetRequest) (*Queue, error)
-	GetQueues(*StreamingQueueGetRequest, Submit_GetQueuesServer) error
-	Health(context.Context, *types.Empty) (*HealthCheckResponse, error)
-}
-
-// UnimplementedSubmitServer can be embedded to have forward compatible implementations.
-type UnimplementedSubmitServer struct {
+	GetQueues(*StreamingQueueGetRequest, QueueService_GetQueuesServer) error
+	CordonQueue(context.Context, *QueueCordonRequest) (*types.Empty, error)
+	UncordonQueue(context.Context, *QueueUncordonRequest) (*types.Empty, error)
+	PreemptOnQueue(context.Context, *QueuePreemptRequest) (*types.Empty, error)
+	CancelOnQueue(context.Context, *QueueCancelRequest) (*types.Empty, error)
 }
 
-func (*UnimplementedSubmitServer) SubmitJobs(ctx context.Context, req *JobSubmitRequest) (*JobSubmitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitJobs not implemented")
-}
-func (*UnimplementedSubmitServer) CancelJobs(ctx context.Context, req *JobCancelRequest) (*CancellationResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelJobs not implemented")
-}
-func (*UnimplementedSubmitServer) CancelJobSet(ctx context.Context, req *JobSetCancelRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelJobSet not implemented")
-}
-func (*UnimplementedSubmitServer) ReprioritizeJobs(ctx context.Context, req *JobReprioritizeRequest) (*JobReprioritizeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReprioritizeJobs not implemented")
-}
-func (*UnimplementedSubmitServer) PreemptJobs(ctx context.Context, req *JobPreemptRequest) (*PreemptionResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PreemptJobs not implemented")
+// UnimplementedQueueServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedQueueServiceServer struct {
 }
-func (*UnimplementedSubmitServer) CreateQueue(ctx context.Context, req *Queue) (*types.Empty, error) {
+
+func (*UnimplementedQueueServiceServer) CreateQueue(ctx context.Context, req *Queue) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateQueue not implemented")
 }
-func (*UnimplementedSubmitServer) CreateQueues(ctx context.Context, req *QueueList) (*BatchQueueCreateResponse, error) {
+func (*UnimplementedQueueServiceServer) CreateQueues(ctx context.Context, req *QueueList) (*BatchQueueCreateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateQueues not implemented")
 }
-func (*UnimplementedSubmitServer) UpdateQueue(ctx context.Context, req *Queue) (*types.Empty, error) {
+func (*UnimplementedQueueServiceServer) UpdateQueue(ctx context.Context, req *Queue) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateQueue not implemented")
 }
-func (*UnimplementedSubmitServer) UpdateQueues(ctx context.Context, req *QueueList) (*BatchQueueUpdateResponse, error) {
+func (*UnimplementedQueueServiceServer) UpdateQueues(ctx context.Context, req *QueueList) (*BatchQueueUpdateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateQueues not implemented")
 }
-func (*UnimplementedSubmitServer) DeleteQueue(ctx context.Context, req *QueueDeleteRequest) (*types.Empty, error) {
+func (*UnimplementedQueueServiceServer) DeleteQueue(ctx context.Context, req *QueueDeleteRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteQueue not implemented")
 }
-func (*UnimplementedSubmitServer) GetQueue(ctx context.Context, req *QueueGetRequest) (*Queue, error) {
+func (*UnimplementedQueueServiceServer) GetQueue(ctx context.Context, req *QueueGetRequest) (*Queue, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetQueue not implemented")
 }
-func (*UnimplementedSubmitServer) GetQueues(req *StreamingQueueGetRequest, srv Submit_GetQueuesServer) error {
+func (*UnimplementedQueueServiceServer) GetQueues(req *StreamingQueueGetRequest, srv QueueService_GetQueuesServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetQueues not implemented")
 }
-func (*UnimplementedSubmitServer) Health(ctx context.Context, req *types.Empty) (*HealthCheckResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+func (*UnimplementedQueueServiceServer) CordonQueue(ctx context.Context, req *QueueCordonRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CordonQueue not implemented")
+}
+func (*UnimplementedQueueServiceServer) UncordonQueue(ctx context.Context, req *QueueUncordonRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UncordonQueue not implemented")
+}
+func (*UnimplementedQueueServiceServer) PreemptOnQueue(ctx context.Context, req *QueuePreemptRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreemptOnQueue not implemented")
+}
+func (*UnimplementedQueueServiceServer) CancelOnQueue(ctx context.Context, req *QueueCancelRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOnQueue not implemented")
 }
 
-func RegisterSubmitServer(s *grpc.Server, srv SubmitServer) {
-	s.RegisterService(&_Submit_serviceDesc, srv)
+func RegisterQueueServiceServer(s *grpc.Server, srv QueueServiceServer) {
+	s.RegisterService(&_QueueService_serviceDesc, srv)
 }
 
-func _Submit_SubmitJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JobSubmitRequest)
+func _QueueService_CreateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Queue)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SubmitServer).SubmitJobs(ctx, in)
+		return srv.(QueueServiceServer).CreateQueue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Submit/SubmitJobs",
+		FullMethod: "/api.QueueService/CreateQueue",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).SubmitJobs(ctx, req.(*JobSubmitRequest))
+		return srv.(QueueServiceServer).CreateQueue(ctx, req.(*Queue))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Submit_CancelJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JobCancelRequest)
+func _QueueService_CreateQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueList)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SubmitServer).CancelJobs(ctx, in)
+		return srv.(QueueServiceServer).CreateQueues(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Submit/CancelJobs",
+		FullMethod: "/api.QueueService/CreateQueues",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).CancelJobs(ctx, req.(*JobCancelRequest))
+		return srv.(QueueServiceServer).CreateQueues(ctx, req.(*QueueList))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Submit_CancelJobSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JobSetCancelRequest)
+func _QueueService_UpdateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Queue)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SubmitServer).CancelJobSet(ctx, in)
+		return srv.(QueueServiceServer).UpdateQueue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Submit/CancelJobSet",
+		FullMethod: "/api.QueueService/UpdateQueue",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).CancelJobSet(ctx, req.(*JobSetCancelRequest))
+		return srv.(QueueServiceServer).UpdateQueue(ctx, req.(*Queue))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Submit_ReprioritizeJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JobReprioritizeRequest)
+func _QueueService_UpdateQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueList)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SubmitServer).ReprioritizeJobs(ctx, in)
+		return srv.(QueueServiceServer).UpdateQueues(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Submit/ReprioritizeJobs",
+		FullMethod: "/api.QueueService/UpdateQueues",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).ReprioritizeJobs(ctx, req.(*JobReprioritizeRequest))
+		return srv.(QueueServiceServer).UpdateQueues(ctx, req.(*QueueList))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Submit_PreemptJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JobPreemptRequest)
+func _QueueService_DeleteQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueDeleteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SubmitServer).PreemptJobs(ctx, in)
+		return srv.(QueueServiceServer).DeleteQueue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Submit/PreemptJobs",
+		FullMethod: "/api.QueueService/DeleteQueue",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).PreemptJobs(ctx, req.(*JobPreemptRequest))
+		return srv.(QueueServiceServer).DeleteQueue(ctx, req.(*QueueDeleteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Submit_CreateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Queue)
+func _QueueService_GetQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueGetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SubmitServer).CreateQueue(ctx, in)
+		return srv.(QueueServiceServer).GetQueue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Submit/CreateQueue",
+		FullMethod: "/api.QueueService/GetQueue",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).CreateQueue(ctx, req.(*Queue))
+		return srv.(QueueServiceServer).GetQueue(ctx, req.(*QueueGetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Submit_CreateQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueList)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SubmitServer).CreateQueues(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/CreateQueues",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).CreateQueues(ctx, req.(*QueueList))
+func _QueueService_GetQueues_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamingQueueGetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(QueueServiceServer).GetQueues(m, &queueServiceGetQueuesServer{stream})
 }
 
-func _Submit_UpdateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Queue)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SubmitServer).UpdateQueue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Submit/UpdateQueue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).UpdateQueue(ctx, req.(*Queue))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueueService_GetQueuesServer interface {
+	Send(*StreamingQueueMessage) error
+	grpc.ServerStream
 }
 
-func _Submit_UpdateQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueList)
+type queueServiceGetQueuesServer struct {
+	grpc.ServerStream
+}
+
+func (x *queueServiceGetQueuesServer) Send(m *StreamingQueueMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _QueueService_CordonQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueCordonRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SubmitServer).UpdateQueues(ctx, in)
+		return srv.(QueueServiceServer).CordonQueue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Submit/UpdateQueues",
+		FullMethod: "/api.QueueService/CordonQueue",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).UpdateQueues(ctx, req.(*QueueList))
+		return srv.(QueueServiceServer).CordonQueue(ctx, req.(*QueueCordonRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Submit_DeleteQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueDeleteRequest)
+func _QueueService_UncordonQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueUncordonRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SubmitServer).DeleteQueue(ctx, in)
+		return srv.(QueueServiceServer).UncordonQueue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Submit/DeleteQueue",
+		FullMethod: "/api.QueueService/UncordonQueue",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).DeleteQueue(ctx, req.(*QueueDeleteRequest))
+		return srv.(QueueServiceServer).UncordonQueue(ctx, req.(*QueueUncordonRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Submit_GetQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueueGetRequest)
+func _QueueService_PreemptOnQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueuePreemptRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SubmitServer).GetQueue(ctx, in)
+		return srv.(QueueServiceServer).PreemptOnQueue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Submit/GetQueue",
+		FullMethod: "/api.QueueService/PreemptOnQueue",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).GetQueue(ctx, req.(*QueueGetRequest))
+		return srv.(QueueServiceServer).PreemptOnQueue(ctx, req.(*QueuePreemptRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Submit_GetQueues_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StreamingQueueGetRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(SubmitServer).GetQueues(m, &submitGetQueuesServer{stream})
-}
-
-type Submit_GetQueuesServer interface {
-	Send(*StreamingQueueMessage) error
-	grpc.ServerStream
-}
-
-type submitGetQueuesServer struct {
-	grpc.ServerStream
-}
-
-func (x *submitGetQueuesServer) Send(m *StreamingQueueMessage) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _Submit_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
+func _QueueService_CancelOnQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueCancelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SubmitServer).Health(ctx, in)
+		return srv.(QueueServiceServer).CancelOnQueue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Submit/Health",
+		FullMethod: "/api.QueueService/CancelOnQueue",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SubmitServer).Health(ctx, req.(*types.Empty))
+		return srv.(QueueServiceServer).CancelOnQueue(ctx, req.(*QueueCancelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Submit_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "api.Submit",
-	HandlerType: (*SubmitServer)(nil),
+var _QueueService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.QueueService",
+	HandlerType: (*QueueServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "SubmitJobs",
-			Handler:    _Submit_SubmitJobs_Handler,
+			MethodName: "CreateQueue",
+			Handler:    _QueueService_CreateQueue_Handler,
 		},
 		{
-			MethodName: "CancelJobs",
-			Handler:    _Submit_CancelJobs_Handler,
+			MethodName: "CreateQueues",
+			Handler:    _QueueService_CreateQueues_Handler,
 		},
 		{
-			MethodName: "CancelJobSet",
-			Handler:    _Submit_CancelJobSet_Handler,
+			MethodName: "UpdateQueue",
+			Handler:    _QueueService_UpdateQueue_Handler,
 		},
 		{
-			MethodName: "ReprioritizeJobs",
-			Handler:    _Submit_ReprioritizeJobs_Handler,
+			MethodName: "UpdateQueues",
+			Handler:    _QueueService_UpdateQueues_Handler,
 		},
 		{
-			MethodName: "PreemptJobs",
-			Handler:    _Submit_PreemptJobs_Handler,
+			MethodName: "DeleteQueue",
+			Handler:    _QueueService_DeleteQueue_Handler,
 		},
 		{
-			MethodName: "CreateQueue",
-			Handler:    _Submit_CreateQueue_Handler,
+			MethodName: "GetQueue",
+			Handler:    _QueueService_GetQueue_Handler,
 		},
 		{
-			MethodName: "CreateQueues",
-			Handler:    _Submit_CreateQueues_Handler,
-		},
-		{
-			MethodName: "UpdateQueue",
-			Handler:    _Submit_UpdateQueue_Handler,
-		},
-		{
-			MethodName: "UpdateQueues",
-			Handler:    _Submit_UpdateQueues_Handler,
+			MethodName: "CordonQueue",
+			Handler:    _QueueService_CordonQueue_Handler,
 		},
 		{
-			MethodName: "DeleteQueue",
-			Handler:    _Submit_DeleteQueue_Handler,
+			MethodName: "UncordonQueue",
+			Handler:    _QueueService_UncordonQueue_Handler,
 		},
 		{
-			MethodName: "GetQueue",
-			Handler:    _Submit_GetQueue_Handler,
+			MethodName: "PreemptOnQueue",
+			Handler:    _QueueService_PreemptOnQueue_Handler,
 		},
 		{
-			MethodName: "Health",
-			Handler:    _Submit_Health_Handler,
+			MethodName: "CancelOnQueue",
+			Handler:    _QueueService_CancelOnQueue_Handler,
 		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "GetQueues",
-			Handler:       _Submit_GetQueues_Handler,
+			Handler:       _QueueService_GetQueues_Handler,
 			ServerStreams: true,
 		},
 	},
 	Metadata: "pkg/api/submit.proto",
 }
 
-func (m *JobSubmitRequestItem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// RetryPolicyServiceClient is the client API for RetryPolicyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RetryPolicyServiceClient interface {
+	CreateRetryPolicy(ctx context.Context, in *RetryPolicy, opts ...grpc.CallOption) (*types.Empty, error)
+	UpdateRetryPolicy(ctx context.Context, in *RetryPolicy, opts ...grpc.CallOption) (*types.Empty, error)
+	DeleteRetryPolicy(ctx context.Context, in *RetryPolicyDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	GetRetryPolicy(ctx context.Context, in *RetryPolicyGetRequest, opts ...grpc.CallOption) (*RetryPolicy, error)
+	GetRetryPolicies(ctx context.Context, in *RetryPolicyListRequest, opts ...grpc.CallOption) (*RetryPolicyList, error)
+}
+
+type retryPolicyServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRetryPolicyServiceClient(cc *grpc.ClientConn) RetryPolicyServiceClient {
+	return &retryPolicyServiceClient{cc}
+}
+
+func (c *retryPolicyServiceClient) CreateRetryPolicy(ctx context.Context, in *RetryPolicy, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.RetryPolicyService/CreateRetryPolicy", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *JobSubmitRequestItem) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *retryPolicyServiceClient) UpdateRetryPolicy(ctx context.Context, in *RetryPolicy, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.RetryPolicyService/UpdateRetryPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *JobSubmitRequestItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalJobUri) > 0 {
-		i -= len(m.ExternalJobUri)
-		copy(dAtA[i:], m.ExternalJobUri)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.ExternalJobUri)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.Scheduler) > 0 {
-		i -= len(m.Scheduler)
-		copy(dAtA[i:], m.Scheduler)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Scheduler)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.Services) > 0 {
-		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Services[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
-	}
-	if len(m.Ingress) > 0 {
-		for iNdEx := len(m.Ingress) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Ingress[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.PodSpecs) > 0 {
-		for iNdEx := len(m.PodSpecs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PodSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if len(m.RequiredNodeLabels) > 0 {
-		for k := range m.RequiredNodeLabels {
-			v := m.RequiredNodeLabels[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.Annotations) > 0 {
-		for k := range m.Annotations {
-			v := m.Annotations[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Labels) > 0 {
-		for k := range m.Labels {
-			v := m.Labels[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.PodSpec != nil {
-		{
-			size, err := m.PodSpec.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSubmit(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *retryPolicyServiceClient) DeleteRetryPolicy(ctx context.Context, in *RetryPolicyDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.RetryPolicyService/DeleteRetryPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Priority != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Priority))))
-		i--
-		dAtA[i] = 0x9
+	return out, nil
+}
+
+func (c *retryPolicyServiceClient) GetRetryPolicy(ctx context.Context, in *RetryPolicyGetRequest, opts ...grpc.CallOption) (*RetryPolicy, error) {
+	out := new(RetryPolicy)
+	err := c.cc.Invoke(ctx, "/api.RetryPolicyService/GetRetryPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *IngressConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *retryPolicyServiceClient) GetRetryPolicies(ctx context.Context, in *RetryPolicyListRequest, opts ...grpc.CallOption) (*RetryPolicyList, error) {
+	out := new(RetryPolicyList)
+	err := c.cc.Invoke(ctx, "/api.RetryPolicyService/GetRetryPolicies", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *IngressConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RetryPolicyServiceServer is the server API for RetryPolicyService service.
+type RetryPolicyServiceServer interface {
+	CreateRetryPolicy(context.Context, *RetryPolicy) (*types.Empty, error)
+	UpdateRetryPolicy(context.Context, *RetryPolicy) (*types.Empty, error)
+	DeleteRetryPolicy(context.Context, *RetryPolicyDeleteRequest) (*types.Empty, error)
+	GetRetryPolicy(context.Context, *RetryPolicyGetRequest) (*RetryPolicy, error)
+	GetRetryPolicies(context.Context, *RetryPolicyListRequest) (*RetryPolicyList, error)
 }
 
-func (m *IngressConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UseClusterIP {
-		i--
-		if m.UseClusterIP {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.CertName) > 0 {
-		i -= len(m.CertName)
-		copy(dAtA[i:], m.CertName)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.CertName)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.TlsEnabled {
-		i--
-		if m.TlsEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Annotations) > 0 {
-		for k := range m.Annotations {
-			v := m.Annotations[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Ports) > 0 {
-		dAtA3 := make([]byte, len(m.Ports)*10)
-		var j2 int
-		for _, num := range m.Ports {
-			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j2++
-			}
-			dAtA3[j2] = uint8(num)
-			j2++
-		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintSubmit(dAtA, i, uint64(j2))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Type != 0 {
-		i = encodeVarintSubmit(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// UnimplementedRetryPolicyServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedRetryPolicyServiceServer struct {
 }
 
-func (m *ServiceConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedRetryPolicyServiceServer) CreateRetryPolicy(ctx context.Context, req *RetryPolicy) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRetryPolicy not implemented")
+}
+func (*UnimplementedRetryPolicyServiceServer) UpdateRetryPolicy(ctx context.Context, req *RetryPolicy) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRetryPolicy not implemented")
+}
+func (*UnimplementedRetryPolicyServiceServer) DeleteRetryPolicy(ctx context.Context, req *RetryPolicyDeleteRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRetryPolicy not implemented")
+}
+func (*UnimplementedRetryPolicyServiceServer) GetRetryPolicy(ctx context.Context, req *RetryPolicyGetRequest) (*RetryPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRetryPolicy not implemented")
+}
+func (*UnimplementedRetryPolicyServiceServer) GetRetryPolicies(ctx context.Context, req *RetryPolicyListRequest) (*RetryPolicyList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRetryPolicies not implemented")
 }
 
-func (m *ServiceConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterRetryPolicyServiceServer(s *grpc.Server, srv RetryPolicyServiceServer) {
+	s.RegisterService(&_RetryPolicyService_serviceDesc, srv)
 }
 
-func (m *ServiceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
+func _RetryPolicyService_CreateRetryPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Ports) > 0 {
-		dAtA5 := make([]byte, len(m.Ports)*10)
-		var j4 int
-		for _, num := range m.Ports {
-			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j4++
-			}
-			dAtA5[j4] = uint8(num)
-			j4++
-		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintSubmit(dAtA, i, uint64(j4))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(RetryPolicyServiceServer).CreateRetryPolicy(ctx, in)
 	}
-	if m.Type != 0 {
-		i = encodeVarintSubmit(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RetryPolicyService/CreateRetryPolicy",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RetryPolicyServiceServer).CreateRetryPolicy(ctx, req.(*RetryPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobSubmitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _RetryPolicyService_UpdateRetryPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryPolicy)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *JobSubmitRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *JobSubmitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobRequestItems) > 0 {
-		for iNdEx := len(m.JobRequestItems) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.JobRequestItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(RetryPolicyServiceServer).UpdateRetryPolicy(ctx, in)
 	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RetryPolicyService/UpdateRetryPolicy",
 	}
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RetryPolicyServiceServer).UpdateRetryPolicy(ctx, req.(*RetryPolicy))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobPreemptRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _RetryPolicyService_DeleteRetryPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryPolicyDeleteRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(RetryPolicyServiceServer).DeleteRetryPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RetryPolicyService/DeleteRetryPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RetryPolicyServiceServer).DeleteRetryPolicy(ctx, req.(*RetryPolicyDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobPreemptRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _RetryPolicyService_GetRetryPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryPolicyGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RetryPolicyServiceServer).GetRetryPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RetryPolicyService/GetRetryPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RetryPolicyServiceServer).GetRetryPolicy(ctx, req.(*RetryPolicyGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobPreemptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x22
+func _RetryPolicyService_GetRetryPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryPolicyListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.JobIds) > 0 {
-		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.JobIds[iNdEx])
-			copy(dAtA[i:], m.JobIds[iNdEx])
-			i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobIds[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(RetryPolicyServiceServer).GetRetryPolicies(ctx, in)
 	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RetryPolicyService/GetRetryPolicies",
 	}
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RetryPolicyServiceServer).GetRetryPolicies(ctx, req.(*RetryPolicyListRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobCancelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var _RetryPolicyService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.RetryPolicyService",
+	HandlerType: (*RetryPolicyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRetryPolicy",
+			Handler:    _RetryPolicyService_CreateRetryPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateRetryPolicy",
+			Handler:    _RetryPolicyService_UpdateRetryPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteRetryPolicy",
+			Handler:    _RetryPolicyService_DeleteRetryPolicy_Handler,
+		},
+		{
+			MethodName: "GetRetryPolicy",
+			Handler:    _RetryPolicyService_GetRetryPolicy_Handler,
+		},
+		{
+			MethodName: "GetRetryPolicies",
+			Handler:    _RetryPolicyService_GetRetryPolicies_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/api/submit.proto",
+}
+
+// SubmitClient is the client API for Submit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SubmitClient interface {
+	SubmitJobs(ctx context.Context, in *JobSubmitRequest, opts ...grpc.CallOption) (*JobSubmitResponse, error)
+	CancelJobs(ctx context.Context, in *JobCancelRequest, opts ...grpc.CallOption) (*CancellationResult, error)
+	CancelJobSet(ctx context.Context, in *JobSetCancelRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	ReprioritizeJobs(ctx context.Context, in *JobReprioritizeRequest, opts ...grpc.CallOption) (*JobReprioritizeResponse, error)
+	PreemptJobs(ctx context.Context, in *JobPreemptRequest, opts ...grpc.CallOption) (*PreemptionResult, error)
+	CancelJobsBySelector(ctx context.Context, in *JobSelectorCancelRequest, opts ...grpc.CallOption) (*JobSelectorResult, error)
+	ReprioritizeJobsBySelector(ctx context.Context, in *JobSelectorReprioritizeRequest, opts ...grpc.CallOption) (*JobSelectorResult, error)
+	// Change the scheduling requirements of queued jobs.
+	// Jobs that are leased, running or finished are not updated.
+	UpdateQueuedJobs(ctx context.Context, in *JobUpdateRequest, opts ...grpc.CallOption) (*JobUpdateResponse, error)
+	// Move queued jobs to another queue and/or job set. Job ids are unchanged.
+	// Jobs that are leased, running, finished, being cancelled or in a gang are not moved.
+	MoveJobs(ctx context.Context, in *JobMoveRequest, opts ...grpc.CallOption) (*JobMoveResponse, error)
+	// Suspend jobs. Suspended jobs are not scheduled. Running jobs are preempted without
+	// consuming a retry and stay suspended until resumed.
+	SuspendJobs(ctx context.Context, in *JobSuspendRequest, opts ...grpc.CallOption) (*JobSuspensionResult, error)
+	ResumeJobs(ctx context.Context, in *JobResumeRequest, opts ...grpc.CallOption) (*JobSuspensionResult, error)
+	SuspendJobSet(ctx context.Context, in *JobSetSuspendRequest, opts ...grpc.CallOption) (*JobSuspensionResult, error)
+	ResumeJobSet(ctx context.Context, in *JobSetResumeRequest, opts ...grpc.CallOption) (*JobSuspensionResult, error)
+	CreateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error)
+	CreateQueues(ctx context.Context, in *QueueList, opts ...grpc.CallOption) (*BatchQueueCreateResponse, error)
+	UpdateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error)
+	UpdateQueues(ctx context.Context, in *QueueList, opts ...grpc.CallOption) (*BatchQueueUpdateResponse, error)
+	DeleteQueue(ctx context.Context, in *QueueDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	GetQueue(ctx context.Context, in *QueueGetRequest, opts ...grpc.CallOption) (*Queue, error)
+	GetQueues(ctx context.Context, in *StreamingQueueGetRequest, opts ...grpc.CallOption) (Submit_GetQueuesClient, error)
+	Health(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*HealthCheckResponse, error)
+}
+
+type submitClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSubmitClient(cc *grpc.ClientConn) SubmitClient {
+	return &submitClient{cc}
+}
+
+func (c *submitClient) SubmitJobs(ctx context.Context, in *JobSubmitRequest, opts ...grpc.CallOption) (*JobSubmitResponse, error) {
+	out := new(JobSubmitResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/SubmitJobs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *JobCancelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *submitClient) CancelJobs(ctx context.Context, in *JobCancelRequest, opts ...grpc.CallOption) (*CancellationResult, error) {
+	out := new(CancellationResult)
+	err := c.cc.Invoke(ctx, "/api.Submit/CancelJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *JobCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.JobIds) > 0 {
-		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.JobIds[iNdEx])
-			copy(dAtA[i:], m.JobIds[iNdEx])
-			i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobIds[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+func (c *submitClient) CancelJobSet(ctx context.Context, in *JobSetCancelRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/CancelJobSet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *JobSetCancelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *submitClient) ReprioritizeJobs(ctx context.Context, in *JobReprioritizeRequest, opts ...grpc.CallOption) (*JobReprioritizeResponse, error) {
+	out := new(JobReprioritizeResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/ReprioritizeJobs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *JobSetCancelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *submitClient) PreemptJobs(ctx context.Context, in *JobPreemptRequest, opts ...grpc.CallOption) (*PreemptionResult, error) {
+	out := new(PreemptionResult)
+	err := c.cc.Invoke(ctx, "/api.Submit/PreemptJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *JobSetCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Filter != nil {
-		{
-			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSubmit(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0xa
+func (c *submitClient) CancelJobsBySelector(ctx context.Context, in *JobSelectorCancelRequest, opts ...grpc.CallOption) (*JobSelectorResult, error) {
+	out := new(JobSelectorResult)
+	err := c.cc.Invoke(ctx, "/api.Submit/CancelJobsBySelector", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *JobSetFilter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *submitClient) ReprioritizeJobsBySelector(ctx context.Context, in *JobSelectorReprioritizeRequest, opts ...grpc.CallOption) (*JobSelectorResult, error) {
+	out := new(JobSelectorResult)
+	err := c.cc.Invoke(ctx, "/api.Submit/ReprioritizeJobsBySelector", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *JobSetFilter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *submitClient) UpdateQueuedJobs(ctx context.Context, in *JobUpdateRequest, opts ...grpc.CallOption) (*JobUpdateResponse, error) {
+	out := new(JobUpdateResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/UpdateQueuedJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *JobSetFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.States) > 0 {
-		dAtA8 := make([]byte, len(m.States)*10)
-		var j7 int
-		for _, num := range m.States {
-			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j7++
-			}
-			dAtA8[j7] = uint8(num)
-			j7++
-		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintSubmit(dAtA, i, uint64(j7))
-		i--
-		dAtA[i] = 0xa
+func (c *submitClient) MoveJobs(ctx context.Context, in *JobMoveRequest, opts ...grpc.CallOption) (*JobMoveResponse, error) {
+	out := new(JobMoveResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/MoveJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *Job) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *submitClient) SuspendJobs(ctx context.Context, in *JobSuspendRequest, opts ...grpc.CallOption) (*JobSuspensionResult, error) {
+	out := new(JobSuspensionResult)
+	err := c.cc.Invoke(ctx, "/api.Submit/SuspendJobs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Job) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *submitClient) ResumeJobs(ctx context.Context, in *JobResumeRequest, opts ...grpc.CallOption) (*JobSuspensionResult, error) {
+	out := new(JobSuspensionResult)
+	err := c.cc.Invoke(ctx, "/api.Submit/ResumeJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *Job) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SchedulingResourceRequirements != nil {
-		{
-			size, err := m.SchedulingResourceRequirements.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSubmit(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xaa
-	}
-	if len(m.Scheduler) > 0 {
-		i -= len(m.Scheduler)
-		copy(dAtA[i:], m.Scheduler)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Scheduler)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
+func (c *submitClient) SuspendJobSet(ctx context.Context, in *JobSetSuspendRequest, opts ...grpc.CallOption) (*JobSuspensionResult, error) {
+	out := new(JobSuspensionResult)
+	err := c.cc.Invoke(ctx, "/api.Submit/SuspendJobSet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.CompressedQueueOwnershipUserGroups) > 0 {
-		i -= len(m.CompressedQueueOwnershipUserGroups)
-		copy(dAtA[i:], m.CompressedQueueOwnershipUserGroups)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.CompressedQueueOwnershipUserGroups)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x9a
+	return out, nil
+}
+
+func (c *submitClient) ResumeJobSet(ctx context.Context, in *JobSetResumeRequest, opts ...grpc.CallOption) (*JobSuspensionResult, error) {
+	out := new(JobSuspensionResult)
+	err := c.cc.Invoke(ctx, "/api.Submit/ResumeJobSet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.K8SService) > 0 {
-		for iNdEx := len(m.K8SService) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.K8SService[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
-		}
+	return out, nil
+}
+
+func (c *submitClient) CreateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/CreateQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.K8SIngress) > 0 {
-		for iNdEx := len(m.K8SIngress) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.K8SIngress[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x8a
-		}
+	return out, nil
+}
+
+func (c *submitClient) CreateQueues(ctx context.Context, in *QueueList, opts ...grpc.CallOption) (*BatchQueueCreateResponse, error) {
+	out := new(BatchQueueCreateResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/CreateQueues", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Services) > 0 {
-		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Services[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x82
-		}
+	return out, nil
+}
+
+func (c *submitClient) UpdateQueue(ctx context.Context, in *Queue, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/UpdateQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.QueueOwnershipUserGroups) > 0 {
-		for iNdEx := len(m.QueueOwnershipUserGroups) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.QueueOwnershipUserGroups[iNdEx])
-			copy(dAtA[i:], m.QueueOwnershipUserGroups[iNdEx])
-			i = encodeVarintSubmit(dAtA, i, uint64(len(m.QueueOwnershipUserGroups[iNdEx])))
-			i--
-			dAtA[i] = 0x7a
-		}
+	return out, nil
+}
+
+func (c *submitClient) UpdateQueues(ctx context.Context, in *QueueList, opts ...grpc.CallOption) (*BatchQueueUpdateResponse, error) {
+	out := new(BatchQueueUpdateResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/UpdateQueues", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Ingress) > 0 {
-		for iNdEx := len(m.Ingress) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Ingress[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x72
-		}
+	return out, nil
+}
+
+func (c *submitClient) DeleteQueue(ctx context.Context, in *QueueDeleteRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Submit/DeleteQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0x6a
+	return out, nil
+}
+
+func (c *submitClient) GetQueue(ctx context.Context, in *QueueGetRequest, opts ...grpc.CallOption) (*Queue, error) {
+	out := new(Queue)
+	err := c.cc.Invoke(ctx, "/api.Submit/GetQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PodSpecs) > 0 {
-		for iNdEx := len(m.PodSpecs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PodSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
-		}
+	return out, nil
+}
+
+func (c *submitClient) GetQueues(ctx context.Context, in *StreamingQueueGetRequest, opts ...grpc.CallOption) (Submit_GetQueuesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Submit_serviceDesc.Streams[0], "/api.Submit/GetQueues", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RequiredNodeLabels) > 0 {
-		for k := range m.RequiredNodeLabels {
-			v := m.RequiredNodeLabels[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x5a
-		}
+	x := &submitGetQueuesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if len(m.Annotations) > 0 {
-		for k := range m.Annotations {
-			v := m.Annotations[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x52
-		}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if len(m.Labels) > 0 {
-		for k := range m.Labels {
-			v := m.Labels[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4a
-		}
+	return x, nil
+}
+
+type Submit_GetQueuesClient interface {
+	Recv() (*StreamingQueueMessage, error)
+	grpc.ClientStream
+}
+
+type submitGetQueuesClient struct {
+	grpc.ClientStream
+}
+
+func (x *submitGetQueuesClient) Recv() (*StreamingQueueMessage, error) {
+	m := new(StreamingQueueMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x42
+	return m, nil
+}
+
+func (c *submitClient) Health(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
+	out := new(HealthCheckResponse)
+	err := c.cc.Invoke(ctx, "/api.Submit/Health", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x3a
+	return out, nil
+}
+
+// SubmitServer is the server API for Submit service.
+type SubmitServer interface {
+	SubmitJobs(context.Context, *JobSubmitRequest) (*JobSubmitResponse, error)
+	CancelJobs(context.Context, *JobCancelRequest) (*CancellationResult, error)
+	CancelJobSet(context.Context, *JobSetCancelRequest) (*types.Empty, error)
+	ReprioritizeJobs(context.Context, *JobReprioritizeRequest) (*JobReprioritizeResponse, error)
+	PreemptJobs(context.Context, *JobPreemptRequest) (*PreemptionResult, error)
+	CancelJobsBySelector(context.Context, *JobSelectorCancelRequest) (*JobSelectorResult, error)
+	ReprioritizeJobsBySelector(context.Context, *JobSelectorReprioritizeRequest) (*JobSelectorResult, error)
+	// Change the scheduling requirements of queued jobs.
+	// Jobs that are leased, running or finished are not updated.
+	UpdateQueuedJobs(context.Context, *JobUpdateRequest) (*JobUpdateResponse, error)
+	// Move queued jobs to another queue and/or job set. Job ids are unchanged.
+	// Jobs that are leased, running, finished, being cancelled or in a gang are not moved.
+	MoveJobs(context.Context, *JobMoveRequest) (*JobMoveResponse, error)
+	// Suspend jobs. Suspended jobs are not scheduled. Running jobs are preempted without
+	// consuming a retry and stay suspended until resumed.
+	SuspendJobs(context.Context, *JobSuspendRequest) (*JobSuspensionResult, error)
+	ResumeJobs(context.Context, *JobResumeRequest) (*JobSuspensionResult, error)
+	SuspendJobSet(context.Context, *JobSetSuspendRequest) (*JobSuspensionResult, error)
+	ResumeJobSet(context.Context, *JobSetResumeRequest) (*JobSuspensionResult, error)
+	CreateQueue(context.Context, *Queue) (*types.Empty, error)
+	CreateQueues(context.Context, *QueueList) (*BatchQueueCreateResponse, error)
+	UpdateQueue(context.Context, *Queue) (*types.Empty, error)
+	UpdateQueues(context.Context, *QueueList) (*BatchQueueUpdateResponse, error)
+	DeleteQueue(context.Context, *QueueDeleteRequest) (*types.Empty, error)
+	GetQueue(context.Context, *QueueGetRequest) (*Queue, error)
+	GetQueues(*StreamingQueueGetRequest, Submit_GetQueuesServer) error
+	Health(context.Context, *types.Empty) (*HealthCheckResponse, error)
+}
+
+// UnimplementedSubmitServer can be embedded to have forward compatible implementations.
+type UnimplementedSubmitServer struct {
+}
+
+func (*UnimplementedSubmitServer) SubmitJobs(ctx context.Context, req *JobSubmitRequest) (*JobSubmitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitJobs not implemented")
+}
+func (*UnimplementedSubmitServer) CancelJobs(ctx context.Context, req *JobCancelRequest) (*CancellationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJobs not implemented")
+}
+func (*UnimplementedSubmitServer) CancelJobSet(ctx context.Context, req *JobSetCancelRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJobSet not implemented")
+}
+func (*UnimplementedSubmitServer) ReprioritizeJobs(ctx context.Context, req *JobReprioritizeRequest) (*JobReprioritizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReprioritizeJobs not implemented")
+}
+func (*UnimplementedSubmitServer) PreemptJobs(ctx context.Context, req *JobPreemptRequest) (*PreemptionResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreemptJobs not implemented")
+}
+func (*UnimplementedSubmitServer) CancelJobsBySelector(ctx context.Context, req *JobSelectorCancelRequest) (*JobSelectorResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJobsBySelector not implemented")
+}
+func (*UnimplementedSubmitServer) ReprioritizeJobsBySelector(ctx context.Context, req *JobSelectorReprioritizeRequest) (*JobSelectorResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReprioritizeJobsBySelector not implemented")
+}
+func (*UnimplementedSubmitServer) UpdateQueuedJobs(ctx context.Context, req *JobUpdateRequest) (*JobUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQueuedJobs not implemented")
+}
+func (*UnimplementedSubmitServer) MoveJobs(ctx context.Context, req *JobMoveRequest) (*JobMoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveJobs not implemented")
+}
+func (*UnimplementedSubmitServer) SuspendJobs(ctx context.Context, req *JobSuspendRequest) (*JobSuspensionResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendJobs not implemented")
+}
+func (*UnimplementedSubmitServer) ResumeJobs(ctx context.Context, req *JobResumeRequest) (*JobSuspensionResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeJobs not implemented")
+}
+func (*UnimplementedSubmitServer) SuspendJobSet(ctx context.Context, req *JobSetSuspendRequest) (*JobSuspensionResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendJobSet not implemented")
+}
+func (*UnimplementedSubmitServer) ResumeJobSet(ctx context.Context, req *JobSetResumeRequest) (*JobSuspensionResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeJobSet not implemented")
+}
+func (*UnimplementedSubmitServer) CreateQueue(ctx context.Context, req *Queue) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQueue not implemented")
+}
+func (*UnimplementedSubmitServer) CreateQueues(ctx context.Context, req *QueueList) (*BatchQueueCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQueues not implemented")
+}
+func (*UnimplementedSubmitServer) UpdateQueue(ctx context.Context, req *Queue) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQueue not implemented")
+}
+func (*UnimplementedSubmitServer) UpdateQueues(ctx context.Context, req *QueueList) (*BatchQueueUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQueues not implemented")
+}
+func (*UnimplementedSubmitServer) DeleteQueue(ctx context.Context, req *QueueDeleteRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQueue not implemented")
+}
+func (*UnimplementedSubmitServer) GetQueue(ctx context.Context, req *QueueGetRequest) (*Queue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueue not implemented")
+}
+func (*UnimplementedSubmitServer) GetQueues(req *StreamingQueueGetRequest, srv Submit_GetQueuesServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetQueues not implemented")
+}
+func (*UnimplementedSubmitServer) Health(ctx context.Context, req *types.Empty) (*HealthCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
+
+func RegisterSubmitServer(s *grpc.Server, srv SubmitServer) {
+	s.RegisterService(&_Submit_serviceDesc, srv)
+}
+
+func _Submit_SubmitJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobSubmitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Created != nil {
-		{
-			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSubmit(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+	if interceptor == nil {
+		return srv.(SubmitServer).SubmitJobs(ctx, in)
 	}
-	if m.PodSpec != nil {
-		{
-			size, err := m.PodSpec.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSubmit(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/SubmitJobs",
 	}
-	if m.Priority != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Priority))))
-		i--
-		dAtA[i] = 0x21
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).SubmitJobs(ctx, req.(*JobSubmitRequest))
 	}
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_CancelJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(SubmitServer).CancelJobs(ctx, in)
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/CancelJobs",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).CancelJobs(ctx, req.(*JobCancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobReprioritizeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Submit_CancelJobSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobSetCancelRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *JobReprioritizeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(SubmitServer).CancelJobSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/CancelJobSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).CancelJobSet(ctx, req.(*JobSetCancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobReprioritizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NewPriority != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NewPriority))))
-		i--
-		dAtA[i] = 0x21
+func _Submit_ReprioritizeJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobReprioritizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(SubmitServer).ReprioritizeJobs(ctx, in)
 	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/ReprioritizeJobs",
 	}
-	if len(m.JobIds) > 0 {
-		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.JobIds[iNdEx])
-			copy(dAtA[i:], m.JobIds[iNdEx])
-			i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).ReprioritizeJobs(ctx, req.(*JobReprioritizeRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobReprioritizeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Submit_PreemptJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobPreemptRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(SubmitServer).PreemptJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/PreemptJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).PreemptJobs(ctx, req.(*JobPreemptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobReprioritizeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Submit_CancelJobsBySelector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobSelectorCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).CancelJobsBySelector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/CancelJobsBySelector",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).CancelJobsBySelector(ctx, req.(*JobSelectorCancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobReprioritizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ReprioritizationResults) > 0 {
-		for k := range m.ReprioritizationResults {
-			v := m.ReprioritizationResults[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+func _Submit_ReprioritizeJobsBySelector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobSelectorReprioritizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(SubmitServer).ReprioritizeJobsBySelector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/ReprioritizeJobsBySelector",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).ReprioritizeJobsBySelector(ctx, req.(*JobSelectorReprioritizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobSubmitResponseItem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Submit_UpdateQueuedJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobUpdateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(SubmitServer).UpdateQueuedJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/UpdateQueuedJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).UpdateQueuedJobs(ctx, req.(*JobUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobSubmitResponseItem) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Submit_MoveJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobMoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).MoveJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/MoveJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).MoveJobs(ctx, req.(*JobMoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobSubmitResponseItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x12
+func _Submit_SuspendJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobSuspendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(SubmitServer).SuspendJobs(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/SuspendJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).SuspendJobs(ctx, req.(*JobSuspendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobSubmitResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Submit_ResumeJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobResumeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(SubmitServer).ResumeJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/ResumeJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).ResumeJobs(ctx, req.(*JobResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobSubmitResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Submit_SuspendJobSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobSetSuspendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).SuspendJobSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/SuspendJobSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).SuspendJobSet(ctx, req.(*JobSetSuspendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobSubmitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobResponseItems) > 0 {
-		for iNdEx := len(m.JobResponseItems) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.JobResponseItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Submit_ResumeJobSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobSetResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(SubmitServer).ResumeJobSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/ResumeJobSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).ResumeJobSet(ctx, req.(*JobSetResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Queue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Submit_CreateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Queue)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(SubmitServer).CreateQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/CreateQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).CreateQueue(ctx, req.(*Queue))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Queue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Submit_CreateQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).CreateQueues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/CreateQueues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).CreateQueues(ctx, req.(*QueueList))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Queue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RetryPolicies) > 0 {
-		for iNdEx := len(m.RetryPolicies) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RetryPolicies[iNdEx])
-			copy(dAtA[i:], m.RetryPolicies[iNdEx])
-			i = encodeVarintSubmit(dAtA, i, uint64(len(m.RetryPolicies[iNdEx])))
-			i--
-			dAtA[i] = 0x5a
-		}
+func _Submit_UpdateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Queue)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Labels) > 0 {
-		for k := range m.Labels {
-			v := m.Labels[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x52
-		}
+	if interceptor == nil {
+		return srv.(SubmitServer).UpdateQueue(ctx, in)
 	}
-	if len(m.LabelsDeprecated) > 0 {
-		for iNdEx := len(m.LabelsDeprecated) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.LabelsDeprecated[iNdEx])
-			copy(dAtA[i:], m.LabelsDeprecated[iNdEx])
-			i = encodeVarintSubmit(dAtA, i, uint64(len(m.LabelsDeprecated[iNdEx])))
-			i--
-			dAtA[i] = 0x4a
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/UpdateQueue",
 	}
-	if m.Cordoned {
-		i--
-		if m.Cordoned {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).UpdateQueue(ctx, req.(*Queue))
 	}
-	if len(m.ResourceLimitsByPriorityClassName) > 0 {
-		for k := range m.ResourceLimitsByPriorityClassName {
-			v := m.ResourceLimitsByPriorityClassName[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintSubmit(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3a
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_UpdateQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueList)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Permissions) > 0 {
-		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Permissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
+	if interceptor == nil {
+		return srv.(SubmitServer).UpdateQueues(ctx, in)
 	}
-	if len(m.ResourceLimits) > 0 {
-		for k := range m.ResourceLimits {
-			v := m.ResourceLimits[k]
-			baseI := i
-			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
-			i--
-			dAtA[i] = 0x11
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x2a
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/UpdateQueues",
 	}
-	if len(m.GroupOwners) > 0 {
-		for iNdEx := len(m.GroupOwners) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.GroupOwners[iNdEx])
-			copy(dAtA[i:], m.GroupOwners[iNdEx])
-			i = encodeVarintSubmit(dAtA, i, uint64(len(m.GroupOwners[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).UpdateQueues(ctx, req.(*QueueList))
 	}
-	if len(m.UserOwners) > 0 {
-		for iNdEx := len(m.UserOwners) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.UserOwners[iNdEx])
-			copy(dAtA[i:], m.UserOwners[iNdEx])
-			i = encodeVarintSubmit(dAtA, i, uint64(len(m.UserOwners[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_DeleteQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PriorityFactor != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.PriorityFactor))))
-		i--
-		dAtA[i] = 0x11
+	if interceptor == nil {
+		return srv.(SubmitServer).DeleteQueue(ctx, in)
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/DeleteQueue",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).DeleteQueue(ctx, req.(*QueueDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Queue_Permissions) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Submit_GetQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueGetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Queue_Permissions) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Queue_Permissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Verbs) > 0 {
-		for iNdEx := len(m.Verbs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Verbs[iNdEx])
-			copy(dAtA[i:], m.Verbs[iNdEx])
-			i = encodeVarintSubmit(dAtA, i, uint64(len(m.Verbs[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(SubmitServer).GetQueue(ctx, in)
 	}
-	if len(m.Subjects) > 0 {
-		for iNdEx := len(m.Subjects) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Subjects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/GetQueue",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).GetQueue(ctx, req.(*QueueGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Queue_Permissions_Subject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _Submit_GetQueues_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamingQueueGetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(SubmitServer).GetQueues(m, &submitGetQueuesServer{stream})
 }
 
-func (m *Queue_Permissions_Subject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Submit_GetQueuesServer interface {
+	Send(*StreamingQueueMessage) error
+	grpc.ServerStream
 }
 
-func (m *Queue_Permissions_Subject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Kind) > 0 {
-		i -= len(m.Kind)
-		copy(dAtA[i:], m.Kind)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Kind)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type submitGetQueuesServer struct {
+	grpc.ServerStream
 }
 
-func (m *PriorityClassResourceLimits) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (x *submitGetQueuesServer) Send(m *StreamingQueueMessage) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *PriorityClassResourceLimits) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Submit_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Submit/Health",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).Health(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PriorityClassResourceLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MaximumResourceFractionByPool) > 0 {
-		for k := range m.MaximumResourceFractionByPool {
-			v := m.MaximumResourceFractionByPool[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintSubmit(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.MaximumResourceFraction) > 0 {
-		for k := range m.MaximumResourceFraction {
-			v := m.MaximumResourceFraction[k]
-			baseI := i
-			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
-			i--
-			dAtA[i] = 0x11
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+var _Submit_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Submit",
+	HandlerType: (*SubmitServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitJobs",
+			Handler:    _Submit_SubmitJobs_Handler,
+		},
+		{
+			MethodName: "CancelJobs",
+			Handler:    _Submit_CancelJobs_Handler,
+		},
+		{
+			MethodName: "CancelJobSet",
+			Handler:    _Submit_CancelJobSet_Handler,
+		},
+		{
+			MethodName: "ReprioritizeJobs",
+			Handler:    _Submit_ReprioritizeJobs_Handler,
+		},
+		{
+			MethodName: "PreemptJobs",
+			Handler:    _Submit_PreemptJobs_Handler,
+		},
+		{
+			MethodName: "CancelJobsBySelector",
+			Handler:    _Submit_CancelJobsBySelector_Handler,
+		},
+		{
+			MethodName: "ReprioritizeJobsBySelector",
+			Handler:    _Submit_ReprioritizeJobsBySelector_Handler,
+		},
+		{
+			MethodName: "UpdateQueuedJobs",
+			Handler:    _Submit_UpdateQueuedJobs_Handler,
+		},
+		{
+			MethodName: "MoveJobs",
+			Handler:    _Submit_MoveJobs_Handler,
+		},
+		{
+			MethodName: "SuspendJobs",
+			Handler:    _Submit_SuspendJobs_Handler,
+		},
+		{
+			MethodName: "ResumeJobs",
+			Handler:    _Submit_ResumeJobs_Handler,
+		},
+		{
+			MethodName: "SuspendJobSet",
+			Handler:    _Submit_SuspendJobSet_Handler,
+		},
+		{
+			MethodName: "ResumeJobSet",
+			Handler:    _Submit_ResumeJobSet_Handler,
+		},
+		{
+			MethodName: "CreateQueue",
+			Handler:    _Submit_CreateQueue_Handler,
+		},
+		{
+			MethodName: "CreateQueues",
+			Handler:    _Submit_CreateQueues_Handler,
+		},
+		{
+			MethodName: "UpdateQueue",
+			Handler:    _Submit_UpdateQueue_Handler,
+		},
+		{
+			MethodName: "UpdateQueues",
+			Handler:    _Submit_UpdateQueues_Handler,
+		},
+		{
+			MethodName: "DeleteQueue",
+			Handler:    _Submit_DeleteQueue_Handler,
+		},
+		{
+			MethodName: "GetQueue",
+			Handler:    _Submit_GetQueue_Handler,
+		},
+		{
+			MethodName: "Health",
+			Handler:    _Submit_Health_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetQueues",
+			Handler:       _Submit_GetQueues_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pkg/api/submit.proto",
 }
 
-func (m *PriorityClassPoolResourceLimits) Marshal() (dAtA []byte, err error) {
+func (m *JobSubmitRequestItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5786,61 +5833,50 @@ func (m *PriorityClassPoolResourceLimits) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PriorityClassPoolResourceLimits) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobSubmitRequestItem) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PriorityClassPoolResourceLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobSubmitRequestItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MaximumResourceFraction) > 0 {
-		for k := range m.MaximumResourceFraction {
-			v := m.MaximumResourceFraction[k]
-			baseI := i
-			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
-			i--
-			dAtA[i] = 0x11
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+	if len(m.VolumeClaimTemplates) > 0 {
+		for iNdEx := len(m.VolumeClaimTemplates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VolumeClaimTemplates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0xa
-			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			dAtA[i] = 0x1
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x82
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueueList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.Secrets) > 0 {
+		for iNdEx := len(m.Secrets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Secrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueueList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueueList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Queues) > 0 {
-		for iNdEx := len(m.Queues) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ConfigMaps) > 0 {
+		for iNdEx := len(m.ConfigMaps) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Queues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ConfigMaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5848,67 +5884,94 @@ func (m *QueueList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintSubmit(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x72
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CancellationResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.ExternalJobUri) > 0 {
+		i -= len(m.ExternalJobUri)
+		copy(dAtA[i:], m.ExternalJobUri)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.ExternalJobUri)))
+		i--
+		dAtA[i] = 0x6a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CancellationResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CancellationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CancelledIds) > 0 {
-		for iNdEx := len(m.CancelledIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CancelledIds[iNdEx])
-			copy(dAtA[i:], m.CancelledIds[iNdEx])
-			i = encodeVarintSubmit(dAtA, i, uint64(len(m.CancelledIds[iNdEx])))
+	if len(m.Scheduler) > 0 {
+		i -= len(m.Scheduler)
+		copy(dAtA[i:], m.Scheduler)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Scheduler)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Services) > 0 {
+		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Services[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x52
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PreemptionResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.Ingress) > 0 {
+		for iNdEx := len(m.Ingress) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ingress[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PreemptionResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PreemptionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PreemptionResults) > 0 {
-		for k := range m.PreemptionResults {
-			v := m.PreemptionResults[k]
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PodSpecs) > 0 {
+		for iNdEx := len(m.PodSpecs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PodSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RequiredNodeLabels) > 0 {
+		for k := range m.RequiredNodeLabels {
+			v := m.RequiredNodeLabels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k := range m.Annotations {
+			v := m.Annotations[k]
 			baseI := i
 			i -= len(v)
 			copy(dAtA[i:], v)
@@ -5922,13 +5985,57 @@ func (m *PreemptionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xa
 			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
 			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
 			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PodSpec != nil {
+		{
+			size, err := m.PodSpec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Priority != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Priority))))
+		i--
+		dAtA[i] = 0x9
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RetryPolicy) Marshal() (dAtA []byte, err error) {
+func (m *SecretReference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5938,39 +6045,22 @@ func (m *RetryPolicy) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RetryPolicy) MarshalTo(dAtA []byte) (int, error) {
+func (m *SecretReference) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SecretReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Rules) > 0 {
-		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.DefaultAction != 0 {
-		i = encodeVarintSubmit(dAtA, i, uint64(m.DefaultAction))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.RetryLimit != 0 {
-		i = encodeVarintSubmit(dAtA, i, uint64(m.RetryLimit))
+	if len(m.SourceName) > 0 {
+		i -= len(m.SourceName)
+		copy(dAtA[i:], m.SourceName)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.SourceName)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
@@ -5982,7 +6072,7 @@ func (m *RetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RetryRule) Marshal() (dAtA []byte, err error) {
+func (m *IngressConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5992,51 +6082,89 @@ func (m *RetryRule) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RetryRule) MarshalTo(dAtA []byte) (int, error) {
+func (m *IngressConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RetryRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IngressConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Mutate != nil {
-		{
-			size, err := m.Mutate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSubmit(dAtA, i, uint64(size))
+	if m.UseClusterIP {
+		i--
+		if m.UseClusterIP {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x30
 	}
-	if len(m.OnSubcategory) > 0 {
-		i -= len(m.OnSubcategory)
-		copy(dAtA[i:], m.OnSubcategory)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.OnSubcategory)))
+	if len(m.CertName) > 0 {
+		i -= len(m.CertName)
+		copy(dAtA[i:], m.CertName)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.CertName)))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x2a
 	}
-	if len(m.OnCategory) > 0 {
-		i -= len(m.OnCategory)
-		copy(dAtA[i:], m.OnCategory)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.OnCategory)))
+	if m.TlsEnabled {
 		i--
-		dAtA[i] = 0x2a
+		if m.TlsEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Action != 0 {
-		i = encodeVarintSubmit(dAtA, i, uint64(m.Action))
+	if len(m.Annotations) > 0 {
+		for k := range m.Annotations {
+			v := m.Annotations[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Ports) > 0 {
+		dAtA3 := make([]byte, len(m.Ports)*10)
+		var j2 int
+		for _, num := range m.Ports {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintSubmit(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.Type))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RetryMutation) Marshal() (dAtA []byte, err error) {
+func (m *ServiceConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6046,44 +6174,50 @@ func (m *RetryMutation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RetryMutation) MarshalTo(dAtA []byte) (int, error) {
+func (m *ServiceConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RetryMutation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ServiceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Resources != nil {
-		{
-			size, err := m.Resources.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Ports) > 0 {
+		dAtA5 := make([]byte, len(m.Ports)*10)
+		var j4 int
+		for _, num := range m.Ports {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
 			}
-			i -= size
-			i = encodeVarintSubmit(dAtA, i, uint64(size))
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintSubmit(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Affinity != nil {
-		{
-			size, err := m.Affinity.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSubmit(dAtA, i, uint64(size))
-		}
+	if m.Type != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.Type))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RetryAffinityMutation) Marshal() (dAtA []byte, err error) {
+func (m *JobSubmitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6093,30 +6227,48 @@ func (m *RetryAffinityMutation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RetryAffinityMutation) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobSubmitRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RetryAffinityMutation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobSubmitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.AvoidSameNode {
-		i--
-		if m.AvoidSameNode {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.JobRequestItems) > 0 {
+		for iNdEx := len(m.JobRequestItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JobRequestItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RetryResourceMutation) Marshal() (dAtA []byte, err error) {
+func (m *JobPreemptRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6126,32 +6278,57 @@ func (m *RetryResourceMutation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RetryResourceMutation) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobPreemptRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RetryResourceMutation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobPreemptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Memory != nil {
-		{
-			size, err := m.Memory.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSubmit(dAtA, i, uint64(size))
+	if len(m.IdempotencyKey) > 0 {
+		i -= len(m.IdempotencyKey)
+		copy(dAtA[i:], m.IdempotencyKey)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.IdempotencyKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.JobIds) > 0 {
+		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobIds[iNdEx])
+			copy(dAtA[i:], m.JobIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RetryResourceBump) Marshal() (dAtA []byte, err error) {
+func (m *JobCancelRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6161,93 +6338,64 @@ func (m *RetryResourceBump) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RetryResourceBump) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobCancelRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RetryResourceBump) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Factor != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Factor))))
+	if len(m.IdempotencyKey) > 0 {
+		i -= len(m.IdempotencyKey)
+		copy(dAtA[i:], m.IdempotencyKey)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.IdempotencyKey)))
 		i--
-		dAtA[i] = 0x11
+		dAtA[i] = 0x32
 	}
-	if len(m.Static) > 0 {
-		i -= len(m.Static)
-		copy(dAtA[i:], m.Static)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Static)))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Reason)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x2a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RetryPolicyGetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.JobIds) > 0 {
+		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobIds[iNdEx])
+			copy(dAtA[i:], m.JobIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RetryPolicyGetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RetryPolicyGetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RetryPolicyDeleteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RetryPolicyDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RetryPolicyDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RetryPolicyListRequest) Marshal() (dAtA []byte, err error) {
+func (m *JobSetCancelRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6257,20 +6405,60 @@ func (m *RetryPolicyListRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RetryPolicyListRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobSetCancelRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RetryPolicyListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobSetCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.IdempotencyKey) > 0 {
+		i -= len(m.IdempotencyKey)
+		copy(dAtA[i:], m.IdempotencyKey)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.IdempotencyKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RetryPolicyList) Marshal() (dAtA []byte, err error) {
+func (m *JobSetFilter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6280,34 +6468,38 @@ func (m *RetryPolicyList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RetryPolicyList) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobSetFilter) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RetryPolicyList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobSetFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RetryPolicies) > 0 {
-		for iNdEx := len(m.RetryPolicies) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RetryPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
+	if len(m.States) > 0 {
+		dAtA8 := make([]byte, len(m.States)*10)
+		var j7 int
+		for _, num := range m.States {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
 			}
-			i--
-			dAtA[i] = 0xa
+			dAtA8[j7] = uint8(num)
+			j7++
 		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintSubmit(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueueGetRequest) Marshal() (dAtA []byte, err error) {
+func (m *Job) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6317,27 +6509,266 @@ func (m *QueueGetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueueGetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Job) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueueGetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Job) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+	if m.SchedulingResourceRequirements != nil {
+		{
+			size, err := m.SchedulingResourceRequirements.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.Scheduler) > 0 {
+		i -= len(m.Scheduler)
+		copy(dAtA[i:], m.Scheduler)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Scheduler)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.CompressedQueueOwnershipUserGroups) > 0 {
+		i -= len(m.CompressedQueueOwnershipUserGroups)
+		copy(dAtA[i:], m.CompressedQueueOwnershipUserGroups)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.CompressedQueueOwnershipUserGroups)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.K8SService) > 0 {
+		for iNdEx := len(m.K8SService) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.K8SService[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.K8SIngress) > 0 {
+		for iNdEx := len(m.K8SIngress) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.K8SIngress[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.Services) > 0 {
+		for iNdEx := len(m.Services) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Services[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.QueueOwnershipUserGroups) > 0 {
+		for iNdEx := len(m.QueueOwnershipUserGroups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.QueueOwnershipUserGroups[iNdEx])
+			copy(dAtA[i:], m.QueueOwnershipUserGroups[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.QueueOwnershipUserGroups[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.Ingress) > 0 {
+		for iNdEx := len(m.Ingress) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ingress[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.PodSpecs) > 0 {
+		for iNdEx := len(m.PodSpecs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PodSpecs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.RequiredNodeLabels) > 0 {
+		for k := range m.RequiredNodeLabels {
+			v := m.RequiredNodeLabels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k := range m.Annotations {
+			v := m.Annotations[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Created != nil {
+		{
+			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PodSpec != nil {
+		{
+			size, err := m.PodSpec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Priority != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Priority))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Id)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueueCordonRequest) Marshal() (dAtA []byte, err error) {
+func (m *JobReprioritizeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6347,27 +6778,56 @@ func (m *QueueCordonRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueueCordonRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobReprioritizeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueueCordonRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobReprioritizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+	if len(m.IdempotencyKey) > 0 {
+		i -= len(m.IdempotencyKey)
+		copy(dAtA[i:], m.IdempotencyKey)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.IdempotencyKey)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x2a
+	}
+	if m.NewPriority != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NewPriority))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobIds) > 0 {
+		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobIds[iNdEx])
+			copy(dAtA[i:], m.JobIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueueUncordonRequest) Marshal() (dAtA []byte, err error) {
+func (m *JobReprioritizeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6377,27 +6837,39 @@ func (m *QueueUncordonRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueueUncordonRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobReprioritizeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueueUncordonRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobReprioritizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.ReprioritizationResults) > 0 {
+		for k := range m.ReprioritizationResults {
+			v := m.ReprioritizationResults[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *StreamingQueueGetRequest) Marshal() (dAtA []byte, err error) {
+func (m *JobSubmitResponseItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6407,25 +6879,34 @@ func (m *StreamingQueueGetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StreamingQueueGetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobSubmitResponseItem) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StreamingQueueGetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobSubmitResponseItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Num != 0 {
-		i = encodeVarintSubmit(dAtA, i, uint64(m.Num))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Error)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueueDeleteRequest) Marshal() (dAtA []byte, err error) {
+func (m *JobSubmitResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6435,27 +6916,34 @@ func (m *QueueDeleteRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueueDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobSubmitResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueueDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobSubmitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.JobResponseItems) > 0 {
+		for iNdEx := len(m.JobResponseItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JobResponseItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *JobSetInfo) Marshal() (dAtA []byte, err error) {
+func (m *Queue) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6465,25 +6953,144 @@ func (m *JobSetInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobSetInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *Queue) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Queue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LeasedJobs != 0 {
-		i = encodeVarintSubmit(dAtA, i, uint64(m.LeasedJobs))
+	if len(m.RetryPolicies) > 0 {
+		for iNdEx := len(m.RetryPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RetryPolicies[iNdEx])
+			copy(dAtA[i:], m.RetryPolicies[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.RetryPolicies[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.LabelsDeprecated) > 0 {
+		for iNdEx := len(m.LabelsDeprecated) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LabelsDeprecated[iNdEx])
+			copy(dAtA[i:], m.LabelsDeprecated[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.LabelsDeprecated[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Cordoned {
 		i--
-		dAtA[i] = 0x18
+		if m.Cordoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.QueuedJobs != 0 {
-		i = encodeVarintSubmit(dAtA, i, uint64(m.QueuedJobs))
+	if len(m.ResourceLimitsByPriorityClassName) > 0 {
+		for k := range m.ResourceLimitsByPriorityClassName {
+			v := m.ResourceLimitsByPriorityClassName[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintSubmit(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Permissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ResourceLimits) > 0 {
+		for k := range m.ResourceLimits {
+			v := m.ResourceLimits[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.GroupOwners) > 0 {
+		for iNdEx := len(m.GroupOwners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GroupOwners[iNdEx])
+			copy(dAtA[i:], m.GroupOwners[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.GroupOwners[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.UserOwners) > 0 {
+		for iNdEx := len(m.UserOwners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UserOwners[iNdEx])
+			copy(dAtA[i:], m.UserOwners[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.UserOwners[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PriorityFactor != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.PriorityFactor))))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x11
 	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
@@ -6495,7 +7102,7 @@ func (m *JobSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueueUpdateResponse) Marshal() (dAtA []byte, err error) {
+func (m *Queue_Permissions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6505,62 +7112,29 @@ func (m *QueueUpdateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueueUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *Queue_Permissions) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueueUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Queue_Permissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Queue != nil {
-		{
-			size, err := m.Queue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSubmit(dAtA, i, uint64(size))
+	if len(m.Verbs) > 0 {
+		for iNdEx := len(m.Verbs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Verbs[iNdEx])
+			copy(dAtA[i:], m.Verbs[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.Verbs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *BatchQueueUpdateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *BatchQueueUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *BatchQueueUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FailedQueues) > 0 {
-		for iNdEx := len(m.FailedQueues) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Subjects) > 0 {
+		for iNdEx := len(m.Subjects) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.FailedQueues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Subjects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -6574,7 +7148,7 @@ func (m *BatchQueueUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *QueueCreateResponse) Marshal() (dAtA []byte, err error) {
+func (m *Queue_Permissions_Subject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6584,39 +7158,34 @@ func (m *QueueCreateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueueCreateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *Queue_Permissions_Subject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueueCreateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Queue_Permissions_Subject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Error)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Queue != nil {
-		{
-			size, err := m.Queue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSubmit(dAtA, i, uint64(size))
-		}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Kind)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BatchQueueCreateResponse) Marshal() (dAtA []byte, err error) {
+func (m *PriorityClassResourceLimits) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6626,26 +7195,56 @@ func (m *BatchQueueCreateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BatchQueueCreateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriorityClassResourceLimits) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BatchQueueCreateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriorityClassResourceLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.FailedQueues) > 0 {
-		for iNdEx := len(m.FailedQueues) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FailedQueues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+	if len(m.MaximumResourceFractionByPool) > 0 {
+		for k := range m.MaximumResourceFractionByPool {
+			v := m.MaximumResourceFractionByPool[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintSubmit(dAtA, i, uint64(size))
 				}
-				i -= size
-				i = encodeVarintSubmit(dAtA, i, uint64(size))
+				i--
+				dAtA[i] = 0x12
 			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MaximumResourceFraction) > 0 {
+		for k := range m.MaximumResourceFraction {
+			v := m.MaximumResourceFraction[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6653,7 +7252,7 @@ func (m *BatchQueueCreateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *EndMarker) Marshal() (dAtA []byte, err error) {
+func (m *PriorityClassPoolResourceLimits) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6663,20 +7262,38 @@ func (m *EndMarker) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EndMarker) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriorityClassPoolResourceLimits) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EndMarker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriorityClassPoolResourceLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.MaximumResourceFraction) > 0 {
+		for k := range m.MaximumResourceFraction {
+			v := m.MaximumResourceFraction[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *StreamingQueueMessage) Marshal() (dAtA []byte, err error) {
+func (m *QueueList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6686,71 +7303,34 @@ func (m *StreamingQueueMessage) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StreamingQueueMessage) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueueList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StreamingQueueMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueueList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Event != nil {
-		{
-			size := m.Event.Size()
-			i -= size
-			if _, err := m.Event.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *StreamingQueueMessage_Queue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *StreamingQueueMessage_Queue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Queue != nil {
-		{
-			size, err := m.Queue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Queues) > 0 {
+		for iNdEx := len(m.Queues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintSubmit(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *StreamingQueueMessage_End) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *StreamingQueueMessage_End) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.End != nil {
-		{
-			size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintSubmit(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
-}
-func (m *QueuePreemptRequest) Marshal() (dAtA []byte, err error) {
+func (m *CancellationResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6760,45 +7340,29 @@ func (m *QueuePreemptRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueuePreemptRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CancellationResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueuePreemptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CancellationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Pools[iNdEx])
-			copy(dAtA[i:], m.Pools[iNdEx])
-			i = encodeVarintSubmit(dAtA, i, uint64(len(m.Pools[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.PriorityClasses) > 0 {
-		for iNdEx := len(m.PriorityClasses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PriorityClasses[iNdEx])
-			copy(dAtA[i:], m.PriorityClasses[iNdEx])
-			i = encodeVarintSubmit(dAtA, i, uint64(len(m.PriorityClasses[iNdEx])))
+	if len(m.CancelledIds) > 0 {
+		for iNdEx := len(m.CancelledIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CancelledIds[iNdEx])
+			copy(dAtA[i:], m.CancelledIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.CancelledIds[iNdEx])))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueueCancelRequest) Marshal() (dAtA []byte, err error) {
+func (m *PreemptionResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6808,1143 +7372,5535 @@ func (m *QueueCancelRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueueCancelRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PreemptionResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueueCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PreemptionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Pools[iNdEx])
-			copy(dAtA[i:], m.Pools[iNdEx])
-			i = encodeVarintSubmit(dAtA, i, uint64(len(m.Pools[iNdEx])))
+	if len(m.PreemptionResults) > 0 {
+		for k := range m.PreemptionResults {
+			v := m.PreemptionResults[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.JobStates) > 0 {
-		dAtA23 := make([]byte, len(m.JobStates)*10)
-		var j22 int
-		for _, num := range m.JobStates {
-			for num >= 1<<7 {
-				dAtA23[j22] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j22++
-			}
-			dAtA23[j22] = uint8(num)
-			j22++
-		}
-		i -= j22
-		copy(dAtA[i:], dAtA23[:j22])
-		i = encodeVarintSubmit(dAtA, i, uint64(j22))
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSelector) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSelector) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSelector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobSetPrefix) > 0 {
+		i -= len(m.JobSetPrefix)
+		copy(dAtA[i:], m.JobSetPrefix)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetPrefix)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.PriorityClasses) > 0 {
-		for iNdEx := len(m.PriorityClasses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PriorityClasses[iNdEx])
-			copy(dAtA[i:], m.PriorityClasses[iNdEx])
-			i = encodeVarintSubmit(dAtA, i, uint64(len(m.PriorityClasses[iNdEx])))
+	if len(m.Annotations) > 0 {
+		for k := range m.Annotations {
+			v := m.Annotations[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
 			i--
 			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintSubmit(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSubmit(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *JobSelectorCancelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *JobSubmitRequestItem) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *JobSelectorCancelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSelectorCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Priority != 0 {
-		n += 9
-	}
-	if m.PodSpec != nil {
-		l = m.PodSpec.Size()
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
-	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
-	}
-	if len(m.RequiredNodeLabels) > 0 {
-		for k, v := range m.RequiredNodeLabels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
-	}
-	if len(m.PodSpecs) > 0 {
-		for _, e := range m.PodSpecs {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if len(m.Ingress) > 0 {
-		for _, e := range m.Ingress {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
-		}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Services) > 0 {
-		for _, e := range m.Services {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
+	if m.Selector != nil {
+		{
+			size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Scheduler)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.ExternalJobUri)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *IngressConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobSelectorReprioritizeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSelectorReprioritizeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSelectorReprioritizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovSubmit(uint64(m.Type))
-	}
-	if len(m.Ports) > 0 {
-		l = 0
-		for _, e := range m.Ports {
-			l += sovSubmit(uint64(e))
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		n += 1 + sovSubmit(uint64(l)) + l
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+	if m.NewPriority != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NewPriority))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.Selector != nil {
+		{
+			size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.TlsEnabled {
-		n += 2
-	}
-	l = len(m.CertName)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if m.UseClusterIP {
-		n += 2
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ServiceConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovSubmit(uint64(m.Type))
-	}
-	if len(m.Ports) > 0 {
-		l = 0
-		for _, e := range m.Ports {
-			l += sovSubmit(uint64(e))
-		}
-		n += 1 + sovSubmit(uint64(l)) + l
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+func (m *JobSelectorResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *JobSubmitRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *JobSelectorResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSelectorResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if len(m.JobRequestItems) > 0 {
-		for _, e := range m.JobRequestItems {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
-		}
+	if m.MatchedJobs != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.MatchedJobs))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobPreemptRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueuedJobUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedJobUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedJobUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if len(m.PriorityClassName) > 0 {
+		i -= len(m.PriorityClassName)
+		copy(dAtA[i:], m.PriorityClassName)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.PriorityClassName)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if len(m.Tolerations) > 0 {
+		for iNdEx := len(m.Tolerations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tolerations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if len(m.JobIds) > 0 {
-		for _, s := range m.JobIds {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
+	if len(m.NodeSelector) > 0 {
+		for k := range m.NodeSelector {
+			v := m.NodeSelector[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if len(m.ContainerResources) > 0 {
+		for k := range m.ContainerResources {
+			v := m.ContainerResources[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintSubmit(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobCancelRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobUpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if m.Update != nil {
+		{
+			size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if len(m.JobIds) > 0 {
-		for _, s := range m.JobIds {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
+		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobIds[iNdEx])
+			copy(dAtA[i:], m.JobIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobSetCancelRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if m.Filter != nil {
-		l = m.Filter.Size()
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+func (m *JobUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *JobSetFilter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.States) > 0 {
-		l = 0
-		for _, e := range m.States {
-			l += sovSubmit(uint64(e))
-		}
-		n += 1 + sovSubmit(uint64(l)) + l
-	}
-	return n
+func (m *JobUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Job) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *JobUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if m.Priority != 0 {
-		n += 9
-	}
-	if m.PodSpec != nil {
-		l = m.PodSpec.Size()
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if m.Created != nil {
-		l = m.Created.Size()
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
-	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
-	}
-	if len(m.RequiredNodeLabels) > 0 {
-		for k, v := range m.RequiredNodeLabels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
-	}
-	if len(m.PodSpecs) > 0 {
-		for _, e := range m.PodSpecs {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
-		}
-	}
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if len(m.Ingress) > 0 {
-		for _, e := range m.Ingress {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
-		}
-	}
-	if len(m.QueueOwnershipUserGroups) > 0 {
-		for _, s := range m.QueueOwnershipUserGroups {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
-		}
-	}
-	if len(m.Services) > 0 {
-		for _, e := range m.Services {
-			l = e.Size()
-			n += 2 + l + sovSubmit(uint64(l))
-		}
-	}
-	if len(m.K8SIngress) > 0 {
-		for _, e := range m.K8SIngress {
-			l = e.Size()
-			n += 2 + l + sovSubmit(uint64(l))
-		}
-	}
-	if len(m.K8SService) > 0 {
-		for _, e := range m.K8SService {
-			l = e.Size()
-			n += 2 + l + sovSubmit(uint64(l))
+	if len(m.UpdateResults) > 0 {
+		for k := range m.UpdateResults {
+			v := m.UpdateResults[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	l = len(m.CompressedQueueOwnershipUserGroups)
-	if l > 0 {
-		n += 2 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.Scheduler)
-	if l > 0 {
-		n += 2 + l + sovSubmit(uint64(l))
-	}
-	if m.SchedulingResourceRequirements != nil {
-		l = m.SchedulingResourceRequirements.Size()
-		n += 2 + l + sovSubmit(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobReprioritizeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobMoveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobMoveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobMoveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.JobIds) > 0 {
-		for _, s := range m.JobIds {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
-		}
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if len(m.DestinationJobSetId) > 0 {
+		i -= len(m.DestinationJobSetId)
+		copy(dAtA[i:], m.DestinationJobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.DestinationJobSetId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if len(m.DestinationQueue) > 0 {
+		i -= len(m.DestinationQueue)
+		copy(dAtA[i:], m.DestinationQueue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.DestinationQueue)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.NewPriority != 0 {
-		n += 9
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *JobReprioritizeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if len(m.ReprioritizationResults) > 0 {
-		for k, v := range m.ReprioritizationResults {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+	if len(m.JobIds) > 0 {
+		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobIds[iNdEx])
+			copy(dAtA[i:], m.JobIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobSubmitResponseItem) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+func (m *JobMoveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *JobSubmitResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *JobMoveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobMoveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.JobResponseItems) > 0 {
-		for _, e := range m.JobResponseItems {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
+	if len(m.MoveResults) > 0 {
+		for k := range m.MoveResults {
+			v := m.MoveResults[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Queue) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobSuspendRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if m.PriorityFactor != 0 {
-		n += 9
-	}
-	if len(m.UserOwners) > 0 {
-		for _, s := range m.UserOwners {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
-		}
-	}
-	if len(m.GroupOwners) > 0 {
-		for _, s := range m.GroupOwners {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
-		}
-	}
-	if len(m.ResourceLimits) > 0 {
-		for k, v := range m.ResourceLimits {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + 8
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
-	}
-	if len(m.Permissions) > 0 {
-		for _, e := range m.Permissions {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
-		}
-	}
-	if len(m.ResourceLimitsByPriorityClassName) > 0 {
-		for k, v := range m.ResourceLimitsByPriorityClassName {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovSubmit(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
-	}
-	if m.Cordoned {
-		n += 2
+	return dAtA[:n], nil
+}
+
+func (m *JobSuspendRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSuspendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.LabelsDeprecated) > 0 {
-		for _, s := range m.LabelsDeprecated {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
-		}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.RetryPolicies) > 0 {
-		for _, s := range m.RetryPolicies {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
+	if len(m.JobIds) > 0 {
+		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobIds[iNdEx])
+			copy(dAtA[i:], m.JobIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Queue_Permissions) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobResumeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobResumeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobResumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Subjects) > 0 {
-		for _, e := range m.Subjects {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
-		}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Verbs) > 0 {
-		for _, s := range m.Verbs {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobIds) > 0 {
+		for iNdEx := len(m.JobIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.JobIds[iNdEx])
+			copy(dAtA[i:], m.JobIds[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Queue_Permissions_Subject) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobSetSuspendRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSetSuspendRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSetSuspendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Kind)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PriorityClassResourceLimits) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobSetResumeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSetResumeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSetResumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.MaximumResourceFraction) > 0 {
-		for k, v := range m.MaximumResourceFraction {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + 8
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.MaximumResourceFractionByPool) > 0 {
-		for k, v := range m.MaximumResourceFractionByPool {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovSubmit(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PriorityClassPoolResourceLimits) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobSuspensionResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.MaximumResourceFraction) > 0 {
-		for k, v := range m.MaximumResourceFraction {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + 8
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueueList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Queues) > 0 {
-		for _, e := range m.Queues {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
-		}
-	}
-	return n
+func (m *JobSuspensionResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CancellationResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *JobSuspensionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CancelledIds) > 0 {
-		for _, s := range m.CancelledIds {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
+	if len(m.Results) > 0 {
+		for k := range m.Results {
+			v := m.Results[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSubmit(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSubmit(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PreemptionResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PreemptionResults) > 0 {
-		for k, v := range m.PreemptionResults {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
-			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
-		}
+func (m *RetryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RetryPolicy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RetryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if m.RetryLimit != 0 {
-		n += 1 + sovSubmit(uint64(m.RetryLimit))
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
 	if m.DefaultAction != 0 {
-		n += 1 + sovSubmit(uint64(m.DefaultAction))
+		i = encodeVarintSubmit(dAtA, i, uint64(m.DefaultAction))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Rules) > 0 {
-		for _, e := range m.Rules {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
-		}
+	if m.RetryLimit != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.RetryLimit))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RetryRule) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RetryRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Action != 0 {
-		n += 1 + sovSubmit(uint64(m.Action))
+	if m.Mutate != nil {
+		{
+			size, err := m.Mutate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.OnCategory)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if len(m.OnSubcategory) > 0 {
+		i -= len(m.OnSubcategory)
+		copy(dAtA[i:], m.OnSubcategory)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.OnSubcategory)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.OnSubcategory)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if len(m.OnCategory) > 0 {
+		i -= len(m.OnCategory)
+		copy(dAtA[i:], m.OnCategory)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.OnCategory)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Mutate != nil {
-		l = m.Mutate.Size()
-		n += 1 + l + sovSubmit(uint64(l))
+	if m.Action != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RetryMutation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RetryMutation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryMutation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryMutation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Affinity != nil {
-		l = m.Affinity.Size()
-		n += 1 + l + sovSubmit(uint64(l))
-	}
 	if m.Resources != nil {
-		l = m.Resources.Size()
-		n += 1 + l + sovSubmit(uint64(l))
+		{
+			size, err := m.Resources.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Affinity != nil {
+		{
+			size, err := m.Affinity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RetryAffinityMutation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RetryAffinityMutation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryAffinityMutation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryAffinityMutation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.AvoidSameNode {
-		n += 2
-	}
-	return n
+		i--
+		if m.AvoidSameNode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RetryResourceMutation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RetryResourceMutation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryResourceMutation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryResourceMutation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Memory != nil {
-		l = m.Memory.Size()
-		n += 1 + l + sovSubmit(uint64(l))
+		{
+			size, err := m.Memory.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RetryResourceBump) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RetryResourceBump) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryResourceBump) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryResourceBump) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Static)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
 	if m.Factor != 0 {
-		n += 9
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Factor))))
+		i--
+		dAtA[i] = 0x11
 	}
-	return n
+	if len(m.Static) > 0 {
+		i -= len(m.Static)
+		copy(dAtA[i:], m.Static)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Static)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RetryPolicyGetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RetryPolicyGetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryPolicyGetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryPolicyGetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RetryPolicyDeleteRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RetryPolicyDeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryPolicyDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryPolicyDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RetryPolicyListRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RetryPolicyListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryPolicyListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryPolicyListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RetryPolicyList) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RetryPolicyList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryPolicyList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryPolicyList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.RetryPolicies) > 0 {
-		for _, e := range m.RetryPolicies {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
+		for iNdEx := len(m.RetryPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RetryPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueueGetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+func (m *QueueGetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueueCordonRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	return n
+func (m *QueueGetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueueUncordonRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueueGetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StreamingQueueGetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Num != 0 {
-		n += 1 + sovSubmit(uint64(m.Num))
+func (m *QueueCordonRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueueDeleteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueueCordonRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueCordonRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobSetInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueueUncordonRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueUncordonRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueUncordonRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	if m.QueuedJobs != 0 {
-		n += 1 + sovSubmit(uint64(m.QueuedJobs))
-	}
-	if m.LeasedJobs != 0 {
-		n += 1 + sovSubmit(uint64(m.LeasedJobs))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueueUpdateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StreamingQueueGetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamingQueueGetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamingQueueGetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Queue != nil {
-		l = m.Queue.Size()
-		n += 1 + l + sovSubmit(uint64(l))
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if m.Num != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.Num))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BatchQueueUpdateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueueDeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.FailedQueues) > 0 {
-		for _, e := range m.FailedQueues {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
-		}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueueCreateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobSetInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSetInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Queue != nil {
-		l = m.Queue.Size()
-		n += 1 + l + sovSubmit(uint64(l))
+	if m.LeasedJobs != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.LeasedJobs))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if m.QueuedJobs != 0 {
+		i = encodeVarintSubmit(dAtA, i, uint64(m.QueuedJobs))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *BatchQueueCreateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueueUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.FailedQueues) > 0 {
-		for _, e := range m.FailedQueues {
-			l = e.Size()
-			n += 1 + l + sovSubmit(uint64(l))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Queue != nil {
+		{
+			size, err := m.Queue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EndMarker) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BatchQueueUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *StreamingQueueMessage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Event != nil {
-		n += m.Event.Size()
-	}
-	return n
+func (m *BatchQueueUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StreamingQueueMessage_Queue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BatchQueueUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if len(m.FailedQueues) > 0 {
+		for iNdEx := len(m.FailedQueues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedQueues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueCreateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueCreateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueCreateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Queue != nil {
-		l = m.Queue.Size()
-		n += 1 + l + sovSubmit(uint64(l))
+		{
+			size, err := m.Queue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *StreamingQueueMessage_End) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *BatchQueueCreateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchQueueCreateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchQueueCreateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.End != nil {
-		l = m.End.Size()
-		n += 1 + l + sovSubmit(uint64(l))
+	if len(m.FailedQueues) > 0 {
+		for iNdEx := len(m.FailedQueues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedQueues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *QueuePreemptRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EndMarker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EndMarker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndMarker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamingQueueMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PriorityClasses) > 0 {
-		for _, s := range m.PriorityClasses {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *StreamingQueueMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamingQueueMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Event != nil {
+		{
+			size := m.Event.Size()
+			i -= size
+			if _, err := m.Event.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
 		}
 	}
-	if len(m.Pools) > 0 {
-		for _, s := range m.Pools {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamingQueueMessage_Queue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamingQueueMessage_Queue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Queue != nil {
+		{
+			size, err := m.Queue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *StreamingQueueMessage_End) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueueCancelRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StreamingQueueMessage_End) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.End != nil {
+		{
+			size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubmit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *QueuePreemptRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuePreemptRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuePreemptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSubmit(uint64(l))
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Pools[iNdEx])
+			copy(dAtA[i:], m.Pools[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.Pools[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if len(m.PriorityClasses) > 0 {
-		for _, s := range m.PriorityClasses {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
+		for iNdEx := len(m.PriorityClasses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PriorityClasses[iNdEx])
+			copy(dAtA[i:], m.PriorityClasses[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.PriorityClasses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.JobStates) > 0 {
-		l = 0
-		for _, e := range m.JobStates {
-			l += sovSubmit(uint64(e))
-		}
-		n += 1 + sovSubmit(uint64(l)) + l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.Pools) > 0 {
-		for _, s := range m.Pools {
-			l = len(s)
-			n += 1 + l + sovSubmit(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueueCancelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueCancelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueueCancelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Pools[iNdEx])
+			copy(dAtA[i:], m.Pools[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.Pools[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.JobStates) > 0 {
+		dAtA27 := make([]byte, len(m.JobStates)*10)
+		var j26 int
+		for _, num := range m.JobStates {
+			for num >= 1<<7 {
+				dAtA27[j26] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j26++
+			}
+			dAtA27[j26] = uint8(num)
+			j26++
+		}
+		i -= j26
+		copy(dAtA[i:], dAtA27[:j26])
+		i = encodeVarintSubmit(dAtA, i, uint64(j26))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PriorityClasses) > 0 {
+		for iNdEx := len(m.PriorityClasses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PriorityClasses[iNdEx])
+			copy(dAtA[i:], m.PriorityClasses[iNdEx])
+			i = encodeVarintSubmit(dAtA, i, uint64(len(m.PriorityClasses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSubmit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSubmit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSubmit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *JobSubmitRequestItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		n += 9
+	}
+	if m.PodSpec != nil {
+		l = m.PodSpec.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.RequiredNodeLabels) > 0 {
+		for k, v := range m.RequiredNodeLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.PodSpecs) > 0 {
+		for _, e := range m.PodSpecs {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.Ingress) > 0 {
+		for _, e := range m.Ingress {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.Services) > 0 {
+		for _, e := range m.Services {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = len(m.Scheduler)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.ExternalJobUri)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.ConfigMaps) > 0 {
+		for _, e := range m.ConfigMaps {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.Secrets) > 0 {
+		for _, e := range m.Secrets {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.VolumeClaimTemplates) > 0 {
+		for _, e := range m.VolumeClaimTemplates {
+			l = e.Size()
+			n += 2 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SecretReference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.SourceName)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *IngressConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovSubmit(uint64(m.Type))
+	}
+	if len(m.Ports) > 0 {
+		l = 0
+		for _, e := range m.Ports {
+			l += sovSubmit(uint64(e))
+		}
+		n += 1 + sovSubmit(uint64(l)) + l
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if m.TlsEnabled {
+		n += 2
+	}
+	l = len(m.CertName)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.UseClusterIP {
+		n += 2
+	}
+	return n
+}
+
+func (m *ServiceConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovSubmit(uint64(m.Type))
+	}
+	if len(m.Ports) > 0 {
+		l = 0
+		for _, e := range m.Ports {
+			l += sovSubmit(uint64(e))
+		}
+		n += 1 + sovSubmit(uint64(l)) + l
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSubmitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.JobRequestItems) > 0 {
+		for _, e := range m.JobRequestItems {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobPreemptRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.JobIds) > 0 {
+		for _, s := range m.JobIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobCancelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.JobIds) > 0 {
+		for _, s := range m.JobIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSetCancelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSetFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.States) > 0 {
+		l = 0
+		for _, e := range m.States {
+			l += sovSubmit(uint64(e))
+		}
+		n += 1 + sovSubmit(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *Job) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 9
+	}
+	if m.PodSpec != nil {
+		l = m.PodSpec.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.RequiredNodeLabels) > 0 {
+		for k, v := range m.RequiredNodeLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.PodSpecs) > 0 {
+		for _, e := range m.PodSpecs {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.Ingress) > 0 {
+		for _, e := range m.Ingress {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.QueueOwnershipUserGroups) > 0 {
+		for _, s := range m.QueueOwnershipUserGroups {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.Services) > 0 {
+		for _, e := range m.Services {
+			l = e.Size()
+			n += 2 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.K8SIngress) > 0 {
+		for _, e := range m.K8SIngress {
+			l = e.Size()
+			n += 2 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.K8SService) > 0 {
+		for _, e := range m.K8SService {
+			l = e.Size()
+			n += 2 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = len(m.CompressedQueueOwnershipUserGroups)
+	if l > 0 {
+		n += 2 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Scheduler)
+	if l > 0 {
+		n += 2 + l + sovSubmit(uint64(l))
+	}
+	if m.SchedulingResourceRequirements != nil {
+		l = m.SchedulingResourceRequirements.Size()
+		n += 2 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobReprioritizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobIds) > 0 {
+		for _, s := range m.JobIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.NewPriority != 0 {
+		n += 9
+	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobReprioritizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReprioritizationResults) > 0 {
+		for k, v := range m.ReprioritizationResults {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *JobSubmitResponseItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSubmitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobResponseItems) > 0 {
+		for _, e := range m.JobResponseItems {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Queue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.PriorityFactor != 0 {
+		n += 9
+	}
+	if len(m.UserOwners) > 0 {
+		for _, s := range m.UserOwners {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.GroupOwners) > 0 {
+		for _, s := range m.GroupOwners {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.ResourceLimits) > 0 {
+		for k, v := range m.ResourceLimits {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + 8
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Permissions) > 0 {
+		for _, e := range m.Permissions {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.ResourceLimitsByPriorityClassName) > 0 {
+		for k, v := range m.ResourceLimitsByPriorityClassName {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovSubmit(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if m.Cordoned {
+		n += 2
+	}
+	if len(m.LabelsDeprecated) > 0 {
+		for _, s := range m.LabelsDeprecated {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.RetryPolicies) > 0 {
+		for _, s := range m.RetryPolicies {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Queue_Permissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subjects) > 0 {
+		for _, e := range m.Subjects {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.Verbs) > 0 {
+		for _, s := range m.Verbs {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Queue_Permissions_Subject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *PriorityClassResourceLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MaximumResourceFraction) > 0 {
+		for k, v := range m.MaximumResourceFraction {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + 8
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MaximumResourceFractionByPool) > 0 {
+		for k, v := range m.MaximumResourceFractionByPool {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovSubmit(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *PriorityClassPoolResourceLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MaximumResourceFraction) > 0 {
+		for k, v := range m.MaximumResourceFraction {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + 8
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueueList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Queues) > 0 {
+		for _, e := range m.Queues {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CancellationResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CancelledIds) > 0 {
+		for _, s := range m.CancelledIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PreemptionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PreemptionResults) > 0 {
+		for k, v := range m.PreemptionResults {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *JobSelector) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.JobSetPrefix)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSelectorCancelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.Selector != nil {
+		l = m.Selector.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *JobSelectorReprioritizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.Selector != nil {
+		l = m.Selector.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.NewPriority != 0 {
+		n += 9
+	}
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *JobSelectorResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MatchedJobs != 0 {
+		n += 1 + sovSubmit(uint64(m.MatchedJobs))
+	}
+	return n
+}
+
+func (m *QueuedJobUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ContainerResources) > 0 {
+		for k, v := range m.ContainerResources {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovSubmit(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.NodeSelector) > 0 {
+		for k, v := range m.NodeSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Tolerations) > 0 {
+		for _, e := range m.Tolerations {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = len(m.PriorityClassName)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobUpdateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobIds) > 0 {
+		for _, s := range m.JobIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.Update != nil {
+		l = m.Update.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UpdateResults) > 0 {
+		for k, v := range m.UpdateResults {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *JobMoveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobIds) > 0 {
+		for _, s := range m.JobIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.DestinationQueue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.DestinationJobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobMoveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MoveResults) > 0 {
+		for k, v := range m.MoveResults {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *JobSuspendRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobIds) > 0 {
+		for _, s := range m.JobIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobResumeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.JobIds) > 0 {
+		for _, s := range m.JobIds {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSetSuspendRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSetResumeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSuspensionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for k, v := range m.Results {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSubmit(uint64(len(k))) + 1 + len(v) + sovSubmit(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSubmit(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *RetryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.RetryLimit != 0 {
+		n += 1 + sovSubmit(uint64(m.RetryLimit))
+	}
+	if m.DefaultAction != 0 {
+		n += 1 + sovSubmit(uint64(m.DefaultAction))
+	}
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RetryRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != 0 {
+		n += 1 + sovSubmit(uint64(m.Action))
+	}
+	l = len(m.OnCategory)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.OnSubcategory)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.Mutate != nil {
+		l = m.Mutate.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *RetryMutation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Affinity != nil {
+		l = m.Affinity.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.Resources != nil {
+		l = m.Resources.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *RetryAffinityMutation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AvoidSameNode {
+		n += 2
+	}
+	return n
+}
+
+func (m *RetryResourceMutation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Memory != nil {
+		l = m.Memory.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *RetryResourceBump) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Static)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.Factor != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *RetryPolicyGetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *RetryPolicyDeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *RetryPolicyListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RetryPolicyList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RetryPolicies) > 0 {
+		for _, e := range m.RetryPolicies {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueueGetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *QueueCordonRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *QueueUncordonRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *StreamingQueueGetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Num != 0 {
+		n += 1 + sovSubmit(uint64(m.Num))
+	}
+	return n
+}
+
+func (m *QueueDeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSetInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if m.QueuedJobs != 0 {
+		n += 1 + sovSubmit(uint64(m.QueuedJobs))
+	}
+	if m.LeasedJobs != 0 {
+		n += 1 + sovSubmit(uint64(m.LeasedJobs))
+	}
+	return n
+}
+
+func (m *QueueUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Queue != nil {
+		l = m.Queue.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchQueueUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailedQueues) > 0 {
+		for _, e := range m.FailedQueues {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueueCreateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Queue != nil {
+		l = m.Queue.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchQueueCreateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailedQueues) > 0 {
+		for _, e := range m.FailedQueues {
+			l = e.Size()
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EndMarker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StreamingQueueMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Event != nil {
+		n += m.Event.Size()
+	}
+	return n
+}
+
+func (m *StreamingQueueMessage_Queue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Queue != nil {
+		l = m.Queue.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+func (m *StreamingQueueMessage_End) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.End != nil {
+		l = m.End.Size()
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	return n
+}
+func (m *QueuePreemptRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.PriorityClasses) > 0 {
+		for _, s := range m.PriorityClasses {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.Pools) > 0 {
+		for _, s := range m.Pools {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueueCancelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSubmit(uint64(l))
+	}
+	if len(m.PriorityClasses) > 0 {
+		for _, s := range m.PriorityClasses {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	if len(m.JobStates) > 0 {
+		l = 0
+		for _, e := range m.JobStates {
+			l += sovSubmit(uint64(e))
+		}
+		n += 1 + sovSubmit(uint64(l)) + l
+	}
+	if len(m.Pools) > 0 {
+		for _, s := range m.Pools {
+			l = len(s)
+			n += 1 + l + sovSubmit(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovSubmit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSubmit(x uint64) (n int) {
+	return sovSubmit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSubmitRequestItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSubmitRequestItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Priority = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PodSpec == nil {
+				m.PodSpec = &v1.PodSpec{}
+			}
+			if err := m.PodSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredNodeLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RequiredNodeLabels == nil {
+				m.RequiredNodeLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RequiredNodeLabels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpecs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodSpecs = append(m.PodSpecs, &v1.PodSpec{})
+			if err := m.PodSpecs[len(m.PodSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ingress = append(m.Ingress, &IngressConfig{})
+			if err := m.Ingress[len(m.Ingress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Services = append(m.Services, &ServiceConfig{})
+			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheduler", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scheduler = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalJobUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalJobUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigMaps = append(m.ConfigMaps, &v1.ConfigMap{})
+			if err := m.ConfigMaps[len(m.ConfigMaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secrets = append(m.Secrets, &SecretReference{})
+			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeClaimTemplates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VolumeClaimTemplates = append(m.VolumeClaimTemplates, &v1.PersistentVolumeClaimTemplate{})
+			if err := m.VolumeClaimTemplates[len(m.VolumeClaimTemplates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SecretReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecretReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecretReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IngressConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IngressConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IngressConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= IngressType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ports = append(m.Ports, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSubmit
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSubmit
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ports) == 0 {
+					m.Ports = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ports = append(m.Ports, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TlsEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TlsEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CertName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseClusterIP", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseClusterIP = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ServiceType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ports = append(m.Ports, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSubmit
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSubmit
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ports) == 0 {
+					m.Ports = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ports = append(m.Ports, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSubmitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSubmitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobRequestItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobRequestItems = append(m.JobRequestItems, &JobSubmitRequestItem{})
+			if err := m.JobRequestItems[len(m.JobRequestItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobPreemptRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobPreemptRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobPreemptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobCancelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSetCancelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSetCancelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSetCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &JobSetFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSetFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSetFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSetFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v JobState
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= JobState(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.States = append(m.States, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSubmit
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSubmit
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.States) == 0 {
+					m.States = make([]JobState, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v JobState
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= JobState(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.States = append(m.States, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovSubmit(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSubmit(x uint64) (n int) {
-	return sovSubmit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
+func (m *Job) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7954,39 +12910,271 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowSubmit
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Job: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Job: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Priority = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PodSpec == nil {
+				m.PodSpec = &v1.PodSpec{}
+			}
+			if err := m.PodSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &types.Timestamp{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobSubmitRequestItem: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSubmitRequestItem: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Priority = float64(math.Float64frombits(v))
-		case 2:
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8013,18 +13201,109 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PodSpec == nil {
-				m.PodSpec = &v1.PodSpec{}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			if err := m.PodSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -8034,27 +13313,122 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredNodeLabels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8081,8 +13455,8 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.RequiredNodeLabels == nil {
+				m.RequiredNodeLabels = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -8177,11 +13551,211 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			m.RequiredNodeLabels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 5:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpecs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodSpecs = append(m.PodSpecs, &v1.PodSpec{})
+			if err := m.PodSpecs[len(m.PodSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ingress = append(m.Ingress, &IngressConfig{})
+			if err := m.Ingress[len(m.Ingress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueOwnershipUserGroups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueOwnershipUserGroups = append(m.QueueOwnershipUserGroups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Services = append(m.Services, &ServiceConfig{})
+			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field K8SIngress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.K8SIngress = append(m.K8SIngress, &v11.Ingress{})
+			if err := m.K8SIngress[len(m.K8SIngress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field K8SService", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8198,119 +13772,60 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.K8SService = append(m.K8SService, &v1.Service{})
+			if err := m.K8SService[len(m.K8SService)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressedQueueOwnershipUserGroups", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSubmit(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.CompressedQueueOwnershipUserGroups = append(m.CompressedQueueOwnershipUserGroups[:0], dAtA[iNdEx:postIndex]...)
+			if m.CompressedQueueOwnershipUserGroups == nil {
+				m.CompressedQueueOwnershipUserGroups = []byte{}
 			}
-			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 6:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredNodeLabels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheduler", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -8320,122 +13835,27 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RequiredNodeLabels == nil {
-				m.RequiredNodeLabels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSubmit(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.RequiredNodeLabels[mapkey] = mapvalue
+			m.Scheduler = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpecs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingResourceRequirements", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8459,17 +13879,69 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SchedulingResourceRequirements == nil {
+				m.SchedulingResourceRequirements = &v1.ResourceRequirements{}
+			}
+			if err := m.SchedulingResourceRequirements.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobReprioritizeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSpecs = append(m.PodSpecs, &v1.PodSpec{})
-			if err := m.PodSpecs[len(m.PodSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 8:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobReprioritizeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobReprioritizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8497,47 +13969,13 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Ingress = append(m.Ingress, &IngressConfig{})
-			if err := m.Ingress[len(m.Ingress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -8547,29 +13985,27 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Services = append(m.Services, &ServiceConfig{})
-			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scheduler", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8597,11 +14033,22 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scheduler = string(dAtA[iNdEx:postIndex])
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPriority", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.NewPriority = float64(math.Float64frombits(v))
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalJobUri", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8629,7 +14076,7 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalJobUri = string(dAtA[iNdEx:postIndex])
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8652,7 +14099,7 @@ func (m *JobSubmitRequestItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IngressConfig) Unmarshal(dAtA []byte) error {
+func (m *JobReprioritizeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8675,110 +14122,15 @@ func (m *IngressConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IngressConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobReprioritizeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IngressConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobReprioritizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= IngressType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Ports = append(m.Ports, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthSubmit
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthSubmit
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Ports) == 0 {
-					m.Ports = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ports = append(m.Ports, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReprioritizationResults", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8805,8 +14157,8 @@ func (m *IngressConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			if m.ReprioritizationResults == nil {
+				m.ReprioritizationResults = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -8901,13 +14253,63 @@ func (m *IngressConfig) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
+			m.ReprioritizationResults[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TlsEnabled", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSubmitResponseItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSubmitResponseItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSubmitResponseItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -8917,15 +14319,27 @@ func (m *IngressConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TlsEnabled = bool(v != 0)
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8953,13 +14367,63 @@ func (m *IngressConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertName = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseClusterIP", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSubmitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSubmitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSubmitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobResponseItems", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -8969,12 +14433,26 @@ func (m *IngressConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UseClusterIP = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobResponseItems = append(m.JobResponseItems, &JobSubmitResponseItem{})
+			if err := m.JobResponseItems[len(m.JobResponseItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -8996,7 +14474,7 @@ func (m *IngressConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ServiceConfig) Unmarshal(dAtA []byte) error {
+func (m *Queue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9019,17 +14497,17 @@ func (m *ServiceConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ServiceConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: Queue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServiceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Queue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -9039,31 +14517,136 @@ func (m *ServiceConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= ServiceType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFactor", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.PriorityFactor = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserOwners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
 				}
-				m.Ports = append(m.Ports, v)
-			} else if wireType == 2 {
-				var packedLen int
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserOwners = append(m.UserOwners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupOwners = append(m.GroupOwners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceLimits == nil {
+				m.ResourceLimits = make(map[string]float64)
+			}
+			var mapkey string
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowSubmit
@@ -9073,34 +14656,14 @@ func (m *ServiceConfig) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					packedLen |= int(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthSubmit
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthSubmit
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Ports) == 0 {
-					m.Ports = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowSubmit
@@ -9110,21 +14673,54 @@ func (m *ServiceConfig) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= uint32(b&0x7F) << shift
+						stringLenmapkey |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					m.Ports = append(m.Ports, v)
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
 			}
-		case 3:
+			m.ResourceLimits[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -9134,79 +14730,160 @@ func (m *ServiceConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSubmit(dAtA[iNdEx:])
-			if err != nil {
+			m.Permissions = append(m.Permissions, &Queue_Permissions{})
+			if err := m.Permissions[len(m.Permissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSubmit
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimitsByPriorityClassName", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSubmit
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.ResourceLimitsByPriorityClassName == nil {
+				m.ResourceLimitsByPriorityClassName = make(map[string]*PriorityClassResourceLimits)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobSubmitRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSubmitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			var mapkey string
+			var mapvalue *PriorityClassResourceLimits
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &PriorityClassResourceLimits{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-			var stringLen uint64
+			m.ResourceLimitsByPriorityClassName[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cordoned", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -9216,27 +14893,15 @@ func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.Cordoned = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelsDeprecated", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9264,11 +14929,11 @@ func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			m.LabelsDeprecated = append(m.LabelsDeprecated, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobRequestItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9295,160 +14960,107 @@ func (m *JobSubmitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobRequestItems = append(m.JobRequestItems, &JobSubmitRequestItem{})
-			if err := m.JobRequestItems[len(m.JobRequestItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSubmit(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobPreemptRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSubmit
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobPreemptRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobPreemptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicies", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9476,7 +15088,7 @@ func (m *JobPreemptRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.RetryPolicies = append(m.RetryPolicies, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9499,7 +15111,7 @@ func (m *JobPreemptRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
+func (m *Queue_Permissions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9522,17 +15134,17 @@ func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobCancelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Permissions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Permissions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subjects", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -9542,27 +15154,29 @@ func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			m.Subjects = append(m.Subjects, &Queue_Permissions_Subject{})
+			if err := m.Subjects[len(m.Subjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Verbs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9590,43 +15204,61 @@ func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			m.Verbs = append(m.Verbs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Queue_Permissions_Subject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Subject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Subject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9654,11 +15286,11 @@ func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9686,7 +15318,7 @@ func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9709,7 +15341,7 @@ func (m *JobCancelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSetCancelRequest) Unmarshal(dAtA []byte) error {
+func (m *PriorityClassResourceLimits) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9732,17 +15364,17 @@ func (m *JobSetCancelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSetCancelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriorityClassResourceLimits: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSetCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriorityClassResourceLimits: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumResourceFraction", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -9752,59 +15384,101 @@ func (m *JobSetCancelRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			if m.MaximumResourceFraction == nil {
+				m.MaximumResourceFraction = make(map[string]float64)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			m.MaximumResourceFraction[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumResourceFractionByPool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9831,44 +15505,105 @@ func (m *JobSetCancelRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &JobSetFilter{}
-			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			if m.MaximumResourceFractionByPool == nil {
+				m.MaximumResourceFractionByPool = make(map[string]*PriorityClassPoolResourceLimits)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue *PriorityClassPoolResourceLimits
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &PriorityClassPoolResourceLimits{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.MaximumResourceFractionByPool[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9891,7 +15626,7 @@ func (m *JobSetCancelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSetFilter) Unmarshal(dAtA []byte) error {
+func (m *PriorityClassPoolResourceLimits) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9914,32 +15649,49 @@ func (m *JobSetFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSetFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriorityClassPoolResourceLimits: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSetFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriorityClassPoolResourceLimits: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v JobState
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= JobState(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumResourceFraction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
 				}
-				m.States = append(m.States, v)
-			} else if wireType == 2 {
-				var packedLen int
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaximumResourceFraction == nil {
+				m.MaximumResourceFraction = make(map[string]float64)
+			}
+			var mapkey string
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowSubmit
@@ -9949,27 +15701,14 @@ func (m *JobSetFilter) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					packedLen |= int(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthSubmit
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthSubmit
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.States) == 0 {
-					m.States = make([]JobState, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v JobState
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowSubmit
@@ -9979,16 +15718,49 @@ func (m *JobSetFilter) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= JobState(b&0x7F) << shift
+						stringLenmapkey |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					m.States = append(m.States, v)
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
 			}
+			m.MaximumResourceFraction[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -10010,7 +15782,7 @@ func (m *JobSetFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Job) Unmarshal(dAtA []byte) error {
+func (m *QueueList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10033,122 +15805,15 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Job: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueueList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Job: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueueList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Priority = float64(math.Float64frombits(v))
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queues", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10175,52 +15840,64 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PodSpec == nil {
-				m.PodSpec = &v1.PodSpec{}
-			}
-			if err := m.PodSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Queues = append(m.Queues, &Queue{})
+			if err := m.Queues[len(m.Queues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSubmit
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Created == nil {
-				m.Created = &types.Timestamp{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancellationResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
 			}
-			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancellationResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancellationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10248,43 +15925,61 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.CancelledIds = append(m.CancelledIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PreemptionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PreemptionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PreemptionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreemptionResults", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10311,8 +16006,8 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.PreemptionResults == nil {
+				m.PreemptionResults = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -10407,11 +16102,61 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			m.PreemptionResults[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSelector) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSelector: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSelector: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10438,8 +16183,8 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -10534,11 +16279,11 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredNodeLabels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10565,8 +16310,8 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RequiredNodeLabels == nil {
-				m.RequiredNodeLabels = make(map[string]string)
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -10661,45 +16406,11 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.RequiredNodeLabels[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpecs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PodSpecs = append(m.PodSpecs, &v1.PodSpec{})
-			if err := m.PodSpecs[len(m.PodSpecs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10727,45 +16438,61 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			m.JobSetPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSelectorCancelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ingress = append(m.Ingress, &IngressConfig{})
-			if err := m.Ingress[len(m.Ingress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 15:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSelectorCancelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSelectorCancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueueOwnershipUserGroups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10793,11 +16520,11 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueueOwnershipUserGroups = append(m.QueueOwnershipUserGroups, string(dAtA[iNdEx:postIndex]))
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10824,50 +16551,18 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Services = append(m.Services, &ServiceConfig{})
-			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field K8SIngress", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Selector == nil {
+				m.Selector = &JobSelector{}
 			}
-			m.K8SIngress = append(m.K8SIngress, &v11.Ingress{})
-			if err := m.K8SIngress[len(m.K8SIngress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field K8SService", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -10877,31 +16572,29 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.K8SService = append(m.K8SService, &v1.Service{})
-			if err := m.K8SService[len(m.K8SService)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompressedQueueOwnershipUserGroups", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -10911,29 +16604,65 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthSubmit
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CompressedQueueOwnershipUserGroups = append(m.CompressedQueueOwnershipUserGroups[:0], dAtA[iNdEx:postIndex]...)
-			if m.CompressedQueueOwnershipUserGroups == nil {
-				m.CompressedQueueOwnershipUserGroups = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSelectorReprioritizeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
 			}
-			iNdEx = postIndex
-		case 20:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSelectorReprioritizeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSelectorReprioritizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scheduler", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10961,11 +16690,11 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scheduler = string(dAtA[iNdEx:postIndex])
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 21:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingResourceRequirements", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10992,13 +16721,44 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SchedulingResourceRequirements == nil {
-				m.SchedulingResourceRequirements = &v1.ResourceRequirements{}
+			if m.Selector == nil {
+				m.Selector = &JobSelector{}
 			}
-			if err := m.SchedulingResourceRequirements.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPriority", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.NewPriority = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -11020,7 +16780,7 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobReprioritizeRequest) Unmarshal(dAtA []byte) error {
+func (m *JobSelectorResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11043,17 +16803,17 @@ func (m *JobReprioritizeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobReprioritizeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSelectorResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobReprioritizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSelectorResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchedJobs", wireType)
 			}
-			var stringLen uint64
+			m.MatchedJobs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -11063,29 +16823,66 @@ func (m *JobReprioritizeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MatchedJobs |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSubmit
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedJobUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedJobUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedJobUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerResources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -11095,120 +16892,124 @@ func (m *JobReprioritizeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			if m.ContainerResources == nil {
+				m.ContainerResources = make(map[string]*v1.ResourceRequirements)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue *v1.ResourceRequirements
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSubmit
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v1.ResourceRequirements{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSubmit(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			m.ContainerResources[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPriority", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.NewPriority = float64(math.Float64frombits(v))
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSubmit(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobReprioritizeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSubmit
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobReprioritizeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobReprioritizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReprioritizationResults", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11235,8 +17036,8 @@ func (m *JobReprioritizeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReprioritizationResults == nil {
-				m.ReprioritizationResults = make(map[string]string)
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -11331,63 +17132,13 @@ func (m *JobReprioritizeResponse) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.ReprioritizationResults[mapkey] = mapvalue
+			m.NodeSelector[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSubmit(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobSubmitResponseItem) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSubmit
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobSubmitResponseItem: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSubmitResponseItem: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -11397,27 +17148,29 @@ func (m *JobSubmitResponseItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			m.Tolerations = append(m.Tolerations, &v1.Toleration{})
+			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11442,94 +17195,10 @@ func (m *JobSubmitResponseItem) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Error = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSubmit(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobSubmitResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSubmit
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobSubmitResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSubmitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobResponseItems", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JobResponseItems = append(m.JobResponseItems, &JobSubmitResponseItem{})
-			if err := m.JobResponseItems[len(m.JobResponseItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11552,7 +17221,7 @@ func (m *JobSubmitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Queue) Unmarshal(dAtA []byte) error {
+func (m *JobUpdateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11575,15 +17244,15 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Queue: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobUpdateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Queue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11611,22 +17280,11 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFactor", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.PriorityFactor = float64(math.Float64frombits(v))
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserOwners", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11654,11 +17312,11 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserOwners = append(m.UserOwners, string(dAtA[iNdEx:postIndex]))
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupOwners", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11686,11 +17344,11 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupOwners = append(m.GroupOwners, string(dAtA[iNdEx:postIndex]))
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11717,11 +17375,97 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceLimits == nil {
-				m.ResourceLimits = make(map[string]float64)
+			if m.Update == nil {
+				m.Update = &QueuedJobUpdate{}
+			}
+			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobUpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobUpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateResults == nil {
+				m.UpdateResults = make(map[string]string)
 			}
 			var mapkey string
-			var mapvalue float64
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -11770,13 +17514,34 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapvaluetemp uint64
-					if (iNdEx + 8) > l {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSubmit
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSubmit
+					}
+					if postStringIndexmapvalue > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-					iNdEx += 8
-					mapvalue = math.Float64frombits(mapvaluetemp)
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipSubmit(dAtA[iNdEx:])
@@ -11792,13 +17557,63 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.ResourceLimits[mapkey] = mapvalue
+			m.UpdateResults[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobMoveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobMoveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobMoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -11808,31 +17623,61 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Permissions = append(m.Permissions, &Queue_Permissions{})
-			if err := m.Permissions[len(m.Permissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimitsByPriorityClassName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -11842,126 +17687,29 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceLimitsByPriorityClassName == nil {
-				m.ResourceLimitsByPriorityClassName = make(map[string]*PriorityClassResourceLimits)
-			}
-			var mapkey string
-			var mapvalue *PriorityClassResourceLimits
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &PriorityClassResourceLimits{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSubmit(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.ResourceLimitsByPriorityClassName[mapkey] = mapvalue
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cordoned", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationQueue", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -11971,15 +17719,27 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Cordoned = bool(v != 0)
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelsDeprecated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationJobSetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12007,11 +17767,61 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LabelsDeprecated = append(m.LabelsDeprecated, string(dAtA[iNdEx:postIndex]))
+			m.DestinationJobSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobMoveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobMoveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobMoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MoveResults", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12038,8 +17848,8 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.MoveResults == nil {
+				m.MoveResults = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -12134,39 +17944,7 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Labels[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicies", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSubmit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RetryPolicies = append(m.RetryPolicies, string(dAtA[iNdEx:postIndex]))
+			m.MoveResults[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12189,7 +17967,7 @@ func (m *Queue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Queue_Permissions) Unmarshal(dAtA []byte) error {
+func (m *JobSuspendRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12212,17 +17990,17 @@ func (m *Queue_Permissions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Permissions: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSuspendRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Permissions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSuspendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subjects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -12232,29 +18010,27 @@ func (m *Queue_Permissions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subjects = append(m.Subjects, &Queue_Permissions_Subject{})
-			if err := m.Subjects[len(m.Subjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verbs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12272,71 +18048,21 @@ func (m *Queue_Permissions) Unmarshal(dAtA []byte) error {
 				}
 			}
 			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Verbs = append(m.Verbs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSubmit(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSubmit
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Queue_Permissions_Subject) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSubmit
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Subject: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Subject: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12364,11 +18090,11 @@ func (m *Queue_Permissions_Subject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12396,7 +18122,7 @@ func (m *Queue_Permissions_Subject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12419,7 +18145,7 @@ func (m *Queue_Permissions_Subject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriorityClassResourceLimits) Unmarshal(dAtA []byte) error {
+func (m *JobResumeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12442,17 +18168,17 @@ func (m *PriorityClassResourceLimits) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriorityClassResourceLimits: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobResumeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriorityClassResourceLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobResumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumResourceFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -12462,103 +18188,29 @@ func (m *PriorityClassResourceLimits) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaximumResourceFraction == nil {
-				m.MaximumResourceFraction = make(map[string]float64)
-			}
-			var mapkey string
-			var mapvalue float64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp uint64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-					iNdEx += 8
-					mapvalue = math.Float64frombits(mapvaluetemp)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSubmit(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.MaximumResourceFraction[mapkey] = mapvalue
+			m.JobIds = append(m.JobIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumResourceFractionByPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -12568,120 +18220,55 @@ func (m *PriorityClassResourceLimits) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.MaximumResourceFractionByPool == nil {
-				m.MaximumResourceFractionByPool = make(map[string]*PriorityClassPoolResourceLimits)
-			}
-			var mapkey string
-			var mapvalue *PriorityClassPoolResourceLimits
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &PriorityClassPoolResourceLimits{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSubmit(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.MaximumResourceFractionByPool[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12704,7 +18291,7 @@ func (m *PriorityClassResourceLimits) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriorityClassPoolResourceLimits) Unmarshal(dAtA []byte) error {
+func (m *JobSetSuspendRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12727,17 +18314,17 @@ func (m *PriorityClassPoolResourceLimits) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriorityClassPoolResourceLimits: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSetSuspendRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriorityClassPoolResourceLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSetSuspendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumResourceFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -12747,153 +18334,61 @@ func (m *PriorityClassPoolResourceLimits) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaximumResourceFraction == nil {
-				m.MaximumResourceFraction = make(map[string]float64)
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
-			var mapkey string
-			var mapvalue float64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowSubmit
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowSubmit
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp uint64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-					iNdEx += 8
-					mapvalue = math.Float64frombits(mapvaluetemp)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipSubmit(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthSubmit
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.MaximumResourceFraction[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSubmit(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueueList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSubmit
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueueList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueueList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSubmit
@@ -12903,25 +18398,23 @@ func (m *QueueList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSubmit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSubmit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queues = append(m.Queues, &Queue{})
-			if err := m.Queues[len(m.Queues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12944,7 +18437,7 @@ func (m *QueueList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancellationResult) Unmarshal(dAtA []byte) error {
+func (m *JobSetResumeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12967,15 +18460,15 @@ func (m *CancellationResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancellationResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSetResumeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancellationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSetResumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelledIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13003,7 +18496,39 @@ func (m *CancellationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CancelledIds = append(m.CancelledIds, string(dAtA[iNdEx:postIndex]))
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13026,7 +18551,7 @@ func (m *CancellationResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PreemptionResult) Unmarshal(dAtA []byte) error {
+func (m *JobSuspensionResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13049,15 +18574,15 @@ func (m *PreemptionResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PreemptionResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSuspensionResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PreemptionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSuspensionResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreemptionResults", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13084,8 +18609,8 @@ func (m *PreemptionResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PreemptionResults == nil {
-				m.PreemptionResults = make(map[string]string)
+			if m.Results == nil {
+				m.Results = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -13180,7 +18705,7 @@ func (m *PreemptionResult) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.PreemptionResults[mapkey] = mapvalue
+			m.Results[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -180,6 +180,12 @@ func JobIdFromApiEvent(msg *EventMessage) string {
 		return e.Reprioritizing.JobId
 	case *EventMessage_Preempted:
 		return e.Preempted.JobId
+	case *EventMessage_Moved:
+		return e.Moved.JobId
+	case *EventMessage_Suspended:
+		return e.Suspended.JobId
+	case *EventMessage_Resumed:
+		return e.Resumed.JobId
 	}
 	return ""
 }
@@ -218,6 +224,12 @@ func JobSetIdFromApiEvent(msg *EventMessage) string {
 		return e.Reprioritizing.JobSetId
 	case *EventMessage_Preempted:
 		return e.Preempted.JobSetId
+	case *EventMessage_Moved:
+		return e.Moved.JobSetId
+	case *EventMessage_Suspended:
+		return e.Suspended.JobSetId
+	case *EventMessage_Resumed:
+		return e.Resumed.JobSetId
 	}
 	return ""
 }
@@ -202,6 +202,10 @@ type EventSequence_Event struct {
 	//	*EventSequence_Event_JobRunCancelled
 	//	*EventSequence_Event_JobValidated
 	//	*EventSequence_Event_JobCancelledDebugInfo
+	//	*EventSequence_Event_UpdateQueuedJob
+	//	*EventSequence_Event_MoveJob
+	//	*EventSequence_Event_SuspendJob
+	//	*EventSequence_Event_ResumeJob
 	Event isEventSequence_Event_Event `protobuf_oneof:"event"`
 }
 
@@ -316,6 +320,18 @@ type EventSequence_Event_JobValidated struct {
 type EventSequence_Event_JobCancelledDebugInfo struct {
 	JobCancelledDebugInfo *JobCancelledDebugInfo `protobuf:"bytes,26,opt,name=jobCancelledDebugInfo,proto3,oneof" json:"jobCancelledDebugInfo,omitempty"`
 }
+type EventSequence_Event_UpdateQueuedJob struct {
+	UpdateQueuedJob *UpdateQueuedJob `protobuf:"bytes,27,opt,name=updateQueuedJob,proto3,oneof" json:"updateQueuedJob,omitempty"`
+}
+type EventSequence_Event_MoveJob struct {
+	MoveJob *MoveJob `protobuf:"bytes,28,opt,name=moveJob,proto3,oneof" json:"moveJob,omitempty"`
+}
+type EventSequence_Event_SuspendJob struct {
+	SuspendJob *SuspendJob `protobuf:"bytes,29,opt,name=suspendJob,proto3,oneof" json:"suspendJob,omitempty"`
+}
+type EventSequence_Event_ResumeJob struct {
+	ResumeJob *ResumeJob `protobuf:"bytes,30,opt,name=resumeJob,proto3,oneof" json:"resumeJob,omitempty"`
+}
 
 func (*EventSequence_Event_SubmitJob) isEventSequence_Event_Event()                 {}
 func (*EventSequence_Event_ReprioritiseJob) isEventSequence_Event_Event()           {}
@@ -341,6 +357,10 @@ func (*EventSequence_Event_JobPreemptionRequested) isEventSequence_Event_Event()
 func (*EventSequence_Event_JobRunCancelled) isEventSequence_Event_Event()           {}
 func (*EventSequence_Event_JobValidated) isEventSequence_Event_Event()              {}
 func (*EventSequence_Event_JobCancelledDebugInfo) isEventSequence_Event_Event()     {}
+func (*EventSequence_Event_UpdateQueuedJob) isEventSequence_Event_Event()           {}
+func (*EventSequence_Event_MoveJob) isEventSequence_Event_Event()                   {}
+func (*EventSequence_Event_SuspendJob) isEventSequence_Event_Event()                {}
+func (*EventSequence_Event_ResumeJob) isEventSequence_Event_Event()                 {}
 
 func (m *EventSequence_Event) GetEvent() isEventSequence_Event_Event {
 	if m != nil {
@@ -524,6 +544,34 @@ func (m *EventSequence_Event) GetJobCancelledDebugInfo() *JobCancelledDebugInfo
 	return nil
 }
 
+func (m *EventSequence_Event) GetUpdateQueuedJob() *UpdateQueuedJob {
+	if x, ok := m.GetEvent().(*EventSequence_Event_UpdateQueuedJob); ok {
+		return x.UpdateQueuedJob
+	}
+	return nil
+}
+
+func (m *EventSequence_Event) GetMoveJob() *MoveJob {
+	if x, ok := m.GetEvent().(*EventSequence_Event_MoveJob); ok {
+		return x.MoveJob
+	}
+	return nil
+}
+
+func (m *EventSequence_Event) GetSuspendJob() *SuspendJob {
+	if x, ok := m.GetEvent().(*EventSequence_Event_SuspendJob); ok {
+		return x.SuspendJob
+	}
+	return nil
+}
+
+func (m *EventSequence_Event) GetResumeJob() *ResumeJob {
+	if x, ok := m.GetEvent().(*EventSequence_Event_ResumeJob); ok {
+		return x.ResumeJob
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*EventSequence_Event) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -551,6 +599,10 @@ func (*EventSequence_Event) XXX_OneofWrappers() []interface{} {
 		(*EventSequence_Event_JobRunCancelled)(nil),
 		(*EventSequence_Event_JobValidated)(nil),
 		(*EventSequence_Event_JobCancelledDebugInfo)(nil),
+		(*EventSequence_Event_UpdateQueuedJob)(nil),
+		(*EventSequence_Event_MoveJob)(nil),
+		(*EventSequence_Event_SuspendJob)(nil),
+		(*EventSequence_Event_ResumeJob)(nil),
 	}
 }
 
@@ -894,6 +946,8 @@ type KubernetesObject struct {
 	//	*KubernetesObject_Ingress
 	//	*KubernetesObject_Service
 	//	*KubernetesObject_ConfigMap
+	//	*KubernetesObject_Secret
+	//	*KubernetesObject_PersistentVolumeClaim
 	Object isKubernetesObject_Object `protobuf_oneof:"object"`
 }
 
@@ -948,11 +1002,19 @@ type KubernetesObject_Service struct {
 type KubernetesObject_ConfigMap struct {
 	ConfigMap *v11.ConfigMap `protobuf:"bytes,5,opt,name=configMap,proto3,oneof" json:"configMap,omitempty"`
 }
+type KubernetesObject_Secret struct {
+	Secret *SecretReference `protobuf:"bytes,6,opt,name=secret,proto3,oneof" json:"secret,omitempty"`
+}
+type KubernetesObject_PersistentVolumeClaim struct {
+	PersistentVolumeClaim *v11.PersistentVolumeClaimSpec `protobuf:"bytes,7,opt,name=persistentVolumeClaim,proto3,oneof" json:"persistentVolumeClaim,omitempty"`
+}
 
-func (*KubernetesObject_PodSpec) isKubernetesObject_Object()   {}
-func (*KubernetesObject_Ingress) isKubernetesObject_Object()   {}
-func (*KubernetesObject_Service) isKubernetesObject_Object()   {}
-func (*KubernetesObject_ConfigMap) isKubernetesObject_Object() {}
+func (*KubernetesObject_PodSpec) isKubernetesObject_Object()               {}
+func (*KubernetesObject_Ingress) isKubernetesObject_Object()               {}
+func (*KubernetesObject_Service) isKubernetesObject_Object()               {}
+func (*KubernetesObject_ConfigMap) isKubernetesObject_Object()             {}
+func (*KubernetesObject_Secret) isKubernetesObject_Object()                {}
+func (*KubernetesObject_PersistentVolumeClaim) isKubernetesObject_Object() {}
 
 func (m *KubernetesObject) GetObject() isKubernetesObject_Object {
 	if m != nil {
@@ -996,6 +1058,20 @@ func (m *KubernetesObject) GetConfigMap() *v11.ConfigMap {
 	return nil
 }
 
+func (m *KubernetesObject) GetSecret() *SecretReference {
+	if x, ok := m.GetObject().(*KubernetesObject_Secret); ok {
+		return x.Secret
+	}
+	return nil
+}
+
+func (m *KubernetesObject) GetPersistentVolumeClaim() *v11.PersistentVolumeClaimSpec {
+	if x, ok := m.GetObject().(*KubernetesObject_PersistentVolumeClaim); ok {
+		return x.PersistentVolumeClaim
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*KubernetesObject) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1003,7 +1079,56 @@ func (*KubernetesObject) XXX_OneofWrappers() []interface{} {
 		(*KubernetesObject_Ingress)(nil),
 		(*KubernetesObject_Service)(nil),
 		(*KubernetesObject_ConfigMap)(nil),
+		(*KubernetesObject_Secret)(nil),
+		(*KubernetesObject_PersistentVolumeClaim)(nil),
+	}
+}
+
+// A secret to be copied by the executor into the job's namespace.
+// Only the name of the source secret is carried, so that secret data never enters the event log.
+type SecretReference struct {
+	// Name of the secret in the executor's job secret namespace.
+	SourceName string `protobuf:"bytes,1,opt,name=source_name,json=sourceName,proto3" json:"sourceName,omitempty"`
+}
+
+func (m *SecretReference) Reset()         { *m = SecretReference{} }
+func (m *SecretReference) String() string { return proto.CompactTextString(m) }
+func (*SecretReference) ProtoMessage()    {}
+func (*SecretReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{5}
+}
+func (m *SecretReference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecretReference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SecretReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretReference.Merge(m, src)
+}
+func (m *SecretReference) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretReference proto.InternalMessageInfo
+
+func (m *SecretReference) GetSourceName() string {
+	if m != nil {
+		return m.SourceName
 	}
+	return ""
 }
 
 // Auxiliary information needed to instantiate the object in Kubernetes.
@@ -1028,7 +1153,7 @@ func (m *ObjectMeta) Reset()         { *m = ObjectMeta{} }
 func (m *ObjectMeta) String() string { return proto.CompactTextString(m) }
 func (*ObjectMeta) ProtoMessage()    {}
 func (*ObjectMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{5}
+	return fileDescriptor_6aab92ca59e015f8, []int{6}
 }
 func (m *ObjectMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1111,7 +1236,7 @@ func (m *PodSpecWithAvoidList) Reset()         { *m = PodSpecWithAvoidList{} }
 func (m *PodSpecWithAvoidList) String() string { return proto.CompactTextString(m) }
 func (*PodSpecWithAvoidList) ProtoMessage()    {}
 func (*PodSpecWithAvoidList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{6}
+	return fileDescriptor_6aab92ca59e015f8, []int{7}
 }
 func (m *PodSpecWithAvoidList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1164,7 +1289,7 @@ func (m *ReprioritiseJob) Reset()         { *m = ReprioritiseJob{} }
 func (m *ReprioritiseJob) String() string { return proto.CompactTextString(m) }
 func (*ReprioritiseJob) ProtoMessage()    {}
 func (*ReprioritiseJob) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{7}
+	return fileDescriptor_6aab92ca59e015f8, []int{8}
 }
 func (m *ReprioritiseJob) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1207,6 +1332,251 @@ func (m *ReprioritiseJob) GetJobId() string {
 	return ""
 }
 
+// Changes the scheduling requirements of a queued job.
+// The update is ignored if the job is no longer queued when it is processed.
+// Fields left empty are not changed.
+type UpdateQueuedJob struct {
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	// New resource requirements, keyed by container name.
+	ContainerResources map[string]*v11.ResourceRequirements `protobuf:"bytes,2,rep,name=container_resources,json=containerResources,proto3" json:"containerResources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// If non-empty, replaces the node selector of the job.
+	NodeSelector map[string]string `protobuf:"bytes,3,rep,name=node_selector,json=nodeSelector,proto3" json:"nodeSelector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// If non-empty, replaces the tolerations of the job.
+	Tolerations []*v11.Toleration `protobuf:"bytes,4,rep,name=tolerations,proto3" json:"tolerations,omitempty"`
+	// If set, replaces the priority class of the job.
+	PriorityClassName string `protobuf:"bytes,5,opt,name=priority_class_name,json=priorityClassName,proto3" json:"priorityClassName,omitempty"`
+}
+
+func (m *UpdateQueuedJob) Reset()         { *m = UpdateQueuedJob{} }
+func (m *UpdateQueuedJob) String() string { return proto.CompactTextString(m) }
+func (*UpdateQueuedJob) ProtoMessage()    {}
+func (*UpdateQueuedJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{9}
+}
+func (m *UpdateQueuedJob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateQueuedJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateQueuedJob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateQueuedJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateQueuedJob.Merge(m, src)
+}
+func (m *UpdateQueuedJob) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateQueuedJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateQueuedJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateQueuedJob proto.InternalMessageInfo
+
+func (m *UpdateQueuedJob) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *UpdateQueuedJob) GetContainerResources() map[string]*v11.ResourceRequirements {
+	if m != nil {
+		return m.ContainerResources
+	}
+	return nil
+}
+
+func (m *UpdateQueuedJob) GetNodeSelector() map[string]string {
+	if m != nil {
+		return m.NodeSelector
+	}
+	return nil
+}
+
+func (m *UpdateQueuedJob) GetTolerations() []*v11.Toleration {
+	if m != nil {
+		return m.Tolerations
+	}
+	return nil
+}
+
+func (m *UpdateQueuedJob) GetPriorityClassName() string {
+	if m != nil {
+		return m.PriorityClassName
+	}
+	return ""
+}
+
+// Moves a queued job to another queue and/or job set, keeping its job id.
+// The event is published in the job set the job is moved from.
+// The move is ignored if the job is no longer queued when it is processed.
+type MoveJob struct {
+	JobId               string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	DestinationQueue    string `protobuf:"bytes,2,opt,name=destination_queue,json=destinationQueue,proto3" json:"destinationQueue,omitempty"`
+	DestinationJobSetId string `protobuf:"bytes,3,opt,name=destination_job_set_id,json=destinationJobSetId,proto3" json:"destinationJobSetId,omitempty"`
+}
+
+func (m *MoveJob) Reset()         { *m = MoveJob{} }
+func (m *MoveJob) String() string { return proto.CompactTextString(m) }
+func (*MoveJob) ProtoMessage()    {}
+func (*MoveJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{10}
+}
+func (m *MoveJob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MoveJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MoveJob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MoveJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveJob.Merge(m, src)
+}
+func (m *MoveJob) XXX_Size() int {
+	return m.Size()
+}
+func (m *MoveJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveJob proto.InternalMessageInfo
+
+func (m *MoveJob) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *MoveJob) GetDestinationQueue() string {
+	if m != nil {
+		return m.DestinationQueue
+	}
+	return ""
+}
+
+func (m *MoveJob) GetDestinationJobSetId() string {
+	if m != nil {
+		return m.DestinationJobSetId
+	}
+	return ""
+}
+
+// Suspends a job. A suspended job is not scheduled; if it is running, its run is preempted
+// without consuming a retry and the job is held until it is resumed.
+type SuspendJob struct {
+	JobId  string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *SuspendJob) Reset()         { *m = SuspendJob{} }
+func (m *SuspendJob) String() string { return proto.CompactTextString(m) }
+func (*SuspendJob) ProtoMessage()    {}
+func (*SuspendJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{11}
+}
+func (m *SuspendJob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuspendJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuspendJob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuspendJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuspendJob.Merge(m, src)
+}
+func (m *SuspendJob) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuspendJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuspendJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuspendJob proto.InternalMessageInfo
+
+func (m *SuspendJob) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *SuspendJob) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// Resumes a suspended job, making it eligible for scheduling again.
+type ResumeJob struct {
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+}
+
+func (m *ResumeJob) Reset()         { *m = ResumeJob{} }
+func (m *ResumeJob) String() string { return proto.CompactTextString(m) }
+func (*ResumeJob) ProtoMessage()    {}
+func (*ResumeJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{12}
+}
+func (m *ResumeJob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResumeJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResumeJob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResumeJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeJob.Merge(m, src)
+}
+func (m *ResumeJob) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResumeJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeJob proto.InternalMessageInfo
+
+func (m *ResumeJob) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
 type JobRequeued struct {
 	SchedulingInfo *schedulerobjects.JobSchedulingInfo `protobuf:"bytes,2,opt,name=scheduling_info,json=schedulingInfo,proto3" json:"schedulingInfo,omitempty"`
 	// Used by the scheduler to maintain a consistent state
@@ -1218,7 +1588,7 @@ func (m *JobRequeued) Reset()         { *m = JobRequeued{} }
 func (m *JobRequeued) String() string { return proto.CompactTextString(m) }
 func (*JobRequeued) ProtoMessage()    {}
 func (*JobRequeued) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{8}
+	return fileDescriptor_6aab92ca59e015f8, []int{13}
 }
 func (m *JobRequeued) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1278,7 +1648,7 @@ func (m *ReprioritiseJobSet) Reset()         { *m = ReprioritiseJobSet{} }
 func (m *ReprioritiseJobSet) String() string { return proto.CompactTextString(m) }
 func (*ReprioritiseJobSet) ProtoMessage()    {}
 func (*ReprioritiseJobSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{9}
+	return fileDescriptor_6aab92ca59e015f8, []int{14}
 }
 func (m *ReprioritiseJobSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1325,7 +1695,7 @@ func (m *ReprioritisedJob) Reset()         { *m = ReprioritisedJob{} }
 func (m *ReprioritisedJob) String() string { return proto.CompactTextString(m) }
 func (*ReprioritisedJob) ProtoMessage()    {}
 func (*ReprioritisedJob) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{10}
+	return fileDescriptor_6aab92ca59e015f8, []int{15}
 }
 func (m *ReprioritisedJob) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1380,7 +1750,7 @@ func (m *CancelJob) Reset()         { *m = CancelJob{} }
 func (m *CancelJob) String() string { return proto.CompactTextString(m) }
 func (*CancelJob) ProtoMessage()    {}
 func (*CancelJob) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{11}
+	return fileDescriptor_6aab92ca59e015f8, []int{16}
 }
 func (m *CancelJob) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1433,7 +1803,7 @@ func (m *JobSetFilter) Reset()         { *m = JobSetFilter{} }
 func (m *JobSetFilter) String() string { return proto.CompactTextString(m) }
 func (*JobSetFilter) ProtoMessage()    {}
 func (*JobSetFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{12}
+	return fileDescriptor_6aab92ca59e015f8, []int{17}
 }
 func (m *JobSetFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1480,7 +1850,7 @@ func (m *CancelJobSet) Reset()         { *m = CancelJobSet{} }
 func (m *CancelJobSet) String() string { return proto.CompactTextString(m) }
 func (*CancelJobSet) ProtoMessage()    {}
 func (*CancelJobSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{13}
+	return fileDescriptor_6aab92ca59e015f8, []int{18}
 }
 func (m *CancelJobSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1535,7 +1905,7 @@ func (m *CancelledJob) Reset()         { *m = CancelledJob{} }
 func (m *CancelledJob) String() string { return proto.CompactTextString(m) }
 func (*CancelledJob) ProtoMessage()    {}
 func (*CancelledJob) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{14}
+	return fileDescriptor_6aab92ca59e015f8, []int{19}
 }
 func (m *CancelledJob) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1597,7 +1967,7 @@ func (m *JobSucceeded) Reset()         { *m = JobSucceeded{} }
 func (m *JobSucceeded) String() string { return proto.CompactTextString(m) }
 func (*JobSucceeded) ProtoMessage()    {}
 func (*JobSucceeded) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{15}
+	return fileDescriptor_6aab92ca59e015f8, []int{20}
 }
 func (m *JobSucceeded) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1673,7 +2043,7 @@ func (m *JobRunLeased) Reset()         { *m = JobRunLeased{} }
 func (m *JobRunLeased) String() string { return proto.CompactTextString(m) }
 func (*JobRunLeased) ProtoMessage()    {}
 func (*JobRunLeased) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{16}
+	return fileDescriptor_6aab92ca59e015f8, []int{21}
 }
 func (m *JobRunLeased) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1780,7 +2150,7 @@ func (m *JobRunAssigned) Reset()         { *m = JobRunAssigned{} }
 func (m *JobRunAssigned) String() string { return proto.CompactTextString(m) }
 func (*JobRunAssigned) ProtoMessage()    {}
 func (*JobRunAssigned) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{17}
+	return fileDescriptor_6aab92ca59e015f8, []int{22}
 }
 func (m *JobRunAssigned) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1851,7 +2221,7 @@ func (m *JobRunRunning) Reset()         { *m = JobRunRunning{} }
 func (m *JobRunRunning) String() string { return proto.CompactTextString(m) }
 func (*JobRunRunning) ProtoMessage()    {}
 func (*JobRunRunning) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{18}
+	return fileDescriptor_6aab92ca59e015f8, []int{23}
 }
 func (m *JobRunRunning) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1921,7 +2291,7 @@ func (m *KubernetesResourceInfo) Reset()         { *m = KubernetesResourceInfo{}
 func (m *KubernetesResourceInfo) String() string { return proto.CompactTextString(m) }
 func (*KubernetesResourceInfo) ProtoMessage()    {}
 func (*KubernetesResourceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{19}
+	return fileDescriptor_6aab92ca59e015f8, []int{24}
 }
 func (m *KubernetesResourceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2012,7 +2382,7 @@ func (m *PodInfo) Reset()         { *m = PodInfo{} }
 func (m *PodInfo) String() string { return proto.CompactTextString(m) }
 func (*PodInfo) ProtoMessage()    {}
 func (*PodInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{20}
+	return fileDescriptor_6aab92ca59e015f8, []int{25}
 }
 func (m *PodInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2067,7 +2437,7 @@ func (m *IngressInfo) Reset()         { *m = IngressInfo{} }
 func (m *IngressInfo) String() string { return proto.CompactTextString(m) }
 func (*IngressInfo) ProtoMessage()    {}
 func (*IngressInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{21}
+	return fileDescriptor_6aab92ca59e015f8, []int{26}
 }
 func (m *IngressInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2130,7 +2500,7 @@ func (m *StandaloneIngressInfo) Reset()         { *m = StandaloneIngressInfo{} }
 func (m *StandaloneIngressInfo) String() string { return proto.CompactTextString(m) }
 func (*StandaloneIngressInfo) ProtoMessage()    {}
 func (*StandaloneIngressInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{22}
+	return fileDescriptor_6aab92ca59e015f8, []int{27}
 }
 func (m *StandaloneIngressInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2229,7 +2599,7 @@ func (m *JobRunSucceeded) Reset()         { *m = JobRunSucceeded{} }
 func (m *JobRunSucceeded) String() string { return proto.CompactTextString(m) }
 func (*JobRunSucceeded) ProtoMessage()    {}
 func (*JobRunSucceeded) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{23}
+	return fileDescriptor_6aab92ca59e015f8, []int{28}
 }
 func (m *JobRunSucceeded) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2292,7 +2662,7 @@ func (m *JobErrors) Reset()         { *m = JobErrors{} }
 func (m *JobErrors) String() string { return proto.CompactTextString(m) }
 func (*JobErrors) ProtoMessage()    {}
 func (*JobErrors) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{24}
+	return fileDescriptor_6aab92ca59e015f8, []int{29}
 }
 func (m *JobErrors) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2353,7 +2723,7 @@ func (m *JobRunErrors) Reset()         { *m = JobRunErrors{} }
 func (m *JobRunErrors) String() string { return proto.CompactTextString(m) }
 func (*JobRunErrors) ProtoMessage()    {}
 func (*JobRunErrors) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{25}
+	return fileDescriptor_6aab92ca59e015f8, []int{30}
 }
 func (m *JobRunErrors) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2443,7 +2813,7 @@ func (m *Error) Reset()         { *m = Error{} }
 func (m *Error) String() string { return proto.CompactTextString(m) }
 func (*Error) ProtoMessage()    {}
 func (*Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{26}
+	return fileDescriptor_6aab92ca59e015f8, []int{31}
 }
 func (m *Error) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2663,7 +3033,7 @@ func (m *KubernetesError) Reset()         { *m = KubernetesError{} }
 func (m *KubernetesError) String() string { return proto.CompactTextString(m) }
 func (*KubernetesError) ProtoMessage()    {}
 func (*KubernetesError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{27}
+	return fileDescriptor_6aab92ca59e015f8, []int{32}
 }
 func (m *KubernetesError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2709,13 +3079,15 @@ type PodError struct {
 	ContainerErrors  []*ContainerError `protobuf:"bytes,5,rep,name=containerErrors,proto3" json:"containerErrors,omitempty"`
 	KubernetesReason KubernetesReason  `protobuf:"varint,6,opt,name=kubernetes_reason,json=kubernetesReason,proto3,enum=armadaevents.KubernetesReason" json:"kubernetesReason,omitempty"`
 	DebugMessage     string            `protobuf:"bytes,7,opt,name=debugMessage,proto3" json:"debugMessage,omitempty"`
+	// Locations of container logs captured by the executor before the pod was deleted.
+	ContainerLogs []*ContainerLog `protobuf:"bytes,8,rep,name=container_logs,json=containerLogs,proto3" json:"containerLogs,omitempty"`
 }
 
 func (m *PodError) Reset()         { *m = PodError{} }
 func (m *PodError) String() string { return proto.CompactTextString(m) }
 func (*PodError) ProtoMessage()    {}
 func (*PodError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{28}
+	return fileDescriptor_6aab92ca59e015f8, []int{33}
 }
 func (m *PodError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2793,10 +3165,71 @@ func (m *PodError) GetDebugMessage() string {
 	return ""
 }
 
-type ContainerError struct {
-	// this ObjectMeta identifies the container
-	ObjectMeta *ObjectMeta `protobuf:"bytes,1,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
-	// Exit code of the application running in the container.
+func (m *PodError) GetContainerLogs() []*ContainerLog {
+	if m != nil {
+		return m.ContainerLogs
+	}
+	return nil
+}
+
+// Location of the logs of a container, captured by the executor and uploaded to a blob store.
+type ContainerLog struct {
+	ContainerName string `protobuf:"bytes,1,opt,name=container_name,json=containerName,proto3" json:"containerName,omitempty"`
+	// URI of the stored logs, e.g., s3://bucket/key or file:///path.
+	Location string `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (m *ContainerLog) Reset()         { *m = ContainerLog{} }
+func (m *ContainerLog) String() string { return proto.CompactTextString(m) }
+func (*ContainerLog) ProtoMessage()    {}
+func (*ContainerLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{34}
+}
+func (m *ContainerLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContainerLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContainerLog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContainerLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerLog.Merge(m, src)
+}
+func (m *ContainerLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContainerLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerLog proto.InternalMessageInfo
+
+func (m *ContainerLog) GetContainerName() string {
+	if m != nil {
+		return m.ContainerName
+	}
+	return ""
+}
+
+func (m *ContainerLog) GetLocation() string {
+	if m != nil {
+		return m.Location
+	}
+	return ""
+}
+
+type ContainerError struct {
+	// this ObjectMeta identifies the container
+	ObjectMeta *ObjectMeta `protobuf:"bytes,1,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
+	// Exit code of the application running in the container.
 	ExitCode int32 `protobuf:"varint,2,opt,name=exit_code,json=exitCode,proto3" json:"exitCode,omitempty"`
 	// Corresponds to v1.ContainerStateTerminated.Message
 	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
@@ -2810,7 +3243,7 @@ func (m *ContainerError) Reset()         { *m = ContainerError{} }
 func (m *ContainerError) String() string { return proto.CompactTextString(m) }
 func (*ContainerError) ProtoMessage()    {}
 func (*ContainerError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{29}
+	return fileDescriptor_6aab92ca59e015f8, []int{35}
 }
 func (m *ContainerError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2887,7 +3320,7 @@ func (m *PodLeaseReturned) Reset()         { *m = PodLeaseReturned{} }
 func (m *PodLeaseReturned) String() string { return proto.CompactTextString(m) }
 func (*PodLeaseReturned) ProtoMessage()    {}
 func (*PodLeaseReturned) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{30}
+	return fileDescriptor_6aab92ca59e015f8, []int{36}
 }
 func (m *PodLeaseReturned) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2965,7 +3398,7 @@ func (m *PodTerminated) Reset()         { *m = PodTerminated{} }
 func (m *PodTerminated) String() string { return proto.CompactTextString(m) }
 func (*PodTerminated) ProtoMessage()    {}
 func (*PodTerminated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{31}
+	return fileDescriptor_6aab92ca59e015f8, []int{37}
 }
 func (m *PodTerminated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3029,7 +3462,7 @@ func (m *ExecutorError) Reset()         { *m = ExecutorError{} }
 func (m *ExecutorError) String() string { return proto.CompactTextString(m) }
 func (*ExecutorError) ProtoMessage()    {}
 func (*ExecutorError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{32}
+	return fileDescriptor_6aab92ca59e015f8, []int{38}
 }
 func (m *ExecutorError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3070,7 +3503,7 @@ func (m *PodUnschedulable) Reset()         { *m = PodUnschedulable{} }
 func (m *PodUnschedulable) String() string { return proto.CompactTextString(m) }
 func (*PodUnschedulable) ProtoMessage()    {}
 func (*PodUnschedulable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{33}
+	return fileDescriptor_6aab92ca59e015f8, []int{39}
 }
 func (m *PodUnschedulable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3134,7 +3567,7 @@ func (m *LeaseExpired) Reset()         { *m = LeaseExpired{} }
 func (m *LeaseExpired) String() string { return proto.CompactTextString(m) }
 func (*LeaseExpired) ProtoMessage()    {}
 func (*LeaseExpired) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{34}
+	return fileDescriptor_6aab92ca59e015f8, []int{40}
 }
 func (m *LeaseExpired) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3171,7 +3604,7 @@ func (m *MaxRunsExceeded) Reset()         { *m = MaxRunsExceeded{} }
 func (m *MaxRunsExceeded) String() string { return proto.CompactTextString(m) }
 func (*MaxRunsExceeded) ProtoMessage()    {}
 func (*MaxRunsExceeded) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{35}
+	return fileDescriptor_6aab92ca59e015f8, []int{41}
 }
 func (m *MaxRunsExceeded) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3215,7 +3648,7 @@ func (m *JobRunPreemptedError) Reset()         { *m = JobRunPreemptedError{} }
 func (m *JobRunPreemptedError) String() string { return proto.CompactTextString(m) }
 func (*JobRunPreemptedError) ProtoMessage()    {}
 func (*JobRunPreemptedError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{36}
+	return fileDescriptor_6aab92ca59e015f8, []int{42}
 }
 func (m *JobRunPreemptedError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3259,7 +3692,7 @@ func (m *GangJobUnschedulable) Reset()         { *m = GangJobUnschedulable{} }
 func (m *GangJobUnschedulable) String() string { return proto.CompactTextString(m) }
 func (*GangJobUnschedulable) ProtoMessage()    {}
 func (*GangJobUnschedulable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{37}
+	return fileDescriptor_6aab92ca59e015f8, []int{43}
 }
 func (m *GangJobUnschedulable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3303,7 +3736,7 @@ func (m *JobRejected) Reset()         { *m = JobRejected{} }
 func (m *JobRejected) String() string { return proto.CompactTextString(m) }
 func (*JobRejected) ProtoMessage()    {}
 func (*JobRejected) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{38}
+	return fileDescriptor_6aab92ca59e015f8, []int{44}
 }
 func (m *JobRejected) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3347,7 +3780,7 @@ func (m *ReconciliationError) Reset()         { *m = ReconciliationError{} }
 func (m *ReconciliationError) String() string { return proto.CompactTextString(m) }
 func (*ReconciliationError) ProtoMessage()    {}
 func (*ReconciliationError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{39}
+	return fileDescriptor_6aab92ca59e015f8, []int{45}
 }
 func (m *ReconciliationError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3389,13 +3822,15 @@ type JobRunPreempted struct {
 	PreemptedRunId  string `protobuf:"bytes,6,opt,name=preempted_run_id,json=preemptedRunId,proto3" json:"preemptedRunId,omitempty"`
 	Reason          string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
 	PreemptingJobId string `protobuf:"bytes,8,opt,name=preempting_job_id,json=preemptingJobId,proto3" json:"preemptingJobId,omitempty"`
+	// True if the run was preempted because its job was suspended. Such runs don't count as attempts to run the job.
+	Suspended bool `protobuf:"varint,9,opt,name=suspended,proto3" json:"suspended,omitempty"`
 }
 
 func (m *JobRunPreempted) Reset()         { *m = JobRunPreempted{} }
 func (m *JobRunPreempted) String() string { return proto.CompactTextString(m) }
 func (*JobRunPreempted) ProtoMessage()    {}
 func (*JobRunPreempted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{40}
+	return fileDescriptor_6aab92ca59e015f8, []int{46}
 }
 func (m *JobRunPreempted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3452,6 +3887,13 @@ func (m *JobRunPreempted) GetPreemptingJobId() string {
 	return ""
 }
 
+func (m *JobRunPreempted) GetSuspended() bool {
+	if m != nil {
+		return m.Suspended
+	}
+	return false
+}
+
 // Message used internally by Armada to see if messages can be propagated through a pulsar partition
 type PartitionMarker struct {
 	// Group id identifies the group of partition markers, one per partition
@@ -3464,7 +3906,7 @@ func (m *PartitionMarker) Reset()         { *m = PartitionMarker{} }
 func (m *PartitionMarker) String() string { return proto.CompactTextString(m) }
 func (*PartitionMarker) ProtoMessage()    {}
 func (*PartitionMarker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{41}
+	return fileDescriptor_6aab92ca59e015f8, []int{47}
 }
 func (m *PartitionMarker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3517,7 +3959,7 @@ func (m *JobRunPreemptionRequested) Reset()         { *m = JobRunPreemptionReque
 func (m *JobRunPreemptionRequested) String() string { return proto.CompactTextString(m) }
 func (*JobRunPreemptionRequested) ProtoMessage()    {}
 func (*JobRunPreemptionRequested) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{42}
+	return fileDescriptor_6aab92ca59e015f8, []int{48}
 }
 func (m *JobRunPreemptionRequested) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3570,7 +4012,7 @@ func (m *JobPreemptionRequested) Reset()         { *m = JobPreemptionRequested{}
 func (m *JobPreemptionRequested) String() string { return proto.CompactTextString(m) }
 func (*JobPreemptionRequested) ProtoMessage()    {}
 func (*JobPreemptionRequested) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{43}
+	return fileDescriptor_6aab92ca59e015f8, []int{49}
 }
 func (m *JobPreemptionRequested) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3617,13 +4059,16 @@ func (m *JobPreemptionRequested) GetReason() string {
 type JobValidated struct {
 	Pools []string `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools,omitempty"`
 	JobId string   `protobuf:"bytes,3,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	// The version of the job's scheduling info that was validated.
+	// The job isn't marked as validated if its scheduling info has changed since.
+	SchedulingInfoVersion uint32 `protobuf:"varint,4,opt,name=scheduling_info_version,json=schedulingInfoVersion,proto3" json:"schedulingInfoVersion,omitempty"`
 }
 
 func (m *JobValidated) Reset()         { *m = JobValidated{} }
 func (m *JobValidated) String() string { return proto.CompactTextString(m) }
 func (*JobValidated) ProtoMessage()    {}
 func (*JobValidated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{44}
+	return fileDescriptor_6aab92ca59e015f8, []int{50}
 }
 func (m *JobValidated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3666,6 +4111,13 @@ func (m *JobValidated) GetJobId() string {
 	return ""
 }
 
+func (m *JobValidated) GetSchedulingInfoVersion() uint32 {
+	if m != nil {
+		return m.SchedulingInfoVersion
+	}
+	return 0
+}
+
 // Generated by the scheduler when a job is cancelled, all active job runs are also cancelled
 // One such message is generated per job run that was cancelled.
 type JobRunCancelled struct {
@@ -3679,7 +4131,7 @@ func (m *JobRunCancelled) Reset()         { *m = JobRunCancelled{} }
 func (m *JobRunCancelled) String() string { return proto.CompactTextString(m) }
 func (*JobRunCancelled) ProtoMessage()    {}
 func (*JobRunCancelled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{45}
+	return fileDescriptor_6aab92ca59e015f8, []int{51}
 }
 func (m *JobRunCancelled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3750,7 +4202,7 @@ func (m *JobCancelledDebugInfo) Reset()         { *m = JobCancelledDebugInfo{} }
 func (m *JobCancelledDebugInfo) String() string { return proto.CompactTextString(m) }
 func (*JobCancelledDebugInfo) ProtoMessage()    {}
 func (*JobCancelledDebugInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{46}
+	return fileDescriptor_6aab92ca59e015f8, []int{52}
 }
 func (m *JobCancelledDebugInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3812,11 +4264,18 @@ func init() {
 	proto.RegisterType((*SubmitJob)(nil), "armadaevents.SubmitJob")
 	proto.RegisterType((*KubernetesMainObject)(nil), "armadaevents.KubernetesMainObject")
 	proto.RegisterType((*KubernetesObject)(nil), "armadaevents.KubernetesObject")
+	proto.RegisterType((*SecretReference)(nil), "armadaevents.SecretReference")
 	proto.RegisterType((*ObjectMeta)(nil), "armadaevents.ObjectMeta")
 	proto.RegisterMapType((map[string]string)(nil), "armadaevents.ObjectMeta.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "armadaevents.ObjectMeta.LabelsEntry")
 	proto.RegisterType((*PodSpecWithAvoidList)(nil), "armadaevents.PodSpecWithAvoidList")
 	proto.RegisterType((*ReprioritiseJob)(nil), "armadaevents.ReprioritiseJob")
+	proto.RegisterType((*UpdateQueuedJob)(nil), "armadaevents.UpdateQueuedJob")
+	proto.RegisterMapType((map[string]*v11.ResourceRequirements)(nil), "armadaevents.UpdateQueuedJob.ContainerResourcesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "armadaevents.UpdateQueuedJob.NodeSelectorEntry")
+	proto.RegisterType((*MoveJob)(nil), "armadaevents.MoveJob")
+	proto.RegisterType((*SuspendJob)(nil), "armadaevents.SuspendJob")
+	proto.RegisterType((*ResumeJob)(nil), "armadaevents.ResumeJob")
 	proto.RegisterType((*JobRequeued)(nil), "armadaevents.JobRequeued")
 	proto.RegisterType((*ReprioritiseJobSet)(nil), "armadaevents.ReprioritiseJobSet")
 	proto.RegisterType((*ReprioritisedJob)(nil), "armadaevents.ReprioritisedJob")
@@ -3840,6 +4299,7 @@ func init() {
 	proto.RegisterType((*Error)(nil), "armadaevents.Error")
 	proto.RegisterType((*KubernetesError)(nil), "armadaevents.KubernetesError")
 	proto.RegisterType((*PodError)(nil), "armadaevents.PodError")
+	proto.RegisterType((*ContainerLog)(nil), "armadaevents.ContainerLog")
 	proto.RegisterType((*ContainerError)(nil), "armadaevents.ContainerError")
 	proto.RegisterType((*PodLeaseReturned)(nil), "armadaevents.PodLeaseReturned")
 	proto.RegisterType((*PodTerminated)(nil), "armadaevents.PodTerminated")
@@ -3863,252 +4323,285 @@ func init() {
 func init() { proto.RegisterFile("pkg/armadaevents/events.proto", fileDescriptor_6aab92ca59e015f8) }
 
 var fileDescriptor_6aab92ca59e015f8 = []byte{
-	// 3918 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3b, 0x5d, 0x6f, 0x1b, 0x57,
-	0x76, 0x1e, 0x7e, 0xf3, 0x50, 0xa2, 0xe8, 0xab, 0x8f, 0xd0, 0xda, 0x58, 0x54, 0xe8, 0x74, 0xd7,
-	0x0e, 0xb2, 0x94, 0xd7, 0x69, 0x8a, 0x6c, 0xb6, 0xd8, 0x40, 0xb4, 0x65, 0x5b, 0x8c, 0x65, 0xcb,
-	0x94, 0x95, 0xba, 0x45, 0x00, 0xee, 0x90, 0x73, 0x45, 0x8d, 0x44, 0xce, 0x4c, 0xe6, 0x43, 0x2b,
-	0x01, 0x0b, 0x34, 0x5b, 0xa4, 0x7d, 0xce, 0x4b, 0x81, 0x62, 0x5f, 0x9a, 0x97, 0x3e, 0xb4, 0x40,
-	0xd1, 0xa7, 0x3e, 0xf4, 0x07, 0x14, 0xe8, 0x43, 0x51, 0xe4, 0xb1, 0xe8, 0x03, 0xb1, 0x48, 0xd0,
-	0x17, 0x3e, 0xf4, 0x07, 0xf4, 0x69, 0x71, 0x3f, 0x66, 0xe6, 0xde, 0xe1, 0xa5, 0x45, 0x79, 0xad,
-	0xc0, 0x8b, 0x3c, 0x49, 0x3c, 0x9f, 0x77, 0xce, 0x39, 0xf7, 0xcc, 0x39, 0xe7, 0xde, 0x81, 0xeb,
-	0xce, 0x71, 0x7f, 0x43, 0x77, 0x87, 0xba, 0xa1, 0xe3, 0x13, 0x6c, 0xf9, 0xde, 0x06, 0xfb, 0xd3,
-	0x70, 0x5c, 0xdb, 0xb7, 0xd1, 0x9c, 0x88, 0x5a, 0xad, 0x1f, 0x7f, 0xe0, 0x35, 0x4c, 0x7b, 0x43,
-	0x77, 0xcc, 0x8d, 0x9e, 0xed, 0xe2, 0x8d, 0x93, 0x9f, 0x6c, 0xf4, 0xb1, 0x85, 0x5d, 0xdd, 0xc7,
-	0x06, 0xe3, 0x58, 0xbd, 0x29, 0xd0, 0x58, 0xd8, 0xff, 0xa5, 0xed, 0x1e, 0x9b, 0x56, 0x5f, 0x45,
-	0x59, 0xeb, 0xdb, 0x76, 0x7f, 0x80, 0x37, 0xe8, 0xaf, 0x6e, 0x70, 0xb0, 0xe1, 0x9b, 0x43, 0xec,
-	0xf9, 0xfa, 0xd0, 0xe1, 0x04, 0x7f, 0x1c, 0x8b, 0x1a, 0xea, 0xbd, 0x43, 0xd3, 0xc2, 0xee, 0xd9,
-	0x06, 0x5d, 0xaf, 0x63, 0x6e, 0xb8, 0xd8, 0xb3, 0x03, 0xb7, 0x87, 0x27, 0xc4, 0x7e, 0x68, 0x5a,
-	0x3e, 0x76, 0x2d, 0x7d, 0xb0, 0xe1, 0xf5, 0x0e, 0xb1, 0x11, 0x0c, 0xb0, 0x1b, 0xff, 0x67, 0x77,
-	0x8f, 0x70, 0xcf, 0xf7, 0x26, 0x00, 0x8c, 0xb7, 0xfe, 0xdb, 0x15, 0x98, 0xdf, 0x22, 0xcf, 0xba,
-	0x87, 0x3f, 0x0b, 0xb0, 0xd5, 0xc3, 0xe8, 0x16, 0x64, 0x3f, 0x0b, 0x70, 0x80, 0xab, 0xda, 0xba,
-	0x76, 0xb3, 0xd8, 0x5c, 0x1c, 0x8f, 0x6a, 0x0b, 0x14, 0xf0, 0xae, 0x3d, 0x34, 0x7d, 0x3c, 0x74,
-	0xfc, 0xb3, 0x36, 0xa3, 0x40, 0x1f, 0xc2, 0xdc, 0x91, 0xdd, 0xed, 0x78, 0xd8, 0xef, 0x58, 0xfa,
-	0x10, 0x57, 0x53, 0x94, 0xa3, 0x3a, 0x1e, 0xd5, 0x96, 0x8e, 0xec, 0xee, 0x1e, 0xf6, 0x1f, 0xeb,
-	0x43, 0x91, 0x0d, 0x62, 0x28, 0xfa, 0x31, 0xe4, 0x03, 0x0f, 0xbb, 0x1d, 0xd3, 0xa8, 0xa6, 0x29,
-	0xdb, 0xd2, 0x78, 0x54, 0xab, 0x10, 0xd0, 0xb6, 0x21, 0xb0, 0xe4, 0x18, 0x04, 0xbd, 0x0b, 0xb9,
-	0xbe, 0x6b, 0x07, 0x8e, 0x57, 0xcd, 0xac, 0xa7, 0x43, 0x6a, 0x06, 0x11, 0xa9, 0x19, 0x04, 0x3d,
-	0x81, 0x1c, 0x73, 0x60, 0x35, 0xbb, 0x9e, 0xbe, 0x59, 0xba, 0xf3, 0x56, 0x43, 0xf4, 0x6a, 0x43,
-	0x7a, 0x60, 0xf6, 0x8b, 0x09, 0x64, 0x78, 0x51, 0x20, 0x8f, 0x83, 0x7f, 0x5b, 0x82, 0x2c, 0xa5,
-	0x43, 0x1f, 0x43, 0xbe, 0xe7, 0x62, 0x62, 0xfd, 0x2a, 0x5a, 0xd7, 0x6e, 0x96, 0xee, 0xac, 0x36,
-	0x98, 0x57, 0x1b, 0xa1, 0x57, 0x1b, 0xcf, 0x42, 0xaf, 0x36, 0x97, 0xc7, 0xa3, 0xda, 0x55, 0x4e,
-	0x2e, 0x48, 0x0d, 0x25, 0xa0, 0x5d, 0x28, 0x7a, 0x41, 0x77, 0x68, 0xfa, 0x2d, 0xbb, 0x4b, 0xed,
-	0x5d, 0xba, 0xf3, 0x86, 0xbc, 0xd4, 0xbd, 0x10, 0xdd, 0x7c, 0x63, 0x3c, 0xaa, 0x2d, 0x46, 0xd4,
-	0xb1, 0xb4, 0x87, 0x57, 0xda, 0xb1, 0x10, 0x74, 0x08, 0x0b, 0x2e, 0x76, 0x5c, 0xd3, 0x76, 0x4d,
-	0xdf, 0xf4, 0x30, 0x91, 0x9b, 0xa2, 0x72, 0xaf, 0xcb, 0x72, 0xdb, 0x32, 0x51, 0xf3, 0xfa, 0x78,
-	0x54, 0xbb, 0x96, 0xe0, 0x94, 0x74, 0x24, 0xc5, 0x22, 0x1f, 0x50, 0x02, 0xb4, 0x87, 0x7d, 0xea,
-	0xcb, 0xd2, 0x9d, 0xf5, 0x17, 0x2a, 0xdb, 0xc3, 0x7e, 0x73, 0x7d, 0x3c, 0xaa, 0xbd, 0x39, 0xc9,
-	0x2f, 0xa9, 0x54, 0xc8, 0x47, 0x03, 0xa8, 0x88, 0x50, 0x83, 0x3c, 0x60, 0x86, 0xea, 0x5c, 0x9b,
-	0xae, 0x93, 0x50, 0x35, 0xd7, 0xc6, 0xa3, 0xda, 0x6a, 0x92, 0x57, 0xd2, 0x37, 0x21, 0x99, 0xf8,
-	0xa7, 0xa7, 0x5b, 0x3d, 0x3c, 0x20, 0x6a, 0xb2, 0x2a, 0xff, 0xdc, 0x0d, 0xd1, 0xcc, 0x3f, 0x11,
-	0xb5, 0xec, 0x9f, 0x08, 0x8c, 0x3e, 0x85, 0xb9, 0xe8, 0x07, 0xb1, 0x57, 0x8e, 0xc7, 0x90, 0x5a,
-	0x28, 0xb1, 0xd4, 0xea, 0x78, 0x54, 0x5b, 0x11, 0x79, 0x24, 0xd1, 0x92, 0xb4, 0x58, 0xfa, 0x80,
-	0x59, 0x26, 0x3f, 0x5d, 0x3a, 0xa3, 0x10, 0xa5, 0x0f, 0x26, 0x2d, 0x22, 0x49, 0x23, 0xd2, 0xc9,
-	0x06, 0x0e, 0x7a, 0x3d, 0x8c, 0x0d, 0x6c, 0x54, 0x0b, 0x2a, 0xe9, 0x2d, 0x81, 0x82, 0x49, 0x17,
-	0x79, 0x64, 0xe9, 0x22, 0x86, 0xd8, 0xfa, 0xc8, 0xee, 0x6e, 0xb9, 0xae, 0xed, 0x7a, 0xd5, 0xa2,
-	0xca, 0xd6, 0xad, 0x10, 0xcd, 0x6c, 0x1d, 0x51, 0xcb, 0xb6, 0x8e, 0xc0, 0x7c, 0xbd, 0xed, 0xc0,
-	0x7a, 0x84, 0x75, 0x0f, 0x1b, 0x55, 0x98, 0xb2, 0xde, 0x88, 0x22, 0x5a, 0x6f, 0x04, 0x99, 0x58,
-	0x6f, 0x84, 0x41, 0x06, 0x94, 0xd9, 0xef, 0x4d, 0xcf, 0x33, 0xfb, 0x16, 0x36, 0xaa, 0x25, 0x2a,
-	0xff, 0x4d, 0x95, 0xfc, 0x90, 0xa6, 0xf9, 0xe6, 0x78, 0x54, 0xab, 0xca, 0x7c, 0x92, 0x8e, 0x84,
-	0x4c, 0xf4, 0x0b, 0x98, 0x67, 0x90, 0x76, 0x60, 0x59, 0xa6, 0xd5, 0xaf, 0xce, 0x51, 0x25, 0x3f,
-	0x50, 0x29, 0xe1, 0x24, 0xcd, 0x1f, 0x8c, 0x47, 0xb5, 0x37, 0x24, 0x2e, 0x49, 0x85, 0x2c, 0x90,
-	0x64, 0x0c, 0x06, 0x88, 0x1d, 0x3b, 0xaf, 0xca, 0x18, 0x2d, 0x99, 0x88, 0x65, 0x8c, 0x04, 0xa7,
-	0x9c, 0x31, 0x12, 0xc8, 0xd8, 0x1f, 0xdc, 0xc9, 0xe5, 0xe9, 0xfe, 0xe0, 0x7e, 0x16, 0xfc, 0xa1,
-	0x70, 0xb5, 0x24, 0x0d, 0x7d, 0xae, 0xc1, 0xb2, 0xe7, 0xeb, 0x96, 0xa1, 0x0f, 0x6c, 0x0b, 0x6f,
-	0x5b, 0x7d, 0x17, 0x7b, 0xde, 0xb6, 0x75, 0x60, 0x57, 0x2b, 0x54, 0xcf, 0x8d, 0x44, 0x62, 0x55,
-	0x91, 0x36, 0x6f, 0x8c, 0x47, 0xb5, 0x9a, 0x52, 0x8a, 0xa4, 0x59, 0xad, 0x08, 0x9d, 0xc2, 0x62,
-	0xf8, 0x92, 0xde, 0xf7, 0xcd, 0x81, 0xe9, 0xe9, 0xbe, 0x69, 0x5b, 0xd5, 0xab, 0x54, 0xff, 0x5b,
-	0xc9, 0xfc, 0x34, 0x41, 0xd8, 0x7c, 0x6b, 0x3c, 0xaa, 0x5d, 0x57, 0x48, 0x90, 0x74, 0xab, 0x54,
-	0xc4, 0x4e, 0xdc, 0x75, 0x31, 0x21, 0xc4, 0x46, 0x75, 0x71, 0xba, 0x13, 0x23, 0x22, 0xd1, 0x89,
-	0x11, 0x50, 0xe5, 0xc4, 0x08, 0x49, 0x34, 0x39, 0xba, 0xeb, 0x9b, 0x44, 0xed, 0x8e, 0xee, 0x1e,
-	0x63, 0xb7, 0xba, 0xa4, 0xd2, 0xb4, 0x2b, 0x13, 0x31, 0x4d, 0x09, 0x4e, 0x59, 0x53, 0x02, 0x89,
-	0xbe, 0xd4, 0x40, 0x5e, 0x9a, 0x69, 0x5b, 0x6d, 0xf2, 0xd2, 0xf6, 0xc8, 0xe3, 0x2d, 0x53, 0xa5,
-	0x3f, 0x7a, 0xc1, 0xe3, 0x89, 0xe4, 0xcd, 0x1f, 0x8d, 0x47, 0xb5, 0x1b, 0x53, 0xa5, 0x49, 0x0b,
-	0x99, 0xae, 0x14, 0x3d, 0x87, 0x12, 0x41, 0x62, 0x5a, 0xfe, 0x18, 0xd5, 0x15, 0xba, 0x86, 0x6b,
-	0x93, 0x6b, 0xe0, 0x04, 0xcd, 0x6b, 0xe3, 0x51, 0x6d, 0x59, 0xe0, 0x90, 0xf4, 0x88, 0xa2, 0xd0,
-	0x17, 0x1a, 0x90, 0x40, 0x57, 0x3d, 0xe9, 0x1b, 0x54, 0xcb, 0xdb, 0x13, 0x5a, 0x54, 0x8f, 0xf9,
-	0xf6, 0x78, 0x54, 0x5b, 0x57, 0xcb, 0x91, 0x74, 0x4f, 0xd1, 0x15, 0xc7, 0x51, 0xf4, 0x92, 0xa8,
-	0x56, 0xa7, 0xc7, 0x51, 0x44, 0x24, 0xc6, 0x51, 0x04, 0x54, 0xc5, 0x51, 0x84, 0xe4, 0xc9, 0xe0,
-	0x13, 0x7d, 0x60, 0x1a, 0xb4, 0x98, 0xba, 0x36, 0x25, 0x19, 0x44, 0x14, 0x51, 0x32, 0x88, 0x20,
-	0x13, 0xc9, 0x20, 0xc2, 0xd0, 0x64, 0x70, 0x64, 0x77, 0x23, 0x75, 0xf7, 0x70, 0x37, 0xe8, 0xd3,
-	0x64, 0xb0, 0xaa, 0x4a, 0x06, 0x2d, 0x15, 0x29, 0x4b, 0x06, 0x4a, 0x29, 0x72, 0x32, 0x50, 0x92,
-	0x34, 0xf3, 0x90, 0xa5, 0xd2, 0xeb, 0xbf, 0x29, 0xc2, 0xa2, 0x62, 0xb7, 0x23, 0x0c, 0xf3, 0xe1,
-	0x56, 0xee, 0x98, 0x64, 0x69, 0x69, 0x95, 0xa3, 0x3f, 0x0e, 0xba, 0xd8, 0xb5, 0xb0, 0x8f, 0xbd,
-	0x50, 0x06, 0x95, 0x4e, 0x8d, 0xe1, 0x0a, 0x10, 0xa1, 0xbc, 0x9c, 0x13, 0xe1, 0xe8, 0x37, 0x1a,
-	0x54, 0x87, 0xfa, 0x69, 0x27, 0x04, 0x7a, 0x9d, 0x03, 0xdb, 0xed, 0x38, 0xd8, 0x35, 0x6d, 0x83,
-	0x16, 0xd3, 0xa5, 0x3b, 0x7f, 0x7a, 0x6e, 0x6a, 0x6a, 0xec, 0xe8, 0xa7, 0x21, 0xd8, 0xbb, 0x6f,
-	0xbb, 0xbb, 0x94, 0x7d, 0xcb, 0xf2, 0xdd, 0x33, 0x66, 0xa6, 0xa1, 0x0a, 0x2f, 0xac, 0x69, 0x59,
-	0x49, 0x80, 0xfe, 0x56, 0x83, 0x15, 0xdf, 0xf6, 0xf5, 0x41, 0xa7, 0x17, 0x0c, 0x83, 0x81, 0xee,
-	0x9b, 0x27, 0xb8, 0x13, 0x78, 0x7a, 0x1f, 0xf3, 0xca, 0xfd, 0x67, 0xe7, 0x2f, 0xed, 0x19, 0xe1,
-	0xbf, 0x1b, 0xb1, 0xef, 0x13, 0x6e, 0xb6, 0xb2, 0xfa, 0x78, 0x54, 0x5b, 0xf3, 0x15, 0x68, 0x61,
-	0x61, 0x4b, 0x2a, 0x3c, 0x7a, 0x07, 0x72, 0xa4, 0xb3, 0x31, 0x0d, 0x5a, 0xa0, 0xf1, 0x2e, 0xe8,
-	0xc8, 0xee, 0x4a, 0xbd, 0x49, 0x96, 0x02, 0x08, 0xad, 0x1b, 0x58, 0x84, 0x36, 0x1f, 0xd3, 0xba,
-	0x81, 0x25, 0xd3, 0x52, 0x00, 0x75, 0x86, 0x7e, 0xd2, 0x57, 0x3b, 0xa3, 0x30, 0xab, 0x33, 0x36,
-	0x4f, 0xfa, 0x2f, 0x74, 0x86, 0xae, 0xc2, 0x8b, 0xce, 0x50, 0x12, 0xac, 0x7e, 0xa5, 0xc1, 0xea,
-	0x74, 0x3f, 0xa3, 0x1b, 0x90, 0x3e, 0xc6, 0x67, 0xbc, 0x2d, 0xbc, 0x3a, 0x1e, 0xd5, 0xe6, 0x8f,
-	0xf1, 0x99, 0x20, 0x95, 0x60, 0xd1, 0x9f, 0x43, 0xf6, 0x44, 0x1f, 0x04, 0x98, 0x77, 0x1d, 0x8d,
-	0x06, 0xeb, 0x68, 0x1b, 0x62, 0x47, 0xdb, 0x70, 0x8e, 0xfb, 0x04, 0xd0, 0x08, 0xad, 0xd0, 0x78,
-	0x1a, 0xe8, 0x96, 0x6f, 0xfa, 0x67, 0xcc, 0x76, 0x54, 0x80, 0x68, 0x3b, 0x0a, 0xf8, 0x30, 0xf5,
-	0x81, 0xb6, 0xfa, 0xf7, 0x1a, 0x5c, 0x9b, 0xea, 0xef, 0xd7, 0x62, 0x85, 0xc4, 0x88, 0xd3, 0xfd,
-	0xf3, 0x3a, 0x2c, 0xb1, 0x95, 0x29, 0x68, 0x95, 0x54, 0x2b, 0x53, 0x48, 0x55, 0xd2, 0xf5, 0xff,
-	0xcf, 0x41, 0x31, 0xea, 0x31, 0xd1, 0x43, 0xa8, 0x18, 0xd8, 0x08, 0x9c, 0x81, 0xd9, 0xa3, 0x91,
-	0x46, 0x82, 0x9a, 0x35, 0xf5, 0x34, 0xc1, 0x4b, 0x38, 0x29, 0xbc, 0x17, 0x12, 0x28, 0x74, 0x07,
-	0x0a, 0xbc, 0x97, 0x3a, 0xa3, 0x79, 0x6d, 0xbe, 0xb9, 0x32, 0x1e, 0xd5, 0x50, 0x08, 0x13, 0x58,
-	0x23, 0x3a, 0xd4, 0x06, 0x60, 0xc3, 0x89, 0x1d, 0xec, 0xeb, 0xbc, 0xab, 0xab, 0xca, 0xbb, 0xe1,
-	0x49, 0x84, 0x67, 0x63, 0x86, 0x98, 0x5e, 0x1c, 0x33, 0xc4, 0x50, 0xf4, 0x29, 0xc0, 0x50, 0x37,
-	0x2d, 0xc6, 0xc7, 0x5b, 0xb8, 0xfa, 0xb4, 0x0c, 0xbb, 0x13, 0x51, 0x32, 0xe9, 0x31, 0xa7, 0x28,
-	0x3d, 0x86, 0xa2, 0x27, 0x90, 0xe7, 0xe3, 0x94, 0x6a, 0x8e, 0x6e, 0xde, 0xb5, 0x69, 0xa2, 0xb9,
-	0x58, 0x3a, 0x10, 0xe0, 0x2c, 0xe2, 0x40, 0x80, 0x83, 0x88, 0xd9, 0x06, 0xe6, 0x01, 0xf6, 0xcd,
-	0x21, 0xa6, 0xd9, 0x84, 0x9b, 0x2d, 0x84, 0x89, 0x66, 0x0b, 0x61, 0xe8, 0x03, 0x00, 0xdd, 0xdf,
-	0xb1, 0x3d, 0xff, 0x89, 0xd5, 0xc3, 0xb4, 0x29, 0x2b, 0xb0, 0xe5, 0xc7, 0x50, 0x71, 0xf9, 0x31,
-	0x14, 0xfd, 0x0c, 0x4a, 0x0e, 0x2f, 0x02, 0xba, 0x03, 0x4c, 0x9b, 0xae, 0x02, 0xab, 0x59, 0x04,
-	0xb0, 0xc0, 0x2b, 0x52, 0xa3, 0x07, 0xb0, 0xd0, 0xb3, 0xad, 0x5e, 0xe0, 0xba, 0xd8, 0xea, 0x9d,
-	0xed, 0xe9, 0x07, 0x98, 0x36, 0x58, 0x05, 0x16, 0x2a, 0x09, 0x94, 0x18, 0x2a, 0x09, 0x14, 0x7a,
-	0x1f, 0x8a, 0xd1, 0x70, 0x8a, 0xf6, 0x50, 0x45, 0x3e, 0xeb, 0x08, 0x81, 0x02, 0x73, 0x4c, 0x49,
-	0x16, 0x6f, 0x7a, 0xf7, 0x78, 0xd0, 0x61, 0xda, 0x17, 0xf1, 0xc5, 0x0b, 0x60, 0x71, 0xf1, 0x02,
-	0x58, 0xc8, 0xef, 0xe5, 0x73, 0xf3, 0xfb, 0x7d, 0xa8, 0xe0, 0x53, 0x36, 0x60, 0xeb, 0x10, 0xa6,
-	0xc0, 0x35, 0x69, 0x4b, 0x51, 0x64, 0xcd, 0x5c, 0x88, 0x6b, 0xd9, 0xdd, 0x7d, 0xd7, 0x14, 0xd8,
-	0xcb, 0x32, 0x26, 0xda, 0x76, 0xf3, 0x95, 0x72, 0x2b, 0x53, 0x58, 0xa8, 0x54, 0xea, 0xff, 0xa9,
-	0xc1, 0x92, 0x2a, 0xfa, 0x12, 0x3b, 0x41, 0x7b, 0x25, 0x3b, 0xe1, 0x13, 0x28, 0x38, 0xb6, 0xd1,
-	0xf1, 0x1c, 0xdc, 0xe3, 0x79, 0x25, 0xb1, 0x0f, 0x76, 0x6d, 0x63, 0xcf, 0xc1, 0xbd, 0x3f, 0x33,
-	0xfd, 0xc3, 0xcd, 0x13, 0xdb, 0x34, 0x1e, 0x99, 0x1e, 0x0f, 0x58, 0x87, 0x61, 0xa4, 0xaa, 0x27,
-	0xcf, 0x81, 0xcd, 0x02, 0xe4, 0x98, 0x96, 0xfa, 0x7f, 0xa5, 0xa1, 0x92, 0x8c, 0xf8, 0x3f, 0xa4,
-	0x47, 0x41, 0xcf, 0x21, 0x6f, 0xb2, 0x76, 0x8e, 0xd7, 0x62, 0x7f, 0x24, 0x64, 0xde, 0x46, 0x3c,
-	0xdb, 0x6d, 0x9c, 0xfc, 0xa4, 0xc1, 0xfb, 0x3e, 0x6a, 0x02, 0x2a, 0x99, 0x73, 0xca, 0x92, 0x39,
-	0x10, 0xb5, 0x21, 0xef, 0x61, 0xf7, 0xc4, 0xec, 0x61, 0x9e, 0xd7, 0x6a, 0xa2, 0xe4, 0x9e, 0xed,
-	0x62, 0x22, 0x73, 0x8f, 0x91, 0xc4, 0x32, 0x39, 0x8f, 0x2c, 0x93, 0x03, 0xd1, 0x27, 0x50, 0xec,
-	0xd9, 0xd6, 0x81, 0xd9, 0xdf, 0xd1, 0x1d, 0x9e, 0xd9, 0xae, 0xab, 0xa4, 0xde, 0x0d, 0x89, 0xf8,
-	0x88, 0x2a, 0xfc, 0x99, 0x18, 0x51, 0x45, 0x54, 0xb1, 0x43, 0xff, 0x2f, 0x03, 0x10, 0x3b, 0x07,
-	0xfd, 0x14, 0x4a, 0xf8, 0x14, 0xf7, 0x02, 0xdf, 0xa6, 0x63, 0x5b, 0x2d, 0x9e, 0xf6, 0x86, 0x60,
-	0x69, 0xfb, 0x40, 0x0c, 0x25, 0x7b, 0xdc, 0xd2, 0x87, 0xd8, 0x73, 0xf4, 0x5e, 0x38, 0x26, 0xa6,
-	0x8b, 0x89, 0x80, 0xe2, 0x1e, 0x8f, 0x80, 0xe8, 0x87, 0x90, 0xa1, 0x83, 0x65, 0x36, 0x21, 0x46,
-	0xe3, 0x51, 0xad, 0x6c, 0xc9, 0x23, 0x65, 0x8a, 0x47, 0x1f, 0xc1, 0xfc, 0x71, 0x14, 0x78, 0x64,
-	0x6d, 0x19, 0xca, 0x40, 0x8b, 0xe4, 0x18, 0x21, 0xad, 0x6e, 0x4e, 0x84, 0xa3, 0x03, 0x28, 0xe9,
-	0x96, 0x65, 0xfb, 0xf4, 0xf5, 0x15, 0x4e, 0x8d, 0x6f, 0x4d, 0x0b, 0xd3, 0xc6, 0x66, 0x4c, 0xcb,
-	0xca, 0x2e, 0x9a, 0x77, 0x04, 0x09, 0x62, 0xde, 0x11, 0xc0, 0xa8, 0x0d, 0xb9, 0x81, 0xde, 0xc5,
-	0x83, 0xf0, 0x7d, 0xf1, 0xf6, 0x54, 0x15, 0x8f, 0x28, 0x19, 0x93, 0x4e, 0x67, 0xd3, 0x8c, 0x4f,
-	0x9c, 0x4d, 0x33, 0xc8, 0xea, 0x01, 0x54, 0x92, 0xeb, 0x99, 0xad, 0xcc, 0xb8, 0x25, 0x96, 0x19,
-	0xc5, 0x73, 0x2b, 0x1b, 0x1d, 0x4a, 0xc2, 0xa2, 0x2e, 0x43, 0x45, 0xfd, 0x1f, 0x35, 0x58, 0x52,
-	0xed, 0x5d, 0xb4, 0x23, 0xec, 0x78, 0x8d, 0x4f, 0xc0, 0x14, 0xa1, 0xce, 0x79, 0xa7, 0x6c, 0xf5,
-	0x78, 0xa3, 0x37, 0xa1, 0x6c, 0xd9, 0x06, 0xee, 0xe8, 0x44, 0xc1, 0xc0, 0xf4, 0xfc, 0x6a, 0x8a,
-	0x9e, 0x2a, 0xd0, 0xc9, 0x19, 0xc1, 0x6c, 0x86, 0x08, 0x81, 0x7b, 0x5e, 0x42, 0xd4, 0x7f, 0x09,
-	0x0b, 0x89, 0xb9, 0xb6, 0x54, 0xf4, 0xa4, 0x66, 0x2c, 0x7a, 0xe2, 0x37, 0x51, 0xfa, 0xbc, 0x37,
-	0x11, 0x7b, 0x83, 0xd4, 0xff, 0x3a, 0x05, 0x25, 0x61, 0xc8, 0x80, 0x8e, 0x60, 0x81, 0xbf, 0x15,
-	0x4d, 0xab, 0xcf, 0x3a, 0xc9, 0x14, 0x6f, 0x72, 0x27, 0x0e, 0x7d, 0x5a, 0x76, 0x77, 0x2f, 0xa2,
-	0xa5, 0x8d, 0x24, 0x7d, 0x87, 0x79, 0x12, 0x4c, 0x7c, 0x87, 0xc9, 0x18, 0xf4, 0x1c, 0x56, 0x02,
-	0x87, 0xb4, 0xd8, 0x1d, 0x8f, 0x1f, 0x9f, 0x74, 0xac, 0x60, 0xd8, 0xc5, 0x2e, 0x5d, 0x7d, 0x96,
-	0x75, 0x5c, 0x8c, 0x22, 0x3c, 0x5f, 0x79, 0x4c, 0xf1, 0x62, 0xc7, 0xa5, 0xc2, 0x0b, 0x76, 0xc8,
-	0xcc, 0x68, 0x87, 0x87, 0x80, 0x26, 0x0f, 0x16, 0x24, 0x1f, 0x68, 0xb3, 0xf9, 0xa0, 0x7e, 0x0a,
-	0x95, 0xe4, 0x71, 0xc1, 0x77, 0xe4, 0xcb, 0x63, 0x28, 0x46, 0xc3, 0x7e, 0xf4, 0x2e, 0xe4, 0x5c,
-	0xac, 0x7b, 0xb6, 0xc5, 0x77, 0x0b, 0xdd, 0xf6, 0x0c, 0x22, 0x6e, 0x7b, 0x06, 0x79, 0x09, 0x65,
-	0xcf, 0x60, 0x8e, 0x19, 0xe9, 0xbe, 0x39, 0xf0, 0xb1, 0x8b, 0xee, 0x41, 0xce, 0xf3, 0x75, 0x1f,
-	0x7b, 0x55, 0x6d, 0x3d, 0x7d, 0xb3, 0x7c, 0x67, 0x65, 0x72, 0x92, 0x4f, 0xd0, 0x6c, 0x1d, 0x8c,
-	0x52, 0x5c, 0x07, 0x83, 0xd4, 0xff, 0x4a, 0x83, 0x39, 0xf1, 0xc0, 0xe2, 0xd5, 0x88, 0xbd, 0x98,
-	0x31, 0x48, 0xe2, 0x98, 0x13, 0xcf, 0x35, 0x2e, 0xcf, 0x96, 0xe4, 0x2d, 0xc8, 0x4e, 0x45, 0x3a,
-	0x81, 0x87, 0x5d, 0x1e, 0xad, 0xf4, 0x2d, 0xc8, 0xc0, 0xfb, 0x9e, 0x14, 0xed, 0x10, 0x43, 0xb9,
-	0x1b, 0xc8, 0x5a, 0xc5, 0x53, 0x12, 0xd4, 0x8f, 0x07, 0x41, 0x64, 0x93, 0x79, 0x34, 0x19, 0xcd,
-	0x3a, 0x08, 0xa2, 0x29, 0x4b, 0x62, 0x17, 0x53, 0x96, 0x84, 0x78, 0x89, 0x90, 0xf9, 0x2a, 0x4b,
-	0xd7, 0x1a, 0x9f, 0x7a, 0x24, 0x6a, 0x80, 0xf4, 0x05, 0x6a, 0x80, 0x1f, 0x43, 0x9e, 0x26, 0xdd,
-	0x68, 0x8b, 0x53, 0x9f, 0x10, 0x90, 0x7c, 0xe2, 0xcb, 0x20, 0x2f, 0x48, 0x35, 0xd9, 0xdf, 0x33,
-	0xd5, 0x74, 0xe0, 0xda, 0xa1, 0xee, 0x75, 0xc2, 0xe4, 0x68, 0x74, 0x74, 0xbf, 0x13, 0xed, 0xf5,
-	0x1c, 0xed, 0x23, 0xe8, 0x1c, 0xf5, 0x50, 0xf7, 0xf6, 0x42, 0x9a, 0x4d, 0x7f, 0x77, 0x72, 0xe7,
-	0xaf, 0xa8, 0x29, 0xd0, 0x3e, 0x2c, 0xab, 0x85, 0xe7, 0xe9, 0xca, 0xe9, 0x98, 0xdf, 0x7b, 0xa1,
-	0xe4, 0x45, 0x05, 0x1a, 0xfd, 0x5a, 0x83, 0x2a, 0x79, 0x0b, 0xba, 0xf8, 0xb3, 0xc0, 0x74, 0xf1,
-	0x90, 0x84, 0x45, 0xc7, 0x3e, 0xc1, 0xee, 0x40, 0x3f, 0xe3, 0x27, 0x66, 0x6f, 0x4d, 0xa6, 0xfc,
-	0x5d, 0xdb, 0x68, 0x0b, 0x0c, 0xec, 0xd1, 0x1c, 0x19, 0xf8, 0x84, 0x09, 0x11, 0x1f, 0x4d, 0x4d,
-	0x21, 0x84, 0x10, 0x5c, 0x60, 0x30, 0x56, 0x3a, 0x77, 0x30, 0xf6, 0x43, 0xc8, 0x38, 0xb6, 0x3d,
-	0xa0, 0x6d, 0x1c, 0xaf, 0xf4, 0xc8, 0x6f, 0xb1, 0xd2, 0x23, 0xbf, 0xc5, 0xd9, 0x45, 0x2b, 0x53,
-	0x28, 0x54, 0x8a, 0xe4, 0x75, 0x58, 0x96, 0x0f, 0xd9, 0x26, 0x37, 0x54, 0xfa, 0xd2, 0x37, 0x54,
-	0xe6, 0x02, 0xd6, 0xc8, 0xce, 0x6c, 0x8d, 0xdc, 0xec, 0xd6, 0xa8, 0x7f, 0x91, 0x82, 0x79, 0xe9,
-	0x1c, 0xf0, 0xfb, 0x69, 0x86, 0xbf, 0x4b, 0xc1, 0x8a, 0xfa, 0x91, 0x2e, 0xa5, 0x15, 0x7d, 0x08,
-	0xa4, 0xa8, 0xdc, 0x8e, 0x8b, 0xae, 0xe5, 0x89, 0x4e, 0x94, 0x9a, 0x33, 0xac, 0x48, 0x27, 0x4e,
-	0x0f, 0x42, 0x76, 0xf4, 0x1c, 0x4a, 0xa6, 0x70, 0x68, 0x99, 0x56, 0x9d, 0x2d, 0x89, 0x47, 0x95,
-	0x6c, 0xd4, 0x31, 0xe5, 0x80, 0x52, 0x14, 0xd5, 0xcc, 0x41, 0x86, 0x54, 0x85, 0xf5, 0x13, 0xc8,
-	0xf3, 0xe5, 0xa0, 0xf7, 0xa0, 0x48, 0x73, 0x31, 0xed, 0xae, 0x58, 0x09, 0x4f, 0xcb, 0x1b, 0x02,
-	0x4c, 0x5c, 0xda, 0x29, 0x84, 0x30, 0xf4, 0x27, 0x00, 0x24, 0xfd, 0xf0, 0x2c, 0x9c, 0xa2, 0xb9,
-	0x8c, 0x76, 0x71, 0x8e, 0x6d, 0x4c, 0xa4, 0xde, 0x62, 0x04, 0xac, 0xff, 0x73, 0x0a, 0x4a, 0xe2,
-	0x31, 0xe9, 0x4b, 0x29, 0xff, 0x15, 0x84, 0x1d, 0x76, 0x47, 0x37, 0x0c, 0xf2, 0x17, 0x87, 0x2f,
-	0xca, 0x8d, 0xa9, 0x46, 0x0a, 0xff, 0xdf, 0x0c, 0x39, 0x58, 0x3f, 0x45, 0xaf, 0x82, 0x98, 0x09,
-	0x94, 0xa0, 0xb5, 0x92, 0xc4, 0xad, 0x1e, 0xc3, 0xb2, 0x52, 0x94, 0xd8, 0x05, 0x65, 0x5f, 0x55,
-	0x17, 0xf4, 0x0f, 0x59, 0x58, 0x56, 0x1e, 0x4f, 0x27, 0x22, 0x38, 0xfd, 0x4a, 0x22, 0xf8, 0x6f,
-	0x34, 0x95, 0x65, 0xd9, 0xc1, 0xd0, 0x4f, 0x67, 0x38, 0x33, 0x7f, 0x55, 0x36, 0x96, 0xc3, 0x22,
-	0xfb, 0x52, 0x31, 0x99, 0x9b, 0x35, 0x26, 0xd1, 0x6d, 0xd6, 0x50, 0x52, 0x5d, 0xec, 0xd8, 0x26,
-	0xdc, 0xa1, 0x09, 0x55, 0x79, 0x0e, 0x42, 0x1f, 0xc1, 0x7c, 0xc8, 0xc1, 0xc6, 0x18, 0x85, 0x78,
-	0xc6, 0xc0, 0x69, 0x92, 0x93, 0x8c, 0x39, 0x11, 0x2e, 0x64, 0xc9, 0xe2, 0x05, 0xb2, 0x24, 0x9c,
-	0x97, 0x25, 0xbf, 0xd3, 0xd8, 0x94, 0x52, 0xed, 0x48, 0x83, 0x85, 0xc4, 0xad, 0x90, 0x3f, 0xf8,
-	0x77, 0x8e, 0xf4, 0x80, 0x9f, 0x6b, 0x50, 0x8c, 0x2e, 0x1d, 0xa1, 0x4d, 0xc8, 0x61, 0x76, 0x71,
-	0x85, 0xa5, 0x9d, 0xc5, 0xc4, 0xa5, 0x42, 0x82, 0xe3, 0xd7, 0x08, 0x13, 0x77, 0x55, 0xda, 0x9c,
-	0xf1, 0x25, 0x0a, 0xf0, 0x7f, 0xd5, 0xc2, 0x02, 0x7c, 0x62, 0x15, 0xe9, 0xdf, 0x7f, 0x15, 0x97,
-	0x67, 0xba, 0x7f, 0x2f, 0x41, 0x96, 0xae, 0x85, 0x34, 0xd2, 0x3e, 0x76, 0x87, 0xa6, 0xa5, 0x0f,
-	0x68, 0x28, 0x16, 0xd8, 0xae, 0x0e, 0x61, 0xe2, 0xae, 0x0e, 0x61, 0xe8, 0x10, 0x16, 0xe2, 0xf1,
-	0x1c, 0x15, 0xa3, 0xbe, 0xc5, 0xf8, 0xb1, 0x4c, 0xc4, 0x8e, 0x1e, 0x12, 0x9c, 0xf2, 0x35, 0x84,
-	0x04, 0x12, 0x19, 0x50, 0xee, 0xd9, 0x96, 0xaf, 0x9b, 0x16, 0x76, 0x99, 0xa2, 0xb4, 0xea, 0x16,
-	0xd7, 0x5d, 0x89, 0x86, 0x0d, 0x4d, 0x64, 0x3e, 0xf9, 0x16, 0x97, 0x8c, 0x43, 0xbf, 0x80, 0xf9,
-	0xb0, 0x11, 0x62, 0x4a, 0x32, 0xaa, 0x5b, 0x5c, 0x5b, 0x22, 0x09, 0xdb, 0x0c, 0x12, 0x97, 0x7c,
-	0x8b, 0x4b, 0x42, 0xa1, 0x4f, 0x61, 0x6e, 0x40, 0x3a, 0xb4, 0xad, 0x53, 0xc7, 0x74, 0xb1, 0xa1,
-	0xbe, 0x57, 0xf8, 0x48, 0xa0, 0x60, 0x89, 0x4b, 0xe4, 0x91, 0xaf, 0x53, 0x88, 0x18, 0xe2, 0x8f,
-	0xa1, 0x7e, 0xda, 0x0e, 0x2c, 0x6f, 0xeb, 0x94, 0xdf, 0x11, 0xcb, 0xab, 0xfc, 0xb1, 0x23, 0x13,
-	0x31, 0x7f, 0x24, 0x38, 0x65, 0x7f, 0x24, 0x90, 0xe8, 0x11, 0xcd, 0xcb, 0xcc, 0x48, 0xec, 0x7e,
-	0xe1, 0xca, 0x44, 0x41, 0xc5, 0xec, 0xc3, 0xc6, 0x31, 0xfc, 0x97, 0x24, 0x34, 0x92, 0x80, 0x06,
-	0x50, 0x71, 0x6c, 0x83, 0x3e, 0x76, 0x1b, 0xfb, 0x81, 0x6b, 0x61, 0x83, 0x37, 0x4a, 0x6b, 0x13,
-	0x52, 0x25, 0x2a, 0xf6, 0xfa, 0x4a, 0xf2, 0xca, 0xb7, 0x45, 0x93, 0x58, 0xf4, 0x2b, 0x58, 0x4a,
-	0xdc, 0x96, 0x62, 0xcf, 0x51, 0x52, 0x1d, 0x51, 0xb4, 0x14, 0x94, 0xac, 0xa7, 0x55, 0xc9, 0x90,
-	0x34, 0x2b, 0xb5, 0x10, 0xed, 0x7d, 0xdd, 0xea, 0xb7, 0xec, 0xee, 0xbe, 0xc5, 0x9b, 0x40, 0xbd,
-	0x3b, 0xc0, 0xfc, 0xc2, 0x60, 0x42, 0xfb, 0x03, 0x05, 0x25, 0xd3, 0xae, 0x92, 0x21, 0x6b, 0x57,
-	0x51, 0x44, 0x37, 0xa3, 0x48, 0x59, 0x11, 0xdd, 0x20, 0x54, 0xdd, 0x8c, 0x62, 0x04, 0xc2, 0xcd,
-	0x28, 0x06, 0x50, 0xdc, 0x8c, 0x62, 0x08, 0x76, 0xa9, 0xae, 0x67, 0x5b, 0x3d, 0x73, 0x60, 0xd2,
-	0x11, 0x37, 0x33, 0x6a, 0x59, 0x7d, 0xa9, 0x6e, 0x82, 0x30, 0xbc, 0x54, 0x37, 0x81, 0x48, 0x5e,
-	0xaa, 0x9b, 0x20, 0x40, 0x0f, 0xa1, 0x72, 0xa0, 0x9b, 0x83, 0xc0, 0xc5, 0x9d, 0x9e, 0xee, 0xe3,
-	0xbe, 0xed, 0x9e, 0xf1, 0x83, 0x3f, 0x1a, 0xd7, 0x1c, 0x77, 0x97, 0xa3, 0xc4, 0x23, 0xce, 0x04,
-	0x0a, 0x3d, 0x85, 0xc5, 0x50, 0x92, 0x17, 0x74, 0x23, 0x61, 0x57, 0xa9, 0x30, 0x7a, 0x15, 0x9a,
-	0xa3, 0xf7, 0x62, 0xac, 0x20, 0x0f, 0x4d, 0x62, 0xd1, 0x36, 0x5c, 0x75, 0xb1, 0xef, 0x9e, 0x75,
-	0x1c, 0x7b, 0x60, 0xf6, 0xce, 0x58, 0x25, 0x83, 0xe2, 0xd5, 0x51, 0xe4, 0x2e, 0xc5, 0x25, 0x2a,
-	0x9a, 0x85, 0x04, 0xaa, 0x59, 0x08, 0x67, 0x65, 0xad, 0x4c, 0x21, 0x5b, 0xc9, 0xb5, 0x32, 0x05,
-	0xa8, 0x94, 0xf8, 0x11, 0xe5, 0x53, 0x58, 0x48, 0x24, 0x59, 0xf4, 0x73, 0x88, 0x2e, 0x18, 0x3d,
-	0x3b, 0x73, 0xc2, 0x0a, 0x5e, 0xba, 0x90, 0x44, 0xe0, 0xaa, 0x0b, 0x49, 0x04, 0x5e, 0xff, 0x32,
-	0x03, 0x85, 0x70, 0x17, 0x5f, 0x4a, 0x4f, 0xb6, 0x01, 0xf9, 0x21, 0xf6, 0xe8, 0x25, 0xa2, 0x54,
-	0x5c, 0xda, 0x71, 0x90, 0x58, 0xda, 0x71, 0x90, 0x5c, 0x79, 0xa6, 0x5f, 0xaa, 0xf2, 0xcc, 0xcc,
-	0x5c, 0x79, 0x62, 0x7a, 0x6e, 0x2e, 0xbc, 0x1d, 0xc2, 0xe3, 0xa6, 0x17, 0xbf, 0x72, 0xc2, 0x53,
-	0x75, 0x91, 0x31, 0x71, 0xaa, 0x2e, 0xa2, 0xd0, 0x31, 0x5c, 0x15, 0x8e, 0xc4, 0xf8, 0x2c, 0x94,
-	0xbc, 0x15, 0xca, 0xd3, 0x2f, 0x29, 0xb4, 0x29, 0x15, 0xcb, 0x7d, 0xc7, 0x09, 0xa8, 0x58, 0xba,
-	0x27, 0x71, 0x24, 0x24, 0x0c, 0xdc, 0x0d, 0xfa, 0x3b, 0xdc, 0xec, 0xf9, 0x38, 0x24, 0x44, 0xb8,
-	0x18, 0x12, 0x22, 0xbc, 0xfe, 0xbf, 0x29, 0x28, 0xcb, 0xcf, 0x7b, 0x29, 0x81, 0xf1, 0x1e, 0x14,
-	0xf1, 0xa9, 0xe9, 0x77, 0x7a, 0xb6, 0x81, 0x79, 0xff, 0x4a, 0xfd, 0x4c, 0x80, 0x77, 0x6d, 0x43,
-	0xf2, 0x73, 0x08, 0x13, 0xa3, 0x29, 0x3d, 0x53, 0x34, 0xc5, 0xa3, 0xe7, 0xcc, 0x0c, 0xa3, 0x67,
-	0xa5, 0x9f, 0x8a, 0x97, 0xe3, 0xa7, 0xfa, 0xd7, 0x29, 0xa8, 0x24, 0x5f, 0x75, 0xaf, 0xc7, 0x16,
-	0x94, 0x77, 0x53, 0x7a, 0xe6, 0xdd, 0xf4, 0x11, 0xcc, 0x93, 0xfa, 0x54, 0xf7, 0x7d, 0x7e, 0xed,
-	0x39, 0x43, 0x4b, 0x4c, 0x96, 0x8d, 0x02, 0x6b, 0x33, 0x84, 0x4b, 0xd9, 0x48, 0x80, 0x4f, 0x84,
-	0x6e, 0xf6, 0x82, 0xa1, 0xfb, 0xeb, 0x14, 0xcc, 0xef, 0xda, 0xc6, 0x33, 0x56, 0xba, 0xfa, 0xaf,
-	0x8b, 0x3d, 0xbf, 0xcb, 0x94, 0x56, 0x5f, 0x80, 0x79, 0xa9, 0x76, 0xad, 0x7f, 0xc1, 0xe2, 0x4c,
-	0x2e, 0x11, 0xbe, 0x7f, 0x76, 0x29, 0xc3, 0x9c, 0x58, 0x72, 0xd7, 0x9b, 0xb0, 0x90, 0xa8, 0x90,
-	0xc5, 0x07, 0xd0, 0x66, 0x79, 0x80, 0xfa, 0x3d, 0x58, 0x52, 0x95, 0x8e, 0x42, 0xd6, 0xd1, 0x66,
-	0x38, 0x2f, 0x7b, 0x00, 0x4b, 0xaa, 0x12, 0xf0, 0xe2, 0xcb, 0xf9, 0x39, 0x3f, 0x8b, 0xe6, 0xc5,
-	0xda, 0x85, 0xf9, 0xef, 0xc3, 0xa2, 0xa2, 0x68, 0xbb, 0xb8, 0x9c, 0xaf, 0x52, 0xe1, 0x2c, 0x22,
-	0xfe, 0x54, 0xe1, 0x3e, 0x54, 0x9c, 0xf0, 0x47, 0x87, 0x77, 0xbc, 0xd9, 0xf8, 0xe2, 0x56, 0x84,
-	0x6b, 0x25, 0x5a, 0xdf, 0xb2, 0x8c, 0x91, 0xe5, 0xf0, 0x6e, 0x38, 0xa7, 0x90, 0xd3, 0x4e, 0xb4,
-	0xc5, 0x65, 0x19, 0x23, 0xb8, 0x28, 0x3f, 0xc3, 0x8b, 0x61, 0x1b, 0xae, 0x86, 0xd7, 0xed, 0xac,
-	0x7e, 0xb8, 0xfc, 0x42, 0x5c, 0xe0, 0xc5, 0xc8, 0xe4, 0xfa, 0x17, 0x12, 0x28, 0xda, 0x98, 0x67,
-	0xeb, 0x9f, 0x6b, 0xb0, 0x90, 0xf8, 0x2a, 0x03, 0xdd, 0x86, 0x02, 0xfd, 0x64, 0x32, 0x1e, 0x49,
-	0x50, 0x43, 0x53, 0x98, 0x24, 0x33, 0xcf, 0x41, 0xe8, 0x7d, 0x28, 0x46, 0x1f, 0x6a, 0xf0, 0x83,
-	0x71, 0xb6, 0x15, 0x42, 0xa0, 0xb4, 0x15, 0x42, 0x20, 0x9f, 0x66, 0xfc, 0x25, 0x5c, 0x9b, 0xfa,
-	0x89, 0xc6, 0x85, 0x0e, 0x61, 0xe3, 0xb1, 0x44, 0xe6, 0x42, 0x63, 0x89, 0x53, 0x58, 0x51, 0x7f,
-	0x39, 0x21, 0x68, 0x4f, 0x9d, 0xab, 0x3d, 0x76, 0x64, 0xfa, 0x7c, 0x47, 0x46, 0x27, 0xfd, 0x73,
-	0xe2, 0xd7, 0x0c, 0xe8, 0x16, 0x64, 0x1d, 0xdb, 0x1e, 0x78, 0xfc, 0xe6, 0x09, 0x55, 0x47, 0x01,
-	0xa2, 0x3a, 0x0a, 0x78, 0x89, 0xa9, 0xd1, 0xff, 0x44, 0x93, 0xb9, 0xf8, 0x83, 0x8b, 0x4b, 0x32,
-	0xaf, 0x60, 0x8c, 0xec, 0x0c, 0x51, 0xfd, 0x3e, 0x14, 0x5d, 0x66, 0x73, 0xdb, 0xe5, 0x9b, 0x88,
-	0x86, 0x4f, 0x04, 0x14, 0xc3, 0x27, 0x02, 0x4a, 0x3e, 0xfc, 0x17, 0x0d, 0x96, 0x95, 0x1f, 0x6c,
-	0x08, 0x8f, 0xa8, 0x5d, 0xe0, 0x11, 0x53, 0xe7, 0x3e, 0x62, 0xb2, 0x46, 0x48, 0x5f, 0xac, 0x46,
-	0x78, 0xe7, 0x36, 0x14, 0xc2, 0x5b, 0x0f, 0x08, 0x20, 0xf7, 0x74, 0x7f, 0x6b, 0x7f, 0xeb, 0x5e,
-	0xe5, 0x0a, 0x2a, 0x41, 0x7e, 0x77, 0xeb, 0xf1, 0xbd, 0xed, 0xc7, 0x0f, 0x2a, 0x1a, 0xf9, 0xd1,
-	0xde, 0x7f, 0xfc, 0x98, 0xfc, 0x48, 0xbd, 0xf3, 0x48, 0xbc, 0x49, 0xc9, 0x8b, 0xec, 0x39, 0x28,
-	0x6c, 0x3a, 0x0e, 0xcd, 0x8f, 0x8c, 0x77, 0xeb, 0xc4, 0x24, 0x49, 0xb7, 0xa2, 0xa1, 0x3c, 0xa4,
-	0x9f, 0x3c, 0xd9, 0xa9, 0xa4, 0xd0, 0x12, 0x54, 0xee, 0x61, 0xdd, 0x18, 0x98, 0x16, 0x0e, 0x5f,
-	0x31, 0x95, 0x74, 0xf3, 0xe8, 0x3f, 0xbe, 0x59, 0xd3, 0xbe, 0xfe, 0x66, 0x4d, 0xfb, 0xed, 0x37,
-	0x6b, 0xda, 0x97, 0xdf, 0xae, 0x5d, 0xf9, 0xfa, 0xdb, 0xb5, 0x2b, 0xff, 0xfd, 0xed, 0xda, 0x95,
-	0xbf, 0xb8, 0xdd, 0x37, 0xfd, 0xc3, 0xa0, 0xdb, 0xe8, 0xd9, 0x43, 0xfe, 0x4d, 0xbc, 0xe3, 0xda,
-	0x24, 0x97, 0xf3, 0x5f, 0x1b, 0xc9, 0x8f, 0xe5, 0xff, 0x29, 0x75, 0x7d, 0x93, 0xfe, 0xdc, 0x65,
-	0x74, 0x8d, 0x6d, 0xbb, 0xc1, 0x00, 0xf4, 0xf3, 0x68, 0xaf, 0x9b, 0xa3, 0x9f, 0x41, 0xbf, 0xf7,
-	0xbb, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcd, 0x1d, 0x47, 0xde, 0x67, 0x3f, 0x00, 0x00,
+	// 4433 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3c, 0x4b, 0x6c, 0x1c, 0xc9,
+	0x75, 0xea, 0xf9, 0xcf, 0x1b, 0x92, 0x33, 0x2c, 0x7e, 0xd4, 0xe2, 0x4a, 0x1c, 0x7a, 0x76, 0x63,
+	0x6b, 0x17, 0xbb, 0xc3, 0xb5, 0x36, 0xeb, 0xac, 0xd7, 0x81, 0x0d, 0x8e, 0xc4, 0x5d, 0x89, 0x2b,
+	0x4a, 0xd4, 0x50, 0x54, 0x36, 0x89, 0x91, 0x49, 0xcf, 0x74, 0x71, 0xd4, 0xe2, 0x4c, 0xf7, 0xb8,
+	0x3f, 0x34, 0x09, 0x18, 0x88, 0x1d, 0x6c, 0x8c, 0x20, 0x27, 0x5f, 0x8c, 0x04, 0xbe, 0xc4, 0x40,
+	0x90, 0x83, 0x0d, 0x04, 0x39, 0xe5, 0x16, 0xe4, 0x16, 0x20, 0x87, 0x1c, 0xf6, 0x18, 0xf8, 0x30,
+	0x08, 0x76, 0x91, 0xcb, 0x04, 0x08, 0x72, 0xce, 0x29, 0xa8, 0x4f, 0x77, 0x57, 0x75, 0xd7, 0x88,
+	0x33, 0xb2, 0x68, 0xac, 0xb1, 0x27, 0x69, 0xde, 0xb7, 0xaa, 0x5e, 0xd5, 0xab, 0xf7, 0x5e, 0xbd,
+	0x26, 0xdc, 0x18, 0x9d, 0xf4, 0xb7, 0x0d, 0x77, 0x68, 0x98, 0x06, 0x3e, 0xc5, 0xb6, 0xef, 0x6d,
+	0xb3, 0x7f, 0x9a, 0x23, 0xd7, 0xf1, 0x1d, 0xb4, 0x20, 0xa2, 0x36, 0x1a, 0x27, 0xef, 0x79, 0x4d,
+	0xcb, 0xd9, 0x36, 0x46, 0xd6, 0x76, 0xcf, 0x71, 0xf1, 0xf6, 0xe9, 0xd7, 0xb7, 0xfb, 0xd8, 0xc6,
+	0xae, 0xe1, 0x63, 0x93, 0x71, 0x6c, 0xdc, 0x14, 0x68, 0x6c, 0xec, 0x7f, 0xdf, 0x71, 0x4f, 0x2c,
+	0xbb, 0xaf, 0xa2, 0xac, 0xf7, 0x1d, 0xa7, 0x3f, 0xc0, 0xdb, 0xf4, 0x57, 0x37, 0x38, 0xde, 0xf6,
+	0xad, 0x21, 0xf6, 0x7c, 0x63, 0x38, 0xe2, 0x04, 0xbf, 0x1b, 0x8b, 0x1a, 0x1a, 0xbd, 0xa7, 0x96,
+	0x8d, 0xdd, 0xf3, 0x6d, 0x3a, 0xde, 0x91, 0xb5, 0xed, 0x62, 0xcf, 0x09, 0xdc, 0x1e, 0x4e, 0x89,
+	0x7d, 0xdf, 0xb2, 0x7d, 0xec, 0xda, 0xc6, 0x60, 0xdb, 0xeb, 0x3d, 0xc5, 0x66, 0x30, 0xc0, 0x6e,
+	0xfc, 0x3f, 0xa7, 0xfb, 0x0c, 0xf7, 0x7c, 0x2f, 0x05, 0x60, 0xbc, 0x8d, 0x7f, 0xbe, 0x06, 0x8b,
+	0xbb, 0x64, 0xae, 0x87, 0xf8, 0x7b, 0x01, 0xb6, 0x7b, 0x18, 0xbd, 0x0e, 0xf9, 0xef, 0x05, 0x38,
+	0xc0, 0xba, 0xb6, 0xa5, 0xdd, 0x2c, 0xb7, 0x56, 0x26, 0xe3, 0x7a, 0x95, 0x02, 0xde, 0x74, 0x86,
+	0x96, 0x8f, 0x87, 0x23, 0xff, 0xbc, 0xcd, 0x28, 0xd0, 0xfb, 0xb0, 0xf0, 0xcc, 0xe9, 0x76, 0x3c,
+	0xec, 0x77, 0x6c, 0x63, 0x88, 0xf5, 0x0c, 0xe5, 0xd0, 0x27, 0xe3, 0xfa, 0xea, 0x33, 0xa7, 0x7b,
+	0x88, 0xfd, 0x07, 0xc6, 0x50, 0x64, 0x83, 0x18, 0x8a, 0xde, 0x82, 0x62, 0xe0, 0x61, 0xb7, 0x63,
+	0x99, 0x7a, 0x96, 0xb2, 0xad, 0x4e, 0xc6, 0xf5, 0x1a, 0x01, 0xdd, 0x33, 0x05, 0x96, 0x02, 0x83,
+	0xa0, 0x37, 0xa1, 0xd0, 0x77, 0x9d, 0x60, 0xe4, 0xe9, 0xb9, 0xad, 0x6c, 0x48, 0xcd, 0x20, 0x22,
+	0x35, 0x83, 0xa0, 0x87, 0x50, 0x60, 0x06, 0xd4, 0xf3, 0x5b, 0xd9, 0x9b, 0x95, 0x5b, 0x5f, 0x69,
+	0x8a, 0x56, 0x6d, 0x4a, 0x13, 0x66, 0xbf, 0x98, 0x40, 0x86, 0x17, 0x05, 0xf2, 0x7d, 0xf0, 0xd3,
+	0xab, 0x90, 0xa7, 0x74, 0xe8, 0x23, 0x28, 0xf6, 0x5c, 0x4c, 0x56, 0x5f, 0x47, 0x5b, 0xda, 0xcd,
+	0xca, 0xad, 0x8d, 0x26, 0xb3, 0x6a, 0x33, 0xb4, 0x6a, 0xf3, 0x71, 0x68, 0xd5, 0xd6, 0xda, 0x64,
+	0x5c, 0x5f, 0xe6, 0xe4, 0x82, 0xd4, 0x50, 0x02, 0x3a, 0x80, 0xb2, 0x17, 0x74, 0x87, 0x96, 0xbf,
+	0xe7, 0x74, 0xe9, 0x7a, 0x57, 0x6e, 0x5d, 0x95, 0x87, 0x7a, 0x18, 0xa2, 0x5b, 0x57, 0x27, 0xe3,
+	0xfa, 0x4a, 0x44, 0x1d, 0x4b, 0xbb, 0x7b, 0xa5, 0x1d, 0x0b, 0x41, 0x4f, 0xa1, 0xea, 0xe2, 0x91,
+	0x6b, 0x39, 0xae, 0xe5, 0x5b, 0x1e, 0x26, 0x72, 0x33, 0x54, 0xee, 0x0d, 0x59, 0x6e, 0x5b, 0x26,
+	0x6a, 0xdd, 0x98, 0x8c, 0xeb, 0xd7, 0x12, 0x9c, 0x92, 0x8e, 0xa4, 0x58, 0xe4, 0x03, 0x4a, 0x80,
+	0x0e, 0xb1, 0x4f, 0x6d, 0x59, 0xb9, 0xb5, 0xf5, 0x5c, 0x65, 0x87, 0xd8, 0x6f, 0x6d, 0x4d, 0xc6,
+	0xf5, 0xeb, 0x69, 0x7e, 0x49, 0xa5, 0x42, 0x3e, 0x1a, 0x40, 0x4d, 0x84, 0x9a, 0x64, 0x82, 0x39,
+	0xaa, 0x73, 0x73, 0xba, 0x4e, 0x42, 0xd5, 0xda, 0x9c, 0x8c, 0xeb, 0x1b, 0x49, 0x5e, 0x49, 0x5f,
+	0x4a, 0x32, 0xb1, 0x4f, 0xcf, 0xb0, 0x7b, 0x78, 0x40, 0xd4, 0xe4, 0x55, 0xf6, 0xb9, 0x1d, 0xa2,
+	0x99, 0x7d, 0x22, 0x6a, 0xd9, 0x3e, 0x11, 0x18, 0x7d, 0x17, 0x16, 0xa2, 0x1f, 0x64, 0xbd, 0x0a,
+	0x7c, 0x0f, 0xa9, 0x85, 0x92, 0x95, 0xda, 0x98, 0x8c, 0xeb, 0xeb, 0x22, 0x8f, 0x24, 0x5a, 0x92,
+	0x16, 0x4b, 0x1f, 0xb0, 0x95, 0x29, 0x4e, 0x97, 0xce, 0x28, 0x44, 0xe9, 0x83, 0xf4, 0x8a, 0x48,
+	0xd2, 0x88, 0x74, 0x72, 0x80, 0x83, 0x5e, 0x0f, 0x63, 0x13, 0x9b, 0x7a, 0x49, 0x25, 0x7d, 0x4f,
+	0xa0, 0x60, 0xd2, 0x45, 0x1e, 0x59, 0xba, 0x88, 0x21, 0x6b, 0xfd, 0xcc, 0xe9, 0xee, 0xba, 0xae,
+	0xe3, 0x7a, 0x7a, 0x59, 0xb5, 0xd6, 0x7b, 0x21, 0x9a, 0xad, 0x75, 0x44, 0x2d, 0xaf, 0x75, 0x04,
+	0xe6, 0xe3, 0x6d, 0x07, 0xf6, 0x7d, 0x6c, 0x78, 0xd8, 0xd4, 0x61, 0xca, 0x78, 0x23, 0x8a, 0x68,
+	0xbc, 0x11, 0x24, 0x35, 0xde, 0x08, 0x83, 0x4c, 0x58, 0x62, 0xbf, 0x77, 0x3c, 0xcf, 0xea, 0xdb,
+	0xd8, 0xd4, 0x2b, 0x54, 0xfe, 0x75, 0x95, 0xfc, 0x90, 0xa6, 0x75, 0x7d, 0x32, 0xae, 0xeb, 0x32,
+	0x9f, 0xa4, 0x23, 0x21, 0x13, 0xfd, 0x29, 0x2c, 0x32, 0x48, 0x3b, 0xb0, 0x6d, 0xcb, 0xee, 0xeb,
+	0x0b, 0x54, 0xc9, 0x2b, 0x2a, 0x25, 0x9c, 0xa4, 0xf5, 0xca, 0x64, 0x5c, 0xbf, 0x2a, 0x71, 0x49,
+	0x2a, 0x64, 0x81, 0xc4, 0x63, 0x30, 0x40, 0x6c, 0xd8, 0x45, 0x95, 0xc7, 0xd8, 0x93, 0x89, 0x98,
+	0xc7, 0x48, 0x70, 0xca, 0x1e, 0x23, 0x81, 0x8c, 0xed, 0xc1, 0x8d, 0xbc, 0x34, 0xdd, 0x1e, 0xdc,
+	0xce, 0x82, 0x3d, 0x14, 0xa6, 0x96, 0xa4, 0xa1, 0x1f, 0x6a, 0xb0, 0xe6, 0xf9, 0x86, 0x6d, 0x1a,
+	0x03, 0xc7, 0xc6, 0xf7, 0xec, 0xbe, 0x8b, 0x3d, 0xef, 0x9e, 0x7d, 0xec, 0xe8, 0x35, 0xaa, 0xe7,
+	0xd5, 0x84, 0x63, 0x55, 0x91, 0xb6, 0x5e, 0x9d, 0x8c, 0xeb, 0x75, 0xa5, 0x14, 0x49, 0xb3, 0x5a,
+	0x11, 0x3a, 0x83, 0x95, 0xf0, 0x92, 0x3e, 0xf2, 0xad, 0x81, 0xe5, 0x19, 0xbe, 0xe5, 0xd8, 0xfa,
+	0xf2, 0x96, 0x96, 0xbe, 0x83, 0xda, 0x69, 0xc2, 0xd6, 0x57, 0x26, 0xe3, 0xfa, 0x0d, 0x85, 0x04,
+	0x49, 0xb7, 0x4a, 0x45, 0x6c, 0xc4, 0x03, 0x17, 0x13, 0x42, 0x6c, 0xea, 0x2b, 0xd3, 0x8d, 0x18,
+	0x11, 0x89, 0x46, 0x8c, 0x80, 0x2a, 0x23, 0x46, 0x48, 0xa2, 0x69, 0x64, 0xb8, 0xbe, 0x45, 0xd4,
+	0xee, 0x1b, 0xee, 0x09, 0x76, 0xf5, 0x55, 0x95, 0xa6, 0x03, 0x99, 0x88, 0x69, 0x4a, 0x70, 0xca,
+	0x9a, 0x12, 0x48, 0xf4, 0x13, 0x0d, 0xe4, 0xa1, 0x59, 0x8e, 0xdd, 0x26, 0x97, 0xb6, 0x47, 0xa6,
+	0xb7, 0x46, 0x95, 0x7e, 0xed, 0x39, 0xd3, 0x13, 0xc9, 0x5b, 0x5f, 0x9b, 0x8c, 0xeb, 0xaf, 0x4e,
+	0x95, 0x26, 0x0d, 0x64, 0xba, 0x52, 0xf4, 0x31, 0x54, 0x08, 0x12, 0xd3, 0xf0, 0xc7, 0xd4, 0xd7,
+	0xe9, 0x18, 0xae, 0xa5, 0xc7, 0xc0, 0x09, 0x5a, 0xd7, 0x26, 0xe3, 0xfa, 0x9a, 0xc0, 0x21, 0xe9,
+	0x11, 0x45, 0xa1, 0x4f, 0x34, 0x20, 0x1b, 0x5d, 0x35, 0xd3, 0xab, 0x54, 0xcb, 0x6b, 0x29, 0x2d,
+	0xaa, 0x69, 0xbe, 0x36, 0x19, 0xd7, 0xb7, 0xd4, 0x72, 0x24, 0xdd, 0x53, 0x74, 0xc5, 0xfb, 0x28,
+	0xba, 0x24, 0x74, 0x7d, 0xfa, 0x3e, 0x8a, 0x88, 0xc4, 0x7d, 0x14, 0x01, 0x55, 0xfb, 0x28, 0x42,
+	0x72, 0x67, 0xf0, 0xc4, 0x18, 0x58, 0x26, 0x0d, 0xa6, 0xae, 0x4d, 0x71, 0x06, 0x11, 0x45, 0xe4,
+	0x0c, 0x22, 0x48, 0xca, 0x19, 0x44, 0x18, 0xea, 0x0c, 0x9e, 0x39, 0xdd, 0x48, 0xdd, 0x1d, 0xdc,
+	0x0d, 0xfa, 0xd4, 0x19, 0x6c, 0xa8, 0x9c, 0xc1, 0x9e, 0x8a, 0x94, 0x39, 0x03, 0xa5, 0x14, 0xd9,
+	0x19, 0x28, 0x49, 0xc8, 0x52, 0x06, 0x23, 0x32, 0x9a, 0x47, 0xd4, 0xc2, 0xe4, 0x3a, 0x7e, 0x45,
+	0xb5, 0x94, 0x47, 0x32, 0x11, 0x5b, 0xca, 0x04, 0xa7, 0xbc, 0x94, 0x09, 0x24, 0xba, 0x0b, 0xc5,
+	0xa1, 0x73, 0x4a, 0x63, 0xbd, 0xeb, 0x54, 0xc3, 0x9a, 0xac, 0x61, 0x9f, 0x21, 0x59, 0x34, 0xca,
+	0x29, 0x25, 0x89, 0x21, 0x3b, 0x7a, 0x0c, 0xe0, 0x05, 0xde, 0x08, 0xdb, 0x74, 0xb8, 0x37, 0xa8,
+	0x30, 0x3d, 0x19, 0x90, 0x86, 0x78, 0x16, 0xe8, 0xc7, 0xf4, 0x92, 0x48, 0x41, 0x0e, 0xb9, 0xd9,
+	0x5d, 0xec, 0x05, 0x43, 0x3a, 0xc2, 0x4d, 0xd5, 0xcd, 0xde, 0x0e, 0xd1, 0xec, 0x66, 0x8f, 0xa8,
+	0xe5, 0x9b, 0x3d, 0x02, 0xb7, 0x8a, 0x90, 0xa7, 0x9c, 0x8d, 0x9f, 0x95, 0x61, 0x45, 0xe1, 0x49,
+	0x11, 0x86, 0xc5, 0xd0, 0x4d, 0x76, 0x2c, 0x62, 0xf6, 0xac, 0xea, 0x10, 0x7d, 0x14, 0x74, 0xb1,
+	0x6b, 0x63, 0x1f, 0x7b, 0xa1, 0x0c, 0x6a, 0x77, 0xba, 0xd1, 0x5c, 0x01, 0x22, 0x84, 0xee, 0x0b,
+	0x22, 0x1c, 0xfd, 0x4c, 0x03, 0x7d, 0x68, 0x9c, 0x75, 0x42, 0xa0, 0xd7, 0x39, 0x76, 0xdc, 0xce,
+	0x08, 0xbb, 0x96, 0x63, 0xd2, 0x44, 0xa5, 0x72, 0xeb, 0xf7, 0x2f, 0x74, 0xfb, 0xcd, 0x7d, 0xe3,
+	0x2c, 0x04, 0x7b, 0x1f, 0x38, 0xee, 0x01, 0x65, 0xdf, 0xb5, 0x7d, 0xf7, 0x9c, 0x6d, 0xc1, 0xa1,
+	0x0a, 0x2f, 0x8c, 0x69, 0x4d, 0x49, 0x80, 0x7e, 0xaa, 0xc1, 0xba, 0xef, 0xf8, 0xc6, 0xa0, 0xd3,
+	0x0b, 0x86, 0xc1, 0xc0, 0xf0, 0xad, 0x53, 0xdc, 0x09, 0x3c, 0xa3, 0x8f, 0x79, 0x56, 0xf4, 0xad,
+	0x8b, 0x87, 0xf6, 0x98, 0xf0, 0xdf, 0x8e, 0xd8, 0x8f, 0x08, 0x37, 0x1b, 0x59, 0x63, 0x32, 0xae,
+	0x6f, 0xfa, 0x0a, 0xb4, 0x30, 0xb0, 0x55, 0x15, 0x1e, 0xbd, 0x01, 0x05, 0x92, 0x35, 0x5a, 0xa6,
+	0x5e, 0x88, 0x33, 0xcc, 0x67, 0x4e, 0x57, 0xca, 0xfb, 0xf2, 0x14, 0x40, 0x68, 0xdd, 0xc0, 0x26,
+	0xb4, 0xc5, 0x98, 0xd6, 0x0d, 0x6c, 0x99, 0x96, 0x02, 0xa8, 0x31, 0x8c, 0xd3, 0xbe, 0xda, 0x18,
+	0xa5, 0x59, 0x8d, 0xb1, 0x73, 0xda, 0x7f, 0xae, 0x31, 0x0c, 0x15, 0x5e, 0x34, 0x86, 0x92, 0x60,
+	0xe3, 0xe7, 0x1a, 0x6c, 0x4c, 0xb7, 0x33, 0x7a, 0x15, 0xb2, 0x27, 0xf8, 0x9c, 0xa7, 0xdc, 0xcb,
+	0x93, 0x71, 0x7d, 0xf1, 0x04, 0x9f, 0x0b, 0x52, 0x09, 0x16, 0xfd, 0x21, 0xe4, 0x4f, 0x8d, 0x41,
+	0x80, 0x79, 0x46, 0xd7, 0x6c, 0xb2, 0x6a, 0x41, 0x53, 0xac, 0x16, 0x34, 0x47, 0x27, 0x7d, 0x02,
+	0x68, 0x86, 0xab, 0xd0, 0x7c, 0x14, 0x18, 0xb6, 0x6f, 0xf9, 0xe7, 0x6c, 0xed, 0xa8, 0x00, 0x71,
+	0xed, 0x28, 0xe0, 0xfd, 0xcc, 0x7b, 0xda, 0xc6, 0xdf, 0x6a, 0x70, 0x6d, 0xaa, 0xbd, 0xbf, 0x10,
+	0x23, 0x24, 0x8b, 0x38, 0xdd, 0x3e, 0x5f, 0x84, 0x21, 0xee, 0xe5, 0x4a, 0x5a, 0x2d, 0xb3, 0x97,
+	0x2b, 0x65, 0x6a, 0xd9, 0xc6, 0xff, 0x15, 0xa0, 0x1c, 0xe5, 0xef, 0xe8, 0x2e, 0xd4, 0x4c, 0x6c,
+	0x06, 0xa3, 0x81, 0xd5, 0xa3, 0x3b, 0x8d, 0x6c, 0x6a, 0x56, 0x30, 0xa1, 0x1e, 0x5f, 0xc2, 0x49,
+	0xdb, 0xbb, 0x9a, 0x40, 0xa1, 0x5b, 0x50, 0xe2, 0x79, 0xea, 0x39, 0xf5, 0x6b, 0x8b, 0xad, 0xf5,
+	0xc9, 0xb8, 0x8e, 0x42, 0x98, 0xc0, 0x1a, 0xd1, 0xa1, 0x36, 0x00, 0x2b, 0xfc, 0xec, 0x63, 0xdf,
+	0xd0, 0x73, 0x2a, 0xcf, 0xfe, 0x30, 0xc2, 0x33, 0xcf, 0x1e, 0xd3, 0x0b, 0x12, 0x05, 0x29, 0xe8,
+	0xbb, 0x00, 0x43, 0xc3, 0xb2, 0x19, 0x1f, 0x4f, 0x8f, 0x1b, 0xd3, 0x3c, 0xec, 0x7e, 0x44, 0xc9,
+	0xa4, 0xc7, 0x9c, 0xa2, 0xf4, 0x18, 0x8a, 0x1e, 0x42, 0x91, 0xe9, 0xf2, 0xf4, 0xc2, 0x56, 0x36,
+	0x9d, 0xe0, 0xc7, 0xa2, 0xb9, 0x58, 0x7a, 0xbd, 0x71, 0x16, 0xb1, 0xd8, 0xc2, 0x41, 0x64, 0xd9,
+	0x06, 0xd6, 0x31, 0xf6, 0xad, 0x21, 0xd6, 0x8b, 0xf1, 0xb2, 0x85, 0x30, 0x71, 0xd9, 0x42, 0x18,
+	0x7a, 0x0f, 0xc0, 0xf0, 0xf7, 0x1d, 0xcf, 0x7f, 0x68, 0xf7, 0x30, 0x4d, 0x78, 0x4b, 0x6c, 0xf8,
+	0x31, 0x54, 0x1c, 0x7e, 0x0c, 0x45, 0xdf, 0x82, 0xca, 0x88, 0x07, 0x58, 0xdd, 0x01, 0xa6, 0x09,
+	0x6d, 0x89, 0xc5, 0x83, 0x02, 0x58, 0xe0, 0x15, 0xa9, 0xd1, 0x87, 0x50, 0xed, 0x39, 0x76, 0x2f,
+	0x70, 0x5d, 0x6c, 0xf7, 0xce, 0x0f, 0x8d, 0x63, 0x4c, 0x93, 0xd7, 0x12, 0xdb, 0x2a, 0x09, 0x94,
+	0xb8, 0x55, 0x12, 0x28, 0xf4, 0x2e, 0x94, 0xa3, 0xc2, 0x1f, 0xcd, 0x4f, 0xcb, 0xbc, 0x8e, 0x14,
+	0x02, 0x05, 0xe6, 0x98, 0x92, 0x0c, 0xde, 0xf2, 0xee, 0xf0, 0x4d, 0x87, 0xf5, 0x85, 0x78, 0xf0,
+	0x02, 0x58, 0x1c, 0xbc, 0x00, 0x16, 0xfc, 0xfb, 0xd2, 0x85, 0xfe, 0xfd, 0x03, 0xa8, 0xe1, 0x33,
+	0x56, 0xbc, 0xec, 0x10, 0xa6, 0xc0, 0xb5, 0x68, 0xba, 0x56, 0x66, 0x89, 0x72, 0x88, 0xdb, 0x73,
+	0xba, 0x47, 0xae, 0x25, 0xb0, 0x2f, 0xc9, 0x98, 0xe8, 0xd8, 0x2d, 0xd6, 0x96, 0xf6, 0x72, 0xa5,
+	0x6a, 0xad, 0xd6, 0xf8, 0x77, 0x0d, 0x56, 0x55, 0xbb, 0x2f, 0x71, 0x12, 0xb4, 0x97, 0x72, 0x12,
+	0x9e, 0x40, 0x69, 0xe4, 0x98, 0x1d, 0x6f, 0x84, 0x7b, 0x7a, 0x46, 0x75, 0x0e, 0x0e, 0x1c, 0xf3,
+	0x70, 0x84, 0x7b, 0x7f, 0x60, 0xf9, 0x4f, 0x77, 0x4e, 0x1d, 0xcb, 0xbc, 0x6f, 0x79, 0x7c, 0xc3,
+	0x8e, 0x18, 0x46, 0x8e, 0xc7, 0x38, 0xb0, 0x55, 0x82, 0x02, 0xd3, 0xd2, 0xf8, 0x65, 0x1e, 0x6a,
+	0xc9, 0x1d, 0xff, 0xdb, 0x34, 0x15, 0xf4, 0x31, 0x14, 0x2d, 0x96, 0x2a, 0xf3, 0x58, 0xec, 0x77,
+	0x04, 0xcf, 0xdb, 0x8c, 0xeb, 0xe6, 0xcd, 0xd3, 0xaf, 0x37, 0x79, 0x4e, 0x4d, 0x97, 0x80, 0x4a,
+	0xe6, 0x9c, 0xb2, 0x64, 0x0e, 0x44, 0x6d, 0x28, 0x7a, 0xd8, 0x3d, 0xb5, 0x7a, 0x98, 0xfb, 0xb5,
+	0xba, 0x28, 0xb9, 0xe7, 0xb8, 0x98, 0xc8, 0x3c, 0x64, 0x24, 0xb1, 0x4c, 0xce, 0x23, 0xcb, 0xe4,
+	0x40, 0xf4, 0x04, 0xca, 0x3d, 0xc7, 0x3e, 0xb6, 0xfa, 0xfb, 0xc6, 0x88, 0x7b, 0xb6, 0x1b, 0x2a,
+	0xa9, 0xb7, 0x43, 0x22, 0x5e, 0xfe, 0x0b, 0x7f, 0x26, 0xca, 0x7f, 0x21, 0x18, 0xed, 0x43, 0xc1,
+	0xc3, 0x3d, 0x37, 0x2a, 0xfc, 0x25, 0x72, 0x81, 0x43, 0x8a, 0x6b, 0xe3, 0x63, 0x4c, 0x8e, 0x30,
+	0x66, 0x45, 0x69, 0xc6, 0x20, 0x49, 0xe4, 0x42, 0xd0, 0x5f, 0x69, 0xb0, 0x36, 0xc2, 0xae, 0x67,
+	0x79, 0x3e, 0xb6, 0xfd, 0x27, 0xce, 0x20, 0x18, 0xe2, 0xdb, 0x03, 0xc3, 0x1a, 0xf2, 0xca, 0xdf,
+	0x5b, 0xaa, 0x31, 0x1f, 0xa8, 0x18, 0xe8, 0xba, 0xd0, 0x00, 0x47, 0x29, 0x4f, 0x4e, 0x78, 0x94,
+	0x24, 0xc2, 0x66, 0xbd, 0x0f, 0xd5, 0xc4, 0x4c, 0xd0, 0x37, 0xa1, 0xc2, 0xc3, 0x71, 0xfa, 0x52,
+	0xa0, 0xc5, 0x2f, 0x05, 0x0c, 0x9c, 0x7c, 0x29, 0x88, 0xa1, 0x8d, 0xff, 0xc9, 0x01, 0xc4, 0xdb,
+	0x98, 0x48, 0xc2, 0x67, 0xb8, 0x17, 0xf8, 0x0e, 0x7d, 0x3c, 0x10, 0x24, 0x85, 0x60, 0xc9, 0xd1,
+	0x40, 0x0c, 0x25, 0xde, 0x90, 0x68, 0xf7, 0x46, 0x46, 0x2f, 0x7c, 0xac, 0xa0, 0x66, 0x8b, 0x80,
+	0xa2, 0x37, 0x8c, 0x80, 0xe8, 0xab, 0x90, 0xa3, 0x83, 0x66, 0xef, 0x14, 0x68, 0x32, 0xae, 0x2f,
+	0xd9, 0xf2, 0x70, 0x29, 0x1e, 0x7d, 0x07, 0x16, 0x4f, 0xa2, 0x23, 0x4a, 0xc6, 0x96, 0xa3, 0x0c,
+	0x34, 0x9d, 0x88, 0x11, 0xd2, 0xe8, 0x16, 0x44, 0x38, 0x3a, 0x86, 0x8a, 0x61, 0xdb, 0x8e, 0x4f,
+	0x2f, 0xfa, 0xf0, 0xed, 0xe2, 0xf5, 0x69, 0x07, 0xba, 0xb9, 0x13, 0xd3, 0xb2, 0x00, 0x95, 0x7a,
+	0x68, 0x41, 0x82, 0xe8, 0xa1, 0x05, 0x30, 0x6a, 0x43, 0x61, 0x60, 0x74, 0xf1, 0x20, 0xbc, 0x59,
+	0x5f, 0x9b, 0xaa, 0xe2, 0x3e, 0x25, 0x63, 0xd2, 0xe9, 0x66, 0x64, 0x7c, 0xe2, 0x0b, 0x09, 0x83,
+	0x6c, 0x1c, 0x43, 0x2d, 0x39, 0x9e, 0xd9, 0x02, 0xb2, 0xd7, 0xc5, 0x80, 0xac, 0x7c, 0x61, 0x0c,
+	0x68, 0x40, 0x45, 0x18, 0xd4, 0x65, 0xa8, 0x68, 0xfc, 0x42, 0x83, 0x55, 0x95, 0x97, 0x43, 0xfb,
+	0x82, 0x6f, 0xd4, 0x78, 0x1d, 0x56, 0x75, 0xc0, 0x1c, 0x33, 0x76, 0x33, 0x29, 0xa7, 0x18, 0xbb,
+	0xc4, 0x16, 0x2c, 0xd9, 0x8e, 0x89, 0x3b, 0x06, 0x51, 0x30, 0xb0, 0x3c, 0x5f, 0xcf, 0xd0, 0xb7,
+	0x2d, 0x5a, 0xbf, 0x25, 0x98, 0x9d, 0x10, 0x21, 0x70, 0x2f, 0x4a, 0x88, 0xc6, 0xf7, 0xa1, 0x9a,
+	0x78, 0x5d, 0x91, 0xc2, 0xc3, 0xcc, 0x8c, 0xe1, 0x61, 0x7c, 0x67, 0x67, 0x2f, 0xba, 0xb3, 0xd9,
+	0x5d, 0xdb, 0xf8, 0xef, 0x3c, 0x54, 0x13, 0xa5, 0x0b, 0x41, 0x8a, 0x76, 0xe1, 0xcd, 0xff, 0x97,
+	0x1a, 0x10, 0x77, 0xe9, 0x1b, 0x24, 0xe2, 0x8e, 0x73, 0x36, 0xba, 0x04, 0x95, 0x5b, 0xef, 0x3e,
+	0xb7, 0x46, 0xd2, 0xbc, 0x1d, 0x32, 0x46, 0xa9, 0x00, 0xdb, 0xa2, 0xf4, 0x55, 0xa9, 0x97, 0x42,
+	0x0a, 0xda, 0x51, 0x1a, 0x8b, 0x46, 0x40, 0x17, 0xb5, 0xe3, 0xe1, 0x01, 0xee, 0xf9, 0x8e, 0xab,
+	0x67, 0xe9, 0x18, 0xb6, 0x9f, 0x3f, 0x86, 0x07, 0x8e, 0x89, 0x0f, 0x39, 0x07, 0xd3, 0x4e, 0x0f,
+	0xba, 0x2d, 0x80, 0xc5, 0x83, 0x2e, 0xc2, 0xd1, 0x1f, 0x43, 0xc5, 0x77, 0x06, 0xd8, 0xe5, 0x07,
+	0x3d, 0xc7, 0xe3, 0x5b, 0xc5, 0x5e, 0x7a, 0x1c, 0x91, 0xb1, 0xd3, 0x2d, 0xb0, 0x89, 0xa7, 0x5b,
+	0x00, 0xa3, 0x87, 0xb0, 0x12, 0xda, 0xb5, 0xd3, 0x1b, 0x18, 0x9e, 0xc7, 0x5c, 0x6e, 0x9e, 0x9a,
+	0xa4, 0x3e, 0x19, 0xd7, 0x5f, 0x09, 0xd1, 0xb7, 0x09, 0x36, 0xe1, 0x79, 0x97, 0x53, 0xc8, 0x8d,
+	0xbf, 0xd6, 0xe0, 0xea, 0x94, 0x15, 0x9f, 0xed, 0xfc, 0x1d, 0xca, 0x39, 0xd7, 0x4d, 0xd5, 0x44,
+	0x43, 0xb9, 0xa4, 0x16, 0x69, 0xb9, 0x78, 0x48, 0xd6, 0xfc, 0x42, 0x67, 0xd0, 0x87, 0xe5, 0x94,
+	0x19, 0x2e, 0xc5, 0x25, 0xfc, 0x4a, 0x83, 0x22, 0x2f, 0xa3, 0xcd, 0xb5, 0xcb, 0x3f, 0x82, 0x65,
+	0x13, 0x7b, 0xbe, 0x65, 0xb3, 0x94, 0x8f, 0x3d, 0xac, 0x33, 0x95, 0xf4, 0x3d, 0x52, 0x40, 0x3e,
+	0x4a, 0xbc, 0xb1, 0xd7, 0x92, 0x38, 0xf4, 0x04, 0xd6, 0x45, 0x61, 0xe1, 0xd3, 0x7b, 0x74, 0x68,
+	0xe9, 0xf3, 0x81, 0x40, 0xc1, 0x1e, 0x05, 0xa5, 0x61, 0xad, 0x28, 0xd0, 0x8d, 0x63, 0x80, 0xb8,
+	0xaa, 0x37, 0xd7, 0xf4, 0xde, 0x84, 0x82, 0x8b, 0x0d, 0xcf, 0xb1, 0xf9, 0x9c, 0xe8, 0x15, 0xc1,
+	0x20, 0xe2, 0x15, 0xc1, 0x20, 0x8d, 0xdf, 0x83, 0x72, 0x54, 0xe8, 0x9b, 0x47, 0x4d, 0xe3, 0x2f,
+	0x32, 0x50, 0x11, 0xca, 0xea, 0xe8, 0x19, 0x54, 0x79, 0xae, 0x62, 0xd9, 0x7d, 0x56, 0xdf, 0xcb,
+	0xf0, 0xb2, 0x6e, 0xaa, 0xcd, 0x81, 0xcc, 0x32, 0xa2, 0xa5, 0xe5, 0x3d, 0x9a, 0x59, 0x78, 0x12,
+	0x4c, 0xcc, 0x2c, 0x64, 0x0c, 0xfa, 0x18, 0xd6, 0x59, 0xbd, 0xb5, 0xe3, 0xf1, 0x86, 0x81, 0x8e,
+	0x1d, 0x0c, 0xbb, 0xd8, 0xa5, 0x8b, 0x9e, 0x67, 0x75, 0x30, 0x46, 0x11, 0x76, 0x14, 0x3c, 0xa0,
+	0x78, 0xb1, 0x0e, 0xa6, 0xc2, 0x0b, 0x2b, 0x90, 0x9b, 0xd1, 0xe7, 0xde, 0x05, 0x94, 0x7e, 0x4a,
+	0x97, 0xfc, 0xbd, 0x36, 0x9b, 0xbf, 0x6f, 0x9c, 0x41, 0x2d, 0xf9, 0x40, 0xfe, 0x1b, 0xba, 0x37,
+	0x4e, 0xa0, 0x1c, 0x3d, 0x6f, 0xcf, 0xb7, 0x7f, 0x5e, 0x40, 0xd9, 0x63, 0x58, 0x60, 0x8b, 0xf4,
+	0x81, 0x35, 0xf0, 0xb1, 0x8b, 0xee, 0x40, 0xc1, 0xf3, 0x0d, 0x1f, 0x7b, 0xba, 0xb6, 0x95, 0xbd,
+	0xb9, 0x74, 0x6b, 0x3d, 0xfd, 0x76, 0x4d, 0xd0, 0x3c, 0xee, 0xa6, 0x94, 0xe2, 0x38, 0x18, 0xa4,
+	0xf1, 0xe7, 0x1a, 0x2c, 0x88, 0x4f, 0xf4, 0x2f, 0x47, 0xec, 0x9c, 0x87, 0xe9, 0x17, 0xd1, 0x20,
+	0xf8, 0xeb, 0xfc, 0xa5, 0xad, 0x25, 0x89, 0xb8, 0x59, 0x1f, 0x40, 0x27, 0xf0, 0xb0, 0xab, 0xe7,
+	0xe2, 0x88, 0x9b, 0x81, 0x8f, 0x3c, 0x69, 0xb7, 0x43, 0x0c, 0xe5, 0x66, 0x20, 0x63, 0x15, 0xfb,
+	0x02, 0x50, 0x3f, 0x2e, 0xcf, 0x93, 0x43, 0x16, 0xde, 0xfa, 0xb3, 0x95, 0xe7, 0x69, 0x78, 0x24,
+	0xb1, 0x8b, 0xe1, 0x91, 0x84, 0x78, 0x81, 0x2d, 0xf3, 0xf3, 0x3c, 0x1d, 0x6b, 0xfc, 0xce, 0x9f,
+	0xc8, 0x37, 0xb2, 0x73, 0xe4, 0x1b, 0x6f, 0x41, 0x91, 0x06, 0x16, 0xd1, 0x11, 0xa7, 0x36, 0x21,
+	0x20, 0x89, 0xa5, 0xc0, 0x20, 0xcf, 0x71, 0x35, 0xf9, 0x5f, 0xd3, 0xd5, 0x74, 0xe0, 0xda, 0x53,
+	0xc3, 0xeb, 0x84, 0xce, 0xd1, 0xec, 0x18, 0x7e, 0x27, 0x3a, 0xeb, 0x05, 0x5a, 0xdd, 0xa1, 0x2f,
+	0x87, 0x4f, 0x0d, 0xef, 0x30, 0xa4, 0xd9, 0xf1, 0x0f, 0xd2, 0x27, 0x7f, 0x5d, 0x4d, 0x81, 0x8e,
+	0x60, 0x4d, 0x2d, 0xbc, 0x48, 0x47, 0x4e, 0x6f, 0x26, 0xef, 0xb9, 0x92, 0x57, 0x14, 0x68, 0xf4,
+	0x23, 0x0d, 0x74, 0x12, 0x71, 0xbb, 0x42, 0x50, 0xd0, 0x71, 0x4e, 0xb1, 0x3b, 0x30, 0xce, 0x79,
+	0x8f, 0xc8, 0x57, 0xd2, 0x2e, 0xff, 0xc0, 0x31, 0xa5, 0x28, 0x82, 0x4e, 0x6d, 0x24, 0x03, 0x1f,
+	0x32, 0x21, 0xe2, 0xd4, 0xd4, 0x14, 0xc2, 0x16, 0x82, 0x39, 0x9e, 0x2b, 0x2a, 0x17, 0x3e, 0x57,
+	0x7c, 0x15, 0x72, 0x23, 0xc7, 0x19, 0xe8, 0x0b, 0x71, 0x56, 0x49, 0x7e, 0x8b, 0x59, 0x25, 0xf9,
+	0x2d, 0x56, 0x94, 0xf7, 0x72, 0xa5, 0x52, 0xad, 0x4c, 0xae, 0xc3, 0x25, 0xb9, 0xad, 0x24, 0x7d,
+	0xa0, 0xb2, 0x97, 0x7e, 0xa0, 0x72, 0x73, 0xac, 0x46, 0x7e, 0xe6, 0xd5, 0x28, 0xcc, 0xbe, 0x1a,
+	0x8d, 0x4f, 0x32, 0xb0, 0x28, 0x75, 0xbe, 0x7c, 0x39, 0x97, 0xe1, 0x6f, 0x32, 0xb0, 0xae, 0x9e,
+	0xd2, 0xa5, 0x14, 0x08, 0xef, 0x02, 0x49, 0x60, 0xef, 0xc5, 0x41, 0xd7, 0x5a, 0xaa, 0x3e, 0x48,
+	0x97, 0x33, 0xcc, 0x7e, 0x53, 0xef, 0xe5, 0x21, 0x3b, 0xe9, 0xa6, 0xb0, 0x84, 0x36, 0x9d, 0xac,
+	0xaa, 0x9b, 0x42, 0x6c, 0xce, 0x61, 0x05, 0xe8, 0x29, 0x2d, 0x39, 0xa2, 0xa8, 0x56, 0x01, 0x72,
+	0x24, 0x2a, 0x6c, 0x9c, 0x42, 0x91, 0x0f, 0x07, 0xbd, 0x03, 0x65, 0xea, 0x8b, 0x85, 0xf2, 0x13,
+	0x0d, 0x6f, 0x08, 0x30, 0x91, 0x02, 0x95, 0x42, 0x18, 0xfa, 0x06, 0x00, 0x71, 0x3f, 0xdc, 0x0b,
+	0x67, 0xa8, 0x2f, 0xa3, 0x15, 0xa3, 0x91, 0x63, 0xa6, 0x5c, 0x6f, 0x39, 0x02, 0x36, 0xfe, 0x21,
+	0x03, 0x15, 0x61, 0xe4, 0x2f, 0xa6, 0xfc, 0x07, 0x10, 0xd6, 0x3d, 0x3b, 0x86, 0x69, 0x92, 0x7f,
+	0xa3, 0xf4, 0x78, 0x7b, 0xea, 0x22, 0x85, 0xff, 0xdf, 0x09, 0x39, 0x58, 0x6a, 0x4a, 0x93, 0x0d,
+	0x2b, 0x81, 0x12, 0x93, 0x8d, 0x24, 0x6e, 0xe3, 0x04, 0xd6, 0x94, 0xa2, 0xc4, 0xf4, 0x2a, 0xff,
+	0xb2, 0xd2, 0xab, 0xbf, 0xcf, 0xc3, 0x9a, 0xb2, 0x21, 0x2b, 0xb1, 0x83, 0xb3, 0x2f, 0x65, 0x07,
+	0xff, 0x58, 0x53, 0xad, 0x2c, 0x4b, 0xc2, 0xbf, 0x39, 0x43, 0x97, 0xd8, 0xcb, 0x5a, 0x63, 0x79,
+	0x5b, 0xe4, 0x5f, 0x68, 0x4f, 0x16, 0x66, 0xdd, 0x93, 0xe8, 0x6d, 0x56, 0xbc, 0xa2, 0xba, 0xd8,
+	0x63, 0x7a, 0x78, 0x42, 0x13, 0xaa, 0x8a, 0x1c, 0x44, 0xea, 0x99, 0x21, 0x07, 0x2b, 0x99, 0x96,
+	0xe2, 0x7a, 0x26, 0xa7, 0x49, 0x56, 0x4d, 0x17, 0x44, 0xb8, 0xe0, 0x25, 0xcb, 0x73, 0x78, 0x49,
+	0xb8, 0xc8, 0x4b, 0xfe, 0x46, 0xf7, 0xa6, 0xe4, 0x6a, 0xc7, 0x1a, 0x54, 0x13, 0x7d, 0x90, 0xbf,
+	0xf5, 0x77, 0x8e, 0x34, 0xc1, 0x1f, 0x6a, 0x50, 0x8e, 0xda, 0x6c, 0xd1, 0x0e, 0x14, 0x30, 0xfd,
+	0x1f, 0x77, 0x3b, 0x2b, 0x89, 0x36, 0x7a, 0x82, 0xe3, 0x8d, 0xf3, 0x89, 0xee, 0xcc, 0x36, 0x67,
+	0x7c, 0x81, 0x00, 0xfc, 0x9f, 0xb4, 0x30, 0x00, 0x4f, 0x8d, 0x22, 0xfb, 0xeb, 0x8f, 0xe2, 0xf2,
+	0x96, 0xee, 0x5f, 0x2b, 0x90, 0xa7, 0x63, 0x21, 0x89, 0xb4, 0x8f, 0xdd, 0xa1, 0x65, 0x1b, 0x03,
+	0xba, 0x15, 0x4b, 0xec, 0x54, 0x87, 0x30, 0xf1, 0x54, 0x87, 0x30, 0xd2, 0x2d, 0x16, 0x3f, 0x05,
+	0x50, 0x31, 0xea, 0xbe, 0xfd, 0x8f, 0x64, 0x22, 0xf6, 0x20, 0x9c, 0xe0, 0x94, 0xbb, 0xc5, 0x12,
+	0x48, 0xd2, 0xb7, 0x1c, 0xd5, 0x40, 0x99, 0xa2, 0xac, 0xaa, 0x6f, 0xf9, 0xb6, 0x44, 0xc3, 0x8a,
+	0x26, 0x32, 0x9f, 0xdc, 0xb7, 0x2c, 0xe3, 0x48, 0xdf, 0x72, 0x98, 0x08, 0x31, 0x25, 0x39, 0x55,
+	0xdf, 0xf2, 0xae, 0x48, 0xc2, 0x0e, 0x83, 0xc4, 0x25, 0xf7, 0x2d, 0x4b, 0x28, 0xd2, 0x40, 0x38,
+	0xc0, 0x86, 0x87, 0x77, 0xcf, 0x46, 0x96, 0x8b, 0x4d, 0x75, 0x27, 0xfd, 0x7d, 0x81, 0x82, 0x39,
+	0x2e, 0x91, 0x47, 0x6e, 0x20, 0x14, 0x31, 0xc4, 0x1e, 0xa4, 0xab, 0x2a, 0xb0, 0xbd, 0xdd, 0x33,
+	0xde, 0x15, 0x5d, 0x54, 0xd9, 0x63, 0x5f, 0x26, 0x62, 0xf6, 0x48, 0x70, 0xca, 0xf6, 0x48, 0x20,
+	0xd1, 0x7d, 0xea, 0x97, 0xd9, 0x22, 0xb1, 0x8e, 0xfa, 0xf5, 0x54, 0x40, 0xc5, 0xd6, 0x87, 0x95,
+	0x63, 0xf8, 0x2f, 0x49, 0x68, 0x24, 0x81, 0x7c, 0x1f, 0x31, 0x72, 0x4c, 0x3a, 0xed, 0x36, 0xf6,
+	0x03, 0xd7, 0xc6, 0x26, 0x4f, 0x94, 0x36, 0x53, 0x52, 0x25, 0x2a, 0x76, 0x7d, 0x25, 0x79, 0xe5,
+	0xef, 0x23, 0x92, 0x58, 0xf4, 0x03, 0x58, 0x4d, 0xf4, 0x07, 0xb3, 0x79, 0x54, 0x54, 0x0f, 0xc7,
+	0x7b, 0x0a, 0x4a, 0x96, 0xd3, 0xaa, 0x64, 0x48, 0x9a, 0x95, 0x5a, 0x88, 0xf6, 0xbe, 0x61, 0xf7,
+	0x49, 0x0b, 0x80, 0xcd, 0x93, 0x40, 0x83, 0xf4, 0x5a, 0x2c, 0xa8, 0xb4, 0x7f, 0xa8, 0xa0, 0x64,
+	0xda, 0x55, 0x32, 0x64, 0xed, 0x2a, 0x8a, 0xa8, 0x17, 0x98, 0x84, 0x15, 0x51, 0xcf, 0xbc, 0xaa,
+	0x17, 0x98, 0x11, 0x08, 0xbd, 0xc0, 0x0c, 0xa0, 0xe8, 0x05, 0x66, 0x08, 0xd6, 0x46, 0x4e, 0x3a,
+	0x39, 0xac, 0x81, 0x45, 0x2b, 0xb5, 0x6c, 0x51, 0x97, 0xd4, 0x6d, 0xe4, 0x29, 0xc2, 0xb0, 0x8d,
+	0x3c, 0x85, 0x48, 0xb6, 0x91, 0xa7, 0x08, 0x48, 0x8f, 0xd2, 0xb1, 0x61, 0x0d, 0x02, 0x17, 0x77,
+	0x7a, 0x86, 0x8f, 0xfb, 0x8e, 0x7b, 0xce, 0xdb, 0x31, 0xe8, 0xbe, 0xe6, 0xb8, 0xdb, 0x1c, 0x25,
+	0x36, 0x9e, 0x24, 0x50, 0xe8, 0x11, 0xac, 0x84, 0x92, 0xbc, 0xa0, 0x1b, 0x09, 0x5b, 0xa6, 0xc2,
+	0xe8, 0x33, 0x0d, 0x47, 0x1f, 0xc6, 0x58, 0x41, 0x1e, 0x4a, 0x63, 0xd1, 0x3d, 0x58, 0x76, 0xb1,
+	0xef, 0x9e, 0x77, 0x46, 0xce, 0xc0, 0xea, 0x9d, 0xb3, 0x48, 0x06, 0xc5, 0xa3, 0xa3, 0xc8, 0x03,
+	0x8a, 0x4b, 0x44, 0x34, 0xd5, 0x04, 0x8a, 0x3c, 0x55, 0xb3, 0x3a, 0xd8, 0x5e, 0xae, 0x94, 0xaf,
+	0x15, 0xf6, 0x72, 0x25, 0xa8, 0x55, 0x78, 0xe3, 0xc8, 0x23, 0xa8, 0x26, 0x9c, 0x2c, 0xfa, 0x36,
+	0x44, 0x6d, 0x9f, 0x8f, 0xcf, 0x47, 0x61, 0x04, 0x2f, 0xb5, 0x89, 0x12, 0xb8, 0xaa, 0x4d, 0x94,
+	0xc0, 0x1b, 0xff, 0x9b, 0x83, 0x52, 0x78, 0x8a, 0x2f, 0x25, 0x27, 0xdb, 0x86, 0xe2, 0x10, 0x7b,
+	0xb4, 0xb5, 0x33, 0x13, 0x87, 0x76, 0x1c, 0x24, 0x86, 0x76, 0x1c, 0x24, 0x47, 0x9e, 0xd9, 0x17,
+	0x8a, 0x3c, 0x73, 0x33, 0x47, 0x9e, 0x18, 0xaa, 0xf2, 0xed, 0x10, 0x3e, 0x6d, 0x3f, 0xff, 0xca,
+	0x09, 0x7b, 0x9d, 0x44, 0xc6, 0x44, 0xaf, 0x93, 0x88, 0x42, 0x27, 0xb0, 0x2c, 0x3c, 0xbf, 0xf3,
+	0x5a, 0x28, 0xb9, 0x15, 0x96, 0xa6, 0xb7, 0x8e, 0xb5, 0x29, 0x15, 0xf3, 0x7d, 0x27, 0x09, 0xa8,
+	0x18, 0xba, 0x27, 0x71, 0x64, 0x4b, 0x98, 0xa4, 0xd5, 0x7b, 0x9f, 0x2f, 0x7b, 0x31, 0xde, 0x12,
+	0x22, 0x5c, 0xdc, 0x12, 0x22, 0x1c, 0xfd, 0x89, 0x70, 0x0b, 0x77, 0x06, 0x4e, 0xdf, 0xe3, 0x1d,
+	0xaa, 0x1b, 0x53, 0x96, 0xe4, 0xbe, 0xc3, 0xbf, 0xeb, 0xe9, 0x09, 0x10, 0x29, 0x58, 0x94, 0x10,
+	0x8d, 0x1f, 0x93, 0xf2, 0xb0, 0x00, 0x21, 0x8f, 0xcd, 0xb1, 0x42, 0x21, 0x0f, 0x95, 0x85, 0x26,
+	0x6c, 0xbf, 0x28, 0x21, 0x68, 0x07, 0x9d, 0xc3, 0xda, 0x10, 0xf5, 0x4c, 0xbc, 0x69, 0x42, 0x98,
+	0xd4, 0x41, 0xc7, 0x61, 0x8d, 0xff, 0xca, 0xc0, 0x92, 0x6c, 0xd8, 0x4b, 0x39, 0x01, 0xef, 0x40,
+	0x19, 0x9f, 0x59, 0x7e, 0xa7, 0xe7, 0x98, 0x98, 0x27, 0xea, 0x74, 0x6c, 0x04, 0x78, 0xdb, 0x31,
+	0xa5, 0x0d, 0x1d, 0xc2, 0xc4, 0x63, 0x93, 0x9d, 0xe9, 0xd8, 0xc4, 0x35, 0xf6, 0xdc, 0x0c, 0x35,
+	0x76, 0xe5, 0x86, 0x2c, 0x5f, 0xce, 0x86, 0x6c, 0x7c, 0x9a, 0x81, 0x5a, 0xf2, 0x4e, 0xff, 0x62,
+	0xf8, 0x1a, 0xd9, 0x6d, 0x64, 0x67, 0x76, 0x1b, 0xdf, 0x81, 0x45, 0x12, 0x88, 0x1b, 0xbe, 0xcf,
+	0xbf, 0x68, 0xca, 0xd1, 0x58, 0x9a, 0xb9, 0xdd, 0xc0, 0xde, 0x09, 0xe1, 0x92, 0xdb, 0x15, 0xe0,
+	0xa9, 0x33, 0x9a, 0x9f, 0xef, 0x8c, 0x36, 0x7e, 0x94, 0x81, 0xc5, 0x03, 0xc7, 0x7c, 0xcc, 0x62,
+	0x74, 0x1f, 0x9b, 0x5f, 0x3e, 0xdf, 0xdd, 0xa8, 0xc2, 0xa2, 0x14, 0xa4, 0x37, 0x3e, 0x61, 0xfb,
+	0x4c, 0x8e, 0x85, 0xbe, 0x7c, 0xeb, 0xb2, 0x04, 0x0b, 0x62, 0x6e, 0xd1, 0x68, 0x41, 0x35, 0x91,
+	0x0a, 0x88, 0x13, 0xd0, 0x66, 0x99, 0x40, 0xe3, 0x0e, 0xac, 0xaa, 0x62, 0x64, 0xc1, 0xeb, 0x68,
+	0x33, 0x3c, 0x0c, 0x7e, 0x08, 0xab, 0xaa, 0x58, 0x77, 0xfe, 0xe1, 0x7c, 0x9b, 0x3f, 0xba, 0xf3,
+	0xa8, 0x74, 0x6e, 0xfe, 0x0f, 0xc8, 0xa7, 0x39, 0xe9, 0x18, 0x73, 0x6e, 0x39, 0xff, 0x92, 0x81,
+	0x6a, 0x62, 0x5d, 0x48, 0xdf, 0xf0, 0x28, 0xfc, 0xd1, 0xe1, 0xa9, 0x7d, 0x3e, 0xee, 0x1b, 0x8e,
+	0x70, 0x7b, 0x89, 0x1c, 0x7f, 0x49, 0xc6, 0xc8, 0x72, 0x78, 0xda, 0x5f, 0x50, 0xc8, 0x69, 0x07,
+	0xf6, 0x14, 0x39, 0x14, 0x23, 0x98, 0xa8, 0x38, 0xc3, 0xc5, 0x70, 0x0f, 0x96, 0x39, 0x3f, 0xe9,
+	0x5f, 0xe0, 0xc3, 0x2f, 0xc5, 0x91, 0x6c, 0x8c, 0x4c, 0x8e, 0xbf, 0x9a, 0x40, 0xa1, 0xeb, 0xe4,
+	0x2f, 0x08, 0xd0, 0xde, 0x0d, 0x9e, 0xe8, 0x95, 0xda, 0x31, 0x80, 0xd6, 0x27, 0xf2, 0xa4, 0xa8,
+	0x53, 0x4d, 0x7c, 0x8e, 0x49, 0xaa, 0x81, 0xf4, 0x6f, 0x25, 0xc4, 0x95, 0x19, 0x6a, 0x06, 0x0a,
+	0x93, 0x34, 0x16, 0x39, 0x88, 0x34, 0x4f, 0x46, 0x5f, 0x68, 0xf2, 0xfe, 0x00, 0x76, 0x50, 0x42,
+	0xa0, 0x74, 0x50, 0x42, 0x20, 0x2f, 0xea, 0xfc, 0x19, 0x5c, 0x9b, 0xfa, 0x6d, 0xe6, 0x5c, 0x6f,
+	0xd1, 0x71, 0x75, 0x26, 0x37, 0x57, 0x75, 0xe6, 0x0c, 0xd6, 0xd5, 0x9f, 0x4c, 0x0a, 0xda, 0x33,
+	0x73, 0xf4, 0xbb, 0x64, 0x2f, 0x36, 0x33, 0x9f, 0xfa, 0xdf, 0xb1, 0x7a, 0x56, 0xfc, 0x6d, 0xe2,
+	0xeb, 0x90, 0x27, 0x6f, 0x38, 0x1e, 0xef, 0xf6, 0xa3, 0xfa, 0x28, 0x40, 0xd4, 0x47, 0x01, 0x73,
+	0xad, 0xcc, 0x37, 0xe0, 0x6a, 0xa2, 0x29, 0xa6, 0x73, 0x8a, 0x5d, 0xcf, 0xe2, 0xc1, 0xca, 0x62,
+	0x7b, 0x4d, 0xee, 0x6c, 0x79, 0xc2, 0x90, 0x7c, 0x94, 0xbf, 0x8a, 0x2a, 0x9b, 0xf1, 0x27, 0x9a,
+	0x97, 0x64, 0x17, 0x61, 0x15, 0xf3, 0x33, 0x1c, 0x96, 0x77, 0xc9, 0xd7, 0x83, 0xd4, 0x58, 0x8e,
+	0xcb, 0xcf, 0x26, 0xff, 0x48, 0x90, 0x03, 0xc5, 0x7d, 0x17, 0x01, 0x25, 0xe3, 0xff, 0xa3, 0x06,
+	0x6b, 0xca, 0x4f, 0x3c, 0xe7, 0x6a, 0x76, 0x8a, 0xa7, 0x98, 0xb9, 0x70, 0x8a, 0xc9, 0xd0, 0x23,
+	0x3b, 0x5f, 0xe8, 0xf1, 0xc6, 0xdb, 0x50, 0x0a, 0xbb, 0x46, 0x10, 0x40, 0xe1, 0xd1, 0xd1, 0xee,
+	0xd1, 0xee, 0x9d, 0xda, 0x15, 0x54, 0x81, 0xe2, 0xc1, 0xee, 0x83, 0x3b, 0xf7, 0x1e, 0x7c, 0x58,
+	0xd3, 0xc8, 0x8f, 0xf6, 0xd1, 0x83, 0x07, 0xe4, 0x47, 0xe6, 0x8d, 0xfb, 0xe2, 0xf7, 0x01, 0x3c,
+	0x49, 0x59, 0x80, 0xd2, 0xce, 0x68, 0x44, 0xdd, 0x2e, 0xe3, 0xdd, 0x3d, 0xb5, 0x88, 0x2f, 0xaf,
+	0x69, 0xa8, 0x08, 0xd9, 0x87, 0x0f, 0xf7, 0x6b, 0x19, 0xb4, 0x0a, 0xb5, 0x3b, 0xd8, 0x30, 0x07,
+	0x96, 0x8d, 0xc3, 0x9b, 0xab, 0x96, 0x6d, 0x3d, 0xfb, 0xb7, 0xcf, 0x36, 0xb5, 0x4f, 0x3f, 0xdb,
+	0xd4, 0xfe, 0xf3, 0xb3, 0x4d, 0xed, 0x27, 0x9f, 0x6f, 0x5e, 0xf9, 0xf4, 0xf3, 0xcd, 0x2b, 0xff,
+	0xf1, 0xf9, 0xe6, 0x95, 0x3f, 0x7a, 0xbb, 0x6f, 0xf9, 0x4f, 0x83, 0x6e, 0xb3, 0xe7, 0x0c, 0xf9,
+	0x5f, 0xd1, 0x19, 0xb9, 0x0e, 0xb9, 0x22, 0xf8, 0xaf, 0xed, 0xe4, 0x9f, 0xd7, 0xf9, 0x65, 0xe6,
+	0xc6, 0x0e, 0xfd, 0x79, 0xc0, 0xe8, 0x9a, 0xf7, 0x9c, 0x26, 0x03, 0xd0, 0x3f, 0xa8, 0xe2, 0x75,
+	0x0b, 0xf4, 0x0f, 0xa7, 0xbc, 0xf3, 0xff, 0x03, 0x00, 0x41, 0xe2, 0xde, 0x21, 0x99, 0x47, 0x00,
+	0x00,
 }
 
 func (m *EventSequence) Marshal() (dAtA []byte, err error) {
@@ -4748,6 +5241,98 @@ func (m *EventSequence_Event_JobCancelledDebugInfo) MarshalToSizedBuffer(dAtA []
 	}
 	return len(dAtA) - i, nil
 }
+func (m *EventSequence_Event_UpdateQueuedJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSequence_Event_UpdateQueuedJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UpdateQueuedJob != nil {
+		{
+			size, err := m.UpdateQueuedJob.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventSequence_Event_MoveJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSequence_Event_MoveJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MoveJob != nil {
+		{
+			size, err := m.MoveJob.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventSequence_Event_SuspendJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSequence_Event_SuspendJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SuspendJob != nil {
+		{
+			size, err := m.SuspendJob.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventSequence_Event_ResumeJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSequence_Event_ResumeJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ResumeJob != nil {
+		{
+			size, err := m.ResumeJob.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ResourceUtilisation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5209,6 +5794,78 @@ func (m *KubernetesObject_ConfigMap) MarshalToSizedBuffer(dAtA []byte) (int, err
 	}
 	return len(dAtA) - i, nil
 }
+func (m *KubernetesObject_Secret) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KubernetesObject_Secret) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Secret != nil {
+		{
+			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *KubernetesObject_PersistentVolumeClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KubernetesObject_PersistentVolumeClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PersistentVolumeClaim != nil {
+		{
+			size, err := m.PersistentVolumeClaim.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SecretReference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecretReference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SourceName) > 0 {
+		i -= len(m.SourceName)
+		copy(dAtA[i:], m.SourceName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SourceName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ObjectMeta) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5377,7 +6034,7 @@ func (m *ReprioritiseJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *JobRequeued) Marshal() (dAtA []byte, err error) {
+func (m *UpdateQueuedJob) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5387,55 +6044,262 @@ func (m *JobRequeued) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobRequeued) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateQueuedJob) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobRequeued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateQueuedJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.UpdateSequenceNumber != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.UpdateSequenceNumber))
+	if len(m.PriorityClassName) > 0 {
+		i -= len(m.PriorityClassName)
+		copy(dAtA[i:], m.PriorityClassName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PriorityClassName)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x2a
 	}
-	if m.SchedulingInfo != nil {
-		{
-			size, err := m.SchedulingInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Tolerations) > 0 {
+		for iNdEx := len(m.Tolerations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tolerations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x22
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ReprioritiseJobSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ReprioritiseJobSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
+	if len(m.NodeSelector) > 0 {
+		for k := range m.NodeSelector {
+			v := m.NodeSelector[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintEvents(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvents(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvents(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ContainerResources) > 0 {
+		for k := range m.ContainerResources {
+			v := m.ContainerResources[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintEvents(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvents(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvents(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MoveJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MoveJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MoveJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DestinationJobSetId) > 0 {
+		i -= len(m.DestinationJobSetId)
+		copy(dAtA[i:], m.DestinationJobSetId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DestinationJobSetId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DestinationQueue) > 0 {
+		i -= len(m.DestinationQueue)
+		copy(dAtA[i:], m.DestinationQueue)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DestinationQueue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SuspendJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuspendJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuspendJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResumeJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResumeJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResumeJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobRequeued) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobRequeued) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobRequeued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UpdateSequenceNumber != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.UpdateSequenceNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SchedulingInfo != nil {
+		{
+			size, err := m.SchedulingInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReprioritiseJobSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReprioritiseJobSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
@@ -5545,20 +6409,20 @@ func (m *JobSetFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.States) > 0 {
-		dAtA42 := make([]byte, len(m.States)*10)
-		var j41 int
+		dAtA49 := make([]byte, len(m.States)*10)
+		var j48 int
 		for _, num := range m.States {
 			for num >= 1<<7 {
-				dAtA42[j41] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA49[j48] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j41++
+				j48++
 			}
-			dAtA42[j41] = uint8(num)
-			j41++
+			dAtA49[j48] = uint8(num)
+			j48++
 		}
-		i -= j41
-		copy(dAtA[i:], dAtA42[:j41])
-		i = encodeVarintEvents(dAtA, i, uint64(j41))
+		i -= j48
+		copy(dAtA[i:], dAtA49[:j48])
+		i = encodeVarintEvents(dAtA, i, uint64(j48))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -5593,20 +6457,20 @@ func (m *CancelJobSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if len(m.States) > 0 {
-		dAtA44 := make([]byte, len(m.States)*10)
-		var j43 int
+		dAtA51 := make([]byte, len(m.States)*10)
+		var j50 int
 		for _, num := range m.States {
 			for num >= 1<<7 {
-				dAtA44[j43] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA51[j50] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j43++
+				j50++
 			}
-			dAtA44[j43] = uint8(num)
-			j43++
+			dAtA51[j50] = uint8(num)
+			j50++
 		}
-		i -= j43
-		copy(dAtA[i:], dAtA44[:j43])
-		i = encodeVarintEvents(dAtA, i, uint64(j43))
+		i -= j50
+		copy(dAtA[i:], dAtA51[:j50])
+		i = encodeVarintEvents(dAtA, i, uint64(j50))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -6663,6 +7527,20 @@ func (m *PodError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ContainerLogs) > 0 {
+		for iNdEx := len(m.ContainerLogs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContainerLogs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.DebugMessage) > 0 {
 		i -= len(m.DebugMessage)
 		copy(dAtA[i:], m.DebugMessage)
@@ -6723,6 +7601,43 @@ func (m *PodError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContainerLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContainerLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContainerLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Location) > 0 {
+		i -= len(m.Location)
+		copy(dAtA[i:], m.Location)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Location)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContainerName) > 0 {
+		i -= len(m.ContainerName)
+		copy(dAtA[i:], m.ContainerName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContainerName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ContainerError) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7170,6 +8085,16 @@ func (m *JobRunPreempted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Suspended {
+		i--
+		if m.Suspended {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.PreemptingJobId) > 0 {
 		i -= len(m.PreemptingJobId)
 		copy(dAtA[i:], m.PreemptingJobId)
@@ -7330,6 +8255,11 @@ func (m *JobValidated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SchedulingInfoVersion != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SchedulingInfoVersion))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.JobId) > 0 {
 		i -= len(m.JobId)
 		copy(dAtA[i:], m.JobId)
@@ -7792,17 +8722,65 @@ func (m *EventSequence_Event_JobCancelledDebugInfo) Size() (n int) {
 	}
 	return n
 }
-func (m *ResourceUtilisation) Size() (n int) {
+func (m *EventSequence_Event_UpdateQueuedJob) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ResourceInfo != nil {
-		l = m.ResourceInfo.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if m.UpdateQueuedJob != nil {
+		l = m.UpdateQueuedJob.Size()
+		n += 2 + l + sovEvents(uint64(l))
 	}
-	if len(m.MaxResourcesForPeriod) > 0 {
+	return n
+}
+func (m *EventSequence_Event_MoveJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MoveJob != nil {
+		l = m.MoveJob.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_SuspendJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SuspendJob != nil {
+		l = m.SuspendJob.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_ResumeJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResumeJob != nil {
+		l = m.ResumeJob.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *ResourceUtilisation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResourceInfo != nil {
+		l = m.ResourceInfo.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.MaxResourcesForPeriod) > 0 {
 		for k, v := range m.MaxResourcesForPeriod {
 			_ = k
 			_ = v
@@ -8001,6 +8979,43 @@ func (m *KubernetesObject_ConfigMap) Size() (n int) {
 	}
 	return n
 }
+func (m *KubernetesObject_Secret) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Secret != nil {
+		l = m.Secret.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *KubernetesObject_PersistentVolumeClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PersistentVolumeClaim != nil {
+		l = m.PersistentVolumeClaim.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *SecretReference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *ObjectMeta) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8077,6 +9092,101 @@ func (m *ReprioritiseJob) Size() (n int) {
 	return n
 }
 
+func (m *UpdateQueuedJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.ContainerResources) > 0 {
+		for k, v := range m.ContainerResources {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovEvents(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovEvents(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovEvents(uint64(mapEntrySize))
+		}
+	}
+	if len(m.NodeSelector) > 0 {
+		for k, v := range m.NodeSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEvents(uint64(len(k))) + 1 + len(v) + sovEvents(uint64(len(v)))
+			n += mapEntrySize + 1 + sovEvents(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Tolerations) > 0 {
+		for _, e := range m.Tolerations {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.PriorityClassName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *MoveJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.DestinationQueue)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.DestinationJobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *SuspendJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *ResumeJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *JobRequeued) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8706,6 +9816,29 @@ func (m *PodError) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if len(m.ContainerLogs) > 0 {
+		for _, e := range m.ContainerLogs {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContainerLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContainerName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Location)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -8916,6 +10049,9 @@ func (m *JobRunPreempted) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.Suspended {
+		n += 2
+	}
 	return n
 }
 
@@ -8985,6 +10121,9 @@ func (m *JobValidated) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.SchedulingInfoVersion != 0 {
+		n += 1 + sovEvents(uint64(m.SchedulingInfoVersion))
+	}
 	return n
 }
 
@@ -10157,59 +11296,44 @@ func (m *EventSequence_Event) Unmarshal(dAtA []byte) error {
 			}
 			m.Event = &EventSequence_Event_JobCancelledDebugInfo{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateQueuedJob", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceUtilisation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &UpdateQueuedJob{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceUtilisation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceUtilisation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 3:
+			m.Event = &EventSequence_Event_UpdateQueuedJob{v}
+			iNdEx = postIndex
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MoveJob", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10236,16 +11360,15 @@ func (m *ResourceUtilisation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceInfo == nil {
-				m.ResourceInfo = &KubernetesResourceInfo{}
-			}
-			if err := m.ResourceInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &MoveJob{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Event = &EventSequence_Event_MoveJob{v}
 			iNdEx = postIndex
-		case 4:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxResourcesForPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendJob", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10272,13 +11395,169 @@ func (m *ResourceUtilisation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxResourcesForPeriod == nil {
-				m.MaxResourcesForPeriod = make(map[string]*resource.Quantity)
+			v := &SuspendJob{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue *resource.Quantity
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
+			m.Event = &EventSequence_Event_SuspendJob{v}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ResumeJob{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_ResumeJob{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceUtilisation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceUtilisation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceUtilisation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceInfo == nil {
+				m.ResourceInfo = &KubernetesResourceInfo{}
+			}
+			if err := m.ResourceInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxResourcesForPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxResourcesForPeriod == nil {
+				m.MaxResourcesForPeriod = make(map[string]*resource.Quantity)
+			}
+			var mapkey string
+			var mapvalue *resource.Quantity
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
 				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
@@ -11443,6 +12722,76 @@ func (m *KubernetesObject) Unmarshal(dAtA []byte) error {
 			}
 			m.Object = &KubernetesObject_ConfigMap{v}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SecretReference{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Object = &KubernetesObject_Secret{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistentVolumeClaim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &v11.PersistentVolumeClaimSpec{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Object = &KubernetesObject_PersistentVolumeClaim{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -11464,7 +12813,7 @@ func (m *KubernetesObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
+func (m *SecretReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11487,15 +12836,15 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ObjectMeta: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ObjectMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11523,39 +12872,121 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExecutorId = string(dAtA[iNdEx:postIndex])
+			m.SourceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -11873,7 +13304,776 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PodSpecWithAvoidList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PodSpecWithAvoidList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PodSpecWithAvoidList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PodSpec == nil {
+				m.PodSpec = &v11.PodSpec{}
+			}
+			if err := m.PodSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAvoidlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeAvoidlist = append(m.NodeAvoidlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReprioritiseJob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReprioritiseJob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReprioritiseJob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateQueuedJob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateQueuedJob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateQueuedJob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContainerResources == nil {
+				m.ContainerResources = make(map[string]*v11.ResourceRequirements)
+			}
+			var mapkey string
+			var mapvalue *v11.ResourceRequirements
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvents
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthEvents
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v11.ResourceRequirements{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvents(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ContainerResources[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvents
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthEvents
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvents(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NodeSelector[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tolerations = append(m.Tolerations, &v11.Toleration{})
+			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MoveJob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MoveJob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MoveJob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationJobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationJobSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11896,7 +14096,7 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PodSpecWithAvoidList) Unmarshal(dAtA []byte) error {
+func (m *SuspendJob) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11919,17 +14119,17 @@ func (m *PodSpecWithAvoidList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PodSpecWithAvoidList: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuspendJob: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodSpecWithAvoidList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuspendJob: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11939,31 +14139,27 @@ func (m *PodSpecWithAvoidList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PodSpec == nil {
-				m.PodSpec = &v11.PodSpec{}
-			}
-			if err := m.PodSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeAvoidlist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11991,7 +14187,7 @@ func (m *PodSpecWithAvoidList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeAvoidlist = append(m.NodeAvoidlist, string(dAtA[iNdEx:postIndex]))
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12014,7 +14210,7 @@ func (m *PodSpecWithAvoidList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReprioritiseJob) Unmarshal(dAtA []byte) error {
+func (m *ResumeJob) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12037,32 +14233,13 @@ func (m *ReprioritiseJob) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReprioritiseJob: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResumeJob: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReprioritiseJob: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResumeJob: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
-			}
-			m.Priority = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Priority |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
@@ -15840,6 +18017,154 @@ func (m *PodError) Unmarshal(dAtA []byte) error {
 			}
 			m.DebugMessage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerLogs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerLogs = append(m.ContainerLogs, &ContainerLog{})
+			if err := m.ContainerLogs[len(m.ContainerLogs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerLog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Location = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -17243,6 +19568,26 @@ func (m *JobRunPreempted) Unmarshal(dAtA []byte) error {
 			}
 			m.PreemptingJobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Suspended = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -17686,6 +20031,25 @@ func (m *JobValidated) Unmarshal(dAtA []byte) error {
 			}
 			m.JobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingInfoVersion", wireType)
+			}
+			m.SchedulingInfoVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchedulingInfoVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
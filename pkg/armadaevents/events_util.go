@@ -44,6 +44,14 @@ func (ev *EventSequence_Event) GetEventName() string {
 		return "JobRunAssigned"
 	case *EventSequence_Event_JobValidated:
 		return "JobValidated"
+	case *EventSequence_Event_UpdateQueuedJob:
+		return "UpdateQueuedJob"
+	case *EventSequence_Event_MoveJob:
+		return "MoveJob"
+	case *EventSequence_Event_SuspendJob:
+		return "SuspendJob"
+	case *EventSequence_Event_ResumeJob:
+		return "ResumeJob"
 	case *EventSequence_Event_ReprioritisedJob:
 		return "ReprioritisedJob"
 	case *EventSequence_Event_ResourceUtilisation:
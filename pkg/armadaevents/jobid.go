@@ -44,6 +44,14 @@ func JobIdFromEvent(event *EventSequence_Event) (string, error) {
 		return e.JobRequeued.JobId, nil
 	case *EventSequence_Event_JobValidated:
 		return e.JobValidated.JobId, nil
+	case *EventSequence_Event_UpdateQueuedJob:
+		return e.UpdateQueuedJob.JobId, nil
+	case *EventSequence_Event_MoveJob:
+		return e.MoveJob.JobId, nil
+	case *EventSequence_Event_SuspendJob:
+		return e.SuspendJob.JobId, nil
+	case *EventSequence_Event_ResumeJob:
+		return e.ResumeJob.JobId, nil
 	default:
 		err := errors.WithStack(&armadaerrors.ErrInvalidArgument{
 			Name:    "event.Event",
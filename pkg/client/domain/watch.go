@@ -222,6 +222,12 @@ func updateJobInfo(info *JobInfo, event api.Event) {
 		// TODO
 	case *api.JobReprioritizedEvent:
 		// TODO
+	case *api.JobMovedEvent:
+		// Later events for the job are published in the destination job set.
+		if info.Job != nil {
+			info.Job.Queue = typed.DestinationQueue
+			info.Job.JobSetId = typed.DestinationJobSetId
+		}
 	case *api.JobTerminatedEvent:
 		// NOOP
 	case *api.JobIngressInfoEvent:
@@ -311,6 +317,12 @@ func isLifeCycleEvent(event api.Event) bool {
 		return true
 	case *api.JobReprioritizedEvent:
 		return false
+	case *api.JobMovedEvent:
+		return false
+	case *api.JobSuspendedEvent:
+		return false
+	case *api.JobResumedEvent:
+		return false
 	case *api.JobTerminatedEvent:
 		return false
 	case *api.JobUtilisationEvent:
@@ -0,0 +1,38 @@
+package node
+
+import (
+	"fmt"
+
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+type ReleaseQuarantineAPI func(string, string) error
+
+func ReleaseNodeQuarantine(getConnectionDetails client.ConnectionDetails) ReleaseQuarantineAPI {
+	return func(name, executorName string) error {
+		connectionDetails, err := getConnectionDetails()
+		if err != nil {
+			return fmt.Errorf("failed to obtain api connection details: %s", err)
+		}
+		conn, err := client.CreateApiConnection(connectionDetails)
+		if err != nil {
+			return fmt.Errorf("failed to connect to api because %s", err)
+		}
+		defer conn.Close()
+
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		nodeClient := api.NewNodeClient(conn)
+		newReleaseNodeQuarantine := &api.NodeQuarantineReleaseRequest{
+			Name:     name,
+			Executor: executorName,
+		}
+		if _, err = nodeClient.ReleaseNodeQuarantine(ctx, newReleaseNodeQuarantine); err != nil {
+			return err
+		}
+		return nil
+	}
+}
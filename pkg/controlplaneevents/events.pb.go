@@ -70,6 +70,7 @@ type Event struct {
 	//	*Event_CancelOnQueue
 	//	*Event_PreemptOnNode
 	//	*Event_CancelOnNode
+	//	*Event_ReleaseNodeQuarantine
 	Event isEvent_Event `protobuf_oneof:"event"`
 }
 
@@ -136,6 +137,9 @@ type Event_PreemptOnNode struct {
 type Event_CancelOnNode struct {
 	CancelOnNode *CancelOnNode `protobuf:"bytes,9,opt,name=cancelOnNode,proto3,oneof" json:"cancelOnNode,omitempty"`
 }
+type Event_ReleaseNodeQuarantine struct {
+	ReleaseNodeQuarantine *ReleaseNodeQuarantine `protobuf:"bytes,10,opt,name=releaseNodeQuarantine,proto3,oneof" json:"releaseNodeQuarantine,omitempty"`
+}
 
 func (*Event_ExecutorSettingsUpsert) isEvent_Event() {}
 func (*Event_ExecutorSettingsDelete) isEvent_Event() {}
@@ -145,6 +149,7 @@ func (*Event_PreemptOnQueue) isEvent_Event()         {}
 func (*Event_CancelOnQueue) isEvent_Event()          {}
 func (*Event_PreemptOnNode) isEvent_Event()          {}
 func (*Event_CancelOnNode) isEvent_Event()           {}
+func (*Event_ReleaseNodeQuarantine) isEvent_Event()  {}
 
 func (m *Event) GetEvent() isEvent_Event {
 	if m != nil {
@@ -216,6 +221,13 @@ func (m *Event) GetCancelOnNode() *CancelOnNode {
 	return nil
 }
 
+func (m *Event) GetReleaseNodeQuarantine() *ReleaseNodeQuarantine {
+	if x, ok := m.GetEvent().(*Event_ReleaseNodeQuarantine); ok {
+		return x.ReleaseNodeQuarantine
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Event) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -227,6 +239,7 @@ func (*Event) XXX_OneofWrappers() []interface{} {
 		(*Event_CancelOnQueue)(nil),
 		(*Event_PreemptOnNode)(nil),
 		(*Event_CancelOnNode)(nil),
+		(*Event_ReleaseNodeQuarantine)(nil),
 	}
 }
 
@@ -616,6 +629,59 @@ func (m *CancelOnNode) GetPriorityClasses() []string {
 	return nil
 }
 
+// The named node on the executor should be released from quarantine.
+type ReleaseNodeQuarantine struct {
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Executor string `protobuf:"bytes,2,opt,name=executor,proto3" json:"executor,omitempty"`
+}
+
+func (m *ReleaseNodeQuarantine) Reset()         { *m = ReleaseNodeQuarantine{} }
+func (m *ReleaseNodeQuarantine) String() string { return proto.CompactTextString(m) }
+func (*ReleaseNodeQuarantine) ProtoMessage()    {}
+func (*ReleaseNodeQuarantine) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ccee8bdbf348752, []int{7}
+}
+func (m *ReleaseNodeQuarantine) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseNodeQuarantine) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseNodeQuarantine.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReleaseNodeQuarantine) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseNodeQuarantine.Merge(m, src)
+}
+func (m *ReleaseNodeQuarantine) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseNodeQuarantine) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseNodeQuarantine.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseNodeQuarantine proto.InternalMessageInfo
+
+func (m *ReleaseNodeQuarantine) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ReleaseNodeQuarantine) GetExecutor() string {
+	if m != nil {
+		return m.Executor
+	}
+	return ""
+}
+
 type PreemptOnQueue struct {
 	Name            string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	PriorityClasses []string `protobuf:"bytes,2,rep,name=priorityClasses,proto3" json:"priorityClasses,omitempty"`
@@ -627,7 +693,7 @@ func (m *PreemptOnQueue) Reset()         { *m = PreemptOnQueue{} }
 func (m *PreemptOnQueue) String() string { return proto.CompactTextString(m) }
 func (*PreemptOnQueue) ProtoMessage()    {}
 func (*PreemptOnQueue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2ccee8bdbf348752, []int{7}
+	return fileDescriptor_2ccee8bdbf348752, []int{8}
 }
 func (m *PreemptOnQueue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -689,7 +755,7 @@ func (m *CancelOnQueue) Reset()         { *m = CancelOnQueue{} }
 func (m *CancelOnQueue) String() string { return proto.CompactTextString(m) }
 func (*CancelOnQueue) ProtoMessage()    {}
 func (*CancelOnQueue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2ccee8bdbf348752, []int{8}
+	return fileDescriptor_2ccee8bdbf348752, []int{9}
 }
 func (m *CancelOnQueue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -755,6 +821,7 @@ func init() {
 	proto.RegisterType((*CancelOnExecutor)(nil), "controlplaneevents.CancelOnExecutor")
 	proto.RegisterType((*PreemptOnNode)(nil), "controlplaneevents.PreemptOnNode")
 	proto.RegisterType((*CancelOnNode)(nil), "controlplaneevents.CancelOnNode")
+	proto.RegisterType((*ReleaseNodeQuarantine)(nil), "controlplaneevents.ReleaseNodeQuarantine")
 	proto.RegisterType((*PreemptOnQueue)(nil), "controlplaneevents.PreemptOnQueue")
 	proto.RegisterType((*CancelOnQueue)(nil), "controlplaneevents.CancelOnQueue")
 }
@@ -764,61 +831,64 @@ func init() {
 }
 
 var fileDescriptor_2ccee8bdbf348752 = []byte{
-	// 849 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x96, 0x4f, 0x6f, 0xfb, 0x34,
-	0x18, 0xc7, 0x9b, 0xfe, 0x8f, 0x7f, 0x5b, 0xd7, 0x79, 0xd0, 0x85, 0x0e, 0x9a, 0xd2, 0x0d, 0x34,
-	0xa6, 0x29, 0x95, 0x86, 0xe0, 0x88, 0x58, 0xd6, 0x6a, 0x8c, 0xa1, 0xac, 0xeb, 0xa8, 0x10, 0xdc,
-	0xd2, 0xd4, 0x2b, 0x1d, 0x6d, 0x1c, 0x12, 0xb7, 0x62, 0x67, 0x24, 0x0e, 0x9c, 0x78, 0x1d, 0x88,
-	0x17, 0xc2, 0x71, 0x47, 0x0e, 0x10, 0x41, 0x77, 0x0b, 0x6f, 0x02, 0xc5, 0x6e, 0xba, 0xb8, 0xc9,
-	0x4a, 0x37, 0x24, 0x0e, 0x3b, 0x35, 0xb6, 0xbf, 0xdf, 0xe7, 0xf3, 0x3c, 0xae, 0x1f, 0x27, 0x60,
-	0xd7, 0xfa, 0xa6, 0x5f, 0x37, 0xb0, 0x49, 0x6c, 0x3c, 0xb4, 0x86, 0xba, 0x89, 0xd0, 0x04, 0x99,
-	0xc4, 0xa9, 0xb3, 0x1f, 0xc5, 0xb2, 0x31, 0xc1, 0x10, 0x46, 0x05, 0x65, 0xb9, 0x8f, 0x71, 0x7f,
-	0x88, 0xea, 0x54, 0xd1, 0x1d, 0x5f, 0xd7, 0xc9, 0x60, 0x84, 0x1c, 0xa2, 0x8f, 0x2c, 0x66, 0xaa,
-	0x7d, 0x9f, 0x07, 0x99, 0xa6, 0xaf, 0x85, 0xe7, 0x20, 0x67, 0xd8, 0x48, 0x27, 0xa8, 0x27, 0x09,
-	0x55, 0x61, 0xff, 0xd5, 0x51, 0x59, 0x61, 0x66, 0x25, 0x30, 0x2b, 0x9f, 0x07, 0x66, 0xf5, 0x75,
-	0xcf, 0x95, 0x37, 0x67, 0xf2, 0x43, 0x3c, 0x1a, 0x10, 0x34, 0xb2, 0xc8, 0x6d, 0x3b, 0x88, 0x00,
-	0x7f, 0x14, 0x40, 0x09, 0x7d, 0x87, 0x8c, 0x31, 0xc1, 0xf6, 0x15, 0x22, 0x64, 0x60, 0xf6, 0x9d,
-	0x8e, 0xe5, 0x20, 0x9b, 0x48, 0x49, 0x1a, 0xfc, 0x40, 0x89, 0x66, 0xab, 0x34, 0x63, 0x1d, 0xea,
-	0x9e, 0xe7, 0xca, 0xd5, 0xf8, 0x68, 0x0f, 0xec, 0x4f, 0x12, 0xed, 0x47, 0x88, 0xb1, 0xc9, 0x34,
-	0xd0, 0x10, 0x11, 0x24, 0xa5, 0x56, 0x4f, 0x86, 0x39, 0xe2, 0x93, 0x61, 0x6b, 0xcb, 0x93, 0x61,
-	0x1a, 0x38, 0x01, 0x9b, 0x96, 0x8d, 0x7c, 0xd5, 0x85, 0x19, 0x20, 0xa4, 0x34, 0x4d, 0xe3, 0x9d,
-	0xb8, 0x34, 0x5a, 0x8b, 0x62, 0x55, 0xf6, 0x5c, 0x79, 0x27, 0x12, 0x83, 0x83, 0x47, 0x11, 0xd0,
-	0x06, 0x45, 0x43, 0x37, 0x0d, 0x34, 0x0c, 0x61, 0x33, 0x14, 0xbb, 0x17, 0x87, 0x3d, 0x59, 0xd0,
-	0xaa, 0x15, 0xcf, 0x95, 0xcb, 0x8b, 0x11, 0x38, 0x68, 0x24, 0x3e, 0xbc, 0x01, 0x85, 0x79, 0x22,
-	0x97, 0x63, 0x34, 0x46, 0x52, 0x96, 0x12, 0x6b, 0x4b, 0x0b, 0xa5, 0x4a, 0xf5, 0x4d, 0xcf, 0x95,
-	0x25, 0xde, 0xcd, 0xd1, 0x16, 0x22, 0xc3, 0x6b, 0xb0, 0x1e, 0xf0, 0x19, 0x2a, 0x47, 0x51, 0x6f,
-	0x2f, 0x2b, 0x8e, 0x91, 0x76, 0x3c, 0x57, 0xde, 0xe6, 0xbc, 0x1c, 0x88, 0x0f, 0xeb, 0x73, 0xe6,
-	0x64, 0x0d, 0xf7, 0x90, 0x94, 0x7f, 0x9c, 0xd3, 0x0a, 0x0b, 0x19, 0x87, 0xf3, 0xf2, 0x1c, 0x6e,
-	0x09, 0x76, 0xc1, 0x5a, 0x00, 0xa6, 0x18, 0x91, 0x62, 0xaa, 0xcb, 0xca, 0xa1, 0x94, 0xb2, 0xe7,
-	0xca, 0xa5, 0xb0, 0x93, 0x83, 0x70, 0x31, 0xd5, 0x1c, 0xc8, 0xd0, 0x10, 0xb5, 0xbf, 0x05, 0x50,
-	0x8a, 0x6f, 0x3e, 0xf8, 0x2e, 0x48, 0x9b, 0xfa, 0x08, 0xd1, 0x3b, 0x41, 0x54, 0xa1, 0xe7, 0xca,
-	0x05, 0x7f, 0x1c, 0x6a, 0x7a, 0xba, 0x0e, 0x8f, 0x40, 0xde, 0xc0, 0x76, 0x0f, 0x9b, 0xa8, 0x47,
-	0x5b, 0x3c, 0xaf, 0x96, 0x3c, 0x57, 0x86, 0xc1, 0x5c, 0x48, 0x3f, 0xd7, 0xc1, 0x8f, 0xc0, 0x1a,
-	0x7b, 0x6e, 0x23, 0xdd, 0xc1, 0x26, 0xed, 0x46, 0x71, 0x56, 0x41, 0x68, 0x3e, 0xe4, 0xe5, 0xf4,
-	0xf0, 0x03, 0x20, 0x3a, 0x88, 0xa8, 0xb7, 0x1d, 0x07, 0xb1, 0x1e, 0x12, 0xd5, 0x6d, 0xcf, 0x95,
-	0xb7, 0xe6, 0x93, 0x21, 0xe7, 0x83, 0xb2, 0xf6, 0x71, 0xb4, 0xd8, 0x59, 0x73, 0xae, 0x58, 0x6c,
-	0xed, 0x0f, 0x01, 0x6c, 0x46, 0x1a, 0x73, 0xe5, 0xad, 0x3a, 0x04, 0xd9, 0x6f, 0xfd, 0xb3, 0xe4,
-	0x48, 0xc9, 0x6a, 0x6a, 0x5f, 0x54, 0x5f, 0xf3, 0x5c, 0xb9, 0xc8, 0x66, 0x42, 0xda, 0x99, 0x06,
-	0x9e, 0x82, 0x0d, 0xcb, 0x1e, 0x60, 0x7b, 0x40, 0x6e, 0x4f, 0x86, 0xba, 0xe3, 0x20, 0x47, 0x4a,
-	0x51, 0xdb, 0x5b, 0x9e, 0x2b, 0xbf, 0xb1, 0xb0, 0x14, 0xf2, 0x2f, 0xba, 0xe0, 0x7b, 0x20, 0x63,
-	0x61, 0x3c, 0x74, 0xa4, 0x34, 0xb5, 0x6f, 0x79, 0xae, 0xbc, 0x41, 0x27, 0x42, 0x26, 0xa6, 0xa8,
-	0xfd, 0x2e, 0x80, 0xe2, 0xe2, 0x0d, 0xf0, 0x82, 0xca, 0x9b, 0x0a, 0x60, 0x9d, 0xeb, 0xcd, 0xa7,
-	0x9c, 0xf2, 0xe0, 0x5e, 0xa7, 0xa7, 0x5c, 0x64, 0xa7, 0x1c, 0x45, 0xee, 0xc3, 0xf6, 0x5c, 0x17,
-	0xda, 0x8f, 0xd4, 0xf3, 0xf6, 0x23, 0xfd, 0x9c, 0xfd, 0xa8, 0xfd, 0x25, 0x80, 0xb5, 0xf0, 0xcd,
-	0xf0, 0x12, 0x6b, 0xfc, 0x45, 0x00, 0x05, 0xfe, 0xbd, 0xb1, 0x72, 0x95, 0x31, 0x39, 0x24, 0xff,
-	0xdb, 0xb9, 0x4b, 0xfd, 0xeb, 0xb9, 0xfb, 0x21, 0x09, 0xd6, 0xb9, 0x77, 0xcf, 0xff, 0x9f, 0xed,
-	0x97, 0x40, 0xbc, 0xc1, 0xdd, 0x2b, 0xa2, 0x93, 0xd9, 0x7f, 0x55, 0x88, 0x7f, 0x1b, 0x1f, 0x1b,
-	0x64, 0x30, 0x41, 0x9f, 0xce, 0xa4, 0xec, 0x5a, 0x9d, 0x1b, 0xc3, 0xd7, 0xea, 0x7c, 0xf2, 0x09,
-	0x0d, 0x78, 0x70, 0x01, 0x0a, 0x3c, 0x00, 0xbe, 0x02, 0xb9, 0x8e, 0x76, 0xae, 0x5d, 0x7c, 0xa1,
-	0x15, 0x13, 0x10, 0x80, 0xec, 0x65, 0xa7, 0xd9, 0x69, 0x36, 0x8a, 0x82, 0xff, 0xfc, 0x59, 0xf3,
-	0xf8, 0xaa, 0xd9, 0x28, 0x26, 0x7d, 0x51, 0xab, 0xa9, 0x35, 0xce, 0xb4, 0xd3, 0x62, 0xca, 0x1f,
-	0xb4, 0x3b, 0x9a, 0xe6, 0x0f, 0xd2, 0xea, 0xe4, 0xd7, 0x69, 0x45, 0xb8, 0x9b, 0x56, 0x84, 0x3f,
-	0xa7, 0x15, 0xe1, 0xa7, 0xfb, 0x4a, 0xe2, 0xee, 0xbe, 0x92, 0xf8, 0xed, 0xbe, 0x92, 0xf8, 0xea,
-	0xc3, 0xfe, 0x80, 0x7c, 0x3d, 0xee, 0x2a, 0x06, 0x1e, 0xd5, 0x75, 0x7b, 0xa4, 0xf7, 0x74, 0xcb,
-	0xc6, 0x37, 0xc8, 0x20, 0xb3, 0x51, 0x3d, 0xfe, 0xd3, 0xfa, 0xe7, 0xe4, 0xee, 0x31, 0x5d, 0x6f,
-	0x31, 0xb5, 0x72, 0x86, 0x95, 0x13, 0xa6, 0x6a, 0xf9, 0x2a, 0xfa, 0xcd, 0xec, 0x74, 0xb3, 0xf4,
-	0xdb, 0xf8, 0xfd, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x16, 0x2e, 0xa9, 0xde, 0xa1, 0x0b, 0x00,
-	0x00,
+	// 898 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x97, 0xcf, 0x73, 0xdb, 0x44,
+	0x14, 0xc7, 0x2d, 0xdb, 0xf9, 0xa1, 0xd7, 0xc4, 0x75, 0xb6, 0x24, 0x15, 0x29, 0x78, 0x83, 0x53,
+	0x98, 0xb6, 0xd3, 0x91, 0x67, 0xca, 0xc0, 0x91, 0x21, 0x4a, 0x3c, 0xa5, 0x94, 0x71, 0x1c, 0x07,
+	0x0f, 0x03, 0x37, 0x59, 0x7e, 0x35, 0x0e, 0xb2, 0x56, 0x48, 0xeb, 0x0c, 0xf9, 0x07, 0x7a, 0x60,
+	0x38, 0xf0, 0x77, 0x30, 0xfc, 0x21, 0x1c, 0x7b, 0xe4, 0x00, 0x1a, 0x48, 0x6e, 0xe2, 0x9f, 0x60,
+	0xb4, 0x6b, 0xb9, 0x5a, 0x4b, 0x0d, 0x69, 0x19, 0x38, 0xf4, 0x14, 0xef, 0xee, 0xf7, 0xfb, 0x3e,
+	0xef, 0xed, 0x3c, 0x3d, 0x45, 0xb0, 0xeb, 0x7f, 0x33, 0x6a, 0x39, 0xcc, 0xe3, 0x01, 0x73, 0x7d,
+	0xd7, 0xf6, 0x10, 0x4f, 0xd1, 0xe3, 0x61, 0x4b, 0xfe, 0x31, 0xfd, 0x80, 0x71, 0x46, 0x48, 0x5e,
+	0xb0, 0x4d, 0x47, 0x8c, 0x8d, 0x5c, 0x6c, 0x09, 0xc5, 0x60, 0xfa, 0xa4, 0xc5, 0xc7, 0x13, 0x0c,
+	0xb9, 0x3d, 0xf1, 0xa5, 0xa9, 0xf9, 0x83, 0x0e, 0x4b, 0xed, 0x44, 0x4b, 0x1e, 0xc3, 0x8a, 0x13,
+	0xa0, 0xcd, 0x71, 0x68, 0x68, 0x3b, 0xda, 0x9d, 0x6b, 0x0f, 0xb6, 0x4d, 0x69, 0x36, 0x53, 0xb3,
+	0xf9, 0x79, 0x6a, 0xb6, 0x36, 0xe3, 0x88, 0x6e, 0xcc, 0xe4, 0xf7, 0xd9, 0x64, 0xcc, 0x71, 0xe2,
+	0xf3, 0xb3, 0x5e, 0x1a, 0x81, 0x7c, 0xaf, 0xc1, 0x16, 0x7e, 0x87, 0xce, 0x94, 0xb3, 0xe0, 0x18,
+	0x39, 0x1f, 0x7b, 0xa3, 0xb0, 0xef, 0x87, 0x18, 0x70, 0xa3, 0x2c, 0x82, 0xdf, 0x33, 0xf3, 0xd9,
+	0x9a, 0xed, 0x42, 0x87, 0x75, 0x3b, 0x8e, 0xe8, 0x4e, 0x71, 0xb4, 0xe7, 0xec, 0x4f, 0x4a, 0xbd,
+	0x17, 0x10, 0x0b, 0x93, 0x39, 0x40, 0x17, 0x39, 0x1a, 0x95, 0xab, 0x27, 0x23, 0x1d, 0xc5, 0xc9,
+	0xc8, 0xb3, 0xcb, 0x93, 0x91, 0x1a, 0x72, 0x0a, 0x1b, 0x7e, 0x80, 0x89, 0xea, 0xd0, 0x4b, 0x11,
+	0x46, 0x55, 0xa4, 0xf1, 0x6e, 0x51, 0x1a, 0xdd, 0x45, 0xb1, 0x45, 0xe3, 0x88, 0xde, 0xca, 0xc5,
+	0x50, 0xe0, 0x79, 0x04, 0x09, 0xa0, 0xee, 0xd8, 0x9e, 0x83, 0x6e, 0x06, 0xbb, 0x24, 0xb0, 0xb7,
+	0x8b, 0xb0, 0xfb, 0x0b, 0x5a, 0xab, 0x11, 0x47, 0x74, 0x7b, 0x31, 0x82, 0x02, 0xcd, 0xc5, 0x27,
+	0x27, 0x50, 0x9b, 0x27, 0x72, 0x34, 0xc5, 0x29, 0x1a, 0xcb, 0x82, 0xd8, 0xbc, 0xb4, 0x50, 0xa1,
+	0xb4, 0xde, 0x8a, 0x23, 0x6a, 0xa8, 0x6e, 0x85, 0xb6, 0x10, 0x99, 0x3c, 0x81, 0xf5, 0x94, 0x2f,
+	0x51, 0x2b, 0x02, 0xf5, 0xce, 0x65, 0xc5, 0x49, 0xd2, 0xad, 0x38, 0xa2, 0x37, 0x15, 0xaf, 0x02,
+	0x52, 0xc3, 0x26, 0x9c, 0x39, 0xb9, 0xc3, 0x86, 0x68, 0xac, 0xbe, 0x98, 0xd3, 0xcd, 0x0a, 0x25,
+	0x47, 0xf1, 0xaa, 0x1c, 0xe5, 0x88, 0x0c, 0x60, 0x2d, 0x05, 0x0b, 0x8c, 0x2e, 0x30, 0x3b, 0x97,
+	0x95, 0x23, 0x28, 0xdb, 0x71, 0x44, 0xb7, 0xb2, 0x4e, 0x05, 0xa2, 0xc4, 0x24, 0x4f, 0x35, 0xd8,
+	0x0c, 0xd0, 0x45, 0x3b, 0xc4, 0x64, 0x7d, 0x34, 0xb5, 0x03, 0xdb, 0xe3, 0x63, 0x0f, 0x0d, 0x10,
+	0xb4, 0xbb, 0x45, 0xb4, 0x5e, 0x91, 0xc1, 0xda, 0x8d, 0x23, 0x4a, 0x0b, 0x63, 0x29, 0xfc, 0x62,
+	0x9c, 0xb5, 0x02, 0x4b, 0x22, 0x7a, 0xf3, 0x2f, 0x0d, 0xb6, 0x8a, 0xa7, 0x00, 0x79, 0x0f, 0xaa,
+	0x9e, 0x3d, 0x41, 0x31, 0x9c, 0x74, 0x8b, 0xc4, 0x11, 0xad, 0x25, 0xeb, 0xcc, 0xf4, 0x11, 0xe7,
+	0xe4, 0x01, 0xac, 0x3a, 0x2c, 0x18, 0x32, 0x0f, 0x87, 0x62, 0xd6, 0xac, 0x5a, 0x5b, 0x71, 0x44,
+	0x49, 0xba, 0x97, 0xd1, 0xcf, 0x75, 0xe4, 0x23, 0x58, 0x93, 0xbf, 0x7b, 0x68, 0x87, 0xcc, 0x13,
+	0x63, 0x41, 0x9f, 0x5d, 0x65, 0x66, 0x3f, 0xe3, 0x55, 0xf4, 0xe4, 0x03, 0xd0, 0x43, 0xe4, 0xd6,
+	0x59, 0x3f, 0x44, 0xf9, 0x30, 0xeb, 0xd6, 0xcd, 0x38, 0xa2, 0x37, 0xe6, 0x9b, 0x19, 0xe7, 0x73,
+	0x65, 0xf3, 0xe3, 0x7c, 0xb1, 0xb3, 0x29, 0x71, 0xc5, 0x62, 0x9b, 0xbf, 0x6b, 0xb0, 0x91, 0x9b,
+	0x10, 0x57, 0xbe, 0xaa, 0xfb, 0xb0, 0xfc, 0x6d, 0xd2, 0xd4, 0xa1, 0x51, 0xde, 0xa9, 0xdc, 0xd1,
+	0xad, 0x37, 0xe2, 0x88, 0xd6, 0xe5, 0x4e, 0x46, 0x3b, 0xd3, 0x90, 0x87, 0x70, 0xdd, 0x0f, 0xc6,
+	0x2c, 0x18, 0xf3, 0xb3, 0x7d, 0xd7, 0x0e, 0x43, 0x0c, 0x8d, 0x8a, 0xb0, 0xbd, 0x1d, 0x47, 0xf4,
+	0xcd, 0x85, 0xa3, 0x8c, 0x7f, 0xd1, 0x45, 0xee, 0xc2, 0x92, 0xcf, 0x98, 0x1b, 0x1a, 0x55, 0x61,
+	0xbf, 0x11, 0x47, 0xf4, 0xba, 0xd8, 0xc8, 0x98, 0xa4, 0xa2, 0xf9, 0x9b, 0x06, 0xf5, 0xc5, 0x51,
+	0xf4, 0x1a, 0x95, 0x77, 0xae, 0xc1, 0xba, 0x32, 0x24, 0x5e, 0xa6, 0xcb, 0xd3, 0x17, 0x8c, 0xe8,
+	0x72, 0x5d, 0x76, 0x39, 0xe6, 0x06, 0x73, 0x6f, 0xae, 0xcb, 0xdc, 0x47, 0xe5, 0xd5, 0xee, 0xa3,
+	0xfa, 0x2a, 0xf7, 0xd1, 0xfc, 0x53, 0x83, 0xb5, 0xec, 0x88, 0x7a, 0x1d, 0x6b, 0x0c, 0x61, 0xb3,
+	0x70, 0x2e, 0xfe, 0x97, 0xb5, 0x36, 0x7f, 0xd6, 0xa0, 0xa6, 0xbe, 0x35, 0xaf, 0x8c, 0x2b, 0x28,
+	0xbc, 0xfc, 0xef, 0x9a, 0xbd, 0xf2, 0x8f, 0xcd, 0xfe, 0xb4, 0x0c, 0xeb, 0xca, 0x9b, 0xf7, 0xff,
+	0xcf, 0xf6, 0x4b, 0xd0, 0x4f, 0xd8, 0xe0, 0x98, 0xdb, 0x7c, 0xd6, 0x20, 0xb5, 0xe2, 0xff, 0x45,
+	0xf6, 0x1c, 0x3e, 0x3e, 0xc5, 0x4f, 0x67, 0x52, 0x39, 0xcb, 0xe7, 0xc6, 0xec, 0x2c, 0x9f, 0x6f,
+	0xbe, 0xc4, 0x53, 0x7f, 0xef, 0x10, 0x6a, 0x2a, 0x80, 0x5c, 0x83, 0x95, 0x7e, 0xe7, 0x71, 0xe7,
+	0xf0, 0x8b, 0x4e, 0xbd, 0x44, 0x00, 0x96, 0x8f, 0xfa, 0xed, 0x7e, 0xfb, 0xa0, 0xae, 0x25, 0xbf,
+	0x3f, 0x6b, 0xef, 0x1d, 0xb7, 0x0f, 0xea, 0xe5, 0x44, 0xd4, 0x6d, 0x77, 0x0e, 0x1e, 0x75, 0x1e,
+	0xd6, 0x2b, 0xc9, 0xa2, 0xd7, 0xef, 0x74, 0x92, 0x45, 0xd5, 0x3a, 0xfd, 0xe5, 0xbc, 0xa1, 0x3d,
+	0x3b, 0x6f, 0x68, 0x7f, 0x9c, 0x37, 0xb4, 0x1f, 0x2f, 0x1a, 0xa5, 0x67, 0x17, 0x8d, 0xd2, 0xaf,
+	0x17, 0x8d, 0xd2, 0x57, 0x1f, 0x8e, 0xc6, 0xfc, 0xeb, 0xe9, 0xc0, 0x74, 0xd8, 0xa4, 0x65, 0x07,
+	0x13, 0x7b, 0x68, 0xfb, 0x01, 0x3b, 0x41, 0x87, 0xcf, 0x56, 0xad, 0xe2, 0x0f, 0x8b, 0x9f, 0xca,
+	0xbb, 0x7b, 0xe2, 0xbc, 0x2b, 0xd5, 0xe6, 0x23, 0x66, 0xee, 0x4b, 0x55, 0x37, 0x51, 0x89, 0x2f,
+	0x86, 0x70, 0xb0, 0x2c, 0xbe, 0x0c, 0xde, 0xff, 0x7b, 0x00, 0xfb, 0x1c, 0xca, 0x9d, 0x9f, 0x0c,
+	0x00, 0x00,
 }
 
 func (m *Event) Marshal() (dAtA []byte, err error) {
@@ -1033,6 +1103,27 @@ func (m *Event_CancelOnNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Event_ReleaseNodeQuarantine) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event_ReleaseNodeQuarantine) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ReleaseNodeQuarantine != nil {
+		{
+			size, err := m.ReleaseNodeQuarantine.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ExecutorSettingsUpsert) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1341,6 +1432,43 @@ func (m *CancelOnNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReleaseNodeQuarantine) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseNodeQuarantine) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseNodeQuarantine) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PreemptOnQueue) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1419,20 +1547,20 @@ func (m *CancelOnQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.JobStates) > 0 {
-		dAtA11 := make([]byte, len(m.JobStates)*10)
-		var j10 int
+		dAtA12 := make([]byte, len(m.JobStates)*10)
+		var j11 int
 		for _, num := range m.JobStates {
 			for num >= 1<<7 {
-				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j10++
+				j11++
 			}
-			dAtA11[j10] = uint8(num)
-			j10++
+			dAtA12[j11] = uint8(num)
+			j11++
 		}
-		i -= j10
-		copy(dAtA[i:], dAtA11[:j10])
-		i = encodeVarintEvents(dAtA, i, uint64(j10))
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintEvents(dAtA, i, uint64(j11))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1578,6 +1706,18 @@ func (m *Event_CancelOnNode) Size() (n int) {
 	}
 	return n
 }
+func (m *Event_ReleaseNodeQuarantine) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReleaseNodeQuarantine != nil {
+		l = m.ReleaseNodeQuarantine.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
 func (m *ExecutorSettingsUpsert) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1735,6 +1875,23 @@ func (m *CancelOnNode) Size() (n int) {
 	return n
 }
 
+func (m *ReleaseNodeQuarantine) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *PreemptOnQueue) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2143,6 +2300,41 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 			}
 			m.Event = &Event_CancelOnNode{v}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseNodeQuarantine", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ReleaseNodeQuarantine{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &Event_ReleaseNodeQuarantine{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3124,6 +3316,120 @@ func (m *CancelOnNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ReleaseNodeQuarantine) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseNodeQuarantine: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseNodeQuarantine: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PreemptOnQueue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -57,6 +57,8 @@ type NodeInfo struct {
 	Pool string `protobuf:"bytes,13,opt,name=pool,proto3" json:"pool,omitempty"`
 	// Replaces resource_usage_by_queue
 	ResourceUsageByQueueAndPool []*PoolQueueResource `protobuf:"bytes,14,rep,name=resource_usage_by_queue_and_pool,json=resourceUsageByQueueAndPool,proto3" json:"resourceUsageByQueueAndPool,omitempty"`
+	// Accelerator resources, e.g., GPUs, included in total_resources that are unhealthy, so shouldn't be scheduled onto.
+	UnhealthyResources map[string]*resource.Quantity `protobuf:"bytes,15,rep,name=unhealthy_resources,json=unhealthyResources,proto3" json:"unhealthyResources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *NodeInfo) Reset()         { *m = NodeInfo{} }
@@ -176,6 +178,13 @@ func (m *NodeInfo) GetResourceUsageByQueueAndPool() []*PoolQueueResource {
 	return nil
 }
 
+func (m *NodeInfo) GetUnhealthyResources() map[string]*resource.Quantity {
+	if m != nil {
+		return m.UnhealthyResources
+	}
+	return nil
+}
+
 type ComputeResource struct {
 	Resources map[string]*resource.Quantity `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
@@ -342,6 +351,9 @@ type LeaseRequest struct {
 	MaxJobsToLease uint32 `protobuf:"varint,7,opt,name=max_jobs_to_lease,json=maxJobsToLease,proto3" json:"maxJobsToLease,omitempty"`
 	// Run Ids of jobs owned by the executor but not currently assigned to a node.
 	UnassignedJobRunIds []string `protobuf:"bytes,8,rep,name=unassigned_job_run_ids,json=unassignedJobRunIds,proto3" json:"unassignedJobRunIds,omitempty"`
+	// Requests to release nodes from quarantine that the executor has acted on, either by releasing the node or by
+	// finding the request doesn't apply. The scheduler stops sending these requests.
+	ProcessedNodeQuarantineReleases []*NodeQuarantineRelease `protobuf:"bytes,9,rep,name=processed_node_quarantine_releases,json=processedNodeQuarantineReleases,proto3" json:"processedNodeQuarantineReleases,omitempty"`
 }
 
 func (m *LeaseRequest) Reset()         { *m = LeaseRequest{} }
@@ -426,6 +438,13 @@ func (m *LeaseRequest) GetUnassignedJobRunIds() []string {
 	return nil
 }
 
+func (m *LeaseRequest) GetProcessedNodeQuarantineReleases() []*NodeQuarantineRelease {
+	if m != nil {
+		return m.ProcessedNodeQuarantineReleases
+	}
+	return nil
+}
+
 // Indicates that a job run is now leased.
 type JobRunLease struct {
 	Queue    string                  `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
@@ -434,6 +453,8 @@ type JobRunLease struct {
 	Groups   []string                `protobuf:"bytes,5,rep,name=groups,proto3" json:"groups,omitempty"`
 	Job      *armadaevents.SubmitJob `protobuf:"bytes,6,opt,name=job,proto3" json:"job,omitempty"`
 	JobRunId string                  `protobuf:"bytes,7,opt,name=job_run_id,json=jobRunId,proto3" json:"jobRunId,omitempty"`
+	// Labels of the job's queue when the run was leased.
+	QueueLabels map[string]string `protobuf:"bytes,8,rep,name=queue_labels,json=queueLabels,proto3" json:"queueLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *JobRunLease) Reset()         { *m = JobRunLease{} }
@@ -511,6 +532,13 @@ func (m *JobRunLease) GetJobRunId() string {
 	return ""
 }
 
+func (m *JobRunLease) GetQueueLabels() map[string]string {
+	if m != nil {
+		return m.QueueLabels
+	}
+	return nil
+}
+
 // Indicates that the job runs with the given ids should be cancelled.
 type CancelRuns struct {
 	JobRunIdsToCancel []string `protobuf:"bytes,2,rep,name=job_run_ids_to_cancel,json=jobRunIdsToCancel,proto3" json:"jobRunIdsToCancel,omitempty"`
@@ -638,12 +666,112 @@ func (m *EndMarker) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EndMarker proto.InternalMessageInfo
 
+// A request to release a node from quarantine.
+// The executor only releases the node if it was quarantined before the request was made.
+type NodeQuarantineRelease struct {
+	NodeName    string           `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"nodeName,omitempty"`
+	RequestedAt *types.Timestamp `protobuf:"bytes,2,opt,name=requested_at,json=requestedAt,proto3" json:"requestedAt,omitempty"`
+}
+
+func (m *NodeQuarantineRelease) Reset()         { *m = NodeQuarantineRelease{} }
+func (m *NodeQuarantineRelease) String() string { return proto.CompactTextString(m) }
+func (*NodeQuarantineRelease) ProtoMessage()    {}
+func (*NodeQuarantineRelease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_57e0d9d0e484e459, []int{9}
+}
+func (m *NodeQuarantineRelease) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeQuarantineRelease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeQuarantineRelease.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeQuarantineRelease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeQuarantineRelease.Merge(m, src)
+}
+func (m *NodeQuarantineRelease) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeQuarantineRelease) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeQuarantineRelease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeQuarantineRelease proto.InternalMessageInfo
+
+func (m *NodeQuarantineRelease) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
+}
+
+func (m *NodeQuarantineRelease) GetRequestedAt() *types.Timestamp {
+	if m != nil {
+		return m.RequestedAt
+	}
+	return nil
+}
+
+// Indicates that the given nodes should be released from quarantine.
+type ReleaseNodeQuarantines struct {
+	Releases []*NodeQuarantineRelease `protobuf:"bytes,1,rep,name=releases,proto3" json:"releases,omitempty"`
+}
+
+func (m *ReleaseNodeQuarantines) Reset()         { *m = ReleaseNodeQuarantines{} }
+func (m *ReleaseNodeQuarantines) String() string { return proto.CompactTextString(m) }
+func (*ReleaseNodeQuarantines) ProtoMessage()    {}
+func (*ReleaseNodeQuarantines) Descriptor() ([]byte, []int) {
+	return fileDescriptor_57e0d9d0e484e459, []int{10}
+}
+func (m *ReleaseNodeQuarantines) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseNodeQuarantines) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseNodeQuarantines.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReleaseNodeQuarantines) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseNodeQuarantines.Merge(m, src)
+}
+func (m *ReleaseNodeQuarantines) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseNodeQuarantines) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseNodeQuarantines.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseNodeQuarantines proto.InternalMessageInfo
+
+func (m *ReleaseNodeQuarantines) GetReleases() []*NodeQuarantineRelease {
+	if m != nil {
+		return m.Releases
+	}
+	return nil
+}
+
 type LeaseStreamMessage struct {
 	// Types that are valid to be assigned to Event:
 	//	*LeaseStreamMessage_Lease
 	//	*LeaseStreamMessage_CancelRuns
 	//	*LeaseStreamMessage_End
 	//	*LeaseStreamMessage_PreemptRuns
+	//	*LeaseStreamMessage_ReleaseNodeQuarantines
 	Event isLeaseStreamMessage_Event `protobuf_oneof:"event"`
 }
 
@@ -651,7 +779,7 @@ func (m *LeaseStreamMessage) Reset()         { *m = LeaseStreamMessage{} }
 func (m *LeaseStreamMessage) String() string { return proto.CompactTextString(m) }
 func (*LeaseStreamMessage) ProtoMessage()    {}
 func (*LeaseStreamMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_57e0d9d0e484e459, []int{9}
+	return fileDescriptor_57e0d9d0e484e459, []int{11}
 }
 func (m *LeaseStreamMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -698,11 +826,15 @@ type LeaseStreamMessage_End struct {
 type LeaseStreamMessage_PreemptRuns struct {
 	PreemptRuns *PreemptRuns `protobuf:"bytes,4,opt,name=preempt_runs,json=preemptRuns,proto3,oneof" json:"preemptRuns,omitempty"`
 }
+type LeaseStreamMessage_ReleaseNodeQuarantines struct {
+	ReleaseNodeQuarantines *ReleaseNodeQuarantines `protobuf:"bytes,5,opt,name=release_node_quarantines,json=releaseNodeQuarantines,proto3,oneof" json:"releaseNodeQuarantines,omitempty"`
+}
 
-func (*LeaseStreamMessage_Lease) isLeaseStreamMessage_Event()       {}
-func (*LeaseStreamMessage_CancelRuns) isLeaseStreamMessage_Event()  {}
-func (*LeaseStreamMessage_End) isLeaseStreamMessage_Event()         {}
-func (*LeaseStreamMessage_PreemptRuns) isLeaseStreamMessage_Event() {}
+func (*LeaseStreamMessage_Lease) isLeaseStreamMessage_Event()                  {}
+func (*LeaseStreamMessage_CancelRuns) isLeaseStreamMessage_Event()             {}
+func (*LeaseStreamMessage_End) isLeaseStreamMessage_Event()                    {}
+func (*LeaseStreamMessage_PreemptRuns) isLeaseStreamMessage_Event()            {}
+func (*LeaseStreamMessage_ReleaseNodeQuarantines) isLeaseStreamMessage_Event() {}
 
 func (m *LeaseStreamMessage) GetEvent() isLeaseStreamMessage_Event {
 	if m != nil {
@@ -739,6 +871,13 @@ func (m *LeaseStreamMessage) GetPreemptRuns() *PreemptRuns {
 	return nil
 }
 
+func (m *LeaseStreamMessage) GetReleaseNodeQuarantines() *ReleaseNodeQuarantines {
+	if x, ok := m.GetEvent().(*LeaseStreamMessage_ReleaseNodeQuarantines); ok {
+		return x.ReleaseNodeQuarantines
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*LeaseStreamMessage) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -746,6 +885,7 @@ func (*LeaseStreamMessage) XXX_OneofWrappers() []interface{} {
 		(*LeaseStreamMessage_CancelRuns)(nil),
 		(*LeaseStreamMessage_End)(nil),
 		(*LeaseStreamMessage_PreemptRuns)(nil),
+		(*LeaseStreamMessage_ReleaseNodeQuarantines)(nil),
 	}
 }
 
@@ -757,6 +897,7 @@ func init() {
 	proto.RegisterMapType((map[int32]*ComputeResource)(nil), "executorapi.NodeInfo.NonArmadaAllocatedResourcesEntry")
 	proto.RegisterMapType((map[string]api.JobState)(nil), "executorapi.NodeInfo.RunIdsByStateEntry")
 	proto.RegisterMapType((map[string]*resource.Quantity)(nil), "executorapi.NodeInfo.TotalResourcesEntry")
+	proto.RegisterMapType((map[string]*resource.Quantity)(nil), "executorapi.NodeInfo.UnhealthyResourcesEntry")
 	proto.RegisterType((*ComputeResource)(nil), "executorapi.ComputeResource")
 	proto.RegisterMapType((map[string]*resource.Quantity)(nil), "executorapi.ComputeResource.ResourcesEntry")
 	proto.RegisterType((*PoolQueueResource)(nil), "executorapi.PoolQueueResource")
@@ -766,112 +907,129 @@ func init() {
 	proto.RegisterMapType((map[string]*resource.Quantity)(nil), "executorapi.LeaseRequest.MinimumJobSizeEntry")
 	proto.RegisterMapType((map[string]*resource.Quantity)(nil), "executorapi.LeaseRequest.ResourcesEntry")
 	proto.RegisterType((*JobRunLease)(nil), "executorapi.JobRunLease")
+	proto.RegisterMapType((map[string]string)(nil), "executorapi.JobRunLease.QueueLabelsEntry")
 	proto.RegisterType((*CancelRuns)(nil), "executorapi.CancelRuns")
 	proto.RegisterType((*PreemptRuns)(nil), "executorapi.PreemptRuns")
 	proto.RegisterType((*EndMarker)(nil), "executorapi.EndMarker")
+	proto.RegisterType((*NodeQuarantineRelease)(nil), "executorapi.NodeQuarantineRelease")
+	proto.RegisterType((*ReleaseNodeQuarantines)(nil), "executorapi.ReleaseNodeQuarantines")
 	proto.RegisterType((*LeaseStreamMessage)(nil), "executorapi.LeaseStreamMessage")
 }
 
 func init() { proto.RegisterFile("pkg/executorapi/executorapi.proto", fileDescriptor_57e0d9d0e484e459) }
 
 var fileDescriptor_57e0d9d0e484e459 = []byte{
-	// 1526 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x3b, 0x6f, 0xdc, 0xc6,
-	0x16, 0x16, 0x57, 0xd2, 0x5a, 0x3b, 0xab, 0xe7, 0xe8, 0x45, 0x49, 0xf6, 0x52, 0xde, 0x8b, 0x7b,
-	0x21, 0xe1, 0xda, 0xdc, 0x6b, 0xd9, 0xb8, 0x70, 0x82, 0x24, 0x88, 0xd6, 0x10, 0x1c, 0x09, 0xb6,
-	0x63, 0x4b, 0x8a, 0x91, 0xa4, 0x21, 0x86, 0xcb, 0xf1, 0x9a, 0x12, 0x39, 0x43, 0x93, 0x43, 0xc5,
-	0xeb, 0x2a, 0x65, 0x8a, 0x14, 0x2e, 0xd2, 0xa4, 0x30, 0xd2, 0xa5, 0xce, 0xcf, 0x48, 0x69, 0x20,
-	0x4d, 0x2a, 0x26, 0xb0, 0x3a, 0xf6, 0xe9, 0x83, 0x99, 0x21, 0xb5, 0xc3, 0x7d, 0x58, 0x4e, 0x2a,
-	0x21, 0x95, 0x34, 0xe7, 0xf5, 0x7d, 0x73, 0xce, 0x9c, 0xb3, 0x67, 0x17, 0x5c, 0x0d, 0x8e, 0xdb,
-	0x0d, 0xfc, 0x1c, 0xb7, 0x62, 0x46, 0x43, 0x14, 0xb8, 0xea, 0xff, 0x66, 0x10, 0x52, 0x46, 0x61,
-	0x55, 0x11, 0xad, 0x5e, 0xe1, 0xf6, 0x28, 0xf4, 0x91, 0x83, 0xf0, 0x09, 0x26, 0x2c, 0x6a, 0xc8,
-	0x3f, 0xd2, 0x76, 0x75, 0x41, 0xa8, 0x03, 0xb7, 0x11, 0xc5, 0xb6, 0xef, 0xb2, 0x4c, 0xba, 0xd6,
-	0xa6, 0xb4, 0xed, 0xe1, 0x86, 0x38, 0xd9, 0xf1, 0x93, 0x06, 0xf6, 0x03, 0xd6, 0xc9, 0x94, 0xf5,
-	0xe3, 0xdb, 0x91, 0xe9, 0x52, 0xe1, 0xd5, 0xa2, 0x21, 0x6e, 0x9c, 0xdc, 0x68, 0xb4, 0x31, 0xc1,
-	0x21, 0x62, 0xd8, 0xc9, 0x6c, 0x6e, 0x75, 0x6d, 0x7c, 0xd4, 0x7a, 0xea, 0x12, 0x1c, 0x76, 0x1a,
-	0x39, 0x54, 0x88, 0x23, 0x1a, 0x87, 0x2d, 0xdc, 0xeb, 0x55, 0xff, 0x63, 0x06, 0x4c, 0x3c, 0xa0,
-	0x0e, 0xde, 0x25, 0x4f, 0x28, 0xfc, 0x0f, 0x18, 0x23, 0xc8, 0xc7, 0xba, 0xb6, 0xae, 0x6d, 0x54,
-	0x9a, 0x30, 0x4d, 0x8c, 0x69, 0x7e, 0xbe, 0x46, 0x7d, 0x97, 0x09, 0x3a, 0xfb, 0x42, 0x0f, 0xef,
-	0x82, 0x32, 0x43, 0x2e, 0x61, 0x91, 0x5e, 0x5a, 0x1f, 0xdd, 0xa8, 0x6e, 0xad, 0x98, 0x12, 0xdb,
-	0xe4, 0x09, 0xe1, 0xfc, 0xcc, 0x93, 0x1b, 0xe6, 0x21, 0xb7, 0x68, 0x2e, 0xa4, 0x89, 0x31, 0x2b,
-	0x8d, 0x95, 0x30, 0x99, 0x3b, 0xfc, 0x14, 0x94, 0x3d, 0x64, 0x63, 0x2f, 0xd2, 0x47, 0x45, 0xa0,
-	0xab, 0xa6, 0x9a, 0xda, 0x9c, 0x97, 0x79, 0x4f, 0xd8, 0xec, 0x10, 0x16, 0x76, 0x64, 0x40, 0xe9,
-	0xa4, 0x06, 0x94, 0x12, 0xf8, 0x8d, 0x06, 0x16, 0x91, 0xe7, 0xd1, 0x16, 0x62, 0xc8, 0xf6, 0xb0,
-	0x95, 0xdf, 0x3b, 0xd2, 0xc7, 0x04, 0x40, 0x63, 0x30, 0xc0, 0x76, 0xd7, 0x65, 0x3f, 0xf7, 0x90,
-	0x70, 0xf5, 0x34, 0x31, 0x6a, 0x68, 0x80, 0x5a, 0x01, 0x5f, 0x18, 0xa4, 0x87, 0x5f, 0x6b, 0x60,
-	0x1e, 0x9d, 0x20, 0xd7, 0xeb, 0x21, 0x32, 0x2e, 0x88, 0x5c, 0x1f, 0x42, 0x24, 0x77, 0xe8, 0xa1,
-	0xb1, 0x9e, 0x26, 0xc6, 0x65, 0xd4, 0xa7, 0x54, 0x48, 0xc0, 0x7e, 0x2d, 0x0c, 0xc0, 0x0c, 0xa3,
-	0x0c, 0x79, 0x0a, 0x7a, 0x59, 0xa0, 0x6f, 0x0e, 0x46, 0x3f, 0xe4, 0xc6, 0x3d, 0xc8, 0x97, 0xd3,
-	0xc4, 0xd0, 0x59, 0x41, 0xa1, 0xa0, 0x4e, 0x17, 0x35, 0x90, 0x80, 0xd9, 0x30, 0x26, 0x96, 0xeb,
-	0x44, 0x96, 0xdd, 0xb1, 0x22, 0x86, 0x18, 0xd6, 0x27, 0x04, 0xe4, 0xc6, 0x60, 0xc8, 0xfd, 0x98,
-	0xec, 0x3a, 0x51, 0xb3, 0x73, 0xc0, 0x4d, 0x25, 0xe2, 0x5a, 0x9a, 0x18, 0xcb, 0xa1, 0x2a, 0x57,
-	0x00, 0xa7, 0x0a, 0x0a, 0xf8, 0xa3, 0x06, 0x6a, 0x84, 0x12, 0x4b, 0x76, 0x9b, 0x95, 0x15, 0x02,
-	0x3b, 0xca, 0x8d, 0x2b, 0x02, 0xfe, 0xff, 0x83, 0xe1, 0x1f, 0x50, 0xb2, 0x2d, 0x5c, 0xb7, 0x73,
-	0xcf, 0x9e, 0xeb, 0x6f, 0xa6, 0x89, 0xf1, 0x6f, 0x32, 0xdc, 0x4a, 0xa1, 0xb6, 0xf6, 0x16, 0x33,
-	0xb8, 0x0d, 0xa6, 0x62, 0x12, 0xb5, 0x9e, 0x62, 0x27, 0x16, 0x45, 0xd2, 0xc1, 0xba, 0xb6, 0x31,
-	0x21, 0xef, 0x5a, 0x50, 0xa8, 0x77, 0x2d, 0x28, 0xe0, 0x4d, 0x50, 0x21, 0xd4, 0xc1, 0x16, 0xeb,
-	0x04, 0x58, 0x9f, 0x14, 0x2d, 0xba, 0x94, 0x26, 0x06, 0xe4, 0xc2, 0xc3, 0x4e, 0xa0, 0x7a, 0x4e,
-	0xe4, 0x32, 0xde, 0xd2, 0x01, 0xa5, 0x9e, 0x3e, 0xd5, 0x6d, 0x69, 0x7e, 0x56, 0x5b, 0x9a, 0x9f,
-	0xe1, 0x4b, 0x0d, 0xac, 0xe7, 0x39, 0xb3, 0xe2, 0x08, 0xb5, 0x31, 0x2f, 0xe0, 0xb3, 0x18, 0xc7,
-	0xd8, 0x42, 0xc4, 0xb1, 0x44, 0x90, 0x69, 0x91, 0xca, 0x5a, 0x21, 0x95, 0x0f, 0x29, 0xf5, 0x1e,
-	0x71, 0xb3, 0xfc, 0xae, 0x32, 0x65, 0x79, 0xac, 0xcf, 0x78, 0xa8, 0x66, 0x47, 0x58, 0x6c, 0x13,
-	0xe7, 0x61, 0x11, 0x7b, 0xed, 0x2d, 0x66, 0xab, 0x08, 0x54, 0x95, 0xc6, 0x87, 0xff, 0x02, 0xa3,
-	0xc7, 0xb8, 0x93, 0xcd, 0xa6, 0xb9, 0x34, 0x31, 0xa6, 0x8e, 0x71, 0x47, 0x89, 0xc5, 0xb5, 0x70,
-	0x13, 0x8c, 0x9f, 0x20, 0x2f, 0xc6, 0x7a, 0x49, 0x98, 0xcd, 0xa7, 0x89, 0x31, 0x23, 0x04, 0x8a,
-	0xa1, 0xb4, 0x78, 0xbf, 0x74, 0x5b, 0x5b, 0xfd, 0x41, 0x03, 0x2b, 0x43, 0x7b, 0xff, 0xdd, 0x10,
-	0xbf, 0x50, 0x11, 0xab, 0x5b, 0xa6, 0x32, 0x0a, 0xcf, 0xc6, 0xb0, 0x19, 0x1c, 0xb7, 0xc5, 0x6c,
-	0xcc, 0xaf, 0x6b, 0x3e, 0x8a, 0x11, 0x61, 0x2e, 0xeb, 0x9c, 0xcb, 0xf0, 0x95, 0x06, 0x96, 0x87,
-	0x0c, 0x85, 0x0b, 0xc1, 0xef, 0x7b, 0x0d, 0xcc, 0x0f, 0x18, 0x1b, 0x17, 0x82, 0xdb, 0x57, 0x00,
-	0xf6, 0x8f, 0x97, 0x77, 0x63, 0x76, 0x5b, 0x65, 0x36, 0xbd, 0x35, 0x25, 0x18, 0xec, 0x51, 0x5b,
-	0xc4, 0x39, 0x17, 0xf8, 0x3b, 0x0d, 0xac, 0x9f, 0x37, 0x59, 0x54, 0x1e, 0xe3, 0x43, 0x79, 0xdc,
-	0x2d, 0x66, 0xe8, 0x72, 0xa1, 0xf5, 0xee, 0x50, 0x3f, 0x88, 0x59, 0xb7, 0xf1, 0xce, 0xa1, 0xb5,
-	0x37, 0x36, 0x71, 0x69, 0x76, 0x62, 0x6f, 0x6c, 0xa2, 0x3a, 0x3b, 0x59, 0xff, 0xb6, 0x04, 0x66,
-	0x7a, 0xfc, 0xa1, 0x0d, 0x2a, 0xdd, 0xb1, 0xa9, 0x89, 0x5e, 0xff, 0xef, 0xdb, 0x00, 0xcd, 0x9e,
-	0x59, 0xb9, 0x9c, 0x26, 0xc6, 0x7c, 0x38, 0x60, 0x32, 0x76, 0xc3, 0xf2, 0xd4, 0x4c, 0x5f, 0xbc,
-	0xa7, 0x52, 0x3f, 0x2d, 0x81, 0xb9, 0xbe, 0x49, 0x76, 0x36, 0x3c, 0xb5, 0x73, 0x86, 0xe7, 0x26,
-	0x18, 0x17, 0x93, 0x52, 0x9d, 0x3a, 0x42, 0xa0, 0x82, 0x09, 0x01, 0x74, 0xd4, 0x1c, 0x8f, 0x0e,
-	0x58, 0x05, 0xfa, 0x58, 0xfc, 0x83, 0xb2, 0xfc, 0x18, 0x54, 0x76, 0xf8, 0x26, 0x7c, 0xcf, 0x8d,
-	0x18, 0xdc, 0x05, 0x65, 0xb9, 0x16, 0x67, 0x4f, 0x6d, 0xcd, 0x54, 0x57, 0x66, 0x53, 0x18, 0x1e,
-	0xe0, 0x67, 0x31, 0x26, 0x2d, 0x2c, 0xb7, 0x3e, 0xa9, 0x51, 0xb7, 0x3e, 0x29, 0xa9, 0xff, 0x56,
-	0x06, 0x93, 0xf7, 0x30, 0x8a, 0xf0, 0x3e, 0xb7, 0x8f, 0x18, 0x7c, 0x0f, 0x9c, 0x2d, 0xe4, 0x96,
-	0xeb, 0x64, 0x97, 0xd6, 0xd3, 0xc4, 0x58, 0xc8, 0xc5, 0xbb, 0x8e, 0x12, 0x07, 0x74, 0xa5, 0x67,
-	0x35, 0x2f, 0x9d, 0x53, 0x73, 0xab, 0xbf, 0x90, 0xc5, 0x15, 0x47, 0x25, 0xf4, 0x37, 0x6a, 0x08,
-	0x63, 0x30, 0xeb, 0xbb, 0xc4, 0xf5, 0x63, 0xdf, 0x3a, 0xa2, 0xb6, 0x15, 0xb9, 0x2f, 0x70, 0xb6,
-	0xc4, 0x5e, 0x1f, 0x8e, 0x73, 0x5f, 0x7a, 0xf0, 0x49, 0xe5, 0xbe, 0xc0, 0xca, 0x06, 0xe7, 0x17,
-	0x14, 0xea, 0x06, 0x57, 0xd4, 0xc0, 0x8f, 0xc1, 0x38, 0x5f, 0x1e, 0xf2, 0x3d, 0x75, 0x71, 0xe0,
-	0xde, 0x24, 0x2b, 0x2d, 0xec, 0xd4, 0x4a, 0x0b, 0x01, 0xbc, 0x0b, 0xe6, 0x7c, 0xf4, 0x9c, 0x93,
-	0x8e, 0x2c, 0x46, 0x2d, 0x8f, 0xf3, 0xd3, 0x2f, 0xad, 0x6b, 0x1b, 0x53, 0x19, 0x15, 0xf4, 0x7c,
-	0x8f, 0xda, 0xd1, 0x21, 0x15, 0xcc, 0x0b, 0x54, 0x0a, 0x1a, 0xf8, 0x18, 0x2c, 0xc5, 0x04, 0x45,
-	0x91, 0xdb, 0x26, 0xd8, 0x11, 0x49, 0xc8, 0x76, 0x4b, 0xb1, 0x52, 0x56, 0x9a, 0x57, 0xd3, 0xc4,
-	0xb8, 0xd2, 0xb5, 0xd8, 0xa3, 0xb6, 0x1c, 0xf7, 0x4a, 0xc8, 0xf9, 0x01, 0xea, 0x0b, 0xda, 0x1d,
-	0xe2, 0xa3, 0x74, 0x40, 0xfd, 0x2e, 0x44, 0xe7, 0xfe, 0x54, 0x02, 0x55, 0x99, 0x40, 0x59, 0x9a,
-	0xbf, 0x30, 0xf1, 0xae, 0x81, 0x32, 0x7f, 0x0a, 0x98, 0xe9, 0xa3, 0xc2, 0x56, 0xb4, 0xb2, 0x94,
-	0xa8, 0xad, 0x2c, 0x25, 0xbc, 0xfd, 0xe2, 0x08, 0x87, 0xfa, 0x58, 0xb7, 0xfd, 0xf8, 0x59, 0x6d,
-	0x3f, 0x7e, 0xe6, 0x51, 0xdb, 0x21, 0x8d, 0x03, 0xf9, 0x4e, 0xb3, 0xa8, 0x52, 0xa2, 0x46, 0x95,
-	0x12, 0xf8, 0x01, 0x18, 0x3d, 0xa2, 0xb6, 0x5e, 0x16, 0xb9, 0x59, 0x2e, 0x0e, 0x9a, 0x03, 0xf1,
-	0x2d, 0x7c, 0x8f, 0xda, 0x32, 0xb7, 0x47, 0xd4, 0x56, 0x73, 0x7b, 0x44, 0x6d, 0x78, 0x0b, 0x80,
-	0xee, 0xe3, 0x13, 0x2f, 0x39, 0xdb, 0xbc, 0x8f, 0xb2, 0x27, 0xa5, 0x6e, 0xde, 0xb9, 0xac, 0x6e,
-	0x01, 0x70, 0x07, 0x91, 0x16, 0xf6, 0xf6, 0x63, 0x12, 0xc1, 0x47, 0x60, 0x51, 0x79, 0xc0, 0xbc,
-	0x2f, 0x5a, 0x42, 0x29, 0xbe, 0x41, 0x57, 0x9a, 0x46, 0x9a, 0x18, 0x6b, 0xb9, 0x6b, 0x74, 0x48,
-	0xa5, 0xa7, 0x12, 0x77, 0xae, 0x4f, 0x59, 0x6f, 0x81, 0xea, 0xc3, 0x10, 0x73, 0xb5, 0x40, 0x38,
-	0x04, 0x4b, 0x3d, 0x08, 0x81, 0xd4, 0x66, 0x10, 0xe2, 0x2b, 0xa4, 0x12, 0x25, 0xf3, 0x55, 0xbf,
-	0x42, 0xf6, 0x6b, 0xeb, 0x55, 0x50, 0xd9, 0x21, 0xce, 0x7d, 0x14, 0x1e, 0xe3, 0xb0, 0xfe, 0x4b,
-	0x09, 0x40, 0x51, 0xff, 0x03, 0x16, 0x62, 0xe4, 0xdf, 0xc7, 0x11, 0x5f, 0xdb, 0xe1, 0x0e, 0x18,
-	0x97, 0x4d, 0xae, 0x89, 0xfc, 0xea, 0x85, 0x91, 0xa1, 0xbc, 0x1a, 0xf9, 0x4c, 0xbc, 0x62, 0xd7,
-	0x7f, 0x32, 0xb2, 0x2f, 0xbd, 0xe1, 0x21, 0xa8, 0xca, 0x9c, 0xf0, 0x3b, 0x44, 0xd9, 0x43, 0x5e,
-	0x2e, 0x2e, 0x20, 0x67, 0x09, 0x95, 0xd3, 0xbc, 0x75, 0x76, 0x2e, 0x04, 0x04, 0x5d, 0x39, 0xfc,
-	0x10, 0x8c, 0x62, 0xe2, 0x88, 0xb7, 0x57, 0xdd, 0x5a, 0x2a, 0x44, 0x3b, 0xbb, 0x98, 0xac, 0x3c,
-	0x26, 0x4e, 0x21, 0x0a, 0xf7, 0x83, 0x9f, 0x83, 0xc9, 0x2c, 0x8d, 0x92, 0xd5, 0xd8, 0x80, 0x2b,
-	0x2a, 0x55, 0x68, 0xae, 0xa4, 0x89, 0xb1, 0x18, 0x74, 0x05, 0x85, 0x88, 0x55, 0x45, 0xd1, 0xbc,
-	0x04, 0xc6, 0xc5, 0x0b, 0xdc, 0x7a, 0xa5, 0x81, 0xea, 0x4e, 0x16, 0x6e, 0x3b, 0x70, 0xe1, 0x83,
-	0xec, 0xc3, 0x4c, 0x66, 0x2e, 0x82, 0x2b, 0x43, 0xc7, 0xfd, 0xaa, 0xd1, 0xaf, 0x2a, 0x94, 0x66,
-	0x43, 0xfb, 0x9f, 0x06, 0x3f, 0x02, 0x93, 0xfb, 0x38, 0xa0, 0x21, 0x13, 0x1f, 0xa9, 0x11, 0xec,
-	0x49, 0x42, 0xfe, 0x81, 0xbc, 0xba, 0x64, 0xca, 0x9f, 0xa0, 0xcc, 0xfc, 0x27, 0x28, 0x73, 0x87,
-	0xf3, 0x6e, 0xee, 0xfe, 0xfc, 0xa6, 0xa6, 0xbd, 0x7e, 0x53, 0xd3, 0x7e, 0x7f, 0x53, 0xd3, 0x5e,
-	0x9e, 0xd6, 0x46, 0x5e, 0x9f, 0xd6, 0x46, 0x7e, 0x3d, 0xad, 0x8d, 0x7c, 0xd9, 0x68, 0xbb, 0xec,
-	0x69, 0x6c, 0x9b, 0x2d, 0xea, 0x67, 0xbf, 0x77, 0x05, 0x21, 0x3d, 0xc2, 0x2d, 0x96, 0x9d, 0x1a,
-	0x3d, 0x3f, 0x9c, 0xd9, 0x65, 0x11, 0xfa, 0xe6, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x80, 0x1b,
-	0x8b, 0x62, 0x52, 0x13, 0x00, 0x00,
+	// 1757 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x18, 0x3b, 0x6f, 0xe3, 0xc8,
+	0xd9, 0xb4, 0xfc, 0x90, 0x46, 0x7e, 0x8e, 0x5f, 0xb4, 0xbc, 0x27, 0x6a, 0x75, 0x49, 0x60, 0x23,
+	0x17, 0x2a, 0xe7, 0x3b, 0x04, 0x9b, 0x20, 0x39, 0xc4, 0x3a, 0x18, 0x1b, 0x1b, 0x5e, 0x67, 0x2d,
+	0xeb, 0x16, 0x49, 0x1a, 0x62, 0x28, 0xce, 0xc9, 0xb4, 0x45, 0x0e, 0x4d, 0x0e, 0x9d, 0xd5, 0x56,
+	0x69, 0x02, 0xa4, 0x48, 0xb1, 0x45, 0x8a, 0xa4, 0x58, 0xa4, 0xdb, 0x9f, 0x91, 0x3a, 0xe5, 0x96,
+	0x01, 0x02, 0x10, 0xc1, 0xba, 0xe3, 0xaf, 0x08, 0x66, 0x86, 0x94, 0x86, 0x12, 0x65, 0x3b, 0xa9,
+	0x8c, 0xab, 0x24, 0x7e, 0xef, 0xf9, 0x5e, 0xf3, 0x7d, 0x03, 0x9e, 0x7a, 0x57, 0xdd, 0x06, 0x7e,
+	0x8d, 0x3b, 0x21, 0x25, 0x3e, 0xf2, 0x6c, 0xf9, 0xbf, 0xee, 0xf9, 0x84, 0x12, 0x58, 0x96, 0x40,
+	0x95, 0x4f, 0x18, 0x3d, 0xf2, 0x1d, 0x64, 0x21, 0x7c, 0x83, 0x5d, 0x1a, 0x34, 0xc4, 0x8f, 0xa0,
+	0xad, 0xac, 0x73, 0xb4, 0x67, 0x37, 0x82, 0xd0, 0x74, 0x6c, 0x9a, 0x40, 0x77, 0xba, 0x84, 0x74,
+	0x7b, 0xb8, 0xc1, 0xbf, 0xcc, 0xf0, 0xdb, 0x06, 0x76, 0x3c, 0xda, 0x4f, 0x90, 0xda, 0x28, 0x92,
+	0xda, 0x0e, 0x0e, 0x28, 0x72, 0xbc, 0x84, 0xa0, 0x7e, 0xf5, 0x2c, 0xd0, 0x6d, 0xc2, 0xc5, 0x76,
+	0x88, 0x8f, 0x1b, 0x37, 0x9f, 0x37, 0xba, 0xd8, 0xc5, 0x3e, 0xa2, 0xd8, 0x4a, 0x68, 0xbe, 0x1c,
+	0xd2, 0x38, 0xa8, 0x73, 0x61, 0xbb, 0xd8, 0xef, 0x37, 0x52, 0x5b, 0x7c, 0x1c, 0x90, 0xd0, 0xef,
+	0xe0, 0x51, 0xae, 0xfa, 0x5f, 0x21, 0x28, 0x9e, 0x12, 0x0b, 0x1f, 0xb9, 0xdf, 0x12, 0xf8, 0x03,
+	0x30, 0xe3, 0x22, 0x07, 0xab, 0x4a, 0x4d, 0xd9, 0x2d, 0x35, 0x61, 0x1c, 0x69, 0x4b, 0xec, 0xfb,
+	0x33, 0xe2, 0xd8, 0x94, 0xdb, 0xdb, 0xe2, 0x78, 0xf8, 0x1c, 0xcc, 0x51, 0x64, 0xbb, 0x34, 0x50,
+	0xa7, 0x6b, 0x85, 0xdd, 0xf2, 0xfe, 0xb6, 0x2e, 0x74, 0xeb, 0xcc, 0x63, 0xcc, 0x3e, 0xfd, 0xe6,
+	0x73, 0xbd, 0xcd, 0x28, 0x9a, 0xeb, 0x71, 0xa4, 0xad, 0x08, 0x62, 0x49, 0x4c, 0xc2, 0x0e, 0x7f,
+	0x0d, 0xe6, 0x7a, 0xc8, 0xc4, 0xbd, 0x40, 0x2d, 0x70, 0x41, 0x4f, 0x75, 0xd9, 0xf7, 0xa9, 0x5d,
+	0xfa, 0x09, 0xa7, 0x39, 0x74, 0xa9, 0xdf, 0x17, 0x02, 0x05, 0x93, 0x2c, 0x50, 0x40, 0xe0, 0x9f,
+	0x14, 0xb0, 0x81, 0x7a, 0x3d, 0xd2, 0x41, 0x14, 0x99, 0x3d, 0x6c, 0xa4, 0xe7, 0x0e, 0xd4, 0x19,
+	0xae, 0xa0, 0x91, 0xaf, 0xe0, 0x60, 0xc8, 0xd2, 0x4a, 0x39, 0x84, 0xba, 0x7a, 0x1c, 0x69, 0x55,
+	0x94, 0x83, 0x96, 0x94, 0xaf, 0xe7, 0xe1, 0xe1, 0x1f, 0x14, 0xb0, 0x86, 0x6e, 0x90, 0xdd, 0x1b,
+	0x31, 0x64, 0x96, 0x1b, 0xf2, 0xa3, 0x09, 0x86, 0xa4, 0x0c, 0x23, 0x66, 0xd4, 0xe2, 0x48, 0x7b,
+	0x82, 0xc6, 0x90, 0x92, 0x11, 0x70, 0x1c, 0x0b, 0x3d, 0xb0, 0x4c, 0x09, 0x45, 0x3d, 0x49, 0xfb,
+	0x1c, 0xd7, 0xbe, 0x97, 0xaf, 0xbd, 0xcd, 0x88, 0x47, 0x34, 0x3f, 0x89, 0x23, 0x4d, 0xa5, 0x19,
+	0x84, 0xa4, 0x75, 0x29, 0x8b, 0x81, 0x2e, 0x58, 0xf1, 0x43, 0xd7, 0xb0, 0xad, 0xc0, 0x30, 0xfb,
+	0x46, 0x40, 0x11, 0xc5, 0x6a, 0x91, 0xab, 0xdc, 0xcd, 0x57, 0xd9, 0x0a, 0xdd, 0x23, 0x2b, 0x68,
+	0xf6, 0xcf, 0x19, 0xa9, 0xd0, 0xb8, 0x13, 0x47, 0xda, 0x96, 0x2f, 0xc3, 0x25, 0x85, 0x8b, 0x19,
+	0x04, 0x7c, 0xaf, 0x80, 0xaa, 0x4b, 0x5c, 0x43, 0x94, 0xa3, 0x91, 0x04, 0x02, 0x5b, 0xd2, 0x89,
+	0x4b, 0x5c, 0xfd, 0x4f, 0xf2, 0xd5, 0x9f, 0x12, 0xf7, 0x80, 0xb3, 0x1e, 0xa4, 0x9c, 0x23, 0xc7,
+	0xdf, 0x8b, 0x23, 0xed, 0xfb, 0xee, 0x64, 0x2a, 0xc9, 0xb4, 0x9d, 0x3b, 0xc8, 0xe0, 0x01, 0x58,
+	0x0c, 0xdd, 0xa0, 0x73, 0x81, 0xad, 0x90, 0x07, 0x49, 0x05, 0x35, 0x65, 0xb7, 0x28, 0xce, 0x9a,
+	0x41, 0xc8, 0x67, 0xcd, 0x20, 0xe0, 0x17, 0xa0, 0xe4, 0x12, 0x0b, 0x1b, 0xb4, 0xef, 0x61, 0x75,
+	0x81, 0x97, 0xe8, 0x66, 0x1c, 0x69, 0x90, 0x01, 0xdb, 0x7d, 0x4f, 0xe6, 0x2c, 0xa6, 0x30, 0x56,
+	0xd2, 0x1e, 0x21, 0x3d, 0x75, 0x71, 0x58, 0xd2, 0xec, 0x5b, 0x2e, 0x69, 0xf6, 0x0d, 0xdf, 0x2a,
+	0xa0, 0x96, 0xfa, 0xcc, 0x08, 0x03, 0xd4, 0xc5, 0x2c, 0x80, 0xd7, 0x21, 0x0e, 0xb1, 0x81, 0x5c,
+	0xcb, 0xe0, 0x42, 0x96, 0xb8, 0x2b, 0xab, 0x19, 0x57, 0xbe, 0x24, 0xa4, 0x77, 0xc6, 0xc8, 0xd2,
+	0xb3, 0x0a, 0x97, 0xa5, 0xb2, 0xbe, 0x61, 0xa2, 0x9a, 0x7d, 0x4e, 0x71, 0xe0, 0x5a, 0x2f, 0xb3,
+	0xba, 0x77, 0xee, 0x20, 0xe3, 0x05, 0x14, 0xba, 0x17, 0x18, 0xf5, 0xe8, 0x45, 0x5f, 0x0a, 0xe8,
+	0xf2, 0x5d, 0x05, 0xf4, 0x4d, 0xca, 0x90, 0x57, 0x40, 0xe1, 0x18, 0x52, 0x2e, 0xa0, 0x71, 0x6c,
+	0x05, 0x81, 0xb2, 0xd4, 0x7b, 0xe0, 0xa7, 0xa0, 0x70, 0x85, 0xfb, 0x49, 0x7b, 0x5c, 0x8d, 0x23,
+	0x6d, 0xf1, 0x0a, 0xf7, 0x25, 0x11, 0x0c, 0x0b, 0xf7, 0xc0, 0xec, 0x0d, 0xea, 0x85, 0x58, 0x9d,
+	0xe6, 0x64, 0x6b, 0x71, 0xa4, 0x2d, 0x73, 0x80, 0x44, 0x28, 0x28, 0x7e, 0x36, 0xfd, 0x4c, 0xa9,
+	0xfc, 0x5d, 0x01, 0xdb, 0x13, 0xdb, 0xcf, 0xc3, 0x34, 0xfe, 0x56, 0xd6, 0x58, 0xde, 0xd7, 0xa5,
+	0x6e, 0x3c, 0xb8, 0x09, 0x74, 0xef, 0xaa, 0xcb, 0x00, 0x7a, 0xea, 0x47, 0xfd, 0x2c, 0x44, 0x2e,
+	0xb5, 0x69, 0xff, 0x5e, 0x0b, 0xdf, 0x29, 0x60, 0x6b, 0x42, 0x5f, 0x7a, 0x14, 0xf6, 0xfd, 0x4d,
+	0x01, 0x6b, 0x39, 0x9d, 0xeb, 0x51, 0xd8, 0xf6, 0x7b, 0x00, 0xc7, 0x3b, 0xdc, 0xc3, 0x2c, 0x7b,
+	0x26, 0x5b, 0xb6, 0xb4, 0xbf, 0xc8, 0x2d, 0x38, 0x26, 0x26, 0x97, 0x73, 0xaf, 0xe2, 0xbf, 0x28,
+	0xa0, 0x76, 0x5f, 0x73, 0x93, 0xed, 0x98, 0x9d, 0x68, 0xc7, 0xf3, 0xac, 0x87, 0x9e, 0x64, 0xea,
+	0xee, 0x6b, 0xe2, 0x78, 0x21, 0x1d, 0xd6, 0xfe, 0x43, 0x72, 0x69, 0x42, 0x89, 0x3e, 0x86, 0x78,
+	0x1d, 0xcf, 0x14, 0xe7, 0x57, 0x8a, 0xc7, 0x33, 0xc5, 0xf2, 0xca, 0x42, 0xfd, 0xcf, 0xd3, 0x60,
+	0x79, 0xe4, 0x7c, 0xd0, 0x04, 0xa5, 0x61, 0x23, 0x52, 0x78, 0x23, 0xfa, 0xe1, 0x5d, 0x0e, 0xd1,
+	0x47, 0xda, 0xd0, 0x56, 0x1c, 0x69, 0x6b, 0x7e, 0x4e, 0xf7, 0x19, 0x8a, 0x65, 0xa1, 0x5b, 0x7a,
+	0x7c, 0xae, 0xa9, 0xdf, 0x4e, 0x83, 0xd5, 0xb1, 0x66, 0x3f, 0xb8, 0x5f, 0x94, 0x7b, 0xee, 0x97,
+	0x3d, 0x30, 0xcb, 0x2f, 0x13, 0xb9, 0x2b, 0x72, 0x80, 0xac, 0x8c, 0x03, 0xa0, 0x25, 0xfb, 0xb8,
+	0x90, 0xd3, 0xec, 0xc7, 0xac, 0xf8, 0x0e, 0x79, 0xf9, 0x15, 0x28, 0x1d, 0xb2, 0x6d, 0xe2, 0xc4,
+	0x0e, 0x28, 0x3c, 0x02, 0x73, 0x62, 0xb5, 0x48, 0x52, 0x6d, 0x47, 0x97, 0xd7, 0x0e, 0x9d, 0x13,
+	0x9e, 0xe3, 0xeb, 0x10, 0xbb, 0x1d, 0x2c, 0x06, 0x63, 0x81, 0x91, 0x07, 0x63, 0x01, 0xa9, 0x7f,
+	0x98, 0x07, 0x0b, 0x27, 0x18, 0x05, 0xb8, 0xc5, 0xe8, 0x03, 0x0a, 0x7f, 0x0a, 0x06, 0x4b, 0x8d,
+	0x61, 0x5b, 0xc9, 0xa1, 0xd5, 0x38, 0xd2, 0xd6, 0x53, 0xf0, 0x91, 0x25, 0xc9, 0x01, 0x43, 0xe8,
+	0x20, 0xe6, 0xd3, 0xf7, 0xc4, 0xdc, 0x18, 0x0f, 0x64, 0x76, 0x0a, 0x94, 0x0d, 0xfa, 0x3f, 0x62,
+	0x08, 0x43, 0xb0, 0xe2, 0xd8, 0xae, 0xed, 0x84, 0x8e, 0x71, 0x49, 0x4c, 0x23, 0xb0, 0xdf, 0x60,
+	0x75, 0x26, 0x27, 0x61, 0x32, 0x7a, 0x5e, 0x08, 0x0e, 0xd6, 0x49, 0xed, 0x37, 0x58, 0x1a, 0x72,
+	0x9d, 0x0c, 0x42, 0x1e, 0x72, 0xb3, 0x18, 0xf8, 0x4b, 0x30, 0xcb, 0xe6, 0xab, 0x74, 0x94, 0xdf,
+	0xc8, 0x9d, 0x44, 0x44, 0xa4, 0x39, 0x9d, 0x1c, 0x69, 0x0e, 0x80, 0xcf, 0xc1, 0xaa, 0x83, 0x5e,
+	0x33, 0xa3, 0x03, 0x83, 0x12, 0xa3, 0xc7, 0xec, 0x53, 0xe7, 0x6b, 0xca, 0xee, 0x62, 0x62, 0x0a,
+	0x7a, 0x7d, 0x4c, 0xcc, 0xa0, 0x4d, 0xb8, 0xe5, 0x19, 0x53, 0x32, 0x18, 0xf8, 0x0a, 0x6c, 0x86,
+	0x2e, 0x0a, 0x02, 0xbb, 0xeb, 0x62, 0x8b, 0x3b, 0x21, 0x19, 0xbf, 0xf9, 0xd4, 0x5d, 0x6a, 0x3e,
+	0x8d, 0x23, 0xed, 0x93, 0x21, 0xc5, 0x31, 0x31, 0xc5, 0x75, 0x24, 0x89, 0x5c, 0xcb, 0x41, 0x43,
+	0x02, 0xea, 0x9e, 0x4f, 0x3a, 0x38, 0x08, 0xb0, 0x65, 0xf0, 0xa9, 0xf3, 0x3a, 0x44, 0x3e, 0xcb,
+	0x61, 0x97, 0xed, 0x32, 0xdc, 0xe0, 0x74, 0xb4, 0xae, 0x8f, 0x9d, 0xff, 0x6c, 0x40, 0xdb, 0x12,
+	0xa4, 0x2d, 0x6d, 0x20, 0x2d, 0x17, 0xff, 0x58, 0xcb, 0x91, 0xcf, 0x16, 0x39, 0x09, 0xf3, 0x28,
+	0x5a, 0xc5, 0x3f, 0x0a, 0xa0, 0x2c, 0x22, 0x26, 0x72, 0xe1, 0x7f, 0x68, 0xb1, 0x9f, 0x81, 0x39,
+	0x96, 0x7b, 0x98, 0xaa, 0x05, 0x4e, 0xcb, 0x7b, 0x87, 0x80, 0xc8, 0xbd, 0x43, 0x40, 0x58, 0xbd,
+	0x87, 0x01, 0xf6, 0xd5, 0x99, 0x61, 0xbd, 0xb3, 0x6f, 0xb9, 0xde, 0xd9, 0x37, 0x93, 0xda, 0xf5,
+	0x49, 0xe8, 0x89, 0xc2, 0x48, 0xa4, 0x0a, 0x88, 0x2c, 0x55, 0x40, 0xe0, 0xcf, 0x41, 0xe1, 0x92,
+	0x98, 0xea, 0x1c, 0xf7, 0xcd, 0x56, 0xb6, 0xb3, 0x9d, 0xf3, 0xa7, 0x93, 0x63, 0x62, 0x0a, 0xdf,
+	0x5e, 0x12, 0x53, 0xf6, 0xed, 0x25, 0x31, 0xe1, 0x97, 0x00, 0x0c, 0xb3, 0x5d, 0x9d, 0x1f, 0x6e,
+	0x43, 0x97, 0x49, 0x0e, 0xcb, 0xdb, 0x50, 0x0a, 0x83, 0x27, 0x60, 0x41, 0xac, 0x34, 0xc9, 0xab,
+	0x43, 0x31, 0x67, 0x1b, 0x96, 0x5c, 0xaa, 0xf3, 0x5b, 0x46, 0xda, 0x00, 0x5a, 0xe5, 0xeb, 0x21,
+	0xa4, 0xf2, 0x15, 0x58, 0x19, 0x25, 0x80, 0x2b, 0x52, 0x62, 0x88, 0x2c, 0x58, 0xcf, 0xec, 0x03,
+	0x72, 0x00, 0x0d, 0x00, 0xbe, 0x46, 0x6e, 0x07, 0xf7, 0x5a, 0xa1, 0x1b, 0xc0, 0x33, 0xb0, 0x21,
+	0xd5, 0x2f, 0x6b, 0x0b, 0x1d, 0x8e, 0xe4, 0x6f, 0x2c, 0xa5, 0xa6, 0x16, 0x47, 0xda, 0x4e, 0x7a,
+	0x90, 0xa0, 0x4d, 0x04, 0xa7, 0x74, 0xca, 0xd5, 0x31, 0x64, 0xbd, 0x03, 0xca, 0x2f, 0x7d, 0xcc,
+	0xd0, 0x5c, 0x43, 0x1b, 0x6c, 0x8e, 0x68, 0xf0, 0x04, 0x36, 0x51, 0xc1, 0x77, 0x24, 0x49, 0x4a,
+	0xc2, 0x2b, 0xef, 0x48, 0xe3, 0xd8, 0x7a, 0x19, 0x94, 0x0e, 0x5d, 0xeb, 0x05, 0xf2, 0xaf, 0xb0,
+	0x5f, 0x7f, 0xaf, 0x80, 0x8d, 0xdc, 0x0a, 0x1f, 0x6c, 0xaf, 0xd2, 0x03, 0xd3, 0x60, 0x7b, 0x3d,
+	0x45, 0xce, 0xd8, 0xf6, 0xca, 0x60, 0xf0, 0x37, 0x60, 0xc1, 0x17, 0x6d, 0x1b, 0x5b, 0x06, 0xa2,
+	0x49, 0x21, 0x55, 0x74, 0xf1, 0x5e, 0xa6, 0xa7, 0xef, 0x65, 0x7a, 0x3b, 0x7d, 0x2f, 0x6b, 0x6e,
+	0xc7, 0x91, 0xb6, 0x31, 0xe0, 0x39, 0x90, 0x8d, 0x2f, 0x4b, 0xe0, 0xba, 0x07, 0x36, 0x13, 0xcb,
+	0xb2, 0xe6, 0x06, 0xf0, 0x15, 0x28, 0x0e, 0x1a, 0x9c, 0xf2, 0xd0, 0x06, 0x27, 0xce, 0x92, 0xf2,
+	0xc9, 0x67, 0x49, 0x61, 0xf5, 0x7f, 0x17, 0x00, 0xe4, 0x59, 0x75, 0x4e, 0x7d, 0x8c, 0x9c, 0x17,
+	0x38, 0x60, 0x3b, 0x2f, 0x3c, 0x04, 0xb3, 0xa2, 0xfd, 0x2b, 0xfc, 0x6c, 0xea, 0xa4, 0x5c, 0x14,
+	0xf5, 0xdc, 0xcb, 0xde, 0x07, 0xbf, 0x9a, 0x6a, 0x09, 0x6e, 0xd8, 0x06, 0x65, 0x91, 0x2e, 0x2c,
+	0xbc, 0x41, 0xe2, 0xa8, 0xad, 0xec, 0x68, 0x3a, 0xc8, 0x35, 0x71, 0xcf, 0x77, 0x06, 0xdf, 0x19,
+	0x81, 0x60, 0x08, 0x87, 0xbf, 0x00, 0x05, 0xec, 0x5a, 0xbc, 0x49, 0x94, 0xf7, 0x37, 0x33, 0xd2,
+	0x06, 0x31, 0x17, 0x25, 0x8a, 0x5d, 0x2b, 0x23, 0x85, 0xf1, 0xb1, 0xf0, 0x25, 0x19, 0x26, 0xac,
+	0x9a, 0xc9, 0x39, 0xa2, 0x94, 0xa0, 0x22, 0x78, 0xde, 0x10, 0x90, 0x91, 0x58, 0x96, 0x10, 0xf0,
+	0x8f, 0x0a, 0x50, 0x13, 0xcf, 0x8e, 0x5e, 0x4f, 0xac, 0xfb, 0x30, 0x35, 0x9f, 0x66, 0xd4, 0xe4,
+	0x07, 0xbb, 0xf9, 0xbd, 0x38, 0xd2, 0x6a, 0x7e, 0x2e, 0x2e, 0xa3, 0x7c, 0x33, 0x9f, 0xa6, 0x39,
+	0x0f, 0x66, 0x79, 0xcb, 0xda, 0x7f, 0xa7, 0x80, 0xf2, 0x61, 0xa2, 0xef, 0xc0, 0xb3, 0xe1, 0x69,
+	0x32, 0x6e, 0x89, 0x08, 0x06, 0x70, 0x7b, 0xe2, 0x40, 0x52, 0xd1, 0xc6, 0x51, 0x99, 0x14, 0xd9,
+	0x55, 0x7e, 0xac, 0xc0, 0xaf, 0xc0, 0x42, 0x0b, 0x7b, 0xc4, 0xa7, 0x7c, 0xe8, 0x0b, 0xe0, 0x48,
+	0x30, 0xd2, 0x91, 0xb1, 0xb2, 0x39, 0x56, 0x1b, 0x87, 0xec, 0x08, 0xcd, 0xa3, 0x7f, 0x7e, 0xac,
+	0x2a, 0x1f, 0x3e, 0x56, 0x95, 0xff, 0x7c, 0xac, 0x2a, 0x6f, 0x6f, 0xab, 0x53, 0x1f, 0x6e, 0xab,
+	0x53, 0xff, 0xba, 0xad, 0x4e, 0xfd, 0xae, 0xd1, 0xb5, 0xe9, 0x45, 0x68, 0xea, 0x1d, 0xe2, 0x24,
+	0xaf, 0xda, 0x9e, 0x4f, 0x2e, 0x71, 0x87, 0x26, 0x5f, 0x8d, 0x91, 0xe7, 0x71, 0x73, 0x8e, 0x8b,
+	0xfe, 0xe2, 0xbf, 0x03, 0x00, 0x21, 0xcc, 0x1a, 0xf4, 0x38, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1057,6 +1215,32 @@ func (m *NodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UnhealthyResources) > 0 {
+		for k := range m.UnhealthyResources {
+			v := m.UnhealthyResources[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintExecutorapi(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintExecutorapi(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintExecutorapi(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	if len(m.ResourceUsageByQueueAndPool) > 0 {
 		for iNdEx := len(m.ResourceUsageByQueueAndPool) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1426,6 +1610,20 @@ func (m *LeaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProcessedNodeQuarantineReleases) > 0 {
+		for iNdEx := len(m.ProcessedNodeQuarantineReleases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProcessedNodeQuarantineReleases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExecutorapi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.UnassignedJobRunIds) > 0 {
 		for iNdEx := len(m.UnassignedJobRunIds) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.UnassignedJobRunIds[iNdEx])
@@ -1543,6 +1741,25 @@ func (m *JobRunLease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.QueueLabels) > 0 {
+		for k := range m.QueueLabels {
+			v := m.QueueLabels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintExecutorapi(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintExecutorapi(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintExecutorapi(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.JobRunId) > 0 {
 		i -= len(m.JobRunId)
 		copy(dAtA[i:], m.JobRunId)
@@ -1682,6 +1899,85 @@ func (m *EndMarker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NodeQuarantineRelease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeQuarantineRelease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeQuarantineRelease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequestedAt != nil {
+		{
+			size, err := m.RequestedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExecutorapi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintExecutorapi(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReleaseNodeQuarantines) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseNodeQuarantines) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseNodeQuarantines) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Releases) > 0 {
+		for iNdEx := len(m.Releases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Releases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExecutorapi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LeaseStreamMessage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1798,6 +2094,27 @@ func (m *LeaseStreamMessage_PreemptRuns) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+func (m *LeaseStreamMessage_ReleaseNodeQuarantines) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaseStreamMessage_ReleaseNodeQuarantines) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ReleaseNodeQuarantines != nil {
+		{
+			size, err := m.ReleaseNodeQuarantines.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExecutorapi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
 func encodeVarintExecutorapi(dAtA []byte, offset int, v uint64) int {
 	offset -= sovExecutorapi(v)
 	base := offset
@@ -1910,6 +2227,19 @@ func (m *NodeInfo) Size() (n int) {
 			n += 1 + l + sovExecutorapi(uint64(l))
 		}
 	}
+	if len(m.UnhealthyResources) > 0 {
+		for k, v := range m.UnhealthyResources {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovExecutorapi(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovExecutorapi(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovExecutorapi(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -2035,6 +2365,12 @@ func (m *LeaseRequest) Size() (n int) {
 			n += 1 + l + sovExecutorapi(uint64(l))
 		}
 	}
+	if len(m.ProcessedNodeQuarantineReleases) > 0 {
+		for _, e := range m.ProcessedNodeQuarantineReleases {
+			l = e.Size()
+			n += 1 + l + sovExecutorapi(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2070,6 +2406,14 @@ func (m *JobRunLease) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovExecutorapi(uint64(l))
 	}
+	if len(m.QueueLabels) > 0 {
+		for k, v := range m.QueueLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovExecutorapi(uint64(len(k))) + 1 + len(v) + sovExecutorapi(uint64(len(v)))
+			n += mapEntrySize + 1 + sovExecutorapi(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -2112,6 +2456,38 @@ func (m *EndMarker) Size() (n int) {
 	return n
 }
 
+func (m *NodeQuarantineRelease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovExecutorapi(uint64(l))
+	}
+	if m.RequestedAt != nil {
+		l = m.RequestedAt.Size()
+		n += 1 + l + sovExecutorapi(uint64(l))
+	}
+	return n
+}
+
+func (m *ReleaseNodeQuarantines) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Releases) > 0 {
+		for _, e := range m.Releases {
+			l = e.Size()
+			n += 1 + l + sovExecutorapi(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *LeaseStreamMessage) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2172,8 +2548,20 @@ func (m *LeaseStreamMessage_PreemptRuns) Size() (n int) {
 	}
 	return n
 }
-
-func sovExecutorapi(x uint64) (n int) {
+func (m *LeaseStreamMessage_ReleaseNodeQuarantines) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReleaseNodeQuarantines != nil {
+		l = m.ReleaseNodeQuarantines.Size()
+		n += 1 + l + sovExecutorapi(uint64(l))
+	}
+	return n
+}
+
+func sovExecutorapi(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozExecutorapi(x uint64) (n int) {
@@ -3134,6 +3522,135 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnhealthyResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorapi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutorapi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutorapi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UnhealthyResources == nil {
+				m.UnhealthyResources = make(map[string]*resource.Quantity)
+			}
+			var mapkey string
+			var mapvalue *resource.Quantity
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowExecutorapi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutorapi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthExecutorapi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthExecutorapi
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutorapi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthExecutorapi
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthExecutorapi
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipExecutorapi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthExecutorapi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.UnhealthyResources[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutorapi(dAtA[iNdEx:])
@@ -4097,6 +4614,40 @@ func (m *LeaseRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.UnassignedJobRunIds = append(m.UnassignedJobRunIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedNodeQuarantineReleases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorapi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutorapi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutorapi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessedNodeQuarantineReleases = append(m.ProcessedNodeQuarantineReleases, &NodeQuarantineRelease{})
+			if err := m.ProcessedNodeQuarantineReleases[len(m.ProcessedNodeQuarantineReleases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutorapi(dAtA[iNdEx:])
@@ -4343,6 +4894,133 @@ func (m *JobRunLease) Unmarshal(dAtA []byte) error {
 			}
 			m.JobRunId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorapi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutorapi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutorapi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QueueLabels == nil {
+				m.QueueLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowExecutorapi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutorapi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthExecutorapi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthExecutorapi
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutorapi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthExecutorapi
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthExecutorapi
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipExecutorapi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthExecutorapi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.QueueLabels[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutorapi(dAtA[iNdEx:])
@@ -4578,6 +5256,208 @@ func (m *EndMarker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *NodeQuarantineRelease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutorapi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeQuarantineRelease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeQuarantineRelease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorapi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutorapi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutorapi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorapi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutorapi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutorapi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RequestedAt == nil {
+				m.RequestedAt = &types.Timestamp{}
+			}
+			if err := m.RequestedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutorapi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecutorapi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseNodeQuarantines) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutorapi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseNodeQuarantines: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseNodeQuarantines: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Releases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorapi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutorapi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutorapi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Releases = append(m.Releases, &NodeQuarantineRelease{})
+			if err := m.Releases[len(m.Releases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutorapi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecutorapi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *LeaseStreamMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4747,6 +5627,41 @@ func (m *LeaseStreamMessage) Unmarshal(dAtA []byte) error {
 			}
 			m.Event = &LeaseStreamMessage_PreemptRuns{v}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseNodeQuarantines", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutorapi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutorapi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutorapi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ReleaseNodeQuarantines{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &LeaseStreamMessage_ReleaseNodeQuarantines{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutorapi(dAtA[iNdEx:])
@@ -51,6 +51,11 @@ func NewNodeFromNodeInfo(nodeInfo *NodeInfo, executor string, allowedPriorities
 		}
 	}
 
+	var unhealthyResources *schedulerobjects.ResourceList
+	if len(nodeInfo.UnhealthyResources) > 0 {
+		unhealthyResources = ResourceListFromProtoResources(nodeInfo.UnhealthyResources)
+	}
+
 	jobRunsByState := make(map[string]schedulerobjects.JobRunState)
 	for jobId, state := range nodeInfo.RunIdsByState {
 		jobRunsByState[jobId] = api.JobRunStateFromApiJobState(state)
@@ -66,6 +71,7 @@ func NewNodeFromNodeInfo(nodeInfo *NodeInfo, executor string, allowedPriorities
 		Labels:                      nodeInfo.GetLabels(),
 		TotalResources:              ResourceListFromProtoResources(nodeInfo.TotalResources),
 		UnallocatableResources:      unallocatableResources,
+		UnhealthyResources:          unhealthyResources,
 		StateByJobRunId:             jobRunsByState,
 		Unschedulable:               nodeInfo.Unschedulable,
 		ResourceUsageByQueueAndPool: resourceUsageByQueueAndPool,